@@ -1,9 +1,8 @@
 package main
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
-	"time"
 
 	dnac "github.com/cisco-en-programmability/dnacenter-go-sdk/sdk"
 )
@@ -39,36 +38,18 @@ func main() {
 	}
 
 	commands := []string{"show version", "show ip interface brief"}
-	runReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationRequest := &dnac.RunReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationRequest{
-		Commands:    commands,
-		DeviceUUIDs: deviceIDs,
-		Timeout:     0,
-	}
-	response, _, err := client.CommandRunner.RunReadOnlyCommandsOnDevicesToGetTheirRealTimeConfiguration(runReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationRequest)
-	taskID := response.Response.TaskID
-
-	fmt.Println(taskID)
-	time.Sleep(20 * time.Second)
-
-	taskResponse, _, err := client.Task.GetTaskByID(taskID)
-	if err != nil {
-		fmt.Println(err)
-	}
-	type fileIDJSON struct {
-		FileID string `json:"fileId"`
-	}
-	fmt.Println(taskResponse.Response.Progress)
-	var fileID fileIDJSON
-	err = json.Unmarshal([]byte(taskResponse.Response.Progress), &fileID)
+	result, err := client.CommandRunner.Run(context.Background(), deviceIDs, commands, nil)
 	if err != nil {
 		fmt.Println(err)
 		return
 	}
-
-	fileResponse, _, err := client.File.DownloadAFileByFileID(fileID.FileID)
-	if err != nil {
-		fmt.Println(err)
+	for deviceID, output := range result {
+		for command, text := range output.Success {
+			fmt.Println(deviceID, command)
+			fmt.Println(text)
+		}
+		for command, reason := range output.Failure {
+			fmt.Println(deviceID, command, "failed:", reason)
+		}
 	}
-	fmt.Println(fileResponse)
-
 }
@@ -60,9 +60,9 @@ func main() {
 			Comments:    "DNA Center HTTP Credentials",
 			Description: "HTTP Creds",
 			Password:    "HTTP-cr3d$",
-			Port:        443,
+			Port:        dnac.Int(443),
 			Username:    "dnac-http-user",
-			Secure:      true,
+			Secure:      dnac.Bool(true),
 		},
 	}
 
@@ -1,9 +1,13 @@
 package dnac
 
+//go:generate go run ./internal/geninterfaces
+
 import (
+	"context"
 	"crypto/tls"
-	"fmt"
+	"math"
 	"os"
+	"time"
 
 	"github.com/go-resty/resty/v2"
 )
@@ -15,7 +19,10 @@ var apiURL = "https://sandboxdnac.cisco.com"
 // Client manages communication with the Webex Teams API API v1.0.0
 // In most cases there should be only one, shared, APIClient.
 type Client struct {
-	common service // Reuse a single struct instead of allocating one for each service on the heap.
+	common  service // Reuse a single struct instead of allocating one for each service on the heap.
+	cfg     clientConfig
+	tokens  tokenManager
+	limiter *rateLimiter
 
 	// API Services
 	Authentication              *AuthenticationService
@@ -53,34 +60,45 @@ type service struct {
 
 // SetAuthToken defines the Authorization token sent in the request
 func (s *Client) SetAuthToken(accessToken string) {
-	s.common.client.SetAuthToken(accessToken)
+	s.SetTokenProvider(StaticTokenProvider(accessToken))
 }
 
-// NewClient creates a new API client. Requires a userAgent string describing your application.
-// optionally a custom http.Client to allow for advanced features such as caching.
-func NewClient() (*Client, error) {
-	var username = ""
-	var password = ""
-	client := resty.New()
-	c := &Client{}
-	c.common.client = client
+// SetTokenProvider replaces the provider of the access tokens sent in the requests.
+func (s *Client) SetTokenProvider(provider TokenProvider) {
+	s.tokens.setProvider(provider)
+}
 
-	if os.Getenv("DNAC_DEBUG") == "true" {
-		client.SetDebug(true)
+// New creates a new API client configured by opts and obtains an initial
+// access token from its TokenProvider. All configuration is kept on the
+// returned Client; the process environment is neither read nor modified.
+func New(opts ...Option) (*Client, error) {
+	cfg := clientConfig{
+		tokenLifetime: defaultTokenLifetime,
 	}
-	if os.Getenv("DNAC_SSL_VERIFY") == "false" {
-		client.SetTLSClientConfig(&tls.Config{InsecureSkipVerify: true})
+	for _, opt := range opts {
+		opt(&cfg)
 	}
 
-	if os.Getenv("DNAC_BASE_URL") != "" {
-		c.common.client.SetHostURL(os.Getenv("DNAC_BASE_URL"))
+	var client *resty.Client
+	if cfg.httpClient != nil {
+		client = resty.NewWithClient(cfg.httpClient)
+	} else {
+		client = resty.New()
 	}
-	if os.Getenv("DNAC_USERNAME") != "" {
-		username = os.Getenv("DNAC_USERNAME")
+	c := &Client{cfg: cfg}
+	c.common.client = client
+
+	client.SetDebug(cfg.debug)
+	if cfg.tlsConfig != nil {
+		client.SetTLSClientConfig(cfg.tlsConfig)
 	}
-	if os.Getenv("DNAC_PASSWORD") != "" {
-		password = os.Getenv("DNAC_PASSWORD")
+	if cfg.baseURL != "" {
+		client.SetHostURL(cfg.baseURL)
 	}
+	if cfg.userAgent != "" {
+		client.SetHeader("User-Agent", cfg.userAgent)
+	}
+
 	// API Services
 	c.Authentication = (*AuthenticationService)(&c.common)
 	c.Sites = (*SitesService)(&c.common)
@@ -110,42 +128,65 @@ func NewClient() (*Client, error) {
 	c.EventManagement = (*EventManagementService)(&c.common)
 	c.DeviceReplacement = (*DeviceReplacementService)(&c.common)
 
-	result, response, err := c.Authentication.AuthenticationAPI(username, password)
-	if err != nil {
-		return c, err
+	if cfg.tokenProvider != nil {
+		c.tokens.provider = cfg.tokenProvider
+	} else {
+		provider := NewPasswordTokenProvider(c.Authentication, cfg.username, cfg.password)
+		provider.Lifetime = cfg.tokenLifetime
+		c.tokens.provider = provider
 	}
-	if response.StatusCode() > 399 {
-		error := response.Error()
-		return c, fmt.Errorf("%s", error)
+	c.limiter = newRateLimiter(cfg)
+	if c.limiter != nil {
+		client.OnBeforeRequest(c.waitRateLimit)
+	}
+	client.OnBeforeRequest(c.setAuthTokenHeader)
+	// One extra attempt is reserved for replaying a request after a token refresh.
+	retryCount := 1
+	if cfg.retryPolicy.MaxAttempts > 1 {
+		retryCount = cfg.retryPolicy.MaxAttempts
+	}
+	client.SetRetryCount(retryCount)
+	client.SetRetryWaitTime(time.Nanosecond)
+	client.SetRetryMaxWaitTime(time.Duration(math.MaxInt64))
+	client.SetRetryAfter(c.retryWait)
+	client.AddRetryCondition(c.retryCondition)
+	client.AddRetryHook(c.onRetry)
+
+	if _, err := c.tokens.get(context.Background()); err != nil {
+		return c, err
 	}
-	client.SetHeader("X-Auth-Token", result.Token)
 	return c, nil
 }
 
-//NewClientWithOptions is the client with options passed with parameters
+// NewClient creates a new API client configured from the DNAC_BASE_URL,
+// DNAC_USERNAME, DNAC_PASSWORD, DNAC_DEBUG and DNAC_SSL_VERIFY environment variables.
+func NewClient() (*Client, error) {
+	return New(optionsFromEnv(
+		os.Getenv("DNAC_BASE_URL"),
+		os.Getenv("DNAC_USERNAME"),
+		os.Getenv("DNAC_PASSWORD"),
+		os.Getenv("DNAC_DEBUG"),
+		os.Getenv("DNAC_SSL_VERIFY"),
+	)...)
+}
+
+// NewClientWithOptions is the client with options passed with parameters
 func NewClientWithOptions(baseURL string, username string, password string, debug string, sslVerify string) (*Client, error) {
-	var err error
-	err = os.Setenv("DNAC_BASE_URL", baseURL)
-	if err != nil {
-		return nil, err
-	}
-	err = os.Setenv("DNAC_USERNAME", username)
-	if err != nil {
-		return nil, err
-	}
-	err = os.Setenv("DNAC_PASSWORD", password)
-	if err != nil {
-		return nil, err
-	}
-	err = os.Setenv("DNAC_DEBUG", debug)
-	if err != nil {
-		return nil, err
+	return New(optionsFromEnv(baseURL, username, password, debug, sslVerify)...)
+}
+
+// optionsFromEnv translates the string settings accepted by NewClient and
+// NewClientWithOptions into Options.
+func optionsFromEnv(baseURL string, username string, password string, debug string, sslVerify string) []Option {
+	opts := []Option{
+		WithBaseURL(baseURL),
+		WithCredentials(username, password),
+		WithDebug(debug == "true"),
 	}
-	err = os.Setenv("DNAC_SSL_VERIFY", sslVerify)
-	if err != nil {
-		return nil, err
+	if sslVerify == "false" {
+		opts = append(opts, WithTLSConfig(&tls.Config{InsecureSkipVerify: true}))
 	}
-	return NewClient()
+	return opts
 }
 
 // Error indicates an error from the invocation of a Cisco DNA Center API.
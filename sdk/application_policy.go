@@ -1,7 +1,7 @@
 package dnac
 
 import (
-	"fmt"
+	"context"
 
 	"github.com/go-resty/resty/v2"
 	"github.com/google/go-querystring/query"
@@ -12,7 +12,7 @@ type ApplicationPolicyService service
 
 // CreateApplicationRequest is the createApplicationRequest definition
 type CreateApplicationRequest struct {
-	ApplicationSet      CreateApplicationRequestApplicationSet        `json:"applicationSet,omitempty"`      //
+	ApplicationSet      *CreateApplicationRequestApplicationSet       `json:"applicationSet,omitempty"`      //
 	Name                string                                        `json:"name,omitempty"`                //
 	NetworkApplications []CreateApplicationRequestNetworkApplications `json:"networkApplications,omitempty"` //
 	NetworkIDentity     []CreateApplicationRequestNetworkIDentity     `json:"networkIdentity,omitempty"`     //
@@ -36,8 +36,8 @@ type CreateApplicationRequestNetworkApplications struct {
 	IgnoreConflict     string `json:"ignoreConflict,omitempty"`     //
 	LongDescription    string `json:"longDescription,omitempty"`    //
 	Name               string `json:"name,omitempty"`               //
-	Popularity         int    `json:"popularity,omitempty"`         //
-	Rank               int    `json:"rank,omitempty"`               //
+	Popularity         *int   `json:"popularity,omitempty"`         //
+	Rank               *int   `json:"rank,omitempty"`               //
 	ServerName         string `json:"serverName,omitempty"`         //
 	TrafficClass       string `json:"trafficClass,omitempty"`       //
 	URL                string `json:"url,omitempty"`                //
@@ -46,10 +46,10 @@ type CreateApplicationRequestNetworkApplications struct {
 // CreateApplicationRequestNetworkIDentity is the createApplicationRequestNetworkIDentity definition
 type CreateApplicationRequestNetworkIDentity struct {
 	DisplayName string `json:"displayName,omitempty"` //
-	LowerPort   int    `json:"lowerPort,omitempty"`   //
+	LowerPort   *int   `json:"lowerPort,omitempty"`   //
 	Ports       string `json:"ports,omitempty"`       //
 	Protocol    string `json:"protocol,omitempty"`    //
-	UpperPort   int    `json:"upperPort,omitempty"`   //
+	UpperPort   *int   `json:"upperPort,omitempty"`   //
 }
 
 // CreateApplicationSetRequest is the createApplicationSetRequest definition
@@ -59,7 +59,7 @@ type CreateApplicationSetRequest struct {
 
 // EditApplicationRequest is the editApplicationRequest definition
 type EditApplicationRequest struct {
-	ApplicationSet      EditApplicationRequestApplicationSet        `json:"applicationSet,omitempty"`      //
+	ApplicationSet      *EditApplicationRequestApplicationSet       `json:"applicationSet,omitempty"`      //
 	ID                  string                                      `json:"id,omitempty"`                  //
 	Name                string                                      `json:"name,omitempty"`                //
 	NetworkApplications []EditApplicationRequestNetworkApplications `json:"networkApplications,omitempty"` //
@@ -85,8 +85,8 @@ type EditApplicationRequestNetworkApplications struct {
 	IgnoreConflict     string `json:"ignoreConflict,omitempty"`     //
 	LongDescription    string `json:"longDescription,omitempty"`    //
 	Name               string `json:"name,omitempty"`               //
-	Popularity         int    `json:"popularity,omitempty"`         //
-	Rank               int    `json:"rank,omitempty"`               //
+	Popularity         *int   `json:"popularity,omitempty"`         //
+	Rank               *int   `json:"rank,omitempty"`               //
 	ServerName         string `json:"serverName,omitempty"`         //
 	TrafficClass       string `json:"trafficClass,omitempty"`       //
 	URL                string `json:"url,omitempty"`                //
@@ -96,10 +96,10 @@ type EditApplicationRequestNetworkApplications struct {
 type EditApplicationRequestNetworkIDentity struct {
 	DisplayName string `json:"displayName,omitempty"` //
 	ID          string `json:"id,omitempty"`          //
-	LowerPort   int    `json:"lowerPort,omitempty"`   //
+	LowerPort   *int   `json:"lowerPort,omitempty"`   //
 	Ports       string `json:"ports,omitempty"`       //
 	Protocol    string `json:"protocol,omitempty"`    //
-	UpperPort   int    `json:"upperPort,omitempty"`   //
+	UpperPort   *int   `json:"upperPort,omitempty"`   //
 }
 
 // CreateApplicationResponse is the createApplicationResponse definition
@@ -247,10 +247,16 @@ type GetApplicationsResponseNetworkIDentity struct {
 /* Create new Custom application
  */
 func (s *ApplicationPolicyService) CreateApplication(createApplicationRequest *[]CreateApplicationRequest) (*CreateApplicationResponse, *resty.Response, error) {
+	return s.CreateApplicationWithContext(context.Background(), createApplicationRequest)
+}
+
+// CreateApplicationWithContext is like CreateApplication but sends the request with ctx for cancellation and deadlines.
+func (s *ApplicationPolicyService) CreateApplicationWithContext(ctx context.Context, createApplicationRequest *[]CreateApplicationRequest) (*CreateApplicationResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/applications"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(createApplicationRequest).
 		SetResult(&CreateApplicationResponse{}).
 		SetError(&Error{}).
@@ -261,7 +267,7 @@ func (s *ApplicationPolicyService) CreateApplication(createApplicationRequest *[
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation createApplication")
+		return nil, response, newAPIError("createApplication", response)
 	}
 
 	result := response.Result().(*CreateApplicationResponse)
@@ -272,10 +278,16 @@ func (s *ApplicationPolicyService) CreateApplication(createApplicationRequest *[
 /* Create new custom application-set/s
  */
 func (s *ApplicationPolicyService) CreateApplicationSet(createApplicationSetRequest *[]CreateApplicationSetRequest) (*CreateApplicationSetResponse, *resty.Response, error) {
+	return s.CreateApplicationSetWithContext(context.Background(), createApplicationSetRequest)
+}
+
+// CreateApplicationSetWithContext is like CreateApplicationSet but sends the request with ctx for cancellation and deadlines.
+func (s *ApplicationPolicyService) CreateApplicationSetWithContext(ctx context.Context, createApplicationSetRequest *[]CreateApplicationSetRequest) (*CreateApplicationSetResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/application-policy-application-set"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(createApplicationSetRequest).
 		SetResult(&CreateApplicationSetResponse{}).
 		SetError(&Error{}).
@@ -286,7 +298,7 @@ func (s *ApplicationPolicyService) CreateApplicationSet(createApplicationSetRequ
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation createApplicationSet")
+		return nil, response, newAPIError("createApplicationSet", response)
 	}
 
 	result := response.Result().(*CreateApplicationSetResponse)
@@ -303,12 +315,18 @@ type DeleteApplicationQueryParams struct {
 @param id Application's Id
 */
 func (s *ApplicationPolicyService) DeleteApplication(deleteApplicationQueryParams *DeleteApplicationQueryParams) (*DeleteApplicationResponse, *resty.Response, error) {
+	return s.DeleteApplicationWithContext(context.Background(), deleteApplicationQueryParams)
+}
+
+// DeleteApplicationWithContext is like DeleteApplication but sends the request with ctx for cancellation and deadlines.
+func (s *ApplicationPolicyService) DeleteApplicationWithContext(ctx context.Context, deleteApplicationQueryParams *DeleteApplicationQueryParams) (*DeleteApplicationResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/applications"
 
 	queryString, _ := query.Values(deleteApplicationQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&DeleteApplicationResponse{}).
 		SetError(&Error{}).
@@ -319,7 +337,7 @@ func (s *ApplicationPolicyService) DeleteApplication(deleteApplicationQueryParam
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation deleteApplication")
+		return nil, response, newAPIError("deleteApplication", response)
 	}
 
 	result := response.Result().(*DeleteApplicationResponse)
@@ -336,12 +354,18 @@ type DeleteApplicationSetQueryParams struct {
 @param id
 */
 func (s *ApplicationPolicyService) DeleteApplicationSet(deleteApplicationSetQueryParams *DeleteApplicationSetQueryParams) (*DeleteApplicationSetResponse, *resty.Response, error) {
+	return s.DeleteApplicationSetWithContext(context.Background(), deleteApplicationSetQueryParams)
+}
+
+// DeleteApplicationSetWithContext is like DeleteApplicationSet but sends the request with ctx for cancellation and deadlines.
+func (s *ApplicationPolicyService) DeleteApplicationSetWithContext(ctx context.Context, deleteApplicationSetQueryParams *DeleteApplicationSetQueryParams) (*DeleteApplicationSetResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/application-policy-application-set"
 
 	queryString, _ := query.Values(deleteApplicationSetQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&DeleteApplicationSetResponse{}).
 		SetError(&Error{}).
@@ -352,7 +376,7 @@ func (s *ApplicationPolicyService) DeleteApplicationSet(deleteApplicationSetQuer
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation deleteApplicationSet")
+		return nil, response, newAPIError("deleteApplicationSet", response)
 	}
 
 	result := response.Result().(*DeleteApplicationSetResponse)
@@ -363,10 +387,16 @@ func (s *ApplicationPolicyService) DeleteApplicationSet(deleteApplicationSetQuer
 /* Edit the attributes of an existing application
  */
 func (s *ApplicationPolicyService) EditApplication(editApplicationRequest *[]EditApplicationRequest) (*EditApplicationResponse, *resty.Response, error) {
+	return s.EditApplicationWithContext(context.Background(), editApplicationRequest)
+}
+
+// EditApplicationWithContext is like EditApplication but sends the request with ctx for cancellation and deadlines.
+func (s *ApplicationPolicyService) EditApplicationWithContext(ctx context.Context, editApplicationRequest *[]EditApplicationRequest) (*EditApplicationResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/applications"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(editApplicationRequest).
 		SetResult(&EditApplicationResponse{}).
 		SetError(&Error{}).
@@ -377,7 +407,7 @@ func (s *ApplicationPolicyService) EditApplication(editApplicationRequest *[]Edi
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation editApplication")
+		return nil, response, newAPIError("editApplication", response)
 	}
 
 	result := response.Result().(*EditApplicationResponse)
@@ -398,12 +428,18 @@ type GetApplicationSetsQueryParams struct {
 @param name
 */
 func (s *ApplicationPolicyService) GetApplicationSets(getApplicationSetsQueryParams *GetApplicationSetsQueryParams) (*GetApplicationSetsResponse, *resty.Response, error) {
+	return s.GetApplicationSetsWithContext(context.Background(), getApplicationSetsQueryParams)
+}
+
+// GetApplicationSetsWithContext is like GetApplicationSets but sends the request with ctx for cancellation and deadlines.
+func (s *ApplicationPolicyService) GetApplicationSetsWithContext(ctx context.Context, getApplicationSetsQueryParams *GetApplicationSetsQueryParams) (*GetApplicationSetsResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/application-policy-application-set"
 
 	queryString, _ := query.Values(getApplicationSetsQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetApplicationSetsResponse{}).
 		SetError(&Error{}).
@@ -414,7 +450,7 @@ func (s *ApplicationPolicyService) GetApplicationSets(getApplicationSetsQueryPar
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getApplicationSets")
+		return nil, response, newAPIError("getApplicationSets", response)
 	}
 
 	result := response.Result().(*GetApplicationSetsResponse)
@@ -425,10 +461,16 @@ func (s *ApplicationPolicyService) GetApplicationSets(getApplicationSetsQueryPar
 /* Get the number of existing application-sets
  */
 func (s *ApplicationPolicyService) GetApplicationSetsCount() (*GetApplicationSetsCountResponse, *resty.Response, error) {
+	return s.GetApplicationSetsCountWithContext(context.Background())
+}
+
+// GetApplicationSetsCountWithContext is like GetApplicationSetsCount but sends the request with ctx for cancellation and deadlines.
+func (s *ApplicationPolicyService) GetApplicationSetsCountWithContext(ctx context.Context) (*GetApplicationSetsCountResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/application-policy-application-set-count"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&GetApplicationSetsCountResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -438,7 +480,7 @@ func (s *ApplicationPolicyService) GetApplicationSetsCount() (*GetApplicationSet
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getApplicationSetsCount")
+		return nil, response, newAPIError("getApplicationSetsCount", response)
 	}
 
 	result := response.Result().(*GetApplicationSetsCountResponse)
@@ -459,12 +501,18 @@ type GetApplicationsQueryParams struct {
 @param name Application's name
 */
 func (s *ApplicationPolicyService) GetApplications(getApplicationsQueryParams *GetApplicationsQueryParams) (*GetApplicationsResponse, *resty.Response, error) {
+	return s.GetApplicationsWithContext(context.Background(), getApplicationsQueryParams)
+}
+
+// GetApplicationsWithContext is like GetApplications but sends the request with ctx for cancellation and deadlines.
+func (s *ApplicationPolicyService) GetApplicationsWithContext(ctx context.Context, getApplicationsQueryParams *GetApplicationsQueryParams) (*GetApplicationsResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/applications"
 
 	queryString, _ := query.Values(getApplicationsQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetApplicationsResponse{}).
 		SetError(&Error{}).
@@ -475,7 +523,7 @@ func (s *ApplicationPolicyService) GetApplications(getApplicationsQueryParams *G
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getApplications")
+		return nil, response, newAPIError("getApplications", response)
 	}
 
 	result := response.Result().(*GetApplicationsResponse)
@@ -486,10 +534,16 @@ func (s *ApplicationPolicyService) GetApplications(getApplicationsQueryParams *G
 /* Get the number of all existing applications
  */
 func (s *ApplicationPolicyService) GetApplicationsCount() (*GetApplicationsCountResponse, *resty.Response, error) {
+	return s.GetApplicationsCountWithContext(context.Background())
+}
+
+// GetApplicationsCountWithContext is like GetApplicationsCount but sends the request with ctx for cancellation and deadlines.
+func (s *ApplicationPolicyService) GetApplicationsCountWithContext(ctx context.Context) (*GetApplicationsCountResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/applications-count"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&GetApplicationsCountResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -499,7 +553,7 @@ func (s *ApplicationPolicyService) GetApplicationsCount() (*GetApplicationsCount
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getApplicationsCount")
+		return nil, response, newAPIError("getApplicationsCount", response)
 	}
 
 	result := response.Result().(*GetApplicationsCountResponse)
@@ -1,7 +1,7 @@
 package dnac
 
 import (
-	"fmt"
+	"context"
 
 	"github.com/go-resty/resty/v2"
 	"github.com/google/go-querystring/query"
@@ -57,12 +57,18 @@ type ApplicationsQueryParams struct {
 @param limit The max number of application entries in returned data [1, 1000] (optionally used with siteId only)
 */
 func (s *ApplicationsService) Applications(applicationsQueryParams *ApplicationsQueryParams) (*ApplicationsResponse, *resty.Response, error) {
+	return s.ApplicationsWithContext(context.Background(), applicationsQueryParams)
+}
+
+// ApplicationsWithContext is like Applications but sends the request with ctx for cancellation and deadlines.
+func (s *ApplicationsService) ApplicationsWithContext(ctx context.Context, applicationsQueryParams *ApplicationsQueryParams) (*ApplicationsResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/application-health"
 
 	queryString, _ := query.Values(applicationsQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&ApplicationsResponse{}).
 		SetError(&Error{}).
@@ -73,7 +79,7 @@ func (s *ApplicationsService) Applications(applicationsQueryParams *Applications
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation applications")
+		return nil, response, newAPIError("applications", response)
 	}
 
 	result := response.Result().(*ApplicationsResponse)
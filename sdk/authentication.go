@@ -1,6 +1,7 @@
 package dnac
 
 import (
+	"context"
 	"github.com/go-resty/resty/v2"
 )
 
@@ -18,10 +19,16 @@ type AuthenticationAPIResponse struct {
 @param Authorization String composed of “Basic”, followed by a space, followed by the Base64 encoding of “username:password”, NOT including the quotes. For example “Basic YWRtaW46TWFnbGV2MTIz”, where YWRtaW46TWFnbGV2MTIz is the Base 64 encoding.
 */
 func (s *AuthenticationService) AuthenticationAPI(username string, password string) (*AuthenticationAPIResponse, *resty.Response, error) {
+	return s.AuthenticationAPIWithContext(context.Background(), username, password)
+}
+
+// AuthenticationAPIWithContext is like AuthenticationAPI but sends the request with ctx for cancellation and deadlines.
+func (s *AuthenticationService) AuthenticationAPIWithContext(ctx context.Context, username string, password string) (*AuthenticationAPIResponse, *resty.Response, error) {
 
 	path := "/dna/system/api/v1/auth/token"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBasicAuth(username, password).
 		SetResult(&AuthenticationAPIResponse{}).
 		SetError(&Error{}).
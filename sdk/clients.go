@@ -1,7 +1,7 @@
 package dnac
 
 import (
-	"fmt"
+	"context"
 
 	"github.com/go-resty/resty/v2"
 	"github.com/google/go-querystring/query"
@@ -427,12 +427,18 @@ type GetClientDetailQueryParams struct {
 @param macAddress MAC Address of the client
 */
 func (s *ClientsService) GetClientDetail(getClientDetailQueryParams *GetClientDetailQueryParams) (*GetClientDetailResponse, *resty.Response, error) {
+	return s.GetClientDetailWithContext(context.Background(), getClientDetailQueryParams)
+}
+
+// GetClientDetailWithContext is like GetClientDetail but sends the request with ctx for cancellation and deadlines.
+func (s *ClientsService) GetClientDetailWithContext(ctx context.Context, getClientDetailQueryParams *GetClientDetailQueryParams) (*GetClientDetailResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/client-detail"
 
 	queryString, _ := query.Values(getClientDetailQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetClientDetailResponse{}).
 		SetError(&Error{}).
@@ -443,7 +449,7 @@ func (s *ClientsService) GetClientDetail(getClientDetailQueryParams *GetClientDe
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getClientDetail")
+		return nil, response, newAPIError("getClientDetail", response)
 	}
 
 	result := response.Result().(*GetClientDetailResponse)
@@ -464,12 +470,18 @@ type GetClientEnrichmentDetailsHeaderParams struct {
 @param issueCategory The category of the DNA event based on which the underlying issues need to be fetched
 */
 func (s *ClientsService) GetClientEnrichmentDetails(getClientEnrichmentDetailsHeaderParams *GetClientEnrichmentDetailsHeaderParams) (*GetClientEnrichmentDetailsResponse, *resty.Response, error) {
+	return s.GetClientEnrichmentDetailsWithContext(context.Background(), getClientEnrichmentDetailsHeaderParams)
+}
+
+// GetClientEnrichmentDetailsWithContext is like GetClientEnrichmentDetails but sends the request with ctx for cancellation and deadlines.
+func (s *ClientsService) GetClientEnrichmentDetailsWithContext(ctx context.Context, getClientEnrichmentDetailsHeaderParams *GetClientEnrichmentDetailsHeaderParams) (*GetClientEnrichmentDetailsResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/client-enrichment-details"
 
 	var response *resty.Response
 	var err error
 	clientRequest := s.client.R().
+		SetContext(ctx).
 		SetHeader("Content-Type", "application/json").
 		SetHeader("Accept", "application/json")
 
@@ -490,7 +502,7 @@ func (s *ClientsService) GetClientEnrichmentDetails(getClientEnrichmentDetailsHe
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getClientEnrichmentDetails")
+		return nil, response, newAPIError("getClientEnrichmentDetails", response)
 	}
 
 	result := response.Result().(*GetClientEnrichmentDetailsResponse)
@@ -507,12 +519,18 @@ type GetOverallClientHealthQueryParams struct {
 @param timestamp Epoch time(in milliseconds) when the Client health data is required
 */
 func (s *ClientsService) GetOverallClientHealth(getOverallClientHealthQueryParams *GetOverallClientHealthQueryParams) (*GetOverallClientHealthResponse, *resty.Response, error) {
+	return s.GetOverallClientHealthWithContext(context.Background(), getOverallClientHealthQueryParams)
+}
+
+// GetOverallClientHealthWithContext is like GetOverallClientHealth but sends the request with ctx for cancellation and deadlines.
+func (s *ClientsService) GetOverallClientHealthWithContext(ctx context.Context, getOverallClientHealthQueryParams *GetOverallClientHealthQueryParams) (*GetOverallClientHealthResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/client-health"
 
 	queryString, _ := query.Values(getOverallClientHealthQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetOverallClientHealthResponse{}).
 		SetError(&Error{}).
@@ -523,7 +541,7 @@ func (s *ClientsService) GetOverallClientHealth(getOverallClientHealthQueryParam
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getOverallClientHealth")
+		return nil, response, newAPIError("getOverallClientHealth", response)
 	}
 
 	result := response.Result().(*GetOverallClientHealthResponse)
@@ -1,7 +1,7 @@
 package dnac
 
 import (
-	"fmt"
+	"context"
 
 	"github.com/go-resty/resty/v2"
 )
@@ -15,7 +15,7 @@ type RunReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationRequest struct {
 	Description string   `json:"description,omitempty"` //
 	DeviceUUIDs []string `json:"deviceUuids,omitempty"` //
 	Name        string   `json:"name,omitempty"`        //
-	Timeout     int      `json:"timeout,omitempty"`     //
+	Timeout     *int     `json:"timeout,omitempty"`     //
 }
 
 // RunReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationRequestCommands is the runReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationRequestCommands definition
@@ -49,10 +49,16 @@ type RunReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationResponseResponse
 /* Get valid keywords
  */
 func (s *CommandRunnerService) GetAllKeywordsOfCLIsAcceptedByCommandRunner() (*GetAllKeywordsOfCLIsAcceptedByCommandRunnerResponse, *resty.Response, error) {
+	return s.GetAllKeywordsOfCLIsAcceptedByCommandRunnerWithContext(context.Background())
+}
+
+// GetAllKeywordsOfCLIsAcceptedByCommandRunnerWithContext is like GetAllKeywordsOfCLIsAcceptedByCommandRunner but sends the request with ctx for cancellation and deadlines.
+func (s *CommandRunnerService) GetAllKeywordsOfCLIsAcceptedByCommandRunnerWithContext(ctx context.Context) (*GetAllKeywordsOfCLIsAcceptedByCommandRunnerResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/network-device-poller/cli/legit-reads"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&GetAllKeywordsOfCLIsAcceptedByCommandRunnerResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -62,7 +68,7 @@ func (s *CommandRunnerService) GetAllKeywordsOfCLIsAcceptedByCommandRunner() (*G
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getAllKeywordsOfCLIsAcceptedByCommandRunner")
+		return nil, response, newAPIError("getAllKeywordsOfCLIsAcceptedByCommandRunner", response)
 	}
 
 	result := response.Result().(*GetAllKeywordsOfCLIsAcceptedByCommandRunnerResponse)
@@ -73,10 +79,16 @@ func (s *CommandRunnerService) GetAllKeywordsOfCLIsAcceptedByCommandRunner() (*G
 /* Submit request for read-only CLIs
  */
 func (s *CommandRunnerService) RunReadOnlyCommandsOnDevicesToGetTheirRealTimeConfiguration(runReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationRequest *RunReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationRequest) (*RunReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationResponse, *resty.Response, error) {
+	return s.RunReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationWithContext(context.Background(), runReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationRequest)
+}
+
+// RunReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationWithContext is like RunReadOnlyCommandsOnDevicesToGetTheirRealTimeConfiguration but sends the request with ctx for cancellation and deadlines.
+func (s *CommandRunnerService) RunReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationWithContext(ctx context.Context, runReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationRequest *RunReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationRequest) (*RunReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/network-device-poller/cli/read-request"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(runReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationRequest).
 		SetResult(&RunReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationResponse{}).
 		SetError(&Error{}).
@@ -87,7 +99,7 @@ func (s *CommandRunnerService) RunReadOnlyCommandsOnDevicesToGetTheirRealTimeCon
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation runReadOnlyCommandsOnDevicesToGetTheirRealTimeConfiguration")
+		return nil, response, newAPIError("runReadOnlyCommandsOnDevicesToGetTheirRealTimeConfiguration", response)
 	}
 
 	result := response.Result().(*RunReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationResponse)
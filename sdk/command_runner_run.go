@@ -0,0 +1,144 @@
+package dnac
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	// commandRunnerMaxDevices is the maximum number of devices DNA Center accepts in one read request.
+	commandRunnerMaxDevices = 100
+	// commandRunnerMaxCommands is the maximum number of commands DNA Center accepts in one read request.
+	commandRunnerMaxCommands = 5
+)
+
+// CommandRunnerOptions controls how Run submits and waits for read requests.
+type CommandRunnerOptions struct {
+	Timeout               int                 // Timeout in seconds sent with every read request
+	MaxDevicesPerRequest  int                 // Defaults to 100
+	MaxCommandsPerRequest int                 // Defaults to 5
+	Wait                  *WaitForTaskOptions // How the read request tasks are polled
+}
+
+// CommandRunnerOutput holds the outputs of the commands run on one device,
+// keyed by command and bucketed by their status.
+type CommandRunnerOutput struct {
+	DeviceUUID  string
+	Success     map[string]string // Output of the commands that succeeded
+	Failure     map[string]string // Error of the commands that failed
+	Blacklisted map[string]string // Commands that are not allowed
+}
+
+// CommandRunnerResult holds the outputs of Run keyed by device UUID.
+type CommandRunnerResult map[string]*CommandRunnerOutput
+
+// commandRunnerFileEntry is the per-device entry of the file produced by a read request.
+type commandRunnerFileEntry struct {
+	DeviceUUID       string `json:"deviceUuid"`
+	CommandResponses struct {
+		Success     map[string]string `json:"SUCCESS"`
+		Failure     map[string]string `json:"FAILURE"`
+		Blacklisted map[string]string `json:"BLACKLISTED"`
+	} `json:"commandResponses"`
+}
+
+// Run runs the read-only commands on the devices and returns their outputs.
+// It submits as many read requests as needed to stay within the per-request
+// device and command limits, waits for their tasks and downloads and parses
+// the resulting files. opts may be nil to use the defaults.
+func (s *CommandRunnerService) Run(ctx context.Context, deviceUUIDs []string, commands []string, opts *CommandRunnerOptions) (CommandRunnerResult, error) {
+	maxDevices := commandRunnerMaxDevices
+	maxCommands := commandRunnerMaxCommands
+	var timeout *int
+	var waitOpts *WaitForTaskOptions
+	if opts != nil {
+		if opts.MaxDevicesPerRequest > 0 {
+			maxDevices = opts.MaxDevicesPerRequest
+		}
+		if opts.MaxCommandsPerRequest > 0 {
+			maxCommands = opts.MaxCommandsPerRequest
+		}
+		if opts.Timeout > 0 {
+			timeout = Int(opts.Timeout)
+		}
+		waitOpts = opts.Wait
+	}
+
+	result := CommandRunnerResult{}
+	for _, devices := range chunkStrings(deviceUUIDs, maxDevices) {
+		for _, cmds := range chunkStrings(commands, maxCommands) {
+			request := &RunReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationRequest{
+				Commands:    cmds,
+				DeviceUUIDs: devices,
+				Timeout:     timeout,
+			}
+			if err := s.runBatch(ctx, request, waitOpts, result); err != nil {
+				return result, err
+			}
+		}
+	}
+	return result, nil
+}
+
+// runBatch submits one read request and merges its outputs into result.
+func (s *CommandRunnerService) runBatch(ctx context.Context, request *RunReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationRequest, waitOpts *WaitForTaskOptions, result CommandRunnerResult) error {
+	response, _, err := s.RunReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationWithContext(ctx, request)
+	if err != nil {
+		return err
+	}
+	taskID := response.Response.TaskID
+	task, err := (*TaskService)(s).WaitForTask(ctx, taskID, waitOpts)
+	if err != nil {
+		return err
+	}
+
+	fileID, err := taskFileID(task.Task)
+	if err != nil {
+		return err
+	}
+	content, err := (*FileService)(s).downloadFile(ctx, fileID)
+	if err != nil {
+		return err
+	}
+	var entries []commandRunnerFileEntry
+	if err := json.Unmarshal(content, &entries); err != nil {
+		return fmt.Errorf("parsing file %s of task %s: %w", fileID, taskID, err)
+	}
+
+	for _, entry := range entries {
+		output, ok := result[entry.DeviceUUID]
+		if !ok {
+			output = &CommandRunnerOutput{
+				DeviceUUID:  entry.DeviceUUID,
+				Success:     map[string]string{},
+				Failure:     map[string]string{},
+				Blacklisted: map[string]string{},
+			}
+			result[entry.DeviceUUID] = output
+		}
+		mergeStrings(output.Success, entry.CommandResponses.Success)
+		mergeStrings(output.Failure, entry.CommandResponses.Failure)
+		mergeStrings(output.Blacklisted, entry.CommandResponses.Blacklisted)
+	}
+	return nil
+}
+
+// chunkStrings splits values into consecutive chunks of at most size elements.
+func chunkStrings(values []string, size int) [][]string {
+	var chunks [][]string
+	for len(values) > size {
+		chunks = append(chunks, values[:size])
+		values = values[size:]
+	}
+	if len(values) > 0 {
+		chunks = append(chunks, values)
+	}
+	return chunks
+}
+
+func mergeStrings(dst map[string]string, src map[string]string) {
+	for k, v := range src {
+		dst[k] = v
+	}
+}
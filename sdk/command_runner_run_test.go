@@ -0,0 +1,133 @@
+package dnac_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	dnac "github.com/cisco-en-programmability/dnacenter-go-sdk/sdk"
+	"github.com/cisco-en-programmability/dnacenter-go-sdk/sdk/dnactest"
+)
+
+func numbered(prefix string, n int) []string {
+	values := make([]string, n)
+	for i := range values {
+		values[i] = fmt.Sprintf("%s%d", prefix, i)
+	}
+	return values
+}
+
+func TestCommandRunnerRunBatches(t *testing.T) {
+	tests := []struct {
+		name     string
+		devices  int
+		commands int
+		opts     *dnac.CommandRunnerOptions
+		batches  [][2]int // Devices and commands of each read request
+	}{
+		{"within limits", 100, 5, nil, [][2]int{{100, 5}}},
+		{"default limits", 150, 7, nil, [][2]int{{100, 5}, {100, 2}, {50, 5}, {50, 2}}},
+		{"custom limits", 3, 2, &dnac.CommandRunnerOptions{MaxDevicesPerRequest: 2, MaxCommandsPerRequest: 1}, [][2]int{{2, 1}, {2, 1}, {1, 1}, {1, 1}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, client := taskServer(t)
+			srv.RespondTask(http.MethodPost, readRequestPath, http.StatusAccepted, dnactest.Task{Progress: `{"fileId":"f1"}`})
+			srv.RespondSequence(http.MethodGet, filePath, dnactest.Response{Body: "[]"})
+			opts := tt.opts
+			if opts == nil {
+				opts = &dnac.CommandRunnerOptions{}
+			}
+			opts.Wait = fastPolls
+
+			devices := numbered("d", tt.devices)
+			commands := numbered("show ", tt.commands)
+			if _, err := client.CommandRunner.Run(context.Background(), devices, commands, opts); err != nil {
+				t.Fatal(err)
+			}
+
+			requests := srv.RequestsTo(http.MethodPost, readRequestPath)
+			if len(requests) != len(tt.batches) {
+				t.Fatalf("got %d read requests, want %d", len(requests), len(tt.batches))
+			}
+			seen := map[string]bool{}
+			for i, r := range requests {
+				var body dnac.RunReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationRequest
+				if err := json.Unmarshal(r.Body, &body); err != nil {
+					t.Fatal(err)
+				}
+				if got := [2]int{len(body.DeviceUUIDs), len(body.Commands)}; got != tt.batches[i] {
+					t.Errorf("request %d has %d devices and %d commands, want %v", i, got[0], got[1], tt.batches[i])
+				}
+				for _, device := range body.DeviceUUIDs {
+					for _, command := range body.Commands {
+						pair := device + " " + command
+						if seen[pair] {
+							t.Errorf("%q run twice", pair)
+						}
+						seen[pair] = true
+					}
+				}
+			}
+			if len(seen) != tt.devices*tt.commands {
+				t.Errorf("ran %d device commands, want %d", len(seen), tt.devices*tt.commands)
+			}
+			srv.AssertCallCount(t, http.MethodGet, "/dna/intent/api/v1/file/f1", len(tt.batches))
+		})
+	}
+}
+
+func TestCommandRunnerRunParsesOutputs(t *testing.T) {
+	srv, client := taskServer(t)
+	srv.RespondTask(http.MethodPost, readRequestPath, http.StatusAccepted, dnactest.Task{AdditionalStatusURL: "/api/v1/file/f1"})
+	srv.RespondSequence(http.MethodGet, filePath,
+		dnactest.Response{Body: `[
+			{"deviceUuid": "d1", "commandResponses": {"SUCCESS": {"show version": "IOS XE 17.3"}, "FAILURE": {}, "BLACKLISTED": {}}},
+			{"deviceUuid": "d2", "commandResponses": {"SUCCESS": {}, "FAILURE": {"show version": "timed out"}, "BLACKLISTED": {}}}
+		]`},
+		dnactest.Response{Body: `[
+			{"deviceUuid": "d1", "commandResponses": {"SUCCESS": {}, "FAILURE": {}, "BLACKLISTED": {"conf t": "not allowed"}}}
+		]`},
+	)
+
+	result, err := client.CommandRunner.Run(context.Background(), []string{"d1", "d2"}, []string{"show version", "conf t"},
+		&dnac.CommandRunnerOptions{MaxCommandsPerRequest: 1, Timeout: 30, Wait: fastPolls})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := dnac.CommandRunnerResult{
+		"d1": {
+			DeviceUUID:  "d1",
+			Success:     map[string]string{"show version": "IOS XE 17.3"},
+			Failure:     map[string]string{},
+			Blacklisted: map[string]string{"conf t": "not allowed"},
+		},
+		"d2": {
+			DeviceUUID:  "d2",
+			Success:     map[string]string{},
+			Failure:     map[string]string{"show version": "timed out"},
+			Blacklisted: map[string]string{},
+		},
+	}
+	if !reflect.DeepEqual(result, want) {
+		t.Errorf("got %+v, want %+v", result, want)
+	}
+	srv.AssertJSONBody(t, http.MethodPost, readRequestPath, map[string]interface{}{
+		"commands":    []string{"conf t"},
+		"deviceUuids": []string{"d1", "d2"},
+		"timeout":     30,
+	})
+}
+
+func TestCommandRunnerRunReportsInvalidFile(t *testing.T) {
+	srv, client := taskServer(t)
+	srv.RespondTask(http.MethodPost, readRequestPath, http.StatusAccepted, dnactest.Task{Progress: `{"fileId":"f1"}`})
+	srv.RespondSequence(http.MethodGet, filePath, dnactest.Response{Body: "not json"})
+
+	if _, err := client.CommandRunner.Run(context.Background(), []string{"d1"}, []string{"show version"}, &dnac.CommandRunnerOptions{Wait: fastPolls}); err == nil {
+		t.Error("got nil, want an error for the invalid file")
+	}
+}
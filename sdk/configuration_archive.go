@@ -1,28 +1,62 @@
 package dnac
 
 import (
+	"context"
 	"github.com/go-resty/resty/v2"
 )
 
 // ConfigurationArchiveService is the service to communicate with the ConfigurationArchive API endpoint
 type ConfigurationArchiveService service
 
+// ExportDeviceConfigurationsRequest is the exportDeviceConfigurationsRequest definition
+type ExportDeviceConfigurationsRequest struct {
+	DeviceID []string `json:"deviceId,omitempty"` //
+	Password string   `json:"password,omitempty"` //
+}
+
+// ExportDeviceConfigurationsRequestDeviceID is the exportDeviceConfigurationsRequestDeviceID definition
+type ExportDeviceConfigurationsRequestDeviceID []string
+
+// ExportDeviceConfigurationsResponse is the exportDeviceConfigurationsResponse definition
+type ExportDeviceConfigurationsResponse struct {
+	Response ExportDeviceConfigurationsResponseResponse `json:"response,omitempty"` //
+	Version  string                                     `json:"version,omitempty"`  //
+}
+
+// ExportDeviceConfigurationsResponseResponse is the exportDeviceConfigurationsResponseResponse definition
+type ExportDeviceConfigurationsResponseResponse struct {
+	TaskID string `json:"taskId,omitempty"` //
+	URL    string `json:"url,omitempty"`    //
+}
+
 // ExportDeviceConfigurations exportDeviceConfigurations
 /* Export Device configurations to an encrypted zip file.
 @param Content-Type
 */
-func (s *ConfigurationArchiveService) ExportDeviceConfigurations() (string, *resty.Response, error) {
+func (s *ConfigurationArchiveService) ExportDeviceConfigurations(exportDeviceConfigurationsRequest *ExportDeviceConfigurationsRequest) (*ExportDeviceConfigurationsResponse, *resty.Response, error) {
+	return s.ExportDeviceConfigurationsWithContext(context.Background(), exportDeviceConfigurationsRequest)
+}
+
+// ExportDeviceConfigurationsWithContext is like ExportDeviceConfigurations but sends the request with ctx for cancellation and deadlines.
+func (s *ConfigurationArchiveService) ExportDeviceConfigurationsWithContext(ctx context.Context, exportDeviceConfigurationsRequest *ExportDeviceConfigurationsRequest) (*ExportDeviceConfigurationsResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/network-device-archive/cleartext"
 
-	var operationResult string
 	response, err := s.client.R().
-		SetResult(&operationResult).
+		SetContext(ctx).
+		SetBody(exportDeviceConfigurationsRequest).
+		SetResult(&ExportDeviceConfigurationsResponse{}).
 		SetError(&Error{}).
 		Post(path)
 
 	if err != nil {
-		return "", nil, err
+		return nil, nil, err
 	}
-	return operationResult, response, err
+
+	if response.IsError() {
+		return nil, response, newAPIError("exportDeviceConfigurations", response)
+	}
+
+	result := response.Result().(*ExportDeviceConfigurationsResponse)
+	return result, response, err
 }
@@ -0,0 +1,89 @@
+package dnac
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"path"
+	"strings"
+)
+
+// DeviceConfiguration holds the configurations of one device extracted from
+// a configuration archive.
+type DeviceConfiguration struct {
+	RunningConfig string            // Running configuration
+	StartupConfig string            // Startup configuration
+	Files         map[string]string // Content of every file of the device, keyed by its path in the archive
+}
+
+// ExportAndExtract exports the configurations of the devices into an archive
+// encrypted with password, waits for the export task, downloads the archive
+// and decrypts and unzips it. The configurations are keyed by device
+// hostname, taken from the top-level directory of each file or, for files at
+// the root of the archive, from the file name up to the first underscore.
+// waitOpts may be nil.
+func (s *ConfigurationArchiveService) ExportAndExtract(ctx context.Context, deviceIDs []string, password string, waitOpts *WaitForTaskOptions) (map[string]*DeviceConfiguration, error) {
+	response, _, err := s.ExportDeviceConfigurationsWithContext(ctx, &ExportDeviceConfigurationsRequest{
+		DeviceID: deviceIDs,
+		Password: password,
+	})
+	if err != nil {
+		return nil, err
+	}
+	task, err := (*TaskService)(s).WaitForTask(ctx, response.Response.TaskID, waitOpts)
+	if err != nil {
+		return nil, err
+	}
+	fileID, err := taskFileID(task.Task)
+	if err != nil {
+		return nil, err
+	}
+	archive, err := (*FileService)(s).downloadFile(ctx, fileID)
+	if err != nil {
+		return nil, err
+	}
+	return extractDeviceConfigurations(archive, password)
+}
+
+// extractDeviceConfigurations reads the configurations of a device
+// configuration archive.
+func extractDeviceConfigurations(archive []byte, password string) (map[string]*DeviceConfiguration, error) {
+	reader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		return nil, err
+	}
+
+	configs := map[string]*DeviceConfiguration{}
+	for _, f := range reader.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		content, err := readZipEntry(f, password)
+		if err != nil {
+			return nil, err
+		}
+
+		name := strings.TrimPrefix(f.Name, "/")
+		hostname := name
+		if i := strings.Index(name, "/"); i >= 0 {
+			hostname = name[:i]
+		} else if i := strings.Index(name, "_"); i >= 0 {
+			hostname = name[:i]
+		}
+		config, ok := configs[hostname]
+		if !ok {
+			config = &DeviceConfiguration{Files: map[string]string{}}
+			configs[hostname] = config
+		}
+		config.Files[name] = string(content)
+
+		base := strings.ToUpper(path.Base(name))
+		switch {
+		case strings.Contains(base, "RUNNING"):
+			config.RunningConfig = string(content)
+		case strings.Contains(base, "STARTUP"):
+			config.StartupConfig = string(content)
+		}
+	}
+	return configs, nil
+}
@@ -1,6 +1,7 @@
 package dnac
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -13,11 +14,11 @@ type ConfigurationTemplatesService service
 
 // CreateProjectRequest is the createProjectRequest definition
 type CreateProjectRequest struct {
-	IsDeletable    bool                            `json:"isDeletable,omitempty"`    //
-	CreateTime     int                             `json:"createTime,omitempty"`     //
+	IsDeletable    *bool                           `json:"isDeletable,omitempty"`    //
+	CreateTime     *int                            `json:"createTime,omitempty"`     //
 	Description    string                          `json:"description,omitempty"`    //
 	ID             string                          `json:"id,omitempty"`             //
-	LastUpdateTime int                             `json:"lastUpdateTime,omitempty"` //
+	LastUpdateTime *int                            `json:"lastUpdateTime,omitempty"` //
 	Name           string                          `json:"name,omitempty"`           //
 	Tags           []string                        `json:"tags,omitempty"`           //
 	Templates      []CreateProjectRequestTemplates `json:"templates,omitempty"`      //
@@ -25,15 +26,15 @@ type CreateProjectRequest struct {
 
 // CreateProjectRequestTemplates is the createProjectRequestTemplates definition
 type CreateProjectRequestTemplates struct {
-	Name              string  `json:"name,omitempty"`              //
-	Composite         bool    `json:"composite,omitempty"`         //
-	Language          string  `json:"language,omitempty"`          //
-	ID                string  `json:"id,omitempty"`                //
-	CustomParamsOrder bool    `json:"customParamsOrder,omitempty"` //
-	LastUpdateTime    float64 `json:"lastUpdateTime,omitempty"`    //
-	LatestVersionTime float64 `json:"latestVersionTime,omitempty"` //
-	ProjectAssociated bool    `json:"projectAssociated,omitempty"` //
-	DocumentDatabase  bool    `json:"documentDatabase,omitempty"`  //
+	Name              string   `json:"name,omitempty"`              //
+	Composite         *bool    `json:"composite,omitempty"`         //
+	Language          string   `json:"language,omitempty"`          //
+	ID                string   `json:"id,omitempty"`                //
+	CustomParamsOrder *bool    `json:"customParamsOrder,omitempty"` //
+	LastUpdateTime    *float64 `json:"lastUpdateTime,omitempty"`    //
+	LatestVersionTime *float64 `json:"latestVersionTime,omitempty"` //
+	ProjectAssociated *bool    `json:"projectAssociated,omitempty"` //
+	DocumentDatabase  *bool    `json:"documentDatabase,omitempty"`  //
 }
 
 // CreateProjectRequestTags is the createProjectRequestTags definition
@@ -42,14 +43,14 @@ type CreateProjectRequestTags []string
 // CreateTemplateRequest is the createTemplateRequest definition
 type CreateTemplateRequest struct {
 	Author                  string                                        `json:"author,omitempty"`                  //
-	Composite               bool                                          `json:"composite,omitempty"`               //
+	Composite               *bool                                         `json:"composite,omitempty"`               //
 	ContainingTemplates     []CreateTemplateRequestContainingTemplates    `json:"containingTemplates,omitempty"`     //
-	CreateTime              int                                           `json:"createTime,omitempty"`              //
+	CreateTime              *int                                          `json:"createTime,omitempty"`              //
 	Description             string                                        `json:"description,omitempty"`             //
 	DeviceTypes             []CreateTemplateRequestDeviceTypes            `json:"deviceTypes,omitempty"`             //
 	FailurePolicy           string                                        `json:"failurePolicy,omitempty"`           //
 	ID                      string                                        `json:"id,omitempty"`                      //
-	LastUpdateTime          int                                           `json:"lastUpdateTime,omitempty"`          //
+	LastUpdateTime          *int                                          `json:"lastUpdateTime,omitempty"`          //
 	Name                    string                                        `json:"name,omitempty"`                    //
 	ParentTemplateID        string                                        `json:"parentTemplateId,omitempty"`        //
 	ProjectID               string                                        `json:"projectId,omitempty"`               //
@@ -67,7 +68,7 @@ type CreateTemplateRequest struct {
 
 // CreateTemplateRequestContainingTemplates is the createTemplateRequestContainingTemplates definition
 type CreateTemplateRequestContainingTemplates struct {
-	Composite bool   `json:"composite,omitempty"` //
+	Composite *bool  `json:"composite,omitempty"` //
 	ID        string `json:"id,omitempty"`        //
 	Name      string `json:"name,omitempty"`      //
 	Version   string `json:"version,omitempty"`   //
@@ -82,30 +83,30 @@ type CreateTemplateRequestDeviceTypes struct {
 
 // CreateTemplateRequestRollbackTemplateParams is the createTemplateRequestRollbackTemplateParams definition
 type CreateTemplateRequestRollbackTemplateParams struct {
-	Binding         string                                               `json:"binding,omitempty"`         //
-	DataType        string                                               `json:"dataType,omitempty"`        //
-	DefaultValue    string                                               `json:"defaultValue,omitempty"`    //
-	Description     string                                               `json:"description,omitempty"`     //
-	DisplayName     string                                               `json:"displayName,omitempty"`     //
-	Group           string                                               `json:"group,omitempty"`           //
-	ID              string                                               `json:"id,omitempty"`              //
-	InstructionText string                                               `json:"instructionText,omitempty"` //
-	Key             string                                               `json:"key,omitempty"`             //
-	NotParam        bool                                                 `json:"notParam,omitempty"`        //
-	Order           int                                                  `json:"order,omitempty"`           //
-	ParamArray      bool                                                 `json:"paramArray,omitempty"`      //
-	ParameterName   string                                               `json:"parameterName,omitempty"`   //
-	Provider        string                                               `json:"provider,omitempty"`        //
-	Range           []CreateTemplateRequestRollbackTemplateParamsRange   `json:"range,omitempty"`           //
-	Required        bool                                                 `json:"required,omitempty"`        //
-	Selection       CreateTemplateRequestRollbackTemplateParamsSelection `json:"selection,omitempty"`       //
+	Binding         string                                                `json:"binding,omitempty"`         //
+	DataType        string                                                `json:"dataType,omitempty"`        //
+	DefaultValue    string                                                `json:"defaultValue,omitempty"`    //
+	Description     string                                                `json:"description,omitempty"`     //
+	DisplayName     string                                                `json:"displayName,omitempty"`     //
+	Group           string                                                `json:"group,omitempty"`           //
+	ID              string                                                `json:"id,omitempty"`              //
+	InstructionText string                                                `json:"instructionText,omitempty"` //
+	Key             string                                                `json:"key,omitempty"`             //
+	NotParam        *bool                                                 `json:"notParam,omitempty"`        //
+	Order           *int                                                  `json:"order,omitempty"`           //
+	ParamArray      *bool                                                 `json:"paramArray,omitempty"`      //
+	ParameterName   string                                                `json:"parameterName,omitempty"`   //
+	Provider        string                                                `json:"provider,omitempty"`        //
+	Range           []CreateTemplateRequestRollbackTemplateParamsRange    `json:"range,omitempty"`           //
+	Required        *bool                                                 `json:"required,omitempty"`        //
+	Selection       *CreateTemplateRequestRollbackTemplateParamsSelection `json:"selection,omitempty"`       //
 }
 
 // CreateTemplateRequestRollbackTemplateParamsRange is the createTemplateRequestRollbackTemplateParamsRange definition
 type CreateTemplateRequestRollbackTemplateParamsRange struct {
 	ID       string `json:"id,omitempty"`       //
-	MaxValue int    `json:"maxValue,omitempty"` //
-	MinValue int    `json:"minValue,omitempty"` //
+	MaxValue *int   `json:"maxValue,omitempty"` //
+	MinValue *int   `json:"minValue,omitempty"` //
 }
 
 // CreateTemplateRequestRollbackTemplateParamsSelection is the createTemplateRequestRollbackTemplateParamsSelection definition
@@ -120,30 +121,30 @@ type CreateTemplateRequestTags []string
 
 // CreateTemplateRequestTemplateParams is the createTemplateRequestTemplateParams definition
 type CreateTemplateRequestTemplateParams struct {
-	Binding         string                                       `json:"binding,omitempty"`         //
-	DataType        string                                       `json:"dataType,omitempty"`        //
-	DefaultValue    string                                       `json:"defaultValue,omitempty"`    //
-	Description     string                                       `json:"description,omitempty"`     //
-	DisplayName     string                                       `json:"displayName,omitempty"`     //
-	Group           string                                       `json:"group,omitempty"`           //
-	ID              string                                       `json:"id,omitempty"`              //
-	InstructionText string                                       `json:"instructionText,omitempty"` //
-	Key             string                                       `json:"key,omitempty"`             //
-	NotParam        bool                                         `json:"notParam,omitempty"`        //
-	Order           int                                          `json:"order,omitempty"`           //
-	ParamArray      bool                                         `json:"paramArray,omitempty"`      //
-	ParameterName   string                                       `json:"parameterName,omitempty"`   //
-	Provider        string                                       `json:"provider,omitempty"`        //
-	Range           []CreateTemplateRequestTemplateParamsRange   `json:"range,omitempty"`           //
-	Required        bool                                         `json:"required,omitempty"`        //
-	Selection       CreateTemplateRequestTemplateParamsSelection `json:"selection,omitempty"`       //
+	Binding         string                                        `json:"binding,omitempty"`         //
+	DataType        string                                        `json:"dataType,omitempty"`        //
+	DefaultValue    string                                        `json:"defaultValue,omitempty"`    //
+	Description     string                                        `json:"description,omitempty"`     //
+	DisplayName     string                                        `json:"displayName,omitempty"`     //
+	Group           string                                        `json:"group,omitempty"`           //
+	ID              string                                        `json:"id,omitempty"`              //
+	InstructionText string                                        `json:"instructionText,omitempty"` //
+	Key             string                                        `json:"key,omitempty"`             //
+	NotParam        *bool                                         `json:"notParam,omitempty"`        //
+	Order           *int                                          `json:"order,omitempty"`           //
+	ParamArray      *bool                                         `json:"paramArray,omitempty"`      //
+	ParameterName   string                                        `json:"parameterName,omitempty"`   //
+	Provider        string                                        `json:"provider,omitempty"`        //
+	Range           []CreateTemplateRequestTemplateParamsRange    `json:"range,omitempty"`           //
+	Required        *bool                                         `json:"required,omitempty"`        //
+	Selection       *CreateTemplateRequestTemplateParamsSelection `json:"selection,omitempty"`       //
 }
 
 // CreateTemplateRequestTemplateParamsRange is the createTemplateRequestTemplateParamsRange definition
 type CreateTemplateRequestTemplateParamsRange struct {
 	ID       string `json:"id,omitempty"`       //
-	MaxValue int    `json:"maxValue,omitempty"` //
-	MinValue int    `json:"minValue,omitempty"` //
+	MaxValue *int   `json:"maxValue,omitempty"` //
+	MinValue *int   `json:"minValue,omitempty"` //
 }
 
 // CreateTemplateRequestTemplateParamsSelection is the createTemplateRequestTemplateParamsSelection definition
@@ -155,8 +156,8 @@ type CreateTemplateRequestTemplateParamsSelection struct {
 
 // DeployTemplateRequest is the deployTemplateRequest definition
 type DeployTemplateRequest struct {
-	ForcePushTemplate            bool                              `json:"forcePushTemplate,omitempty"`            //
-	IsComposite                  bool                              `json:"isComposite,omitempty"`                  //
+	ForcePushTemplate            *bool                             `json:"forcePushTemplate,omitempty"`            //
+	IsComposite                  *bool                             `json:"isComposite,omitempty"`                  //
 	MainTemplateID               string                            `json:"mainTemplateId,omitempty"`               //
 	MemberTemplateDeploymentInfo *[]DeployTemplateRequest          `json:"memberTemplateDeploymentInfo,omitempty"` //
 	TargetInfo                   []DeployTemplateRequestTargetInfo `json:"targetInfo,omitempty"`                   //
@@ -179,10 +180,10 @@ type PreviewTemplateRequest struct {
 
 // UpdateProjectRequest is the updateProjectRequest definition
 type UpdateProjectRequest struct {
-	CreateTime     int                             `json:"createTime,omitempty"`     //
+	CreateTime     *int                            `json:"createTime,omitempty"`     //
 	Description    string                          `json:"description,omitempty"`    //
 	ID             string                          `json:"id,omitempty"`             //
-	LastUpdateTime int                             `json:"lastUpdateTime,omitempty"` //
+	LastUpdateTime *int                            `json:"lastUpdateTime,omitempty"` //
 	Name           string                          `json:"name,omitempty"`           //
 	Tags           []string                        `json:"tags,omitempty"`           //
 	Templates      []UpdateProjectRequestTemplates `json:"templates,omitempty"`      //
@@ -190,15 +191,15 @@ type UpdateProjectRequest struct {
 
 // UpdateProjectRequestTemplates is the updateProjectRequestTemplates definition
 type UpdateProjectRequestTemplates struct {
-	Name              string  `json:"name,omitempty"`              //
-	Composite         bool    `json:"composite,omitempty"`         //
-	Language          string  `json:"language,omitempty"`          //
-	ID                string  `json:"id,omitempty"`                //
-	CustomParamsOrder bool    `json:"customParamsOrder,omitempty"` //
-	LastUpdateTime    float64 `json:"lastUpdateTime,omitempty"`    //
-	LatestVersionTime float64 `json:"latestVersionTime,omitempty"` //
-	ProjectAssociated bool    `json:"projectAssociated,omitempty"` //
-	DocumentDatabase  bool    `json:"documentDatabase,omitempty"`  //
+	Name              string   `json:"name,omitempty"`              //
+	Composite         *bool    `json:"composite,omitempty"`         //
+	Language          string   `json:"language,omitempty"`          //
+	ID                string   `json:"id,omitempty"`                //
+	CustomParamsOrder *bool    `json:"customParamsOrder,omitempty"` //
+	LastUpdateTime    *float64 `json:"lastUpdateTime,omitempty"`    //
+	LatestVersionTime *float64 `json:"latestVersionTime,omitempty"` //
+	ProjectAssociated *bool    `json:"projectAssociated,omitempty"` //
+	DocumentDatabase  *bool    `json:"documentDatabase,omitempty"`  //
 }
 
 // UpdateProjectRequestTags is the updateProjectRequestTags definition
@@ -207,14 +208,14 @@ type UpdateProjectRequestTags []string
 // UpdateTemplateRequest is the updateTemplateRequest definition
 type UpdateTemplateRequest struct {
 	Author                  string                                        `json:"author,omitempty"`                  //
-	Composite               bool                                          `json:"composite,omitempty"`               //
+	Composite               *bool                                         `json:"composite,omitempty"`               //
 	ContainingTemplates     []UpdateTemplateRequestContainingTemplates    `json:"containingTemplates,omitempty"`     //
-	CreateTime              int                                           `json:"createTime,omitempty"`              //
+	CreateTime              *int                                          `json:"createTime,omitempty"`              //
 	Description             string                                        `json:"description,omitempty"`             //
 	DeviceTypes             []UpdateTemplateRequestDeviceTypes            `json:"deviceTypes,omitempty"`             //
 	FailurePolicy           string                                        `json:"failurePolicy,omitempty"`           //
 	ID                      string                                        `json:"id,omitempty"`                      //
-	LastUpdateTime          int                                           `json:"lastUpdateTime,omitempty"`          //
+	LastUpdateTime          *int                                          `json:"lastUpdateTime,omitempty"`          //
 	Name                    string                                        `json:"name,omitempty"`                    //
 	ParentTemplateID        string                                        `json:"parentTemplateId,omitempty"`        //
 	ProjectID               string                                        `json:"projectId,omitempty"`               //
@@ -232,7 +233,7 @@ type UpdateTemplateRequest struct {
 
 // UpdateTemplateRequestContainingTemplates is the updateTemplateRequestContainingTemplates definition
 type UpdateTemplateRequestContainingTemplates struct {
-	Composite bool   `json:"composite,omitempty"` //
+	Composite *bool  `json:"composite,omitempty"` //
 	ID        string `json:"id,omitempty"`        //
 	Name      string `json:"name,omitempty"`      //
 	Version   string `json:"version,omitempty"`   //
@@ -247,30 +248,30 @@ type UpdateTemplateRequestDeviceTypes struct {
 
 // UpdateTemplateRequestRollbackTemplateParams is the updateTemplateRequestRollbackTemplateParams definition
 type UpdateTemplateRequestRollbackTemplateParams struct {
-	Binding         string                                               `json:"binding,omitempty"`         //
-	DataType        string                                               `json:"dataType,omitempty"`        //
-	DefaultValue    string                                               `json:"defaultValue,omitempty"`    //
-	Description     string                                               `json:"description,omitempty"`     //
-	DisplayName     string                                               `json:"displayName,omitempty"`     //
-	Group           string                                               `json:"group,omitempty"`           //
-	ID              string                                               `json:"id,omitempty"`              //
-	InstructionText string                                               `json:"instructionText,omitempty"` //
-	Key             string                                               `json:"key,omitempty"`             //
-	NotParam        bool                                                 `json:"notParam,omitempty"`        //
-	Order           int                                                  `json:"order,omitempty"`           //
-	ParamArray      bool                                                 `json:"paramArray,omitempty"`      //
-	ParameterName   string                                               `json:"parameterName,omitempty"`   //
-	Provider        string                                               `json:"provider,omitempty"`        //
-	Range           []UpdateTemplateRequestRollbackTemplateParamsRange   `json:"range,omitempty"`           //
-	Required        bool                                                 `json:"required,omitempty"`        //
-	Selection       UpdateTemplateRequestRollbackTemplateParamsSelection `json:"selection,omitempty"`       //
+	Binding         string                                                `json:"binding,omitempty"`         //
+	DataType        string                                                `json:"dataType,omitempty"`        //
+	DefaultValue    string                                                `json:"defaultValue,omitempty"`    //
+	Description     string                                                `json:"description,omitempty"`     //
+	DisplayName     string                                                `json:"displayName,omitempty"`     //
+	Group           string                                                `json:"group,omitempty"`           //
+	ID              string                                                `json:"id,omitempty"`              //
+	InstructionText string                                                `json:"instructionText,omitempty"` //
+	Key             string                                                `json:"key,omitempty"`             //
+	NotParam        *bool                                                 `json:"notParam,omitempty"`        //
+	Order           *int                                                  `json:"order,omitempty"`           //
+	ParamArray      *bool                                                 `json:"paramArray,omitempty"`      //
+	ParameterName   string                                                `json:"parameterName,omitempty"`   //
+	Provider        string                                                `json:"provider,omitempty"`        //
+	Range           []UpdateTemplateRequestRollbackTemplateParamsRange    `json:"range,omitempty"`           //
+	Required        *bool                                                 `json:"required,omitempty"`        //
+	Selection       *UpdateTemplateRequestRollbackTemplateParamsSelection `json:"selection,omitempty"`       //
 }
 
 // UpdateTemplateRequestRollbackTemplateParamsRange is the updateTemplateRequestRollbackTemplateParamsRange definition
 type UpdateTemplateRequestRollbackTemplateParamsRange struct {
 	ID       string `json:"id,omitempty"`       //
-	MaxValue int    `json:"maxValue,omitempty"` //
-	MinValue int    `json:"minValue,omitempty"` //
+	MaxValue *int   `json:"maxValue,omitempty"` //
+	MinValue *int   `json:"minValue,omitempty"` //
 }
 
 // UpdateTemplateRequestRollbackTemplateParamsSelection is the updateTemplateRequestRollbackTemplateParamsSelection definition
@@ -285,30 +286,30 @@ type UpdateTemplateRequestTags []string
 
 // UpdateTemplateRequestTemplateParams is the updateTemplateRequestTemplateParams definition
 type UpdateTemplateRequestTemplateParams struct {
-	Binding         string                                       `json:"binding,omitempty"`         //
-	DataType        string                                       `json:"dataType,omitempty"`        //
-	DefaultValue    string                                       `json:"defaultValue,omitempty"`    //
-	Description     string                                       `json:"description,omitempty"`     //
-	DisplayName     string                                       `json:"displayName,omitempty"`     //
-	Group           string                                       `json:"group,omitempty"`           //
-	ID              string                                       `json:"id,omitempty"`              //
-	InstructionText string                                       `json:"instructionText,omitempty"` //
-	Key             string                                       `json:"key,omitempty"`             //
-	NotParam        bool                                         `json:"notParam,omitempty"`        //
-	Order           int                                          `json:"order,omitempty"`           //
-	ParamArray      bool                                         `json:"paramArray,omitempty"`      //
-	ParameterName   string                                       `json:"parameterName,omitempty"`   //
-	Provider        string                                       `json:"provider,omitempty"`        //
-	Range           []UpdateTemplateRequestTemplateParamsRange   `json:"range,omitempty"`           //
-	Required        bool                                         `json:"required,omitempty"`        //
-	Selection       UpdateTemplateRequestTemplateParamsSelection `json:"selection,omitempty"`       //
+	Binding         string                                        `json:"binding,omitempty"`         //
+	DataType        string                                        `json:"dataType,omitempty"`        //
+	DefaultValue    string                                        `json:"defaultValue,omitempty"`    //
+	Description     string                                        `json:"description,omitempty"`     //
+	DisplayName     string                                        `json:"displayName,omitempty"`     //
+	Group           string                                        `json:"group,omitempty"`           //
+	ID              string                                        `json:"id,omitempty"`              //
+	InstructionText string                                        `json:"instructionText,omitempty"` //
+	Key             string                                        `json:"key,omitempty"`             //
+	NotParam        *bool                                         `json:"notParam,omitempty"`        //
+	Order           *int                                          `json:"order,omitempty"`           //
+	ParamArray      *bool                                         `json:"paramArray,omitempty"`      //
+	ParameterName   string                                        `json:"parameterName,omitempty"`   //
+	Provider        string                                        `json:"provider,omitempty"`        //
+	Range           []UpdateTemplateRequestTemplateParamsRange    `json:"range,omitempty"`           //
+	Required        *bool                                         `json:"required,omitempty"`        //
+	Selection       *UpdateTemplateRequestTemplateParamsSelection `json:"selection,omitempty"`       //
 }
 
 // UpdateTemplateRequestTemplateParamsRange is the updateTemplateRequestTemplateParamsRange definition
 type UpdateTemplateRequestTemplateParamsRange struct {
 	ID       string `json:"id,omitempty"`       //
-	MaxValue int    `json:"maxValue,omitempty"` //
-	MinValue int    `json:"minValue,omitempty"` //
+	MaxValue *int   `json:"maxValue,omitempty"` //
+	MinValue *int   `json:"minValue,omitempty"` //
 }
 
 // UpdateTemplateRequestTemplateParamsSelection is the updateTemplateRequestTemplateParamsSelection definition
@@ -653,10 +654,16 @@ type VersionTemplateResponseResponse struct {
 /* Creates a new project
  */
 func (s *ConfigurationTemplatesService) CreateProject(createProjectRequest *CreateProjectRequest) (*CreateProjectResponse, *resty.Response, error) {
+	return s.CreateProjectWithContext(context.Background(), createProjectRequest)
+}
+
+// CreateProjectWithContext is like CreateProject but sends the request with ctx for cancellation and deadlines.
+func (s *ConfigurationTemplatesService) CreateProjectWithContext(ctx context.Context, createProjectRequest *CreateProjectRequest) (*CreateProjectResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/template-programmer/project"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(createProjectRequest).
 		SetResult(&CreateProjectResponse{}).
 		SetError(&Error{}).
@@ -667,7 +674,7 @@ func (s *ConfigurationTemplatesService) CreateProject(createProjectRequest *Crea
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation createProject")
+		return nil, response, newAPIError("createProject", response)
 	}
 
 	result := response.Result().(*CreateProjectResponse)
@@ -679,11 +686,17 @@ func (s *ConfigurationTemplatesService) CreateProject(createProjectRequest *Crea
 @param projectID projectId
 */
 func (s *ConfigurationTemplatesService) CreateTemplate(projectID string, createTemplateRequest *CreateTemplateRequest) (*CreateTemplateResponse, *resty.Response, error) {
+	return s.CreateTemplateWithContext(context.Background(), projectID, createTemplateRequest)
+}
+
+// CreateTemplateWithContext is like CreateTemplate but sends the request with ctx for cancellation and deadlines.
+func (s *ConfigurationTemplatesService) CreateTemplateWithContext(ctx context.Context, projectID string, createTemplateRequest *CreateTemplateRequest) (*CreateTemplateResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/template-programmer/project/{projectId}/template"
 	path = strings.Replace(path, "{"+"projectId"+"}", fmt.Sprintf("%v", projectID), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(createTemplateRequest).
 		SetResult(&CreateTemplateResponse{}).
 		SetError(&Error{}).
@@ -694,7 +707,7 @@ func (s *ConfigurationTemplatesService) CreateTemplate(projectID string, createT
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation createTemplate")
+		return nil, response, newAPIError("createTemplate", response)
 	}
 
 	result := response.Result().(*CreateTemplateResponse)
@@ -706,11 +719,17 @@ func (s *ConfigurationTemplatesService) CreateTemplate(projectID string, createT
 @param projectID projectId
 */
 func (s *ConfigurationTemplatesService) DeleteProject(projectID string) (*DeleteProjectResponse, *resty.Response, error) {
+	return s.DeleteProjectWithContext(context.Background(), projectID)
+}
+
+// DeleteProjectWithContext is like DeleteProject but sends the request with ctx for cancellation and deadlines.
+func (s *ConfigurationTemplatesService) DeleteProjectWithContext(ctx context.Context, projectID string) (*DeleteProjectResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/template-programmer/project/{projectId}"
 	path = strings.Replace(path, "{"+"projectId"+"}", fmt.Sprintf("%v", projectID), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&DeleteProjectResponse{}).
 		SetError(&Error{}).
 		Delete(path)
@@ -720,7 +739,7 @@ func (s *ConfigurationTemplatesService) DeleteProject(projectID string) (*Delete
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation deleteProject")
+		return nil, response, newAPIError("deleteProject", response)
 	}
 
 	result := response.Result().(*DeleteProjectResponse)
@@ -732,11 +751,17 @@ func (s *ConfigurationTemplatesService) DeleteProject(projectID string) (*Delete
 @param templateID templateId
 */
 func (s *ConfigurationTemplatesService) DeleteTemplate(templateID string) (*DeleteTemplateResponse, *resty.Response, error) {
+	return s.DeleteTemplateWithContext(context.Background(), templateID)
+}
+
+// DeleteTemplateWithContext is like DeleteTemplate but sends the request with ctx for cancellation and deadlines.
+func (s *ConfigurationTemplatesService) DeleteTemplateWithContext(ctx context.Context, templateID string) (*DeleteTemplateResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/template-programmer/template/{templateId}"
 	path = strings.Replace(path, "{"+"templateId"+"}", fmt.Sprintf("%v", templateID), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&DeleteTemplateResponse{}).
 		SetError(&Error{}).
 		Delete(path)
@@ -746,7 +771,7 @@ func (s *ConfigurationTemplatesService) DeleteTemplate(templateID string) (*Dele
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation deleteTemplate")
+		return nil, response, newAPIError("deleteTemplate", response)
 	}
 
 	result := response.Result().(*DeleteTemplateResponse)
@@ -757,10 +782,16 @@ func (s *ConfigurationTemplatesService) DeleteTemplate(templateID string) (*Dele
 /* Deploys a template
  */
 func (s *ConfigurationTemplatesService) DeployTemplate(deployTemplateRequest *DeployTemplateRequest) (*DeployTemplateResponse, *resty.Response, error) {
+	return s.DeployTemplateWithContext(context.Background(), deployTemplateRequest)
+}
+
+// DeployTemplateWithContext is like DeployTemplate but sends the request with ctx for cancellation and deadlines.
+func (s *ConfigurationTemplatesService) DeployTemplateWithContext(ctx context.Context, deployTemplateRequest *DeployTemplateRequest) (*DeployTemplateResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/template-programmer/template/deploy"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(deployTemplateRequest).
 		SetResult(&DeployTemplateResponse{}).
 		SetError(&Error{}).
@@ -771,7 +802,7 @@ func (s *ConfigurationTemplatesService) DeployTemplate(deployTemplateRequest *De
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation deployTemplate")
+		return nil, response, newAPIError("deployTemplate", response)
 	}
 
 	result := response.Result().(*DeployTemplateResponse)
@@ -788,12 +819,18 @@ type GetProjectsQueryParams struct {
 @param name Name of project to be searched
 */
 func (s *ConfigurationTemplatesService) GetProjects(getProjectsQueryParams *GetProjectsQueryParams) (*[]GetProjectsResponse, *resty.Response, error) {
+	return s.GetProjectsWithContext(context.Background(), getProjectsQueryParams)
+}
+
+// GetProjectsWithContext is like GetProjects but sends the request with ctx for cancellation and deadlines.
+func (s *ConfigurationTemplatesService) GetProjectsWithContext(ctx context.Context, getProjectsQueryParams *GetProjectsQueryParams) (*[]GetProjectsResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/template-programmer/project"
 
 	queryString, _ := query.Values(getProjectsQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&[]GetProjectsResponse{}).
 		SetError(&Error{}).
@@ -804,7 +841,7 @@ func (s *ConfigurationTemplatesService) GetProjects(getProjectsQueryParams *GetP
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getProjects")
+		return nil, response, newAPIError("getProjects", response)
 	}
 
 	result := response.Result().(*[]GetProjectsResponse)
@@ -816,11 +853,17 @@ func (s *ConfigurationTemplatesService) GetProjects(getProjectsQueryParams *GetP
 @param deploymentID deploymentId
 */
 func (s *ConfigurationTemplatesService) GetTemplateDeploymentStatus(deploymentID string) (*GetTemplateDeploymentStatusResponse, *resty.Response, error) {
+	return s.GetTemplateDeploymentStatusWithContext(context.Background(), deploymentID)
+}
+
+// GetTemplateDeploymentStatusWithContext is like GetTemplateDeploymentStatus but sends the request with ctx for cancellation and deadlines.
+func (s *ConfigurationTemplatesService) GetTemplateDeploymentStatusWithContext(ctx context.Context, deploymentID string) (*GetTemplateDeploymentStatusResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/template-programmer/template/deploy/status/{deploymentId}"
 	path = strings.Replace(path, "{"+"deploymentId"+"}", fmt.Sprintf("%v", deploymentID), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&GetTemplateDeploymentStatusResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -830,7 +873,7 @@ func (s *ConfigurationTemplatesService) GetTemplateDeploymentStatus(deploymentID
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getTemplateDeploymentStatus")
+		return nil, response, newAPIError("getTemplateDeploymentStatus", response)
 	}
 
 	result := response.Result().(*GetTemplateDeploymentStatusResponse)
@@ -848,6 +891,11 @@ type GetTemplateDetailsQueryParams struct {
 @param latestVersion latestVersion
 */
 func (s *ConfigurationTemplatesService) GetTemplateDetails(templateID string, getTemplateDetailsQueryParams *GetTemplateDetailsQueryParams) (*GetTemplateDetailsResponse, *resty.Response, error) {
+	return s.GetTemplateDetailsWithContext(context.Background(), templateID, getTemplateDetailsQueryParams)
+}
+
+// GetTemplateDetailsWithContext is like GetTemplateDetails but sends the request with ctx for cancellation and deadlines.
+func (s *ConfigurationTemplatesService) GetTemplateDetailsWithContext(ctx context.Context, templateID string, getTemplateDetailsQueryParams *GetTemplateDetailsQueryParams) (*GetTemplateDetailsResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/template-programmer/template/{templateId}"
 	path = strings.Replace(path, "{"+"templateId"+"}", fmt.Sprintf("%v", templateID), -1)
@@ -855,6 +903,7 @@ func (s *ConfigurationTemplatesService) GetTemplateDetails(templateID string, ge
 	queryString, _ := query.Values(getTemplateDetailsQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetTemplateDetailsResponse{}).
 		SetError(&Error{}).
@@ -865,7 +914,7 @@ func (s *ConfigurationTemplatesService) GetTemplateDetails(templateID string, ge
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getTemplateDetails")
+		return nil, response, newAPIError("getTemplateDetails", response)
 	}
 
 	result := response.Result().(*GetTemplateDetailsResponse)
@@ -877,11 +926,17 @@ func (s *ConfigurationTemplatesService) GetTemplateDetails(templateID string, ge
 @param templateID templateId
 */
 func (s *ConfigurationTemplatesService) GetTemplateVersions(templateID string) (*[]GetTemplateVersionsResponse, *resty.Response, error) {
+	return s.GetTemplateVersionsWithContext(context.Background(), templateID)
+}
+
+// GetTemplateVersionsWithContext is like GetTemplateVersions but sends the request with ctx for cancellation and deadlines.
+func (s *ConfigurationTemplatesService) GetTemplateVersionsWithContext(ctx context.Context, templateID string) (*[]GetTemplateVersionsResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/template-programmer/template/version/{templateId}"
 	path = strings.Replace(path, "{"+"templateId"+"}", fmt.Sprintf("%v", templateID), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&[]GetTemplateVersionsResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -891,7 +946,7 @@ func (s *ConfigurationTemplatesService) GetTemplateVersions(templateID string) (
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getTemplateVersions")
+		return nil, response, newAPIError("getTemplateVersions", response)
 	}
 
 	result := response.Result().(*[]GetTemplateVersionsResponse)
@@ -920,12 +975,18 @@ type GetsTheTemplatesAvailableQueryParams struct {
 @param filterConflictingTemplates filterConflictingTemplates
 */
 func (s *ConfigurationTemplatesService) GetsTheTemplatesAvailable(getsTheTemplatesAvailableQueryParams *GetsTheTemplatesAvailableQueryParams) (*[]GetsTheTemplatesAvailableResponse, *resty.Response, error) {
+	return s.GetsTheTemplatesAvailableWithContext(context.Background(), getsTheTemplatesAvailableQueryParams)
+}
+
+// GetsTheTemplatesAvailableWithContext is like GetsTheTemplatesAvailable but sends the request with ctx for cancellation and deadlines.
+func (s *ConfigurationTemplatesService) GetsTheTemplatesAvailableWithContext(ctx context.Context, getsTheTemplatesAvailableQueryParams *GetsTheTemplatesAvailableQueryParams) (*[]GetsTheTemplatesAvailableResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/template-programmer/template"
 
 	queryString, _ := query.Values(getsTheTemplatesAvailableQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&[]GetsTheTemplatesAvailableResponse{}).
 		SetError(&Error{}).
@@ -936,7 +997,7 @@ func (s *ConfigurationTemplatesService) GetsTheTemplatesAvailable(getsTheTemplat
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getsTheTemplatesAvailable")
+		return nil, response, newAPIError("getsTheTemplatesAvailable", response)
 	}
 
 	result := response.Result().(*[]GetsTheTemplatesAvailableResponse)
@@ -947,10 +1008,16 @@ func (s *ConfigurationTemplatesService) GetsTheTemplatesAvailable(getsTheTemplat
 /* Previews an existing template
  */
 func (s *ConfigurationTemplatesService) PreviewTemplate(previewTemplateRequest *PreviewTemplateRequest) (*PreviewTemplateResponse, *resty.Response, error) {
+	return s.PreviewTemplateWithContext(context.Background(), previewTemplateRequest)
+}
+
+// PreviewTemplateWithContext is like PreviewTemplate but sends the request with ctx for cancellation and deadlines.
+func (s *ConfigurationTemplatesService) PreviewTemplateWithContext(ctx context.Context, previewTemplateRequest *PreviewTemplateRequest) (*PreviewTemplateResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/template-programmer/template/preview"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(previewTemplateRequest).
 		SetResult(&PreviewTemplateResponse{}).
 		SetError(&Error{}).
@@ -961,7 +1028,7 @@ func (s *ConfigurationTemplatesService) PreviewTemplate(previewTemplateRequest *
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation previewTemplate")
+		return nil, response, newAPIError("previewTemplate", response)
 	}
 
 	result := response.Result().(*PreviewTemplateResponse)
@@ -972,10 +1039,16 @@ func (s *ConfigurationTemplatesService) PreviewTemplate(previewTemplateRequest *
 /* Updates an existing project
  */
 func (s *ConfigurationTemplatesService) UpdateProject(updateProjectRequest *UpdateProjectRequest) (*UpdateProjectResponse, *resty.Response, error) {
+	return s.UpdateProjectWithContext(context.Background(), updateProjectRequest)
+}
+
+// UpdateProjectWithContext is like UpdateProject but sends the request with ctx for cancellation and deadlines.
+func (s *ConfigurationTemplatesService) UpdateProjectWithContext(ctx context.Context, updateProjectRequest *UpdateProjectRequest) (*UpdateProjectResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/template-programmer/project"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(updateProjectRequest).
 		SetResult(&UpdateProjectResponse{}).
 		SetError(&Error{}).
@@ -986,7 +1059,7 @@ func (s *ConfigurationTemplatesService) UpdateProject(updateProjectRequest *Upda
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation updateProject")
+		return nil, response, newAPIError("updateProject", response)
 	}
 
 	result := response.Result().(*UpdateProjectResponse)
@@ -997,10 +1070,16 @@ func (s *ConfigurationTemplatesService) UpdateProject(updateProjectRequest *Upda
 /* Updates an existing template
  */
 func (s *ConfigurationTemplatesService) UpdateTemplate(updateTemplateRequest *UpdateTemplateRequest) (*UpdateTemplateResponse, *resty.Response, error) {
+	return s.UpdateTemplateWithContext(context.Background(), updateTemplateRequest)
+}
+
+// UpdateTemplateWithContext is like UpdateTemplate but sends the request with ctx for cancellation and deadlines.
+func (s *ConfigurationTemplatesService) UpdateTemplateWithContext(ctx context.Context, updateTemplateRequest *UpdateTemplateRequest) (*UpdateTemplateResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/template-programmer/template"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(updateTemplateRequest).
 		SetResult(&UpdateTemplateResponse{}).
 		SetError(&Error{}).
@@ -1011,7 +1090,7 @@ func (s *ConfigurationTemplatesService) UpdateTemplate(updateTemplateRequest *Up
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation updateTemplate")
+		return nil, response, newAPIError("updateTemplate", response)
 	}
 
 	result := response.Result().(*UpdateTemplateResponse)
@@ -1022,10 +1101,16 @@ func (s *ConfigurationTemplatesService) UpdateTemplate(updateTemplateRequest *Up
 /* Creates Versioning for the current contents of the template
  */
 func (s *ConfigurationTemplatesService) VersionTemplate(versionTemplateRequest *VersionTemplateRequest) (*VersionTemplateResponse, *resty.Response, error) {
+	return s.VersionTemplateWithContext(context.Background(), versionTemplateRequest)
+}
+
+// VersionTemplateWithContext is like VersionTemplate but sends the request with ctx for cancellation and deadlines.
+func (s *ConfigurationTemplatesService) VersionTemplateWithContext(ctx context.Context, versionTemplateRequest *VersionTemplateRequest) (*VersionTemplateResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/template-programmer/template/version"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(versionTemplateRequest).
 		SetResult(&VersionTemplateResponse{}).
 		SetError(&Error{}).
@@ -1036,7 +1121,7 @@ func (s *ConfigurationTemplatesService) VersionTemplate(versionTemplateRequest *
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation versionTemplate")
+		return nil, response, newAPIError("versionTemplate", response)
 	}
 
 	result := response.Result().(*VersionTemplateResponse)
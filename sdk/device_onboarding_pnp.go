@@ -1,6 +1,7 @@
 package dnac
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -14,62 +15,62 @@ type DeviceOnboardingPnPService service
 // AddAWorkflowRequest is the addAWorkflowRequest definition
 type AddAWorkflowRequest struct {
 	TypeID         string                     `json:"id,omitempty"`             //
-	AddToInventory bool                       `json:"addToInventory,omitempty"` //
-	AddedOn        float64                    `json:"addedOn,omitempty"`        //
+	AddToInventory *bool                      `json:"addToInventory,omitempty"` //
+	AddedOn        *float64                   `json:"addedOn,omitempty"`        //
 	ConfigID       string                     `json:"configId,omitempty"`       //
-	CurrTaskIDx    float64                    `json:"currTaskIdx,omitempty"`    //
+	CurrTaskIDx    *float64                   `json:"currTaskIdx,omitempty"`    //
 	Description    string                     `json:"description,omitempty"`    //
-	EndTime        int                        `json:"endTime,omitempty"`        //
-	ExecTime       float64                    `json:"execTime,omitempty"`       //
+	EndTime        *int                       `json:"endTime,omitempty"`        //
+	ExecTime       *float64                   `json:"execTime,omitempty"`       //
 	ImageID        string                     `json:"imageId,omitempty"`        //
 	InstanceType   string                     `json:"instanceType,omitempty"`   //
-	LastupdateOn   float64                    `json:"lastupdateOn,omitempty"`   //
+	LastupdateOn   *float64                   `json:"lastupdateOn,omitempty"`   //
 	Name           string                     `json:"name,omitempty"`           //
-	StartTime      int                        `json:"startTime,omitempty"`      //
+	StartTime      *int                       `json:"startTime,omitempty"`      //
 	State          string                     `json:"state,omitempty"`          //
 	Tasks          []AddAWorkflowRequestTasks `json:"tasks,omitempty"`          //
 	TenantID       string                     `json:"tenantId,omitempty"`       //
 	Type           string                     `json:"type,omitempty"`           //
 	UseState       string                     `json:"useState,omitempty"`       //
-	Version        float64                    `json:"version,omitempty"`        //
+	Version        *float64                   `json:"version,omitempty"`        //
 }
 
 // AddAWorkflowRequestTasks is the addAWorkflowRequestTasks definition
 type AddAWorkflowRequestTasks struct {
-	CurrWorkItemIDx int                                    `json:"currWorkItemIdx,omitempty"` //
-	EndTime         int                                    `json:"endTime,omitempty"`         //
+	CurrWorkItemIDx *int                                   `json:"currWorkItemIdx,omitempty"` //
+	EndTime         *int                                   `json:"endTime,omitempty"`         //
 	Name            string                                 `json:"name,omitempty"`            //
-	StartTime       int                                    `json:"startTime,omitempty"`       //
+	StartTime       *int                                   `json:"startTime,omitempty"`       //
 	State           string                                 `json:"state,omitempty"`           //
-	TaskSeqNo       int                                    `json:"taskSeqNo"`                 //
-	TimeTaken       float64                                `json:"timeTaken,omitempty"`       //
+	TaskSeqNo       *int                                   `json:"taskSeqNo"`                 //
+	TimeTaken       *float64                               `json:"timeTaken,omitempty"`       //
 	Type            string                                 `json:"type,omitempty"`            //
 	WorkItemList    []AddAWorkflowRequestTasksWorkItemList `json:"workItemList,omitempty"`    //
 }
 
 // AddAWorkflowRequestTasksWorkItemList is the addAWorkflowRequestTasksWorkItemList definition
 type AddAWorkflowRequestTasksWorkItemList struct {
-	Command   string  `json:"command,omitempty"`   //
-	EndTime   int     `json:"endTime,omitempty"`   //
-	OutputStr string  `json:"outputStr,omitempty"` //
-	StartTime int     `json:"startTime,omitempty"` //
-	State     string  `json:"state,omitempty"`     //
-	TimeTaken float64 `json:"timeTaken,omitempty"` //
+	Command   string   `json:"command,omitempty"`   //
+	EndTime   *int     `json:"endTime,omitempty"`   //
+	OutputStr string   `json:"outputStr,omitempty"` //
+	StartTime *int     `json:"startTime,omitempty"` //
+	State     string   `json:"state,omitempty"`     //
+	TimeTaken *float64 `json:"timeTaken,omitempty"` //
 }
 
 // AddDeviceToPnpDatabaseRequest is the addDeviceToPnpDatabaseRequest definition
 type AddDeviceToPnpDatabaseRequest struct {
-	TypeID               string                                           `json:"id,omitempty"`                   //
-	DayZeroConfig        AddDeviceToPnpDatabaseRequestDayZeroConfig       `json:"dayZeroConfig,omitempty"`        //
-	DayZeroConfigPreview string                                           `json:"dayZeroConfigPreview,omitempty"` //
-	DeviceInfo           AddDeviceToPnpDatabaseRequestDeviceInfo          `json:"deviceInfo,omitempty"`           //
-	RunSummaryList       []AddDeviceToPnpDatabaseRequestRunSummaryList    `json:"runSummaryList,omitempty"`       //
-	SystemResetWorkflow  AddDeviceToPnpDatabaseRequestSystemResetWorkflow `json:"systemResetWorkflow,omitempty"`  //
-	SystemWorkflow       AddDeviceToPnpDatabaseRequestSystemWorkflow      `json:"systemWorkflow,omitempty"`       //
-	TenantID             string                                           `json:"tenantId,omitempty"`             //
-	Version              float64                                          `json:"version,omitempty"`              //
-	Workflow             AddDeviceToPnpDatabaseRequestWorkflow            `json:"workflow,omitempty"`             //
-	WorkflowParameters   AddDeviceToPnpDatabaseRequestWorkflowParameters  `json:"workflowParameters,omitempty"`   //
+	TypeID               string                                            `json:"id,omitempty"`                   //
+	DayZeroConfig        *AddDeviceToPnpDatabaseRequestDayZeroConfig       `json:"dayZeroConfig,omitempty"`        //
+	DayZeroConfigPreview string                                            `json:"dayZeroConfigPreview,omitempty"` //
+	DeviceInfo           *AddDeviceToPnpDatabaseRequestDeviceInfo          `json:"deviceInfo,omitempty"`           //
+	RunSummaryList       []AddDeviceToPnpDatabaseRequestRunSummaryList     `json:"runSummaryList,omitempty"`       //
+	SystemResetWorkflow  *AddDeviceToPnpDatabaseRequestSystemResetWorkflow `json:"systemResetWorkflow,omitempty"`  //
+	SystemWorkflow       *AddDeviceToPnpDatabaseRequestSystemWorkflow      `json:"systemWorkflow,omitempty"`       //
+	TenantID             string                                            `json:"tenantId,omitempty"`             //
+	Version              *float64                                          `json:"version,omitempty"`              //
+	Workflow             *AddDeviceToPnpDatabaseRequestWorkflow            `json:"workflow,omitempty"`             //
+	WorkflowParameters   *AddDeviceToPnpDatabaseRequestWorkflowParameters  `json:"workflowParameters,omitempty"`   //
 }
 
 // AddDeviceToPnpDatabaseRequestDayZeroConfig is the addDeviceToPnpDatabaseRequestDayZeroConfig definition
@@ -79,8 +80,8 @@ type AddDeviceToPnpDatabaseRequestDayZeroConfig struct {
 
 // AddDeviceToPnpDatabaseRequestDeviceInfo is the addDeviceToPnpDatabaseRequestDeviceInfo definition
 type AddDeviceToPnpDatabaseRequestDeviceInfo struct {
-	AAACredentials            AddDeviceToPnpDatabaseRequestDeviceInfoAAACredentials         `json:"aaaCredentials,omitempty"`            //
-	AddedOn                   float64                                                       `json:"addedOn,omitempty"`                   //
+	AAACredentials            *AddDeviceToPnpDatabaseRequestDeviceInfoAAACredentials        `json:"aaaCredentials,omitempty"`            //
+	AddedOn                   *float64                                                      `json:"addedOn,omitempty"`                   //
 	AddnMacAddrs              []string                                                      `json:"addnMacAddrs,omitempty"`              //
 	AgentType                 string                                                        `json:"agentType,omitempty"`                 //
 	AuthStatus                string                                                        `json:"authStatus,omitempty"`                //
@@ -93,16 +94,16 @@ type AddDeviceToPnpDatabaseRequestDeviceInfo struct {
 	DeviceType                string                                                        `json:"deviceType,omitempty"`                //
 	FeaturesSupported         []string                                                      `json:"featuresSupported,omitempty"`         //
 	FileSystemList            []AddDeviceToPnpDatabaseRequestDeviceInfoFileSystemList       `json:"fileSystemList,omitempty"`            //
-	FirstContact              float64                                                       `json:"firstContact,omitempty"`              //
+	FirstContact              *float64                                                      `json:"firstContact,omitempty"`              //
 	Hostname                  string                                                        `json:"hostname,omitempty"`                  //
 	HTTPHeaders               []AddDeviceToPnpDatabaseRequestDeviceInfoHTTPHeaders          `json:"httpHeaders,omitempty"`               //
 	ImageFile                 string                                                        `json:"imageFile,omitempty"`                 //
 	ImageVersion              string                                                        `json:"imageVersion,omitempty"`              //
 	IPInterfaces              []AddDeviceToPnpDatabaseRequestDeviceInfoIPInterfaces         `json:"ipInterfaces,omitempty"`              //
-	LastContact               float64                                                       `json:"lastContact,omitempty"`               //
-	LastSyncTime              float64                                                       `json:"lastSyncTime,omitempty"`              //
-	LastUpdateOn              float64                                                       `json:"lastUpdateOn,omitempty"`              //
-	Location                  AddDeviceToPnpDatabaseRequestDeviceInfoLocation               `json:"location,omitempty"`                  //
+	LastContact               *float64                                                      `json:"lastContact,omitempty"`               //
+	LastSyncTime              *float64                                                      `json:"lastSyncTime,omitempty"`              //
+	LastUpdateOn              *float64                                                      `json:"lastUpdateOn,omitempty"`              //
+	Location                  *AddDeviceToPnpDatabaseRequestDeviceInfoLocation              `json:"location,omitempty"`                  //
 	MacAddress                string                                                        `json:"macAddress,omitempty"`                //
 	Mode                      string                                                        `json:"mode,omitempty"`                      //
 	Name                      string                                                        `json:"name,omitempty"`                      //
@@ -110,20 +111,20 @@ type AddDeviceToPnpDatabaseRequestDeviceInfo struct {
 	OnbState                  string                                                        `json:"onbState,omitempty"`                  //
 	Pid                       string                                                        `json:"pid,omitempty"`                       //
 	PnpProfileList            []AddDeviceToPnpDatabaseRequestDeviceInfoPnpProfileList       `json:"pnpProfileList,omitempty"`            //
-	PopulateInventory         bool                                                          `json:"populateInventory,omitempty"`         //
+	PopulateInventory         *bool                                                         `json:"populateInventory,omitempty"`         //
 	PreWorkflowCliOuputs      []AddDeviceToPnpDatabaseRequestDeviceInfoPreWorkflowCliOuputs `json:"preWorkflowCliOuputs,omitempty"`      //
 	ProjectID                 string                                                        `json:"projectId,omitempty"`                 //
 	ProjectName               string                                                        `json:"projectName,omitempty"`               //
-	ReloadRequested           bool                                                          `json:"reloadRequested,omitempty"`           //
+	ReloadRequested           *bool                                                         `json:"reloadRequested,omitempty"`           //
 	SerialNumber              string                                                        `json:"serialNumber,omitempty"`              //
 	SiteID                    string                                                        `json:"siteId,omitempty"`                    //
 	SiteName                  string                                                        `json:"siteName,omitempty"`                  //
 	SmartAccountID            string                                                        `json:"smartAccountId,omitempty"`            //
 	Source                    string                                                        `json:"source,omitempty"`                    //
-	Stack                     bool                                                          `json:"stack,omitempty"`                     //
-	StackInfo                 AddDeviceToPnpDatabaseRequestDeviceInfoStackInfo              `json:"stackInfo,omitempty"`                 //
+	Stack                     *bool                                                         `json:"stack,omitempty"`                     //
+	StackInfo                 *AddDeviceToPnpDatabaseRequestDeviceInfoStackInfo             `json:"stackInfo,omitempty"`                 //
 	State                     string                                                        `json:"state,omitempty"`                     //
-	SudiRequired              bool                                                          `json:"sudiRequired,omitempty"`              //
+	SudiRequired              *bool                                                         `json:"sudiRequired,omitempty"`              //
 	Tags                      string                                                        `json:"tags,omitempty"`                      //
 	UserMicNumbers            []string                                                      `json:"userMicNumbers,omitempty"`            //
 	UserSudiSerialNos         []string                                                      `json:"userSudiSerialNos,omitempty"`         //
@@ -152,12 +153,12 @@ type AddDeviceToPnpDatabaseRequestDeviceInfoFeaturesSupported []string
 
 // AddDeviceToPnpDatabaseRequestDeviceInfoFileSystemList is the addDeviceToPnpDatabaseRequestDeviceInfoFileSystemList definition
 type AddDeviceToPnpDatabaseRequestDeviceInfoFileSystemList struct {
-	Freespace float64 `json:"freespace,omitempty"` //
-	Name      string  `json:"name,omitempty"`      //
-	Readable  bool    `json:"readable,omitempty"`  //
-	Size      float64 `json:"size,omitempty"`      //
-	Type      string  `json:"type,omitempty"`      //
-	Writeable bool    `json:"writeable,omitempty"` //
+	Freespace *float64 `json:"freespace,omitempty"` //
+	Name      string   `json:"name,omitempty"`      //
+	Readable  *bool    `json:"readable,omitempty"`  //
+	Size      *float64 `json:"size,omitempty"`      //
+	Type      string   `json:"type,omitempty"`      //
+	Writeable *bool    `json:"writeable,omitempty"` //
 }
 
 // AddDeviceToPnpDatabaseRequestDeviceInfoHTTPHeaders is the addDeviceToPnpDatabaseRequestDeviceInfoHTTPHeaders definition
@@ -202,31 +203,31 @@ type AddDeviceToPnpDatabaseRequestDeviceInfoNeighborLinks struct {
 
 // AddDeviceToPnpDatabaseRequestDeviceInfoPnpProfileList is the addDeviceToPnpDatabaseRequestDeviceInfoPnpProfileList definition
 type AddDeviceToPnpDatabaseRequestDeviceInfoPnpProfileList struct {
-	CreatedBy         string                                                                 `json:"createdBy,omitempty"`         //
-	DiscoveryCreated  bool                                                                   `json:"discoveryCreated,omitempty"`  //
-	PrimaryEndpoint   AddDeviceToPnpDatabaseRequestDeviceInfoPnpProfileListPrimaryEndpoint   `json:"primaryEndpoint,omitempty"`   //
-	ProfileName       string                                                                 `json:"profileName,omitempty"`       //
-	SecondaryEndpoint AddDeviceToPnpDatabaseRequestDeviceInfoPnpProfileListSecondaryEndpoint `json:"secondaryEndpoint,omitempty"` //
+	CreatedBy         string                                                                  `json:"createdBy,omitempty"`         //
+	DiscoveryCreated  *bool                                                                   `json:"discoveryCreated,omitempty"`  //
+	PrimaryEndpoint   *AddDeviceToPnpDatabaseRequestDeviceInfoPnpProfileListPrimaryEndpoint   `json:"primaryEndpoint,omitempty"`   //
+	ProfileName       string                                                                  `json:"profileName,omitempty"`       //
+	SecondaryEndpoint *AddDeviceToPnpDatabaseRequestDeviceInfoPnpProfileListSecondaryEndpoint `json:"secondaryEndpoint,omitempty"` //
 }
 
 // AddDeviceToPnpDatabaseRequestDeviceInfoPnpProfileListPrimaryEndpoint is the addDeviceToPnpDatabaseRequestDeviceInfoPnpProfileListPrimaryEndpoint definition
 type AddDeviceToPnpDatabaseRequestDeviceInfoPnpProfileListPrimaryEndpoint struct {
-	Certificate string  `json:"certificate,omitempty"` //
-	Fqdn        string  `json:"fqdn,omitempty"`        //
-	IPv4Address string  `json:"ipv4Address,omitempty"` //
-	IPv6Address string  `json:"ipv6Address,omitempty"` //
-	Port        float64 `json:"port,omitempty"`        //
-	Protocol    string  `json:"protocol,omitempty"`    //
+	Certificate string   `json:"certificate,omitempty"` //
+	Fqdn        string   `json:"fqdn,omitempty"`        //
+	IPv4Address string   `json:"ipv4Address,omitempty"` //
+	IPv6Address string   `json:"ipv6Address,omitempty"` //
+	Port        *float64 `json:"port,omitempty"`        //
+	Protocol    string   `json:"protocol,omitempty"`    //
 }
 
 // AddDeviceToPnpDatabaseRequestDeviceInfoPnpProfileListSecondaryEndpoint is the addDeviceToPnpDatabaseRequestDeviceInfoPnpProfileListSecondaryEndpoint definition
 type AddDeviceToPnpDatabaseRequestDeviceInfoPnpProfileListSecondaryEndpoint struct {
-	Certificate string  `json:"certificate,omitempty"` //
-	Fqdn        string  `json:"fqdn,omitempty"`        //
-	IPv4Address string  `json:"ipv4Address,omitempty"` //
-	IPv6Address string  `json:"ipv6Address,omitempty"` //
-	Port        float64 `json:"port,omitempty"`        //
-	Protocol    string  `json:"protocol,omitempty"`    //
+	Certificate string   `json:"certificate,omitempty"` //
+	Fqdn        string   `json:"fqdn,omitempty"`        //
+	IPv4Address string   `json:"ipv4Address,omitempty"` //
+	IPv6Address string   `json:"ipv6Address,omitempty"` //
+	Port        *float64 `json:"port,omitempty"`        //
+	Protocol    string   `json:"protocol,omitempty"`    //
 }
 
 // AddDeviceToPnpDatabaseRequestDeviceInfoPreWorkflowCliOuputs is the addDeviceToPnpDatabaseRequestDeviceInfoPreWorkflowCliOuputs definition
@@ -237,28 +238,28 @@ type AddDeviceToPnpDatabaseRequestDeviceInfoPreWorkflowCliOuputs struct {
 
 // AddDeviceToPnpDatabaseRequestDeviceInfoStackInfo is the addDeviceToPnpDatabaseRequestDeviceInfoStackInfo definition
 type AddDeviceToPnpDatabaseRequestDeviceInfoStackInfo struct {
-	IsFullRing             bool                                                              `json:"isFullRing,omitempty"`             //
+	IsFullRing             *bool                                                             `json:"isFullRing,omitempty"`             //
 	StackMemberList        []AddDeviceToPnpDatabaseRequestDeviceInfoStackInfoStackMemberList `json:"stackMemberList,omitempty"`        //
 	StackRingProtocol      string                                                            `json:"stackRingProtocol,omitempty"`      //
-	SupportsStackWorkflows bool                                                              `json:"supportsStackWorkflows,omitempty"` //
-	TotalMemberCount       float64                                                           `json:"totalMemberCount,omitempty"`       //
+	SupportsStackWorkflows *bool                                                             `json:"supportsStackWorkflows,omitempty"` //
+	TotalMemberCount       *float64                                                          `json:"totalMemberCount,omitempty"`       //
 	ValidLicenseLevels     []string                                                          `json:"validLicenseLevels,omitempty"`     //
 }
 
 // AddDeviceToPnpDatabaseRequestDeviceInfoStackInfoStackMemberList is the addDeviceToPnpDatabaseRequestDeviceInfoStackInfoStackMemberList definition
 type AddDeviceToPnpDatabaseRequestDeviceInfoStackInfoStackMemberList struct {
-	HardwareVersion  string  `json:"hardwareVersion,omitempty"`  //
-	LicenseLevel     string  `json:"licenseLevel,omitempty"`     //
-	LicenseType      string  `json:"licenseType,omitempty"`      //
-	MacAddress       string  `json:"macAddress,omitempty"`       //
-	Pid              string  `json:"pid,omitempty"`              //
-	Priority         float64 `json:"priority,omitempty"`         //
-	Role             string  `json:"role,omitempty"`             //
-	SerialNumber     string  `json:"serialNumber,omitempty"`     //
-	SoftwareVersion  string  `json:"softwareVersion,omitempty"`  //
-	StackNumber      float64 `json:"stackNumber,omitempty"`      //
-	State            string  `json:"state,omitempty"`            //
-	SudiSerialNumber string  `json:"sudiSerialNumber,omitempty"` //
+	HardwareVersion  string   `json:"hardwareVersion,omitempty"`  //
+	LicenseLevel     string   `json:"licenseLevel,omitempty"`     //
+	LicenseType      string   `json:"licenseType,omitempty"`      //
+	MacAddress       string   `json:"macAddress,omitempty"`       //
+	Pid              string   `json:"pid,omitempty"`              //
+	Priority         *float64 `json:"priority,omitempty"`         //
+	Role             string   `json:"role,omitempty"`             //
+	SerialNumber     string   `json:"serialNumber,omitempty"`     //
+	SoftwareVersion  string   `json:"softwareVersion,omitempty"`  //
+	StackNumber      *float64 `json:"stackNumber,omitempty"`      //
+	State            string   `json:"state,omitempty"`            //
+	SudiSerialNumber string   `json:"sudiSerialNumber,omitempty"` //
 }
 
 // AddDeviceToPnpDatabaseRequestDeviceInfoStackInfoValidLicenseLevels is the addDeviceToPnpDatabaseRequestDeviceInfoStackInfoValidLicenseLevels definition
@@ -272,17 +273,17 @@ type AddDeviceToPnpDatabaseRequestDeviceInfoUserSudiSerialNos []string
 
 // AddDeviceToPnpDatabaseRequestRunSummaryList is the addDeviceToPnpDatabaseRequestRunSummaryList definition
 type AddDeviceToPnpDatabaseRequestRunSummaryList struct {
-	Details         string                                                     `json:"details,omitempty"`         //
-	ErrorFlag       bool                                                       `json:"errorFlag,omitempty"`       //
-	HistoryTaskInfo AddDeviceToPnpDatabaseRequestRunSummaryListHistoryTaskInfo `json:"historyTaskInfo,omitempty"` //
-	Timestamp       float64                                                    `json:"timestamp,omitempty"`       //
+	Details         string                                                      `json:"details,omitempty"`         //
+	ErrorFlag       *bool                                                       `json:"errorFlag,omitempty"`       //
+	HistoryTaskInfo *AddDeviceToPnpDatabaseRequestRunSummaryListHistoryTaskInfo `json:"historyTaskInfo,omitempty"` //
+	Timestamp       *float64                                                    `json:"timestamp,omitempty"`       //
 }
 
 // AddDeviceToPnpDatabaseRequestRunSummaryListHistoryTaskInfo is the addDeviceToPnpDatabaseRequestRunSummaryListHistoryTaskInfo definition
 type AddDeviceToPnpDatabaseRequestRunSummaryListHistoryTaskInfo struct {
 	AddnDetails  []AddDeviceToPnpDatabaseRequestRunSummaryListHistoryTaskInfoAddnDetails  `json:"addnDetails,omitempty"`  //
 	Name         string                                                                   `json:"name,omitempty"`         //
-	TimeTaken    float64                                                                  `json:"timeTaken,omitempty"`    //
+	TimeTaken    *float64                                                                 `json:"timeTaken,omitempty"`    //
 	Type         string                                                                   `json:"type,omitempty"`         //
 	WorkItemList []AddDeviceToPnpDatabaseRequestRunSummaryListHistoryTaskInfoWorkItemList `json:"workItemList,omitempty"` //
 }
@@ -295,127 +296,127 @@ type AddDeviceToPnpDatabaseRequestRunSummaryListHistoryTaskInfoAddnDetails struc
 
 // AddDeviceToPnpDatabaseRequestRunSummaryListHistoryTaskInfoWorkItemList is the addDeviceToPnpDatabaseRequestRunSummaryListHistoryTaskInfoWorkItemList definition
 type AddDeviceToPnpDatabaseRequestRunSummaryListHistoryTaskInfoWorkItemList struct {
-	Command   string  `json:"command,omitempty"`   //
-	EndTime   int     `json:"endTime,omitempty"`   //
-	OutputStr string  `json:"outputStr,omitempty"` //
-	StartTime int     `json:"startTime,omitempty"` //
-	State     string  `json:"state,omitempty"`     //
-	TimeTaken float64 `json:"timeTaken,omitempty"` //
+	Command   string   `json:"command,omitempty"`   //
+	EndTime   *int     `json:"endTime,omitempty"`   //
+	OutputStr string   `json:"outputStr,omitempty"` //
+	StartTime *int     `json:"startTime,omitempty"` //
+	State     string   `json:"state,omitempty"`     //
+	TimeTaken *float64 `json:"timeTaken,omitempty"` //
 }
 
 // AddDeviceToPnpDatabaseRequestSystemResetWorkflow is the addDeviceToPnpDatabaseRequestSystemResetWorkflow definition
 type AddDeviceToPnpDatabaseRequestSystemResetWorkflow struct {
 	TypeID         string                                                  `json:"id,omitempty"`             //
-	AddToInventory bool                                                    `json:"addToInventory,omitempty"` //
-	AddedOn        float64                                                 `json:"addedOn,omitempty"`        //
+	AddToInventory *bool                                                   `json:"addToInventory,omitempty"` //
+	AddedOn        *float64                                                `json:"addedOn,omitempty"`        //
 	ConfigID       string                                                  `json:"configId,omitempty"`       //
-	CurrTaskIDx    float64                                                 `json:"currTaskIdx,omitempty"`    //
+	CurrTaskIDx    *float64                                                `json:"currTaskIdx,omitempty"`    //
 	Description    string                                                  `json:"description,omitempty"`    //
-	EndTime        int                                                     `json:"endTime,omitempty"`        //
-	ExecTime       float64                                                 `json:"execTime,omitempty"`       //
+	EndTime        *int                                                    `json:"endTime,omitempty"`        //
+	ExecTime       *float64                                                `json:"execTime,omitempty"`       //
 	ImageID        string                                                  `json:"imageId,omitempty"`        //
 	InstanceType   string                                                  `json:"instanceType,omitempty"`   //
-	LastupdateOn   float64                                                 `json:"lastupdateOn,omitempty"`   //
+	LastupdateOn   *float64                                                `json:"lastupdateOn,omitempty"`   //
 	Name           string                                                  `json:"name,omitempty"`           //
-	StartTime      int                                                     `json:"startTime,omitempty"`      //
+	StartTime      *int                                                    `json:"startTime,omitempty"`      //
 	State          string                                                  `json:"state,omitempty"`          //
 	Tasks          []AddDeviceToPnpDatabaseRequestSystemResetWorkflowTasks `json:"tasks,omitempty"`          //
 	TenantID       string                                                  `json:"tenantId,omitempty"`       //
 	Type           string                                                  `json:"type,omitempty"`           //
 	UseState       string                                                  `json:"useState,omitempty"`       //
-	Version        float64                                                 `json:"version,omitempty"`        //
+	Version        *float64                                                `json:"version,omitempty"`        //
 }
 
 // AddDeviceToPnpDatabaseRequestSystemResetWorkflowTasks is the addDeviceToPnpDatabaseRequestSystemResetWorkflowTasks definition
 type AddDeviceToPnpDatabaseRequestSystemResetWorkflowTasks struct {
-	CurrWorkItemIDx int                                                                 `json:"currWorkItemIdx,omitempty"` //
-	EndTime         int                                                                 `json:"endTime,omitempty"`         //
+	CurrWorkItemIDx *int                                                                `json:"currWorkItemIdx,omitempty"` //
+	EndTime         *int                                                                `json:"endTime,omitempty"`         //
 	Name            string                                                              `json:"name,omitempty"`            //
-	StartTime       int                                                                 `json:"startTime,omitempty"`       //
+	StartTime       *int                                                                `json:"startTime,omitempty"`       //
 	State           string                                                              `json:"state,omitempty"`           //
-	TaskSeqNo       int                                                                 `json:"taskSeqNo"`                 //
-	TimeTaken       float64                                                             `json:"timeTaken,omitempty"`       //
+	TaskSeqNo       *int                                                                `json:"taskSeqNo"`                 //
+	TimeTaken       *float64                                                            `json:"timeTaken,omitempty"`       //
 	Type            string                                                              `json:"type,omitempty"`            //
 	WorkItemList    []AddDeviceToPnpDatabaseRequestSystemResetWorkflowTasksWorkItemList `json:"workItemList,omitempty"`    //
 }
 
 // AddDeviceToPnpDatabaseRequestSystemResetWorkflowTasksWorkItemList is the addDeviceToPnpDatabaseRequestSystemResetWorkflowTasksWorkItemList definition
 type AddDeviceToPnpDatabaseRequestSystemResetWorkflowTasksWorkItemList struct {
-	Command   string  `json:"command,omitempty"`   //
-	EndTime   int     `json:"endTime,omitempty"`   //
-	OutputStr string  `json:"outputStr,omitempty"` //
-	StartTime int     `json:"startTime,omitempty"` //
-	State     string  `json:"state,omitempty"`     //
-	TimeTaken float64 `json:"timeTaken,omitempty"` //
+	Command   string   `json:"command,omitempty"`   //
+	EndTime   *int     `json:"endTime,omitempty"`   //
+	OutputStr string   `json:"outputStr,omitempty"` //
+	StartTime *int     `json:"startTime,omitempty"` //
+	State     string   `json:"state,omitempty"`     //
+	TimeTaken *float64 `json:"timeTaken,omitempty"` //
 }
 
 // AddDeviceToPnpDatabaseRequestSystemWorkflow is the addDeviceToPnpDatabaseRequestSystemWorkflow definition
 type AddDeviceToPnpDatabaseRequestSystemWorkflow struct {
 	TypeID         string                                             `json:"id,omitempty"`             //
-	AddToInventory bool                                               `json:"addToInventory,omitempty"` //
-	AddedOn        float64                                            `json:"addedOn,omitempty"`        //
+	AddToInventory *bool                                              `json:"addToInventory,omitempty"` //
+	AddedOn        *float64                                           `json:"addedOn,omitempty"`        //
 	ConfigID       string                                             `json:"configId,omitempty"`       //
-	CurrTaskIDx    float64                                            `json:"currTaskIdx,omitempty"`    //
+	CurrTaskIDx    *float64                                           `json:"currTaskIdx,omitempty"`    //
 	Description    string                                             `json:"description,omitempty"`    //
-	EndTime        int                                                `json:"endTime,omitempty"`        //
-	ExecTime       float64                                            `json:"execTime,omitempty"`       //
+	EndTime        *int                                               `json:"endTime,omitempty"`        //
+	ExecTime       *float64                                           `json:"execTime,omitempty"`       //
 	ImageID        string                                             `json:"imageId,omitempty"`        //
 	InstanceType   string                                             `json:"instanceType,omitempty"`   //
-	LastupdateOn   float64                                            `json:"lastupdateOn,omitempty"`   //
+	LastupdateOn   *float64                                           `json:"lastupdateOn,omitempty"`   //
 	Name           string                                             `json:"name,omitempty"`           //
-	StartTime      int                                                `json:"startTime,omitempty"`      //
+	StartTime      *int                                               `json:"startTime,omitempty"`      //
 	State          string                                             `json:"state,omitempty"`          //
 	Tasks          []AddDeviceToPnpDatabaseRequestSystemWorkflowTasks `json:"tasks,omitempty"`          //
 	TenantID       string                                             `json:"tenantId,omitempty"`       //
 	Type           string                                             `json:"type,omitempty"`           //
 	UseState       string                                             `json:"useState,omitempty"`       //
-	Version        float64                                            `json:"version,omitempty"`        //
+	Version        *float64                                           `json:"version,omitempty"`        //
 }
 
 // AddDeviceToPnpDatabaseRequestSystemWorkflowTasks is the addDeviceToPnpDatabaseRequestSystemWorkflowTasks definition
 type AddDeviceToPnpDatabaseRequestSystemWorkflowTasks struct {
-	CurrWorkItemIDx int                                                            `json:"currWorkItemIdx,omitempty"` //
-	EndTime         int                                                            `json:"endTime,omitempty"`         //
+	CurrWorkItemIDx *int                                                           `json:"currWorkItemIdx,omitempty"` //
+	EndTime         *int                                                           `json:"endTime,omitempty"`         //
 	Name            string                                                         `json:"name,omitempty"`            //
-	StartTime       int                                                            `json:"startTime,omitempty"`       //
+	StartTime       *int                                                           `json:"startTime,omitempty"`       //
 	State           string                                                         `json:"state,omitempty"`           //
-	TaskSeqNo       int                                                            `json:"taskSeqNo"`                 //
-	TimeTaken       float64                                                        `json:"timeTaken,omitempty"`       //
+	TaskSeqNo       *int                                                           `json:"taskSeqNo"`                 //
+	TimeTaken       *float64                                                       `json:"timeTaken,omitempty"`       //
 	Type            string                                                         `json:"type,omitempty"`            //
 	WorkItemList    []AddDeviceToPnpDatabaseRequestSystemWorkflowTasksWorkItemList `json:"workItemList,omitempty"`    //
 }
 
 // AddDeviceToPnpDatabaseRequestSystemWorkflowTasksWorkItemList is the addDeviceToPnpDatabaseRequestSystemWorkflowTasksWorkItemList definition
 type AddDeviceToPnpDatabaseRequestSystemWorkflowTasksWorkItemList struct {
-	Command   string  `json:"command,omitempty"`   //
-	EndTime   int     `json:"endTime,omitempty"`   //
-	OutputStr string  `json:"outputStr,omitempty"` //
-	StartTime int     `json:"startTime,omitempty"` //
-	State     string  `json:"state,omitempty"`     //
-	TimeTaken float64 `json:"timeTaken,omitempty"` //
+	Command   string   `json:"command,omitempty"`   //
+	EndTime   *int     `json:"endTime,omitempty"`   //
+	OutputStr string   `json:"outputStr,omitempty"` //
+	StartTime *int     `json:"startTime,omitempty"` //
+	State     string   `json:"state,omitempty"`     //
+	TimeTaken *float64 `json:"timeTaken,omitempty"` //
 }
 
 // AddDeviceToPnpDatabaseRequestWorkflow is the addDeviceToPnpDatabaseRequestWorkflow definition
 type AddDeviceToPnpDatabaseRequestWorkflow struct {
 	TypeID         string                                       `json:"id,omitempty"`             //
-	AddToInventory bool                                         `json:"addToInventory,omitempty"` //
-	AddedOn        float64                                      `json:"addedOn,omitempty"`        //
+	AddToInventory *bool                                        `json:"addToInventory,omitempty"` //
+	AddedOn        *float64                                     `json:"addedOn,omitempty"`        //
 	ConfigID       string                                       `json:"configId,omitempty"`       //
-	CurrTaskIDx    float64                                      `json:"currTaskIdx,omitempty"`    //
+	CurrTaskIDx    *float64                                     `json:"currTaskIdx,omitempty"`    //
 	Description    string                                       `json:"description,omitempty"`    //
-	EndTime        int                                          `json:"endTime,omitempty"`        //
-	ExecTime       float64                                      `json:"execTime,omitempty"`       //
+	EndTime        *int                                         `json:"endTime,omitempty"`        //
+	ExecTime       *float64                                     `json:"execTime,omitempty"`       //
 	ImageID        string                                       `json:"imageId,omitempty"`        //
 	InstanceType   string                                       `json:"instanceType,omitempty"`   //
-	LastupdateOn   float64                                      `json:"lastupdateOn,omitempty"`   //
+	LastupdateOn   *float64                                     `json:"lastupdateOn,omitempty"`   //
 	Name           string                                       `json:"name,omitempty"`           //
-	StartTime      int                                          `json:"startTime,omitempty"`      //
+	StartTime      *int                                         `json:"startTime,omitempty"`      //
 	State          string                                       `json:"state,omitempty"`          //
 	Tasks          []AddDeviceToPnpDatabaseRequestWorkflowTasks `json:"tasks,omitempty"`          //
 	TenantID       string                                       `json:"tenantId,omitempty"`       //
 	Type           string                                       `json:"type,omitempty"`           //
 	UseState       string                                       `json:"useState,omitempty"`       //
-	Version        float64                                      `json:"version,omitempty"`        //
+	Version        *float64                                     `json:"version,omitempty"`        //
 }
 
 // AddDeviceToPnpDatabaseRequestWorkflowParameters is the addDeviceToPnpDatabaseRequestWorkflowParameters definition
@@ -440,42 +441,42 @@ type AddDeviceToPnpDatabaseRequestWorkflowParametersConfigListConfigParameters s
 
 // AddDeviceToPnpDatabaseRequestWorkflowTasks is the addDeviceToPnpDatabaseRequestWorkflowTasks definition
 type AddDeviceToPnpDatabaseRequestWorkflowTasks struct {
-	CurrWorkItemIDx int                                                      `json:"currWorkItemIdx,omitempty"` //
-	EndTime         int                                                      `json:"endTime,omitempty"`         //
+	CurrWorkItemIDx *int                                                     `json:"currWorkItemIdx,omitempty"` //
+	EndTime         *int                                                     `json:"endTime,omitempty"`         //
 	Name            string                                                   `json:"name,omitempty"`            //
-	StartTime       int                                                      `json:"startTime,omitempty"`       //
+	StartTime       *int                                                     `json:"startTime,omitempty"`       //
 	State           string                                                   `json:"state,omitempty"`           //
-	TaskSeqNo       int                                                      `json:"taskSeqNo"`                 //
-	TimeTaken       float64                                                  `json:"timeTaken,omitempty"`       //
+	TaskSeqNo       *int                                                     `json:"taskSeqNo"`                 //
+	TimeTaken       *float64                                                 `json:"timeTaken,omitempty"`       //
 	Type            string                                                   `json:"type,omitempty"`            //
 	WorkItemList    []AddDeviceToPnpDatabaseRequestWorkflowTasksWorkItemList `json:"workItemList,omitempty"`    //
 }
 
 // AddDeviceToPnpDatabaseRequestWorkflowTasksWorkItemList is the addDeviceToPnpDatabaseRequestWorkflowTasksWorkItemList definition
 type AddDeviceToPnpDatabaseRequestWorkflowTasksWorkItemList struct {
-	Command   string  `json:"command,omitempty"`   //
-	EndTime   int     `json:"endTime,omitempty"`   //
-	OutputStr string  `json:"outputStr,omitempty"` //
-	StartTime int     `json:"startTime,omitempty"` //
-	State     string  `json:"state,omitempty"`     //
-	TimeTaken float64 `json:"timeTaken,omitempty"` //
+	Command   string   `json:"command,omitempty"`   //
+	EndTime   *int     `json:"endTime,omitempty"`   //
+	OutputStr string   `json:"outputStr,omitempty"` //
+	StartTime *int     `json:"startTime,omitempty"` //
+	State     string   `json:"state,omitempty"`     //
+	TimeTaken *float64 `json:"timeTaken,omitempty"` //
 }
 
 // AddVirtualAccountRequest is the addVirtualAccountRequest definition
 type AddVirtualAccountRequest struct {
-	AutoSyncPeriod   int                                `json:"autoSyncPeriod,omitempty"`   //
-	CcoUser          string                             `json:"ccoUser,omitempty"`          //
-	Expiry           int                                `json:"expiry,omitempty"`           //
-	LastSync         int                                `json:"lastSync,omitempty"`         //
-	Profile          AddVirtualAccountRequestProfile    `json:"profile,omitempty"`          //
-	SmartAccountID   string                             `json:"smartAccountId,omitempty"`   //
-	SyncResult       AddVirtualAccountRequestSyncResult `json:"syncResult,omitempty"`       //
-	SyncResultStr    string                             `json:"syncResultStr,omitempty"`    //
-	SyncStartTime    int                                `json:"syncStartTime,omitempty"`    //
-	SyncStatus       string                             `json:"syncStatus,omitempty"`       //
-	TenantID         string                             `json:"tenantId,omitempty"`         //
-	Token            string                             `json:"token,omitempty"`            //
-	VirtualAccountID string                             `json:"virtualAccountId,omitempty"` //
+	AutoSyncPeriod   *int                                `json:"autoSyncPeriod,omitempty"`   //
+	CcoUser          string                              `json:"ccoUser,omitempty"`          //
+	Expiry           *int                                `json:"expiry,omitempty"`           //
+	LastSync         *int                                `json:"lastSync,omitempty"`         //
+	Profile          *AddVirtualAccountRequestProfile    `json:"profile,omitempty"`          //
+	SmartAccountID   string                              `json:"smartAccountId,omitempty"`   //
+	SyncResult       *AddVirtualAccountRequestSyncResult `json:"syncResult,omitempty"`       //
+	SyncResultStr    string                              `json:"syncResultStr,omitempty"`    //
+	SyncStartTime    *int                                `json:"syncStartTime,omitempty"`    //
+	SyncStatus       string                              `json:"syncStatus,omitempty"`       //
+	TenantID         string                              `json:"tenantId,omitempty"`         //
+	Token            string                              `json:"token,omitempty"`            //
+	VirtualAccountID string                              `json:"virtualAccountId,omitempty"` //
 }
 
 // AddVirtualAccountRequestProfile is the addVirtualAccountRequestProfile definition
@@ -483,11 +484,11 @@ type AddVirtualAccountRequestProfile struct {
 	AddressFqdn string `json:"addressFqdn,omitempty"` //
 	AddressIPV4 string `json:"addressIpV4,omitempty"` //
 	Cert        string `json:"cert,omitempty"`        //
-	MakeDefault bool   `json:"makeDefault,omitempty"` //
+	MakeDefault *bool  `json:"makeDefault,omitempty"` //
 	Name        string `json:"name,omitempty"`        //
-	Port        int    `json:"port,omitempty"`        //
+	Port        *int   `json:"port,omitempty"`        //
 	ProfileID   string `json:"profileId,omitempty"`   //
-	Proxy       bool   `json:"proxy,omitempty"`       //
+	Proxy       *bool  `json:"proxy,omitempty"`       //
 }
 
 // AddVirtualAccountRequestSyncResult is the addVirtualAccountRequestSyncResult definition
@@ -520,7 +521,7 @@ type ClaimDeviceRequest struct {
 	FileServiceID     string                              `json:"fileServiceId,omitempty"`     //
 	ImageID           string                              `json:"imageId,omitempty"`           //
 	ImageURL          string                              `json:"imageUrl,omitempty"`          //
-	PopulateInventory bool                                `json:"populateInventory,omitempty"` //
+	PopulateInventory *bool                               `json:"populateInventory,omitempty"` //
 	ProjectID         string                              `json:"projectId,omitempty"`         //
 	WorkflowID        string                              `json:"workflowId,omitempty"`        //
 }
@@ -548,17 +549,17 @@ type ClaimDeviceRequestDeviceClaimListConfigListConfigParameters struct {
 
 // ImportDevicesInBulkRequest is the importDevicesInBulkRequest definition
 type ImportDevicesInBulkRequest struct {
-	TypeID               string                                        `json:"id,omitempty"`                   //
-	DayZeroConfig        ImportDevicesInBulkRequestDayZeroConfig       `json:"dayZeroConfig,omitempty"`        //
-	DayZeroConfigPreview string                                        `json:"dayZeroConfigPreview,omitempty"` //
-	DeviceInfo           ImportDevicesInBulkRequestDeviceInfo          `json:"deviceInfo,omitempty"`           //
-	RunSummaryList       []ImportDevicesInBulkRequestRunSummaryList    `json:"runSummaryList,omitempty"`       //
-	SystemResetWorkflow  ImportDevicesInBulkRequestSystemResetWorkflow `json:"systemResetWorkflow,omitempty"`  //
-	SystemWorkflow       ImportDevicesInBulkRequestSystemWorkflow      `json:"systemWorkflow,omitempty"`       //
-	TenantID             string                                        `json:"tenantId,omitempty"`             //
-	Version              float64                                       `json:"version,omitempty"`              //
-	Workflow             ImportDevicesInBulkRequestWorkflow            `json:"workflow,omitempty"`             //
-	WorkflowParameters   ImportDevicesInBulkRequestWorkflowParameters  `json:"workflowParameters,omitempty"`   //
+	TypeID               string                                         `json:"id,omitempty"`                   //
+	DayZeroConfig        *ImportDevicesInBulkRequestDayZeroConfig       `json:"dayZeroConfig,omitempty"`        //
+	DayZeroConfigPreview string                                         `json:"dayZeroConfigPreview,omitempty"` //
+	DeviceInfo           *ImportDevicesInBulkRequestDeviceInfo          `json:"deviceInfo,omitempty"`           //
+	RunSummaryList       []ImportDevicesInBulkRequestRunSummaryList     `json:"runSummaryList,omitempty"`       //
+	SystemResetWorkflow  *ImportDevicesInBulkRequestSystemResetWorkflow `json:"systemResetWorkflow,omitempty"`  //
+	SystemWorkflow       *ImportDevicesInBulkRequestSystemWorkflow      `json:"systemWorkflow,omitempty"`       //
+	TenantID             string                                         `json:"tenantId,omitempty"`             //
+	Version              *float64                                       `json:"version,omitempty"`              //
+	Workflow             *ImportDevicesInBulkRequestWorkflow            `json:"workflow,omitempty"`             //
+	WorkflowParameters   *ImportDevicesInBulkRequestWorkflowParameters  `json:"workflowParameters,omitempty"`   //
 }
 
 // ImportDevicesInBulkRequestDayZeroConfig is the importDevicesInBulkRequestDayZeroConfig definition
@@ -568,8 +569,8 @@ type ImportDevicesInBulkRequestDayZeroConfig struct {
 
 // ImportDevicesInBulkRequestDeviceInfo is the importDevicesInBulkRequestDeviceInfo definition
 type ImportDevicesInBulkRequestDeviceInfo struct {
-	AAACredentials            ImportDevicesInBulkRequestDeviceInfoAAACredentials         `json:"aaaCredentials,omitempty"`            //
-	AddedOn                   float64                                                    `json:"addedOn,omitempty"`                   //
+	AAACredentials            *ImportDevicesInBulkRequestDeviceInfoAAACredentials        `json:"aaaCredentials,omitempty"`            //
+	AddedOn                   *float64                                                   `json:"addedOn,omitempty"`                   //
 	AddnMacAddrs              []string                                                   `json:"addnMacAddrs,omitempty"`              //
 	AgentType                 string                                                     `json:"agentType,omitempty"`                 //
 	AuthStatus                string                                                     `json:"authStatus,omitempty"`                //
@@ -582,16 +583,16 @@ type ImportDevicesInBulkRequestDeviceInfo struct {
 	DeviceType                string                                                     `json:"deviceType,omitempty"`                //
 	FeaturesSupported         []string                                                   `json:"featuresSupported,omitempty"`         //
 	FileSystemList            []ImportDevicesInBulkRequestDeviceInfoFileSystemList       `json:"fileSystemList,omitempty"`            //
-	FirstContact              float64                                                    `json:"firstContact,omitempty"`              //
+	FirstContact              *float64                                                   `json:"firstContact,omitempty"`              //
 	Hostname                  string                                                     `json:"hostname,omitempty"`                  //
 	HTTPHeaders               []ImportDevicesInBulkRequestDeviceInfoHTTPHeaders          `json:"httpHeaders,omitempty"`               //
 	ImageFile                 string                                                     `json:"imageFile,omitempty"`                 //
 	ImageVersion              string                                                     `json:"imageVersion,omitempty"`              //
 	IPInterfaces              []ImportDevicesInBulkRequestDeviceInfoIPInterfaces         `json:"ipInterfaces,omitempty"`              //
-	LastContact               float64                                                    `json:"lastContact,omitempty"`               //
-	LastSyncTime              float64                                                    `json:"lastSyncTime,omitempty"`              //
-	LastUpdateOn              float64                                                    `json:"lastUpdateOn,omitempty"`              //
-	Location                  ImportDevicesInBulkRequestDeviceInfoLocation               `json:"location,omitempty"`                  //
+	LastContact               *float64                                                   `json:"lastContact,omitempty"`               //
+	LastSyncTime              *float64                                                   `json:"lastSyncTime,omitempty"`              //
+	LastUpdateOn              *float64                                                   `json:"lastUpdateOn,omitempty"`              //
+	Location                  *ImportDevicesInBulkRequestDeviceInfoLocation              `json:"location,omitempty"`                  //
 	MacAddress                string                                                     `json:"macAddress,omitempty"`                //
 	Mode                      string                                                     `json:"mode,omitempty"`                      //
 	Name                      string                                                     `json:"name,omitempty"`                      //
@@ -599,20 +600,20 @@ type ImportDevicesInBulkRequestDeviceInfo struct {
 	OnbState                  string                                                     `json:"onbState,omitempty"`                  //
 	Pid                       string                                                     `json:"pid,omitempty"`                       //
 	PnpProfileList            []ImportDevicesInBulkRequestDeviceInfoPnpProfileList       `json:"pnpProfileList,omitempty"`            //
-	PopulateInventory         bool                                                       `json:"populateInventory,omitempty"`         //
+	PopulateInventory         *bool                                                      `json:"populateInventory,omitempty"`         //
 	PreWorkflowCliOuputs      []ImportDevicesInBulkRequestDeviceInfoPreWorkflowCliOuputs `json:"preWorkflowCliOuputs,omitempty"`      //
 	ProjectID                 string                                                     `json:"projectId,omitempty"`                 //
 	ProjectName               string                                                     `json:"projectName,omitempty"`               //
-	ReloadRequested           bool                                                       `json:"reloadRequested,omitempty"`           //
+	ReloadRequested           *bool                                                      `json:"reloadRequested,omitempty"`           //
 	SerialNumber              string                                                     `json:"serialNumber,omitempty"`              //
 	SiteID                    string                                                     `json:"siteId,omitempty"`                    //
 	SiteName                  string                                                     `json:"siteName,omitempty"`                  //
 	SmartAccountID            string                                                     `json:"smartAccountId,omitempty"`            //
 	Source                    string                                                     `json:"source,omitempty"`                    //
-	Stack                     bool                                                       `json:"stack,omitempty"`                     //
-	StackInfo                 ImportDevicesInBulkRequestDeviceInfoStackInfo              `json:"stackInfo,omitempty"`                 //
+	Stack                     *bool                                                      `json:"stack,omitempty"`                     //
+	StackInfo                 *ImportDevicesInBulkRequestDeviceInfoStackInfo             `json:"stackInfo,omitempty"`                 //
 	State                     string                                                     `json:"state,omitempty"`                     //
-	SudiRequired              bool                                                       `json:"sudiRequired,omitempty"`              //
+	SudiRequired              *bool                                                      `json:"sudiRequired,omitempty"`              //
 	Tags                      string                                                     `json:"tags,omitempty"`                      //
 	UserMicNumbers            []string                                                   `json:"userMicNumbers,omitempty"`            //
 	UserSudiSerialNos         []string                                                   `json:"userSudiSerialNos,omitempty"`         //
@@ -641,12 +642,12 @@ type ImportDevicesInBulkRequestDeviceInfoFeaturesSupported []string
 
 // ImportDevicesInBulkRequestDeviceInfoFileSystemList is the importDevicesInBulkRequestDeviceInfoFileSystemList definition
 type ImportDevicesInBulkRequestDeviceInfoFileSystemList struct {
-	Freespace float64 `json:"freespace,omitempty"` //
-	Name      string  `json:"name,omitempty"`      //
-	Readable  bool    `json:"readable,omitempty"`  //
-	Size      float64 `json:"size,omitempty"`      //
-	Type      string  `json:"type,omitempty"`      //
-	Writeable bool    `json:"writeable,omitempty"` //
+	Freespace *float64 `json:"freespace,omitempty"` //
+	Name      string   `json:"name,omitempty"`      //
+	Readable  *bool    `json:"readable,omitempty"`  //
+	Size      *float64 `json:"size,omitempty"`      //
+	Type      string   `json:"type,omitempty"`      //
+	Writeable *bool    `json:"writeable,omitempty"` //
 }
 
 // ImportDevicesInBulkRequestDeviceInfoHTTPHeaders is the importDevicesInBulkRequestDeviceInfoHTTPHeaders definition
@@ -691,31 +692,31 @@ type ImportDevicesInBulkRequestDeviceInfoNeighborLinks struct {
 
 // ImportDevicesInBulkRequestDeviceInfoPnpProfileList is the importDevicesInBulkRequestDeviceInfoPnpProfileList definition
 type ImportDevicesInBulkRequestDeviceInfoPnpProfileList struct {
-	CreatedBy         string                                                              `json:"createdBy,omitempty"`         //
-	DiscoveryCreated  bool                                                                `json:"discoveryCreated,omitempty"`  //
-	PrimaryEndpoint   ImportDevicesInBulkRequestDeviceInfoPnpProfileListPrimaryEndpoint   `json:"primaryEndpoint,omitempty"`   //
-	ProfileName       string                                                              `json:"profileName,omitempty"`       //
-	SecondaryEndpoint ImportDevicesInBulkRequestDeviceInfoPnpProfileListSecondaryEndpoint `json:"secondaryEndpoint,omitempty"` //
+	CreatedBy         string                                                               `json:"createdBy,omitempty"`         //
+	DiscoveryCreated  *bool                                                                `json:"discoveryCreated,omitempty"`  //
+	PrimaryEndpoint   *ImportDevicesInBulkRequestDeviceInfoPnpProfileListPrimaryEndpoint   `json:"primaryEndpoint,omitempty"`   //
+	ProfileName       string                                                               `json:"profileName,omitempty"`       //
+	SecondaryEndpoint *ImportDevicesInBulkRequestDeviceInfoPnpProfileListSecondaryEndpoint `json:"secondaryEndpoint,omitempty"` //
 }
 
 // ImportDevicesInBulkRequestDeviceInfoPnpProfileListPrimaryEndpoint is the importDevicesInBulkRequestDeviceInfoPnpProfileListPrimaryEndpoint definition
 type ImportDevicesInBulkRequestDeviceInfoPnpProfileListPrimaryEndpoint struct {
-	Certificate string  `json:"certificate,omitempty"` //
-	Fqdn        string  `json:"fqdn,omitempty"`        //
-	IPv4Address string  `json:"ipv4Address,omitempty"` //
-	IPv6Address string  `json:"ipv6Address,omitempty"` //
-	Port        float64 `json:"port,omitempty"`        //
-	Protocol    string  `json:"protocol,omitempty"`    //
+	Certificate string   `json:"certificate,omitempty"` //
+	Fqdn        string   `json:"fqdn,omitempty"`        //
+	IPv4Address string   `json:"ipv4Address,omitempty"` //
+	IPv6Address string   `json:"ipv6Address,omitempty"` //
+	Port        *float64 `json:"port,omitempty"`        //
+	Protocol    string   `json:"protocol,omitempty"`    //
 }
 
 // ImportDevicesInBulkRequestDeviceInfoPnpProfileListSecondaryEndpoint is the importDevicesInBulkRequestDeviceInfoPnpProfileListSecondaryEndpoint definition
 type ImportDevicesInBulkRequestDeviceInfoPnpProfileListSecondaryEndpoint struct {
-	Certificate string  `json:"certificate,omitempty"` //
-	Fqdn        string  `json:"fqdn,omitempty"`        //
-	IPv4Address string  `json:"ipv4Address,omitempty"` //
-	IPv6Address string  `json:"ipv6Address,omitempty"` //
-	Port        float64 `json:"port,omitempty"`        //
-	Protocol    string  `json:"protocol,omitempty"`    //
+	Certificate string   `json:"certificate,omitempty"` //
+	Fqdn        string   `json:"fqdn,omitempty"`        //
+	IPv4Address string   `json:"ipv4Address,omitempty"` //
+	IPv6Address string   `json:"ipv6Address,omitempty"` //
+	Port        *float64 `json:"port,omitempty"`        //
+	Protocol    string   `json:"protocol,omitempty"`    //
 }
 
 // ImportDevicesInBulkRequestDeviceInfoPreWorkflowCliOuputs is the importDevicesInBulkRequestDeviceInfoPreWorkflowCliOuputs definition
@@ -726,28 +727,28 @@ type ImportDevicesInBulkRequestDeviceInfoPreWorkflowCliOuputs struct {
 
 // ImportDevicesInBulkRequestDeviceInfoStackInfo is the importDevicesInBulkRequestDeviceInfoStackInfo definition
 type ImportDevicesInBulkRequestDeviceInfoStackInfo struct {
-	IsFullRing             bool                                                           `json:"isFullRing,omitempty"`             //
+	IsFullRing             *bool                                                          `json:"isFullRing,omitempty"`             //
 	StackMemberList        []ImportDevicesInBulkRequestDeviceInfoStackInfoStackMemberList `json:"stackMemberList,omitempty"`        //
 	StackRingProtocol      string                                                         `json:"stackRingProtocol,omitempty"`      //
-	SupportsStackWorkflows bool                                                           `json:"supportsStackWorkflows,omitempty"` //
-	TotalMemberCount       float64                                                        `json:"totalMemberCount,omitempty"`       //
+	SupportsStackWorkflows *bool                                                          `json:"supportsStackWorkflows,omitempty"` //
+	TotalMemberCount       *float64                                                       `json:"totalMemberCount,omitempty"`       //
 	ValidLicenseLevels     []string                                                       `json:"validLicenseLevels,omitempty"`     //
 }
 
 // ImportDevicesInBulkRequestDeviceInfoStackInfoStackMemberList is the importDevicesInBulkRequestDeviceInfoStackInfoStackMemberList definition
 type ImportDevicesInBulkRequestDeviceInfoStackInfoStackMemberList struct {
-	HardwareVersion  string  `json:"hardwareVersion,omitempty"`  //
-	LicenseLevel     string  `json:"licenseLevel,omitempty"`     //
-	LicenseType      string  `json:"licenseType,omitempty"`      //
-	MacAddress       string  `json:"macAddress,omitempty"`       //
-	Pid              string  `json:"pid,omitempty"`              //
-	Priority         float64 `json:"priority,omitempty"`         //
-	Role             string  `json:"role,omitempty"`             //
-	SerialNumber     string  `json:"serialNumber,omitempty"`     //
-	SoftwareVersion  string  `json:"softwareVersion,omitempty"`  //
-	StackNumber      float64 `json:"stackNumber,omitempty"`      //
-	State            string  `json:"state,omitempty"`            //
-	SudiSerialNumber string  `json:"sudiSerialNumber,omitempty"` //
+	HardwareVersion  string   `json:"hardwareVersion,omitempty"`  //
+	LicenseLevel     string   `json:"licenseLevel,omitempty"`     //
+	LicenseType      string   `json:"licenseType,omitempty"`      //
+	MacAddress       string   `json:"macAddress,omitempty"`       //
+	Pid              string   `json:"pid,omitempty"`              //
+	Priority         *float64 `json:"priority,omitempty"`         //
+	Role             string   `json:"role,omitempty"`             //
+	SerialNumber     string   `json:"serialNumber,omitempty"`     //
+	SoftwareVersion  string   `json:"softwareVersion,omitempty"`  //
+	StackNumber      *float64 `json:"stackNumber,omitempty"`      //
+	State            string   `json:"state,omitempty"`            //
+	SudiSerialNumber string   `json:"sudiSerialNumber,omitempty"` //
 }
 
 // ImportDevicesInBulkRequestDeviceInfoStackInfoValidLicenseLevels is the importDevicesInBulkRequestDeviceInfoStackInfoValidLicenseLevels definition
@@ -761,17 +762,17 @@ type ImportDevicesInBulkRequestDeviceInfoUserSudiSerialNos []string
 
 // ImportDevicesInBulkRequestRunSummaryList is the importDevicesInBulkRequestRunSummaryList definition
 type ImportDevicesInBulkRequestRunSummaryList struct {
-	Details         string                                                  `json:"details,omitempty"`         //
-	ErrorFlag       bool                                                    `json:"errorFlag,omitempty"`       //
-	HistoryTaskInfo ImportDevicesInBulkRequestRunSummaryListHistoryTaskInfo `json:"historyTaskInfo,omitempty"` //
-	Timestamp       float64                                                 `json:"timestamp,omitempty"`       //
+	Details         string                                                   `json:"details,omitempty"`         //
+	ErrorFlag       *bool                                                    `json:"errorFlag,omitempty"`       //
+	HistoryTaskInfo *ImportDevicesInBulkRequestRunSummaryListHistoryTaskInfo `json:"historyTaskInfo,omitempty"` //
+	Timestamp       *float64                                                 `json:"timestamp,omitempty"`       //
 }
 
 // ImportDevicesInBulkRequestRunSummaryListHistoryTaskInfo is the importDevicesInBulkRequestRunSummaryListHistoryTaskInfo definition
 type ImportDevicesInBulkRequestRunSummaryListHistoryTaskInfo struct {
 	AddnDetails  []ImportDevicesInBulkRequestRunSummaryListHistoryTaskInfoAddnDetails  `json:"addnDetails,omitempty"`  //
 	Name         string                                                                `json:"name,omitempty"`         //
-	TimeTaken    float64                                                               `json:"timeTaken,omitempty"`    //
+	TimeTaken    *float64                                                              `json:"timeTaken,omitempty"`    //
 	Type         string                                                                `json:"type,omitempty"`         //
 	WorkItemList []ImportDevicesInBulkRequestRunSummaryListHistoryTaskInfoWorkItemList `json:"workItemList,omitempty"` //
 }
@@ -784,127 +785,127 @@ type ImportDevicesInBulkRequestRunSummaryListHistoryTaskInfoAddnDetails struct {
 
 // ImportDevicesInBulkRequestRunSummaryListHistoryTaskInfoWorkItemList is the importDevicesInBulkRequestRunSummaryListHistoryTaskInfoWorkItemList definition
 type ImportDevicesInBulkRequestRunSummaryListHistoryTaskInfoWorkItemList struct {
-	Command   string  `json:"command,omitempty"`   //
-	EndTime   int     `json:"endTime,omitempty"`   //
-	OutputStr string  `json:"outputStr,omitempty"` //
-	StartTime int     `json:"startTime,omitempty"` //
-	State     string  `json:"state,omitempty"`     //
-	TimeTaken float64 `json:"timeTaken,omitempty"` //
+	Command   string   `json:"command,omitempty"`   //
+	EndTime   *int     `json:"endTime,omitempty"`   //
+	OutputStr string   `json:"outputStr,omitempty"` //
+	StartTime *int     `json:"startTime,omitempty"` //
+	State     string   `json:"state,omitempty"`     //
+	TimeTaken *float64 `json:"timeTaken,omitempty"` //
 }
 
 // ImportDevicesInBulkRequestSystemResetWorkflow is the importDevicesInBulkRequestSystemResetWorkflow definition
 type ImportDevicesInBulkRequestSystemResetWorkflow struct {
 	TypeID         string                                               `json:"id,omitempty"`             //
-	AddToInventory bool                                                 `json:"addToInventory,omitempty"` //
-	AddedOn        float64                                              `json:"addedOn,omitempty"`        //
+	AddToInventory *bool                                                `json:"addToInventory,omitempty"` //
+	AddedOn        *float64                                             `json:"addedOn,omitempty"`        //
 	ConfigID       string                                               `json:"configId,omitempty"`       //
-	CurrTaskIDx    float64                                              `json:"currTaskIdx,omitempty"`    //
+	CurrTaskIDx    *float64                                             `json:"currTaskIdx,omitempty"`    //
 	Description    string                                               `json:"description,omitempty"`    //
-	EndTime        int                                                  `json:"endTime,omitempty"`        //
-	ExecTime       float64                                              `json:"execTime,omitempty"`       //
+	EndTime        *int                                                 `json:"endTime,omitempty"`        //
+	ExecTime       *float64                                             `json:"execTime,omitempty"`       //
 	ImageID        string                                               `json:"imageId,omitempty"`        //
 	InstanceType   string                                               `json:"instanceType,omitempty"`   //
-	LastupdateOn   float64                                              `json:"lastupdateOn,omitempty"`   //
+	LastupdateOn   *float64                                             `json:"lastupdateOn,omitempty"`   //
 	Name           string                                               `json:"name,omitempty"`           //
-	StartTime      int                                                  `json:"startTime,omitempty"`      //
+	StartTime      *int                                                 `json:"startTime,omitempty"`      //
 	State          string                                               `json:"state,omitempty"`          //
 	Tasks          []ImportDevicesInBulkRequestSystemResetWorkflowTasks `json:"tasks,omitempty"`          //
 	TenantID       string                                               `json:"tenantId,omitempty"`       //
 	Type           string                                               `json:"type,omitempty"`           //
 	UseState       string                                               `json:"useState,omitempty"`       //
-	Version        float64                                              `json:"version,omitempty"`        //
+	Version        *float64                                             `json:"version,omitempty"`        //
 }
 
 // ImportDevicesInBulkRequestSystemResetWorkflowTasks is the importDevicesInBulkRequestSystemResetWorkflowTasks definition
 type ImportDevicesInBulkRequestSystemResetWorkflowTasks struct {
-	CurrWorkItemIDx int                                                              `json:"currWorkItemIdx,omitempty"` //
-	EndTime         int                                                              `json:"endTime,omitempty"`         //
+	CurrWorkItemIDx *int                                                             `json:"currWorkItemIdx,omitempty"` //
+	EndTime         *int                                                             `json:"endTime,omitempty"`         //
 	Name            string                                                           `json:"name,omitempty"`            //
-	StartTime       int                                                              `json:"startTime,omitempty"`       //
+	StartTime       *int                                                             `json:"startTime,omitempty"`       //
 	State           string                                                           `json:"state,omitempty"`           //
-	TaskSeqNo       int                                                              `json:"taskSeqNo"`                 //
-	TimeTaken       float64                                                          `json:"timeTaken,omitempty"`       //
+	TaskSeqNo       *int                                                             `json:"taskSeqNo"`                 //
+	TimeTaken       *float64                                                         `json:"timeTaken,omitempty"`       //
 	Type            string                                                           `json:"type,omitempty"`            //
 	WorkItemList    []ImportDevicesInBulkRequestSystemResetWorkflowTasksWorkItemList `json:"workItemList,omitempty"`    //
 }
 
 // ImportDevicesInBulkRequestSystemResetWorkflowTasksWorkItemList is the importDevicesInBulkRequestSystemResetWorkflowTasksWorkItemList definition
 type ImportDevicesInBulkRequestSystemResetWorkflowTasksWorkItemList struct {
-	Command   string  `json:"command,omitempty"`   //
-	EndTime   int     `json:"endTime,omitempty"`   //
-	OutputStr string  `json:"outputStr,omitempty"` //
-	StartTime int     `json:"startTime,omitempty"` //
-	State     string  `json:"state,omitempty"`     //
-	TimeTaken float64 `json:"timeTaken,omitempty"` //
+	Command   string   `json:"command,omitempty"`   //
+	EndTime   *int     `json:"endTime,omitempty"`   //
+	OutputStr string   `json:"outputStr,omitempty"` //
+	StartTime *int     `json:"startTime,omitempty"` //
+	State     string   `json:"state,omitempty"`     //
+	TimeTaken *float64 `json:"timeTaken,omitempty"` //
 }
 
 // ImportDevicesInBulkRequestSystemWorkflow is the importDevicesInBulkRequestSystemWorkflow definition
 type ImportDevicesInBulkRequestSystemWorkflow struct {
 	TypeID         string                                          `json:"id,omitempty"`             //
-	AddToInventory bool                                            `json:"addToInventory,omitempty"` //
-	AddedOn        float64                                         `json:"addedOn,omitempty"`        //
+	AddToInventory *bool                                           `json:"addToInventory,omitempty"` //
+	AddedOn        *float64                                        `json:"addedOn,omitempty"`        //
 	ConfigID       string                                          `json:"configId,omitempty"`       //
-	CurrTaskIDx    float64                                         `json:"currTaskIdx,omitempty"`    //
+	CurrTaskIDx    *float64                                        `json:"currTaskIdx,omitempty"`    //
 	Description    string                                          `json:"description,omitempty"`    //
-	EndTime        int                                             `json:"endTime,omitempty"`        //
-	ExecTime       float64                                         `json:"execTime,omitempty"`       //
+	EndTime        *int                                            `json:"endTime,omitempty"`        //
+	ExecTime       *float64                                        `json:"execTime,omitempty"`       //
 	ImageID        string                                          `json:"imageId,omitempty"`        //
 	InstanceType   string                                          `json:"instanceType,omitempty"`   //
-	LastupdateOn   float64                                         `json:"lastupdateOn,omitempty"`   //
+	LastupdateOn   *float64                                        `json:"lastupdateOn,omitempty"`   //
 	Name           string                                          `json:"name,omitempty"`           //
-	StartTime      int                                             `json:"startTime,omitempty"`      //
+	StartTime      *int                                            `json:"startTime,omitempty"`      //
 	State          string                                          `json:"state,omitempty"`          //
 	Tasks          []ImportDevicesInBulkRequestSystemWorkflowTasks `json:"tasks,omitempty"`          //
 	TenantID       string                                          `json:"tenantId,omitempty"`       //
 	Type           string                                          `json:"type,omitempty"`           //
 	UseState       string                                          `json:"useState,omitempty"`       //
-	Version        float64                                         `json:"version,omitempty"`        //
+	Version        *float64                                        `json:"version,omitempty"`        //
 }
 
 // ImportDevicesInBulkRequestSystemWorkflowTasks is the importDevicesInBulkRequestSystemWorkflowTasks definition
 type ImportDevicesInBulkRequestSystemWorkflowTasks struct {
-	CurrWorkItemIDx int                                                         `json:"currWorkItemIdx,omitempty"` //
-	EndTime         int                                                         `json:"endTime,omitempty"`         //
+	CurrWorkItemIDx *int                                                        `json:"currWorkItemIdx,omitempty"` //
+	EndTime         *int                                                        `json:"endTime,omitempty"`         //
 	Name            string                                                      `json:"name,omitempty"`            //
-	StartTime       int                                                         `json:"startTime,omitempty"`       //
+	StartTime       *int                                                        `json:"startTime,omitempty"`       //
 	State           string                                                      `json:"state,omitempty"`           //
-	TaskSeqNo       int                                                         `json:"taskSeqNo"`                 //
-	TimeTaken       float64                                                     `json:"timeTaken,omitempty"`       //
+	TaskSeqNo       *int                                                        `json:"taskSeqNo"`                 //
+	TimeTaken       *float64                                                    `json:"timeTaken,omitempty"`       //
 	Type            string                                                      `json:"type,omitempty"`            //
 	WorkItemList    []ImportDevicesInBulkRequestSystemWorkflowTasksWorkItemList `json:"workItemList,omitempty"`    //
 }
 
 // ImportDevicesInBulkRequestSystemWorkflowTasksWorkItemList is the importDevicesInBulkRequestSystemWorkflowTasksWorkItemList definition
 type ImportDevicesInBulkRequestSystemWorkflowTasksWorkItemList struct {
-	Command   string  `json:"command,omitempty"`   //
-	EndTime   int     `json:"endTime,omitempty"`   //
-	OutputStr string  `json:"outputStr,omitempty"` //
-	StartTime int     `json:"startTime,omitempty"` //
-	State     string  `json:"state,omitempty"`     //
-	TimeTaken float64 `json:"timeTaken,omitempty"` //
+	Command   string   `json:"command,omitempty"`   //
+	EndTime   *int     `json:"endTime,omitempty"`   //
+	OutputStr string   `json:"outputStr,omitempty"` //
+	StartTime *int     `json:"startTime,omitempty"` //
+	State     string   `json:"state,omitempty"`     //
+	TimeTaken *float64 `json:"timeTaken,omitempty"` //
 }
 
 // ImportDevicesInBulkRequestWorkflow is the importDevicesInBulkRequestWorkflow definition
 type ImportDevicesInBulkRequestWorkflow struct {
 	TypeID         string                                    `json:"id,omitempty"`             //
-	AddToInventory bool                                      `json:"addToInventory,omitempty"` //
-	AddedOn        float64                                   `json:"addedOn,omitempty"`        //
+	AddToInventory *bool                                     `json:"addToInventory,omitempty"` //
+	AddedOn        *float64                                  `json:"addedOn,omitempty"`        //
 	ConfigID       string                                    `json:"configId,omitempty"`       //
-	CurrTaskIDx    float64                                   `json:"currTaskIdx,omitempty"`    //
+	CurrTaskIDx    *float64                                  `json:"currTaskIdx,omitempty"`    //
 	Description    string                                    `json:"description,omitempty"`    //
-	EndTime        int                                       `json:"endTime,omitempty"`        //
-	ExecTime       float64                                   `json:"execTime,omitempty"`       //
+	EndTime        *int                                      `json:"endTime,omitempty"`        //
+	ExecTime       *float64                                  `json:"execTime,omitempty"`       //
 	ImageID        string                                    `json:"imageId,omitempty"`        //
 	InstanceType   string                                    `json:"instanceType,omitempty"`   //
-	LastupdateOn   float64                                   `json:"lastupdateOn,omitempty"`   //
+	LastupdateOn   *float64                                  `json:"lastupdateOn,omitempty"`   //
 	Name           string                                    `json:"name,omitempty"`           //
-	StartTime      int                                       `json:"startTime,omitempty"`      //
+	StartTime      *int                                      `json:"startTime,omitempty"`      //
 	State          string                                    `json:"state,omitempty"`          //
 	Tasks          []ImportDevicesInBulkRequestWorkflowTasks `json:"tasks,omitempty"`          //
 	TenantID       string                                    `json:"tenantId,omitempty"`       //
 	Type           string                                    `json:"type,omitempty"`           //
 	UseState       string                                    `json:"useState,omitempty"`       //
-	Version        float64                                   `json:"version,omitempty"`        //
+	Version        *float64                                  `json:"version,omitempty"`        //
 }
 
 // ImportDevicesInBulkRequestWorkflowParameters is the importDevicesInBulkRequestWorkflowParameters definition
@@ -929,25 +930,25 @@ type ImportDevicesInBulkRequestWorkflowParametersConfigListConfigParameters stru
 
 // ImportDevicesInBulkRequestWorkflowTasks is the importDevicesInBulkRequestWorkflowTasks definition
 type ImportDevicesInBulkRequestWorkflowTasks struct {
-	CurrWorkItemIDx int                                                   `json:"currWorkItemIdx,omitempty"` //
-	EndTime         int                                                   `json:"endTime,omitempty"`         //
+	CurrWorkItemIDx *int                                                  `json:"currWorkItemIdx,omitempty"` //
+	EndTime         *int                                                  `json:"endTime,omitempty"`         //
 	Name            string                                                `json:"name,omitempty"`            //
-	StartTime       int                                                   `json:"startTime,omitempty"`       //
+	StartTime       *int                                                  `json:"startTime,omitempty"`       //
 	State           string                                                `json:"state,omitempty"`           //
-	TaskSeqNo       int                                                   `json:"taskSeqNo"`                 //
-	TimeTaken       float64                                               `json:"timeTaken,omitempty"`       //
+	TaskSeqNo       *int                                                  `json:"taskSeqNo"`                 //
+	TimeTaken       *float64                                              `json:"timeTaken,omitempty"`       //
 	Type            string                                                `json:"type,omitempty"`            //
 	WorkItemList    []ImportDevicesInBulkRequestWorkflowTasksWorkItemList `json:"workItemList,omitempty"`    //
 }
 
 // ImportDevicesInBulkRequestWorkflowTasksWorkItemList is the importDevicesInBulkRequestWorkflowTasksWorkItemList definition
 type ImportDevicesInBulkRequestWorkflowTasksWorkItemList struct {
-	Command   string  `json:"command,omitempty"`   //
-	EndTime   int     `json:"endTime,omitempty"`   //
-	OutputStr string  `json:"outputStr,omitempty"` //
-	StartTime int     `json:"startTime,omitempty"` //
-	State     string  `json:"state,omitempty"`     //
-	TimeTaken float64 `json:"timeTaken,omitempty"` //
+	Command   string   `json:"command,omitempty"`   //
+	EndTime   *int     `json:"endTime,omitempty"`   //
+	OutputStr string   `json:"outputStr,omitempty"` //
+	StartTime *int     `json:"startTime,omitempty"` //
+	State     string   `json:"state,omitempty"`     //
+	TimeTaken *float64 `json:"timeTaken,omitempty"` //
 }
 
 // PreviewConfigRequest is the previewConfigRequest definition
@@ -987,19 +988,19 @@ type ResetDeviceRequestDeviceResetListConfigListConfigParameters struct {
 
 // SyncVirtualAccountDevicesRequest is the syncVirtualAccountDevicesRequest definition
 type SyncVirtualAccountDevicesRequest struct {
-	AutoSyncPeriod   int                                        `json:"autoSyncPeriod,omitempty"`   //
-	CcoUser          string                                     `json:"ccoUser,omitempty"`          //
-	Expiry           int                                        `json:"expiry,omitempty"`           //
-	LastSync         int                                        `json:"lastSync,omitempty"`         //
-	Profile          SyncVirtualAccountDevicesRequestProfile    `json:"profile,omitempty"`          //
-	SmartAccountID   string                                     `json:"smartAccountId,omitempty"`   //
-	SyncResult       SyncVirtualAccountDevicesRequestSyncResult `json:"syncResult,omitempty"`       //
-	SyncResultStr    string                                     `json:"syncResultStr,omitempty"`    //
-	SyncStartTime    int                                        `json:"syncStartTime,omitempty"`    //
-	SyncStatus       string                                     `json:"syncStatus,omitempty"`       //
-	TenantID         string                                     `json:"tenantId,omitempty"`         //
-	Token            string                                     `json:"token,omitempty"`            //
-	VirtualAccountID string                                     `json:"virtualAccountId,omitempty"` //
+	AutoSyncPeriod   *int                                        `json:"autoSyncPeriod,omitempty"`   //
+	CcoUser          string                                      `json:"ccoUser,omitempty"`          //
+	Expiry           *int                                        `json:"expiry,omitempty"`           //
+	LastSync         *int                                        `json:"lastSync,omitempty"`         //
+	Profile          *SyncVirtualAccountDevicesRequestProfile    `json:"profile,omitempty"`          //
+	SmartAccountID   string                                      `json:"smartAccountId,omitempty"`   //
+	SyncResult       *SyncVirtualAccountDevicesRequestSyncResult `json:"syncResult,omitempty"`       //
+	SyncResultStr    string                                      `json:"syncResultStr,omitempty"`    //
+	SyncStartTime    *int                                        `json:"syncStartTime,omitempty"`    //
+	SyncStatus       string                                      `json:"syncStatus,omitempty"`       //
+	TenantID         string                                      `json:"tenantId,omitempty"`         //
+	Token            string                                      `json:"token,omitempty"`            //
+	VirtualAccountID string                                      `json:"virtualAccountId,omitempty"` //
 }
 
 // SyncVirtualAccountDevicesRequestProfile is the syncVirtualAccountDevicesRequestProfile definition
@@ -1007,11 +1008,11 @@ type SyncVirtualAccountDevicesRequestProfile struct {
 	AddressFqdn string `json:"addressFqdn,omitempty"` //
 	AddressIPV4 string `json:"addressIpV4,omitempty"` //
 	Cert        string `json:"cert,omitempty"`        //
-	MakeDefault bool   `json:"makeDefault,omitempty"` //
+	MakeDefault *bool  `json:"makeDefault,omitempty"` //
 	Name        string `json:"name,omitempty"`        //
-	Port        int    `json:"port,omitempty"`        //
+	Port        *int   `json:"port,omitempty"`        //
 	ProfileID   string `json:"profileId,omitempty"`   //
-	Proxy       bool   `json:"proxy,omitempty"`       //
+	Proxy       *bool  `json:"proxy,omitempty"`       //
 }
 
 // SyncVirtualAccountDevicesRequestSyncResult is the syncVirtualAccountDevicesRequestSyncResult definition
@@ -1039,17 +1040,17 @@ type UnClaimDeviceRequestDeviceIDList []string
 
 // UpdateDeviceRequest is the updateDeviceRequest definition
 type UpdateDeviceRequest struct {
-	TypeID               string                                 `json:"id,omitempty"`                   //
-	DayZeroConfig        UpdateDeviceRequestDayZeroConfig       `json:"dayZeroConfig,omitempty"`        //
-	DayZeroConfigPreview string                                 `json:"dayZeroConfigPreview,omitempty"` //
-	DeviceInfo           UpdateDeviceRequestDeviceInfo          `json:"deviceInfo,omitempty"`           //
-	RunSummaryList       []UpdateDeviceRequestRunSummaryList    `json:"runSummaryList,omitempty"`       //
-	SystemResetWorkflow  UpdateDeviceRequestSystemResetWorkflow `json:"systemResetWorkflow,omitempty"`  //
-	SystemWorkflow       UpdateDeviceRequestSystemWorkflow      `json:"systemWorkflow,omitempty"`       //
-	TenantID             string                                 `json:"tenantId,omitempty"`             //
-	Version              float64                                `json:"version,omitempty"`              //
-	Workflow             UpdateDeviceRequestWorkflow            `json:"workflow,omitempty"`             //
-	WorkflowParameters   UpdateDeviceRequestWorkflowParameters  `json:"workflowParameters,omitempty"`   //
+	TypeID               string                                  `json:"id,omitempty"`                   //
+	DayZeroConfig        *UpdateDeviceRequestDayZeroConfig       `json:"dayZeroConfig,omitempty"`        //
+	DayZeroConfigPreview string                                  `json:"dayZeroConfigPreview,omitempty"` //
+	DeviceInfo           *UpdateDeviceRequestDeviceInfo          `json:"deviceInfo,omitempty"`           //
+	RunSummaryList       []UpdateDeviceRequestRunSummaryList     `json:"runSummaryList,omitempty"`       //
+	SystemResetWorkflow  *UpdateDeviceRequestSystemResetWorkflow `json:"systemResetWorkflow,omitempty"`  //
+	SystemWorkflow       *UpdateDeviceRequestSystemWorkflow      `json:"systemWorkflow,omitempty"`       //
+	TenantID             string                                  `json:"tenantId,omitempty"`             //
+	Version              *float64                                `json:"version,omitempty"`              //
+	Workflow             *UpdateDeviceRequestWorkflow            `json:"workflow,omitempty"`             //
+	WorkflowParameters   *UpdateDeviceRequestWorkflowParameters  `json:"workflowParameters,omitempty"`   //
 }
 
 // UpdateDeviceRequestDayZeroConfig is the updateDeviceRequestDayZeroConfig definition
@@ -1059,8 +1060,8 @@ type UpdateDeviceRequestDayZeroConfig struct {
 
 // UpdateDeviceRequestDeviceInfo is the updateDeviceRequestDeviceInfo definition
 type UpdateDeviceRequestDeviceInfo struct {
-	AAACredentials            UpdateDeviceRequestDeviceInfoAAACredentials         `json:"aaaCredentials,omitempty"`            //
-	AddedOn                   float64                                             `json:"addedOn,omitempty"`                   //
+	AAACredentials            *UpdateDeviceRequestDeviceInfoAAACredentials        `json:"aaaCredentials,omitempty"`            //
+	AddedOn                   *float64                                            `json:"addedOn,omitempty"`                   //
 	AddnMacAddrs              []string                                            `json:"addnMacAddrs,omitempty"`              //
 	AgentType                 string                                              `json:"agentType,omitempty"`                 //
 	AuthStatus                string                                              `json:"authStatus,omitempty"`                //
@@ -1073,16 +1074,16 @@ type UpdateDeviceRequestDeviceInfo struct {
 	DeviceType                string                                              `json:"deviceType,omitempty"`                //
 	FeaturesSupported         []string                                            `json:"featuresSupported,omitempty"`         //
 	FileSystemList            []UpdateDeviceRequestDeviceInfoFileSystemList       `json:"fileSystemList,omitempty"`            //
-	FirstContact              float64                                             `json:"firstContact,omitempty"`              //
+	FirstContact              *float64                                            `json:"firstContact,omitempty"`              //
 	Hostname                  string                                              `json:"hostname,omitempty"`                  //
 	HTTPHeaders               []UpdateDeviceRequestDeviceInfoHTTPHeaders          `json:"httpHeaders,omitempty"`               //
 	ImageFile                 string                                              `json:"imageFile,omitempty"`                 //
 	ImageVersion              string                                              `json:"imageVersion,omitempty"`              //
 	IPInterfaces              []UpdateDeviceRequestDeviceInfoIPInterfaces         `json:"ipInterfaces,omitempty"`              //
-	LastContact               float64                                             `json:"lastContact,omitempty"`               //
-	LastSyncTime              float64                                             `json:"lastSyncTime,omitempty"`              //
-	LastUpdateOn              float64                                             `json:"lastUpdateOn,omitempty"`              //
-	Location                  UpdateDeviceRequestDeviceInfoLocation               `json:"location,omitempty"`                  //
+	LastContact               *float64                                            `json:"lastContact,omitempty"`               //
+	LastSyncTime              *float64                                            `json:"lastSyncTime,omitempty"`              //
+	LastUpdateOn              *float64                                            `json:"lastUpdateOn,omitempty"`              //
+	Location                  *UpdateDeviceRequestDeviceInfoLocation              `json:"location,omitempty"`                  //
 	MacAddress                string                                              `json:"macAddress,omitempty"`                //
 	Mode                      string                                              `json:"mode,omitempty"`                      //
 	Name                      string                                              `json:"name,omitempty"`                      //
@@ -1090,20 +1091,20 @@ type UpdateDeviceRequestDeviceInfo struct {
 	OnbState                  string                                              `json:"onbState,omitempty"`                  //
 	Pid                       string                                              `json:"pid,omitempty"`                       //
 	PnpProfileList            []UpdateDeviceRequestDeviceInfoPnpProfileList       `json:"pnpProfileList,omitempty"`            //
-	PopulateInventory         bool                                                `json:"populateInventory,omitempty"`         //
+	PopulateInventory         *bool                                               `json:"populateInventory,omitempty"`         //
 	PreWorkflowCliOuputs      []UpdateDeviceRequestDeviceInfoPreWorkflowCliOuputs `json:"preWorkflowCliOuputs,omitempty"`      //
 	ProjectID                 string                                              `json:"projectId,omitempty"`                 //
 	ProjectName               string                                              `json:"projectName,omitempty"`               //
-	ReloadRequested           bool                                                `json:"reloadRequested,omitempty"`           //
+	ReloadRequested           *bool                                               `json:"reloadRequested,omitempty"`           //
 	SerialNumber              string                                              `json:"serialNumber,omitempty"`              //
 	SiteID                    string                                              `json:"siteId,omitempty"`                    //
 	SiteName                  string                                              `json:"siteName,omitempty"`                  //
 	SmartAccountID            string                                              `json:"smartAccountId,omitempty"`            //
 	Source                    string                                              `json:"source,omitempty"`                    //
-	Stack                     bool                                                `json:"stack,omitempty"`                     //
-	StackInfo                 UpdateDeviceRequestDeviceInfoStackInfo              `json:"stackInfo,omitempty"`                 //
+	Stack                     *bool                                               `json:"stack,omitempty"`                     //
+	StackInfo                 *UpdateDeviceRequestDeviceInfoStackInfo             `json:"stackInfo,omitempty"`                 //
 	State                     string                                              `json:"state,omitempty"`                     //
-	SudiRequired              bool                                                `json:"sudiRequired,omitempty"`              //
+	SudiRequired              *bool                                               `json:"sudiRequired,omitempty"`              //
 	Tags                      string                                              `json:"tags,omitempty"`                      //
 	UserMicNumbers            []string                                            `json:"userMicNumbers,omitempty"`            //
 	UserSudiSerialNos         []string                                            `json:"userSudiSerialNos,omitempty"`         //
@@ -1132,12 +1133,12 @@ type UpdateDeviceRequestDeviceInfoFeaturesSupported []string
 
 // UpdateDeviceRequestDeviceInfoFileSystemList is the updateDeviceRequestDeviceInfoFileSystemList definition
 type UpdateDeviceRequestDeviceInfoFileSystemList struct {
-	Freespace float64 `json:"freespace,omitempty"` //
-	Name      string  `json:"name,omitempty"`      //
-	Readable  bool    `json:"readable,omitempty"`  //
-	Size      float64 `json:"size,omitempty"`      //
-	Type      string  `json:"type,omitempty"`      //
-	Writeable bool    `json:"writeable,omitempty"` //
+	Freespace *float64 `json:"freespace,omitempty"` //
+	Name      string   `json:"name,omitempty"`      //
+	Readable  *bool    `json:"readable,omitempty"`  //
+	Size      *float64 `json:"size,omitempty"`      //
+	Type      string   `json:"type,omitempty"`      //
+	Writeable *bool    `json:"writeable,omitempty"` //
 }
 
 // UpdateDeviceRequestDeviceInfoHTTPHeaders is the updateDeviceRequestDeviceInfoHTTPHeaders definition
@@ -1182,31 +1183,31 @@ type UpdateDeviceRequestDeviceInfoNeighborLinks struct {
 
 // UpdateDeviceRequestDeviceInfoPnpProfileList is the updateDeviceRequestDeviceInfoPnpProfileList definition
 type UpdateDeviceRequestDeviceInfoPnpProfileList struct {
-	CreatedBy         string                                                       `json:"createdBy,omitempty"`         //
-	DiscoveryCreated  bool                                                         `json:"discoveryCreated,omitempty"`  //
-	PrimaryEndpoint   UpdateDeviceRequestDeviceInfoPnpProfileListPrimaryEndpoint   `json:"primaryEndpoint,omitempty"`   //
-	ProfileName       string                                                       `json:"profileName,omitempty"`       //
-	SecondaryEndpoint UpdateDeviceRequestDeviceInfoPnpProfileListSecondaryEndpoint `json:"secondaryEndpoint,omitempty"` //
+	CreatedBy         string                                                        `json:"createdBy,omitempty"`         //
+	DiscoveryCreated  *bool                                                         `json:"discoveryCreated,omitempty"`  //
+	PrimaryEndpoint   *UpdateDeviceRequestDeviceInfoPnpProfileListPrimaryEndpoint   `json:"primaryEndpoint,omitempty"`   //
+	ProfileName       string                                                        `json:"profileName,omitempty"`       //
+	SecondaryEndpoint *UpdateDeviceRequestDeviceInfoPnpProfileListSecondaryEndpoint `json:"secondaryEndpoint,omitempty"` //
 }
 
 // UpdateDeviceRequestDeviceInfoPnpProfileListPrimaryEndpoint is the updateDeviceRequestDeviceInfoPnpProfileListPrimaryEndpoint definition
 type UpdateDeviceRequestDeviceInfoPnpProfileListPrimaryEndpoint struct {
-	Certificate string  `json:"certificate,omitempty"` //
-	Fqdn        string  `json:"fqdn,omitempty"`        //
-	IPv4Address string  `json:"ipv4Address,omitempty"` //
-	IPv6Address string  `json:"ipv6Address,omitempty"` //
-	Port        float64 `json:"port,omitempty"`        //
-	Protocol    string  `json:"protocol,omitempty"`    //
+	Certificate string   `json:"certificate,omitempty"` //
+	Fqdn        string   `json:"fqdn,omitempty"`        //
+	IPv4Address string   `json:"ipv4Address,omitempty"` //
+	IPv6Address string   `json:"ipv6Address,omitempty"` //
+	Port        *float64 `json:"port,omitempty"`        //
+	Protocol    string   `json:"protocol,omitempty"`    //
 }
 
 // UpdateDeviceRequestDeviceInfoPnpProfileListSecondaryEndpoint is the updateDeviceRequestDeviceInfoPnpProfileListSecondaryEndpoint definition
 type UpdateDeviceRequestDeviceInfoPnpProfileListSecondaryEndpoint struct {
-	Certificate string  `json:"certificate,omitempty"` //
-	Fqdn        string  `json:"fqdn,omitempty"`        //
-	IPv4Address string  `json:"ipv4Address,omitempty"` //
-	IPv6Address string  `json:"ipv6Address,omitempty"` //
-	Port        float64 `json:"port,omitempty"`        //
-	Protocol    string  `json:"protocol,omitempty"`    //
+	Certificate string   `json:"certificate,omitempty"` //
+	Fqdn        string   `json:"fqdn,omitempty"`        //
+	IPv4Address string   `json:"ipv4Address,omitempty"` //
+	IPv6Address string   `json:"ipv6Address,omitempty"` //
+	Port        *float64 `json:"port,omitempty"`        //
+	Protocol    string   `json:"protocol,omitempty"`    //
 }
 
 // UpdateDeviceRequestDeviceInfoPreWorkflowCliOuputs is the updateDeviceRequestDeviceInfoPreWorkflowCliOuputs definition
@@ -1217,28 +1218,28 @@ type UpdateDeviceRequestDeviceInfoPreWorkflowCliOuputs struct {
 
 // UpdateDeviceRequestDeviceInfoStackInfo is the updateDeviceRequestDeviceInfoStackInfo definition
 type UpdateDeviceRequestDeviceInfoStackInfo struct {
-	IsFullRing             bool                                                    `json:"isFullRing,omitempty"`             //
+	IsFullRing             *bool                                                   `json:"isFullRing,omitempty"`             //
 	StackMemberList        []UpdateDeviceRequestDeviceInfoStackInfoStackMemberList `json:"stackMemberList,omitempty"`        //
 	StackRingProtocol      string                                                  `json:"stackRingProtocol,omitempty"`      //
-	SupportsStackWorkflows bool                                                    `json:"supportsStackWorkflows,omitempty"` //
-	TotalMemberCount       float64                                                 `json:"totalMemberCount,omitempty"`       //
+	SupportsStackWorkflows *bool                                                   `json:"supportsStackWorkflows,omitempty"` //
+	TotalMemberCount       *float64                                                `json:"totalMemberCount,omitempty"`       //
 	ValidLicenseLevels     []string                                                `json:"validLicenseLevels,omitempty"`     //
 }
 
 // UpdateDeviceRequestDeviceInfoStackInfoStackMemberList is the updateDeviceRequestDeviceInfoStackInfoStackMemberList definition
 type UpdateDeviceRequestDeviceInfoStackInfoStackMemberList struct {
-	HardwareVersion  string  `json:"hardwareVersion,omitempty"`  //
-	LicenseLevel     string  `json:"licenseLevel,omitempty"`     //
-	LicenseType      string  `json:"licenseType,omitempty"`      //
-	MacAddress       string  `json:"macAddress,omitempty"`       //
-	Pid              string  `json:"pid,omitempty"`              //
-	Priority         float64 `json:"priority,omitempty"`         //
-	Role             string  `json:"role,omitempty"`             //
-	SerialNumber     string  `json:"serialNumber,omitempty"`     //
-	SoftwareVersion  string  `json:"softwareVersion,omitempty"`  //
-	StackNumber      float64 `json:"stackNumber,omitempty"`      //
-	State            string  `json:"state,omitempty"`            //
-	SudiSerialNumber string  `json:"sudiSerialNumber,omitempty"` //
+	HardwareVersion  string   `json:"hardwareVersion,omitempty"`  //
+	LicenseLevel     string   `json:"licenseLevel,omitempty"`     //
+	LicenseType      string   `json:"licenseType,omitempty"`      //
+	MacAddress       string   `json:"macAddress,omitempty"`       //
+	Pid              string   `json:"pid,omitempty"`              //
+	Priority         *float64 `json:"priority,omitempty"`         //
+	Role             string   `json:"role,omitempty"`             //
+	SerialNumber     string   `json:"serialNumber,omitempty"`     //
+	SoftwareVersion  string   `json:"softwareVersion,omitempty"`  //
+	StackNumber      *float64 `json:"stackNumber,omitempty"`      //
+	State            string   `json:"state,omitempty"`            //
+	SudiSerialNumber string   `json:"sudiSerialNumber,omitempty"` //
 }
 
 // UpdateDeviceRequestDeviceInfoStackInfoValidLicenseLevels is the updateDeviceRequestDeviceInfoStackInfoValidLicenseLevels definition
@@ -1252,17 +1253,17 @@ type UpdateDeviceRequestDeviceInfoUserSudiSerialNos []string
 
 // UpdateDeviceRequestRunSummaryList is the updateDeviceRequestRunSummaryList definition
 type UpdateDeviceRequestRunSummaryList struct {
-	Details         string                                           `json:"details,omitempty"`         //
-	ErrorFlag       bool                                             `json:"errorFlag,omitempty"`       //
-	HistoryTaskInfo UpdateDeviceRequestRunSummaryListHistoryTaskInfo `json:"historyTaskInfo,omitempty"` //
-	Timestamp       float64                                          `json:"timestamp,omitempty"`       //
+	Details         string                                            `json:"details,omitempty"`         //
+	ErrorFlag       *bool                                             `json:"errorFlag,omitempty"`       //
+	HistoryTaskInfo *UpdateDeviceRequestRunSummaryListHistoryTaskInfo `json:"historyTaskInfo,omitempty"` //
+	Timestamp       *float64                                          `json:"timestamp,omitempty"`       //
 }
 
 // UpdateDeviceRequestRunSummaryListHistoryTaskInfo is the updateDeviceRequestRunSummaryListHistoryTaskInfo definition
 type UpdateDeviceRequestRunSummaryListHistoryTaskInfo struct {
 	AddnDetails  []UpdateDeviceRequestRunSummaryListHistoryTaskInfoAddnDetails  `json:"addnDetails,omitempty"`  //
 	Name         string                                                         `json:"name,omitempty"`         //
-	TimeTaken    float64                                                        `json:"timeTaken,omitempty"`    //
+	TimeTaken    *float64                                                       `json:"timeTaken,omitempty"`    //
 	Type         string                                                         `json:"type,omitempty"`         //
 	WorkItemList []UpdateDeviceRequestRunSummaryListHistoryTaskInfoWorkItemList `json:"workItemList,omitempty"` //
 }
@@ -1275,127 +1276,127 @@ type UpdateDeviceRequestRunSummaryListHistoryTaskInfoAddnDetails struct {
 
 // UpdateDeviceRequestRunSummaryListHistoryTaskInfoWorkItemList is the updateDeviceRequestRunSummaryListHistoryTaskInfoWorkItemList definition
 type UpdateDeviceRequestRunSummaryListHistoryTaskInfoWorkItemList struct {
-	Command   string  `json:"command,omitempty"`   //
-	EndTime   int     `json:"endTime,omitempty"`   //
-	OutputStr string  `json:"outputStr,omitempty"` //
-	StartTime int     `json:"startTime,omitempty"` //
-	State     string  `json:"state,omitempty"`     //
-	TimeTaken float64 `json:"timeTaken,omitempty"` //
+	Command   string   `json:"command,omitempty"`   //
+	EndTime   *int     `json:"endTime,omitempty"`   //
+	OutputStr string   `json:"outputStr,omitempty"` //
+	StartTime *int     `json:"startTime,omitempty"` //
+	State     string   `json:"state,omitempty"`     //
+	TimeTaken *float64 `json:"timeTaken,omitempty"` //
 }
 
 // UpdateDeviceRequestSystemResetWorkflow is the updateDeviceRequestSystemResetWorkflow definition
 type UpdateDeviceRequestSystemResetWorkflow struct {
 	TypeID         string                                        `json:"id,omitempty"`             //
-	AddToInventory bool                                          `json:"addToInventory,omitempty"` //
-	AddedOn        float64                                       `json:"addedOn,omitempty"`        //
+	AddToInventory *bool                                         `json:"addToInventory,omitempty"` //
+	AddedOn        *float64                                      `json:"addedOn,omitempty"`        //
 	ConfigID       string                                        `json:"configId,omitempty"`       //
-	CurrTaskIDx    float64                                       `json:"currTaskIdx,omitempty"`    //
+	CurrTaskIDx    *float64                                      `json:"currTaskIdx,omitempty"`    //
 	Description    string                                        `json:"description,omitempty"`    //
-	EndTime        int                                           `json:"endTime,omitempty"`        //
-	ExecTime       float64                                       `json:"execTime,omitempty"`       //
+	EndTime        *int                                          `json:"endTime,omitempty"`        //
+	ExecTime       *float64                                      `json:"execTime,omitempty"`       //
 	ImageID        string                                        `json:"imageId,omitempty"`        //
 	InstanceType   string                                        `json:"instanceType,omitempty"`   //
-	LastupdateOn   float64                                       `json:"lastupdateOn,omitempty"`   //
+	LastupdateOn   *float64                                      `json:"lastupdateOn,omitempty"`   //
 	Name           string                                        `json:"name,omitempty"`           //
-	StartTime      int                                           `json:"startTime,omitempty"`      //
+	StartTime      *int                                          `json:"startTime,omitempty"`      //
 	State          string                                        `json:"state,omitempty"`          //
 	Tasks          []UpdateDeviceRequestSystemResetWorkflowTasks `json:"tasks,omitempty"`          //
 	TenantID       string                                        `json:"tenantId,omitempty"`       //
 	Type           string                                        `json:"type,omitempty"`           //
 	UseState       string                                        `json:"useState,omitempty"`       //
-	Version        float64                                       `json:"version,omitempty"`        //
+	Version        *float64                                      `json:"version,omitempty"`        //
 }
 
 // UpdateDeviceRequestSystemResetWorkflowTasks is the updateDeviceRequestSystemResetWorkflowTasks definition
 type UpdateDeviceRequestSystemResetWorkflowTasks struct {
-	CurrWorkItemIDx int                                                       `json:"currWorkItemIdx,omitempty"` //
-	EndTime         int                                                       `json:"endTime,omitempty"`         //
+	CurrWorkItemIDx *int                                                      `json:"currWorkItemIdx,omitempty"` //
+	EndTime         *int                                                      `json:"endTime,omitempty"`         //
 	Name            string                                                    `json:"name,omitempty"`            //
-	StartTime       int                                                       `json:"startTime,omitempty"`       //
+	StartTime       *int                                                      `json:"startTime,omitempty"`       //
 	State           string                                                    `json:"state,omitempty"`           //
-	TaskSeqNo       int                                                       `json:"taskSeqNo"`                 //
-	TimeTaken       float64                                                   `json:"timeTaken,omitempty"`       //
+	TaskSeqNo       *int                                                      `json:"taskSeqNo"`                 //
+	TimeTaken       *float64                                                  `json:"timeTaken,omitempty"`       //
 	Type            string                                                    `json:"type,omitempty"`            //
 	WorkItemList    []UpdateDeviceRequestSystemResetWorkflowTasksWorkItemList `json:"workItemList,omitempty"`    //
 }
 
 // UpdateDeviceRequestSystemResetWorkflowTasksWorkItemList is the updateDeviceRequestSystemResetWorkflowTasksWorkItemList definition
 type UpdateDeviceRequestSystemResetWorkflowTasksWorkItemList struct {
-	Command   string  `json:"command,omitempty"`   //
-	EndTime   int     `json:"endTime,omitempty"`   //
-	OutputStr string  `json:"outputStr,omitempty"` //
-	StartTime int     `json:"startTime,omitempty"` //
-	State     string  `json:"state,omitempty"`     //
-	TimeTaken float64 `json:"timeTaken,omitempty"` //
+	Command   string   `json:"command,omitempty"`   //
+	EndTime   *int     `json:"endTime,omitempty"`   //
+	OutputStr string   `json:"outputStr,omitempty"` //
+	StartTime *int     `json:"startTime,omitempty"` //
+	State     string   `json:"state,omitempty"`     //
+	TimeTaken *float64 `json:"timeTaken,omitempty"` //
 }
 
 // UpdateDeviceRequestSystemWorkflow is the updateDeviceRequestSystemWorkflow definition
 type UpdateDeviceRequestSystemWorkflow struct {
 	TypeID         string                                   `json:"id,omitempty"`             //
-	AddToInventory bool                                     `json:"addToInventory,omitempty"` //
-	AddedOn        float64                                  `json:"addedOn,omitempty"`        //
+	AddToInventory *bool                                    `json:"addToInventory,omitempty"` //
+	AddedOn        *float64                                 `json:"addedOn,omitempty"`        //
 	ConfigID       string                                   `json:"configId,omitempty"`       //
-	CurrTaskIDx    float64                                  `json:"currTaskIdx,omitempty"`    //
+	CurrTaskIDx    *float64                                 `json:"currTaskIdx,omitempty"`    //
 	Description    string                                   `json:"description,omitempty"`    //
-	EndTime        int                                      `json:"endTime,omitempty"`        //
-	ExecTime       float64                                  `json:"execTime,omitempty"`       //
+	EndTime        *int                                     `json:"endTime,omitempty"`        //
+	ExecTime       *float64                                 `json:"execTime,omitempty"`       //
 	ImageID        string                                   `json:"imageId,omitempty"`        //
 	InstanceType   string                                   `json:"instanceType,omitempty"`   //
-	LastupdateOn   float64                                  `json:"lastupdateOn,omitempty"`   //
+	LastupdateOn   *float64                                 `json:"lastupdateOn,omitempty"`   //
 	Name           string                                   `json:"name,omitempty"`           //
-	StartTime      int                                      `json:"startTime,omitempty"`      //
+	StartTime      *int                                     `json:"startTime,omitempty"`      //
 	State          string                                   `json:"state,omitempty"`          //
 	Tasks          []UpdateDeviceRequestSystemWorkflowTasks `json:"tasks,omitempty"`          //
 	TenantID       string                                   `json:"tenantId,omitempty"`       //
 	Type           string                                   `json:"type,omitempty"`           //
 	UseState       string                                   `json:"useState,omitempty"`       //
-	Version        float64                                  `json:"version,omitempty"`        //
+	Version        *float64                                 `json:"version,omitempty"`        //
 }
 
 // UpdateDeviceRequestSystemWorkflowTasks is the updateDeviceRequestSystemWorkflowTasks definition
 type UpdateDeviceRequestSystemWorkflowTasks struct {
-	CurrWorkItemIDx int                                                  `json:"currWorkItemIdx,omitempty"` //
-	EndTime         int                                                  `json:"endTime,omitempty"`         //
+	CurrWorkItemIDx *int                                                 `json:"currWorkItemIdx,omitempty"` //
+	EndTime         *int                                                 `json:"endTime,omitempty"`         //
 	Name            string                                               `json:"name,omitempty"`            //
-	StartTime       int                                                  `json:"startTime,omitempty"`       //
+	StartTime       *int                                                 `json:"startTime,omitempty"`       //
 	State           string                                               `json:"state,omitempty"`           //
-	TaskSeqNo       int                                                  `json:"taskSeqNo"`                 //
-	TimeTaken       float64                                              `json:"timeTaken,omitempty"`       //
+	TaskSeqNo       *int                                                 `json:"taskSeqNo"`                 //
+	TimeTaken       *float64                                             `json:"timeTaken,omitempty"`       //
 	Type            string                                               `json:"type,omitempty"`            //
 	WorkItemList    []UpdateDeviceRequestSystemWorkflowTasksWorkItemList `json:"workItemList,omitempty"`    //
 }
 
 // UpdateDeviceRequestSystemWorkflowTasksWorkItemList is the updateDeviceRequestSystemWorkflowTasksWorkItemList definition
 type UpdateDeviceRequestSystemWorkflowTasksWorkItemList struct {
-	Command   string  `json:"command,omitempty"`   //
-	EndTime   int     `json:"endTime,omitempty"`   //
-	OutputStr string  `json:"outputStr,omitempty"` //
-	StartTime int     `json:"startTime,omitempty"` //
-	State     string  `json:"state,omitempty"`     //
-	TimeTaken float64 `json:"timeTaken,omitempty"` //
+	Command   string   `json:"command,omitempty"`   //
+	EndTime   *int     `json:"endTime,omitempty"`   //
+	OutputStr string   `json:"outputStr,omitempty"` //
+	StartTime *int     `json:"startTime,omitempty"` //
+	State     string   `json:"state,omitempty"`     //
+	TimeTaken *float64 `json:"timeTaken,omitempty"` //
 }
 
 // UpdateDeviceRequestWorkflow is the updateDeviceRequestWorkflow definition
 type UpdateDeviceRequestWorkflow struct {
 	TypeID         string                             `json:"id,omitempty"`             //
-	AddToInventory bool                               `json:"addToInventory,omitempty"` //
-	AddedOn        float64                            `json:"addedOn,omitempty"`        //
+	AddToInventory *bool                              `json:"addToInventory,omitempty"` //
+	AddedOn        *float64                           `json:"addedOn,omitempty"`        //
 	ConfigID       string                             `json:"configId,omitempty"`       //
-	CurrTaskIDx    float64                            `json:"currTaskIdx,omitempty"`    //
+	CurrTaskIDx    *float64                           `json:"currTaskIdx,omitempty"`    //
 	Description    string                             `json:"description,omitempty"`    //
-	EndTime        int                                `json:"endTime,omitempty"`        //
-	ExecTime       float64                            `json:"execTime,omitempty"`       //
+	EndTime        *int                               `json:"endTime,omitempty"`        //
+	ExecTime       *float64                           `json:"execTime,omitempty"`       //
 	ImageID        string                             `json:"imageId,omitempty"`        //
 	InstanceType   string                             `json:"instanceType,omitempty"`   //
-	LastupdateOn   float64                            `json:"lastupdateOn,omitempty"`   //
+	LastupdateOn   *float64                           `json:"lastupdateOn,omitempty"`   //
 	Name           string                             `json:"name,omitempty"`           //
-	StartTime      int                                `json:"startTime,omitempty"`      //
+	StartTime      *int                               `json:"startTime,omitempty"`      //
 	State          string                             `json:"state,omitempty"`          //
 	Tasks          []UpdateDeviceRequestWorkflowTasks `json:"tasks,omitempty"`          //
 	TenantID       string                             `json:"tenantId,omitempty"`       //
 	Type           string                             `json:"type,omitempty"`           //
 	UseState       string                             `json:"useState,omitempty"`       //
-	Version        float64                            `json:"version,omitempty"`        //
+	Version        *float64                           `json:"version,omitempty"`        //
 }
 
 // UpdateDeviceRequestWorkflowParameters is the updateDeviceRequestWorkflowParameters definition
@@ -1420,37 +1421,37 @@ type UpdateDeviceRequestWorkflowParametersConfigListConfigParameters struct {
 
 // UpdateDeviceRequestWorkflowTasks is the updateDeviceRequestWorkflowTasks definition
 type UpdateDeviceRequestWorkflowTasks struct {
-	CurrWorkItemIDx int                                            `json:"currWorkItemIdx,omitempty"` //
-	EndTime         int                                            `json:"endTime,omitempty"`         //
+	CurrWorkItemIDx *int                                           `json:"currWorkItemIdx,omitempty"` //
+	EndTime         *int                                           `json:"endTime,omitempty"`         //
 	Name            string                                         `json:"name,omitempty"`            //
-	StartTime       int                                            `json:"startTime,omitempty"`       //
+	StartTime       *int                                           `json:"startTime,omitempty"`       //
 	State           string                                         `json:"state,omitempty"`           //
-	TaskSeqNo       int                                            `json:"taskSeqNo"`                 //
-	TimeTaken       float64                                        `json:"timeTaken,omitempty"`       //
+	TaskSeqNo       *int                                           `json:"taskSeqNo"`                 //
+	TimeTaken       *float64                                       `json:"timeTaken,omitempty"`       //
 	Type            string                                         `json:"type,omitempty"`            //
 	WorkItemList    []UpdateDeviceRequestWorkflowTasksWorkItemList `json:"workItemList,omitempty"`    //
 }
 
 // UpdateDeviceRequestWorkflowTasksWorkItemList is the updateDeviceRequestWorkflowTasksWorkItemList definition
 type UpdateDeviceRequestWorkflowTasksWorkItemList struct {
-	Command   string  `json:"command,omitempty"`   //
-	EndTime   int     `json:"endTime,omitempty"`   //
-	OutputStr string  `json:"outputStr,omitempty"` //
-	StartTime int     `json:"startTime,omitempty"` //
-	State     string  `json:"state,omitempty"`     //
-	TimeTaken float64 `json:"timeTaken,omitempty"` //
+	Command   string   `json:"command,omitempty"`   //
+	EndTime   *int     `json:"endTime,omitempty"`   //
+	OutputStr string   `json:"outputStr,omitempty"` //
+	StartTime *int     `json:"startTime,omitempty"` //
+	State     string   `json:"state,omitempty"`     //
+	TimeTaken *float64 `json:"timeTaken,omitempty"` //
 }
 
 // UpdatePnPGlobalSettingsRequest is the updatePnPGlobalSettingsRequest definition
 type UpdatePnPGlobalSettingsRequest struct {
 	TypeID          string                                          `json:"id,omitempty"`              //
-	AAACredentials  UpdatePnPGlobalSettingsRequestAAACredentials    `json:"aaaCredentials,omitempty"`  //
-	AcceptEula      bool                                            `json:"acceptEula,omitempty"`      //
-	DefaultProfile  UpdatePnPGlobalSettingsRequestDefaultProfile    `json:"defaultProfile,omitempty"`  //
+	AAACredentials  *UpdatePnPGlobalSettingsRequestAAACredentials   `json:"aaaCredentials,omitempty"`  //
+	AcceptEula      *bool                                           `json:"acceptEula,omitempty"`      //
+	DefaultProfile  *UpdatePnPGlobalSettingsRequestDefaultProfile   `json:"defaultProfile,omitempty"`  //
 	SavaMappingList []UpdatePnPGlobalSettingsRequestSavaMappingList `json:"savaMappingList,omitempty"` //
-	TaskTimeOuts    UpdatePnPGlobalSettingsRequestTaskTimeOuts      `json:"taskTimeOuts,omitempty"`    //
+	TaskTimeOuts    *UpdatePnPGlobalSettingsRequestTaskTimeOuts     `json:"taskTimeOuts,omitempty"`    //
 	TenantID        string                                          `json:"tenantId,omitempty"`        //
-	Version         int                                             `json:"version,omitempty"`         //
+	Version         *int                                            `json:"version,omitempty"`         //
 }
 
 // UpdatePnPGlobalSettingsRequestAAACredentials is the updatePnPGlobalSettingsRequestAAACredentials definition
@@ -1464,8 +1465,8 @@ type UpdatePnPGlobalSettingsRequestDefaultProfile struct {
 	Cert          string   `json:"cert,omitempty"`          //
 	FqdnAddresses []string `json:"fqdnAddresses,omitempty"` //
 	IPAddresses   []string `json:"ipAddresses,omitempty"`   //
-	Port          int      `json:"port,omitempty"`          //
-	Proxy         bool     `json:"proxy,omitempty"`         //
+	Port          *int     `json:"port,omitempty"`          //
+	Proxy         *bool    `json:"proxy,omitempty"`         //
 }
 
 // UpdatePnPGlobalSettingsRequestDefaultProfileFqdnAddresses is the updatePnPGlobalSettingsRequestDefaultProfileFqdnAddresses definition
@@ -1476,19 +1477,19 @@ type UpdatePnPGlobalSettingsRequestDefaultProfileIPAddresses []string
 
 // UpdatePnPGlobalSettingsRequestSavaMappingList is the updatePnPGlobalSettingsRequestSavaMappingList definition
 type UpdatePnPGlobalSettingsRequestSavaMappingList struct {
-	AutoSyncPeriod   int                                                     `json:"autoSyncPeriod,omitempty"`   //
-	CcoUser          string                                                  `json:"ccoUser,omitempty"`          //
-	Expiry           int                                                     `json:"expiry,omitempty"`           //
-	LastSync         int                                                     `json:"lastSync,omitempty"`         //
-	Profile          UpdatePnPGlobalSettingsRequestSavaMappingListProfile    `json:"profile,omitempty"`          //
-	SmartAccountID   string                                                  `json:"smartAccountId,omitempty"`   //
-	SyncResult       UpdatePnPGlobalSettingsRequestSavaMappingListSyncResult `json:"syncResult,omitempty"`       //
-	SyncResultStr    string                                                  `json:"syncResultStr,omitempty"`    //
-	SyncStartTime    int                                                     `json:"syncStartTime,omitempty"`    //
-	SyncStatus       string                                                  `json:"syncStatus,omitempty"`       //
-	TenantID         string                                                  `json:"tenantId,omitempty"`         //
-	Token            string                                                  `json:"token,omitempty"`            //
-	VirtualAccountID string                                                  `json:"virtualAccountId,omitempty"` //
+	AutoSyncPeriod   *int                                                     `json:"autoSyncPeriod,omitempty"`   //
+	CcoUser          string                                                   `json:"ccoUser,omitempty"`          //
+	Expiry           *int                                                     `json:"expiry,omitempty"`           //
+	LastSync         *int                                                     `json:"lastSync,omitempty"`         //
+	Profile          *UpdatePnPGlobalSettingsRequestSavaMappingListProfile    `json:"profile,omitempty"`          //
+	SmartAccountID   string                                                   `json:"smartAccountId,omitempty"`   //
+	SyncResult       *UpdatePnPGlobalSettingsRequestSavaMappingListSyncResult `json:"syncResult,omitempty"`       //
+	SyncResultStr    string                                                   `json:"syncResultStr,omitempty"`    //
+	SyncStartTime    *int                                                     `json:"syncStartTime,omitempty"`    //
+	SyncStatus       string                                                   `json:"syncStatus,omitempty"`       //
+	TenantID         string                                                   `json:"tenantId,omitempty"`         //
+	Token            string                                                   `json:"token,omitempty"`            //
+	VirtualAccountID string                                                   `json:"virtualAccountId,omitempty"` //
 }
 
 // UpdatePnPGlobalSettingsRequestSavaMappingListProfile is the updatePnPGlobalSettingsRequestSavaMappingListProfile definition
@@ -1496,11 +1497,11 @@ type UpdatePnPGlobalSettingsRequestSavaMappingListProfile struct {
 	AddressFqdn string `json:"addressFqdn,omitempty"` //
 	AddressIPV4 string `json:"addressIpV4,omitempty"` //
 	Cert        string `json:"cert,omitempty"`        //
-	MakeDefault bool   `json:"makeDefault,omitempty"` //
+	MakeDefault *bool  `json:"makeDefault,omitempty"` //
 	Name        string `json:"name,omitempty"`        //
-	Port        int    `json:"port,omitempty"`        //
+	Port        *int   `json:"port,omitempty"`        //
 	ProfileID   string `json:"profileId,omitempty"`   //
-	Proxy       bool   `json:"proxy,omitempty"`       //
+	Proxy       *bool  `json:"proxy,omitempty"`       //
 }
 
 // UpdatePnPGlobalSettingsRequestSavaMappingListSyncResult is the updatePnPGlobalSettingsRequestSavaMappingListSyncResult definition
@@ -1520,26 +1521,26 @@ type UpdatePnPGlobalSettingsRequestSavaMappingListSyncResultSyncListDeviceSnList
 
 // UpdatePnPGlobalSettingsRequestTaskTimeOuts is the updatePnPGlobalSettingsRequestTaskTimeOuts definition
 type UpdatePnPGlobalSettingsRequestTaskTimeOuts struct {
-	ConfigTimeOut        int `json:"configTimeOut,omitempty"`        //
-	GeneralTimeOut       int `json:"generalTimeOut,omitempty"`       //
-	ImageDownloadTimeOut int `json:"imageDownloadTimeOut,omitempty"` //
+	ConfigTimeOut        *int `json:"configTimeOut,omitempty"`        //
+	GeneralTimeOut       *int `json:"generalTimeOut,omitempty"`       //
+	ImageDownloadTimeOut *int `json:"imageDownloadTimeOut,omitempty"` //
 }
 
 // UpdatePnPServerProfileRequest is the updatePnPServerProfileRequest definition
 type UpdatePnPServerProfileRequest struct {
-	AutoSyncPeriod   int                                     `json:"autoSyncPeriod,omitempty"`   //
-	CcoUser          string                                  `json:"ccoUser,omitempty"`          //
-	Expiry           int                                     `json:"expiry,omitempty"`           //
-	LastSync         int                                     `json:"lastSync,omitempty"`         //
-	Profile          UpdatePnPServerProfileRequestProfile    `json:"profile,omitempty"`          //
-	SmartAccountID   string                                  `json:"smartAccountId,omitempty"`   //
-	SyncResult       UpdatePnPServerProfileRequestSyncResult `json:"syncResult,omitempty"`       //
-	SyncResultStr    string                                  `json:"syncResultStr,omitempty"`    //
-	SyncStartTime    int                                     `json:"syncStartTime,omitempty"`    //
-	SyncStatus       string                                  `json:"syncStatus,omitempty"`       //
-	TenantID         string                                  `json:"tenantId,omitempty"`         //
-	Token            string                                  `json:"token,omitempty"`            //
-	VirtualAccountID string                                  `json:"virtualAccountId,omitempty"` //
+	AutoSyncPeriod   *int                                     `json:"autoSyncPeriod,omitempty"`   //
+	CcoUser          string                                   `json:"ccoUser,omitempty"`          //
+	Expiry           *int                                     `json:"expiry,omitempty"`           //
+	LastSync         *int                                     `json:"lastSync,omitempty"`         //
+	Profile          *UpdatePnPServerProfileRequestProfile    `json:"profile,omitempty"`          //
+	SmartAccountID   string                                   `json:"smartAccountId,omitempty"`   //
+	SyncResult       *UpdatePnPServerProfileRequestSyncResult `json:"syncResult,omitempty"`       //
+	SyncResultStr    string                                   `json:"syncResultStr,omitempty"`    //
+	SyncStartTime    *int                                     `json:"syncStartTime,omitempty"`    //
+	SyncStatus       string                                   `json:"syncStatus,omitempty"`       //
+	TenantID         string                                   `json:"tenantId,omitempty"`         //
+	Token            string                                   `json:"token,omitempty"`            //
+	VirtualAccountID string                                   `json:"virtualAccountId,omitempty"` //
 }
 
 // UpdatePnPServerProfileRequestProfile is the updatePnPServerProfileRequestProfile definition
@@ -1547,11 +1548,11 @@ type UpdatePnPServerProfileRequestProfile struct {
 	AddressFqdn string `json:"addressFqdn,omitempty"` //
 	AddressIPV4 string `json:"addressIpV4,omitempty"` //
 	Cert        string `json:"cert,omitempty"`        //
-	MakeDefault bool   `json:"makeDefault,omitempty"` //
+	MakeDefault *bool  `json:"makeDefault,omitempty"` //
 	Name        string `json:"name,omitempty"`        //
-	Port        int    `json:"port,omitempty"`        //
+	Port        *int   `json:"port,omitempty"`        //
 	ProfileID   string `json:"profileId,omitempty"`   //
-	Proxy       bool   `json:"proxy,omitempty"`       //
+	Proxy       *bool  `json:"proxy,omitempty"`       //
 }
 
 // UpdatePnPServerProfileRequestSyncResult is the updatePnPServerProfileRequestSyncResult definition
@@ -1572,47 +1573,47 @@ type UpdatePnPServerProfileRequestSyncResultSyncListDeviceSnList []string
 // UpdateWorkflowRequest is the updateWorkflowRequest definition
 type UpdateWorkflowRequest struct {
 	TypeID         string                       `json:"id,omitempty"`             //
-	AddToInventory bool                         `json:"addToInventory,omitempty"` //
-	AddedOn        float64                      `json:"addedOn,omitempty"`        //
+	AddToInventory *bool                        `json:"addToInventory,omitempty"` //
+	AddedOn        *float64                     `json:"addedOn,omitempty"`        //
 	ConfigID       string                       `json:"configId,omitempty"`       //
-	CurrTaskIDx    float64                      `json:"currTaskIdx,omitempty"`    //
+	CurrTaskIDx    *float64                     `json:"currTaskIdx,omitempty"`    //
 	Description    string                       `json:"description,omitempty"`    //
-	EndTime        int                          `json:"endTime,omitempty"`        //
-	ExecTime       float64                      `json:"execTime,omitempty"`       //
+	EndTime        *int                         `json:"endTime,omitempty"`        //
+	ExecTime       *float64                     `json:"execTime,omitempty"`       //
 	ImageID        string                       `json:"imageId,omitempty"`        //
 	InstanceType   string                       `json:"instanceType,omitempty"`   //
-	LastupdateOn   float64                      `json:"lastupdateOn,omitempty"`   //
+	LastupdateOn   *float64                     `json:"lastupdateOn,omitempty"`   //
 	Name           string                       `json:"name,omitempty"`           //
-	StartTime      int                          `json:"startTime,omitempty"`      //
+	StartTime      *int                         `json:"startTime,omitempty"`      //
 	State          string                       `json:"state,omitempty"`          //
 	Tasks          []UpdateWorkflowRequestTasks `json:"tasks,omitempty"`          //
 	TenantID       string                       `json:"tenantId,omitempty"`       //
 	Type           string                       `json:"type,omitempty"`           //
 	UseState       string                       `json:"useState,omitempty"`       //
-	Version        float64                      `json:"version,omitempty"`        //
+	Version        *float64                     `json:"version,omitempty"`        //
 }
 
 // UpdateWorkflowRequestTasks is the updateWorkflowRequestTasks definition
 type UpdateWorkflowRequestTasks struct {
-	CurrWorkItemIDx int                                      `json:"currWorkItemIdx,omitempty"` //
-	EndTime         int                                      `json:"endTime,omitempty"`         //
+	CurrWorkItemIDx *int                                     `json:"currWorkItemIdx,omitempty"` //
+	EndTime         *int                                     `json:"endTime,omitempty"`         //
 	Name            string                                   `json:"name,omitempty"`            //
-	StartTime       int                                      `json:"startTime,omitempty"`       //
+	StartTime       *int                                     `json:"startTime,omitempty"`       //
 	State           string                                   `json:"state,omitempty"`           //
-	TaskSeqNo       int                                      `json:"taskSeqNo"`                 //
-	TimeTaken       float64                                  `json:"timeTaken,omitempty"`       //
+	TaskSeqNo       *int                                     `json:"taskSeqNo"`                 //
+	TimeTaken       *float64                                 `json:"timeTaken,omitempty"`       //
 	Type            string                                   `json:"type,omitempty"`            //
 	WorkItemList    []UpdateWorkflowRequestTasksWorkItemList `json:"workItemList,omitempty"`    //
 }
 
 // UpdateWorkflowRequestTasksWorkItemList is the updateWorkflowRequestTasksWorkItemList definition
 type UpdateWorkflowRequestTasksWorkItemList struct {
-	Command   string  `json:"command,omitempty"`   //
-	EndTime   int     `json:"endTime,omitempty"`   //
-	OutputStr string  `json:"outputStr,omitempty"` //
-	StartTime int     `json:"startTime,omitempty"` //
-	State     string  `json:"state,omitempty"`     //
-	TimeTaken float64 `json:"timeTaken,omitempty"` //
+	Command   string   `json:"command,omitempty"`   //
+	EndTime   *int     `json:"endTime,omitempty"`   //
+	OutputStr string   `json:"outputStr,omitempty"` //
+	StartTime *int     `json:"startTime,omitempty"` //
+	State     string   `json:"state,omitempty"`     //
+	TimeTaken *float64 `json:"timeTaken,omitempty"` //
 }
 
 // AddAWorkflowResponse is the addAWorkflowResponse definition
@@ -3065,7 +3066,7 @@ type GetDeviceHistoryResponseResponseHistoryTaskInfoWorkItemList struct {
 
 // GetPnPGlobalSettingsResponse is the getPnPGlobalSettingsResponse definition
 type GetPnPGlobalSettingsResponse struct {
-	TypeID          string                                        `json:"id,omitempty"`              //
+	TypeID          string                                        `json:"_id,omitempty"`             //
 	AAACredentials  GetPnPGlobalSettingsResponseAAACredentials    `json:"aaaCredentials,omitempty"`  //
 	AcceptEula      bool                                          `json:"acceptEula,omitempty"`      //
 	DefaultProfile  GetPnPGlobalSettingsResponseDefaultProfile    `json:"defaultProfile,omitempty"`  //
@@ -4614,7 +4615,7 @@ type UpdateDeviceResponseWorkflowTasksWorkItemList struct {
 
 // UpdatePnPGlobalSettingsResponse is the updatePnPGlobalSettingsResponse definition
 type UpdatePnPGlobalSettingsResponse struct {
-	TypeID          string                                           `json:"id,omitempty"`              //
+	TypeID          string                                           `json:"_id,omitempty"`             //
 	AAACredentials  UpdatePnPGlobalSettingsResponseAAACredentials    `json:"aaaCredentials,omitempty"`  //
 	AcceptEula      bool                                             `json:"acceptEula,omitempty"`      //
 	DefaultProfile  UpdatePnPGlobalSettingsResponseDefaultProfile    `json:"defaultProfile,omitempty"`  //
@@ -4791,10 +4792,16 @@ type UpdateWorkflowResponseTasksWorkItemList struct {
 /* Adds a PnP Workflow along with the relevant tasks in the workflow into the PnP database
  */
 func (s *DeviceOnboardingPnPService) AddAWorkflow(addAWorkflowRequest *AddAWorkflowRequest) (*AddAWorkflowResponse, *resty.Response, error) {
+	return s.AddAWorkflowWithContext(context.Background(), addAWorkflowRequest)
+}
+
+// AddAWorkflowWithContext is like AddAWorkflow but sends the request with ctx for cancellation and deadlines.
+func (s *DeviceOnboardingPnPService) AddAWorkflowWithContext(ctx context.Context, addAWorkflowRequest *AddAWorkflowRequest) (*AddAWorkflowResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/onboarding/pnp-workflow"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(addAWorkflowRequest).
 		SetResult(&AddAWorkflowResponse{}).
 		SetError(&Error{}).
@@ -4805,7 +4812,7 @@ func (s *DeviceOnboardingPnPService) AddAWorkflow(addAWorkflowRequest *AddAWorkf
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation addAWorkflow")
+		return nil, response, newAPIError("addAWorkflow", response)
 	}
 
 	result := response.Result().(*AddAWorkflowResponse)
@@ -4816,10 +4823,16 @@ func (s *DeviceOnboardingPnPService) AddAWorkflow(addAWorkflowRequest *AddAWorkf
 /* Adds a device to the PnP database.
  */
 func (s *DeviceOnboardingPnPService) AddDeviceToPnpDatabase(addDeviceToPnpDatabaseRequest *AddDeviceToPnpDatabaseRequest) (*AddDeviceToPnpDatabaseResponse, *resty.Response, error) {
+	return s.AddDeviceToPnpDatabaseWithContext(context.Background(), addDeviceToPnpDatabaseRequest)
+}
+
+// AddDeviceToPnpDatabaseWithContext is like AddDeviceToPnpDatabase but sends the request with ctx for cancellation and deadlines.
+func (s *DeviceOnboardingPnPService) AddDeviceToPnpDatabaseWithContext(ctx context.Context, addDeviceToPnpDatabaseRequest *AddDeviceToPnpDatabaseRequest) (*AddDeviceToPnpDatabaseResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/onboarding/pnp-device"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(addDeviceToPnpDatabaseRequest).
 		SetResult(&AddDeviceToPnpDatabaseResponse{}).
 		SetError(&Error{}).
@@ -4830,7 +4843,7 @@ func (s *DeviceOnboardingPnPService) AddDeviceToPnpDatabase(addDeviceToPnpDataba
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation addDeviceToPnpDatabase")
+		return nil, response, newAPIError("addDeviceToPnpDatabase", response)
 	}
 
 	result := response.Result().(*AddDeviceToPnpDatabaseResponse)
@@ -4841,10 +4854,16 @@ func (s *DeviceOnboardingPnPService) AddDeviceToPnpDatabase(addDeviceToPnpDataba
 /* Registers a Smart Account, Virtual Account and the relevant server profile info with the PnP System & database. The devices present in the registered virtual account are synced with the PnP database as well. The response payload returns the new profile
  */
 func (s *DeviceOnboardingPnPService) AddVirtualAccount(addVirtualAccountRequest *AddVirtualAccountRequest) (*AddVirtualAccountResponse, *resty.Response, error) {
+	return s.AddVirtualAccountWithContext(context.Background(), addVirtualAccountRequest)
+}
+
+// AddVirtualAccountWithContext is like AddVirtualAccount but sends the request with ctx for cancellation and deadlines.
+func (s *DeviceOnboardingPnPService) AddVirtualAccountWithContext(ctx context.Context, addVirtualAccountRequest *AddVirtualAccountRequest) (*AddVirtualAccountResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/onboarding/pnp-settings/savacct"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(addVirtualAccountRequest).
 		SetResult(&AddVirtualAccountResponse{}).
 		SetError(&Error{}).
@@ -4855,7 +4874,7 @@ func (s *DeviceOnboardingPnPService) AddVirtualAccount(addVirtualAccountRequest
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation addVirtualAccount")
+		return nil, response, newAPIError("addVirtualAccount", response)
 	}
 
 	result := response.Result().(*AddVirtualAccountResponse)
@@ -4866,10 +4885,16 @@ func (s *DeviceOnboardingPnPService) AddVirtualAccount(addVirtualAccountRequest
 /* Claim a device based on DNA-C Site based design process. Different parameters are required for different device platforms.
  */
 func (s *DeviceOnboardingPnPService) ClaimADeviceToASite(claimADeviceToASiteRequest *ClaimADeviceToASiteRequest) (*ClaimADeviceToASiteResponse, *resty.Response, error) {
+	return s.ClaimADeviceToASiteWithContext(context.Background(), claimADeviceToASiteRequest)
+}
+
+// ClaimADeviceToASiteWithContext is like ClaimADeviceToASite but sends the request with ctx for cancellation and deadlines.
+func (s *DeviceOnboardingPnPService) ClaimADeviceToASiteWithContext(ctx context.Context, claimADeviceToASiteRequest *ClaimADeviceToASiteRequest) (*ClaimADeviceToASiteResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/onboarding/pnp-device/site-claim"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(claimADeviceToASiteRequest).
 		SetResult(&ClaimADeviceToASiteResponse{}).
 		SetError(&Error{}).
@@ -4880,7 +4905,7 @@ func (s *DeviceOnboardingPnPService) ClaimADeviceToASite(claimADeviceToASiteRequ
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation claimADeviceToASite")
+		return nil, response, newAPIError("claimADeviceToASite", response)
 	}
 
 	result := response.Result().(*ClaimADeviceToASiteResponse)
@@ -4891,10 +4916,16 @@ func (s *DeviceOnboardingPnPService) ClaimADeviceToASite(claimADeviceToASiteRequ
 /* Claims one of more devices with specified workflow
  */
 func (s *DeviceOnboardingPnPService) ClaimDevice(claimDeviceRequest *ClaimDeviceRequest) (*ClaimDeviceResponse, *resty.Response, error) {
+	return s.ClaimDeviceWithContext(context.Background(), claimDeviceRequest)
+}
+
+// ClaimDeviceWithContext is like ClaimDevice but sends the request with ctx for cancellation and deadlines.
+func (s *DeviceOnboardingPnPService) ClaimDeviceWithContext(ctx context.Context, claimDeviceRequest *ClaimDeviceRequest) (*ClaimDeviceResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/onboarding/pnp-device/claim"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(claimDeviceRequest).
 		SetResult(&ClaimDeviceResponse{}).
 		SetError(&Error{}).
@@ -4905,7 +4936,7 @@ func (s *DeviceOnboardingPnPService) ClaimDevice(claimDeviceRequest *ClaimDevice
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation claimDevice")
+		return nil, response, newAPIError("claimDevice", response)
 	}
 
 	result := response.Result().(*ClaimDeviceResponse)
@@ -4917,11 +4948,17 @@ func (s *DeviceOnboardingPnPService) ClaimDevice(claimDeviceRequest *ClaimDevice
 @param id id
 */
 func (s *DeviceOnboardingPnPService) DeleteDeviceByIDFromPnP(id string) (*DeleteDeviceByIDFromPnPResponse, *resty.Response, error) {
+	return s.DeleteDeviceByIDFromPnPWithContext(context.Background(), id)
+}
+
+// DeleteDeviceByIDFromPnPWithContext is like DeleteDeviceByIDFromPnP but sends the request with ctx for cancellation and deadlines.
+func (s *DeviceOnboardingPnPService) DeleteDeviceByIDFromPnPWithContext(ctx context.Context, id string) (*DeleteDeviceByIDFromPnPResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/onboarding/pnp-device/{id}"
 	path = strings.Replace(path, "{"+"id"+"}", fmt.Sprintf("%v", id), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&DeleteDeviceByIDFromPnPResponse{}).
 		SetError(&Error{}).
 		Delete(path)
@@ -4931,7 +4968,7 @@ func (s *DeviceOnboardingPnPService) DeleteDeviceByIDFromPnP(id string) (*Delete
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation deleteDeviceByIdFromPnP")
+		return nil, response, newAPIError("deleteDeviceByIdFromPnP", response)
 	}
 
 	result := response.Result().(*DeleteDeviceByIDFromPnPResponse)
@@ -4943,11 +4980,17 @@ func (s *DeviceOnboardingPnPService) DeleteDeviceByIDFromPnP(id string) (*Delete
 @param id id
 */
 func (s *DeviceOnboardingPnPService) DeleteWorkflowByID(id string) (*DeleteWorkflowByIDResponse, *resty.Response, error) {
+	return s.DeleteWorkflowByIDWithContext(context.Background(), id)
+}
+
+// DeleteWorkflowByIDWithContext is like DeleteWorkflowByID but sends the request with ctx for cancellation and deadlines.
+func (s *DeviceOnboardingPnPService) DeleteWorkflowByIDWithContext(ctx context.Context, id string) (*DeleteWorkflowByIDResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/onboarding/pnp-workflow/{id}"
 	path = strings.Replace(path, "{"+"id"+"}", fmt.Sprintf("%v", id), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&DeleteWorkflowByIDResponse{}).
 		SetError(&Error{}).
 		Delete(path)
@@ -4957,7 +5000,7 @@ func (s *DeviceOnboardingPnPService) DeleteWorkflowByID(id string) (*DeleteWorkf
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation deleteWorkflowById")
+		return nil, response, newAPIError("deleteWorkflowById", response)
 	}
 
 	result := response.Result().(*DeleteWorkflowByIDResponse)
@@ -4976,12 +5019,18 @@ type DeregisterVirtualAccountQueryParams struct {
 @param name Virtual Account Name
 */
 func (s *DeviceOnboardingPnPService) DeregisterVirtualAccount(deregisterVirtualAccountQueryParams *DeregisterVirtualAccountQueryParams) (*DeregisterVirtualAccountResponse, *resty.Response, error) {
+	return s.DeregisterVirtualAccountWithContext(context.Background(), deregisterVirtualAccountQueryParams)
+}
+
+// DeregisterVirtualAccountWithContext is like DeregisterVirtualAccount but sends the request with ctx for cancellation and deadlines.
+func (s *DeviceOnboardingPnPService) DeregisterVirtualAccountWithContext(ctx context.Context, deregisterVirtualAccountQueryParams *DeregisterVirtualAccountQueryParams) (*DeregisterVirtualAccountResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/onboarding/pnp-settings/vacct"
 
 	queryString, _ := query.Values(deregisterVirtualAccountQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&DeregisterVirtualAccountResponse{}).
 		SetError(&Error{}).
@@ -4992,7 +5041,7 @@ func (s *DeviceOnboardingPnPService) DeregisterVirtualAccount(deregisterVirtualA
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation deregisterVirtualAccount")
+		return nil, response, newAPIError("deregisterVirtualAccount", response)
 	}
 
 	result := response.Result().(*DeregisterVirtualAccountResponse)
@@ -5004,11 +5053,17 @@ func (s *DeviceOnboardingPnPService) DeregisterVirtualAccount(deregisterVirtualA
 @param id id
 */
 func (s *DeviceOnboardingPnPService) GetDeviceByID(id string) (*GetDeviceByIDResponse, *resty.Response, error) {
+	return s.GetDeviceByIDWithContext(context.Background(), id)
+}
+
+// GetDeviceByIDWithContext is like GetDeviceByID but sends the request with ctx for cancellation and deadlines.
+func (s *DeviceOnboardingPnPService) GetDeviceByIDWithContext(ctx context.Context, id string) (*GetDeviceByIDResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/onboarding/pnp-device/{id}"
 	path = strings.Replace(path, "{"+"id"+"}", fmt.Sprintf("%v", id), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&GetDeviceByIDResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -5018,7 +5073,7 @@ func (s *DeviceOnboardingPnPService) GetDeviceByID(id string) (*GetDeviceByIDRes
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getDeviceById")
+		return nil, response, newAPIError("getDeviceById", response)
 	}
 
 	result := response.Result().(*GetDeviceByIDResponse)
@@ -5039,12 +5094,18 @@ type GetDeviceHistoryQueryParams struct {
 @param sortOrder Sort Order Ascending (asc) or Descending (des)
 */
 func (s *DeviceOnboardingPnPService) GetDeviceHistory(getDeviceHistoryQueryParams *GetDeviceHistoryQueryParams) (*GetDeviceHistoryResponse, *resty.Response, error) {
+	return s.GetDeviceHistoryWithContext(context.Background(), getDeviceHistoryQueryParams)
+}
+
+// GetDeviceHistoryWithContext is like GetDeviceHistory but sends the request with ctx for cancellation and deadlines.
+func (s *DeviceOnboardingPnPService) GetDeviceHistoryWithContext(ctx context.Context, getDeviceHistoryQueryParams *GetDeviceHistoryQueryParams) (*GetDeviceHistoryResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/onboarding/pnp-device/history"
 
 	queryString, _ := query.Values(getDeviceHistoryQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetDeviceHistoryResponse{}).
 		SetError(&Error{}).
@@ -5055,7 +5116,7 @@ func (s *DeviceOnboardingPnPService) GetDeviceHistory(getDeviceHistoryQueryParam
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getDeviceHistory")
+		return nil, response, newAPIError("getDeviceHistory", response)
 	}
 
 	result := response.Result().(*GetDeviceHistoryResponse)
@@ -5066,10 +5127,16 @@ func (s *DeviceOnboardingPnPService) GetDeviceHistory(getDeviceHistoryQueryParam
 /* Returns global PnP settings of the user
  */
 func (s *DeviceOnboardingPnPService) GetPnPGlobalSettings() (*GetPnPGlobalSettingsResponse, *resty.Response, error) {
+	return s.GetPnPGlobalSettingsWithContext(context.Background())
+}
+
+// GetPnPGlobalSettingsWithContext is like GetPnPGlobalSettings but sends the request with ctx for cancellation and deadlines.
+func (s *DeviceOnboardingPnPService) GetPnPGlobalSettingsWithContext(ctx context.Context) (*GetPnPGlobalSettingsResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/onboarding/pnp-settings"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&GetPnPGlobalSettingsResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -5079,7 +5146,7 @@ func (s *DeviceOnboardingPnPService) GetPnPGlobalSettings() (*GetPnPGlobalSettin
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getPnPGlobalSettings")
+		return nil, response, newAPIError("getPnPGlobalSettings", response)
 	}
 
 	result := response.Result().(*GetPnPGlobalSettingsResponse)
@@ -5122,12 +5189,18 @@ type GetPnpDeviceCountQueryParams struct {
 @param lastContact Device Has Contacted lastContact > 0
 */
 func (s *DeviceOnboardingPnPService) GetPnpDeviceCount(getPnpDeviceCountQueryParams *GetPnpDeviceCountQueryParams) (*GetPnpDeviceCountResponse, *resty.Response, error) {
+	return s.GetPnpDeviceCountWithContext(context.Background(), getPnpDeviceCountQueryParams)
+}
+
+// GetPnpDeviceCountWithContext is like GetPnpDeviceCount but sends the request with ctx for cancellation and deadlines.
+func (s *DeviceOnboardingPnPService) GetPnpDeviceCountWithContext(ctx context.Context, getPnpDeviceCountQueryParams *GetPnpDeviceCountQueryParams) (*GetPnpDeviceCountResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/onboarding/pnp-device/count"
 
 	queryString, _ := query.Values(getPnpDeviceCountQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetPnpDeviceCountResponse{}).
 		SetError(&Error{}).
@@ -5138,7 +5211,7 @@ func (s *DeviceOnboardingPnPService) GetPnpDeviceCount(getPnpDeviceCountQueryPar
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getPnpDeviceCount")
+		return nil, response, newAPIError("getPnpDeviceCount", response)
 	}
 
 	result := response.Result().(*GetPnpDeviceCountResponse)
@@ -5195,12 +5268,18 @@ type GetPnpDeviceListQueryParams struct {
 @param siteName Device Site Name
 */
 func (s *DeviceOnboardingPnPService) GetPnpDeviceList(getPnpDeviceListQueryParams *GetPnpDeviceListQueryParams) (*[]GetPnpDeviceListResponse, *resty.Response, error) {
+	return s.GetPnpDeviceListWithContext(context.Background(), getPnpDeviceListQueryParams)
+}
+
+// GetPnpDeviceListWithContext is like GetPnpDeviceList but sends the request with ctx for cancellation and deadlines.
+func (s *DeviceOnboardingPnPService) GetPnpDeviceListWithContext(ctx context.Context, getPnpDeviceListQueryParams *GetPnpDeviceListQueryParams) (*[]GetPnpDeviceListResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/onboarding/pnp-device"
 
 	queryString, _ := query.Values(getPnpDeviceListQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&[]GetPnpDeviceListResponse{}).
 		SetError(&Error{}).
@@ -5211,7 +5290,7 @@ func (s *DeviceOnboardingPnPService) GetPnpDeviceList(getPnpDeviceListQueryParam
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getPnpDeviceList")
+		return nil, response, newAPIError("getPnpDeviceList", response)
 	}
 
 	result := response.Result().(*[]GetPnpDeviceListResponse)
@@ -5222,10 +5301,16 @@ func (s *DeviceOnboardingPnPService) GetPnpDeviceList(getPnpDeviceListQueryParam
 /* Returns the list of Smart Account domains
  */
 func (s *DeviceOnboardingPnPService) GetSmartAccountList() (*GetSmartAccountListResponse, *resty.Response, error) {
+	return s.GetSmartAccountListWithContext(context.Background())
+}
+
+// GetSmartAccountListWithContext is like GetSmartAccountList but sends the request with ctx for cancellation and deadlines.
+func (s *DeviceOnboardingPnPService) GetSmartAccountListWithContext(ctx context.Context) (*GetSmartAccountListResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/onboarding/pnp-settings/sacct"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&GetSmartAccountListResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -5235,7 +5320,7 @@ func (s *DeviceOnboardingPnPService) GetSmartAccountList() (*GetSmartAccountList
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getSmartAccountList")
+		return nil, response, newAPIError("getSmartAccountList", response)
 	}
 
 	result := response.Result().(*GetSmartAccountListResponse)
@@ -5248,12 +5333,18 @@ func (s *DeviceOnboardingPnPService) GetSmartAccountList() (*GetSmartAccountList
 @param name Virtual Account Name
 */
 func (s *DeviceOnboardingPnPService) GetSyncResultForVirtualAccount(domain string, name string) (*GetSyncResultForVirtualAccountResponse, *resty.Response, error) {
+	return s.GetSyncResultForVirtualAccountWithContext(context.Background(), domain, name)
+}
+
+// GetSyncResultForVirtualAccountWithContext is like GetSyncResultForVirtualAccount but sends the request with ctx for cancellation and deadlines.
+func (s *DeviceOnboardingPnPService) GetSyncResultForVirtualAccountWithContext(ctx context.Context, domain string, name string) (*GetSyncResultForVirtualAccountResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/onboarding/pnp-device/sacct/{domain}/vacct/{name}/sync-result"
 	path = strings.Replace(path, "{"+"domain"+"}", fmt.Sprintf("%v", domain), -1)
 	path = strings.Replace(path, "{"+"name"+"}", fmt.Sprintf("%v", name), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&GetSyncResultForVirtualAccountResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -5263,7 +5354,7 @@ func (s *DeviceOnboardingPnPService) GetSyncResultForVirtualAccount(domain strin
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getSyncResultForVirtualAccount")
+		return nil, response, newAPIError("getSyncResultForVirtualAccount", response)
 	}
 
 	result := response.Result().(*GetSyncResultForVirtualAccountResponse)
@@ -5275,11 +5366,17 @@ func (s *DeviceOnboardingPnPService) GetSyncResultForVirtualAccount(domain strin
 @param domain Smart Account Domain
 */
 func (s *DeviceOnboardingPnPService) GetVirtualAccountList(domain string) (*GetVirtualAccountListResponse, *resty.Response, error) {
+	return s.GetVirtualAccountListWithContext(context.Background(), domain)
+}
+
+// GetVirtualAccountListWithContext is like GetVirtualAccountList but sends the request with ctx for cancellation and deadlines.
+func (s *DeviceOnboardingPnPService) GetVirtualAccountListWithContext(ctx context.Context, domain string) (*GetVirtualAccountListResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/onboarding/pnp-settings/sacct/{domain}/vacct"
 	path = strings.Replace(path, "{"+"domain"+"}", fmt.Sprintf("%v", domain), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&GetVirtualAccountListResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -5289,7 +5386,7 @@ func (s *DeviceOnboardingPnPService) GetVirtualAccountList(domain string) (*GetV
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getVirtualAccountList")
+		return nil, response, newAPIError("getVirtualAccountList", response)
 	}
 
 	result := response.Result().(*GetVirtualAccountListResponse)
@@ -5301,11 +5398,17 @@ func (s *DeviceOnboardingPnPService) GetVirtualAccountList(domain string) (*GetV
 @param id id
 */
 func (s *DeviceOnboardingPnPService) GetWorkflowByID(id string) (*GetWorkflowByIDResponse, *resty.Response, error) {
+	return s.GetWorkflowByIDWithContext(context.Background(), id)
+}
+
+// GetWorkflowByIDWithContext is like GetWorkflowByID but sends the request with ctx for cancellation and deadlines.
+func (s *DeviceOnboardingPnPService) GetWorkflowByIDWithContext(ctx context.Context, id string) (*GetWorkflowByIDResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/onboarding/pnp-workflow/{id}"
 	path = strings.Replace(path, "{"+"id"+"}", fmt.Sprintf("%v", id), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&GetWorkflowByIDResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -5315,7 +5418,7 @@ func (s *DeviceOnboardingPnPService) GetWorkflowByID(id string) (*GetWorkflowByI
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getWorkflowById")
+		return nil, response, newAPIError("getWorkflowById", response)
 	}
 
 	result := response.Result().(*GetWorkflowByIDResponse)
@@ -5332,12 +5435,18 @@ type GetWorkflowCountQueryParams struct {
 @param name Workflow Name
 */
 func (s *DeviceOnboardingPnPService) GetWorkflowCount(getWorkflowCountQueryParams *GetWorkflowCountQueryParams) (*GetWorkflowCountResponse, *resty.Response, error) {
+	return s.GetWorkflowCountWithContext(context.Background(), getWorkflowCountQueryParams)
+}
+
+// GetWorkflowCountWithContext is like GetWorkflowCount but sends the request with ctx for cancellation and deadlines.
+func (s *DeviceOnboardingPnPService) GetWorkflowCountWithContext(ctx context.Context, getWorkflowCountQueryParams *GetWorkflowCountQueryParams) (*GetWorkflowCountResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/onboarding/pnp-workflow/count"
 
 	queryString, _ := query.Values(getWorkflowCountQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetWorkflowCountResponse{}).
 		SetError(&Error{}).
@@ -5348,7 +5457,7 @@ func (s *DeviceOnboardingPnPService) GetWorkflowCount(getWorkflowCountQueryParam
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getWorkflowCount")
+		return nil, response, newAPIError("getWorkflowCount", response)
 	}
 
 	result := response.Result().(*GetWorkflowCountResponse)
@@ -5375,12 +5484,18 @@ type GetWorkflowsQueryParams struct {
 @param name Workflow Name
 */
 func (s *DeviceOnboardingPnPService) GetWorkflows(getWorkflowsQueryParams *GetWorkflowsQueryParams) (*[]GetWorkflowsResponse, *resty.Response, error) {
+	return s.GetWorkflowsWithContext(context.Background(), getWorkflowsQueryParams)
+}
+
+// GetWorkflowsWithContext is like GetWorkflows but sends the request with ctx for cancellation and deadlines.
+func (s *DeviceOnboardingPnPService) GetWorkflowsWithContext(ctx context.Context, getWorkflowsQueryParams *GetWorkflowsQueryParams) (*[]GetWorkflowsResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/onboarding/pnp-workflow"
 
 	queryString, _ := query.Values(getWorkflowsQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&[]GetWorkflowsResponse{}).
 		SetError(&Error{}).
@@ -5391,7 +5506,7 @@ func (s *DeviceOnboardingPnPService) GetWorkflows(getWorkflowsQueryParams *GetWo
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getWorkflows")
+		return nil, response, newAPIError("getWorkflows", response)
 	}
 
 	result := response.Result().(*[]GetWorkflowsResponse)
@@ -5402,10 +5517,16 @@ func (s *DeviceOnboardingPnPService) GetWorkflows(getWorkflowsQueryParams *GetWo
 /* Add devices to PnP in bulk
  */
 func (s *DeviceOnboardingPnPService) ImportDevicesInBulk(importDevicesInBulkRequest *ImportDevicesInBulkRequest) (*ImportDevicesInBulkResponse, *resty.Response, error) {
+	return s.ImportDevicesInBulkWithContext(context.Background(), importDevicesInBulkRequest)
+}
+
+// ImportDevicesInBulkWithContext is like ImportDevicesInBulk but sends the request with ctx for cancellation and deadlines.
+func (s *DeviceOnboardingPnPService) ImportDevicesInBulkWithContext(ctx context.Context, importDevicesInBulkRequest *ImportDevicesInBulkRequest) (*ImportDevicesInBulkResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/onboarding/pnp-device/import"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(importDevicesInBulkRequest).
 		SetResult(&ImportDevicesInBulkResponse{}).
 		SetError(&Error{}).
@@ -5416,7 +5537,7 @@ func (s *DeviceOnboardingPnPService) ImportDevicesInBulk(importDevicesInBulkRequ
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation importDevicesInBulk")
+		return nil, response, newAPIError("importDevicesInBulk", response)
 	}
 
 	result := response.Result().(*ImportDevicesInBulkResponse)
@@ -5427,10 +5548,16 @@ func (s *DeviceOnboardingPnPService) ImportDevicesInBulk(importDevicesInBulkRequ
 /* Triggers a preview for site-based Day 0 Configuration
  */
 func (s *DeviceOnboardingPnPService) PreviewConfig(previewConfigRequest *PreviewConfigRequest) (*PreviewConfigResponse, *resty.Response, error) {
+	return s.PreviewConfigWithContext(context.Background(), previewConfigRequest)
+}
+
+// PreviewConfigWithContext is like PreviewConfig but sends the request with ctx for cancellation and deadlines.
+func (s *DeviceOnboardingPnPService) PreviewConfigWithContext(ctx context.Context, previewConfigRequest *PreviewConfigRequest) (*PreviewConfigResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/onboarding/pnp-device/site-config-preview"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(previewConfigRequest).
 		SetResult(&PreviewConfigResponse{}).
 		SetError(&Error{}).
@@ -5441,7 +5568,7 @@ func (s *DeviceOnboardingPnPService) PreviewConfig(previewConfigRequest *Preview
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation previewConfig")
+		return nil, response, newAPIError("previewConfig", response)
 	}
 
 	result := response.Result().(*PreviewConfigResponse)
@@ -5452,10 +5579,16 @@ func (s *DeviceOnboardingPnPService) PreviewConfig(previewConfigRequest *Preview
 /* Recovers a device from a Workflow Execution Error state
  */
 func (s *DeviceOnboardingPnPService) ResetDevice(resetDeviceRequest *ResetDeviceRequest) (*ResetDeviceResponse, *resty.Response, error) {
+	return s.ResetDeviceWithContext(context.Background(), resetDeviceRequest)
+}
+
+// ResetDeviceWithContext is like ResetDevice but sends the request with ctx for cancellation and deadlines.
+func (s *DeviceOnboardingPnPService) ResetDeviceWithContext(ctx context.Context, resetDeviceRequest *ResetDeviceRequest) (*ResetDeviceResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/onboarding/pnp-device/reset"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(resetDeviceRequest).
 		SetResult(&ResetDeviceResponse{}).
 		SetError(&Error{}).
@@ -5466,7 +5599,7 @@ func (s *DeviceOnboardingPnPService) ResetDevice(resetDeviceRequest *ResetDevice
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation resetDevice")
+		return nil, response, newAPIError("resetDevice", response)
 	}
 
 	result := response.Result().(*ResetDeviceResponse)
@@ -5477,10 +5610,16 @@ func (s *DeviceOnboardingPnPService) ResetDevice(resetDeviceRequest *ResetDevice
 /* Synchronizes the device info from the given smart account & virtual account with the PnP database. The response payload returns a list of synced devices
  */
 func (s *DeviceOnboardingPnPService) SyncVirtualAccountDevices(syncVirtualAccountDevicesRequest *SyncVirtualAccountDevicesRequest) (*SyncVirtualAccountDevicesResponse, *resty.Response, error) {
+	return s.SyncVirtualAccountDevicesWithContext(context.Background(), syncVirtualAccountDevicesRequest)
+}
+
+// SyncVirtualAccountDevicesWithContext is like SyncVirtualAccountDevices but sends the request with ctx for cancellation and deadlines.
+func (s *DeviceOnboardingPnPService) SyncVirtualAccountDevicesWithContext(ctx context.Context, syncVirtualAccountDevicesRequest *SyncVirtualAccountDevicesRequest) (*SyncVirtualAccountDevicesResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/onboarding/pnp-device/vacct-sync"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(syncVirtualAccountDevicesRequest).
 		SetResult(&SyncVirtualAccountDevicesResponse{}).
 		SetError(&Error{}).
@@ -5491,7 +5630,7 @@ func (s *DeviceOnboardingPnPService) SyncVirtualAccountDevices(syncVirtualAccoun
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation syncVirtualAccountDevices")
+		return nil, response, newAPIError("syncVirtualAccountDevices", response)
 	}
 
 	result := response.Result().(*SyncVirtualAccountDevicesResponse)
@@ -5502,10 +5641,16 @@ func (s *DeviceOnboardingPnPService) SyncVirtualAccountDevices(syncVirtualAccoun
 /* UnClaims one of more devices with specified workflow
  */
 func (s *DeviceOnboardingPnPService) UnClaimDevice(unClaimDeviceRequest *UnClaimDeviceRequest) (*UnClaimDeviceResponse, *resty.Response, error) {
+	return s.UnClaimDeviceWithContext(context.Background(), unClaimDeviceRequest)
+}
+
+// UnClaimDeviceWithContext is like UnClaimDevice but sends the request with ctx for cancellation and deadlines.
+func (s *DeviceOnboardingPnPService) UnClaimDeviceWithContext(ctx context.Context, unClaimDeviceRequest *UnClaimDeviceRequest) (*UnClaimDeviceResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/onboarding/pnp-device/unclaim"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(unClaimDeviceRequest).
 		SetResult(&UnClaimDeviceResponse{}).
 		SetError(&Error{}).
@@ -5516,7 +5661,7 @@ func (s *DeviceOnboardingPnPService) UnClaimDevice(unClaimDeviceRequest *UnClaim
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation unClaimDevice")
+		return nil, response, newAPIError("unClaimDevice", response)
 	}
 
 	result := response.Result().(*UnClaimDeviceResponse)
@@ -5528,11 +5673,17 @@ func (s *DeviceOnboardingPnPService) UnClaimDevice(unClaimDeviceRequest *UnClaim
 @param id id
 */
 func (s *DeviceOnboardingPnPService) UpdateDevice(id string, updateDeviceRequest *UpdateDeviceRequest) (*UpdateDeviceResponse, *resty.Response, error) {
+	return s.UpdateDeviceWithContext(context.Background(), id, updateDeviceRequest)
+}
+
+// UpdateDeviceWithContext is like UpdateDevice but sends the request with ctx for cancellation and deadlines.
+func (s *DeviceOnboardingPnPService) UpdateDeviceWithContext(ctx context.Context, id string, updateDeviceRequest *UpdateDeviceRequest) (*UpdateDeviceResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/onboarding/pnp-device/{id}"
 	path = strings.Replace(path, "{"+"id"+"}", fmt.Sprintf("%v", id), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(updateDeviceRequest).
 		SetResult(&UpdateDeviceResponse{}).
 		SetError(&Error{}).
@@ -5543,7 +5694,7 @@ func (s *DeviceOnboardingPnPService) UpdateDevice(id string, updateDeviceRequest
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation updateDevice")
+		return nil, response, newAPIError("updateDevice", response)
 	}
 
 	result := response.Result().(*UpdateDeviceResponse)
@@ -5554,10 +5705,16 @@ func (s *DeviceOnboardingPnPService) UpdateDevice(id string, updateDeviceRequest
 /* Updates the user's list of global PnP settings
  */
 func (s *DeviceOnboardingPnPService) UpdatePnPGlobalSettings(updatePnPGlobalSettingsRequest *UpdatePnPGlobalSettingsRequest) (*UpdatePnPGlobalSettingsResponse, *resty.Response, error) {
+	return s.UpdatePnPGlobalSettingsWithContext(context.Background(), updatePnPGlobalSettingsRequest)
+}
+
+// UpdatePnPGlobalSettingsWithContext is like UpdatePnPGlobalSettings but sends the request with ctx for cancellation and deadlines.
+func (s *DeviceOnboardingPnPService) UpdatePnPGlobalSettingsWithContext(ctx context.Context, updatePnPGlobalSettingsRequest *UpdatePnPGlobalSettingsRequest) (*UpdatePnPGlobalSettingsResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/onboarding/pnp-settings"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(updatePnPGlobalSettingsRequest).
 		SetResult(&UpdatePnPGlobalSettingsResponse{}).
 		SetError(&Error{}).
@@ -5568,7 +5725,7 @@ func (s *DeviceOnboardingPnPService) UpdatePnPGlobalSettings(updatePnPGlobalSett
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation updatePnPGlobalSettings")
+		return nil, response, newAPIError("updatePnPGlobalSettings", response)
 	}
 
 	result := response.Result().(*UpdatePnPGlobalSettingsResponse)
@@ -5579,10 +5736,16 @@ func (s *DeviceOnboardingPnPService) UpdatePnPGlobalSettings(updatePnPGlobalSett
 /* Updates the PnP Server profile in a registered Virtual Account in the PnP database. The response payload returns the updated smart & virtual account info
  */
 func (s *DeviceOnboardingPnPService) UpdatePnPServerProfile(updatePnPServerProfileRequest *UpdatePnPServerProfileRequest) (*UpdatePnPServerProfileResponse, *resty.Response, error) {
+	return s.UpdatePnPServerProfileWithContext(context.Background(), updatePnPServerProfileRequest)
+}
+
+// UpdatePnPServerProfileWithContext is like UpdatePnPServerProfile but sends the request with ctx for cancellation and deadlines.
+func (s *DeviceOnboardingPnPService) UpdatePnPServerProfileWithContext(ctx context.Context, updatePnPServerProfileRequest *UpdatePnPServerProfileRequest) (*UpdatePnPServerProfileResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/onboarding/pnp-settings/savacct"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(updatePnPServerProfileRequest).
 		SetResult(&UpdatePnPServerProfileResponse{}).
 		SetError(&Error{}).
@@ -5593,7 +5756,7 @@ func (s *DeviceOnboardingPnPService) UpdatePnPServerProfile(updatePnPServerProfi
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation updatePnPServerProfile")
+		return nil, response, newAPIError("updatePnPServerProfile", response)
 	}
 
 	result := response.Result().(*UpdatePnPServerProfileResponse)
@@ -5605,11 +5768,17 @@ func (s *DeviceOnboardingPnPService) UpdatePnPServerProfile(updatePnPServerProfi
 @param id id
 */
 func (s *DeviceOnboardingPnPService) UpdateWorkflow(id string, updateWorkflowRequest *UpdateWorkflowRequest) (*UpdateWorkflowResponse, *resty.Response, error) {
+	return s.UpdateWorkflowWithContext(context.Background(), id, updateWorkflowRequest)
+}
+
+// UpdateWorkflowWithContext is like UpdateWorkflow but sends the request with ctx for cancellation and deadlines.
+func (s *DeviceOnboardingPnPService) UpdateWorkflowWithContext(ctx context.Context, id string, updateWorkflowRequest *UpdateWorkflowRequest) (*UpdateWorkflowResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/onboarding/pnp-workflow/{id}"
 	path = strings.Replace(path, "{"+"id"+"}", fmt.Sprintf("%v", id), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(updateWorkflowRequest).
 		SetResult(&UpdateWorkflowResponse{}).
 		SetError(&Error{}).
@@ -5620,7 +5789,7 @@ func (s *DeviceOnboardingPnPService) UpdateWorkflow(id string, updateWorkflowReq
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation updateWorkflow")
+		return nil, response, newAPIError("updateWorkflow", response)
 	}
 
 	result := response.Result().(*UpdateWorkflowResponse)
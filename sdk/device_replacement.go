@@ -1,7 +1,7 @@
 package dnac
 
 import (
-	"fmt"
+	"context"
 
 	"github.com/go-resty/resty/v2"
 	"github.com/google/go-querystring/query"
@@ -18,7 +18,7 @@ type DeployDeviceReplacementWorkflowRequest struct {
 
 // MarkDeviceForReplacementRequest is the markDeviceForReplacementRequest definition
 type MarkDeviceForReplacementRequest struct {
-	CreationTime                  int    `json:"creationTime,omitempty"`                  //
+	CreationTime                  *int   `json:"creationTime,omitempty"`                  //
 	Family                        string `json:"family,omitempty"`                        //
 	FaultyDeviceID                string `json:"faultyDeviceId,omitempty"`                //
 	FaultyDeviceName              string `json:"faultyDeviceName,omitempty"`              //
@@ -30,13 +30,13 @@ type MarkDeviceForReplacementRequest struct {
 	ReplacementDevicePlatform     string `json:"replacementDevicePlatform,omitempty"`     //
 	ReplacementDeviceSerialNumber string `json:"replacementDeviceSerialNumber,omitempty"` //
 	ReplacementStatus             string `json:"replacementStatus,omitempty"`             //
-	ReplacementTime               int    `json:"replacementTime,omitempty"`               //
+	ReplacementTime               *int   `json:"replacementTime,omitempty"`               //
 	WorkflowID                    string `json:"workflowId,omitempty"`                    //
 }
 
 // UnMarkDeviceForReplacementRequest is the unMarkDeviceForReplacementRequest definition
 type UnMarkDeviceForReplacementRequest struct {
-	CreationTime                  int    `json:"creationTime,omitempty"`                  //
+	CreationTime                  *int   `json:"creationTime,omitempty"`                  //
 	Family                        string `json:"family,omitempty"`                        //
 	FaultyDeviceID                string `json:"faultyDeviceId,omitempty"`                //
 	FaultyDeviceName              string `json:"faultyDeviceName,omitempty"`              //
@@ -48,7 +48,7 @@ type UnMarkDeviceForReplacementRequest struct {
 	ReplacementDevicePlatform     string `json:"replacementDevicePlatform,omitempty"`     //
 	ReplacementDeviceSerialNumber string `json:"replacementDeviceSerialNumber,omitempty"` //
 	ReplacementStatus             string `json:"replacementStatus,omitempty"`             //
-	ReplacementTime               int    `json:"replacementTime,omitempty"`               //
+	ReplacementTime               *int   `json:"replacementTime,omitempty"`               //
 	WorkflowID                    string `json:"workflowId,omitempty"`                    //
 }
 
@@ -122,10 +122,16 @@ type UnMarkDeviceForReplacementResponseResponse struct {
 /* API to trigger RMA workflow that will replace faulty device with replacement device with same configuration and images
  */
 func (s *DeviceReplacementService) DeployDeviceReplacementWorkflow(deployDeviceReplacementWorkflowRequest *DeployDeviceReplacementWorkflowRequest) (*DeployDeviceReplacementWorkflowResponse, *resty.Response, error) {
+	return s.DeployDeviceReplacementWorkflowWithContext(context.Background(), deployDeviceReplacementWorkflowRequest)
+}
+
+// DeployDeviceReplacementWorkflowWithContext is like DeployDeviceReplacementWorkflow but sends the request with ctx for cancellation and deadlines.
+func (s *DeviceReplacementService) DeployDeviceReplacementWorkflowWithContext(ctx context.Context, deployDeviceReplacementWorkflowRequest *DeployDeviceReplacementWorkflowRequest) (*DeployDeviceReplacementWorkflowResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/device-replacement/workflow"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(deployDeviceReplacementWorkflowRequest).
 		SetResult(&DeployDeviceReplacementWorkflowResponse{}).
 		SetError(&Error{}).
@@ -136,7 +142,7 @@ func (s *DeviceReplacementService) DeployDeviceReplacementWorkflow(deployDeviceR
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation deployDeviceReplacementWorkflow")
+		return nil, response, newAPIError("deployDeviceReplacementWorkflow", response)
 	}
 
 	result := response.Result().(*DeployDeviceReplacementWorkflowResponse)
@@ -147,10 +153,16 @@ func (s *DeviceReplacementService) DeployDeviceReplacementWorkflow(deployDeviceR
 /* Marks device for replacement
  */
 func (s *DeviceReplacementService) MarkDeviceForReplacement(markDeviceForReplacementRequest *[]MarkDeviceForReplacementRequest) (*MarkDeviceForReplacementResponse, *resty.Response, error) {
+	return s.MarkDeviceForReplacementWithContext(context.Background(), markDeviceForReplacementRequest)
+}
+
+// MarkDeviceForReplacementWithContext is like MarkDeviceForReplacement but sends the request with ctx for cancellation and deadlines.
+func (s *DeviceReplacementService) MarkDeviceForReplacementWithContext(ctx context.Context, markDeviceForReplacementRequest *[]MarkDeviceForReplacementRequest) (*MarkDeviceForReplacementResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/device-replacement"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(markDeviceForReplacementRequest).
 		SetResult(&MarkDeviceForReplacementResponse{}).
 		SetError(&Error{}).
@@ -161,7 +173,7 @@ func (s *DeviceReplacementService) MarkDeviceForReplacement(markDeviceForReplace
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation markDeviceForReplacement")
+		return nil, response, newAPIError("markDeviceForReplacement", response)
 	}
 
 	result := response.Result().(*MarkDeviceForReplacementResponse)
@@ -198,12 +210,18 @@ type ReturnListOfReplacementDevicesWithReplacementDetailsQueryParams struct {
 @param limit limit
 */
 func (s *DeviceReplacementService) ReturnListOfReplacementDevicesWithReplacementDetails(returnListOfReplacementDevicesWithReplacementDetailsQueryParams *ReturnListOfReplacementDevicesWithReplacementDetailsQueryParams) (*ReturnListOfReplacementDevicesWithReplacementDetailsResponse, *resty.Response, error) {
+	return s.ReturnListOfReplacementDevicesWithReplacementDetailsWithContext(context.Background(), returnListOfReplacementDevicesWithReplacementDetailsQueryParams)
+}
+
+// ReturnListOfReplacementDevicesWithReplacementDetailsWithContext is like ReturnListOfReplacementDevicesWithReplacementDetails but sends the request with ctx for cancellation and deadlines.
+func (s *DeviceReplacementService) ReturnListOfReplacementDevicesWithReplacementDetailsWithContext(ctx context.Context, returnListOfReplacementDevicesWithReplacementDetailsQueryParams *ReturnListOfReplacementDevicesWithReplacementDetailsQueryParams) (*ReturnListOfReplacementDevicesWithReplacementDetailsResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/device-replacement"
 
 	queryString, _ := query.Values(returnListOfReplacementDevicesWithReplacementDetailsQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&ReturnListOfReplacementDevicesWithReplacementDetailsResponse{}).
 		SetError(&Error{}).
@@ -214,7 +232,7 @@ func (s *DeviceReplacementService) ReturnListOfReplacementDevicesWithReplacement
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation returnListOfReplacementDevicesWithReplacementDetails")
+		return nil, response, newAPIError("returnListOfReplacementDevicesWithReplacementDetails", response)
 	}
 
 	result := response.Result().(*ReturnListOfReplacementDevicesWithReplacementDetailsResponse)
@@ -231,12 +249,18 @@ type ReturnReplacementDevicesCountQueryParams struct {
 @param replacementStatus Device Replacement status list[READY-FOR-REPLACEMENT, REPLACEMENT-IN-PROGRESS, REPLACEMENT-SCHEDULED, REPLACED, ERROR]
 */
 func (s *DeviceReplacementService) ReturnReplacementDevicesCount(returnReplacementDevicesCountQueryParams *ReturnReplacementDevicesCountQueryParams) (*ReturnReplacementDevicesCountResponse, *resty.Response, error) {
+	return s.ReturnReplacementDevicesCountWithContext(context.Background(), returnReplacementDevicesCountQueryParams)
+}
+
+// ReturnReplacementDevicesCountWithContext is like ReturnReplacementDevicesCount but sends the request with ctx for cancellation and deadlines.
+func (s *DeviceReplacementService) ReturnReplacementDevicesCountWithContext(ctx context.Context, returnReplacementDevicesCountQueryParams *ReturnReplacementDevicesCountQueryParams) (*ReturnReplacementDevicesCountResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/device-replacement/count"
 
 	queryString, _ := query.Values(returnReplacementDevicesCountQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&ReturnReplacementDevicesCountResponse{}).
 		SetError(&Error{}).
@@ -247,7 +271,7 @@ func (s *DeviceReplacementService) ReturnReplacementDevicesCount(returnReplaceme
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation returnReplacementDevicesCount")
+		return nil, response, newAPIError("returnReplacementDevicesCount", response)
 	}
 
 	result := response.Result().(*ReturnReplacementDevicesCountResponse)
@@ -258,10 +282,16 @@ func (s *DeviceReplacementService) ReturnReplacementDevicesCount(returnReplaceme
 /* UnMarks device for replacement
  */
 func (s *DeviceReplacementService) UnMarkDeviceForReplacement(unMarkDeviceForReplacementRequest *[]UnMarkDeviceForReplacementRequest) (*UnMarkDeviceForReplacementResponse, *resty.Response, error) {
+	return s.UnMarkDeviceForReplacementWithContext(context.Background(), unMarkDeviceForReplacementRequest)
+}
+
+// UnMarkDeviceForReplacementWithContext is like UnMarkDeviceForReplacement but sends the request with ctx for cancellation and deadlines.
+func (s *DeviceReplacementService) UnMarkDeviceForReplacementWithContext(ctx context.Context, unMarkDeviceForReplacementRequest *[]UnMarkDeviceForReplacementRequest) (*UnMarkDeviceForReplacementResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/device-replacement"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(unMarkDeviceForReplacementRequest).
 		SetResult(&UnMarkDeviceForReplacementResponse{}).
 		SetError(&Error{}).
@@ -272,7 +302,7 @@ func (s *DeviceReplacementService) UnMarkDeviceForReplacement(unMarkDeviceForRep
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation unMarkDeviceForReplacement")
+		return nil, response, newAPIError("unMarkDeviceForReplacement", response)
 	}
 
 	result := response.Result().(*UnMarkDeviceForReplacementResponse)
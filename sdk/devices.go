@@ -1,6 +1,7 @@
 package dnac
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -14,12 +15,12 @@ type DevicesService service
 // AddDeviceRequest is the addDeviceRequest definition
 type AddDeviceRequest struct {
 	CliTransport            string                                    `json:"cliTransport,omitempty"`            //
-	ComputeDevice           bool                                      `json:"computeDevice,omitempty"`           //
+	ComputeDevice           *bool                                     `json:"computeDevice,omitempty"`           //
 	EnablePassword          string                                    `json:"enablePassword,omitempty"`          //
 	ExtendedDiscoveryInfo   string                                    `json:"extendedDiscoveryInfo,omitempty"`   //
 	HTTPPassword            string                                    `json:"httpPassword,omitempty"`            //
 	HTTPPort                string                                    `json:"httpPort,omitempty"`                //
-	HTTPSecure              bool                                      `json:"httpSecure,omitempty"`              //
+	HTTPSecure              *bool                                     `json:"httpSecure,omitempty"`              //
 	HTTPUserName            string                                    `json:"httpUserName,omitempty"`            //
 	IPAddress               []string                                  `json:"ipAddress,omitempty"`               //
 	MerakiOrgID             []string                                  `json:"merakiOrgId,omitempty"`             //
@@ -33,8 +34,8 @@ type AddDeviceRequest struct {
 	SNMPPrivProtocol        string                                    `json:"snmpPrivProtocol,omitempty"`        //
 	SNMPROCommunity         string                                    `json:"snmpROCommunity,omitempty"`         //
 	SNMPRWCommunity         string                                    `json:"snmpRWCommunity,omitempty"`         //
-	SNMPRetry               int                                       `json:"snmpRetry,omitempty"`               //
-	SNMPTimeout             int                                       `json:"snmpTimeout,omitempty"`             //
+	SNMPRetry               *int                                      `json:"snmpRetry,omitempty"`               //
+	SNMPTimeout             *int                                      `json:"snmpTimeout,omitempty"`             //
 	SNMPUserName            string                                    `json:"snmpUserName,omitempty"`            //
 	SNMPVersion             string                                    `json:"snmpVersion,omitempty"`             //
 	Type                    string                                    `json:"type,omitempty"`                    //
@@ -72,12 +73,12 @@ type ExportDeviceListRequestParameters []string
 // SyncDevicesRequest is the syncDevicesRequest definition
 type SyncDevicesRequest struct {
 	CliTransport            string                                      `json:"cliTransport,omitempty"`            //
-	ComputeDevice           bool                                        `json:"computeDevice,omitempty"`           //
+	ComputeDevice           *bool                                       `json:"computeDevice,omitempty"`           //
 	EnablePassword          string                                      `json:"enablePassword,omitempty"`          //
 	ExtendedDiscoveryInfo   string                                      `json:"extendedDiscoveryInfo,omitempty"`   //
 	HTTPPassword            string                                      `json:"httpPassword,omitempty"`            //
 	HTTPPort                string                                      `json:"httpPort,omitempty"`                //
-	HTTPSecure              bool                                        `json:"httpSecure,omitempty"`              //
+	HTTPSecure              *bool                                       `json:"httpSecure,omitempty"`              //
 	HTTPUserName            string                                      `json:"httpUserName,omitempty"`            //
 	IPAddress               []string                                    `json:"ipAddress,omitempty"`               //
 	MerakiOrgID             []string                                    `json:"merakiOrgId,omitempty"`             //
@@ -91,8 +92,8 @@ type SyncDevicesRequest struct {
 	SNMPPrivProtocol        string                                      `json:"snmpPrivProtocol,omitempty"`        //
 	SNMPROCommunity         string                                      `json:"snmpROCommunity,omitempty"`         //
 	SNMPRWCommunity         string                                      `json:"snmpRWCommunity,omitempty"`         //
-	SNMPRetry               int                                         `json:"snmpRetry,omitempty"`               //
-	SNMPTimeout             int                                         `json:"snmpTimeout,omitempty"`             //
+	SNMPRetry               *int                                        `json:"snmpRetry,omitempty"`               //
+	SNMPTimeout             *int                                        `json:"snmpTimeout,omitempty"`             //
 	SNMPUserName            string                                      `json:"snmpUserName,omitempty"`            //
 	SNMPVersion             string                                      `json:"snmpVersion,omitempty"`             //
 	Type                    string                                      `json:"type,omitempty"`                    //
@@ -1230,10 +1231,16 @@ type UpdateDeviceRoleResponseResponse struct {
 /* Adds the device with given credential
  */
 func (s *DevicesService) AddDevice(addDeviceRequest *AddDeviceRequest) (*AddDeviceResponse, *resty.Response, error) {
+	return s.AddDeviceWithContext(context.Background(), addDeviceRequest)
+}
+
+// AddDeviceWithContext is like AddDevice but sends the request with ctx for cancellation and deadlines.
+func (s *DevicesService) AddDeviceWithContext(ctx context.Context, addDeviceRequest *AddDeviceRequest) (*AddDeviceResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/network-device"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(addDeviceRequest).
 		SetResult(&AddDeviceResponse{}).
 		SetError(&Error{}).
@@ -1244,7 +1251,7 @@ func (s *DevicesService) AddDevice(addDeviceRequest *AddDeviceRequest) (*AddDevi
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation addDevice")
+		return nil, response, newAPIError("addDevice", response)
 	}
 
 	result := response.Result().(*AddDeviceResponse)
@@ -1262,6 +1269,11 @@ type DeleteDeviceByIDQueryParams struct {
 @param isForceDelete isForceDelete
 */
 func (s *DevicesService) DeleteDeviceByID(id string, deleteDeviceByIDQueryParams *DeleteDeviceByIDQueryParams) (*DeleteDeviceByIDResponse, *resty.Response, error) {
+	return s.DeleteDeviceByIDWithContext(context.Background(), id, deleteDeviceByIDQueryParams)
+}
+
+// DeleteDeviceByIDWithContext is like DeleteDeviceByID but sends the request with ctx for cancellation and deadlines.
+func (s *DevicesService) DeleteDeviceByIDWithContext(ctx context.Context, id string, deleteDeviceByIDQueryParams *DeleteDeviceByIDQueryParams) (*DeleteDeviceByIDResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/network-device/{id}"
 	path = strings.Replace(path, "{"+"id"+"}", fmt.Sprintf("%v", id), -1)
@@ -1269,6 +1281,7 @@ func (s *DevicesService) DeleteDeviceByID(id string, deleteDeviceByIDQueryParams
 	queryString, _ := query.Values(deleteDeviceByIDQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&DeleteDeviceByIDResponse{}).
 		SetError(&Error{}).
@@ -1279,7 +1292,7 @@ func (s *DevicesService) DeleteDeviceByID(id string, deleteDeviceByIDQueryParams
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation deleteDeviceById")
+		return nil, response, newAPIError("deleteDeviceById", response)
 	}
 
 	result := response.Result().(*DeleteDeviceByIDResponse)
@@ -1308,12 +1321,18 @@ type DevicesQueryParams struct {
 @param offset The offset of the first device in the returned data
 */
 func (s *DevicesService) Devices(devicesQueryParams *DevicesQueryParams) (*DevicesResponse, *resty.Response, error) {
+	return s.DevicesWithContext(context.Background(), devicesQueryParams)
+}
+
+// DevicesWithContext is like Devices but sends the request with ctx for cancellation and deadlines.
+func (s *DevicesService) DevicesWithContext(ctx context.Context, devicesQueryParams *DevicesQueryParams) (*DevicesResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/device-health"
 
 	queryString, _ := query.Values(devicesQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&DevicesResponse{}).
 		SetError(&Error{}).
@@ -1324,7 +1343,7 @@ func (s *DevicesService) Devices(devicesQueryParams *DevicesQueryParams) (*Devic
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation devices")
+		return nil, response, newAPIError("devices", response)
 	}
 
 	result := response.Result().(*DevicesResponse)
@@ -1335,10 +1354,16 @@ func (s *DevicesService) Devices(devicesQueryParams *DevicesQueryParams) (*Devic
 /* Exports the selected network device to a file
  */
 func (s *DevicesService) ExportDeviceList(exportDeviceListRequest *ExportDeviceListRequest) (*ExportDeviceListResponse, *resty.Response, error) {
+	return s.ExportDeviceListWithContext(context.Background(), exportDeviceListRequest)
+}
+
+// ExportDeviceListWithContext is like ExportDeviceList but sends the request with ctx for cancellation and deadlines.
+func (s *DevicesService) ExportDeviceListWithContext(ctx context.Context, exportDeviceListRequest *ExportDeviceListRequest) (*ExportDeviceListResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/network-device/file"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(exportDeviceListRequest).
 		SetResult(&ExportDeviceListResponse{}).
 		SetError(&Error{}).
@@ -1349,7 +1374,7 @@ func (s *DevicesService) ExportDeviceList(exportDeviceListRequest *ExportDeviceL
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation exportDeviceList")
+		return nil, response, newAPIError("exportDeviceList", response)
 	}
 
 	result := response.Result().(*ExportDeviceListResponse)
@@ -1368,12 +1393,18 @@ type GetAllInterfacesQueryParams struct {
 @param limit limit
 */
 func (s *DevicesService) GetAllInterfaces(getAllInterfacesQueryParams *GetAllInterfacesQueryParams) (*GetAllInterfacesResponse, *resty.Response, error) {
+	return s.GetAllInterfacesWithContext(context.Background(), getAllInterfacesQueryParams)
+}
+
+// GetAllInterfacesWithContext is like GetAllInterfaces but sends the request with ctx for cancellation and deadlines.
+func (s *DevicesService) GetAllInterfacesWithContext(ctx context.Context, getAllInterfacesQueryParams *GetAllInterfacesQueryParams) (*GetAllInterfacesResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/interface"
 
 	queryString, _ := query.Values(getAllInterfacesQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetAllInterfacesResponse{}).
 		SetError(&Error{}).
@@ -1384,7 +1415,7 @@ func (s *DevicesService) GetAllInterfaces(getAllInterfacesQueryParams *GetAllInt
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getAllInterfaces")
+		return nil, response, newAPIError("getAllInterfaces", response)
 	}
 
 	result := response.Result().(*GetAllInterfacesResponse)
@@ -1396,11 +1427,17 @@ func (s *DevicesService) GetAllInterfaces(getAllInterfacesQueryParams *GetAllInt
 @param id Device ID
 */
 func (s *DevicesService) GetDeviceByID(id string) (*DevicesGetDeviceByIDResponse, *resty.Response, error) {
+	return s.GetDeviceByIDWithContext(context.Background(), id)
+}
+
+// GetDeviceByIDWithContext is like GetDeviceByID but sends the request with ctx for cancellation and deadlines.
+func (s *DevicesService) GetDeviceByIDWithContext(ctx context.Context, id string) (*DevicesGetDeviceByIDResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/network-device/{id}"
 	path = strings.Replace(path, "{"+"id"+"}", fmt.Sprintf("%v", id), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&DevicesGetDeviceByIDResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -1410,7 +1447,7 @@ func (s *DevicesService) GetDeviceByID(id string) (*DevicesGetDeviceByIDResponse
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getDeviceByID")
+		return nil, response, newAPIError("getDeviceByID", response)
 	}
 
 	result := response.Result().(*DevicesGetDeviceByIDResponse)
@@ -1422,11 +1459,17 @@ func (s *DevicesService) GetDeviceByID(id string) (*DevicesGetDeviceByIDResponse
 @param serialNumber Device serial number
 */
 func (s *DevicesService) GetDeviceBySerialNumber(serialNumber string) (*GetDeviceBySerialNumberResponse, *resty.Response, error) {
+	return s.GetDeviceBySerialNumberWithContext(context.Background(), serialNumber)
+}
+
+// GetDeviceBySerialNumberWithContext is like GetDeviceBySerialNumber but sends the request with ctx for cancellation and deadlines.
+func (s *DevicesService) GetDeviceBySerialNumberWithContext(ctx context.Context, serialNumber string) (*GetDeviceBySerialNumberResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/network-device/serial-number/{serialNumber}"
 	path = strings.Replace(path, "{"+"serialNumber"+"}", fmt.Sprintf("%v", serialNumber), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&GetDeviceBySerialNumberResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -1436,7 +1479,7 @@ func (s *DevicesService) GetDeviceBySerialNumber(serialNumber string) (*GetDevic
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getDeviceBySerialNumber")
+		return nil, response, newAPIError("getDeviceBySerialNumber", response)
 	}
 
 	result := response.Result().(*GetDeviceBySerialNumberResponse)
@@ -1448,11 +1491,17 @@ func (s *DevicesService) GetDeviceBySerialNumber(serialNumber string) (*GetDevic
 @param networkDeviceID networkDeviceId
 */
 func (s *DevicesService) GetDeviceConfigByID(networkDeviceID string) (*GetDeviceConfigByIDResponse, *resty.Response, error) {
+	return s.GetDeviceConfigByIDWithContext(context.Background(), networkDeviceID)
+}
+
+// GetDeviceConfigByIDWithContext is like GetDeviceConfigByID but sends the request with ctx for cancellation and deadlines.
+func (s *DevicesService) GetDeviceConfigByIDWithContext(ctx context.Context, networkDeviceID string) (*GetDeviceConfigByIDResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/network-device/{networkDeviceId}/config"
 	path = strings.Replace(path, "{"+"networkDeviceId"+"}", fmt.Sprintf("%v", networkDeviceID), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&GetDeviceConfigByIDResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -1462,7 +1511,7 @@ func (s *DevicesService) GetDeviceConfigByID(networkDeviceID string) (*GetDevice
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getDeviceConfigById")
+		return nil, response, newAPIError("getDeviceConfigById", response)
 	}
 
 	result := response.Result().(*GetDeviceConfigByIDResponse)
@@ -1473,10 +1522,16 @@ func (s *DevicesService) GetDeviceConfigByID(networkDeviceID string) (*GetDevice
 /* Returns the count of device configs
  */
 func (s *DevicesService) GetDeviceConfigCount() (*GetDeviceConfigCountResponse, *resty.Response, error) {
+	return s.GetDeviceConfigCountWithContext(context.Background())
+}
+
+// GetDeviceConfigCountWithContext is like GetDeviceConfigCount but sends the request with ctx for cancellation and deadlines.
+func (s *DevicesService) GetDeviceConfigCountWithContext(ctx context.Context) (*GetDeviceConfigCountResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/network-device/config/count"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&GetDeviceConfigCountResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -1486,7 +1541,7 @@ func (s *DevicesService) GetDeviceConfigCount() (*GetDeviceConfigCountResponse,
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getDeviceConfigCount")
+		return nil, response, newAPIError("getDeviceConfigCount", response)
 	}
 
 	result := response.Result().(*GetDeviceConfigCountResponse)
@@ -1497,10 +1552,16 @@ func (s *DevicesService) GetDeviceConfigCount() (*GetDeviceConfigCountResponse,
 /* Returns the config for all devices
  */
 func (s *DevicesService) GetDeviceConfigForAllDevices() (*GetDeviceConfigForAllDevicesResponse, *resty.Response, error) {
+	return s.GetDeviceConfigForAllDevicesWithContext(context.Background())
+}
+
+// GetDeviceConfigForAllDevicesWithContext is like GetDeviceConfigForAllDevices but sends the request with ctx for cancellation and deadlines.
+func (s *DevicesService) GetDeviceConfigForAllDevicesWithContext(ctx context.Context) (*GetDeviceConfigForAllDevicesResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/network-device/config"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&GetDeviceConfigForAllDevicesResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -1510,7 +1571,7 @@ func (s *DevicesService) GetDeviceConfigForAllDevices() (*GetDeviceConfigForAllD
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getDeviceConfigForAllDevices")
+		return nil, response, newAPIError("getDeviceConfigForAllDevices", response)
 	}
 
 	result := response.Result().(*GetDeviceConfigForAllDevicesResponse)
@@ -1521,10 +1582,16 @@ func (s *DevicesService) GetDeviceConfigForAllDevices() (*GetDeviceConfigForAllD
 /* Returns the count of network devices based on the filter criteria by management IP address, mac address, hostname and location name
  */
 func (s *DevicesService) GetDeviceCount() (*GetDeviceCountResponse, *resty.Response, error) {
+	return s.GetDeviceCountWithContext(context.Background())
+}
+
+// GetDeviceCountWithContext is like GetDeviceCount but sends the request with ctx for cancellation and deadlines.
+func (s *DevicesService) GetDeviceCountWithContext(ctx context.Context) (*GetDeviceCountResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/network-device/count"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&GetDeviceCountResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -1534,7 +1601,7 @@ func (s *DevicesService) GetDeviceCount() (*GetDeviceCountResponse, *resty.Respo
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getDeviceCount")
+		return nil, response, newAPIError("getDeviceCount", response)
 	}
 
 	result := response.Result().(*GetDeviceCountResponse)
@@ -1555,12 +1622,18 @@ type GetDeviceDetailQueryParams struct {
 @param identifier One of keywords : macAddress or uuid or nwDeviceName
 */
 func (s *DevicesService) GetDeviceDetail(getDeviceDetailQueryParams *GetDeviceDetailQueryParams) (*GetDeviceDetailResponse, *resty.Response, error) {
+	return s.GetDeviceDetailWithContext(context.Background(), getDeviceDetailQueryParams)
+}
+
+// GetDeviceDetailWithContext is like GetDeviceDetail but sends the request with ctx for cancellation and deadlines.
+func (s *DevicesService) GetDeviceDetailWithContext(ctx context.Context, getDeviceDetailQueryParams *GetDeviceDetailQueryParams) (*GetDeviceDetailResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/device-detail"
 
 	queryString, _ := query.Values(getDeviceDetailQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetDeviceDetailResponse{}).
 		SetError(&Error{}).
@@ -1571,7 +1644,7 @@ func (s *DevicesService) GetDeviceDetail(getDeviceDetailQueryParams *GetDeviceDe
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getDeviceDetail")
+		return nil, response, newAPIError("getDeviceDetail", response)
 	}
 
 	result := response.Result().(*GetDeviceDetailResponse)
@@ -1584,10 +1657,16 @@ func (s *DevicesService) GetDeviceDetail(getDeviceDetailQueryParams *GetDeviceDe
 @param entity_value Contains the actual value for the entity type that has been defined
 */
 func (s *DevicesService) GetDeviceEnrichmentDetails() (*GetDeviceEnrichmentDetailsResponse, *resty.Response, error) {
+	return s.GetDeviceEnrichmentDetailsWithContext(context.Background())
+}
+
+// GetDeviceEnrichmentDetailsWithContext is like GetDeviceEnrichmentDetails but sends the request with ctx for cancellation and deadlines.
+func (s *DevicesService) GetDeviceEnrichmentDetailsWithContext(ctx context.Context) (*GetDeviceEnrichmentDetailsResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/device-enrichment-details"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&GetDeviceEnrichmentDetailsResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -1597,7 +1676,7 @@ func (s *DevicesService) GetDeviceEnrichmentDetails() (*GetDeviceEnrichmentDetai
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getDeviceEnrichmentDetails")
+		return nil, response, newAPIError("getDeviceEnrichmentDetails", response)
 	}
 
 	result := response.Result().(*GetDeviceEnrichmentDetailsResponse)
@@ -1608,10 +1687,16 @@ func (s *DevicesService) GetDeviceEnrichmentDetails() (*GetDeviceEnrichmentDetai
 /* Returns the count of interfaces for all devices
  */
 func (s *DevicesService) GetDeviceInterfaceCount() (*GetDeviceInterfaceCountResponse, *resty.Response, error) {
+	return s.GetDeviceInterfaceCountWithContext(context.Background())
+}
+
+// GetDeviceInterfaceCountWithContext is like GetDeviceInterfaceCount but sends the request with ctx for cancellation and deadlines.
+func (s *DevicesService) GetDeviceInterfaceCountWithContext(ctx context.Context) (*GetDeviceInterfaceCountResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/interface/count"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&GetDeviceInterfaceCountResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -1621,7 +1706,7 @@ func (s *DevicesService) GetDeviceInterfaceCount() (*GetDeviceInterfaceCountResp
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getDeviceInterfaceCount")
+		return nil, response, newAPIError("getDeviceInterfaceCount", response)
 	}
 
 	result := response.Result().(*GetDeviceInterfaceCountResponse)
@@ -1633,11 +1718,17 @@ func (s *DevicesService) GetDeviceInterfaceCount() (*GetDeviceInterfaceCountResp
 @param deviceID Device ID
 */
 func (s *DevicesService) GetDeviceInterfaceCountByDeviceID(deviceID string) (*GetDeviceInterfaceCountByDeviceIDResponse, *resty.Response, error) {
+	return s.GetDeviceInterfaceCountByDeviceIDWithContext(context.Background(), deviceID)
+}
+
+// GetDeviceInterfaceCountByDeviceIDWithContext is like GetDeviceInterfaceCountByDeviceID but sends the request with ctx for cancellation and deadlines.
+func (s *DevicesService) GetDeviceInterfaceCountByDeviceIDWithContext(ctx context.Context, deviceID string) (*GetDeviceInterfaceCountByDeviceIDResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/interface/network-device/{deviceId}/count"
 	path = strings.Replace(path, "{"+"deviceId"+"}", fmt.Sprintf("%v", deviceID), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&GetDeviceInterfaceCountByDeviceIDResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -1647,7 +1738,7 @@ func (s *DevicesService) GetDeviceInterfaceCountByDeviceID(deviceID string) (*Ge
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getDeviceInterfaceCountByDeviceId")
+		return nil, response, newAPIError("getDeviceInterfaceCountByDeviceId", response)
 	}
 
 	result := response.Result().(*GetDeviceInterfaceCountByDeviceIDResponse)
@@ -1665,6 +1756,11 @@ type GetDeviceInterfaceVLANsQueryParams struct {
 @param interfaceType Vlan assocaited with sub-interface
 */
 func (s *DevicesService) GetDeviceInterfaceVLANs(id string, getDeviceInterfaceVLANsQueryParams *GetDeviceInterfaceVLANsQueryParams) (*GetDeviceInterfaceVLANsResponse, *resty.Response, error) {
+	return s.GetDeviceInterfaceVLANsWithContext(context.Background(), id, getDeviceInterfaceVLANsQueryParams)
+}
+
+// GetDeviceInterfaceVLANsWithContext is like GetDeviceInterfaceVLANs but sends the request with ctx for cancellation and deadlines.
+func (s *DevicesService) GetDeviceInterfaceVLANsWithContext(ctx context.Context, id string, getDeviceInterfaceVLANsQueryParams *GetDeviceInterfaceVLANsQueryParams) (*GetDeviceInterfaceVLANsResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/network-device/{id}/vlan"
 	path = strings.Replace(path, "{"+"id"+"}", fmt.Sprintf("%v", id), -1)
@@ -1672,6 +1768,7 @@ func (s *DevicesService) GetDeviceInterfaceVLANs(id string, getDeviceInterfaceVL
 	queryString, _ := query.Values(getDeviceInterfaceVLANsQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetDeviceInterfaceVLANsResponse{}).
 		SetError(&Error{}).
@@ -1682,7 +1779,7 @@ func (s *DevicesService) GetDeviceInterfaceVLANs(id string, getDeviceInterfaceVL
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getDeviceInterfaceVLANs")
+		return nil, response, newAPIError("getDeviceInterfaceVLANs", response)
 	}
 
 	result := response.Result().(*GetDeviceInterfaceVLANsResponse)
@@ -1696,6 +1793,11 @@ func (s *DevicesService) GetDeviceInterfaceVLANs(id string, getDeviceInterfaceVL
 @param recordsToReturn Number of records to return
 */
 func (s *DevicesService) GetDeviceInterfacesBySpecifiedRange(deviceID string, startIndex int, recordsToReturn int) (*GetDeviceInterfacesBySpecifiedRangeResponse, *resty.Response, error) {
+	return s.GetDeviceInterfacesBySpecifiedRangeWithContext(context.Background(), deviceID, startIndex, recordsToReturn)
+}
+
+// GetDeviceInterfacesBySpecifiedRangeWithContext is like GetDeviceInterfacesBySpecifiedRange but sends the request with ctx for cancellation and deadlines.
+func (s *DevicesService) GetDeviceInterfacesBySpecifiedRangeWithContext(ctx context.Context, deviceID string, startIndex int, recordsToReturn int) (*GetDeviceInterfacesBySpecifiedRangeResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/interface/network-device/{deviceId}/{startIndex}/{recordsToReturn}"
 	path = strings.Replace(path, "{"+"deviceId"+"}", fmt.Sprintf("%v", deviceID), -1)
@@ -1703,6 +1805,7 @@ func (s *DevicesService) GetDeviceInterfacesBySpecifiedRange(deviceID string, st
 	path = strings.Replace(path, "{"+"recordsToReturn"+"}", fmt.Sprintf("%v", recordsToReturn), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&GetDeviceInterfacesBySpecifiedRangeResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -1712,7 +1815,7 @@ func (s *DevicesService) GetDeviceInterfacesBySpecifiedRange(deviceID string, st
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getDeviceInterfacesBySpecifiedRange")
+		return nil, response, newAPIError("getDeviceInterfacesBySpecifiedRange", response)
 	}
 
 	result := response.Result().(*GetDeviceInterfacesBySpecifiedRangeResponse)
@@ -1793,12 +1896,18 @@ If id parameter is provided with comma separated ids, it will return the list of
 @param id Accepts comma separated ids and return list of network-devices for the given ids. If invalid or not-found ids are provided, null entry will be returned in the list.
 */
 func (s *DevicesService) GetDeviceList(getDeviceListQueryParams *GetDeviceListQueryParams) (*GetDeviceListResponse, *resty.Response, error) {
+	return s.GetDeviceListWithContext(context.Background(), getDeviceListQueryParams)
+}
+
+// GetDeviceListWithContext is like GetDeviceList but sends the request with ctx for cancellation and deadlines.
+func (s *DevicesService) GetDeviceListWithContext(ctx context.Context, getDeviceListQueryParams *GetDeviceListQueryParams) (*GetDeviceListResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/network-device"
 
 	queryString, _ := query.Values(getDeviceListQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetDeviceListResponse{}).
 		SetError(&Error{}).
@@ -1809,7 +1918,7 @@ func (s *DevicesService) GetDeviceList(getDeviceListQueryParams *GetDeviceListQu
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getDeviceList")
+		return nil, response, newAPIError("getDeviceList", response)
 	}
 
 	result := response.Result().(*GetDeviceListResponse)
@@ -1821,11 +1930,17 @@ func (s *DevicesService) GetDeviceList(getDeviceListQueryParams *GetDeviceListQu
 @param id Device ID
 */
 func (s *DevicesService) GetDeviceSummary(id string) (*GetDeviceSummaryResponse, *resty.Response, error) {
+	return s.GetDeviceSummaryWithContext(context.Background(), id)
+}
+
+// GetDeviceSummaryWithContext is like GetDeviceSummary but sends the request with ctx for cancellation and deadlines.
+func (s *DevicesService) GetDeviceSummaryWithContext(ctx context.Context, id string) (*GetDeviceSummaryResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/network-device/{id}/brief"
 	path = strings.Replace(path, "{"+"id"+"}", fmt.Sprintf("%v", id), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&GetDeviceSummaryResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -1835,7 +1950,7 @@ func (s *DevicesService) GetDeviceSummary(id string) (*GetDeviceSummaryResponse,
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getDeviceSummary")
+		return nil, response, newAPIError("getDeviceSummary", response)
 	}
 
 	result := response.Result().(*GetDeviceSummaryResponse)
@@ -1847,11 +1962,17 @@ func (s *DevicesService) GetDeviceSummary(id string) (*GetDeviceSummaryResponse,
 @param id Functional Capability UUID
 */
 func (s *DevicesService) GetFunctionalCapabilityByID(id string) (*GetFunctionalCapabilityByIDResponse, *resty.Response, error) {
+	return s.GetFunctionalCapabilityByIDWithContext(context.Background(), id)
+}
+
+// GetFunctionalCapabilityByIDWithContext is like GetFunctionalCapabilityByID but sends the request with ctx for cancellation and deadlines.
+func (s *DevicesService) GetFunctionalCapabilityByIDWithContext(ctx context.Context, id string) (*GetFunctionalCapabilityByIDResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/network-device/functional-capability/{id}"
 	path = strings.Replace(path, "{"+"id"+"}", fmt.Sprintf("%v", id), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&GetFunctionalCapabilityByIDResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -1861,7 +1982,7 @@ func (s *DevicesService) GetFunctionalCapabilityByID(id string) (*GetFunctionalC
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getFunctionalCapabilityById")
+		return nil, response, newAPIError("getFunctionalCapabilityById", response)
 	}
 
 	result := response.Result().(*GetFunctionalCapabilityByIDResponse)
@@ -1880,12 +2001,18 @@ type GetFunctionalCapabilityForDevicesQueryParams struct {
 @param functionName functionName
 */
 func (s *DevicesService) GetFunctionalCapabilityForDevices(getFunctionalCapabilityForDevicesQueryParams *GetFunctionalCapabilityForDevicesQueryParams) (*GetFunctionalCapabilityForDevicesResponse, *resty.Response, error) {
+	return s.GetFunctionalCapabilityForDevicesWithContext(context.Background(), getFunctionalCapabilityForDevicesQueryParams)
+}
+
+// GetFunctionalCapabilityForDevicesWithContext is like GetFunctionalCapabilityForDevices but sends the request with ctx for cancellation and deadlines.
+func (s *DevicesService) GetFunctionalCapabilityForDevicesWithContext(ctx context.Context, getFunctionalCapabilityForDevicesQueryParams *GetFunctionalCapabilityForDevicesQueryParams) (*GetFunctionalCapabilityForDevicesResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/network-device/functional-capability"
 
 	queryString, _ := query.Values(getFunctionalCapabilityForDevicesQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetFunctionalCapabilityForDevicesResponse{}).
 		SetError(&Error{}).
@@ -1896,7 +2023,7 @@ func (s *DevicesService) GetFunctionalCapabilityForDevices(getFunctionalCapabili
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getFunctionalCapabilityForDevices")
+		return nil, response, newAPIError("getFunctionalCapabilityForDevices", response)
 	}
 
 	result := response.Result().(*GetFunctionalCapabilityForDevicesResponse)
@@ -1907,10 +2034,16 @@ func (s *DevicesService) GetFunctionalCapabilityForDevices(getFunctionalCapabili
 /* Returns the interfaces that has ISIS enabled
  */
 func (s *DevicesService) GetISISInterfaces() (*GetISISInterfacesResponse, *resty.Response, error) {
+	return s.GetISISInterfacesWithContext(context.Background())
+}
+
+// GetISISInterfacesWithContext is like GetISISInterfaces but sends the request with ctx for cancellation and deadlines.
+func (s *DevicesService) GetISISInterfacesWithContext(ctx context.Context) (*GetISISInterfacesResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/interface/isis"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&GetISISInterfacesResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -1920,7 +2053,7 @@ func (s *DevicesService) GetISISInterfaces() (*GetISISInterfacesResponse, *resty
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getISISInterfaces")
+		return nil, response, newAPIError("getISISInterfaces", response)
 	}
 
 	result := response.Result().(*GetISISInterfacesResponse)
@@ -1932,11 +2065,17 @@ func (s *DevicesService) GetISISInterfaces() (*GetISISInterfacesResponse, *resty
 @param ipAddress IP address of the interface
 */
 func (s *DevicesService) GetInterfaceByIP(ipAddress string) (*GetInterfaceByIPResponse, *resty.Response, error) {
+	return s.GetInterfaceByIPWithContext(context.Background(), ipAddress)
+}
+
+// GetInterfaceByIPWithContext is like GetInterfaceByIP but sends the request with ctx for cancellation and deadlines.
+func (s *DevicesService) GetInterfaceByIPWithContext(ctx context.Context, ipAddress string) (*GetInterfaceByIPResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/interface/ip-address/{ipAddress}"
 	path = strings.Replace(path, "{"+"ipAddress"+"}", fmt.Sprintf("%v", ipAddress), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&GetInterfaceByIPResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -1946,7 +2085,7 @@ func (s *DevicesService) GetInterfaceByIP(ipAddress string) (*GetInterfaceByIPRe
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getInterfaceByIP")
+		return nil, response, newAPIError("getInterfaceByIP", response)
 	}
 
 	result := response.Result().(*GetInterfaceByIPResponse)
@@ -1958,11 +2097,17 @@ func (s *DevicesService) GetInterfaceByIP(ipAddress string) (*GetInterfaceByIPRe
 @param id Interface ID
 */
 func (s *DevicesService) GetInterfaceByID(id string) (*GetInterfaceByIDResponse, *resty.Response, error) {
+	return s.GetInterfaceByIDWithContext(context.Background(), id)
+}
+
+// GetInterfaceByIDWithContext is like GetInterfaceByID but sends the request with ctx for cancellation and deadlines.
+func (s *DevicesService) GetInterfaceByIDWithContext(ctx context.Context, id string) (*GetInterfaceByIDResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/interface/{id}"
 	path = strings.Replace(path, "{"+"id"+"}", fmt.Sprintf("%v", id), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&GetInterfaceByIDResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -1972,7 +2117,7 @@ func (s *DevicesService) GetInterfaceByID(id string) (*GetInterfaceByIDResponse,
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getInterfaceById")
+		return nil, response, newAPIError("getInterfaceById", response)
 	}
 
 	result := response.Result().(*GetInterfaceByIDResponse)
@@ -1990,6 +2135,11 @@ type GetInterfaceDetailsByDeviceIDAndInterfaceNameQueryParams struct {
 @param name Interface name
 */
 func (s *DevicesService) GetInterfaceDetailsByDeviceIDAndInterfaceName(deviceID string, getInterfaceDetailsByDeviceIDAndInterfaceNameQueryParams *GetInterfaceDetailsByDeviceIDAndInterfaceNameQueryParams) (*GetInterfaceDetailsByDeviceIDAndInterfaceNameResponse, *resty.Response, error) {
+	return s.GetInterfaceDetailsByDeviceIDAndInterfaceNameWithContext(context.Background(), deviceID, getInterfaceDetailsByDeviceIDAndInterfaceNameQueryParams)
+}
+
+// GetInterfaceDetailsByDeviceIDAndInterfaceNameWithContext is like GetInterfaceDetailsByDeviceIDAndInterfaceName but sends the request with ctx for cancellation and deadlines.
+func (s *DevicesService) GetInterfaceDetailsByDeviceIDAndInterfaceNameWithContext(ctx context.Context, deviceID string, getInterfaceDetailsByDeviceIDAndInterfaceNameQueryParams *GetInterfaceDetailsByDeviceIDAndInterfaceNameQueryParams) (*GetInterfaceDetailsByDeviceIDAndInterfaceNameResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/interface/network-device/{deviceId}/interface-name"
 	path = strings.Replace(path, "{"+"deviceId"+"}", fmt.Sprintf("%v", deviceID), -1)
@@ -1997,6 +2147,7 @@ func (s *DevicesService) GetInterfaceDetailsByDeviceIDAndInterfaceName(deviceID
 	queryString, _ := query.Values(getInterfaceDetailsByDeviceIDAndInterfaceNameQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetInterfaceDetailsByDeviceIDAndInterfaceNameResponse{}).
 		SetError(&Error{}).
@@ -2007,7 +2158,7 @@ func (s *DevicesService) GetInterfaceDetailsByDeviceIDAndInterfaceName(deviceID
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getInterfaceDetailsByDeviceIdAndInterfaceName")
+		return nil, response, newAPIError("getInterfaceDetailsByDeviceIdAndInterfaceName", response)
 	}
 
 	result := response.Result().(*GetInterfaceDetailsByDeviceIDAndInterfaceNameResponse)
@@ -2019,11 +2170,17 @@ func (s *DevicesService) GetInterfaceDetailsByDeviceIDAndInterfaceName(deviceID
 @param deviceID Device ID
 */
 func (s *DevicesService) GetInterfaceInfoByID(deviceID string) (*GetInterfaceInfoByIDResponse, *resty.Response, error) {
+	return s.GetInterfaceInfoByIDWithContext(context.Background(), deviceID)
+}
+
+// GetInterfaceInfoByIDWithContext is like GetInterfaceInfoByID but sends the request with ctx for cancellation and deadlines.
+func (s *DevicesService) GetInterfaceInfoByIDWithContext(ctx context.Context, deviceID string) (*GetInterfaceInfoByIDResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/interface/network-device/{deviceId}"
 	path = strings.Replace(path, "{"+"deviceId"+"}", fmt.Sprintf("%v", deviceID), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&GetInterfaceInfoByIDResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -2033,7 +2190,7 @@ func (s *DevicesService) GetInterfaceInfoByID(deviceID string) (*GetInterfaceInf
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getInterfaceInfoById")
+		return nil, response, newAPIError("getInterfaceInfoById", response)
 	}
 
 	result := response.Result().(*GetInterfaceInfoByIDResponse)
@@ -2058,12 +2215,18 @@ type GetModuleCountQueryParams struct {
 @param operationalStateCodeList operationalStateCodeList
 */
 func (s *DevicesService) GetModuleCount(getModuleCountQueryParams *GetModuleCountQueryParams) (*GetModuleCountResponse, *resty.Response, error) {
+	return s.GetModuleCountWithContext(context.Background(), getModuleCountQueryParams)
+}
+
+// GetModuleCountWithContext is like GetModuleCount but sends the request with ctx for cancellation and deadlines.
+func (s *DevicesService) GetModuleCountWithContext(ctx context.Context, getModuleCountQueryParams *GetModuleCountQueryParams) (*GetModuleCountResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/network-device/module/count"
 
 	queryString, _ := query.Values(getModuleCountQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetModuleCountResponse{}).
 		SetError(&Error{}).
@@ -2074,7 +2237,7 @@ func (s *DevicesService) GetModuleCount(getModuleCountQueryParams *GetModuleCoun
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getModuleCount")
+		return nil, response, newAPIError("getModuleCount", response)
 	}
 
 	result := response.Result().(*GetModuleCountResponse)
@@ -2086,11 +2249,17 @@ func (s *DevicesService) GetModuleCount(getModuleCountQueryParams *GetModuleCoun
 @param id id
 */
 func (s *DevicesService) GetModuleInfoByID(id string) (*GetModuleInfoByIDResponse, *resty.Response, error) {
+	return s.GetModuleInfoByIDWithContext(context.Background(), id)
+}
+
+// GetModuleInfoByIDWithContext is like GetModuleInfoByID but sends the request with ctx for cancellation and deadlines.
+func (s *DevicesService) GetModuleInfoByIDWithContext(ctx context.Context, id string) (*GetModuleInfoByIDResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/network-device/module/{id}"
 	path = strings.Replace(path, "{"+"id"+"}", fmt.Sprintf("%v", id), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&GetModuleInfoByIDResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -2100,7 +2269,7 @@ func (s *DevicesService) GetModuleInfoByID(id string) (*GetModuleInfoByIDRespons
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getModuleInfoById")
+		return nil, response, newAPIError("getModuleInfoById", response)
 	}
 
 	result := response.Result().(*GetModuleInfoByIDResponse)
@@ -2129,12 +2298,18 @@ type GetModulesQueryParams struct {
 @param operationalStateCodeList operationalStateCodeList
 */
 func (s *DevicesService) GetModules(getModulesQueryParams *GetModulesQueryParams) (*GetModulesResponse, *resty.Response, error) {
+	return s.GetModulesWithContext(context.Background(), getModulesQueryParams)
+}
+
+// GetModulesWithContext is like GetModules but sends the request with ctx for cancellation and deadlines.
+func (s *DevicesService) GetModulesWithContext(ctx context.Context, getModulesQueryParams *GetModulesQueryParams) (*GetModulesResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/network-device/module"
 
 	queryString, _ := query.Values(getModulesQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetModulesResponse{}).
 		SetError(&Error{}).
@@ -2145,7 +2320,7 @@ func (s *DevicesService) GetModules(getModulesQueryParams *GetModulesQueryParams
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getModules")
+		return nil, response, newAPIError("getModules", response)
 	}
 
 	result := response.Result().(*GetModulesResponse)
@@ -2157,11 +2332,17 @@ func (s *DevicesService) GetModules(getModulesQueryParams *GetModulesQueryParams
 @param ipAddress Device IP address
 */
 func (s *DevicesService) GetNetworkDeviceByIP(ipAddress string) (*GetNetworkDeviceByIPResponse, *resty.Response, error) {
+	return s.GetNetworkDeviceByIPWithContext(context.Background(), ipAddress)
+}
+
+// GetNetworkDeviceByIPWithContext is like GetNetworkDeviceByIP but sends the request with ctx for cancellation and deadlines.
+func (s *DevicesService) GetNetworkDeviceByIPWithContext(ctx context.Context, ipAddress string) (*GetNetworkDeviceByIPResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/network-device/ip-address/{ipAddress}"
 	path = strings.Replace(path, "{"+"ipAddress"+"}", fmt.Sprintf("%v", ipAddress), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&GetNetworkDeviceByIPResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -2171,7 +2352,7 @@ func (s *DevicesService) GetNetworkDeviceByIP(ipAddress string) (*GetNetworkDevi
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getNetworkDeviceByIP")
+		return nil, response, newAPIError("getNetworkDeviceByIP", response)
 	}
 
 	result := response.Result().(*GetNetworkDeviceByIPResponse)
@@ -2184,12 +2365,18 @@ func (s *DevicesService) GetNetworkDeviceByIP(ipAddress string) (*GetNetworkDevi
 @param recordsToReturn Number of records to return
 */
 func (s *DevicesService) GetNetworkDeviceByPaginationRange(startIndex int, recordsToReturn int) (*GetNetworkDeviceByPaginationRangeResponse, *resty.Response, error) {
+	return s.GetNetworkDeviceByPaginationRangeWithContext(context.Background(), startIndex, recordsToReturn)
+}
+
+// GetNetworkDeviceByPaginationRangeWithContext is like GetNetworkDeviceByPaginationRange but sends the request with ctx for cancellation and deadlines.
+func (s *DevicesService) GetNetworkDeviceByPaginationRangeWithContext(ctx context.Context, startIndex int, recordsToReturn int) (*GetNetworkDeviceByPaginationRangeResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/network-device/{startIndex}/{recordsToReturn}"
 	path = strings.Replace(path, "{"+"startIndex"+"}", fmt.Sprintf("%v", startIndex), -1)
 	path = strings.Replace(path, "{"+"recordsToReturn"+"}", fmt.Sprintf("%v", recordsToReturn), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&GetNetworkDeviceByPaginationRangeResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -2199,7 +2386,7 @@ func (s *DevicesService) GetNetworkDeviceByPaginationRange(startIndex int, recor
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getNetworkDeviceByPaginationRange")
+		return nil, response, newAPIError("getNetworkDeviceByPaginationRange", response)
 	}
 
 	result := response.Result().(*GetNetworkDeviceByPaginationRangeResponse)
@@ -2210,10 +2397,16 @@ func (s *DevicesService) GetNetworkDeviceByPaginationRange(startIndex int, recor
 /* Returns the interfaces that has OSPF enabled
  */
 func (s *DevicesService) GetOSPFInterfaces() (*GetOSPFInterfacesResponse, *resty.Response, error) {
+	return s.GetOSPFInterfacesWithContext(context.Background())
+}
+
+// GetOSPFInterfacesWithContext is like GetOSPFInterfaces but sends the request with ctx for cancellation and deadlines.
+func (s *DevicesService) GetOSPFInterfacesWithContext(ctx context.Context) (*GetOSPFInterfacesResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/interface/ospf"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&GetOSPFInterfacesResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -2223,7 +2416,7 @@ func (s *DevicesService) GetOSPFInterfaces() (*GetOSPFInterfacesResponse, *resty
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getOSPFInterfaces")
+		return nil, response, newAPIError("getOSPFInterfaces", response)
 	}
 
 	result := response.Result().(*GetOSPFInterfacesResponse)
@@ -2235,11 +2428,17 @@ func (s *DevicesService) GetOSPFInterfaces() (*GetOSPFInterfacesResponse, *resty
 @param id id
 */
 func (s *DevicesService) GetOrganizationListForMeraki(id string) (*GetOrganizationListForMerakiResponse, *resty.Response, error) {
+	return s.GetOrganizationListForMerakiWithContext(context.Background(), id)
+}
+
+// GetOrganizationListForMerakiWithContext is like GetOrganizationListForMeraki but sends the request with ctx for cancellation and deadlines.
+func (s *DevicesService) GetOrganizationListForMerakiWithContext(ctx context.Context, id string) (*GetOrganizationListForMerakiResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/network-device/{id}/meraki-organization"
 	path = strings.Replace(path, "{"+"id"+"}", fmt.Sprintf("%v", id), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&GetOrganizationListForMerakiResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -2249,7 +2448,7 @@ func (s *DevicesService) GetOrganizationListForMeraki(id string) (*GetOrganizati
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getOrganizationListForMeraki")
+		return nil, response, newAPIError("getOrganizationListForMeraki", response)
 	}
 
 	result := response.Result().(*GetOrganizationListForMerakiResponse)
@@ -2261,11 +2460,17 @@ func (s *DevicesService) GetOrganizationListForMeraki(id string) (*GetOrganizati
 @param id Device ID
 */
 func (s *DevicesService) GetPollingIntervalByID(id string) (*GetPollingIntervalByIDResponse, *resty.Response, error) {
+	return s.GetPollingIntervalByIDWithContext(context.Background(), id)
+}
+
+// GetPollingIntervalByIDWithContext is like GetPollingIntervalByID but sends the request with ctx for cancellation and deadlines.
+func (s *DevicesService) GetPollingIntervalByIDWithContext(ctx context.Context, id string) (*GetPollingIntervalByIDResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/network-device/{id}/collection-schedule"
 	path = strings.Replace(path, "{"+"id"+"}", fmt.Sprintf("%v", id), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&GetPollingIntervalByIDResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -2275,7 +2480,7 @@ func (s *DevicesService) GetPollingIntervalByID(id string) (*GetPollingIntervalB
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getPollingIntervalById")
+		return nil, response, newAPIError("getPollingIntervalById", response)
 	}
 
 	result := response.Result().(*GetPollingIntervalByIDResponse)
@@ -2286,10 +2491,16 @@ func (s *DevicesService) GetPollingIntervalByID(id string) (*GetPollingIntervalB
 /* Returns polling interval of all devices
  */
 func (s *DevicesService) GetPollingIntervalForAllDevices() (*GetPollingIntervalForAllDevicesResponse, *resty.Response, error) {
+	return s.GetPollingIntervalForAllDevicesWithContext(context.Background())
+}
+
+// GetPollingIntervalForAllDevicesWithContext is like GetPollingIntervalForAllDevices but sends the request with ctx for cancellation and deadlines.
+func (s *DevicesService) GetPollingIntervalForAllDevicesWithContext(ctx context.Context) (*GetPollingIntervalForAllDevicesResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/network-device/collection-schedule/global"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&GetPollingIntervalForAllDevicesResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -2299,7 +2510,7 @@ func (s *DevicesService) GetPollingIntervalForAllDevices() (*GetPollingIntervalF
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getPollingIntervalForAllDevices")
+		return nil, response, newAPIError("getPollingIntervalForAllDevices", response)
 	}
 
 	result := response.Result().(*GetPollingIntervalForAllDevicesResponse)
@@ -2311,11 +2522,17 @@ func (s *DevicesService) GetPollingIntervalForAllDevices() (*GetPollingIntervalF
 @param id Device ID
 */
 func (s *DevicesService) GetWirelessLanControllerDetailsByID(id string) (*GetWirelessLanControllerDetailsByIDResponse, *resty.Response, error) {
+	return s.GetWirelessLanControllerDetailsByIDWithContext(context.Background(), id)
+}
+
+// GetWirelessLanControllerDetailsByIDWithContext is like GetWirelessLanControllerDetailsByID but sends the request with ctx for cancellation and deadlines.
+func (s *DevicesService) GetWirelessLanControllerDetailsByIDWithContext(ctx context.Context, id string) (*GetWirelessLanControllerDetailsByIDResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/network-device/{id}/wireless-info"
 	path = strings.Replace(path, "{"+"id"+"}", fmt.Sprintf("%v", id), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&GetWirelessLanControllerDetailsByIDResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -2325,7 +2542,7 @@ func (s *DevicesService) GetWirelessLanControllerDetailsByID(id string) (*GetWir
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getWirelessLanControllerDetailsById")
+		return nil, response, newAPIError("getWirelessLanControllerDetailsById", response)
 	}
 
 	result := response.Result().(*GetWirelessLanControllerDetailsByIDResponse)
@@ -2344,12 +2561,18 @@ type RegisterDeviceForWSAQueryParams struct {
 @param macaddress Mac addres of the device
 */
 func (s *DevicesService) RegisterDeviceForWSA(registerDeviceForWSAQueryParams *RegisterDeviceForWSAQueryParams) (*RegisterDeviceForWSAResponse, *resty.Response, error) {
+	return s.RegisterDeviceForWSAWithContext(context.Background(), registerDeviceForWSAQueryParams)
+}
+
+// RegisterDeviceForWSAWithContext is like RegisterDeviceForWSA but sends the request with ctx for cancellation and deadlines.
+func (s *DevicesService) RegisterDeviceForWSAWithContext(ctx context.Context, registerDeviceForWSAQueryParams *RegisterDeviceForWSAQueryParams) (*RegisterDeviceForWSAResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/network-device/tenantinfo/macaddress"
 
 	queryString, _ := query.Values(registerDeviceForWSAQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&RegisterDeviceForWSAResponse{}).
 		SetError(&Error{}).
@@ -2360,7 +2583,7 @@ func (s *DevicesService) RegisterDeviceForWSA(registerDeviceForWSAQueryParams *R
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation registerDeviceForWSA")
+		return nil, response, newAPIError("registerDeviceForWSA", response)
 	}
 
 	result := response.Result().(*RegisterDeviceForWSAResponse)
@@ -2419,6 +2642,11 @@ type RetrievesAllNetworkDevicesQueryParams struct {
 @param limit limit
 */
 func (s *DevicesService) RetrievesAllNetworkDevices(retrievesAllNetworkDevicesQueryParams *RetrievesAllNetworkDevicesQueryParams) (string, *resty.Response, error) {
+	return s.RetrievesAllNetworkDevicesWithContext(context.Background(), retrievesAllNetworkDevicesQueryParams)
+}
+
+// RetrievesAllNetworkDevicesWithContext is like RetrievesAllNetworkDevices but sends the request with ctx for cancellation and deadlines.
+func (s *DevicesService) RetrievesAllNetworkDevicesWithContext(ctx context.Context, retrievesAllNetworkDevicesQueryParams *RetrievesAllNetworkDevicesQueryParams) (string, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/network-device/autocomplete"
 
@@ -2426,6 +2654,7 @@ func (s *DevicesService) RetrievesAllNetworkDevices(retrievesAllNetworkDevicesQu
 
 	var operationResult string
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&operationResult).
 		SetError(&Error{}).
@@ -2441,10 +2670,16 @@ func (s *DevicesService) RetrievesAllNetworkDevices(retrievesAllNetworkDevicesQu
 /* Sync the devices provided as input
  */
 func (s *DevicesService) SyncDevices(syncDevicesRequest *SyncDevicesRequest) (*SyncDevicesResponse, *resty.Response, error) {
+	return s.SyncDevicesWithContext(context.Background(), syncDevicesRequest)
+}
+
+// SyncDevicesWithContext is like SyncDevices but sends the request with ctx for cancellation and deadlines.
+func (s *DevicesService) SyncDevicesWithContext(ctx context.Context, syncDevicesRequest *SyncDevicesRequest) (*SyncDevicesResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/network-device"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(syncDevicesRequest).
 		SetResult(&SyncDevicesResponse{}).
 		SetError(&Error{}).
@@ -2455,7 +2690,7 @@ func (s *DevicesService) SyncDevices(syncDevicesRequest *SyncDevicesRequest) (*S
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation syncDevices")
+		return nil, response, newAPIError("syncDevices", response)
 	}
 
 	result := response.Result().(*SyncDevicesResponse)
@@ -2472,12 +2707,18 @@ type SyncNetworkDevicesQueryParams struct {
 @param forceSync forceSync
 */
 func (s *DevicesService) SyncNetworkDevices(syncNetworkDevicesQueryParams *SyncNetworkDevicesQueryParams, syncNetworkDevicesRequest *[]SyncNetworkDevicesRequest) (*SyncNetworkDevicesResponse, *resty.Response, error) {
+	return s.SyncNetworkDevicesWithContext(context.Background(), syncNetworkDevicesQueryParams, syncNetworkDevicesRequest)
+}
+
+// SyncNetworkDevicesWithContext is like SyncNetworkDevices but sends the request with ctx for cancellation and deadlines.
+func (s *DevicesService) SyncNetworkDevicesWithContext(ctx context.Context, syncNetworkDevicesQueryParams *SyncNetworkDevicesQueryParams, syncNetworkDevicesRequest *[]SyncNetworkDevicesRequest) (*SyncNetworkDevicesResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/network-device/sync"
 
 	queryString, _ := query.Values(syncNetworkDevicesQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetBody(syncNetworkDevicesRequest).
 		SetResult(&SyncNetworkDevicesResponse{}).
@@ -2489,7 +2730,7 @@ func (s *DevicesService) SyncNetworkDevices(syncNetworkDevicesQueryParams *SyncN
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation syncNetworkDevices")
+		return nil, response, newAPIError("syncNetworkDevices", response)
 	}
 
 	result := response.Result().(*SyncNetworkDevicesResponse)
@@ -2500,10 +2741,16 @@ func (s *DevicesService) SyncNetworkDevices(syncNetworkDevicesQueryParams *SyncN
 /* Updates the role of the device as access, core, distribution, border router
  */
 func (s *DevicesService) UpdateDeviceRole(updateDeviceRoleRequest *UpdateDeviceRoleRequest) (*UpdateDeviceRoleResponse, *resty.Response, error) {
+	return s.UpdateDeviceRoleWithContext(context.Background(), updateDeviceRoleRequest)
+}
+
+// UpdateDeviceRoleWithContext is like UpdateDeviceRole but sends the request with ctx for cancellation and deadlines.
+func (s *DevicesService) UpdateDeviceRoleWithContext(ctx context.Context, updateDeviceRoleRequest *UpdateDeviceRoleRequest) (*UpdateDeviceRoleResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/network-device/brief"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(updateDeviceRoleRequest).
 		SetResult(&UpdateDeviceRoleResponse{}).
 		SetError(&Error{}).
@@ -2514,7 +2761,7 @@ func (s *DevicesService) UpdateDeviceRole(updateDeviceRoleRequest *UpdateDeviceR
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation updateDeviceRole")
+		return nil, response, newAPIError("updateDeviceRole", response)
 	}
 
 	result := response.Result().(*UpdateDeviceRoleResponse)
@@ -1,6 +1,7 @@
 package dnac
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -33,8 +34,8 @@ type CreateHTTPReadCredentialsRequest struct {
 	InstanceTenantID string `json:"instanceTenantId,omitempty"` //
 	InstanceUUID     string `json:"instanceUuid,omitempty"`     //
 	Password         string `json:"password,omitempty"`         //
-	Port             int    `json:"port,omitempty"`             //
-	Secure           bool   `json:"secure,omitempty"`           //
+	Port             *int   `json:"port,omitempty"`             //
+	Secure           *bool  `json:"secure,omitempty"`           //
 	Username         string `json:"username,omitempty"`         //
 }
 
@@ -47,8 +48,8 @@ type CreateHTTPWriteCredentialsRequest struct {
 	InstanceTenantID string `json:"instanceTenantId,omitempty"` //
 	InstanceUUID     string `json:"instanceUuid,omitempty"`     //
 	Password         string `json:"password,omitempty"`         //
-	Port             int    `json:"port,omitempty"`             //
-	Secure           bool   `json:"secure,omitempty"`           //
+	Port             *int   `json:"port,omitempty"`             //
+	Secure           *bool  `json:"secure,omitempty"`           //
 	Username         string `json:"username,omitempty"`         //
 }
 
@@ -106,13 +107,13 @@ type CreateUpdateSNMPPropertiesRequest struct {
 	ID                 string `json:"id,omitempty"`                 //
 	InstanceTenantID   string `json:"instanceTenantId,omitempty"`   //
 	InstanceUUID       string `json:"instanceUuid,omitempty"`       //
-	IntValue           int    `json:"intValue,omitempty"`           //
+	IntValue           *int   `json:"intValue,omitempty"`           //
 	SystemPropertyName string `json:"systemPropertyName,omitempty"` //
 }
 
 // StartDiscoveryRequest is the startDiscoveryRequest definition
 type StartDiscoveryRequest struct {
-	CdpLevel               int                                       `json:"cdpLevel,omitempty"`               //
+	CdpLevel               *int                                      `json:"cdpLevel,omitempty"`               //
 	DiscoveryType          string                                    `json:"discoveryType,omitempty"`          //
 	EnablePasswordList     []string                                  `json:"enablePasswordList,omitempty"`     //
 	GlobalCredentialIDList []string                                  `json:"globalCredentialIdList,omitempty"` //
@@ -120,16 +121,16 @@ type StartDiscoveryRequest struct {
 	HTTPWriteCredential    *StartDiscoveryRequestHTTPWriteCredential `json:"httpWriteCredential,omitempty"`    //
 	IPAddressList          string                                    `json:"ipAddressList,omitempty"`          //
 	IPFilterList           []string                                  `json:"ipFilterList,omitempty"`           //
-	LldpLevel              int                                       `json:"lldpLevel,omitempty"`              //
+	LldpLevel              *int                                      `json:"lldpLevel,omitempty"`              //
 	Name                   string                                    `json:"name,omitempty"`                   //
 	NetconfPort            string                                    `json:"netconfPort,omitempty"`            //
-	NoAddNewDevice         bool                                      `json:"noAddNewDevice,omitempty"`         //
+	NoAddNewDevice         *bool                                     `json:"noAddNewDevice,omitempty"`         //
 	ParentDiscoveryID      string                                    `json:"parentDiscoveryId,omitempty"`      //
 	PasswordList           []string                                  `json:"passwordList,omitempty"`           //
 	PreferredMgmtIPMethod  string                                    `json:"preferredMgmtIPMethod,omitempty"`  //
 	ProtocolOrder          string                                    `json:"protocolOrder,omitempty"`          //
-	ReDiscovery            bool                                      `json:"reDiscovery,omitempty"`            //
-	Retry                  int                                       `json:"retry,omitempty"`                  //
+	ReDiscovery            *bool                                     `json:"reDiscovery,omitempty"`            //
+	Retry                  *int                                      `json:"retry,omitempty"`                  //
 	SNMPAuthPassphrase     string                                    `json:"snmpAuthPassphrase,omitempty"`     //
 	SNMPAuthProtocol       string                                    `json:"snmpAuthProtocol,omitempty"`       //
 	SNMPMode               string                                    `json:"snmpMode,omitempty"`               //
@@ -141,8 +142,8 @@ type StartDiscoveryRequest struct {
 	SNMPRWCommunityDesc    string                                    `json:"snmpRWCommunityDesc,omitempty"`    //
 	SNMPUserName           string                                    `json:"snmpUserName,omitempty"`           //
 	SNMPVersion            string                                    `json:"snmpVersion,omitempty"`            //
-	Timeout                int                                       `json:"timeout,omitempty"`                //
-	UpdateMgmtIP           bool                                      `json:"updateMgmtIp,omitempty"`           //
+	Timeout                *int                                      `json:"timeout,omitempty"`                //
+	UpdateMgmtIP           *bool                                     `json:"updateMgmtIp,omitempty"`           //
 	UserNameList           []string                                  `json:"userNameList,omitempty"`           //
 }
 
@@ -161,8 +162,8 @@ type StartDiscoveryRequestHTTPReadCredential struct {
 	InstanceTenantID string `json:"instanceTenantId,omitempty"` //
 	InstanceUUID     string `json:"instanceUuid,omitempty"`     //
 	Password         string `json:"password,omitempty"`         //
-	Port             int    `json:"port,omitempty"`             //
-	Secure           bool   `json:"secure,omitempty"`           //
+	Port             *int   `json:"port,omitempty"`             //
+	Secure           *bool  `json:"secure,omitempty"`           //
 	Username         string `json:"username,omitempty"`         //
 }
 
@@ -175,8 +176,8 @@ type StartDiscoveryRequestHTTPWriteCredential struct {
 	InstanceTenantID string `json:"instanceTenantId,omitempty"` //
 	InstanceUUID     string `json:"instanceUuid,omitempty"`     //
 	Password         string `json:"password,omitempty"`         //
-	Port             int    `json:"port,omitempty"`             //
-	Secure           bool   `json:"secure,omitempty"`           //
+	Port             *int   `json:"port,omitempty"`             //
+	Secure           *bool  `json:"secure,omitempty"`           //
 	Username         string `json:"username,omitempty"`         //
 }
 
@@ -219,8 +220,8 @@ type UpdateHTTPReadCredentialRequest struct {
 	InstanceTenantID string `json:"instanceTenantId,omitempty"` //
 	InstanceUUID     string `json:"instanceUuid,omitempty"`     //
 	Password         string `json:"password,omitempty"`         //
-	Port             int    `json:"port,omitempty"`             //
-	Secure           bool   `json:"secure,omitempty"`           //
+	Port             *int   `json:"port,omitempty"`             //
+	Secure           *bool  `json:"secure,omitempty"`           //
 	Username         string `json:"username,omitempty"`         //
 }
 
@@ -233,8 +234,8 @@ type UpdateHTTPWriteCredentialsRequest struct {
 	InstanceTenantID string `json:"instanceTenantId,omitempty"` //
 	InstanceUUID     string `json:"instanceUuid,omitempty"`     //
 	Password         string `json:"password,omitempty"`         //
-	Port             int    `json:"port,omitempty"`             //
-	Secure           bool   `json:"secure,omitempty"`           //
+	Port             *int   `json:"port,omitempty"`             //
+	Secure           *bool  `json:"secure,omitempty"`           //
 	Username         string `json:"username,omitempty"`         //
 }
 
@@ -299,8 +300,8 @@ type UpdatesAnExistingDiscoveryBySpecifiedIDRequestHTTPReadCredential struct {
 	InstanceTenantID string `json:"instanceTenantId,omitempty"` //
 	InstanceUUID     string `json:"instanceUuid,omitempty"`     //
 	Password         string `json:"password,omitempty"`         //
-	Port             int    `json:"port,omitempty"`             //
-	Secure           bool   `json:"secure,omitempty"`           //
+	Port             *int   `json:"port,omitempty"`             //
+	Secure           *bool  `json:"secure,omitempty"`           //
 	Username         string `json:"username,omitempty"`         //
 }
 
@@ -313,15 +314,15 @@ type UpdatesAnExistingDiscoveryBySpecifiedIDRequestHTTPWriteCredential struct {
 	InstanceTenantID string `json:"instanceTenantId,omitempty"` //
 	InstanceUUID     string `json:"instanceUuid,omitempty"`     //
 	Password         string `json:"password,omitempty"`         //
-	Port             int    `json:"port,omitempty"`             //
-	Secure           bool   `json:"secure,omitempty"`           //
+	Port             *int   `json:"port,omitempty"`             //
+	Secure           *bool  `json:"secure,omitempty"`           //
 	Username         string `json:"username,omitempty"`         //
 }
 
 // UpdatesAnExistingDiscoveryBySpecifiedIDRequest is the updatesAnExistingDiscoveryBySpecifiedIdRequest definition
 type UpdatesAnExistingDiscoveryBySpecifiedIDRequest struct {
 	AttributeInfo          string                                                             `json:"attributeInfo,omitempty"`          //
-	CdpLevel               int                                                                `json:"cdpLevel,omitempty"`               //
+	CdpLevel               *int                                                               `json:"cdpLevel,omitempty"`               //
 	DeviceIDs              string                                                             `json:"deviceIds,omitempty"`              //
 	DiscoveryCondition     string                                                             `json:"discoveryCondition,omitempty"`     //
 	DiscoveryStatus        string                                                             `json:"discoveryStatus,omitempty"`        //
@@ -333,16 +334,16 @@ type UpdatesAnExistingDiscoveryBySpecifiedIDRequest struct {
 	ID                     string                                                             `json:"id,omitempty"`                     //
 	IPAddressList          string                                                             `json:"ipAddressList,omitempty"`          //
 	IPFilterList           string                                                             `json:"ipFilterList,omitempty"`           //
-	IsAutoCdp              bool                                                               `json:"isAutoCdp,omitempty"`              //
-	LldpLevel              int                                                                `json:"lldpLevel,omitempty"`              //
+	IsAutoCdp              *bool                                                              `json:"isAutoCdp,omitempty"`              //
+	LldpLevel              *int                                                               `json:"lldpLevel,omitempty"`              //
 	Name                   string                                                             `json:"name,omitempty"`                   //
 	NetconfPort            string                                                             `json:"netconfPort,omitempty"`            //
-	NumDevices             int                                                                `json:"numDevices,omitempty"`             //
+	NumDevices             *int                                                               `json:"numDevices,omitempty"`             //
 	ParentDiscoveryID      string                                                             `json:"parentDiscoveryId,omitempty"`      //
 	PasswordList           string                                                             `json:"passwordList,omitempty"`           //
 	PreferredMgmtIPMethod  string                                                             `json:"preferredMgmtIPMethod,omitempty"`  //
 	ProtocolOrder          string                                                             `json:"protocolOrder,omitempty"`          //
-	RetryCount             int                                                                `json:"retryCount,omitempty"`             //
+	RetryCount             *int                                                               `json:"retryCount,omitempty"`             //
 	SNMPAuthPassphrase     string                                                             `json:"snmpAuthPassphrase,omitempty"`     //
 	SNMPAuthProtocol       string                                                             `json:"snmpAuthProtocol,omitempty"`       //
 	SNMPMode               string                                                             `json:"snmpMode,omitempty"`               //
@@ -353,8 +354,8 @@ type UpdatesAnExistingDiscoveryBySpecifiedIDRequest struct {
 	SNMPRwCommunity        string                                                             `json:"snmpRwCommunity,omitempty"`        //
 	SNMPRwCommunityDesc    string                                                             `json:"snmpRwCommunityDesc,omitempty"`    //
 	SNMPUserName           string                                                             `json:"snmpUserName,omitempty"`           //
-	TimeOut                int                                                                `json:"timeOut,omitempty"`                //
-	UpdateMgmtIP           bool                                                               `json:"updateMgmtIp,omitempty"`           //
+	TimeOut                *int                                                               `json:"timeOut,omitempty"`                //
+	UpdateMgmtIP           *bool                                                              `json:"updateMgmtIp,omitempty"`           //
 	UserNameList           string                                                             `json:"userNameList,omitempty"`           //
 }
 
@@ -999,10 +1000,16 @@ type UpdatesAnExistingDiscoveryBySpecifiedIDResponse struct {
 /* Adds global CLI credential
  */
 func (s *DiscoveryService) CreateCLICredentials(createCLICredentialsRequest *[]CreateCLICredentialsRequest) (*CreateCLICredentialsResponse, *resty.Response, error) {
+	return s.CreateCLICredentialsWithContext(context.Background(), createCLICredentialsRequest)
+}
+
+// CreateCLICredentialsWithContext is like CreateCLICredentials but sends the request with ctx for cancellation and deadlines.
+func (s *DiscoveryService) CreateCLICredentialsWithContext(ctx context.Context, createCLICredentialsRequest *[]CreateCLICredentialsRequest) (*CreateCLICredentialsResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/global-credential/cli"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(createCLICredentialsRequest).
 		SetResult(&CreateCLICredentialsResponse{}).
 		SetError(&Error{}).
@@ -1013,7 +1020,7 @@ func (s *DiscoveryService) CreateCLICredentials(createCLICredentialsRequest *[]C
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation createCLICredentials")
+		return nil, response, newAPIError("createCLICredentials", response)
 	}
 
 	result := response.Result().(*CreateCLICredentialsResponse)
@@ -1024,10 +1031,16 @@ func (s *DiscoveryService) CreateCLICredentials(createCLICredentialsRequest *[]C
 /* Adds HTTP read credentials
  */
 func (s *DiscoveryService) CreateHTTPReadCredentials(createHTTPReadCredentialsRequest *[]CreateHTTPReadCredentialsRequest) (*CreateHTTPReadCredentialsResponse, *resty.Response, error) {
+	return s.CreateHTTPReadCredentialsWithContext(context.Background(), createHTTPReadCredentialsRequest)
+}
+
+// CreateHTTPReadCredentialsWithContext is like CreateHTTPReadCredentials but sends the request with ctx for cancellation and deadlines.
+func (s *DiscoveryService) CreateHTTPReadCredentialsWithContext(ctx context.Context, createHTTPReadCredentialsRequest *[]CreateHTTPReadCredentialsRequest) (*CreateHTTPReadCredentialsResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/global-credential/http-read"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(createHTTPReadCredentialsRequest).
 		SetResult(&CreateHTTPReadCredentialsResponse{}).
 		SetError(&Error{}).
@@ -1038,7 +1051,7 @@ func (s *DiscoveryService) CreateHTTPReadCredentials(createHTTPReadCredentialsRe
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation createHTTPReadCredentials")
+		return nil, response, newAPIError("createHTTPReadCredentials", response)
 	}
 
 	result := response.Result().(*CreateHTTPReadCredentialsResponse)
@@ -1049,10 +1062,16 @@ func (s *DiscoveryService) CreateHTTPReadCredentials(createHTTPReadCredentialsRe
 /* Adds global HTTP write credentials
  */
 func (s *DiscoveryService) CreateHTTPWriteCredentials(createHTTPWriteCredentialsRequest *[]CreateHTTPWriteCredentialsRequest) (*CreateHTTPWriteCredentialsResponse, *resty.Response, error) {
+	return s.CreateHTTPWriteCredentialsWithContext(context.Background(), createHTTPWriteCredentialsRequest)
+}
+
+// CreateHTTPWriteCredentialsWithContext is like CreateHTTPWriteCredentials but sends the request with ctx for cancellation and deadlines.
+func (s *DiscoveryService) CreateHTTPWriteCredentialsWithContext(ctx context.Context, createHTTPWriteCredentialsRequest *[]CreateHTTPWriteCredentialsRequest) (*CreateHTTPWriteCredentialsResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/global-credential/http-write"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(createHTTPWriteCredentialsRequest).
 		SetResult(&CreateHTTPWriteCredentialsResponse{}).
 		SetError(&Error{}).
@@ -1063,7 +1082,7 @@ func (s *DiscoveryService) CreateHTTPWriteCredentials(createHTTPWriteCredentials
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation createHTTPWriteCredentials")
+		return nil, response, newAPIError("createHTTPWriteCredentials", response)
 	}
 
 	result := response.Result().(*CreateHTTPWriteCredentialsResponse)
@@ -1074,10 +1093,16 @@ func (s *DiscoveryService) CreateHTTPWriteCredentials(createHTTPWriteCredentials
 /* Adds global netconf credentials
  */
 func (s *DiscoveryService) CreateNetconfCredentials(createNetconfCredentialsRequest *[]CreateNetconfCredentialsRequest) (*CreateNetconfCredentialsResponse, *resty.Response, error) {
+	return s.CreateNetconfCredentialsWithContext(context.Background(), createNetconfCredentialsRequest)
+}
+
+// CreateNetconfCredentialsWithContext is like CreateNetconfCredentials but sends the request with ctx for cancellation and deadlines.
+func (s *DiscoveryService) CreateNetconfCredentialsWithContext(ctx context.Context, createNetconfCredentialsRequest *[]CreateNetconfCredentialsRequest) (*CreateNetconfCredentialsResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/global-credential/netconf"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(createNetconfCredentialsRequest).
 		SetResult(&CreateNetconfCredentialsResponse{}).
 		SetError(&Error{}).
@@ -1088,7 +1113,7 @@ func (s *DiscoveryService) CreateNetconfCredentials(createNetconfCredentialsRequ
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation createNetconfCredentials")
+		return nil, response, newAPIError("createNetconfCredentials", response)
 	}
 
 	result := response.Result().(*CreateNetconfCredentialsResponse)
@@ -1099,10 +1124,16 @@ func (s *DiscoveryService) CreateNetconfCredentials(createNetconfCredentialsRequ
 /* Adds global SNMP read community
  */
 func (s *DiscoveryService) CreateSNMPReadCommunity(createSNMPReadCommunityRequest *[]CreateSNMPReadCommunityRequest) (*CreateSNMPReadCommunityResponse, *resty.Response, error) {
+	return s.CreateSNMPReadCommunityWithContext(context.Background(), createSNMPReadCommunityRequest)
+}
+
+// CreateSNMPReadCommunityWithContext is like CreateSNMPReadCommunity but sends the request with ctx for cancellation and deadlines.
+func (s *DiscoveryService) CreateSNMPReadCommunityWithContext(ctx context.Context, createSNMPReadCommunityRequest *[]CreateSNMPReadCommunityRequest) (*CreateSNMPReadCommunityResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/global-credential/snmpv2-read-community"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(createSNMPReadCommunityRequest).
 		SetResult(&CreateSNMPReadCommunityResponse{}).
 		SetError(&Error{}).
@@ -1113,7 +1144,7 @@ func (s *DiscoveryService) CreateSNMPReadCommunity(createSNMPReadCommunityReques
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation createSNMPReadCommunity")
+		return nil, response, newAPIError("createSNMPReadCommunity", response)
 	}
 
 	result := response.Result().(*CreateSNMPReadCommunityResponse)
@@ -1124,10 +1155,16 @@ func (s *DiscoveryService) CreateSNMPReadCommunity(createSNMPReadCommunityReques
 /* Adds global SNMP write community
  */
 func (s *DiscoveryService) CreateSNMPWriteCommunity(createSNMPWriteCommunityRequest *[]CreateSNMPWriteCommunityRequest) (*CreateSNMPWriteCommunityResponse, *resty.Response, error) {
+	return s.CreateSNMPWriteCommunityWithContext(context.Background(), createSNMPWriteCommunityRequest)
+}
+
+// CreateSNMPWriteCommunityWithContext is like CreateSNMPWriteCommunity but sends the request with ctx for cancellation and deadlines.
+func (s *DiscoveryService) CreateSNMPWriteCommunityWithContext(ctx context.Context, createSNMPWriteCommunityRequest *[]CreateSNMPWriteCommunityRequest) (*CreateSNMPWriteCommunityResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/global-credential/snmpv2-write-community"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(createSNMPWriteCommunityRequest).
 		SetResult(&CreateSNMPWriteCommunityResponse{}).
 		SetError(&Error{}).
@@ -1138,7 +1175,7 @@ func (s *DiscoveryService) CreateSNMPWriteCommunity(createSNMPWriteCommunityRequ
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation createSNMPWriteCommunity")
+		return nil, response, newAPIError("createSNMPWriteCommunity", response)
 	}
 
 	result := response.Result().(*CreateSNMPWriteCommunityResponse)
@@ -1149,10 +1186,16 @@ func (s *DiscoveryService) CreateSNMPWriteCommunity(createSNMPWriteCommunityRequ
 /* Adds global SNMPv3 credentials
  */
 func (s *DiscoveryService) CreateSNMPv3Credentials(createSNMPv3CredentialsRequest *[]CreateSNMPv3CredentialsRequest) (*CreateSNMPv3CredentialsResponse, *resty.Response, error) {
+	return s.CreateSNMPv3CredentialsWithContext(context.Background(), createSNMPv3CredentialsRequest)
+}
+
+// CreateSNMPv3CredentialsWithContext is like CreateSNMPv3Credentials but sends the request with ctx for cancellation and deadlines.
+func (s *DiscoveryService) CreateSNMPv3CredentialsWithContext(ctx context.Context, createSNMPv3CredentialsRequest *[]CreateSNMPv3CredentialsRequest) (*CreateSNMPv3CredentialsResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/global-credential/snmpv3"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(createSNMPv3CredentialsRequest).
 		SetResult(&CreateSNMPv3CredentialsResponse{}).
 		SetError(&Error{}).
@@ -1163,7 +1206,7 @@ func (s *DiscoveryService) CreateSNMPv3Credentials(createSNMPv3CredentialsReques
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation createSNMPv3Credentials")
+		return nil, response, newAPIError("createSNMPv3Credentials", response)
 	}
 
 	result := response.Result().(*CreateSNMPv3CredentialsResponse)
@@ -1174,10 +1217,16 @@ func (s *DiscoveryService) CreateSNMPv3Credentials(createSNMPv3CredentialsReques
 /* Adds SNMP properties
  */
 func (s *DiscoveryService) CreateUpdateSNMPProperties(createUpdateSNMPPropertiesRequest *[]CreateUpdateSNMPPropertiesRequest) (*CreateUpdateSNMPPropertiesResponse, *resty.Response, error) {
+	return s.CreateUpdateSNMPPropertiesWithContext(context.Background(), createUpdateSNMPPropertiesRequest)
+}
+
+// CreateUpdateSNMPPropertiesWithContext is like CreateUpdateSNMPProperties but sends the request with ctx for cancellation and deadlines.
+func (s *DiscoveryService) CreateUpdateSNMPPropertiesWithContext(ctx context.Context, createUpdateSNMPPropertiesRequest *[]CreateUpdateSNMPPropertiesRequest) (*CreateUpdateSNMPPropertiesResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/snmp-property"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(createUpdateSNMPPropertiesRequest).
 		SetResult(&CreateUpdateSNMPPropertiesResponse{}).
 		SetError(&Error{}).
@@ -1188,7 +1237,7 @@ func (s *DiscoveryService) CreateUpdateSNMPProperties(createUpdateSNMPProperties
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation createUpdateSNMPProperties")
+		return nil, response, newAPIError("createUpdateSNMPProperties", response)
 	}
 
 	result := response.Result().(*CreateUpdateSNMPPropertiesResponse)
@@ -1199,10 +1248,16 @@ func (s *DiscoveryService) CreateUpdateSNMPProperties(createUpdateSNMPProperties
 /* Stops all the discoveries and removes them
  */
 func (s *DiscoveryService) DeleteAllDiscovery() (*DeleteAllDiscoveryResponse, *resty.Response, error) {
+	return s.DeleteAllDiscoveryWithContext(context.Background())
+}
+
+// DeleteAllDiscoveryWithContext is like DeleteAllDiscovery but sends the request with ctx for cancellation and deadlines.
+func (s *DiscoveryService) DeleteAllDiscoveryWithContext(ctx context.Context) (*DeleteAllDiscoveryResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/discovery"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&DeleteAllDiscoveryResponse{}).
 		SetError(&Error{}).
 		Delete(path)
@@ -1212,7 +1267,7 @@ func (s *DiscoveryService) DeleteAllDiscovery() (*DeleteAllDiscoveryResponse, *r
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation deleteAllDiscovery")
+		return nil, response, newAPIError("deleteAllDiscovery", response)
 	}
 
 	result := response.Result().(*DeleteAllDiscoveryResponse)
@@ -1224,11 +1279,17 @@ func (s *DiscoveryService) DeleteAllDiscovery() (*DeleteAllDiscoveryResponse, *r
 @param id Discovery ID
 */
 func (s *DiscoveryService) DeleteDiscoveryByID(id string) (*DeleteDiscoveryByIDResponse, *resty.Response, error) {
+	return s.DeleteDiscoveryByIDWithContext(context.Background(), id)
+}
+
+// DeleteDiscoveryByIDWithContext is like DeleteDiscoveryByID but sends the request with ctx for cancellation and deadlines.
+func (s *DiscoveryService) DeleteDiscoveryByIDWithContext(ctx context.Context, id string) (*DeleteDiscoveryByIDResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/discovery/{id}"
 	path = strings.Replace(path, "{"+"id"+"}", fmt.Sprintf("%v", id), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&DeleteDiscoveryByIDResponse{}).
 		SetError(&Error{}).
 		Delete(path)
@@ -1238,7 +1299,7 @@ func (s *DiscoveryService) DeleteDiscoveryByID(id string) (*DeleteDiscoveryByIDR
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation deleteDiscoveryById")
+		return nil, response, newAPIError("deleteDiscoveryById", response)
 	}
 
 	result := response.Result().(*DeleteDiscoveryByIDResponse)
@@ -1251,12 +1312,18 @@ func (s *DiscoveryService) DeleteDiscoveryByID(id string) (*DeleteDiscoveryByIDR
 @param recordsToDelete Number of records to delete
 */
 func (s *DiscoveryService) DeleteDiscoveryBySpecifiedRange(startIndex int, recordsToDelete int) (*DeleteDiscoveryBySpecifiedRangeResponse, *resty.Response, error) {
+	return s.DeleteDiscoveryBySpecifiedRangeWithContext(context.Background(), startIndex, recordsToDelete)
+}
+
+// DeleteDiscoveryBySpecifiedRangeWithContext is like DeleteDiscoveryBySpecifiedRange but sends the request with ctx for cancellation and deadlines.
+func (s *DiscoveryService) DeleteDiscoveryBySpecifiedRangeWithContext(ctx context.Context, startIndex int, recordsToDelete int) (*DeleteDiscoveryBySpecifiedRangeResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/discovery/{startIndex}/{recordsToDelete}"
 	path = strings.Replace(path, "{"+"startIndex"+"}", fmt.Sprintf("%v", startIndex), -1)
 	path = strings.Replace(path, "{"+"recordsToDelete"+"}", fmt.Sprintf("%v", recordsToDelete), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&DeleteDiscoveryBySpecifiedRangeResponse{}).
 		SetError(&Error{}).
 		Delete(path)
@@ -1266,7 +1333,7 @@ func (s *DiscoveryService) DeleteDiscoveryBySpecifiedRange(startIndex int, recor
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation deleteDiscoveryBySpecifiedRange")
+		return nil, response, newAPIError("deleteDiscoveryBySpecifiedRange", response)
 	}
 
 	result := response.Result().(*DeleteDiscoveryBySpecifiedRangeResponse)
@@ -1278,11 +1345,17 @@ func (s *DiscoveryService) DeleteDiscoveryBySpecifiedRange(startIndex int, recor
 @param globalCredentialID ID of global-credential
 */
 func (s *DiscoveryService) DeleteGlobalCredentialsByID(globalCredentialID string) (*DeleteGlobalCredentialsByIDResponse, *resty.Response, error) {
+	return s.DeleteGlobalCredentialsByIDWithContext(context.Background(), globalCredentialID)
+}
+
+// DeleteGlobalCredentialsByIDWithContext is like DeleteGlobalCredentialsByID but sends the request with ctx for cancellation and deadlines.
+func (s *DiscoveryService) DeleteGlobalCredentialsByIDWithContext(ctx context.Context, globalCredentialID string) (*DeleteGlobalCredentialsByIDResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/global-credential/{globalCredentialId}"
 	path = strings.Replace(path, "{"+"globalCredentialId"+"}", fmt.Sprintf("%v", globalCredentialID), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&DeleteGlobalCredentialsByIDResponse{}).
 		SetError(&Error{}).
 		Delete(path)
@@ -1292,7 +1365,7 @@ func (s *DiscoveryService) DeleteGlobalCredentialsByID(globalCredentialID string
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation deleteGlobalCredentialsById")
+		return nil, response, newAPIError("deleteGlobalCredentialsById", response)
 	}
 
 	result := response.Result().(*DeleteGlobalCredentialsByIDResponse)
@@ -1303,10 +1376,16 @@ func (s *DiscoveryService) DeleteGlobalCredentialsByID(globalCredentialID string
 /* Returns the count of all available discovery jobs
  */
 func (s *DiscoveryService) GetCountOfAllDiscoveryJobs() (*GetCountOfAllDiscoveryJobsResponse, *resty.Response, error) {
+	return s.GetCountOfAllDiscoveryJobsWithContext(context.Background())
+}
+
+// GetCountOfAllDiscoveryJobsWithContext is like GetCountOfAllDiscoveryJobs but sends the request with ctx for cancellation and deadlines.
+func (s *DiscoveryService) GetCountOfAllDiscoveryJobsWithContext(ctx context.Context) (*GetCountOfAllDiscoveryJobsResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/discovery/count"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&GetCountOfAllDiscoveryJobsResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -1316,7 +1395,7 @@ func (s *DiscoveryService) GetCountOfAllDiscoveryJobs() (*GetCountOfAllDiscovery
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getCountOfAllDiscoveryJobs")
+		return nil, response, newAPIError("getCountOfAllDiscoveryJobs", response)
 	}
 
 	result := response.Result().(*GetCountOfAllDiscoveryJobsResponse)
@@ -1328,11 +1407,17 @@ func (s *DiscoveryService) GetCountOfAllDiscoveryJobs() (*GetCountOfAllDiscovery
 @param id Global Credential ID
 */
 func (s *DiscoveryService) GetCredentialSubTypeByCredentialID(id string) (*GetCredentialSubTypeByCredentialIDResponse, *resty.Response, error) {
+	return s.GetCredentialSubTypeByCredentialIDWithContext(context.Background(), id)
+}
+
+// GetCredentialSubTypeByCredentialIDWithContext is like GetCredentialSubTypeByCredentialID but sends the request with ctx for cancellation and deadlines.
+func (s *DiscoveryService) GetCredentialSubTypeByCredentialIDWithContext(ctx context.Context, id string) (*GetCredentialSubTypeByCredentialIDResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/global-credential/{id}"
 	path = strings.Replace(path, "{"+"id"+"}", fmt.Sprintf("%v", id), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&GetCredentialSubTypeByCredentialIDResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -1342,7 +1427,7 @@ func (s *DiscoveryService) GetCredentialSubTypeByCredentialID(id string) (*GetCr
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getCredentialSubTypeByCredentialId")
+		return nil, response, newAPIError("getCredentialSubTypeByCredentialId", response)
 	}
 
 	result := response.Result().(*GetCredentialSubTypeByCredentialIDResponse)
@@ -1360,6 +1445,11 @@ type GetDevicesDiscoveredByIDQueryParams struct {
 @param taskID taskId
 */
 func (s *DiscoveryService) GetDevicesDiscoveredByID(id string, getDevicesDiscoveredByIDQueryParams *GetDevicesDiscoveredByIDQueryParams) (*GetDevicesDiscoveredByIDResponse, *resty.Response, error) {
+	return s.GetDevicesDiscoveredByIDWithContext(context.Background(), id, getDevicesDiscoveredByIDQueryParams)
+}
+
+// GetDevicesDiscoveredByIDWithContext is like GetDevicesDiscoveredByID but sends the request with ctx for cancellation and deadlines.
+func (s *DiscoveryService) GetDevicesDiscoveredByIDWithContext(ctx context.Context, id string, getDevicesDiscoveredByIDQueryParams *GetDevicesDiscoveredByIDQueryParams) (*GetDevicesDiscoveredByIDResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/discovery/{id}/network-device/count"
 	path = strings.Replace(path, "{"+"id"+"}", fmt.Sprintf("%v", id), -1)
@@ -1367,6 +1457,7 @@ func (s *DiscoveryService) GetDevicesDiscoveredByID(id string, getDevicesDiscove
 	queryString, _ := query.Values(getDevicesDiscoveredByIDQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetDevicesDiscoveredByIDResponse{}).
 		SetError(&Error{}).
@@ -1377,7 +1468,7 @@ func (s *DiscoveryService) GetDevicesDiscoveredByID(id string, getDevicesDiscove
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getDevicesDiscoveredById")
+		return nil, response, newAPIError("getDevicesDiscoveredById", response)
 	}
 
 	result := response.Result().(*GetDevicesDiscoveredByIDResponse)
@@ -1397,6 +1488,11 @@ type GetDiscoveredDevicesByRangeQueryParams struct {
 @param taskID taskId
 */
 func (s *DiscoveryService) GetDiscoveredDevicesByRange(id string, startIndex int, recordsToReturn int, getDiscoveredDevicesByRangeQueryParams *GetDiscoveredDevicesByRangeQueryParams) (*GetDiscoveredDevicesByRangeResponse, *resty.Response, error) {
+	return s.GetDiscoveredDevicesByRangeWithContext(context.Background(), id, startIndex, recordsToReturn, getDiscoveredDevicesByRangeQueryParams)
+}
+
+// GetDiscoveredDevicesByRangeWithContext is like GetDiscoveredDevicesByRange but sends the request with ctx for cancellation and deadlines.
+func (s *DiscoveryService) GetDiscoveredDevicesByRangeWithContext(ctx context.Context, id string, startIndex int, recordsToReturn int, getDiscoveredDevicesByRangeQueryParams *GetDiscoveredDevicesByRangeQueryParams) (*GetDiscoveredDevicesByRangeResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/discovery/{id}/network-device/{startIndex}/{recordsToReturn}"
 	path = strings.Replace(path, "{"+"id"+"}", fmt.Sprintf("%v", id), -1)
@@ -1406,6 +1502,7 @@ func (s *DiscoveryService) GetDiscoveredDevicesByRange(id string, startIndex int
 	queryString, _ := query.Values(getDiscoveredDevicesByRangeQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetDiscoveredDevicesByRangeResponse{}).
 		SetError(&Error{}).
@@ -1416,7 +1513,7 @@ func (s *DiscoveryService) GetDiscoveredDevicesByRange(id string, startIndex int
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getDiscoveredDevicesByRange")
+		return nil, response, newAPIError("getDiscoveredDevicesByRange", response)
 	}
 
 	result := response.Result().(*GetDiscoveredDevicesByRangeResponse)
@@ -1434,6 +1531,11 @@ type GetDiscoveredNetworkDevicesByDiscoveryIDQueryParams struct {
 @param taskID taskId
 */
 func (s *DiscoveryService) GetDiscoveredNetworkDevicesByDiscoveryID(id string, getDiscoveredNetworkDevicesByDiscoveryIDQueryParams *GetDiscoveredNetworkDevicesByDiscoveryIDQueryParams) (*GetDiscoveredNetworkDevicesByDiscoveryIDResponse, *resty.Response, error) {
+	return s.GetDiscoveredNetworkDevicesByDiscoveryIDWithContext(context.Background(), id, getDiscoveredNetworkDevicesByDiscoveryIDQueryParams)
+}
+
+// GetDiscoveredNetworkDevicesByDiscoveryIDWithContext is like GetDiscoveredNetworkDevicesByDiscoveryID but sends the request with ctx for cancellation and deadlines.
+func (s *DiscoveryService) GetDiscoveredNetworkDevicesByDiscoveryIDWithContext(ctx context.Context, id string, getDiscoveredNetworkDevicesByDiscoveryIDQueryParams *GetDiscoveredNetworkDevicesByDiscoveryIDQueryParams) (*GetDiscoveredNetworkDevicesByDiscoveryIDResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/discovery/{id}/network-device"
 	path = strings.Replace(path, "{"+"id"+"}", fmt.Sprintf("%v", id), -1)
@@ -1441,6 +1543,7 @@ func (s *DiscoveryService) GetDiscoveredNetworkDevicesByDiscoveryID(id string, g
 	queryString, _ := query.Values(getDiscoveredNetworkDevicesByDiscoveryIDQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetDiscoveredNetworkDevicesByDiscoveryIDResponse{}).
 		SetError(&Error{}).
@@ -1451,7 +1554,7 @@ func (s *DiscoveryService) GetDiscoveredNetworkDevicesByDiscoveryID(id string, g
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getDiscoveredNetworkDevicesByDiscoveryId")
+		return nil, response, newAPIError("getDiscoveredNetworkDevicesByDiscoveryId", response)
 	}
 
 	result := response.Result().(*GetDiscoveredNetworkDevicesByDiscoveryIDResponse)
@@ -1464,12 +1567,18 @@ func (s *DiscoveryService) GetDiscoveredNetworkDevicesByDiscoveryID(id string, g
 @param recordsToReturn Number of records to return
 */
 func (s *DiscoveryService) GetDiscoveriesByRange(startIndex int, recordsToReturn int) (*GetDiscoveriesByRangeResponse, *resty.Response, error) {
+	return s.GetDiscoveriesByRangeWithContext(context.Background(), startIndex, recordsToReturn)
+}
+
+// GetDiscoveriesByRangeWithContext is like GetDiscoveriesByRange but sends the request with ctx for cancellation and deadlines.
+func (s *DiscoveryService) GetDiscoveriesByRangeWithContext(ctx context.Context, startIndex int, recordsToReturn int) (*GetDiscoveriesByRangeResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/discovery/{startIndex}/{recordsToReturn}"
 	path = strings.Replace(path, "{"+"startIndex"+"}", fmt.Sprintf("%v", startIndex), -1)
 	path = strings.Replace(path, "{"+"recordsToReturn"+"}", fmt.Sprintf("%v", recordsToReturn), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&GetDiscoveriesByRangeResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -1479,7 +1588,7 @@ func (s *DiscoveryService) GetDiscoveriesByRange(startIndex int, recordsToReturn
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getDiscoveriesByRange")
+		return nil, response, newAPIError("getDiscoveriesByRange", response)
 	}
 
 	result := response.Result().(*GetDiscoveriesByRangeResponse)
@@ -1491,11 +1600,17 @@ func (s *DiscoveryService) GetDiscoveriesByRange(startIndex int, recordsToReturn
 @param id Discovery ID
 */
 func (s *DiscoveryService) GetDiscoveryByID(id string) (*GetDiscoveryByIDResponse, *resty.Response, error) {
+	return s.GetDiscoveryByIDWithContext(context.Background(), id)
+}
+
+// GetDiscoveryByIDWithContext is like GetDiscoveryByID but sends the request with ctx for cancellation and deadlines.
+func (s *DiscoveryService) GetDiscoveryByIDWithContext(ctx context.Context, id string) (*GetDiscoveryByIDResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/discovery/{id}"
 	path = strings.Replace(path, "{"+"id"+"}", fmt.Sprintf("%v", id), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&GetDiscoveryByIDResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -1505,7 +1620,7 @@ func (s *DiscoveryService) GetDiscoveryByID(id string) (*GetDiscoveryByIDRespons
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getDiscoveryById")
+		return nil, response, newAPIError("getDiscoveryById", response)
 	}
 
 	result := response.Result().(*GetDiscoveryByIDResponse)
@@ -1528,12 +1643,18 @@ type GetDiscoveryJobsByIPQueryParams struct {
 @param name name
 */
 func (s *DiscoveryService) GetDiscoveryJobsByIP(getDiscoveryJobsByIPQueryParams *GetDiscoveryJobsByIPQueryParams) (*GetDiscoveryJobsByIPResponse, *resty.Response, error) {
+	return s.GetDiscoveryJobsByIPWithContext(context.Background(), getDiscoveryJobsByIPQueryParams)
+}
+
+// GetDiscoveryJobsByIPWithContext is like GetDiscoveryJobsByIP but sends the request with ctx for cancellation and deadlines.
+func (s *DiscoveryService) GetDiscoveryJobsByIPWithContext(ctx context.Context, getDiscoveryJobsByIPQueryParams *GetDiscoveryJobsByIPQueryParams) (*GetDiscoveryJobsByIPResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/discovery/job"
 
 	queryString, _ := query.Values(getDiscoveryJobsByIPQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetDiscoveryJobsByIPResponse{}).
 		SetError(&Error{}).
@@ -1544,7 +1665,7 @@ func (s *DiscoveryService) GetDiscoveryJobsByIP(getDiscoveryJobsByIPQueryParams
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getDiscoveryJobsByIP")
+		return nil, response, newAPIError("getDiscoveryJobsByIP", response)
 	}
 
 	result := response.Result().(*GetDiscoveryJobsByIPResponse)
@@ -1565,12 +1686,18 @@ type GetGlobalCredentialsQueryParams struct {
 @param order order
 */
 func (s *DiscoveryService) GetGlobalCredentials(getGlobalCredentialsQueryParams *GetGlobalCredentialsQueryParams) (*GetGlobalCredentialsResponse, *resty.Response, error) {
+	return s.GetGlobalCredentialsWithContext(context.Background(), getGlobalCredentialsQueryParams)
+}
+
+// GetGlobalCredentialsWithContext is like GetGlobalCredentials but sends the request with ctx for cancellation and deadlines.
+func (s *DiscoveryService) GetGlobalCredentialsWithContext(ctx context.Context, getGlobalCredentialsQueryParams *GetGlobalCredentialsQueryParams) (*GetGlobalCredentialsResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/global-credential"
 
 	queryString, _ := query.Values(getGlobalCredentialsQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetGlobalCredentialsResponse{}).
 		SetError(&Error{}).
@@ -1581,7 +1708,7 @@ func (s *DiscoveryService) GetGlobalCredentials(getGlobalCredentialsQueryParams
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getGlobalCredentials")
+		return nil, response, newAPIError("getGlobalCredentials", response)
 	}
 
 	result := response.Result().(*GetGlobalCredentialsResponse)
@@ -1603,6 +1730,11 @@ type GetListOfDiscoveriesByDiscoveryIDQueryParams struct {
 @param ipAddress ipAddress
 */
 func (s *DiscoveryService) GetListOfDiscoveriesByDiscoveryID(id string, getListOfDiscoveriesByDiscoveryIDQueryParams *GetListOfDiscoveriesByDiscoveryIDQueryParams) (*GetListOfDiscoveriesByDiscoveryIDResponse, *resty.Response, error) {
+	return s.GetListOfDiscoveriesByDiscoveryIDWithContext(context.Background(), id, getListOfDiscoveriesByDiscoveryIDQueryParams)
+}
+
+// GetListOfDiscoveriesByDiscoveryIDWithContext is like GetListOfDiscoveriesByDiscoveryID but sends the request with ctx for cancellation and deadlines.
+func (s *DiscoveryService) GetListOfDiscoveriesByDiscoveryIDWithContext(ctx context.Context, id string, getListOfDiscoveriesByDiscoveryIDQueryParams *GetListOfDiscoveriesByDiscoveryIDQueryParams) (*GetListOfDiscoveriesByDiscoveryIDResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/discovery/{id}/job"
 	path = strings.Replace(path, "{"+"id"+"}", fmt.Sprintf("%v", id), -1)
@@ -1610,6 +1742,7 @@ func (s *DiscoveryService) GetListOfDiscoveriesByDiscoveryID(id string, getListO
 	queryString, _ := query.Values(getListOfDiscoveriesByDiscoveryIDQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetListOfDiscoveriesByDiscoveryIDResponse{}).
 		SetError(&Error{}).
@@ -1620,7 +1753,7 @@ func (s *DiscoveryService) GetListOfDiscoveriesByDiscoveryID(id string, getListO
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getListOfDiscoveriesByDiscoveryId")
+		return nil, response, newAPIError("getListOfDiscoveriesByDiscoveryId", response)
 	}
 
 	result := response.Result().(*GetListOfDiscoveriesByDiscoveryIDResponse)
@@ -1654,6 +1787,11 @@ type GetNetworkDevicesFromDiscoveryQueryParams struct {
 @param httpStatus httpStatus
 */
 func (s *DiscoveryService) GetNetworkDevicesFromDiscovery(id string, getNetworkDevicesFromDiscoveryQueryParams *GetNetworkDevicesFromDiscoveryQueryParams) (*GetNetworkDevicesFromDiscoveryResponse, *resty.Response, error) {
+	return s.GetNetworkDevicesFromDiscoveryWithContext(context.Background(), id, getNetworkDevicesFromDiscoveryQueryParams)
+}
+
+// GetNetworkDevicesFromDiscoveryWithContext is like GetNetworkDevicesFromDiscovery but sends the request with ctx for cancellation and deadlines.
+func (s *DiscoveryService) GetNetworkDevicesFromDiscoveryWithContext(ctx context.Context, id string, getNetworkDevicesFromDiscoveryQueryParams *GetNetworkDevicesFromDiscoveryQueryParams) (*GetNetworkDevicesFromDiscoveryResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/discovery/{id}/summary"
 	path = strings.Replace(path, "{"+"id"+"}", fmt.Sprintf("%v", id), -1)
@@ -1661,6 +1799,7 @@ func (s *DiscoveryService) GetNetworkDevicesFromDiscovery(id string, getNetworkD
 	queryString, _ := query.Values(getNetworkDevicesFromDiscoveryQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetNetworkDevicesFromDiscoveryResponse{}).
 		SetError(&Error{}).
@@ -1671,7 +1810,7 @@ func (s *DiscoveryService) GetNetworkDevicesFromDiscovery(id string, getNetworkD
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getNetworkDevicesFromDiscovery")
+		return nil, response, newAPIError("getNetworkDevicesFromDiscovery", response)
 	}
 
 	result := response.Result().(*GetNetworkDevicesFromDiscoveryResponse)
@@ -1682,10 +1821,16 @@ func (s *DiscoveryService) GetNetworkDevicesFromDiscovery(id string, getNetworkD
 /* Returns SNMP properties
  */
 func (s *DiscoveryService) GetSNMPProperties() (*GetSNMPPropertiesResponse, *resty.Response, error) {
+	return s.GetSNMPPropertiesWithContext(context.Background())
+}
+
+// GetSNMPPropertiesWithContext is like GetSNMPProperties but sends the request with ctx for cancellation and deadlines.
+func (s *DiscoveryService) GetSNMPPropertiesWithContext(ctx context.Context) (*GetSNMPPropertiesResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/snmp-property"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&GetSNMPPropertiesResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -1695,7 +1840,7 @@ func (s *DiscoveryService) GetSNMPProperties() (*GetSNMPPropertiesResponse, *res
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getSNMPProperties")
+		return nil, response, newAPIError("getSNMPProperties", response)
 	}
 
 	result := response.Result().(*GetSNMPPropertiesResponse)
@@ -1706,10 +1851,16 @@ func (s *DiscoveryService) GetSNMPProperties() (*GetSNMPPropertiesResponse, *res
 /* Initiates discovery with the given parameters
  */
 func (s *DiscoveryService) StartDiscovery(startDiscoveryRequest *StartDiscoveryRequest) (*StartDiscoveryResponse, *resty.Response, error) {
+	return s.StartDiscoveryWithContext(context.Background(), startDiscoveryRequest)
+}
+
+// StartDiscoveryWithContext is like StartDiscovery but sends the request with ctx for cancellation and deadlines.
+func (s *DiscoveryService) StartDiscoveryWithContext(ctx context.Context, startDiscoveryRequest *StartDiscoveryRequest) (*StartDiscoveryResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/discovery"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(startDiscoveryRequest).
 		SetResult(&StartDiscoveryResponse{}).
 		SetError(&Error{}).
@@ -1720,7 +1871,7 @@ func (s *DiscoveryService) StartDiscovery(startDiscoveryRequest *StartDiscoveryR
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation startDiscovery")
+		return nil, response, newAPIError("startDiscovery", response)
 	}
 
 	result := response.Result().(*StartDiscoveryResponse)
@@ -1731,10 +1882,16 @@ func (s *DiscoveryService) StartDiscovery(startDiscoveryRequest *StartDiscoveryR
 /* Updates global CLI credentials
  */
 func (s *DiscoveryService) UpdateCLICredentials(updateCLICredentialsRequest *UpdateCLICredentialsRequest) (*UpdateCLICredentialsResponse, *resty.Response, error) {
+	return s.UpdateCLICredentialsWithContext(context.Background(), updateCLICredentialsRequest)
+}
+
+// UpdateCLICredentialsWithContext is like UpdateCLICredentials but sends the request with ctx for cancellation and deadlines.
+func (s *DiscoveryService) UpdateCLICredentialsWithContext(ctx context.Context, updateCLICredentialsRequest *UpdateCLICredentialsRequest) (*UpdateCLICredentialsResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/global-credential/cli"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(updateCLICredentialsRequest).
 		SetResult(&UpdateCLICredentialsResponse{}).
 		SetError(&Error{}).
@@ -1745,7 +1902,7 @@ func (s *DiscoveryService) UpdateCLICredentials(updateCLICredentialsRequest *Upd
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation updateCLICredentials")
+		return nil, response, newAPIError("updateCLICredentials", response)
 	}
 
 	result := response.Result().(*UpdateCLICredentialsResponse)
@@ -1757,11 +1914,17 @@ func (s *DiscoveryService) UpdateCLICredentials(updateCLICredentialsRequest *Upd
 @param globalCredentialID Global credential Uuid
 */
 func (s *DiscoveryService) UpdateGlobalCredentials(globalCredentialID string, updateGlobalCredentialsRequest *UpdateGlobalCredentialsRequest) (*UpdateGlobalCredentialsResponse, *resty.Response, error) {
+	return s.UpdateGlobalCredentialsWithContext(context.Background(), globalCredentialID, updateGlobalCredentialsRequest)
+}
+
+// UpdateGlobalCredentialsWithContext is like UpdateGlobalCredentials but sends the request with ctx for cancellation and deadlines.
+func (s *DiscoveryService) UpdateGlobalCredentialsWithContext(ctx context.Context, globalCredentialID string, updateGlobalCredentialsRequest *UpdateGlobalCredentialsRequest) (*UpdateGlobalCredentialsResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/global-credential/{globalCredentialId}"
 	path = strings.Replace(path, "{"+"globalCredentialId"+"}", fmt.Sprintf("%v", globalCredentialID), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(updateGlobalCredentialsRequest).
 		SetResult(&UpdateGlobalCredentialsResponse{}).
 		SetError(&Error{}).
@@ -1772,7 +1935,7 @@ func (s *DiscoveryService) UpdateGlobalCredentials(globalCredentialID string, up
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation updateGlobalCredentials")
+		return nil, response, newAPIError("updateGlobalCredentials", response)
 	}
 
 	result := response.Result().(*UpdateGlobalCredentialsResponse)
@@ -1783,10 +1946,16 @@ func (s *DiscoveryService) UpdateGlobalCredentials(globalCredentialID string, up
 /* Updates global HTTP Read credential
  */
 func (s *DiscoveryService) UpdateHTTPReadCredential(updateHTTPReadCredentialRequest *UpdateHTTPReadCredentialRequest) (*UpdateHTTPReadCredentialResponse, *resty.Response, error) {
+	return s.UpdateHTTPReadCredentialWithContext(context.Background(), updateHTTPReadCredentialRequest)
+}
+
+// UpdateHTTPReadCredentialWithContext is like UpdateHTTPReadCredential but sends the request with ctx for cancellation and deadlines.
+func (s *DiscoveryService) UpdateHTTPReadCredentialWithContext(ctx context.Context, updateHTTPReadCredentialRequest *UpdateHTTPReadCredentialRequest) (*UpdateHTTPReadCredentialResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/global-credential/http-read"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(updateHTTPReadCredentialRequest).
 		SetResult(&UpdateHTTPReadCredentialResponse{}).
 		SetError(&Error{}).
@@ -1797,7 +1966,7 @@ func (s *DiscoveryService) UpdateHTTPReadCredential(updateHTTPReadCredentialRequ
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation updateHTTPReadCredential")
+		return nil, response, newAPIError("updateHTTPReadCredential", response)
 	}
 
 	result := response.Result().(*UpdateHTTPReadCredentialResponse)
@@ -1808,10 +1977,16 @@ func (s *DiscoveryService) UpdateHTTPReadCredential(updateHTTPReadCredentialRequ
 /* Updates global HTTP write credentials
  */
 func (s *DiscoveryService) UpdateHTTPWriteCredentials(updateHTTPWriteCredentialsRequest *UpdateHTTPWriteCredentialsRequest) (*UpdateHTTPWriteCredentialsResponse, *resty.Response, error) {
+	return s.UpdateHTTPWriteCredentialsWithContext(context.Background(), updateHTTPWriteCredentialsRequest)
+}
+
+// UpdateHTTPWriteCredentialsWithContext is like UpdateHTTPWriteCredentials but sends the request with ctx for cancellation and deadlines.
+func (s *DiscoveryService) UpdateHTTPWriteCredentialsWithContext(ctx context.Context, updateHTTPWriteCredentialsRequest *UpdateHTTPWriteCredentialsRequest) (*UpdateHTTPWriteCredentialsResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/global-credential/http-write"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(updateHTTPWriteCredentialsRequest).
 		SetResult(&UpdateHTTPWriteCredentialsResponse{}).
 		SetError(&Error{}).
@@ -1822,7 +1997,7 @@ func (s *DiscoveryService) UpdateHTTPWriteCredentials(updateHTTPWriteCredentials
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation updateHTTPWriteCredentials")
+		return nil, response, newAPIError("updateHTTPWriteCredentials", response)
 	}
 
 	result := response.Result().(*UpdateHTTPWriteCredentialsResponse)
@@ -1833,10 +2008,16 @@ func (s *DiscoveryService) UpdateHTTPWriteCredentials(updateHTTPWriteCredentials
 /* Updates global netconf credentials
  */
 func (s *DiscoveryService) UpdateNetconfCredentials(updateNetconfCredentialsRequest *UpdateNetconfCredentialsRequest) (*UpdateNetconfCredentialsResponse, *resty.Response, error) {
+	return s.UpdateNetconfCredentialsWithContext(context.Background(), updateNetconfCredentialsRequest)
+}
+
+// UpdateNetconfCredentialsWithContext is like UpdateNetconfCredentials but sends the request with ctx for cancellation and deadlines.
+func (s *DiscoveryService) UpdateNetconfCredentialsWithContext(ctx context.Context, updateNetconfCredentialsRequest *UpdateNetconfCredentialsRequest) (*UpdateNetconfCredentialsResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/global-credential/netconf"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(updateNetconfCredentialsRequest).
 		SetResult(&UpdateNetconfCredentialsResponse{}).
 		SetError(&Error{}).
@@ -1847,7 +2028,7 @@ func (s *DiscoveryService) UpdateNetconfCredentials(updateNetconfCredentialsRequ
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation updateNetconfCredentials")
+		return nil, response, newAPIError("updateNetconfCredentials", response)
 	}
 
 	result := response.Result().(*UpdateNetconfCredentialsResponse)
@@ -1858,10 +2039,16 @@ func (s *DiscoveryService) UpdateNetconfCredentials(updateNetconfCredentialsRequ
 /* Updates global SNMP read community
  */
 func (s *DiscoveryService) UpdateSNMPReadCommunity(updateSNMPReadCommunityRequest *UpdateSNMPReadCommunityRequest) (*UpdateSNMPReadCommunityResponse, *resty.Response, error) {
+	return s.UpdateSNMPReadCommunityWithContext(context.Background(), updateSNMPReadCommunityRequest)
+}
+
+// UpdateSNMPReadCommunityWithContext is like UpdateSNMPReadCommunity but sends the request with ctx for cancellation and deadlines.
+func (s *DiscoveryService) UpdateSNMPReadCommunityWithContext(ctx context.Context, updateSNMPReadCommunityRequest *UpdateSNMPReadCommunityRequest) (*UpdateSNMPReadCommunityResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/global-credential/snmpv2-read-community"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(updateSNMPReadCommunityRequest).
 		SetResult(&UpdateSNMPReadCommunityResponse{}).
 		SetError(&Error{}).
@@ -1872,7 +2059,7 @@ func (s *DiscoveryService) UpdateSNMPReadCommunity(updateSNMPReadCommunityReques
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation updateSNMPReadCommunity")
+		return nil, response, newAPIError("updateSNMPReadCommunity", response)
 	}
 
 	result := response.Result().(*UpdateSNMPReadCommunityResponse)
@@ -1883,10 +2070,16 @@ func (s *DiscoveryService) UpdateSNMPReadCommunity(updateSNMPReadCommunityReques
 /* Updates global SNMP write community
  */
 func (s *DiscoveryService) UpdateSNMPWriteCommunity(updateSNMPWriteCommunityRequest *UpdateSNMPWriteCommunityRequest) (*UpdateSNMPWriteCommunityResponse, *resty.Response, error) {
+	return s.UpdateSNMPWriteCommunityWithContext(context.Background(), updateSNMPWriteCommunityRequest)
+}
+
+// UpdateSNMPWriteCommunityWithContext is like UpdateSNMPWriteCommunity but sends the request with ctx for cancellation and deadlines.
+func (s *DiscoveryService) UpdateSNMPWriteCommunityWithContext(ctx context.Context, updateSNMPWriteCommunityRequest *UpdateSNMPWriteCommunityRequest) (*UpdateSNMPWriteCommunityResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/global-credential/snmpv2-write-community"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(updateSNMPWriteCommunityRequest).
 		SetResult(&UpdateSNMPWriteCommunityResponse{}).
 		SetError(&Error{}).
@@ -1897,7 +2090,7 @@ func (s *DiscoveryService) UpdateSNMPWriteCommunity(updateSNMPWriteCommunityRequ
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation updateSNMPWriteCommunity")
+		return nil, response, newAPIError("updateSNMPWriteCommunity", response)
 	}
 
 	result := response.Result().(*UpdateSNMPWriteCommunityResponse)
@@ -1908,10 +2101,16 @@ func (s *DiscoveryService) UpdateSNMPWriteCommunity(updateSNMPWriteCommunityRequ
 /* Updates global SNMPv3 credential
  */
 func (s *DiscoveryService) UpdateSNMPv3Credentials(updateSNMPv3CredentialsRequest *UpdateSNMPv3CredentialsRequest) (*UpdateSNMPv3CredentialsResponse, *resty.Response, error) {
+	return s.UpdateSNMPv3CredentialsWithContext(context.Background(), updateSNMPv3CredentialsRequest)
+}
+
+// UpdateSNMPv3CredentialsWithContext is like UpdateSNMPv3Credentials but sends the request with ctx for cancellation and deadlines.
+func (s *DiscoveryService) UpdateSNMPv3CredentialsWithContext(ctx context.Context, updateSNMPv3CredentialsRequest *UpdateSNMPv3CredentialsRequest) (*UpdateSNMPv3CredentialsResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/global-credential/snmpv3"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(updateSNMPv3CredentialsRequest).
 		SetResult(&UpdateSNMPv3CredentialsResponse{}).
 		SetError(&Error{}).
@@ -1922,7 +2121,7 @@ func (s *DiscoveryService) UpdateSNMPv3Credentials(updateSNMPv3CredentialsReques
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation updateSNMPv3Credentials")
+		return nil, response, newAPIError("updateSNMPv3Credentials", response)
 	}
 
 	result := response.Result().(*UpdateSNMPv3CredentialsResponse)
@@ -1933,10 +2132,16 @@ func (s *DiscoveryService) UpdateSNMPv3Credentials(updateSNMPv3CredentialsReques
 /* Stops or starts an existing discovery
  */
 func (s *DiscoveryService) UpdatesAnExistingDiscoveryBySpecifiedID(updatesAnExistingDiscoveryBySpecifiedIDRequest *UpdatesAnExistingDiscoveryBySpecifiedIDRequest) (*UpdatesAnExistingDiscoveryBySpecifiedIDResponse, *resty.Response, error) {
+	return s.UpdatesAnExistingDiscoveryBySpecifiedIDWithContext(context.Background(), updatesAnExistingDiscoveryBySpecifiedIDRequest)
+}
+
+// UpdatesAnExistingDiscoveryBySpecifiedIDWithContext is like UpdatesAnExistingDiscoveryBySpecifiedID but sends the request with ctx for cancellation and deadlines.
+func (s *DiscoveryService) UpdatesAnExistingDiscoveryBySpecifiedIDWithContext(ctx context.Context, updatesAnExistingDiscoveryBySpecifiedIDRequest *UpdatesAnExistingDiscoveryBySpecifiedIDRequest) (*UpdatesAnExistingDiscoveryBySpecifiedIDResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/discovery"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(updatesAnExistingDiscoveryBySpecifiedIDRequest).
 		SetResult(&UpdatesAnExistingDiscoveryBySpecifiedIDResponse{}).
 		SetError(&Error{}).
@@ -1947,7 +2152,7 @@ func (s *DiscoveryService) UpdatesAnExistingDiscoveryBySpecifiedID(updatesAnExis
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation updatesAnExistingDiscoveryBySpecifiedId")
+		return nil, response, newAPIError("updatesAnExistingDiscoveryBySpecifiedId", response)
 	}
 
 	result := response.Result().(*UpdatesAnExistingDiscoveryBySpecifiedIDResponse)
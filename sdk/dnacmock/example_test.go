@@ -0,0 +1,32 @@
+package dnacmock_test
+
+import (
+	"fmt"
+
+	dnac "github.com/cisco-en-programmability/dnacenter-go-sdk/sdk"
+	"github.com/cisco-en-programmability/dnacenter-go-sdk/sdk/dnacmock"
+	"github.com/go-resty/resty/v2"
+)
+
+// hostname is the code under test, taking a *dnac.API.
+func hostname(api *dnac.API, id string) (string, error) {
+	device, _, err := api.Devices.GetDeviceByID(id)
+	if err != nil {
+		return "", err
+	}
+	return device.Response.Hostname, nil
+}
+
+func ExampleNewAPI() {
+	api := dnacmock.NewAPI()
+	api.Devices.GetDeviceByIDFunc = func(id string) (*dnac.DevicesGetDeviceByIDResponse, *resty.Response, error) {
+		return &dnac.DevicesGetDeviceByIDResponse{Response: dnac.DevicesGetDeviceByIDResponseResponse{Hostname: "edge-" + id}}, nil, nil
+	}
+
+	name, err := hostname(api.API(), "1")
+	fmt.Println(name, err)
+	fmt.Println(len(api.Devices.CallsTo("GetDeviceByID")))
+	// Output:
+	// edge-1 <nil>
+	// 1
+}
@@ -0,0 +1,63 @@
+// Package dnacmock provides mock implementations of the service interfaces
+// of package dnac, for unit tests of code that depends on them.
+//
+// Every mock method records its call and forwards it to the function field
+// of the same name suffixed with Func:
+//
+//	api := dnacmock.NewAPI()
+//	api.Devices.GetDeviceByIDFunc = func(id string) (*dnac.DevicesGetDeviceByIDResponse, *resty.Response, error) {
+//		return &dnac.DevicesGetDeviceByIDResponse{}, nil, nil
+//	}
+//	inventory := NewInventory(api.API()) // code under test, taking a *dnac.API
+//	...
+//	if calls := api.Devices.CallsTo("GetDeviceByID"); len(calls) != 1 {
+//		t.Errorf("expected one call, got %d", len(calls))
+//	}
+//
+// The mocks are generated by go generate in the sdk directory.
+package dnacmock
+
+import "sync"
+
+// Call is a recorded call of a mock method.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// Recorder records the calls of a mock. It is safe for concurrent use.
+type Recorder struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+func (r *Recorder) record(method string, args ...interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, Call{Method: method, Args: args})
+}
+
+// Calls returns the calls of the mock, in order.
+func (r *Recorder) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Call(nil), r.calls...)
+}
+
+// CallsTo returns the calls of the method of the mock, in order.
+func (r *Recorder) CallsTo(method string) []Call {
+	var calls []Call
+	for _, call := range r.Calls() {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// Reset forgets the recorded calls.
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = nil
+}
@@ -0,0 +1,6067 @@
+// Code generated by geninterfaces. DO NOT EDIT.
+
+package dnacmock
+
+import (
+	"context"
+	"io"
+
+	dnac "github.com/cisco-en-programmability/dnacenter-go-sdk/sdk"
+	resty "github.com/go-resty/resty/v2"
+)
+
+// AuthenticationAPI is a mock of dnac.AuthenticationAPI. Each method calls the function of the
+// same name suffixed with Func, which must be set if the method is called.
+type AuthenticationAPI struct {
+	Recorder
+
+	AuthenticationAPIFunc            func(username string, password string) (*dnac.AuthenticationAPIResponse, *resty.Response, error)
+	AuthenticationAPIWithContextFunc func(ctx context.Context, username string, password string) (*dnac.AuthenticationAPIResponse, *resty.Response, error)
+}
+
+// AuthenticationAPI calls AuthenticationAPIFunc.
+func (m *AuthenticationAPI) AuthenticationAPI(username string, password string) (*dnac.AuthenticationAPIResponse, *resty.Response, error) {
+	m.record("AuthenticationAPI", username, password)
+	if m.AuthenticationAPIFunc == nil {
+		panic("dnacmock: AuthenticationAPI.AuthenticationAPI called but AuthenticationAPIFunc is not set")
+	}
+	return m.AuthenticationAPIFunc(username, password)
+}
+
+// AuthenticationAPIWithContext calls AuthenticationAPIWithContextFunc.
+func (m *AuthenticationAPI) AuthenticationAPIWithContext(ctx context.Context, username string, password string) (*dnac.AuthenticationAPIResponse, *resty.Response, error) {
+	m.record("AuthenticationAPIWithContext", ctx, username, password)
+	if m.AuthenticationAPIWithContextFunc == nil {
+		panic("dnacmock: AuthenticationAPI.AuthenticationAPIWithContext called but AuthenticationAPIWithContextFunc is not set")
+	}
+	return m.AuthenticationAPIWithContextFunc(ctx, username, password)
+}
+
+// SitesAPI is a mock of dnac.SitesAPI. Each method calls the function of the
+// same name suffixed with Func, which must be set if the method is called.
+type SitesAPI struct {
+	Recorder
+
+	AssignDeviceToSiteFunc            func(siteID string, assignDeviceToSiteRequest *dnac.AssignDeviceToSiteRequest) (*dnac.AssignDeviceToSiteResponse, *resty.Response, error)
+	AssignDeviceToSiteWithContextFunc func(ctx context.Context, siteID string, assignDeviceToSiteRequest *dnac.AssignDeviceToSiteRequest) (*dnac.AssignDeviceToSiteResponse, *resty.Response, error)
+	CreateSiteFunc                    func(createSiteRequest *dnac.CreateSiteRequest) (*dnac.CreateSiteResponse, *resty.Response, error)
+	CreateSiteWithContextFunc         func(ctx context.Context, createSiteRequest *dnac.CreateSiteRequest) (*dnac.CreateSiteResponse, *resty.Response, error)
+	DeleteSiteFunc                    func(siteID string) (*dnac.DeleteSiteResponse, *resty.Response, error)
+	DeleteSiteWithContextFunc         func(ctx context.Context, siteID string) (*dnac.DeleteSiteResponse, *resty.Response, error)
+	GetMembershipFunc                 func(siteID string, getMembershipQueryParams *dnac.GetMembershipQueryParams) (*dnac.GetMembershipResponse, *resty.Response, error)
+	GetMembershipWithContextFunc      func(ctx context.Context, siteID string, getMembershipQueryParams *dnac.GetMembershipQueryParams) (*dnac.GetMembershipResponse, *resty.Response, error)
+	GetSiteFunc                       func(getSiteQueryParams *dnac.GetSiteQueryParams) (*dnac.GetSiteResponse, *resty.Response, error)
+	GetSiteCountFunc                  func(getSiteCountQueryParams *dnac.GetSiteCountQueryParams) (*dnac.GetSiteCountResponse, *resty.Response, error)
+	GetSiteCountWithContextFunc       func(ctx context.Context, getSiteCountQueryParams *dnac.GetSiteCountQueryParams) (*dnac.GetSiteCountResponse, *resty.Response, error)
+	GetSiteHealthFunc                 func(getSiteHealthQueryParams *dnac.GetSiteHealthQueryParams) (*dnac.GetSiteHealthResponse, *resty.Response, error)
+	GetSiteHealthWithContextFunc      func(ctx context.Context, getSiteHealthQueryParams *dnac.GetSiteHealthQueryParams) (*dnac.GetSiteHealthResponse, *resty.Response, error)
+	GetSiteWithContextFunc            func(ctx context.Context, getSiteQueryParams *dnac.GetSiteQueryParams) (*dnac.GetSiteResponse, *resty.Response, error)
+	ListAllFunc                       func(ctx context.Context, params *dnac.GetSiteQueryParams, opts *dnac.PageOptions) *dnac.SiteIterator
+	LoadSiteTreeFunc                  func(ctx context.Context) (*dnac.SiteTree, error)
+	UpdateSiteFunc                    func(siteID string, updateSiteRequest *dnac.UpdateSiteRequest) (*dnac.UpdateSiteResponse, *resty.Response, error)
+	UpdateSiteWithContextFunc         func(ctx context.Context, siteID string, updateSiteRequest *dnac.UpdateSiteRequest) (*dnac.UpdateSiteResponse, *resty.Response, error)
+}
+
+// AssignDeviceToSite calls AssignDeviceToSiteFunc.
+func (m *SitesAPI) AssignDeviceToSite(siteID string, assignDeviceToSiteRequest *dnac.AssignDeviceToSiteRequest) (*dnac.AssignDeviceToSiteResponse, *resty.Response, error) {
+	m.record("AssignDeviceToSite", siteID, assignDeviceToSiteRequest)
+	if m.AssignDeviceToSiteFunc == nil {
+		panic("dnacmock: SitesAPI.AssignDeviceToSite called but AssignDeviceToSiteFunc is not set")
+	}
+	return m.AssignDeviceToSiteFunc(siteID, assignDeviceToSiteRequest)
+}
+
+// AssignDeviceToSiteWithContext calls AssignDeviceToSiteWithContextFunc.
+func (m *SitesAPI) AssignDeviceToSiteWithContext(ctx context.Context, siteID string, assignDeviceToSiteRequest *dnac.AssignDeviceToSiteRequest) (*dnac.AssignDeviceToSiteResponse, *resty.Response, error) {
+	m.record("AssignDeviceToSiteWithContext", ctx, siteID, assignDeviceToSiteRequest)
+	if m.AssignDeviceToSiteWithContextFunc == nil {
+		panic("dnacmock: SitesAPI.AssignDeviceToSiteWithContext called but AssignDeviceToSiteWithContextFunc is not set")
+	}
+	return m.AssignDeviceToSiteWithContextFunc(ctx, siteID, assignDeviceToSiteRequest)
+}
+
+// CreateSite calls CreateSiteFunc.
+func (m *SitesAPI) CreateSite(createSiteRequest *dnac.CreateSiteRequest) (*dnac.CreateSiteResponse, *resty.Response, error) {
+	m.record("CreateSite", createSiteRequest)
+	if m.CreateSiteFunc == nil {
+		panic("dnacmock: SitesAPI.CreateSite called but CreateSiteFunc is not set")
+	}
+	return m.CreateSiteFunc(createSiteRequest)
+}
+
+// CreateSiteWithContext calls CreateSiteWithContextFunc.
+func (m *SitesAPI) CreateSiteWithContext(ctx context.Context, createSiteRequest *dnac.CreateSiteRequest) (*dnac.CreateSiteResponse, *resty.Response, error) {
+	m.record("CreateSiteWithContext", ctx, createSiteRequest)
+	if m.CreateSiteWithContextFunc == nil {
+		panic("dnacmock: SitesAPI.CreateSiteWithContext called but CreateSiteWithContextFunc is not set")
+	}
+	return m.CreateSiteWithContextFunc(ctx, createSiteRequest)
+}
+
+// DeleteSite calls DeleteSiteFunc.
+func (m *SitesAPI) DeleteSite(siteID string) (*dnac.DeleteSiteResponse, *resty.Response, error) {
+	m.record("DeleteSite", siteID)
+	if m.DeleteSiteFunc == nil {
+		panic("dnacmock: SitesAPI.DeleteSite called but DeleteSiteFunc is not set")
+	}
+	return m.DeleteSiteFunc(siteID)
+}
+
+// DeleteSiteWithContext calls DeleteSiteWithContextFunc.
+func (m *SitesAPI) DeleteSiteWithContext(ctx context.Context, siteID string) (*dnac.DeleteSiteResponse, *resty.Response, error) {
+	m.record("DeleteSiteWithContext", ctx, siteID)
+	if m.DeleteSiteWithContextFunc == nil {
+		panic("dnacmock: SitesAPI.DeleteSiteWithContext called but DeleteSiteWithContextFunc is not set")
+	}
+	return m.DeleteSiteWithContextFunc(ctx, siteID)
+}
+
+// GetMembership calls GetMembershipFunc.
+func (m *SitesAPI) GetMembership(siteID string, getMembershipQueryParams *dnac.GetMembershipQueryParams) (*dnac.GetMembershipResponse, *resty.Response, error) {
+	m.record("GetMembership", siteID, getMembershipQueryParams)
+	if m.GetMembershipFunc == nil {
+		panic("dnacmock: SitesAPI.GetMembership called but GetMembershipFunc is not set")
+	}
+	return m.GetMembershipFunc(siteID, getMembershipQueryParams)
+}
+
+// GetMembershipWithContext calls GetMembershipWithContextFunc.
+func (m *SitesAPI) GetMembershipWithContext(ctx context.Context, siteID string, getMembershipQueryParams *dnac.GetMembershipQueryParams) (*dnac.GetMembershipResponse, *resty.Response, error) {
+	m.record("GetMembershipWithContext", ctx, siteID, getMembershipQueryParams)
+	if m.GetMembershipWithContextFunc == nil {
+		panic("dnacmock: SitesAPI.GetMembershipWithContext called but GetMembershipWithContextFunc is not set")
+	}
+	return m.GetMembershipWithContextFunc(ctx, siteID, getMembershipQueryParams)
+}
+
+// GetSite calls GetSiteFunc.
+func (m *SitesAPI) GetSite(getSiteQueryParams *dnac.GetSiteQueryParams) (*dnac.GetSiteResponse, *resty.Response, error) {
+	m.record("GetSite", getSiteQueryParams)
+	if m.GetSiteFunc == nil {
+		panic("dnacmock: SitesAPI.GetSite called but GetSiteFunc is not set")
+	}
+	return m.GetSiteFunc(getSiteQueryParams)
+}
+
+// GetSiteCount calls GetSiteCountFunc.
+func (m *SitesAPI) GetSiteCount(getSiteCountQueryParams *dnac.GetSiteCountQueryParams) (*dnac.GetSiteCountResponse, *resty.Response, error) {
+	m.record("GetSiteCount", getSiteCountQueryParams)
+	if m.GetSiteCountFunc == nil {
+		panic("dnacmock: SitesAPI.GetSiteCount called but GetSiteCountFunc is not set")
+	}
+	return m.GetSiteCountFunc(getSiteCountQueryParams)
+}
+
+// GetSiteCountWithContext calls GetSiteCountWithContextFunc.
+func (m *SitesAPI) GetSiteCountWithContext(ctx context.Context, getSiteCountQueryParams *dnac.GetSiteCountQueryParams) (*dnac.GetSiteCountResponse, *resty.Response, error) {
+	m.record("GetSiteCountWithContext", ctx, getSiteCountQueryParams)
+	if m.GetSiteCountWithContextFunc == nil {
+		panic("dnacmock: SitesAPI.GetSiteCountWithContext called but GetSiteCountWithContextFunc is not set")
+	}
+	return m.GetSiteCountWithContextFunc(ctx, getSiteCountQueryParams)
+}
+
+// GetSiteHealth calls GetSiteHealthFunc.
+func (m *SitesAPI) GetSiteHealth(getSiteHealthQueryParams *dnac.GetSiteHealthQueryParams) (*dnac.GetSiteHealthResponse, *resty.Response, error) {
+	m.record("GetSiteHealth", getSiteHealthQueryParams)
+	if m.GetSiteHealthFunc == nil {
+		panic("dnacmock: SitesAPI.GetSiteHealth called but GetSiteHealthFunc is not set")
+	}
+	return m.GetSiteHealthFunc(getSiteHealthQueryParams)
+}
+
+// GetSiteHealthWithContext calls GetSiteHealthWithContextFunc.
+func (m *SitesAPI) GetSiteHealthWithContext(ctx context.Context, getSiteHealthQueryParams *dnac.GetSiteHealthQueryParams) (*dnac.GetSiteHealthResponse, *resty.Response, error) {
+	m.record("GetSiteHealthWithContext", ctx, getSiteHealthQueryParams)
+	if m.GetSiteHealthWithContextFunc == nil {
+		panic("dnacmock: SitesAPI.GetSiteHealthWithContext called but GetSiteHealthWithContextFunc is not set")
+	}
+	return m.GetSiteHealthWithContextFunc(ctx, getSiteHealthQueryParams)
+}
+
+// GetSiteWithContext calls GetSiteWithContextFunc.
+func (m *SitesAPI) GetSiteWithContext(ctx context.Context, getSiteQueryParams *dnac.GetSiteQueryParams) (*dnac.GetSiteResponse, *resty.Response, error) {
+	m.record("GetSiteWithContext", ctx, getSiteQueryParams)
+	if m.GetSiteWithContextFunc == nil {
+		panic("dnacmock: SitesAPI.GetSiteWithContext called but GetSiteWithContextFunc is not set")
+	}
+	return m.GetSiteWithContextFunc(ctx, getSiteQueryParams)
+}
+
+// ListAll calls ListAllFunc.
+func (m *SitesAPI) ListAll(ctx context.Context, params *dnac.GetSiteQueryParams, opts *dnac.PageOptions) *dnac.SiteIterator {
+	m.record("ListAll", ctx, params, opts)
+	if m.ListAllFunc == nil {
+		panic("dnacmock: SitesAPI.ListAll called but ListAllFunc is not set")
+	}
+	return m.ListAllFunc(ctx, params, opts)
+}
+
+// LoadSiteTree calls LoadSiteTreeFunc.
+func (m *SitesAPI) LoadSiteTree(ctx context.Context) (*dnac.SiteTree, error) {
+	m.record("LoadSiteTree", ctx)
+	if m.LoadSiteTreeFunc == nil {
+		panic("dnacmock: SitesAPI.LoadSiteTree called but LoadSiteTreeFunc is not set")
+	}
+	return m.LoadSiteTreeFunc(ctx)
+}
+
+// UpdateSite calls UpdateSiteFunc.
+func (m *SitesAPI) UpdateSite(siteID string, updateSiteRequest *dnac.UpdateSiteRequest) (*dnac.UpdateSiteResponse, *resty.Response, error) {
+	m.record("UpdateSite", siteID, updateSiteRequest)
+	if m.UpdateSiteFunc == nil {
+		panic("dnacmock: SitesAPI.UpdateSite called but UpdateSiteFunc is not set")
+	}
+	return m.UpdateSiteFunc(siteID, updateSiteRequest)
+}
+
+// UpdateSiteWithContext calls UpdateSiteWithContextFunc.
+func (m *SitesAPI) UpdateSiteWithContext(ctx context.Context, siteID string, updateSiteRequest *dnac.UpdateSiteRequest) (*dnac.UpdateSiteResponse, *resty.Response, error) {
+	m.record("UpdateSiteWithContext", ctx, siteID, updateSiteRequest)
+	if m.UpdateSiteWithContextFunc == nil {
+		panic("dnacmock: SitesAPI.UpdateSiteWithContext called but UpdateSiteWithContextFunc is not set")
+	}
+	return m.UpdateSiteWithContextFunc(ctx, siteID, updateSiteRequest)
+}
+
+// TopologyAPI is a mock of dnac.TopologyAPI. Each method calls the function of the
+// same name suffixed with Func, which must be set if the method is called.
+type TopologyAPI struct {
+	Recorder
+
+	GetL3TopologyDetailsFunc               func(topologyType string) (*dnac.GetL3TopologyDetailsResponse, *resty.Response, error)
+	GetL3TopologyDetailsWithContextFunc    func(ctx context.Context, topologyType string) (*dnac.GetL3TopologyDetailsResponse, *resty.Response, error)
+	GetOverallNetworkHealthFunc            func(getOverallNetworkHealthQueryParams *dnac.GetOverallNetworkHealthQueryParams) (*dnac.GetOverallNetworkHealthResponse, *resty.Response, error)
+	GetOverallNetworkHealthWithContextFunc func(ctx context.Context, getOverallNetworkHealthQueryParams *dnac.GetOverallNetworkHealthQueryParams) (*dnac.GetOverallNetworkHealthResponse, *resty.Response, error)
+	GetPhysicalTopologyFunc                func(getPhysicalTopologyQueryParams *dnac.GetPhysicalTopologyQueryParams) (*dnac.GetPhysicalTopologyResponse, *resty.Response, error)
+	GetPhysicalTopologyWithContextFunc     func(ctx context.Context, getPhysicalTopologyQueryParams *dnac.GetPhysicalTopologyQueryParams) (*dnac.GetPhysicalTopologyResponse, *resty.Response, error)
+	GetSiteTopologyFunc                    func() (*dnac.GetSiteTopologyResponse, *resty.Response, error)
+	GetSiteTopologyWithContextFunc         func(ctx context.Context) (*dnac.GetSiteTopologyResponse, *resty.Response, error)
+	GetTopologyDetailsFunc                 func(vlanID string) (*dnac.GetTopologyDetailsResponse, *resty.Response, error)
+	GetTopologyDetailsWithContextFunc      func(ctx context.Context, vlanID string) (*dnac.GetTopologyDetailsResponse, *resty.Response, error)
+	GetVLANDetailsFunc                     func() (*dnac.GetVLANDetailsResponse, *resty.Response, error)
+	GetVLANDetailsWithContextFunc          func(ctx context.Context) (*dnac.GetVLANDetailsResponse, *resty.Response, error)
+}
+
+// GetL3TopologyDetails calls GetL3TopologyDetailsFunc.
+func (m *TopologyAPI) GetL3TopologyDetails(topologyType string) (*dnac.GetL3TopologyDetailsResponse, *resty.Response, error) {
+	m.record("GetL3TopologyDetails", topologyType)
+	if m.GetL3TopologyDetailsFunc == nil {
+		panic("dnacmock: TopologyAPI.GetL3TopologyDetails called but GetL3TopologyDetailsFunc is not set")
+	}
+	return m.GetL3TopologyDetailsFunc(topologyType)
+}
+
+// GetL3TopologyDetailsWithContext calls GetL3TopologyDetailsWithContextFunc.
+func (m *TopologyAPI) GetL3TopologyDetailsWithContext(ctx context.Context, topologyType string) (*dnac.GetL3TopologyDetailsResponse, *resty.Response, error) {
+	m.record("GetL3TopologyDetailsWithContext", ctx, topologyType)
+	if m.GetL3TopologyDetailsWithContextFunc == nil {
+		panic("dnacmock: TopologyAPI.GetL3TopologyDetailsWithContext called but GetL3TopologyDetailsWithContextFunc is not set")
+	}
+	return m.GetL3TopologyDetailsWithContextFunc(ctx, topologyType)
+}
+
+// GetOverallNetworkHealth calls GetOverallNetworkHealthFunc.
+func (m *TopologyAPI) GetOverallNetworkHealth(getOverallNetworkHealthQueryParams *dnac.GetOverallNetworkHealthQueryParams) (*dnac.GetOverallNetworkHealthResponse, *resty.Response, error) {
+	m.record("GetOverallNetworkHealth", getOverallNetworkHealthQueryParams)
+	if m.GetOverallNetworkHealthFunc == nil {
+		panic("dnacmock: TopologyAPI.GetOverallNetworkHealth called but GetOverallNetworkHealthFunc is not set")
+	}
+	return m.GetOverallNetworkHealthFunc(getOverallNetworkHealthQueryParams)
+}
+
+// GetOverallNetworkHealthWithContext calls GetOverallNetworkHealthWithContextFunc.
+func (m *TopologyAPI) GetOverallNetworkHealthWithContext(ctx context.Context, getOverallNetworkHealthQueryParams *dnac.GetOverallNetworkHealthQueryParams) (*dnac.GetOverallNetworkHealthResponse, *resty.Response, error) {
+	m.record("GetOverallNetworkHealthWithContext", ctx, getOverallNetworkHealthQueryParams)
+	if m.GetOverallNetworkHealthWithContextFunc == nil {
+		panic("dnacmock: TopologyAPI.GetOverallNetworkHealthWithContext called but GetOverallNetworkHealthWithContextFunc is not set")
+	}
+	return m.GetOverallNetworkHealthWithContextFunc(ctx, getOverallNetworkHealthQueryParams)
+}
+
+// GetPhysicalTopology calls GetPhysicalTopologyFunc.
+func (m *TopologyAPI) GetPhysicalTopology(getPhysicalTopologyQueryParams *dnac.GetPhysicalTopologyQueryParams) (*dnac.GetPhysicalTopologyResponse, *resty.Response, error) {
+	m.record("GetPhysicalTopology", getPhysicalTopologyQueryParams)
+	if m.GetPhysicalTopologyFunc == nil {
+		panic("dnacmock: TopologyAPI.GetPhysicalTopology called but GetPhysicalTopologyFunc is not set")
+	}
+	return m.GetPhysicalTopologyFunc(getPhysicalTopologyQueryParams)
+}
+
+// GetPhysicalTopologyWithContext calls GetPhysicalTopologyWithContextFunc.
+func (m *TopologyAPI) GetPhysicalTopologyWithContext(ctx context.Context, getPhysicalTopologyQueryParams *dnac.GetPhysicalTopologyQueryParams) (*dnac.GetPhysicalTopologyResponse, *resty.Response, error) {
+	m.record("GetPhysicalTopologyWithContext", ctx, getPhysicalTopologyQueryParams)
+	if m.GetPhysicalTopologyWithContextFunc == nil {
+		panic("dnacmock: TopologyAPI.GetPhysicalTopologyWithContext called but GetPhysicalTopologyWithContextFunc is not set")
+	}
+	return m.GetPhysicalTopologyWithContextFunc(ctx, getPhysicalTopologyQueryParams)
+}
+
+// GetSiteTopology calls GetSiteTopologyFunc.
+func (m *TopologyAPI) GetSiteTopology() (*dnac.GetSiteTopologyResponse, *resty.Response, error) {
+	m.record("GetSiteTopology")
+	if m.GetSiteTopologyFunc == nil {
+		panic("dnacmock: TopologyAPI.GetSiteTopology called but GetSiteTopologyFunc is not set")
+	}
+	return m.GetSiteTopologyFunc()
+}
+
+// GetSiteTopologyWithContext calls GetSiteTopologyWithContextFunc.
+func (m *TopologyAPI) GetSiteTopologyWithContext(ctx context.Context) (*dnac.GetSiteTopologyResponse, *resty.Response, error) {
+	m.record("GetSiteTopologyWithContext", ctx)
+	if m.GetSiteTopologyWithContextFunc == nil {
+		panic("dnacmock: TopologyAPI.GetSiteTopologyWithContext called but GetSiteTopologyWithContextFunc is not set")
+	}
+	return m.GetSiteTopologyWithContextFunc(ctx)
+}
+
+// GetTopologyDetails calls GetTopologyDetailsFunc.
+func (m *TopologyAPI) GetTopologyDetails(vlanID string) (*dnac.GetTopologyDetailsResponse, *resty.Response, error) {
+	m.record("GetTopologyDetails", vlanID)
+	if m.GetTopologyDetailsFunc == nil {
+		panic("dnacmock: TopologyAPI.GetTopologyDetails called but GetTopologyDetailsFunc is not set")
+	}
+	return m.GetTopologyDetailsFunc(vlanID)
+}
+
+// GetTopologyDetailsWithContext calls GetTopologyDetailsWithContextFunc.
+func (m *TopologyAPI) GetTopologyDetailsWithContext(ctx context.Context, vlanID string) (*dnac.GetTopologyDetailsResponse, *resty.Response, error) {
+	m.record("GetTopologyDetailsWithContext", ctx, vlanID)
+	if m.GetTopologyDetailsWithContextFunc == nil {
+		panic("dnacmock: TopologyAPI.GetTopologyDetailsWithContext called but GetTopologyDetailsWithContextFunc is not set")
+	}
+	return m.GetTopologyDetailsWithContextFunc(ctx, vlanID)
+}
+
+// GetVLANDetails calls GetVLANDetailsFunc.
+func (m *TopologyAPI) GetVLANDetails() (*dnac.GetVLANDetailsResponse, *resty.Response, error) {
+	m.record("GetVLANDetails")
+	if m.GetVLANDetailsFunc == nil {
+		panic("dnacmock: TopologyAPI.GetVLANDetails called but GetVLANDetailsFunc is not set")
+	}
+	return m.GetVLANDetailsFunc()
+}
+
+// GetVLANDetailsWithContext calls GetVLANDetailsWithContextFunc.
+func (m *TopologyAPI) GetVLANDetailsWithContext(ctx context.Context) (*dnac.GetVLANDetailsResponse, *resty.Response, error) {
+	m.record("GetVLANDetailsWithContext", ctx)
+	if m.GetVLANDetailsWithContextFunc == nil {
+		panic("dnacmock: TopologyAPI.GetVLANDetailsWithContext called but GetVLANDetailsWithContextFunc is not set")
+	}
+	return m.GetVLANDetailsWithContextFunc(ctx)
+}
+
+// DevicesAPI is a mock of dnac.DevicesAPI. Each method calls the function of the
+// same name suffixed with Func, which must be set if the method is called.
+type DevicesAPI struct {
+	Recorder
+
+	AddDeviceFunc                                                func(addDeviceRequest *dnac.AddDeviceRequest) (*dnac.AddDeviceResponse, *resty.Response, error)
+	AddDeviceWithContextFunc                                     func(ctx context.Context, addDeviceRequest *dnac.AddDeviceRequest) (*dnac.AddDeviceResponse, *resty.Response, error)
+	DeleteDeviceByIDFunc                                         func(id string, deleteDeviceByIDQueryParams *dnac.DeleteDeviceByIDQueryParams) (*dnac.DeleteDeviceByIDResponse, *resty.Response, error)
+	DeleteDeviceByIDWithContextFunc                              func(ctx context.Context, id string, deleteDeviceByIDQueryParams *dnac.DeleteDeviceByIDQueryParams) (*dnac.DeleteDeviceByIDResponse, *resty.Response, error)
+	DevicesFunc                                                  func(devicesQueryParams *dnac.DevicesQueryParams) (*dnac.DevicesResponse, *resty.Response, error)
+	DevicesWithContextFunc                                       func(ctx context.Context, devicesQueryParams *dnac.DevicesQueryParams) (*dnac.DevicesResponse, *resty.Response, error)
+	ExportDeviceListFunc                                         func(exportDeviceListRequest *dnac.ExportDeviceListRequest) (*dnac.ExportDeviceListResponse, *resty.Response, error)
+	ExportDeviceListWithContextFunc                              func(ctx context.Context, exportDeviceListRequest *dnac.ExportDeviceListRequest) (*dnac.ExportDeviceListResponse, *resty.Response, error)
+	GetAllInterfacesFunc                                         func(getAllInterfacesQueryParams *dnac.GetAllInterfacesQueryParams) (*dnac.GetAllInterfacesResponse, *resty.Response, error)
+	GetAllInterfacesWithContextFunc                              func(ctx context.Context, getAllInterfacesQueryParams *dnac.GetAllInterfacesQueryParams) (*dnac.GetAllInterfacesResponse, *resty.Response, error)
+	GetDeviceByIDFunc                                            func(id string) (*dnac.DevicesGetDeviceByIDResponse, *resty.Response, error)
+	GetDeviceByIDWithContextFunc                                 func(ctx context.Context, id string) (*dnac.DevicesGetDeviceByIDResponse, *resty.Response, error)
+	GetDeviceBySerialNumberFunc                                  func(serialNumber string) (*dnac.GetDeviceBySerialNumberResponse, *resty.Response, error)
+	GetDeviceBySerialNumberWithContextFunc                       func(ctx context.Context, serialNumber string) (*dnac.GetDeviceBySerialNumberResponse, *resty.Response, error)
+	GetDeviceConfigByIDFunc                                      func(networkDeviceID string) (*dnac.GetDeviceConfigByIDResponse, *resty.Response, error)
+	GetDeviceConfigByIDWithContextFunc                           func(ctx context.Context, networkDeviceID string) (*dnac.GetDeviceConfigByIDResponse, *resty.Response, error)
+	GetDeviceConfigCountFunc                                     func() (*dnac.GetDeviceConfigCountResponse, *resty.Response, error)
+	GetDeviceConfigCountWithContextFunc                          func(ctx context.Context) (*dnac.GetDeviceConfigCountResponse, *resty.Response, error)
+	GetDeviceConfigForAllDevicesFunc                             func() (*dnac.GetDeviceConfigForAllDevicesResponse, *resty.Response, error)
+	GetDeviceConfigForAllDevicesWithContextFunc                  func(ctx context.Context) (*dnac.GetDeviceConfigForAllDevicesResponse, *resty.Response, error)
+	GetDeviceCountFunc                                           func() (*dnac.GetDeviceCountResponse, *resty.Response, error)
+	GetDeviceCountWithContextFunc                                func(ctx context.Context) (*dnac.GetDeviceCountResponse, *resty.Response, error)
+	GetDeviceDetailFunc                                          func(getDeviceDetailQueryParams *dnac.GetDeviceDetailQueryParams) (*dnac.GetDeviceDetailResponse, *resty.Response, error)
+	GetDeviceDetailWithContextFunc                               func(ctx context.Context, getDeviceDetailQueryParams *dnac.GetDeviceDetailQueryParams) (*dnac.GetDeviceDetailResponse, *resty.Response, error)
+	GetDeviceEnrichmentDetailsFunc                               func() (*dnac.GetDeviceEnrichmentDetailsResponse, *resty.Response, error)
+	GetDeviceEnrichmentDetailsWithContextFunc                    func(ctx context.Context) (*dnac.GetDeviceEnrichmentDetailsResponse, *resty.Response, error)
+	GetDeviceInterfaceCountFunc                                  func() (*dnac.GetDeviceInterfaceCountResponse, *resty.Response, error)
+	GetDeviceInterfaceCountByDeviceIDFunc                        func(deviceID string) (*dnac.GetDeviceInterfaceCountByDeviceIDResponse, *resty.Response, error)
+	GetDeviceInterfaceCountByDeviceIDWithContextFunc             func(ctx context.Context, deviceID string) (*dnac.GetDeviceInterfaceCountByDeviceIDResponse, *resty.Response, error)
+	GetDeviceInterfaceCountWithContextFunc                       func(ctx context.Context) (*dnac.GetDeviceInterfaceCountResponse, *resty.Response, error)
+	GetDeviceInterfaceVLANsFunc                                  func(id string, getDeviceInterfaceVLANsQueryParams *dnac.GetDeviceInterfaceVLANsQueryParams) (*dnac.GetDeviceInterfaceVLANsResponse, *resty.Response, error)
+	GetDeviceInterfaceVLANsWithContextFunc                       func(ctx context.Context, id string, getDeviceInterfaceVLANsQueryParams *dnac.GetDeviceInterfaceVLANsQueryParams) (*dnac.GetDeviceInterfaceVLANsResponse, *resty.Response, error)
+	GetDeviceInterfacesBySpecifiedRangeFunc                      func(deviceID string, startIndex int, recordsToReturn int) (*dnac.GetDeviceInterfacesBySpecifiedRangeResponse, *resty.Response, error)
+	GetDeviceInterfacesBySpecifiedRangeWithContextFunc           func(ctx context.Context, deviceID string, startIndex int, recordsToReturn int) (*dnac.GetDeviceInterfacesBySpecifiedRangeResponse, *resty.Response, error)
+	GetDeviceListFunc                                            func(getDeviceListQueryParams *dnac.GetDeviceListQueryParams) (*dnac.GetDeviceListResponse, *resty.Response, error)
+	GetDeviceListWithContextFunc                                 func(ctx context.Context, getDeviceListQueryParams *dnac.GetDeviceListQueryParams) (*dnac.GetDeviceListResponse, *resty.Response, error)
+	GetDeviceSummaryFunc                                         func(id string) (*dnac.GetDeviceSummaryResponse, *resty.Response, error)
+	GetDeviceSummaryWithContextFunc                              func(ctx context.Context, id string) (*dnac.GetDeviceSummaryResponse, *resty.Response, error)
+	GetFunctionalCapabilityByIDFunc                              func(id string) (*dnac.GetFunctionalCapabilityByIDResponse, *resty.Response, error)
+	GetFunctionalCapabilityByIDWithContextFunc                   func(ctx context.Context, id string) (*dnac.GetFunctionalCapabilityByIDResponse, *resty.Response, error)
+	GetFunctionalCapabilityForDevicesFunc                        func(getFunctionalCapabilityForDevicesQueryParams *dnac.GetFunctionalCapabilityForDevicesQueryParams) (*dnac.GetFunctionalCapabilityForDevicesResponse, *resty.Response, error)
+	GetFunctionalCapabilityForDevicesWithContextFunc             func(ctx context.Context, getFunctionalCapabilityForDevicesQueryParams *dnac.GetFunctionalCapabilityForDevicesQueryParams) (*dnac.GetFunctionalCapabilityForDevicesResponse, *resty.Response, error)
+	GetISISInterfacesFunc                                        func() (*dnac.GetISISInterfacesResponse, *resty.Response, error)
+	GetISISInterfacesWithContextFunc                             func(ctx context.Context) (*dnac.GetISISInterfacesResponse, *resty.Response, error)
+	GetInterfaceByIDFunc                                         func(id string) (*dnac.GetInterfaceByIDResponse, *resty.Response, error)
+	GetInterfaceByIDWithContextFunc                              func(ctx context.Context, id string) (*dnac.GetInterfaceByIDResponse, *resty.Response, error)
+	GetInterfaceByIPFunc                                         func(ipAddress string) (*dnac.GetInterfaceByIPResponse, *resty.Response, error)
+	GetInterfaceByIPWithContextFunc                              func(ctx context.Context, ipAddress string) (*dnac.GetInterfaceByIPResponse, *resty.Response, error)
+	GetInterfaceDetailsByDeviceIDAndInterfaceNameFunc            func(deviceID string, getInterfaceDetailsByDeviceIDAndInterfaceNameQueryParams *dnac.GetInterfaceDetailsByDeviceIDAndInterfaceNameQueryParams) (*dnac.GetInterfaceDetailsByDeviceIDAndInterfaceNameResponse, *resty.Response, error)
+	GetInterfaceDetailsByDeviceIDAndInterfaceNameWithContextFunc func(ctx context.Context, deviceID string, getInterfaceDetailsByDeviceIDAndInterfaceNameQueryParams *dnac.GetInterfaceDetailsByDeviceIDAndInterfaceNameQueryParams) (*dnac.GetInterfaceDetailsByDeviceIDAndInterfaceNameResponse, *resty.Response, error)
+	GetInterfaceInfoByIDFunc                                     func(deviceID string) (*dnac.GetInterfaceInfoByIDResponse, *resty.Response, error)
+	GetInterfaceInfoByIDWithContextFunc                          func(ctx context.Context, deviceID string) (*dnac.GetInterfaceInfoByIDResponse, *resty.Response, error)
+	GetModuleCountFunc                                           func(getModuleCountQueryParams *dnac.GetModuleCountQueryParams) (*dnac.GetModuleCountResponse, *resty.Response, error)
+	GetModuleCountWithContextFunc                                func(ctx context.Context, getModuleCountQueryParams *dnac.GetModuleCountQueryParams) (*dnac.GetModuleCountResponse, *resty.Response, error)
+	GetModuleInfoByIDFunc                                        func(id string) (*dnac.GetModuleInfoByIDResponse, *resty.Response, error)
+	GetModuleInfoByIDWithContextFunc                             func(ctx context.Context, id string) (*dnac.GetModuleInfoByIDResponse, *resty.Response, error)
+	GetModulesFunc                                               func(getModulesQueryParams *dnac.GetModulesQueryParams) (*dnac.GetModulesResponse, *resty.Response, error)
+	GetModulesWithContextFunc                                    func(ctx context.Context, getModulesQueryParams *dnac.GetModulesQueryParams) (*dnac.GetModulesResponse, *resty.Response, error)
+	GetNetworkDeviceByIPFunc                                     func(ipAddress string) (*dnac.GetNetworkDeviceByIPResponse, *resty.Response, error)
+	GetNetworkDeviceByIPWithContextFunc                          func(ctx context.Context, ipAddress string) (*dnac.GetNetworkDeviceByIPResponse, *resty.Response, error)
+	GetNetworkDeviceByPaginationRangeFunc                        func(startIndex int, recordsToReturn int) (*dnac.GetNetworkDeviceByPaginationRangeResponse, *resty.Response, error)
+	GetNetworkDeviceByPaginationRangeWithContextFunc             func(ctx context.Context, startIndex int, recordsToReturn int) (*dnac.GetNetworkDeviceByPaginationRangeResponse, *resty.Response, error)
+	GetOSPFInterfacesFunc                                        func() (*dnac.GetOSPFInterfacesResponse, *resty.Response, error)
+	GetOSPFInterfacesWithContextFunc                             func(ctx context.Context) (*dnac.GetOSPFInterfacesResponse, *resty.Response, error)
+	GetOrganizationListForMerakiFunc                             func(id string) (*dnac.GetOrganizationListForMerakiResponse, *resty.Response, error)
+	GetOrganizationListForMerakiWithContextFunc                  func(ctx context.Context, id string) (*dnac.GetOrganizationListForMerakiResponse, *resty.Response, error)
+	GetPollingIntervalByIDFunc                                   func(id string) (*dnac.GetPollingIntervalByIDResponse, *resty.Response, error)
+	GetPollingIntervalByIDWithContextFunc                        func(ctx context.Context, id string) (*dnac.GetPollingIntervalByIDResponse, *resty.Response, error)
+	GetPollingIntervalForAllDevicesFunc                          func() (*dnac.GetPollingIntervalForAllDevicesResponse, *resty.Response, error)
+	GetPollingIntervalForAllDevicesWithContextFunc               func(ctx context.Context) (*dnac.GetPollingIntervalForAllDevicesResponse, *resty.Response, error)
+	GetWirelessLanControllerDetailsByIDFunc                      func(id string) (*dnac.GetWirelessLanControllerDetailsByIDResponse, *resty.Response, error)
+	GetWirelessLanControllerDetailsByIDWithContextFunc           func(ctx context.Context, id string) (*dnac.GetWirelessLanControllerDetailsByIDResponse, *resty.Response, error)
+	ListAllFunc                                                  func(ctx context.Context, opts *dnac.PageOptions) *dnac.NetworkDeviceIterator
+	ListAllMatchingFunc                                          func(ctx context.Context, params *dnac.RetrievesAllNetworkDevicesQueryParams, opts *dnac.PageOptions) *dnac.MatchingDeviceIterator
+	RegisterDeviceForWSAFunc                                     func(registerDeviceForWSAQueryParams *dnac.RegisterDeviceForWSAQueryParams) (*dnac.RegisterDeviceForWSAResponse, *resty.Response, error)
+	RegisterDeviceForWSAWithContextFunc                          func(ctx context.Context, registerDeviceForWSAQueryParams *dnac.RegisterDeviceForWSAQueryParams) (*dnac.RegisterDeviceForWSAResponse, *resty.Response, error)
+	RetrievesAllNetworkDevicesFunc                               func(retrievesAllNetworkDevicesQueryParams *dnac.RetrievesAllNetworkDevicesQueryParams) (string, *resty.Response, error)
+	RetrievesAllNetworkDevicesWithContextFunc                    func(ctx context.Context, retrievesAllNetworkDevicesQueryParams *dnac.RetrievesAllNetworkDevicesQueryParams) (string, *resty.Response, error)
+	SyncDevicesFunc                                              func(syncDevicesRequest *dnac.SyncDevicesRequest) (*dnac.SyncDevicesResponse, *resty.Response, error)
+	SyncDevicesWithContextFunc                                   func(ctx context.Context, syncDevicesRequest *dnac.SyncDevicesRequest) (*dnac.SyncDevicesResponse, *resty.Response, error)
+	SyncNetworkDevicesFunc                                       func(syncNetworkDevicesQueryParams *dnac.SyncNetworkDevicesQueryParams, syncNetworkDevicesRequest *[]dnac.SyncNetworkDevicesRequest) (*dnac.SyncNetworkDevicesResponse, *resty.Response, error)
+	SyncNetworkDevicesWithContextFunc                            func(ctx context.Context, syncNetworkDevicesQueryParams *dnac.SyncNetworkDevicesQueryParams, syncNetworkDevicesRequest *[]dnac.SyncNetworkDevicesRequest) (*dnac.SyncNetworkDevicesResponse, *resty.Response, error)
+	UpdateDeviceRoleFunc                                         func(updateDeviceRoleRequest *dnac.UpdateDeviceRoleRequest) (*dnac.UpdateDeviceRoleResponse, *resty.Response, error)
+	UpdateDeviceRoleWithContextFunc                              func(ctx context.Context, updateDeviceRoleRequest *dnac.UpdateDeviceRoleRequest) (*dnac.UpdateDeviceRoleResponse, *resty.Response, error)
+}
+
+// AddDevice calls AddDeviceFunc.
+func (m *DevicesAPI) AddDevice(addDeviceRequest *dnac.AddDeviceRequest) (*dnac.AddDeviceResponse, *resty.Response, error) {
+	m.record("AddDevice", addDeviceRequest)
+	if m.AddDeviceFunc == nil {
+		panic("dnacmock: DevicesAPI.AddDevice called but AddDeviceFunc is not set")
+	}
+	return m.AddDeviceFunc(addDeviceRequest)
+}
+
+// AddDeviceWithContext calls AddDeviceWithContextFunc.
+func (m *DevicesAPI) AddDeviceWithContext(ctx context.Context, addDeviceRequest *dnac.AddDeviceRequest) (*dnac.AddDeviceResponse, *resty.Response, error) {
+	m.record("AddDeviceWithContext", ctx, addDeviceRequest)
+	if m.AddDeviceWithContextFunc == nil {
+		panic("dnacmock: DevicesAPI.AddDeviceWithContext called but AddDeviceWithContextFunc is not set")
+	}
+	return m.AddDeviceWithContextFunc(ctx, addDeviceRequest)
+}
+
+// DeleteDeviceByID calls DeleteDeviceByIDFunc.
+func (m *DevicesAPI) DeleteDeviceByID(id string, deleteDeviceByIDQueryParams *dnac.DeleteDeviceByIDQueryParams) (*dnac.DeleteDeviceByIDResponse, *resty.Response, error) {
+	m.record("DeleteDeviceByID", id, deleteDeviceByIDQueryParams)
+	if m.DeleteDeviceByIDFunc == nil {
+		panic("dnacmock: DevicesAPI.DeleteDeviceByID called but DeleteDeviceByIDFunc is not set")
+	}
+	return m.DeleteDeviceByIDFunc(id, deleteDeviceByIDQueryParams)
+}
+
+// DeleteDeviceByIDWithContext calls DeleteDeviceByIDWithContextFunc.
+func (m *DevicesAPI) DeleteDeviceByIDWithContext(ctx context.Context, id string, deleteDeviceByIDQueryParams *dnac.DeleteDeviceByIDQueryParams) (*dnac.DeleteDeviceByIDResponse, *resty.Response, error) {
+	m.record("DeleteDeviceByIDWithContext", ctx, id, deleteDeviceByIDQueryParams)
+	if m.DeleteDeviceByIDWithContextFunc == nil {
+		panic("dnacmock: DevicesAPI.DeleteDeviceByIDWithContext called but DeleteDeviceByIDWithContextFunc is not set")
+	}
+	return m.DeleteDeviceByIDWithContextFunc(ctx, id, deleteDeviceByIDQueryParams)
+}
+
+// Devices calls DevicesFunc.
+func (m *DevicesAPI) Devices(devicesQueryParams *dnac.DevicesQueryParams) (*dnac.DevicesResponse, *resty.Response, error) {
+	m.record("Devices", devicesQueryParams)
+	if m.DevicesFunc == nil {
+		panic("dnacmock: DevicesAPI.Devices called but DevicesFunc is not set")
+	}
+	return m.DevicesFunc(devicesQueryParams)
+}
+
+// DevicesWithContext calls DevicesWithContextFunc.
+func (m *DevicesAPI) DevicesWithContext(ctx context.Context, devicesQueryParams *dnac.DevicesQueryParams) (*dnac.DevicesResponse, *resty.Response, error) {
+	m.record("DevicesWithContext", ctx, devicesQueryParams)
+	if m.DevicesWithContextFunc == nil {
+		panic("dnacmock: DevicesAPI.DevicesWithContext called but DevicesWithContextFunc is not set")
+	}
+	return m.DevicesWithContextFunc(ctx, devicesQueryParams)
+}
+
+// ExportDeviceList calls ExportDeviceListFunc.
+func (m *DevicesAPI) ExportDeviceList(exportDeviceListRequest *dnac.ExportDeviceListRequest) (*dnac.ExportDeviceListResponse, *resty.Response, error) {
+	m.record("ExportDeviceList", exportDeviceListRequest)
+	if m.ExportDeviceListFunc == nil {
+		panic("dnacmock: DevicesAPI.ExportDeviceList called but ExportDeviceListFunc is not set")
+	}
+	return m.ExportDeviceListFunc(exportDeviceListRequest)
+}
+
+// ExportDeviceListWithContext calls ExportDeviceListWithContextFunc.
+func (m *DevicesAPI) ExportDeviceListWithContext(ctx context.Context, exportDeviceListRequest *dnac.ExportDeviceListRequest) (*dnac.ExportDeviceListResponse, *resty.Response, error) {
+	m.record("ExportDeviceListWithContext", ctx, exportDeviceListRequest)
+	if m.ExportDeviceListWithContextFunc == nil {
+		panic("dnacmock: DevicesAPI.ExportDeviceListWithContext called but ExportDeviceListWithContextFunc is not set")
+	}
+	return m.ExportDeviceListWithContextFunc(ctx, exportDeviceListRequest)
+}
+
+// GetAllInterfaces calls GetAllInterfacesFunc.
+func (m *DevicesAPI) GetAllInterfaces(getAllInterfacesQueryParams *dnac.GetAllInterfacesQueryParams) (*dnac.GetAllInterfacesResponse, *resty.Response, error) {
+	m.record("GetAllInterfaces", getAllInterfacesQueryParams)
+	if m.GetAllInterfacesFunc == nil {
+		panic("dnacmock: DevicesAPI.GetAllInterfaces called but GetAllInterfacesFunc is not set")
+	}
+	return m.GetAllInterfacesFunc(getAllInterfacesQueryParams)
+}
+
+// GetAllInterfacesWithContext calls GetAllInterfacesWithContextFunc.
+func (m *DevicesAPI) GetAllInterfacesWithContext(ctx context.Context, getAllInterfacesQueryParams *dnac.GetAllInterfacesQueryParams) (*dnac.GetAllInterfacesResponse, *resty.Response, error) {
+	m.record("GetAllInterfacesWithContext", ctx, getAllInterfacesQueryParams)
+	if m.GetAllInterfacesWithContextFunc == nil {
+		panic("dnacmock: DevicesAPI.GetAllInterfacesWithContext called but GetAllInterfacesWithContextFunc is not set")
+	}
+	return m.GetAllInterfacesWithContextFunc(ctx, getAllInterfacesQueryParams)
+}
+
+// GetDeviceByID calls GetDeviceByIDFunc.
+func (m *DevicesAPI) GetDeviceByID(id string) (*dnac.DevicesGetDeviceByIDResponse, *resty.Response, error) {
+	m.record("GetDeviceByID", id)
+	if m.GetDeviceByIDFunc == nil {
+		panic("dnacmock: DevicesAPI.GetDeviceByID called but GetDeviceByIDFunc is not set")
+	}
+	return m.GetDeviceByIDFunc(id)
+}
+
+// GetDeviceByIDWithContext calls GetDeviceByIDWithContextFunc.
+func (m *DevicesAPI) GetDeviceByIDWithContext(ctx context.Context, id string) (*dnac.DevicesGetDeviceByIDResponse, *resty.Response, error) {
+	m.record("GetDeviceByIDWithContext", ctx, id)
+	if m.GetDeviceByIDWithContextFunc == nil {
+		panic("dnacmock: DevicesAPI.GetDeviceByIDWithContext called but GetDeviceByIDWithContextFunc is not set")
+	}
+	return m.GetDeviceByIDWithContextFunc(ctx, id)
+}
+
+// GetDeviceBySerialNumber calls GetDeviceBySerialNumberFunc.
+func (m *DevicesAPI) GetDeviceBySerialNumber(serialNumber string) (*dnac.GetDeviceBySerialNumberResponse, *resty.Response, error) {
+	m.record("GetDeviceBySerialNumber", serialNumber)
+	if m.GetDeviceBySerialNumberFunc == nil {
+		panic("dnacmock: DevicesAPI.GetDeviceBySerialNumber called but GetDeviceBySerialNumberFunc is not set")
+	}
+	return m.GetDeviceBySerialNumberFunc(serialNumber)
+}
+
+// GetDeviceBySerialNumberWithContext calls GetDeviceBySerialNumberWithContextFunc.
+func (m *DevicesAPI) GetDeviceBySerialNumberWithContext(ctx context.Context, serialNumber string) (*dnac.GetDeviceBySerialNumberResponse, *resty.Response, error) {
+	m.record("GetDeviceBySerialNumberWithContext", ctx, serialNumber)
+	if m.GetDeviceBySerialNumberWithContextFunc == nil {
+		panic("dnacmock: DevicesAPI.GetDeviceBySerialNumberWithContext called but GetDeviceBySerialNumberWithContextFunc is not set")
+	}
+	return m.GetDeviceBySerialNumberWithContextFunc(ctx, serialNumber)
+}
+
+// GetDeviceConfigByID calls GetDeviceConfigByIDFunc.
+func (m *DevicesAPI) GetDeviceConfigByID(networkDeviceID string) (*dnac.GetDeviceConfigByIDResponse, *resty.Response, error) {
+	m.record("GetDeviceConfigByID", networkDeviceID)
+	if m.GetDeviceConfigByIDFunc == nil {
+		panic("dnacmock: DevicesAPI.GetDeviceConfigByID called but GetDeviceConfigByIDFunc is not set")
+	}
+	return m.GetDeviceConfigByIDFunc(networkDeviceID)
+}
+
+// GetDeviceConfigByIDWithContext calls GetDeviceConfigByIDWithContextFunc.
+func (m *DevicesAPI) GetDeviceConfigByIDWithContext(ctx context.Context, networkDeviceID string) (*dnac.GetDeviceConfigByIDResponse, *resty.Response, error) {
+	m.record("GetDeviceConfigByIDWithContext", ctx, networkDeviceID)
+	if m.GetDeviceConfigByIDWithContextFunc == nil {
+		panic("dnacmock: DevicesAPI.GetDeviceConfigByIDWithContext called but GetDeviceConfigByIDWithContextFunc is not set")
+	}
+	return m.GetDeviceConfigByIDWithContextFunc(ctx, networkDeviceID)
+}
+
+// GetDeviceConfigCount calls GetDeviceConfigCountFunc.
+func (m *DevicesAPI) GetDeviceConfigCount() (*dnac.GetDeviceConfigCountResponse, *resty.Response, error) {
+	m.record("GetDeviceConfigCount")
+	if m.GetDeviceConfigCountFunc == nil {
+		panic("dnacmock: DevicesAPI.GetDeviceConfigCount called but GetDeviceConfigCountFunc is not set")
+	}
+	return m.GetDeviceConfigCountFunc()
+}
+
+// GetDeviceConfigCountWithContext calls GetDeviceConfigCountWithContextFunc.
+func (m *DevicesAPI) GetDeviceConfigCountWithContext(ctx context.Context) (*dnac.GetDeviceConfigCountResponse, *resty.Response, error) {
+	m.record("GetDeviceConfigCountWithContext", ctx)
+	if m.GetDeviceConfigCountWithContextFunc == nil {
+		panic("dnacmock: DevicesAPI.GetDeviceConfigCountWithContext called but GetDeviceConfigCountWithContextFunc is not set")
+	}
+	return m.GetDeviceConfigCountWithContextFunc(ctx)
+}
+
+// GetDeviceConfigForAllDevices calls GetDeviceConfigForAllDevicesFunc.
+func (m *DevicesAPI) GetDeviceConfigForAllDevices() (*dnac.GetDeviceConfigForAllDevicesResponse, *resty.Response, error) {
+	m.record("GetDeviceConfigForAllDevices")
+	if m.GetDeviceConfigForAllDevicesFunc == nil {
+		panic("dnacmock: DevicesAPI.GetDeviceConfigForAllDevices called but GetDeviceConfigForAllDevicesFunc is not set")
+	}
+	return m.GetDeviceConfigForAllDevicesFunc()
+}
+
+// GetDeviceConfigForAllDevicesWithContext calls GetDeviceConfigForAllDevicesWithContextFunc.
+func (m *DevicesAPI) GetDeviceConfigForAllDevicesWithContext(ctx context.Context) (*dnac.GetDeviceConfigForAllDevicesResponse, *resty.Response, error) {
+	m.record("GetDeviceConfigForAllDevicesWithContext", ctx)
+	if m.GetDeviceConfigForAllDevicesWithContextFunc == nil {
+		panic("dnacmock: DevicesAPI.GetDeviceConfigForAllDevicesWithContext called but GetDeviceConfigForAllDevicesWithContextFunc is not set")
+	}
+	return m.GetDeviceConfigForAllDevicesWithContextFunc(ctx)
+}
+
+// GetDeviceCount calls GetDeviceCountFunc.
+func (m *DevicesAPI) GetDeviceCount() (*dnac.GetDeviceCountResponse, *resty.Response, error) {
+	m.record("GetDeviceCount")
+	if m.GetDeviceCountFunc == nil {
+		panic("dnacmock: DevicesAPI.GetDeviceCount called but GetDeviceCountFunc is not set")
+	}
+	return m.GetDeviceCountFunc()
+}
+
+// GetDeviceCountWithContext calls GetDeviceCountWithContextFunc.
+func (m *DevicesAPI) GetDeviceCountWithContext(ctx context.Context) (*dnac.GetDeviceCountResponse, *resty.Response, error) {
+	m.record("GetDeviceCountWithContext", ctx)
+	if m.GetDeviceCountWithContextFunc == nil {
+		panic("dnacmock: DevicesAPI.GetDeviceCountWithContext called but GetDeviceCountWithContextFunc is not set")
+	}
+	return m.GetDeviceCountWithContextFunc(ctx)
+}
+
+// GetDeviceDetail calls GetDeviceDetailFunc.
+func (m *DevicesAPI) GetDeviceDetail(getDeviceDetailQueryParams *dnac.GetDeviceDetailQueryParams) (*dnac.GetDeviceDetailResponse, *resty.Response, error) {
+	m.record("GetDeviceDetail", getDeviceDetailQueryParams)
+	if m.GetDeviceDetailFunc == nil {
+		panic("dnacmock: DevicesAPI.GetDeviceDetail called but GetDeviceDetailFunc is not set")
+	}
+	return m.GetDeviceDetailFunc(getDeviceDetailQueryParams)
+}
+
+// GetDeviceDetailWithContext calls GetDeviceDetailWithContextFunc.
+func (m *DevicesAPI) GetDeviceDetailWithContext(ctx context.Context, getDeviceDetailQueryParams *dnac.GetDeviceDetailQueryParams) (*dnac.GetDeviceDetailResponse, *resty.Response, error) {
+	m.record("GetDeviceDetailWithContext", ctx, getDeviceDetailQueryParams)
+	if m.GetDeviceDetailWithContextFunc == nil {
+		panic("dnacmock: DevicesAPI.GetDeviceDetailWithContext called but GetDeviceDetailWithContextFunc is not set")
+	}
+	return m.GetDeviceDetailWithContextFunc(ctx, getDeviceDetailQueryParams)
+}
+
+// GetDeviceEnrichmentDetails calls GetDeviceEnrichmentDetailsFunc.
+func (m *DevicesAPI) GetDeviceEnrichmentDetails() (*dnac.GetDeviceEnrichmentDetailsResponse, *resty.Response, error) {
+	m.record("GetDeviceEnrichmentDetails")
+	if m.GetDeviceEnrichmentDetailsFunc == nil {
+		panic("dnacmock: DevicesAPI.GetDeviceEnrichmentDetails called but GetDeviceEnrichmentDetailsFunc is not set")
+	}
+	return m.GetDeviceEnrichmentDetailsFunc()
+}
+
+// GetDeviceEnrichmentDetailsWithContext calls GetDeviceEnrichmentDetailsWithContextFunc.
+func (m *DevicesAPI) GetDeviceEnrichmentDetailsWithContext(ctx context.Context) (*dnac.GetDeviceEnrichmentDetailsResponse, *resty.Response, error) {
+	m.record("GetDeviceEnrichmentDetailsWithContext", ctx)
+	if m.GetDeviceEnrichmentDetailsWithContextFunc == nil {
+		panic("dnacmock: DevicesAPI.GetDeviceEnrichmentDetailsWithContext called but GetDeviceEnrichmentDetailsWithContextFunc is not set")
+	}
+	return m.GetDeviceEnrichmentDetailsWithContextFunc(ctx)
+}
+
+// GetDeviceInterfaceCount calls GetDeviceInterfaceCountFunc.
+func (m *DevicesAPI) GetDeviceInterfaceCount() (*dnac.GetDeviceInterfaceCountResponse, *resty.Response, error) {
+	m.record("GetDeviceInterfaceCount")
+	if m.GetDeviceInterfaceCountFunc == nil {
+		panic("dnacmock: DevicesAPI.GetDeviceInterfaceCount called but GetDeviceInterfaceCountFunc is not set")
+	}
+	return m.GetDeviceInterfaceCountFunc()
+}
+
+// GetDeviceInterfaceCountByDeviceID calls GetDeviceInterfaceCountByDeviceIDFunc.
+func (m *DevicesAPI) GetDeviceInterfaceCountByDeviceID(deviceID string) (*dnac.GetDeviceInterfaceCountByDeviceIDResponse, *resty.Response, error) {
+	m.record("GetDeviceInterfaceCountByDeviceID", deviceID)
+	if m.GetDeviceInterfaceCountByDeviceIDFunc == nil {
+		panic("dnacmock: DevicesAPI.GetDeviceInterfaceCountByDeviceID called but GetDeviceInterfaceCountByDeviceIDFunc is not set")
+	}
+	return m.GetDeviceInterfaceCountByDeviceIDFunc(deviceID)
+}
+
+// GetDeviceInterfaceCountByDeviceIDWithContext calls GetDeviceInterfaceCountByDeviceIDWithContextFunc.
+func (m *DevicesAPI) GetDeviceInterfaceCountByDeviceIDWithContext(ctx context.Context, deviceID string) (*dnac.GetDeviceInterfaceCountByDeviceIDResponse, *resty.Response, error) {
+	m.record("GetDeviceInterfaceCountByDeviceIDWithContext", ctx, deviceID)
+	if m.GetDeviceInterfaceCountByDeviceIDWithContextFunc == nil {
+		panic("dnacmock: DevicesAPI.GetDeviceInterfaceCountByDeviceIDWithContext called but GetDeviceInterfaceCountByDeviceIDWithContextFunc is not set")
+	}
+	return m.GetDeviceInterfaceCountByDeviceIDWithContextFunc(ctx, deviceID)
+}
+
+// GetDeviceInterfaceCountWithContext calls GetDeviceInterfaceCountWithContextFunc.
+func (m *DevicesAPI) GetDeviceInterfaceCountWithContext(ctx context.Context) (*dnac.GetDeviceInterfaceCountResponse, *resty.Response, error) {
+	m.record("GetDeviceInterfaceCountWithContext", ctx)
+	if m.GetDeviceInterfaceCountWithContextFunc == nil {
+		panic("dnacmock: DevicesAPI.GetDeviceInterfaceCountWithContext called but GetDeviceInterfaceCountWithContextFunc is not set")
+	}
+	return m.GetDeviceInterfaceCountWithContextFunc(ctx)
+}
+
+// GetDeviceInterfaceVLANs calls GetDeviceInterfaceVLANsFunc.
+func (m *DevicesAPI) GetDeviceInterfaceVLANs(id string, getDeviceInterfaceVLANsQueryParams *dnac.GetDeviceInterfaceVLANsQueryParams) (*dnac.GetDeviceInterfaceVLANsResponse, *resty.Response, error) {
+	m.record("GetDeviceInterfaceVLANs", id, getDeviceInterfaceVLANsQueryParams)
+	if m.GetDeviceInterfaceVLANsFunc == nil {
+		panic("dnacmock: DevicesAPI.GetDeviceInterfaceVLANs called but GetDeviceInterfaceVLANsFunc is not set")
+	}
+	return m.GetDeviceInterfaceVLANsFunc(id, getDeviceInterfaceVLANsQueryParams)
+}
+
+// GetDeviceInterfaceVLANsWithContext calls GetDeviceInterfaceVLANsWithContextFunc.
+func (m *DevicesAPI) GetDeviceInterfaceVLANsWithContext(ctx context.Context, id string, getDeviceInterfaceVLANsQueryParams *dnac.GetDeviceInterfaceVLANsQueryParams) (*dnac.GetDeviceInterfaceVLANsResponse, *resty.Response, error) {
+	m.record("GetDeviceInterfaceVLANsWithContext", ctx, id, getDeviceInterfaceVLANsQueryParams)
+	if m.GetDeviceInterfaceVLANsWithContextFunc == nil {
+		panic("dnacmock: DevicesAPI.GetDeviceInterfaceVLANsWithContext called but GetDeviceInterfaceVLANsWithContextFunc is not set")
+	}
+	return m.GetDeviceInterfaceVLANsWithContextFunc(ctx, id, getDeviceInterfaceVLANsQueryParams)
+}
+
+// GetDeviceInterfacesBySpecifiedRange calls GetDeviceInterfacesBySpecifiedRangeFunc.
+func (m *DevicesAPI) GetDeviceInterfacesBySpecifiedRange(deviceID string, startIndex int, recordsToReturn int) (*dnac.GetDeviceInterfacesBySpecifiedRangeResponse, *resty.Response, error) {
+	m.record("GetDeviceInterfacesBySpecifiedRange", deviceID, startIndex, recordsToReturn)
+	if m.GetDeviceInterfacesBySpecifiedRangeFunc == nil {
+		panic("dnacmock: DevicesAPI.GetDeviceInterfacesBySpecifiedRange called but GetDeviceInterfacesBySpecifiedRangeFunc is not set")
+	}
+	return m.GetDeviceInterfacesBySpecifiedRangeFunc(deviceID, startIndex, recordsToReturn)
+}
+
+// GetDeviceInterfacesBySpecifiedRangeWithContext calls GetDeviceInterfacesBySpecifiedRangeWithContextFunc.
+func (m *DevicesAPI) GetDeviceInterfacesBySpecifiedRangeWithContext(ctx context.Context, deviceID string, startIndex int, recordsToReturn int) (*dnac.GetDeviceInterfacesBySpecifiedRangeResponse, *resty.Response, error) {
+	m.record("GetDeviceInterfacesBySpecifiedRangeWithContext", ctx, deviceID, startIndex, recordsToReturn)
+	if m.GetDeviceInterfacesBySpecifiedRangeWithContextFunc == nil {
+		panic("dnacmock: DevicesAPI.GetDeviceInterfacesBySpecifiedRangeWithContext called but GetDeviceInterfacesBySpecifiedRangeWithContextFunc is not set")
+	}
+	return m.GetDeviceInterfacesBySpecifiedRangeWithContextFunc(ctx, deviceID, startIndex, recordsToReturn)
+}
+
+// GetDeviceList calls GetDeviceListFunc.
+func (m *DevicesAPI) GetDeviceList(getDeviceListQueryParams *dnac.GetDeviceListQueryParams) (*dnac.GetDeviceListResponse, *resty.Response, error) {
+	m.record("GetDeviceList", getDeviceListQueryParams)
+	if m.GetDeviceListFunc == nil {
+		panic("dnacmock: DevicesAPI.GetDeviceList called but GetDeviceListFunc is not set")
+	}
+	return m.GetDeviceListFunc(getDeviceListQueryParams)
+}
+
+// GetDeviceListWithContext calls GetDeviceListWithContextFunc.
+func (m *DevicesAPI) GetDeviceListWithContext(ctx context.Context, getDeviceListQueryParams *dnac.GetDeviceListQueryParams) (*dnac.GetDeviceListResponse, *resty.Response, error) {
+	m.record("GetDeviceListWithContext", ctx, getDeviceListQueryParams)
+	if m.GetDeviceListWithContextFunc == nil {
+		panic("dnacmock: DevicesAPI.GetDeviceListWithContext called but GetDeviceListWithContextFunc is not set")
+	}
+	return m.GetDeviceListWithContextFunc(ctx, getDeviceListQueryParams)
+}
+
+// GetDeviceSummary calls GetDeviceSummaryFunc.
+func (m *DevicesAPI) GetDeviceSummary(id string) (*dnac.GetDeviceSummaryResponse, *resty.Response, error) {
+	m.record("GetDeviceSummary", id)
+	if m.GetDeviceSummaryFunc == nil {
+		panic("dnacmock: DevicesAPI.GetDeviceSummary called but GetDeviceSummaryFunc is not set")
+	}
+	return m.GetDeviceSummaryFunc(id)
+}
+
+// GetDeviceSummaryWithContext calls GetDeviceSummaryWithContextFunc.
+func (m *DevicesAPI) GetDeviceSummaryWithContext(ctx context.Context, id string) (*dnac.GetDeviceSummaryResponse, *resty.Response, error) {
+	m.record("GetDeviceSummaryWithContext", ctx, id)
+	if m.GetDeviceSummaryWithContextFunc == nil {
+		panic("dnacmock: DevicesAPI.GetDeviceSummaryWithContext called but GetDeviceSummaryWithContextFunc is not set")
+	}
+	return m.GetDeviceSummaryWithContextFunc(ctx, id)
+}
+
+// GetFunctionalCapabilityByID calls GetFunctionalCapabilityByIDFunc.
+func (m *DevicesAPI) GetFunctionalCapabilityByID(id string) (*dnac.GetFunctionalCapabilityByIDResponse, *resty.Response, error) {
+	m.record("GetFunctionalCapabilityByID", id)
+	if m.GetFunctionalCapabilityByIDFunc == nil {
+		panic("dnacmock: DevicesAPI.GetFunctionalCapabilityByID called but GetFunctionalCapabilityByIDFunc is not set")
+	}
+	return m.GetFunctionalCapabilityByIDFunc(id)
+}
+
+// GetFunctionalCapabilityByIDWithContext calls GetFunctionalCapabilityByIDWithContextFunc.
+func (m *DevicesAPI) GetFunctionalCapabilityByIDWithContext(ctx context.Context, id string) (*dnac.GetFunctionalCapabilityByIDResponse, *resty.Response, error) {
+	m.record("GetFunctionalCapabilityByIDWithContext", ctx, id)
+	if m.GetFunctionalCapabilityByIDWithContextFunc == nil {
+		panic("dnacmock: DevicesAPI.GetFunctionalCapabilityByIDWithContext called but GetFunctionalCapabilityByIDWithContextFunc is not set")
+	}
+	return m.GetFunctionalCapabilityByIDWithContextFunc(ctx, id)
+}
+
+// GetFunctionalCapabilityForDevices calls GetFunctionalCapabilityForDevicesFunc.
+func (m *DevicesAPI) GetFunctionalCapabilityForDevices(getFunctionalCapabilityForDevicesQueryParams *dnac.GetFunctionalCapabilityForDevicesQueryParams) (*dnac.GetFunctionalCapabilityForDevicesResponse, *resty.Response, error) {
+	m.record("GetFunctionalCapabilityForDevices", getFunctionalCapabilityForDevicesQueryParams)
+	if m.GetFunctionalCapabilityForDevicesFunc == nil {
+		panic("dnacmock: DevicesAPI.GetFunctionalCapabilityForDevices called but GetFunctionalCapabilityForDevicesFunc is not set")
+	}
+	return m.GetFunctionalCapabilityForDevicesFunc(getFunctionalCapabilityForDevicesQueryParams)
+}
+
+// GetFunctionalCapabilityForDevicesWithContext calls GetFunctionalCapabilityForDevicesWithContextFunc.
+func (m *DevicesAPI) GetFunctionalCapabilityForDevicesWithContext(ctx context.Context, getFunctionalCapabilityForDevicesQueryParams *dnac.GetFunctionalCapabilityForDevicesQueryParams) (*dnac.GetFunctionalCapabilityForDevicesResponse, *resty.Response, error) {
+	m.record("GetFunctionalCapabilityForDevicesWithContext", ctx, getFunctionalCapabilityForDevicesQueryParams)
+	if m.GetFunctionalCapabilityForDevicesWithContextFunc == nil {
+		panic("dnacmock: DevicesAPI.GetFunctionalCapabilityForDevicesWithContext called but GetFunctionalCapabilityForDevicesWithContextFunc is not set")
+	}
+	return m.GetFunctionalCapabilityForDevicesWithContextFunc(ctx, getFunctionalCapabilityForDevicesQueryParams)
+}
+
+// GetISISInterfaces calls GetISISInterfacesFunc.
+func (m *DevicesAPI) GetISISInterfaces() (*dnac.GetISISInterfacesResponse, *resty.Response, error) {
+	m.record("GetISISInterfaces")
+	if m.GetISISInterfacesFunc == nil {
+		panic("dnacmock: DevicesAPI.GetISISInterfaces called but GetISISInterfacesFunc is not set")
+	}
+	return m.GetISISInterfacesFunc()
+}
+
+// GetISISInterfacesWithContext calls GetISISInterfacesWithContextFunc.
+func (m *DevicesAPI) GetISISInterfacesWithContext(ctx context.Context) (*dnac.GetISISInterfacesResponse, *resty.Response, error) {
+	m.record("GetISISInterfacesWithContext", ctx)
+	if m.GetISISInterfacesWithContextFunc == nil {
+		panic("dnacmock: DevicesAPI.GetISISInterfacesWithContext called but GetISISInterfacesWithContextFunc is not set")
+	}
+	return m.GetISISInterfacesWithContextFunc(ctx)
+}
+
+// GetInterfaceByID calls GetInterfaceByIDFunc.
+func (m *DevicesAPI) GetInterfaceByID(id string) (*dnac.GetInterfaceByIDResponse, *resty.Response, error) {
+	m.record("GetInterfaceByID", id)
+	if m.GetInterfaceByIDFunc == nil {
+		panic("dnacmock: DevicesAPI.GetInterfaceByID called but GetInterfaceByIDFunc is not set")
+	}
+	return m.GetInterfaceByIDFunc(id)
+}
+
+// GetInterfaceByIDWithContext calls GetInterfaceByIDWithContextFunc.
+func (m *DevicesAPI) GetInterfaceByIDWithContext(ctx context.Context, id string) (*dnac.GetInterfaceByIDResponse, *resty.Response, error) {
+	m.record("GetInterfaceByIDWithContext", ctx, id)
+	if m.GetInterfaceByIDWithContextFunc == nil {
+		panic("dnacmock: DevicesAPI.GetInterfaceByIDWithContext called but GetInterfaceByIDWithContextFunc is not set")
+	}
+	return m.GetInterfaceByIDWithContextFunc(ctx, id)
+}
+
+// GetInterfaceByIP calls GetInterfaceByIPFunc.
+func (m *DevicesAPI) GetInterfaceByIP(ipAddress string) (*dnac.GetInterfaceByIPResponse, *resty.Response, error) {
+	m.record("GetInterfaceByIP", ipAddress)
+	if m.GetInterfaceByIPFunc == nil {
+		panic("dnacmock: DevicesAPI.GetInterfaceByIP called but GetInterfaceByIPFunc is not set")
+	}
+	return m.GetInterfaceByIPFunc(ipAddress)
+}
+
+// GetInterfaceByIPWithContext calls GetInterfaceByIPWithContextFunc.
+func (m *DevicesAPI) GetInterfaceByIPWithContext(ctx context.Context, ipAddress string) (*dnac.GetInterfaceByIPResponse, *resty.Response, error) {
+	m.record("GetInterfaceByIPWithContext", ctx, ipAddress)
+	if m.GetInterfaceByIPWithContextFunc == nil {
+		panic("dnacmock: DevicesAPI.GetInterfaceByIPWithContext called but GetInterfaceByIPWithContextFunc is not set")
+	}
+	return m.GetInterfaceByIPWithContextFunc(ctx, ipAddress)
+}
+
+// GetInterfaceDetailsByDeviceIDAndInterfaceName calls GetInterfaceDetailsByDeviceIDAndInterfaceNameFunc.
+func (m *DevicesAPI) GetInterfaceDetailsByDeviceIDAndInterfaceName(deviceID string, getInterfaceDetailsByDeviceIDAndInterfaceNameQueryParams *dnac.GetInterfaceDetailsByDeviceIDAndInterfaceNameQueryParams) (*dnac.GetInterfaceDetailsByDeviceIDAndInterfaceNameResponse, *resty.Response, error) {
+	m.record("GetInterfaceDetailsByDeviceIDAndInterfaceName", deviceID, getInterfaceDetailsByDeviceIDAndInterfaceNameQueryParams)
+	if m.GetInterfaceDetailsByDeviceIDAndInterfaceNameFunc == nil {
+		panic("dnacmock: DevicesAPI.GetInterfaceDetailsByDeviceIDAndInterfaceName called but GetInterfaceDetailsByDeviceIDAndInterfaceNameFunc is not set")
+	}
+	return m.GetInterfaceDetailsByDeviceIDAndInterfaceNameFunc(deviceID, getInterfaceDetailsByDeviceIDAndInterfaceNameQueryParams)
+}
+
+// GetInterfaceDetailsByDeviceIDAndInterfaceNameWithContext calls GetInterfaceDetailsByDeviceIDAndInterfaceNameWithContextFunc.
+func (m *DevicesAPI) GetInterfaceDetailsByDeviceIDAndInterfaceNameWithContext(ctx context.Context, deviceID string, getInterfaceDetailsByDeviceIDAndInterfaceNameQueryParams *dnac.GetInterfaceDetailsByDeviceIDAndInterfaceNameQueryParams) (*dnac.GetInterfaceDetailsByDeviceIDAndInterfaceNameResponse, *resty.Response, error) {
+	m.record("GetInterfaceDetailsByDeviceIDAndInterfaceNameWithContext", ctx, deviceID, getInterfaceDetailsByDeviceIDAndInterfaceNameQueryParams)
+	if m.GetInterfaceDetailsByDeviceIDAndInterfaceNameWithContextFunc == nil {
+		panic("dnacmock: DevicesAPI.GetInterfaceDetailsByDeviceIDAndInterfaceNameWithContext called but GetInterfaceDetailsByDeviceIDAndInterfaceNameWithContextFunc is not set")
+	}
+	return m.GetInterfaceDetailsByDeviceIDAndInterfaceNameWithContextFunc(ctx, deviceID, getInterfaceDetailsByDeviceIDAndInterfaceNameQueryParams)
+}
+
+// GetInterfaceInfoByID calls GetInterfaceInfoByIDFunc.
+func (m *DevicesAPI) GetInterfaceInfoByID(deviceID string) (*dnac.GetInterfaceInfoByIDResponse, *resty.Response, error) {
+	m.record("GetInterfaceInfoByID", deviceID)
+	if m.GetInterfaceInfoByIDFunc == nil {
+		panic("dnacmock: DevicesAPI.GetInterfaceInfoByID called but GetInterfaceInfoByIDFunc is not set")
+	}
+	return m.GetInterfaceInfoByIDFunc(deviceID)
+}
+
+// GetInterfaceInfoByIDWithContext calls GetInterfaceInfoByIDWithContextFunc.
+func (m *DevicesAPI) GetInterfaceInfoByIDWithContext(ctx context.Context, deviceID string) (*dnac.GetInterfaceInfoByIDResponse, *resty.Response, error) {
+	m.record("GetInterfaceInfoByIDWithContext", ctx, deviceID)
+	if m.GetInterfaceInfoByIDWithContextFunc == nil {
+		panic("dnacmock: DevicesAPI.GetInterfaceInfoByIDWithContext called but GetInterfaceInfoByIDWithContextFunc is not set")
+	}
+	return m.GetInterfaceInfoByIDWithContextFunc(ctx, deviceID)
+}
+
+// GetModuleCount calls GetModuleCountFunc.
+func (m *DevicesAPI) GetModuleCount(getModuleCountQueryParams *dnac.GetModuleCountQueryParams) (*dnac.GetModuleCountResponse, *resty.Response, error) {
+	m.record("GetModuleCount", getModuleCountQueryParams)
+	if m.GetModuleCountFunc == nil {
+		panic("dnacmock: DevicesAPI.GetModuleCount called but GetModuleCountFunc is not set")
+	}
+	return m.GetModuleCountFunc(getModuleCountQueryParams)
+}
+
+// GetModuleCountWithContext calls GetModuleCountWithContextFunc.
+func (m *DevicesAPI) GetModuleCountWithContext(ctx context.Context, getModuleCountQueryParams *dnac.GetModuleCountQueryParams) (*dnac.GetModuleCountResponse, *resty.Response, error) {
+	m.record("GetModuleCountWithContext", ctx, getModuleCountQueryParams)
+	if m.GetModuleCountWithContextFunc == nil {
+		panic("dnacmock: DevicesAPI.GetModuleCountWithContext called but GetModuleCountWithContextFunc is not set")
+	}
+	return m.GetModuleCountWithContextFunc(ctx, getModuleCountQueryParams)
+}
+
+// GetModuleInfoByID calls GetModuleInfoByIDFunc.
+func (m *DevicesAPI) GetModuleInfoByID(id string) (*dnac.GetModuleInfoByIDResponse, *resty.Response, error) {
+	m.record("GetModuleInfoByID", id)
+	if m.GetModuleInfoByIDFunc == nil {
+		panic("dnacmock: DevicesAPI.GetModuleInfoByID called but GetModuleInfoByIDFunc is not set")
+	}
+	return m.GetModuleInfoByIDFunc(id)
+}
+
+// GetModuleInfoByIDWithContext calls GetModuleInfoByIDWithContextFunc.
+func (m *DevicesAPI) GetModuleInfoByIDWithContext(ctx context.Context, id string) (*dnac.GetModuleInfoByIDResponse, *resty.Response, error) {
+	m.record("GetModuleInfoByIDWithContext", ctx, id)
+	if m.GetModuleInfoByIDWithContextFunc == nil {
+		panic("dnacmock: DevicesAPI.GetModuleInfoByIDWithContext called but GetModuleInfoByIDWithContextFunc is not set")
+	}
+	return m.GetModuleInfoByIDWithContextFunc(ctx, id)
+}
+
+// GetModules calls GetModulesFunc.
+func (m *DevicesAPI) GetModules(getModulesQueryParams *dnac.GetModulesQueryParams) (*dnac.GetModulesResponse, *resty.Response, error) {
+	m.record("GetModules", getModulesQueryParams)
+	if m.GetModulesFunc == nil {
+		panic("dnacmock: DevicesAPI.GetModules called but GetModulesFunc is not set")
+	}
+	return m.GetModulesFunc(getModulesQueryParams)
+}
+
+// GetModulesWithContext calls GetModulesWithContextFunc.
+func (m *DevicesAPI) GetModulesWithContext(ctx context.Context, getModulesQueryParams *dnac.GetModulesQueryParams) (*dnac.GetModulesResponse, *resty.Response, error) {
+	m.record("GetModulesWithContext", ctx, getModulesQueryParams)
+	if m.GetModulesWithContextFunc == nil {
+		panic("dnacmock: DevicesAPI.GetModulesWithContext called but GetModulesWithContextFunc is not set")
+	}
+	return m.GetModulesWithContextFunc(ctx, getModulesQueryParams)
+}
+
+// GetNetworkDeviceByIP calls GetNetworkDeviceByIPFunc.
+func (m *DevicesAPI) GetNetworkDeviceByIP(ipAddress string) (*dnac.GetNetworkDeviceByIPResponse, *resty.Response, error) {
+	m.record("GetNetworkDeviceByIP", ipAddress)
+	if m.GetNetworkDeviceByIPFunc == nil {
+		panic("dnacmock: DevicesAPI.GetNetworkDeviceByIP called but GetNetworkDeviceByIPFunc is not set")
+	}
+	return m.GetNetworkDeviceByIPFunc(ipAddress)
+}
+
+// GetNetworkDeviceByIPWithContext calls GetNetworkDeviceByIPWithContextFunc.
+func (m *DevicesAPI) GetNetworkDeviceByIPWithContext(ctx context.Context, ipAddress string) (*dnac.GetNetworkDeviceByIPResponse, *resty.Response, error) {
+	m.record("GetNetworkDeviceByIPWithContext", ctx, ipAddress)
+	if m.GetNetworkDeviceByIPWithContextFunc == nil {
+		panic("dnacmock: DevicesAPI.GetNetworkDeviceByIPWithContext called but GetNetworkDeviceByIPWithContextFunc is not set")
+	}
+	return m.GetNetworkDeviceByIPWithContextFunc(ctx, ipAddress)
+}
+
+// GetNetworkDeviceByPaginationRange calls GetNetworkDeviceByPaginationRangeFunc.
+func (m *DevicesAPI) GetNetworkDeviceByPaginationRange(startIndex int, recordsToReturn int) (*dnac.GetNetworkDeviceByPaginationRangeResponse, *resty.Response, error) {
+	m.record("GetNetworkDeviceByPaginationRange", startIndex, recordsToReturn)
+	if m.GetNetworkDeviceByPaginationRangeFunc == nil {
+		panic("dnacmock: DevicesAPI.GetNetworkDeviceByPaginationRange called but GetNetworkDeviceByPaginationRangeFunc is not set")
+	}
+	return m.GetNetworkDeviceByPaginationRangeFunc(startIndex, recordsToReturn)
+}
+
+// GetNetworkDeviceByPaginationRangeWithContext calls GetNetworkDeviceByPaginationRangeWithContextFunc.
+func (m *DevicesAPI) GetNetworkDeviceByPaginationRangeWithContext(ctx context.Context, startIndex int, recordsToReturn int) (*dnac.GetNetworkDeviceByPaginationRangeResponse, *resty.Response, error) {
+	m.record("GetNetworkDeviceByPaginationRangeWithContext", ctx, startIndex, recordsToReturn)
+	if m.GetNetworkDeviceByPaginationRangeWithContextFunc == nil {
+		panic("dnacmock: DevicesAPI.GetNetworkDeviceByPaginationRangeWithContext called but GetNetworkDeviceByPaginationRangeWithContextFunc is not set")
+	}
+	return m.GetNetworkDeviceByPaginationRangeWithContextFunc(ctx, startIndex, recordsToReturn)
+}
+
+// GetOSPFInterfaces calls GetOSPFInterfacesFunc.
+func (m *DevicesAPI) GetOSPFInterfaces() (*dnac.GetOSPFInterfacesResponse, *resty.Response, error) {
+	m.record("GetOSPFInterfaces")
+	if m.GetOSPFInterfacesFunc == nil {
+		panic("dnacmock: DevicesAPI.GetOSPFInterfaces called but GetOSPFInterfacesFunc is not set")
+	}
+	return m.GetOSPFInterfacesFunc()
+}
+
+// GetOSPFInterfacesWithContext calls GetOSPFInterfacesWithContextFunc.
+func (m *DevicesAPI) GetOSPFInterfacesWithContext(ctx context.Context) (*dnac.GetOSPFInterfacesResponse, *resty.Response, error) {
+	m.record("GetOSPFInterfacesWithContext", ctx)
+	if m.GetOSPFInterfacesWithContextFunc == nil {
+		panic("dnacmock: DevicesAPI.GetOSPFInterfacesWithContext called but GetOSPFInterfacesWithContextFunc is not set")
+	}
+	return m.GetOSPFInterfacesWithContextFunc(ctx)
+}
+
+// GetOrganizationListForMeraki calls GetOrganizationListForMerakiFunc.
+func (m *DevicesAPI) GetOrganizationListForMeraki(id string) (*dnac.GetOrganizationListForMerakiResponse, *resty.Response, error) {
+	m.record("GetOrganizationListForMeraki", id)
+	if m.GetOrganizationListForMerakiFunc == nil {
+		panic("dnacmock: DevicesAPI.GetOrganizationListForMeraki called but GetOrganizationListForMerakiFunc is not set")
+	}
+	return m.GetOrganizationListForMerakiFunc(id)
+}
+
+// GetOrganizationListForMerakiWithContext calls GetOrganizationListForMerakiWithContextFunc.
+func (m *DevicesAPI) GetOrganizationListForMerakiWithContext(ctx context.Context, id string) (*dnac.GetOrganizationListForMerakiResponse, *resty.Response, error) {
+	m.record("GetOrganizationListForMerakiWithContext", ctx, id)
+	if m.GetOrganizationListForMerakiWithContextFunc == nil {
+		panic("dnacmock: DevicesAPI.GetOrganizationListForMerakiWithContext called but GetOrganizationListForMerakiWithContextFunc is not set")
+	}
+	return m.GetOrganizationListForMerakiWithContextFunc(ctx, id)
+}
+
+// GetPollingIntervalByID calls GetPollingIntervalByIDFunc.
+func (m *DevicesAPI) GetPollingIntervalByID(id string) (*dnac.GetPollingIntervalByIDResponse, *resty.Response, error) {
+	m.record("GetPollingIntervalByID", id)
+	if m.GetPollingIntervalByIDFunc == nil {
+		panic("dnacmock: DevicesAPI.GetPollingIntervalByID called but GetPollingIntervalByIDFunc is not set")
+	}
+	return m.GetPollingIntervalByIDFunc(id)
+}
+
+// GetPollingIntervalByIDWithContext calls GetPollingIntervalByIDWithContextFunc.
+func (m *DevicesAPI) GetPollingIntervalByIDWithContext(ctx context.Context, id string) (*dnac.GetPollingIntervalByIDResponse, *resty.Response, error) {
+	m.record("GetPollingIntervalByIDWithContext", ctx, id)
+	if m.GetPollingIntervalByIDWithContextFunc == nil {
+		panic("dnacmock: DevicesAPI.GetPollingIntervalByIDWithContext called but GetPollingIntervalByIDWithContextFunc is not set")
+	}
+	return m.GetPollingIntervalByIDWithContextFunc(ctx, id)
+}
+
+// GetPollingIntervalForAllDevices calls GetPollingIntervalForAllDevicesFunc.
+func (m *DevicesAPI) GetPollingIntervalForAllDevices() (*dnac.GetPollingIntervalForAllDevicesResponse, *resty.Response, error) {
+	m.record("GetPollingIntervalForAllDevices")
+	if m.GetPollingIntervalForAllDevicesFunc == nil {
+		panic("dnacmock: DevicesAPI.GetPollingIntervalForAllDevices called but GetPollingIntervalForAllDevicesFunc is not set")
+	}
+	return m.GetPollingIntervalForAllDevicesFunc()
+}
+
+// GetPollingIntervalForAllDevicesWithContext calls GetPollingIntervalForAllDevicesWithContextFunc.
+func (m *DevicesAPI) GetPollingIntervalForAllDevicesWithContext(ctx context.Context) (*dnac.GetPollingIntervalForAllDevicesResponse, *resty.Response, error) {
+	m.record("GetPollingIntervalForAllDevicesWithContext", ctx)
+	if m.GetPollingIntervalForAllDevicesWithContextFunc == nil {
+		panic("dnacmock: DevicesAPI.GetPollingIntervalForAllDevicesWithContext called but GetPollingIntervalForAllDevicesWithContextFunc is not set")
+	}
+	return m.GetPollingIntervalForAllDevicesWithContextFunc(ctx)
+}
+
+// GetWirelessLanControllerDetailsByID calls GetWirelessLanControllerDetailsByIDFunc.
+func (m *DevicesAPI) GetWirelessLanControllerDetailsByID(id string) (*dnac.GetWirelessLanControllerDetailsByIDResponse, *resty.Response, error) {
+	m.record("GetWirelessLanControllerDetailsByID", id)
+	if m.GetWirelessLanControllerDetailsByIDFunc == nil {
+		panic("dnacmock: DevicesAPI.GetWirelessLanControllerDetailsByID called but GetWirelessLanControllerDetailsByIDFunc is not set")
+	}
+	return m.GetWirelessLanControllerDetailsByIDFunc(id)
+}
+
+// GetWirelessLanControllerDetailsByIDWithContext calls GetWirelessLanControllerDetailsByIDWithContextFunc.
+func (m *DevicesAPI) GetWirelessLanControllerDetailsByIDWithContext(ctx context.Context, id string) (*dnac.GetWirelessLanControllerDetailsByIDResponse, *resty.Response, error) {
+	m.record("GetWirelessLanControllerDetailsByIDWithContext", ctx, id)
+	if m.GetWirelessLanControllerDetailsByIDWithContextFunc == nil {
+		panic("dnacmock: DevicesAPI.GetWirelessLanControllerDetailsByIDWithContext called but GetWirelessLanControllerDetailsByIDWithContextFunc is not set")
+	}
+	return m.GetWirelessLanControllerDetailsByIDWithContextFunc(ctx, id)
+}
+
+// ListAll calls ListAllFunc.
+func (m *DevicesAPI) ListAll(ctx context.Context, opts *dnac.PageOptions) *dnac.NetworkDeviceIterator {
+	m.record("ListAll", ctx, opts)
+	if m.ListAllFunc == nil {
+		panic("dnacmock: DevicesAPI.ListAll called but ListAllFunc is not set")
+	}
+	return m.ListAllFunc(ctx, opts)
+}
+
+// ListAllMatching calls ListAllMatchingFunc.
+func (m *DevicesAPI) ListAllMatching(ctx context.Context, params *dnac.RetrievesAllNetworkDevicesQueryParams, opts *dnac.PageOptions) *dnac.MatchingDeviceIterator {
+	m.record("ListAllMatching", ctx, params, opts)
+	if m.ListAllMatchingFunc == nil {
+		panic("dnacmock: DevicesAPI.ListAllMatching called but ListAllMatchingFunc is not set")
+	}
+	return m.ListAllMatchingFunc(ctx, params, opts)
+}
+
+// RegisterDeviceForWSA calls RegisterDeviceForWSAFunc.
+func (m *DevicesAPI) RegisterDeviceForWSA(registerDeviceForWSAQueryParams *dnac.RegisterDeviceForWSAQueryParams) (*dnac.RegisterDeviceForWSAResponse, *resty.Response, error) {
+	m.record("RegisterDeviceForWSA", registerDeviceForWSAQueryParams)
+	if m.RegisterDeviceForWSAFunc == nil {
+		panic("dnacmock: DevicesAPI.RegisterDeviceForWSA called but RegisterDeviceForWSAFunc is not set")
+	}
+	return m.RegisterDeviceForWSAFunc(registerDeviceForWSAQueryParams)
+}
+
+// RegisterDeviceForWSAWithContext calls RegisterDeviceForWSAWithContextFunc.
+func (m *DevicesAPI) RegisterDeviceForWSAWithContext(ctx context.Context, registerDeviceForWSAQueryParams *dnac.RegisterDeviceForWSAQueryParams) (*dnac.RegisterDeviceForWSAResponse, *resty.Response, error) {
+	m.record("RegisterDeviceForWSAWithContext", ctx, registerDeviceForWSAQueryParams)
+	if m.RegisterDeviceForWSAWithContextFunc == nil {
+		panic("dnacmock: DevicesAPI.RegisterDeviceForWSAWithContext called but RegisterDeviceForWSAWithContextFunc is not set")
+	}
+	return m.RegisterDeviceForWSAWithContextFunc(ctx, registerDeviceForWSAQueryParams)
+}
+
+// RetrievesAllNetworkDevices calls RetrievesAllNetworkDevicesFunc.
+func (m *DevicesAPI) RetrievesAllNetworkDevices(retrievesAllNetworkDevicesQueryParams *dnac.RetrievesAllNetworkDevicesQueryParams) (string, *resty.Response, error) {
+	m.record("RetrievesAllNetworkDevices", retrievesAllNetworkDevicesQueryParams)
+	if m.RetrievesAllNetworkDevicesFunc == nil {
+		panic("dnacmock: DevicesAPI.RetrievesAllNetworkDevices called but RetrievesAllNetworkDevicesFunc is not set")
+	}
+	return m.RetrievesAllNetworkDevicesFunc(retrievesAllNetworkDevicesQueryParams)
+}
+
+// RetrievesAllNetworkDevicesWithContext calls RetrievesAllNetworkDevicesWithContextFunc.
+func (m *DevicesAPI) RetrievesAllNetworkDevicesWithContext(ctx context.Context, retrievesAllNetworkDevicesQueryParams *dnac.RetrievesAllNetworkDevicesQueryParams) (string, *resty.Response, error) {
+	m.record("RetrievesAllNetworkDevicesWithContext", ctx, retrievesAllNetworkDevicesQueryParams)
+	if m.RetrievesAllNetworkDevicesWithContextFunc == nil {
+		panic("dnacmock: DevicesAPI.RetrievesAllNetworkDevicesWithContext called but RetrievesAllNetworkDevicesWithContextFunc is not set")
+	}
+	return m.RetrievesAllNetworkDevicesWithContextFunc(ctx, retrievesAllNetworkDevicesQueryParams)
+}
+
+// SyncDevices calls SyncDevicesFunc.
+func (m *DevicesAPI) SyncDevices(syncDevicesRequest *dnac.SyncDevicesRequest) (*dnac.SyncDevicesResponse, *resty.Response, error) {
+	m.record("SyncDevices", syncDevicesRequest)
+	if m.SyncDevicesFunc == nil {
+		panic("dnacmock: DevicesAPI.SyncDevices called but SyncDevicesFunc is not set")
+	}
+	return m.SyncDevicesFunc(syncDevicesRequest)
+}
+
+// SyncDevicesWithContext calls SyncDevicesWithContextFunc.
+func (m *DevicesAPI) SyncDevicesWithContext(ctx context.Context, syncDevicesRequest *dnac.SyncDevicesRequest) (*dnac.SyncDevicesResponse, *resty.Response, error) {
+	m.record("SyncDevicesWithContext", ctx, syncDevicesRequest)
+	if m.SyncDevicesWithContextFunc == nil {
+		panic("dnacmock: DevicesAPI.SyncDevicesWithContext called but SyncDevicesWithContextFunc is not set")
+	}
+	return m.SyncDevicesWithContextFunc(ctx, syncDevicesRequest)
+}
+
+// SyncNetworkDevices calls SyncNetworkDevicesFunc.
+func (m *DevicesAPI) SyncNetworkDevices(syncNetworkDevicesQueryParams *dnac.SyncNetworkDevicesQueryParams, syncNetworkDevicesRequest *[]dnac.SyncNetworkDevicesRequest) (*dnac.SyncNetworkDevicesResponse, *resty.Response, error) {
+	m.record("SyncNetworkDevices", syncNetworkDevicesQueryParams, syncNetworkDevicesRequest)
+	if m.SyncNetworkDevicesFunc == nil {
+		panic("dnacmock: DevicesAPI.SyncNetworkDevices called but SyncNetworkDevicesFunc is not set")
+	}
+	return m.SyncNetworkDevicesFunc(syncNetworkDevicesQueryParams, syncNetworkDevicesRequest)
+}
+
+// SyncNetworkDevicesWithContext calls SyncNetworkDevicesWithContextFunc.
+func (m *DevicesAPI) SyncNetworkDevicesWithContext(ctx context.Context, syncNetworkDevicesQueryParams *dnac.SyncNetworkDevicesQueryParams, syncNetworkDevicesRequest *[]dnac.SyncNetworkDevicesRequest) (*dnac.SyncNetworkDevicesResponse, *resty.Response, error) {
+	m.record("SyncNetworkDevicesWithContext", ctx, syncNetworkDevicesQueryParams, syncNetworkDevicesRequest)
+	if m.SyncNetworkDevicesWithContextFunc == nil {
+		panic("dnacmock: DevicesAPI.SyncNetworkDevicesWithContext called but SyncNetworkDevicesWithContextFunc is not set")
+	}
+	return m.SyncNetworkDevicesWithContextFunc(ctx, syncNetworkDevicesQueryParams, syncNetworkDevicesRequest)
+}
+
+// UpdateDeviceRole calls UpdateDeviceRoleFunc.
+func (m *DevicesAPI) UpdateDeviceRole(updateDeviceRoleRequest *dnac.UpdateDeviceRoleRequest) (*dnac.UpdateDeviceRoleResponse, *resty.Response, error) {
+	m.record("UpdateDeviceRole", updateDeviceRoleRequest)
+	if m.UpdateDeviceRoleFunc == nil {
+		panic("dnacmock: DevicesAPI.UpdateDeviceRole called but UpdateDeviceRoleFunc is not set")
+	}
+	return m.UpdateDeviceRoleFunc(updateDeviceRoleRequest)
+}
+
+// UpdateDeviceRoleWithContext calls UpdateDeviceRoleWithContextFunc.
+func (m *DevicesAPI) UpdateDeviceRoleWithContext(ctx context.Context, updateDeviceRoleRequest *dnac.UpdateDeviceRoleRequest) (*dnac.UpdateDeviceRoleResponse, *resty.Response, error) {
+	m.record("UpdateDeviceRoleWithContext", ctx, updateDeviceRoleRequest)
+	if m.UpdateDeviceRoleWithContextFunc == nil {
+		panic("dnacmock: DevicesAPI.UpdateDeviceRoleWithContext called but UpdateDeviceRoleWithContextFunc is not set")
+	}
+	return m.UpdateDeviceRoleWithContextFunc(ctx, updateDeviceRoleRequest)
+}
+
+// ClientsAPI is a mock of dnac.ClientsAPI. Each method calls the function of the
+// same name suffixed with Func, which must be set if the method is called.
+type ClientsAPI struct {
+	Recorder
+
+	GetClientDetailFunc                       func(getClientDetailQueryParams *dnac.GetClientDetailQueryParams) (*dnac.GetClientDetailResponse, *resty.Response, error)
+	GetClientDetailWithContextFunc            func(ctx context.Context, getClientDetailQueryParams *dnac.GetClientDetailQueryParams) (*dnac.GetClientDetailResponse, *resty.Response, error)
+	GetClientEnrichmentDetailsFunc            func(getClientEnrichmentDetailsHeaderParams *dnac.GetClientEnrichmentDetailsHeaderParams) (*dnac.GetClientEnrichmentDetailsResponse, *resty.Response, error)
+	GetClientEnrichmentDetailsWithContextFunc func(ctx context.Context, getClientEnrichmentDetailsHeaderParams *dnac.GetClientEnrichmentDetailsHeaderParams) (*dnac.GetClientEnrichmentDetailsResponse, *resty.Response, error)
+	GetOverallClientHealthFunc                func(getOverallClientHealthQueryParams *dnac.GetOverallClientHealthQueryParams) (*dnac.GetOverallClientHealthResponse, *resty.Response, error)
+	GetOverallClientHealthWithContextFunc     func(ctx context.Context, getOverallClientHealthQueryParams *dnac.GetOverallClientHealthQueryParams) (*dnac.GetOverallClientHealthResponse, *resty.Response, error)
+}
+
+// GetClientDetail calls GetClientDetailFunc.
+func (m *ClientsAPI) GetClientDetail(getClientDetailQueryParams *dnac.GetClientDetailQueryParams) (*dnac.GetClientDetailResponse, *resty.Response, error) {
+	m.record("GetClientDetail", getClientDetailQueryParams)
+	if m.GetClientDetailFunc == nil {
+		panic("dnacmock: ClientsAPI.GetClientDetail called but GetClientDetailFunc is not set")
+	}
+	return m.GetClientDetailFunc(getClientDetailQueryParams)
+}
+
+// GetClientDetailWithContext calls GetClientDetailWithContextFunc.
+func (m *ClientsAPI) GetClientDetailWithContext(ctx context.Context, getClientDetailQueryParams *dnac.GetClientDetailQueryParams) (*dnac.GetClientDetailResponse, *resty.Response, error) {
+	m.record("GetClientDetailWithContext", ctx, getClientDetailQueryParams)
+	if m.GetClientDetailWithContextFunc == nil {
+		panic("dnacmock: ClientsAPI.GetClientDetailWithContext called but GetClientDetailWithContextFunc is not set")
+	}
+	return m.GetClientDetailWithContextFunc(ctx, getClientDetailQueryParams)
+}
+
+// GetClientEnrichmentDetails calls GetClientEnrichmentDetailsFunc.
+func (m *ClientsAPI) GetClientEnrichmentDetails(getClientEnrichmentDetailsHeaderParams *dnac.GetClientEnrichmentDetailsHeaderParams) (*dnac.GetClientEnrichmentDetailsResponse, *resty.Response, error) {
+	m.record("GetClientEnrichmentDetails", getClientEnrichmentDetailsHeaderParams)
+	if m.GetClientEnrichmentDetailsFunc == nil {
+		panic("dnacmock: ClientsAPI.GetClientEnrichmentDetails called but GetClientEnrichmentDetailsFunc is not set")
+	}
+	return m.GetClientEnrichmentDetailsFunc(getClientEnrichmentDetailsHeaderParams)
+}
+
+// GetClientEnrichmentDetailsWithContext calls GetClientEnrichmentDetailsWithContextFunc.
+func (m *ClientsAPI) GetClientEnrichmentDetailsWithContext(ctx context.Context, getClientEnrichmentDetailsHeaderParams *dnac.GetClientEnrichmentDetailsHeaderParams) (*dnac.GetClientEnrichmentDetailsResponse, *resty.Response, error) {
+	m.record("GetClientEnrichmentDetailsWithContext", ctx, getClientEnrichmentDetailsHeaderParams)
+	if m.GetClientEnrichmentDetailsWithContextFunc == nil {
+		panic("dnacmock: ClientsAPI.GetClientEnrichmentDetailsWithContext called but GetClientEnrichmentDetailsWithContextFunc is not set")
+	}
+	return m.GetClientEnrichmentDetailsWithContextFunc(ctx, getClientEnrichmentDetailsHeaderParams)
+}
+
+// GetOverallClientHealth calls GetOverallClientHealthFunc.
+func (m *ClientsAPI) GetOverallClientHealth(getOverallClientHealthQueryParams *dnac.GetOverallClientHealthQueryParams) (*dnac.GetOverallClientHealthResponse, *resty.Response, error) {
+	m.record("GetOverallClientHealth", getOverallClientHealthQueryParams)
+	if m.GetOverallClientHealthFunc == nil {
+		panic("dnacmock: ClientsAPI.GetOverallClientHealth called but GetOverallClientHealthFunc is not set")
+	}
+	return m.GetOverallClientHealthFunc(getOverallClientHealthQueryParams)
+}
+
+// GetOverallClientHealthWithContext calls GetOverallClientHealthWithContextFunc.
+func (m *ClientsAPI) GetOverallClientHealthWithContext(ctx context.Context, getOverallClientHealthQueryParams *dnac.GetOverallClientHealthQueryParams) (*dnac.GetOverallClientHealthResponse, *resty.Response, error) {
+	m.record("GetOverallClientHealthWithContext", ctx, getOverallClientHealthQueryParams)
+	if m.GetOverallClientHealthWithContextFunc == nil {
+		panic("dnacmock: ClientsAPI.GetOverallClientHealthWithContext called but GetOverallClientHealthWithContextFunc is not set")
+	}
+	return m.GetOverallClientHealthWithContextFunc(ctx, getOverallClientHealthQueryParams)
+}
+
+// UsersAPI is a mock of dnac.UsersAPI. Each method calls the function of the
+// same name suffixed with Func, which must be set if the method is called.
+type UsersAPI struct {
+	Recorder
+
+	GetUserEnrichmentDetailsFunc            func() (*dnac.GetUserEnrichmentDetailsResponse, *resty.Response, error)
+	GetUserEnrichmentDetailsWithContextFunc func(ctx context.Context) (*dnac.GetUserEnrichmentDetailsResponse, *resty.Response, error)
+}
+
+// GetUserEnrichmentDetails calls GetUserEnrichmentDetailsFunc.
+func (m *UsersAPI) GetUserEnrichmentDetails() (*dnac.GetUserEnrichmentDetailsResponse, *resty.Response, error) {
+	m.record("GetUserEnrichmentDetails")
+	if m.GetUserEnrichmentDetailsFunc == nil {
+		panic("dnacmock: UsersAPI.GetUserEnrichmentDetails called but GetUserEnrichmentDetailsFunc is not set")
+	}
+	return m.GetUserEnrichmentDetailsFunc()
+}
+
+// GetUserEnrichmentDetailsWithContext calls GetUserEnrichmentDetailsWithContextFunc.
+func (m *UsersAPI) GetUserEnrichmentDetailsWithContext(ctx context.Context) (*dnac.GetUserEnrichmentDetailsResponse, *resty.Response, error) {
+	m.record("GetUserEnrichmentDetailsWithContext", ctx)
+	if m.GetUserEnrichmentDetailsWithContextFunc == nil {
+		panic("dnacmock: UsersAPI.GetUserEnrichmentDetailsWithContext called but GetUserEnrichmentDetailsWithContextFunc is not set")
+	}
+	return m.GetUserEnrichmentDetailsWithContextFunc(ctx)
+}
+
+// IssuesAPI is a mock of dnac.IssuesAPI. Each method calls the function of the
+// same name suffixed with Func, which must be set if the method is called.
+type IssuesAPI struct {
+	Recorder
+
+	GetIssueEnrichmentDetailsFunc            func() (*dnac.GetIssueEnrichmentDetailsResponse, *resty.Response, error)
+	GetIssueEnrichmentDetailsWithContextFunc func(ctx context.Context) (*dnac.GetIssueEnrichmentDetailsResponse, *resty.Response, error)
+	IssuesFunc                               func(issuesQueryParams *dnac.IssuesQueryParams) (*dnac.IssuesResponse, *resty.Response, error)
+	IssuesWithContextFunc                    func(ctx context.Context, issuesQueryParams *dnac.IssuesQueryParams) (*dnac.IssuesResponse, *resty.Response, error)
+}
+
+// GetIssueEnrichmentDetails calls GetIssueEnrichmentDetailsFunc.
+func (m *IssuesAPI) GetIssueEnrichmentDetails() (*dnac.GetIssueEnrichmentDetailsResponse, *resty.Response, error) {
+	m.record("GetIssueEnrichmentDetails")
+	if m.GetIssueEnrichmentDetailsFunc == nil {
+		panic("dnacmock: IssuesAPI.GetIssueEnrichmentDetails called but GetIssueEnrichmentDetailsFunc is not set")
+	}
+	return m.GetIssueEnrichmentDetailsFunc()
+}
+
+// GetIssueEnrichmentDetailsWithContext calls GetIssueEnrichmentDetailsWithContextFunc.
+func (m *IssuesAPI) GetIssueEnrichmentDetailsWithContext(ctx context.Context) (*dnac.GetIssueEnrichmentDetailsResponse, *resty.Response, error) {
+	m.record("GetIssueEnrichmentDetailsWithContext", ctx)
+	if m.GetIssueEnrichmentDetailsWithContextFunc == nil {
+		panic("dnacmock: IssuesAPI.GetIssueEnrichmentDetailsWithContext called but GetIssueEnrichmentDetailsWithContextFunc is not set")
+	}
+	return m.GetIssueEnrichmentDetailsWithContextFunc(ctx)
+}
+
+// Issues calls IssuesFunc.
+func (m *IssuesAPI) Issues(issuesQueryParams *dnac.IssuesQueryParams) (*dnac.IssuesResponse, *resty.Response, error) {
+	m.record("Issues", issuesQueryParams)
+	if m.IssuesFunc == nil {
+		panic("dnacmock: IssuesAPI.Issues called but IssuesFunc is not set")
+	}
+	return m.IssuesFunc(issuesQueryParams)
+}
+
+// IssuesWithContext calls IssuesWithContextFunc.
+func (m *IssuesAPI) IssuesWithContext(ctx context.Context, issuesQueryParams *dnac.IssuesQueryParams) (*dnac.IssuesResponse, *resty.Response, error) {
+	m.record("IssuesWithContext", ctx, issuesQueryParams)
+	if m.IssuesWithContextFunc == nil {
+		panic("dnacmock: IssuesAPI.IssuesWithContext called but IssuesWithContextFunc is not set")
+	}
+	return m.IssuesWithContextFunc(ctx, issuesQueryParams)
+}
+
+// SiteDesignAPI is a mock of dnac.SiteDesignAPI. Each method calls the function of the
+// same name suffixed with Func, which must be set if the method is called.
+type SiteDesignAPI struct {
+	Recorder
+
+	CreateNFVProfileFunc                 func(createNFVProfileRequest *dnac.CreateNFVProfileRequest) (*dnac.CreateNFVProfileResponse, *resty.Response, error)
+	CreateNFVProfileWithContextFunc      func(ctx context.Context, createNFVProfileRequest *dnac.CreateNFVProfileRequest) (*dnac.CreateNFVProfileResponse, *resty.Response, error)
+	GetDeviceDetailsByIPFunc             func(getDeviceDetailsByIPQueryParams *dnac.GetDeviceDetailsByIPQueryParams) (*dnac.GetDeviceDetailsByIPResponse, *resty.Response, error)
+	GetDeviceDetailsByIPWithContextFunc  func(ctx context.Context, getDeviceDetailsByIPQueryParams *dnac.GetDeviceDetailsByIPQueryParams) (*dnac.GetDeviceDetailsByIPResponse, *resty.Response, error)
+	GetNFVProfileFunc                    func(id string, getNFVProfileQueryParams *dnac.GetNFVProfileQueryParams) (*dnac.GetNFVProfileResponse, *resty.Response, error)
+	GetNFVProfileWithContextFunc         func(ctx context.Context, id string, getNFVProfileQueryParams *dnac.GetNFVProfileQueryParams) (*dnac.GetNFVProfileResponse, *resty.Response, error)
+	NFVProvisioningDetailFunc            func(nFVProvisioningDetailRequest *dnac.NFVProvisioningDetailRequest) (*dnac.NFVProvisioningDetailResponse, *resty.Response, error)
+	NFVProvisioningDetailWithContextFunc func(ctx context.Context, nFVProvisioningDetailRequest *dnac.NFVProvisioningDetailRequest) (*dnac.NFVProvisioningDetailResponse, *resty.Response, error)
+	ProvisionNFVFunc                     func(provisionNFVRequest *dnac.ProvisionNFVRequest) (*dnac.ProvisionNFVResponse, *resty.Response, error)
+	ProvisionNFVWithContextFunc          func(ctx context.Context, provisionNFVRequest *dnac.ProvisionNFVRequest) (*dnac.ProvisionNFVResponse, *resty.Response, error)
+	UpdateNFVProfileFunc                 func(id string, updateNFVProfileQueryParams *dnac.UpdateNFVProfileQueryParams, updateNFVProfileRequest *dnac.UpdateNFVProfileRequest) (*dnac.UpdateNFVProfileResponse, *resty.Response, error)
+	UpdateNFVProfileWithContextFunc      func(ctx context.Context, id string, updateNFVProfileQueryParams *dnac.UpdateNFVProfileQueryParams, updateNFVProfileRequest *dnac.UpdateNFVProfileRequest) (*dnac.UpdateNFVProfileResponse, *resty.Response, error)
+}
+
+// CreateNFVProfile calls CreateNFVProfileFunc.
+func (m *SiteDesignAPI) CreateNFVProfile(createNFVProfileRequest *dnac.CreateNFVProfileRequest) (*dnac.CreateNFVProfileResponse, *resty.Response, error) {
+	m.record("CreateNFVProfile", createNFVProfileRequest)
+	if m.CreateNFVProfileFunc == nil {
+		panic("dnacmock: SiteDesignAPI.CreateNFVProfile called but CreateNFVProfileFunc is not set")
+	}
+	return m.CreateNFVProfileFunc(createNFVProfileRequest)
+}
+
+// CreateNFVProfileWithContext calls CreateNFVProfileWithContextFunc.
+func (m *SiteDesignAPI) CreateNFVProfileWithContext(ctx context.Context, createNFVProfileRequest *dnac.CreateNFVProfileRequest) (*dnac.CreateNFVProfileResponse, *resty.Response, error) {
+	m.record("CreateNFVProfileWithContext", ctx, createNFVProfileRequest)
+	if m.CreateNFVProfileWithContextFunc == nil {
+		panic("dnacmock: SiteDesignAPI.CreateNFVProfileWithContext called but CreateNFVProfileWithContextFunc is not set")
+	}
+	return m.CreateNFVProfileWithContextFunc(ctx, createNFVProfileRequest)
+}
+
+// GetDeviceDetailsByIP calls GetDeviceDetailsByIPFunc.
+func (m *SiteDesignAPI) GetDeviceDetailsByIP(getDeviceDetailsByIPQueryParams *dnac.GetDeviceDetailsByIPQueryParams) (*dnac.GetDeviceDetailsByIPResponse, *resty.Response, error) {
+	m.record("GetDeviceDetailsByIP", getDeviceDetailsByIPQueryParams)
+	if m.GetDeviceDetailsByIPFunc == nil {
+		panic("dnacmock: SiteDesignAPI.GetDeviceDetailsByIP called but GetDeviceDetailsByIPFunc is not set")
+	}
+	return m.GetDeviceDetailsByIPFunc(getDeviceDetailsByIPQueryParams)
+}
+
+// GetDeviceDetailsByIPWithContext calls GetDeviceDetailsByIPWithContextFunc.
+func (m *SiteDesignAPI) GetDeviceDetailsByIPWithContext(ctx context.Context, getDeviceDetailsByIPQueryParams *dnac.GetDeviceDetailsByIPQueryParams) (*dnac.GetDeviceDetailsByIPResponse, *resty.Response, error) {
+	m.record("GetDeviceDetailsByIPWithContext", ctx, getDeviceDetailsByIPQueryParams)
+	if m.GetDeviceDetailsByIPWithContextFunc == nil {
+		panic("dnacmock: SiteDesignAPI.GetDeviceDetailsByIPWithContext called but GetDeviceDetailsByIPWithContextFunc is not set")
+	}
+	return m.GetDeviceDetailsByIPWithContextFunc(ctx, getDeviceDetailsByIPQueryParams)
+}
+
+// GetNFVProfile calls GetNFVProfileFunc.
+func (m *SiteDesignAPI) GetNFVProfile(id string, getNFVProfileQueryParams *dnac.GetNFVProfileQueryParams) (*dnac.GetNFVProfileResponse, *resty.Response, error) {
+	m.record("GetNFVProfile", id, getNFVProfileQueryParams)
+	if m.GetNFVProfileFunc == nil {
+		panic("dnacmock: SiteDesignAPI.GetNFVProfile called but GetNFVProfileFunc is not set")
+	}
+	return m.GetNFVProfileFunc(id, getNFVProfileQueryParams)
+}
+
+// GetNFVProfileWithContext calls GetNFVProfileWithContextFunc.
+func (m *SiteDesignAPI) GetNFVProfileWithContext(ctx context.Context, id string, getNFVProfileQueryParams *dnac.GetNFVProfileQueryParams) (*dnac.GetNFVProfileResponse, *resty.Response, error) {
+	m.record("GetNFVProfileWithContext", ctx, id, getNFVProfileQueryParams)
+	if m.GetNFVProfileWithContextFunc == nil {
+		panic("dnacmock: SiteDesignAPI.GetNFVProfileWithContext called but GetNFVProfileWithContextFunc is not set")
+	}
+	return m.GetNFVProfileWithContextFunc(ctx, id, getNFVProfileQueryParams)
+}
+
+// NFVProvisioningDetail calls NFVProvisioningDetailFunc.
+func (m *SiteDesignAPI) NFVProvisioningDetail(nFVProvisioningDetailRequest *dnac.NFVProvisioningDetailRequest) (*dnac.NFVProvisioningDetailResponse, *resty.Response, error) {
+	m.record("NFVProvisioningDetail", nFVProvisioningDetailRequest)
+	if m.NFVProvisioningDetailFunc == nil {
+		panic("dnacmock: SiteDesignAPI.NFVProvisioningDetail called but NFVProvisioningDetailFunc is not set")
+	}
+	return m.NFVProvisioningDetailFunc(nFVProvisioningDetailRequest)
+}
+
+// NFVProvisioningDetailWithContext calls NFVProvisioningDetailWithContextFunc.
+func (m *SiteDesignAPI) NFVProvisioningDetailWithContext(ctx context.Context, nFVProvisioningDetailRequest *dnac.NFVProvisioningDetailRequest) (*dnac.NFVProvisioningDetailResponse, *resty.Response, error) {
+	m.record("NFVProvisioningDetailWithContext", ctx, nFVProvisioningDetailRequest)
+	if m.NFVProvisioningDetailWithContextFunc == nil {
+		panic("dnacmock: SiteDesignAPI.NFVProvisioningDetailWithContext called but NFVProvisioningDetailWithContextFunc is not set")
+	}
+	return m.NFVProvisioningDetailWithContextFunc(ctx, nFVProvisioningDetailRequest)
+}
+
+// ProvisionNFV calls ProvisionNFVFunc.
+func (m *SiteDesignAPI) ProvisionNFV(provisionNFVRequest *dnac.ProvisionNFVRequest) (*dnac.ProvisionNFVResponse, *resty.Response, error) {
+	m.record("ProvisionNFV", provisionNFVRequest)
+	if m.ProvisionNFVFunc == nil {
+		panic("dnacmock: SiteDesignAPI.ProvisionNFV called but ProvisionNFVFunc is not set")
+	}
+	return m.ProvisionNFVFunc(provisionNFVRequest)
+}
+
+// ProvisionNFVWithContext calls ProvisionNFVWithContextFunc.
+func (m *SiteDesignAPI) ProvisionNFVWithContext(ctx context.Context, provisionNFVRequest *dnac.ProvisionNFVRequest) (*dnac.ProvisionNFVResponse, *resty.Response, error) {
+	m.record("ProvisionNFVWithContext", ctx, provisionNFVRequest)
+	if m.ProvisionNFVWithContextFunc == nil {
+		panic("dnacmock: SiteDesignAPI.ProvisionNFVWithContext called but ProvisionNFVWithContextFunc is not set")
+	}
+	return m.ProvisionNFVWithContextFunc(ctx, provisionNFVRequest)
+}
+
+// UpdateNFVProfile calls UpdateNFVProfileFunc.
+func (m *SiteDesignAPI) UpdateNFVProfile(id string, updateNFVProfileQueryParams *dnac.UpdateNFVProfileQueryParams, updateNFVProfileRequest *dnac.UpdateNFVProfileRequest) (*dnac.UpdateNFVProfileResponse, *resty.Response, error) {
+	m.record("UpdateNFVProfile", id, updateNFVProfileQueryParams, updateNFVProfileRequest)
+	if m.UpdateNFVProfileFunc == nil {
+		panic("dnacmock: SiteDesignAPI.UpdateNFVProfile called but UpdateNFVProfileFunc is not set")
+	}
+	return m.UpdateNFVProfileFunc(id, updateNFVProfileQueryParams, updateNFVProfileRequest)
+}
+
+// UpdateNFVProfileWithContext calls UpdateNFVProfileWithContextFunc.
+func (m *SiteDesignAPI) UpdateNFVProfileWithContext(ctx context.Context, id string, updateNFVProfileQueryParams *dnac.UpdateNFVProfileQueryParams, updateNFVProfileRequest *dnac.UpdateNFVProfileRequest) (*dnac.UpdateNFVProfileResponse, *resty.Response, error) {
+	m.record("UpdateNFVProfileWithContext", ctx, id, updateNFVProfileQueryParams, updateNFVProfileRequest)
+	if m.UpdateNFVProfileWithContextFunc == nil {
+		panic("dnacmock: SiteDesignAPI.UpdateNFVProfileWithContext called but UpdateNFVProfileWithContextFunc is not set")
+	}
+	return m.UpdateNFVProfileWithContextFunc(ctx, id, updateNFVProfileQueryParams, updateNFVProfileRequest)
+}
+
+// NetworkSettingsAPI is a mock of dnac.NetworkSettingsAPI. Each method calls the function of the
+// same name suffixed with Func, which must be set if the method is called.
+type NetworkSettingsAPI struct {
+	Recorder
+
+	AssignCredentialToSiteFunc                func(siteID string, assignCredentialToSiteRequest *dnac.AssignCredentialToSiteRequest) (*dnac.AssignCredentialToSiteResponse, *resty.Response, error)
+	AssignCredentialToSiteWithContextFunc     func(ctx context.Context, siteID string, assignCredentialToSiteRequest *dnac.AssignCredentialToSiteRequest) (*dnac.AssignCredentialToSiteResponse, *resty.Response, error)
+	CreateDeviceCredentialsFunc               func(createDeviceCredentialsRequest *dnac.CreateDeviceCredentialsRequest) (*dnac.CreateDeviceCredentialsResponse, *resty.Response, error)
+	CreateDeviceCredentialsWithContextFunc    func(ctx context.Context, createDeviceCredentialsRequest *dnac.CreateDeviceCredentialsRequest) (*dnac.CreateDeviceCredentialsResponse, *resty.Response, error)
+	CreateGlobalPoolFunc                      func(createGlobalPoolRequest *dnac.CreateGlobalPoolRequest) (*dnac.CreateGlobalPoolResponse, *resty.Response, error)
+	CreateGlobalPoolWithContextFunc           func(ctx context.Context, createGlobalPoolRequest *dnac.CreateGlobalPoolRequest) (*dnac.CreateGlobalPoolResponse, *resty.Response, error)
+	CreateNetworkFunc                         func(siteID string, createNetworkRequest *dnac.CreateNetworkRequest) (*dnac.CreateNetworkResponse, *resty.Response, error)
+	CreateNetworkWithContextFunc              func(ctx context.Context, siteID string, createNetworkRequest *dnac.CreateNetworkRequest) (*dnac.CreateNetworkResponse, *resty.Response, error)
+	CreateSPProfileFunc                       func(createSPProfileRequest *dnac.CreateSPProfileRequest) (*dnac.CreateSPProfileResponse, *resty.Response, error)
+	CreateSPProfileWithContextFunc            func(ctx context.Context, createSPProfileRequest *dnac.CreateSPProfileRequest) (*dnac.CreateSPProfileResponse, *resty.Response, error)
+	DeleteDeviceCredentialFunc                func(id string) (*dnac.DeleteDeviceCredentialResponse, *resty.Response, error)
+	DeleteDeviceCredentialWithContextFunc     func(ctx context.Context, id string) (*dnac.DeleteDeviceCredentialResponse, *resty.Response, error)
+	DeleteGlobalIPPoolFunc                    func(id string) (*dnac.DeleteGlobalIPPoolResponse, *resty.Response, error)
+	DeleteGlobalIPPoolWithContextFunc         func(ctx context.Context, id string) (*dnac.DeleteGlobalIPPoolResponse, *resty.Response, error)
+	DeleteSPProfileFunc                       func(spProfileName string) (*dnac.DeleteSPProfileResponse, *resty.Response, error)
+	DeleteSPProfileWithContextFunc            func(ctx context.Context, spProfileName string) (*dnac.DeleteSPProfileResponse, *resty.Response, error)
+	GetDeviceCredentialDetailsFunc            func(getDeviceCredentialDetailsQueryParams *dnac.GetDeviceCredentialDetailsQueryParams) (*dnac.GetDeviceCredentialDetailsResponse, *resty.Response, error)
+	GetDeviceCredentialDetailsWithContextFunc func(ctx context.Context, getDeviceCredentialDetailsQueryParams *dnac.GetDeviceCredentialDetailsQueryParams) (*dnac.GetDeviceCredentialDetailsResponse, *resty.Response, error)
+	GetGlobalPoolFunc                         func(getGlobalPoolQueryParams *dnac.GetGlobalPoolQueryParams) (*dnac.GetGlobalPoolResponse, *resty.Response, error)
+	GetGlobalPoolWithContextFunc              func(ctx context.Context, getGlobalPoolQueryParams *dnac.GetGlobalPoolQueryParams) (*dnac.GetGlobalPoolResponse, *resty.Response, error)
+	GetNetworkFunc                            func(getNetworkQueryParams *dnac.GetNetworkQueryParams) (*dnac.GetNetworkResponse, *resty.Response, error)
+	GetNetworkWithContextFunc                 func(ctx context.Context, getNetworkQueryParams *dnac.GetNetworkQueryParams) (*dnac.GetNetworkResponse, *resty.Response, error)
+	GetServiceProviderDetailsFunc             func() (*dnac.GetServiceProviderDetailsResponse, *resty.Response, error)
+	GetServiceProviderDetailsWithContextFunc  func(ctx context.Context) (*dnac.GetServiceProviderDetailsResponse, *resty.Response, error)
+	GetSiteNetworkSettingsFunc                func(ctx context.Context, siteID string) (*dnac.SiteNetworkSettings, error)
+	ListAllGlobalPoolsFunc                    func(ctx context.Context, opts *dnac.PageOptions) *dnac.GlobalPoolIterator
+	LoadGlobalPoolsFunc                       func(ctx context.Context) (*dnac.GlobalPools, error)
+	SyncSiteNetworkSettingsFunc               func(ctx context.Context, siteID string, desired *dnac.SiteNetworkSettings, opts *dnac.WaitForTaskOptions) ([]dnac.SiteNetworkChange, error)
+	UpdateDeviceCredentialsFunc               func(updateDeviceCredentialsRequest *dnac.UpdateDeviceCredentialsRequest) (*dnac.UpdateDeviceCredentialsResponse, *resty.Response, error)
+	UpdateDeviceCredentialsWithContextFunc    func(ctx context.Context, updateDeviceCredentialsRequest *dnac.UpdateDeviceCredentialsRequest) (*dnac.UpdateDeviceCredentialsResponse, *resty.Response, error)
+	UpdateGlobalPoolFunc                      func(updateGlobalPoolRequest *dnac.UpdateGlobalPoolRequest) (*dnac.UpdateGlobalPoolResponse, *resty.Response, error)
+	UpdateGlobalPoolWithContextFunc           func(ctx context.Context, updateGlobalPoolRequest *dnac.UpdateGlobalPoolRequest) (*dnac.UpdateGlobalPoolResponse, *resty.Response, error)
+	UpdateNetworkFunc                         func(siteID string, updateNetworkRequest *dnac.UpdateNetworkRequest) (*dnac.UpdateNetworkResponse, *resty.Response, error)
+	UpdateNetworkWithContextFunc              func(ctx context.Context, siteID string, updateNetworkRequest *dnac.UpdateNetworkRequest) (*dnac.UpdateNetworkResponse, *resty.Response, error)
+	UpdateSPProfileFunc                       func(updateSPProfileRequest *dnac.UpdateSPProfileRequest) (*dnac.UpdateSPProfileResponse, *resty.Response, error)
+	UpdateSPProfileWithContextFunc            func(ctx context.Context, updateSPProfileRequest *dnac.UpdateSPProfileRequest) (*dnac.UpdateSPProfileResponse, *resty.Response, error)
+}
+
+// AssignCredentialToSite calls AssignCredentialToSiteFunc.
+func (m *NetworkSettingsAPI) AssignCredentialToSite(siteID string, assignCredentialToSiteRequest *dnac.AssignCredentialToSiteRequest) (*dnac.AssignCredentialToSiteResponse, *resty.Response, error) {
+	m.record("AssignCredentialToSite", siteID, assignCredentialToSiteRequest)
+	if m.AssignCredentialToSiteFunc == nil {
+		panic("dnacmock: NetworkSettingsAPI.AssignCredentialToSite called but AssignCredentialToSiteFunc is not set")
+	}
+	return m.AssignCredentialToSiteFunc(siteID, assignCredentialToSiteRequest)
+}
+
+// AssignCredentialToSiteWithContext calls AssignCredentialToSiteWithContextFunc.
+func (m *NetworkSettingsAPI) AssignCredentialToSiteWithContext(ctx context.Context, siteID string, assignCredentialToSiteRequest *dnac.AssignCredentialToSiteRequest) (*dnac.AssignCredentialToSiteResponse, *resty.Response, error) {
+	m.record("AssignCredentialToSiteWithContext", ctx, siteID, assignCredentialToSiteRequest)
+	if m.AssignCredentialToSiteWithContextFunc == nil {
+		panic("dnacmock: NetworkSettingsAPI.AssignCredentialToSiteWithContext called but AssignCredentialToSiteWithContextFunc is not set")
+	}
+	return m.AssignCredentialToSiteWithContextFunc(ctx, siteID, assignCredentialToSiteRequest)
+}
+
+// CreateDeviceCredentials calls CreateDeviceCredentialsFunc.
+func (m *NetworkSettingsAPI) CreateDeviceCredentials(createDeviceCredentialsRequest *dnac.CreateDeviceCredentialsRequest) (*dnac.CreateDeviceCredentialsResponse, *resty.Response, error) {
+	m.record("CreateDeviceCredentials", createDeviceCredentialsRequest)
+	if m.CreateDeviceCredentialsFunc == nil {
+		panic("dnacmock: NetworkSettingsAPI.CreateDeviceCredentials called but CreateDeviceCredentialsFunc is not set")
+	}
+	return m.CreateDeviceCredentialsFunc(createDeviceCredentialsRequest)
+}
+
+// CreateDeviceCredentialsWithContext calls CreateDeviceCredentialsWithContextFunc.
+func (m *NetworkSettingsAPI) CreateDeviceCredentialsWithContext(ctx context.Context, createDeviceCredentialsRequest *dnac.CreateDeviceCredentialsRequest) (*dnac.CreateDeviceCredentialsResponse, *resty.Response, error) {
+	m.record("CreateDeviceCredentialsWithContext", ctx, createDeviceCredentialsRequest)
+	if m.CreateDeviceCredentialsWithContextFunc == nil {
+		panic("dnacmock: NetworkSettingsAPI.CreateDeviceCredentialsWithContext called but CreateDeviceCredentialsWithContextFunc is not set")
+	}
+	return m.CreateDeviceCredentialsWithContextFunc(ctx, createDeviceCredentialsRequest)
+}
+
+// CreateGlobalPool calls CreateGlobalPoolFunc.
+func (m *NetworkSettingsAPI) CreateGlobalPool(createGlobalPoolRequest *dnac.CreateGlobalPoolRequest) (*dnac.CreateGlobalPoolResponse, *resty.Response, error) {
+	m.record("CreateGlobalPool", createGlobalPoolRequest)
+	if m.CreateGlobalPoolFunc == nil {
+		panic("dnacmock: NetworkSettingsAPI.CreateGlobalPool called but CreateGlobalPoolFunc is not set")
+	}
+	return m.CreateGlobalPoolFunc(createGlobalPoolRequest)
+}
+
+// CreateGlobalPoolWithContext calls CreateGlobalPoolWithContextFunc.
+func (m *NetworkSettingsAPI) CreateGlobalPoolWithContext(ctx context.Context, createGlobalPoolRequest *dnac.CreateGlobalPoolRequest) (*dnac.CreateGlobalPoolResponse, *resty.Response, error) {
+	m.record("CreateGlobalPoolWithContext", ctx, createGlobalPoolRequest)
+	if m.CreateGlobalPoolWithContextFunc == nil {
+		panic("dnacmock: NetworkSettingsAPI.CreateGlobalPoolWithContext called but CreateGlobalPoolWithContextFunc is not set")
+	}
+	return m.CreateGlobalPoolWithContextFunc(ctx, createGlobalPoolRequest)
+}
+
+// CreateNetwork calls CreateNetworkFunc.
+func (m *NetworkSettingsAPI) CreateNetwork(siteID string, createNetworkRequest *dnac.CreateNetworkRequest) (*dnac.CreateNetworkResponse, *resty.Response, error) {
+	m.record("CreateNetwork", siteID, createNetworkRequest)
+	if m.CreateNetworkFunc == nil {
+		panic("dnacmock: NetworkSettingsAPI.CreateNetwork called but CreateNetworkFunc is not set")
+	}
+	return m.CreateNetworkFunc(siteID, createNetworkRequest)
+}
+
+// CreateNetworkWithContext calls CreateNetworkWithContextFunc.
+func (m *NetworkSettingsAPI) CreateNetworkWithContext(ctx context.Context, siteID string, createNetworkRequest *dnac.CreateNetworkRequest) (*dnac.CreateNetworkResponse, *resty.Response, error) {
+	m.record("CreateNetworkWithContext", ctx, siteID, createNetworkRequest)
+	if m.CreateNetworkWithContextFunc == nil {
+		panic("dnacmock: NetworkSettingsAPI.CreateNetworkWithContext called but CreateNetworkWithContextFunc is not set")
+	}
+	return m.CreateNetworkWithContextFunc(ctx, siteID, createNetworkRequest)
+}
+
+// CreateSPProfile calls CreateSPProfileFunc.
+func (m *NetworkSettingsAPI) CreateSPProfile(createSPProfileRequest *dnac.CreateSPProfileRequest) (*dnac.CreateSPProfileResponse, *resty.Response, error) {
+	m.record("CreateSPProfile", createSPProfileRequest)
+	if m.CreateSPProfileFunc == nil {
+		panic("dnacmock: NetworkSettingsAPI.CreateSPProfile called but CreateSPProfileFunc is not set")
+	}
+	return m.CreateSPProfileFunc(createSPProfileRequest)
+}
+
+// CreateSPProfileWithContext calls CreateSPProfileWithContextFunc.
+func (m *NetworkSettingsAPI) CreateSPProfileWithContext(ctx context.Context, createSPProfileRequest *dnac.CreateSPProfileRequest) (*dnac.CreateSPProfileResponse, *resty.Response, error) {
+	m.record("CreateSPProfileWithContext", ctx, createSPProfileRequest)
+	if m.CreateSPProfileWithContextFunc == nil {
+		panic("dnacmock: NetworkSettingsAPI.CreateSPProfileWithContext called but CreateSPProfileWithContextFunc is not set")
+	}
+	return m.CreateSPProfileWithContextFunc(ctx, createSPProfileRequest)
+}
+
+// DeleteDeviceCredential calls DeleteDeviceCredentialFunc.
+func (m *NetworkSettingsAPI) DeleteDeviceCredential(id string) (*dnac.DeleteDeviceCredentialResponse, *resty.Response, error) {
+	m.record("DeleteDeviceCredential", id)
+	if m.DeleteDeviceCredentialFunc == nil {
+		panic("dnacmock: NetworkSettingsAPI.DeleteDeviceCredential called but DeleteDeviceCredentialFunc is not set")
+	}
+	return m.DeleteDeviceCredentialFunc(id)
+}
+
+// DeleteDeviceCredentialWithContext calls DeleteDeviceCredentialWithContextFunc.
+func (m *NetworkSettingsAPI) DeleteDeviceCredentialWithContext(ctx context.Context, id string) (*dnac.DeleteDeviceCredentialResponse, *resty.Response, error) {
+	m.record("DeleteDeviceCredentialWithContext", ctx, id)
+	if m.DeleteDeviceCredentialWithContextFunc == nil {
+		panic("dnacmock: NetworkSettingsAPI.DeleteDeviceCredentialWithContext called but DeleteDeviceCredentialWithContextFunc is not set")
+	}
+	return m.DeleteDeviceCredentialWithContextFunc(ctx, id)
+}
+
+// DeleteGlobalIPPool calls DeleteGlobalIPPoolFunc.
+func (m *NetworkSettingsAPI) DeleteGlobalIPPool(id string) (*dnac.DeleteGlobalIPPoolResponse, *resty.Response, error) {
+	m.record("DeleteGlobalIPPool", id)
+	if m.DeleteGlobalIPPoolFunc == nil {
+		panic("dnacmock: NetworkSettingsAPI.DeleteGlobalIPPool called but DeleteGlobalIPPoolFunc is not set")
+	}
+	return m.DeleteGlobalIPPoolFunc(id)
+}
+
+// DeleteGlobalIPPoolWithContext calls DeleteGlobalIPPoolWithContextFunc.
+func (m *NetworkSettingsAPI) DeleteGlobalIPPoolWithContext(ctx context.Context, id string) (*dnac.DeleteGlobalIPPoolResponse, *resty.Response, error) {
+	m.record("DeleteGlobalIPPoolWithContext", ctx, id)
+	if m.DeleteGlobalIPPoolWithContextFunc == nil {
+		panic("dnacmock: NetworkSettingsAPI.DeleteGlobalIPPoolWithContext called but DeleteGlobalIPPoolWithContextFunc is not set")
+	}
+	return m.DeleteGlobalIPPoolWithContextFunc(ctx, id)
+}
+
+// DeleteSPProfile calls DeleteSPProfileFunc.
+func (m *NetworkSettingsAPI) DeleteSPProfile(spProfileName string) (*dnac.DeleteSPProfileResponse, *resty.Response, error) {
+	m.record("DeleteSPProfile", spProfileName)
+	if m.DeleteSPProfileFunc == nil {
+		panic("dnacmock: NetworkSettingsAPI.DeleteSPProfile called but DeleteSPProfileFunc is not set")
+	}
+	return m.DeleteSPProfileFunc(spProfileName)
+}
+
+// DeleteSPProfileWithContext calls DeleteSPProfileWithContextFunc.
+func (m *NetworkSettingsAPI) DeleteSPProfileWithContext(ctx context.Context, spProfileName string) (*dnac.DeleteSPProfileResponse, *resty.Response, error) {
+	m.record("DeleteSPProfileWithContext", ctx, spProfileName)
+	if m.DeleteSPProfileWithContextFunc == nil {
+		panic("dnacmock: NetworkSettingsAPI.DeleteSPProfileWithContext called but DeleteSPProfileWithContextFunc is not set")
+	}
+	return m.DeleteSPProfileWithContextFunc(ctx, spProfileName)
+}
+
+// GetDeviceCredentialDetails calls GetDeviceCredentialDetailsFunc.
+func (m *NetworkSettingsAPI) GetDeviceCredentialDetails(getDeviceCredentialDetailsQueryParams *dnac.GetDeviceCredentialDetailsQueryParams) (*dnac.GetDeviceCredentialDetailsResponse, *resty.Response, error) {
+	m.record("GetDeviceCredentialDetails", getDeviceCredentialDetailsQueryParams)
+	if m.GetDeviceCredentialDetailsFunc == nil {
+		panic("dnacmock: NetworkSettingsAPI.GetDeviceCredentialDetails called but GetDeviceCredentialDetailsFunc is not set")
+	}
+	return m.GetDeviceCredentialDetailsFunc(getDeviceCredentialDetailsQueryParams)
+}
+
+// GetDeviceCredentialDetailsWithContext calls GetDeviceCredentialDetailsWithContextFunc.
+func (m *NetworkSettingsAPI) GetDeviceCredentialDetailsWithContext(ctx context.Context, getDeviceCredentialDetailsQueryParams *dnac.GetDeviceCredentialDetailsQueryParams) (*dnac.GetDeviceCredentialDetailsResponse, *resty.Response, error) {
+	m.record("GetDeviceCredentialDetailsWithContext", ctx, getDeviceCredentialDetailsQueryParams)
+	if m.GetDeviceCredentialDetailsWithContextFunc == nil {
+		panic("dnacmock: NetworkSettingsAPI.GetDeviceCredentialDetailsWithContext called but GetDeviceCredentialDetailsWithContextFunc is not set")
+	}
+	return m.GetDeviceCredentialDetailsWithContextFunc(ctx, getDeviceCredentialDetailsQueryParams)
+}
+
+// GetGlobalPool calls GetGlobalPoolFunc.
+func (m *NetworkSettingsAPI) GetGlobalPool(getGlobalPoolQueryParams *dnac.GetGlobalPoolQueryParams) (*dnac.GetGlobalPoolResponse, *resty.Response, error) {
+	m.record("GetGlobalPool", getGlobalPoolQueryParams)
+	if m.GetGlobalPoolFunc == nil {
+		panic("dnacmock: NetworkSettingsAPI.GetGlobalPool called but GetGlobalPoolFunc is not set")
+	}
+	return m.GetGlobalPoolFunc(getGlobalPoolQueryParams)
+}
+
+// GetGlobalPoolWithContext calls GetGlobalPoolWithContextFunc.
+func (m *NetworkSettingsAPI) GetGlobalPoolWithContext(ctx context.Context, getGlobalPoolQueryParams *dnac.GetGlobalPoolQueryParams) (*dnac.GetGlobalPoolResponse, *resty.Response, error) {
+	m.record("GetGlobalPoolWithContext", ctx, getGlobalPoolQueryParams)
+	if m.GetGlobalPoolWithContextFunc == nil {
+		panic("dnacmock: NetworkSettingsAPI.GetGlobalPoolWithContext called but GetGlobalPoolWithContextFunc is not set")
+	}
+	return m.GetGlobalPoolWithContextFunc(ctx, getGlobalPoolQueryParams)
+}
+
+// GetNetwork calls GetNetworkFunc.
+func (m *NetworkSettingsAPI) GetNetwork(getNetworkQueryParams *dnac.GetNetworkQueryParams) (*dnac.GetNetworkResponse, *resty.Response, error) {
+	m.record("GetNetwork", getNetworkQueryParams)
+	if m.GetNetworkFunc == nil {
+		panic("dnacmock: NetworkSettingsAPI.GetNetwork called but GetNetworkFunc is not set")
+	}
+	return m.GetNetworkFunc(getNetworkQueryParams)
+}
+
+// GetNetworkWithContext calls GetNetworkWithContextFunc.
+func (m *NetworkSettingsAPI) GetNetworkWithContext(ctx context.Context, getNetworkQueryParams *dnac.GetNetworkQueryParams) (*dnac.GetNetworkResponse, *resty.Response, error) {
+	m.record("GetNetworkWithContext", ctx, getNetworkQueryParams)
+	if m.GetNetworkWithContextFunc == nil {
+		panic("dnacmock: NetworkSettingsAPI.GetNetworkWithContext called but GetNetworkWithContextFunc is not set")
+	}
+	return m.GetNetworkWithContextFunc(ctx, getNetworkQueryParams)
+}
+
+// GetServiceProviderDetails calls GetServiceProviderDetailsFunc.
+func (m *NetworkSettingsAPI) GetServiceProviderDetails() (*dnac.GetServiceProviderDetailsResponse, *resty.Response, error) {
+	m.record("GetServiceProviderDetails")
+	if m.GetServiceProviderDetailsFunc == nil {
+		panic("dnacmock: NetworkSettingsAPI.GetServiceProviderDetails called but GetServiceProviderDetailsFunc is not set")
+	}
+	return m.GetServiceProviderDetailsFunc()
+}
+
+// GetServiceProviderDetailsWithContext calls GetServiceProviderDetailsWithContextFunc.
+func (m *NetworkSettingsAPI) GetServiceProviderDetailsWithContext(ctx context.Context) (*dnac.GetServiceProviderDetailsResponse, *resty.Response, error) {
+	m.record("GetServiceProviderDetailsWithContext", ctx)
+	if m.GetServiceProviderDetailsWithContextFunc == nil {
+		panic("dnacmock: NetworkSettingsAPI.GetServiceProviderDetailsWithContext called but GetServiceProviderDetailsWithContextFunc is not set")
+	}
+	return m.GetServiceProviderDetailsWithContextFunc(ctx)
+}
+
+// GetSiteNetworkSettings calls GetSiteNetworkSettingsFunc.
+func (m *NetworkSettingsAPI) GetSiteNetworkSettings(ctx context.Context, siteID string) (*dnac.SiteNetworkSettings, error) {
+	m.record("GetSiteNetworkSettings", ctx, siteID)
+	if m.GetSiteNetworkSettingsFunc == nil {
+		panic("dnacmock: NetworkSettingsAPI.GetSiteNetworkSettings called but GetSiteNetworkSettingsFunc is not set")
+	}
+	return m.GetSiteNetworkSettingsFunc(ctx, siteID)
+}
+
+// ListAllGlobalPools calls ListAllGlobalPoolsFunc.
+func (m *NetworkSettingsAPI) ListAllGlobalPools(ctx context.Context, opts *dnac.PageOptions) *dnac.GlobalPoolIterator {
+	m.record("ListAllGlobalPools", ctx, opts)
+	if m.ListAllGlobalPoolsFunc == nil {
+		panic("dnacmock: NetworkSettingsAPI.ListAllGlobalPools called but ListAllGlobalPoolsFunc is not set")
+	}
+	return m.ListAllGlobalPoolsFunc(ctx, opts)
+}
+
+// LoadGlobalPools calls LoadGlobalPoolsFunc.
+func (m *NetworkSettingsAPI) LoadGlobalPools(ctx context.Context) (*dnac.GlobalPools, error) {
+	m.record("LoadGlobalPools", ctx)
+	if m.LoadGlobalPoolsFunc == nil {
+		panic("dnacmock: NetworkSettingsAPI.LoadGlobalPools called but LoadGlobalPoolsFunc is not set")
+	}
+	return m.LoadGlobalPoolsFunc(ctx)
+}
+
+// SyncSiteNetworkSettings calls SyncSiteNetworkSettingsFunc.
+func (m *NetworkSettingsAPI) SyncSiteNetworkSettings(ctx context.Context, siteID string, desired *dnac.SiteNetworkSettings, opts *dnac.WaitForTaskOptions) ([]dnac.SiteNetworkChange, error) {
+	m.record("SyncSiteNetworkSettings", ctx, siteID, desired, opts)
+	if m.SyncSiteNetworkSettingsFunc == nil {
+		panic("dnacmock: NetworkSettingsAPI.SyncSiteNetworkSettings called but SyncSiteNetworkSettingsFunc is not set")
+	}
+	return m.SyncSiteNetworkSettingsFunc(ctx, siteID, desired, opts)
+}
+
+// UpdateDeviceCredentials calls UpdateDeviceCredentialsFunc.
+func (m *NetworkSettingsAPI) UpdateDeviceCredentials(updateDeviceCredentialsRequest *dnac.UpdateDeviceCredentialsRequest) (*dnac.UpdateDeviceCredentialsResponse, *resty.Response, error) {
+	m.record("UpdateDeviceCredentials", updateDeviceCredentialsRequest)
+	if m.UpdateDeviceCredentialsFunc == nil {
+		panic("dnacmock: NetworkSettingsAPI.UpdateDeviceCredentials called but UpdateDeviceCredentialsFunc is not set")
+	}
+	return m.UpdateDeviceCredentialsFunc(updateDeviceCredentialsRequest)
+}
+
+// UpdateDeviceCredentialsWithContext calls UpdateDeviceCredentialsWithContextFunc.
+func (m *NetworkSettingsAPI) UpdateDeviceCredentialsWithContext(ctx context.Context, updateDeviceCredentialsRequest *dnac.UpdateDeviceCredentialsRequest) (*dnac.UpdateDeviceCredentialsResponse, *resty.Response, error) {
+	m.record("UpdateDeviceCredentialsWithContext", ctx, updateDeviceCredentialsRequest)
+	if m.UpdateDeviceCredentialsWithContextFunc == nil {
+		panic("dnacmock: NetworkSettingsAPI.UpdateDeviceCredentialsWithContext called but UpdateDeviceCredentialsWithContextFunc is not set")
+	}
+	return m.UpdateDeviceCredentialsWithContextFunc(ctx, updateDeviceCredentialsRequest)
+}
+
+// UpdateGlobalPool calls UpdateGlobalPoolFunc.
+func (m *NetworkSettingsAPI) UpdateGlobalPool(updateGlobalPoolRequest *dnac.UpdateGlobalPoolRequest) (*dnac.UpdateGlobalPoolResponse, *resty.Response, error) {
+	m.record("UpdateGlobalPool", updateGlobalPoolRequest)
+	if m.UpdateGlobalPoolFunc == nil {
+		panic("dnacmock: NetworkSettingsAPI.UpdateGlobalPool called but UpdateGlobalPoolFunc is not set")
+	}
+	return m.UpdateGlobalPoolFunc(updateGlobalPoolRequest)
+}
+
+// UpdateGlobalPoolWithContext calls UpdateGlobalPoolWithContextFunc.
+func (m *NetworkSettingsAPI) UpdateGlobalPoolWithContext(ctx context.Context, updateGlobalPoolRequest *dnac.UpdateGlobalPoolRequest) (*dnac.UpdateGlobalPoolResponse, *resty.Response, error) {
+	m.record("UpdateGlobalPoolWithContext", ctx, updateGlobalPoolRequest)
+	if m.UpdateGlobalPoolWithContextFunc == nil {
+		panic("dnacmock: NetworkSettingsAPI.UpdateGlobalPoolWithContext called but UpdateGlobalPoolWithContextFunc is not set")
+	}
+	return m.UpdateGlobalPoolWithContextFunc(ctx, updateGlobalPoolRequest)
+}
+
+// UpdateNetwork calls UpdateNetworkFunc.
+func (m *NetworkSettingsAPI) UpdateNetwork(siteID string, updateNetworkRequest *dnac.UpdateNetworkRequest) (*dnac.UpdateNetworkResponse, *resty.Response, error) {
+	m.record("UpdateNetwork", siteID, updateNetworkRequest)
+	if m.UpdateNetworkFunc == nil {
+		panic("dnacmock: NetworkSettingsAPI.UpdateNetwork called but UpdateNetworkFunc is not set")
+	}
+	return m.UpdateNetworkFunc(siteID, updateNetworkRequest)
+}
+
+// UpdateNetworkWithContext calls UpdateNetworkWithContextFunc.
+func (m *NetworkSettingsAPI) UpdateNetworkWithContext(ctx context.Context, siteID string, updateNetworkRequest *dnac.UpdateNetworkRequest) (*dnac.UpdateNetworkResponse, *resty.Response, error) {
+	m.record("UpdateNetworkWithContext", ctx, siteID, updateNetworkRequest)
+	if m.UpdateNetworkWithContextFunc == nil {
+		panic("dnacmock: NetworkSettingsAPI.UpdateNetworkWithContext called but UpdateNetworkWithContextFunc is not set")
+	}
+	return m.UpdateNetworkWithContextFunc(ctx, siteID, updateNetworkRequest)
+}
+
+// UpdateSPProfile calls UpdateSPProfileFunc.
+func (m *NetworkSettingsAPI) UpdateSPProfile(updateSPProfileRequest *dnac.UpdateSPProfileRequest) (*dnac.UpdateSPProfileResponse, *resty.Response, error) {
+	m.record("UpdateSPProfile", updateSPProfileRequest)
+	if m.UpdateSPProfileFunc == nil {
+		panic("dnacmock: NetworkSettingsAPI.UpdateSPProfile called but UpdateSPProfileFunc is not set")
+	}
+	return m.UpdateSPProfileFunc(updateSPProfileRequest)
+}
+
+// UpdateSPProfileWithContext calls UpdateSPProfileWithContextFunc.
+func (m *NetworkSettingsAPI) UpdateSPProfileWithContext(ctx context.Context, updateSPProfileRequest *dnac.UpdateSPProfileRequest) (*dnac.UpdateSPProfileResponse, *resty.Response, error) {
+	m.record("UpdateSPProfileWithContext", ctx, updateSPProfileRequest)
+	if m.UpdateSPProfileWithContextFunc == nil {
+		panic("dnacmock: NetworkSettingsAPI.UpdateSPProfileWithContext called but UpdateSPProfileWithContextFunc is not set")
+	}
+	return m.UpdateSPProfileWithContextFunc(ctx, updateSPProfileRequest)
+}
+
+// SoftwareImageManagementSWIMAPI is a mock of dnac.SoftwareImageManagementSWIMAPI. Each method calls the function of the
+// same name suffixed with Func, which must be set if the method is called.
+type SoftwareImageManagementSWIMAPI struct {
+	Recorder
+
+	GetSoftwareImageDetailsFunc                     func(getSoftwareImageDetailsQueryParams *dnac.GetSoftwareImageDetailsQueryParams) (*dnac.GetSoftwareImageDetailsResponse, *resty.Response, error)
+	GetSoftwareImageDetailsWithContextFunc          func(ctx context.Context, getSoftwareImageDetailsQueryParams *dnac.GetSoftwareImageDetailsQueryParams) (*dnac.GetSoftwareImageDetailsResponse, *resty.Response, error)
+	ImportLocalSoftwareImageFunc                    func(importLocalSoftwareImageQueryParams *dnac.ImportLocalSoftwareImageQueryParams) (*dnac.ImportLocalSoftwareImageResponse, *resty.Response, error)
+	ImportLocalSoftwareImageWithContextFunc         func(ctx context.Context, importLocalSoftwareImageQueryParams *dnac.ImportLocalSoftwareImageQueryParams) (*dnac.ImportLocalSoftwareImageResponse, *resty.Response, error)
+	ImportSoftwareImageViaURLFunc                   func(importSoftwareImageViaURLQueryParams *dnac.ImportSoftwareImageViaURLQueryParams, importSoftwareImageViaURLRequest *[]dnac.ImportSoftwareImageViaURLRequest) (*dnac.ImportSoftwareImageViaURLResponse, *resty.Response, error)
+	ImportSoftwareImageViaURLWithContextFunc        func(ctx context.Context, importSoftwareImageViaURLQueryParams *dnac.ImportSoftwareImageViaURLQueryParams, importSoftwareImageViaURLRequest *[]dnac.ImportSoftwareImageViaURLRequest) (*dnac.ImportSoftwareImageViaURLResponse, *resty.Response, error)
+	TriggerSoftwareImageActivationFunc              func(triggerSoftwareImageActivationQueryParams *dnac.TriggerSoftwareImageActivationQueryParams, triggerSoftwareImageActivationRequest *[]dnac.TriggerSoftwareImageActivationRequest) (*dnac.TriggerSoftwareImageActivationResponse, *resty.Response, error)
+	TriggerSoftwareImageActivationWithContextFunc   func(ctx context.Context, triggerSoftwareImageActivationQueryParams *dnac.TriggerSoftwareImageActivationQueryParams, triggerSoftwareImageActivationRequest *[]dnac.TriggerSoftwareImageActivationRequest) (*dnac.TriggerSoftwareImageActivationResponse, *resty.Response, error)
+	TriggerSoftwareImageDistributionFunc            func(triggerSoftwareImageDistributionRequest *[]dnac.TriggerSoftwareImageDistributionRequest) (*dnac.TriggerSoftwareImageDistributionResponse, *resty.Response, error)
+	TriggerSoftwareImageDistributionWithContextFunc func(ctx context.Context, triggerSoftwareImageDistributionRequest *[]dnac.TriggerSoftwareImageDistributionRequest) (*dnac.TriggerSoftwareImageDistributionResponse, *resty.Response, error)
+	UploadLocalSoftwareImageFunc                    func(ctx context.Context, fileName string, image io.Reader, size int64, importLocalSoftwareImageQueryParams *dnac.ImportLocalSoftwareImageQueryParams, progress dnac.UploadProgressFunc) (*dnac.ImportLocalSoftwareImageResponse, *resty.Response, error)
+	UploadLocalSoftwareImageFileFunc                func(ctx context.Context, filePath string, importLocalSoftwareImageQueryParams *dnac.ImportLocalSoftwareImageQueryParams, progress dnac.UploadProgressFunc) (*dnac.ImportLocalSoftwareImageResponse, *resty.Response, error)
+}
+
+// GetSoftwareImageDetails calls GetSoftwareImageDetailsFunc.
+func (m *SoftwareImageManagementSWIMAPI) GetSoftwareImageDetails(getSoftwareImageDetailsQueryParams *dnac.GetSoftwareImageDetailsQueryParams) (*dnac.GetSoftwareImageDetailsResponse, *resty.Response, error) {
+	m.record("GetSoftwareImageDetails", getSoftwareImageDetailsQueryParams)
+	if m.GetSoftwareImageDetailsFunc == nil {
+		panic("dnacmock: SoftwareImageManagementSWIMAPI.GetSoftwareImageDetails called but GetSoftwareImageDetailsFunc is not set")
+	}
+	return m.GetSoftwareImageDetailsFunc(getSoftwareImageDetailsQueryParams)
+}
+
+// GetSoftwareImageDetailsWithContext calls GetSoftwareImageDetailsWithContextFunc.
+func (m *SoftwareImageManagementSWIMAPI) GetSoftwareImageDetailsWithContext(ctx context.Context, getSoftwareImageDetailsQueryParams *dnac.GetSoftwareImageDetailsQueryParams) (*dnac.GetSoftwareImageDetailsResponse, *resty.Response, error) {
+	m.record("GetSoftwareImageDetailsWithContext", ctx, getSoftwareImageDetailsQueryParams)
+	if m.GetSoftwareImageDetailsWithContextFunc == nil {
+		panic("dnacmock: SoftwareImageManagementSWIMAPI.GetSoftwareImageDetailsWithContext called but GetSoftwareImageDetailsWithContextFunc is not set")
+	}
+	return m.GetSoftwareImageDetailsWithContextFunc(ctx, getSoftwareImageDetailsQueryParams)
+}
+
+// ImportLocalSoftwareImage calls ImportLocalSoftwareImageFunc.
+func (m *SoftwareImageManagementSWIMAPI) ImportLocalSoftwareImage(importLocalSoftwareImageQueryParams *dnac.ImportLocalSoftwareImageQueryParams) (*dnac.ImportLocalSoftwareImageResponse, *resty.Response, error) {
+	m.record("ImportLocalSoftwareImage", importLocalSoftwareImageQueryParams)
+	if m.ImportLocalSoftwareImageFunc == nil {
+		panic("dnacmock: SoftwareImageManagementSWIMAPI.ImportLocalSoftwareImage called but ImportLocalSoftwareImageFunc is not set")
+	}
+	return m.ImportLocalSoftwareImageFunc(importLocalSoftwareImageQueryParams)
+}
+
+// ImportLocalSoftwareImageWithContext calls ImportLocalSoftwareImageWithContextFunc.
+func (m *SoftwareImageManagementSWIMAPI) ImportLocalSoftwareImageWithContext(ctx context.Context, importLocalSoftwareImageQueryParams *dnac.ImportLocalSoftwareImageQueryParams) (*dnac.ImportLocalSoftwareImageResponse, *resty.Response, error) {
+	m.record("ImportLocalSoftwareImageWithContext", ctx, importLocalSoftwareImageQueryParams)
+	if m.ImportLocalSoftwareImageWithContextFunc == nil {
+		panic("dnacmock: SoftwareImageManagementSWIMAPI.ImportLocalSoftwareImageWithContext called but ImportLocalSoftwareImageWithContextFunc is not set")
+	}
+	return m.ImportLocalSoftwareImageWithContextFunc(ctx, importLocalSoftwareImageQueryParams)
+}
+
+// ImportSoftwareImageViaURL calls ImportSoftwareImageViaURLFunc.
+func (m *SoftwareImageManagementSWIMAPI) ImportSoftwareImageViaURL(importSoftwareImageViaURLQueryParams *dnac.ImportSoftwareImageViaURLQueryParams, importSoftwareImageViaURLRequest *[]dnac.ImportSoftwareImageViaURLRequest) (*dnac.ImportSoftwareImageViaURLResponse, *resty.Response, error) {
+	m.record("ImportSoftwareImageViaURL", importSoftwareImageViaURLQueryParams, importSoftwareImageViaURLRequest)
+	if m.ImportSoftwareImageViaURLFunc == nil {
+		panic("dnacmock: SoftwareImageManagementSWIMAPI.ImportSoftwareImageViaURL called but ImportSoftwareImageViaURLFunc is not set")
+	}
+	return m.ImportSoftwareImageViaURLFunc(importSoftwareImageViaURLQueryParams, importSoftwareImageViaURLRequest)
+}
+
+// ImportSoftwareImageViaURLWithContext calls ImportSoftwareImageViaURLWithContextFunc.
+func (m *SoftwareImageManagementSWIMAPI) ImportSoftwareImageViaURLWithContext(ctx context.Context, importSoftwareImageViaURLQueryParams *dnac.ImportSoftwareImageViaURLQueryParams, importSoftwareImageViaURLRequest *[]dnac.ImportSoftwareImageViaURLRequest) (*dnac.ImportSoftwareImageViaURLResponse, *resty.Response, error) {
+	m.record("ImportSoftwareImageViaURLWithContext", ctx, importSoftwareImageViaURLQueryParams, importSoftwareImageViaURLRequest)
+	if m.ImportSoftwareImageViaURLWithContextFunc == nil {
+		panic("dnacmock: SoftwareImageManagementSWIMAPI.ImportSoftwareImageViaURLWithContext called but ImportSoftwareImageViaURLWithContextFunc is not set")
+	}
+	return m.ImportSoftwareImageViaURLWithContextFunc(ctx, importSoftwareImageViaURLQueryParams, importSoftwareImageViaURLRequest)
+}
+
+// TriggerSoftwareImageActivation calls TriggerSoftwareImageActivationFunc.
+func (m *SoftwareImageManagementSWIMAPI) TriggerSoftwareImageActivation(triggerSoftwareImageActivationQueryParams *dnac.TriggerSoftwareImageActivationQueryParams, triggerSoftwareImageActivationRequest *[]dnac.TriggerSoftwareImageActivationRequest) (*dnac.TriggerSoftwareImageActivationResponse, *resty.Response, error) {
+	m.record("TriggerSoftwareImageActivation", triggerSoftwareImageActivationQueryParams, triggerSoftwareImageActivationRequest)
+	if m.TriggerSoftwareImageActivationFunc == nil {
+		panic("dnacmock: SoftwareImageManagementSWIMAPI.TriggerSoftwareImageActivation called but TriggerSoftwareImageActivationFunc is not set")
+	}
+	return m.TriggerSoftwareImageActivationFunc(triggerSoftwareImageActivationQueryParams, triggerSoftwareImageActivationRequest)
+}
+
+// TriggerSoftwareImageActivationWithContext calls TriggerSoftwareImageActivationWithContextFunc.
+func (m *SoftwareImageManagementSWIMAPI) TriggerSoftwareImageActivationWithContext(ctx context.Context, triggerSoftwareImageActivationQueryParams *dnac.TriggerSoftwareImageActivationQueryParams, triggerSoftwareImageActivationRequest *[]dnac.TriggerSoftwareImageActivationRequest) (*dnac.TriggerSoftwareImageActivationResponse, *resty.Response, error) {
+	m.record("TriggerSoftwareImageActivationWithContext", ctx, triggerSoftwareImageActivationQueryParams, triggerSoftwareImageActivationRequest)
+	if m.TriggerSoftwareImageActivationWithContextFunc == nil {
+		panic("dnacmock: SoftwareImageManagementSWIMAPI.TriggerSoftwareImageActivationWithContext called but TriggerSoftwareImageActivationWithContextFunc is not set")
+	}
+	return m.TriggerSoftwareImageActivationWithContextFunc(ctx, triggerSoftwareImageActivationQueryParams, triggerSoftwareImageActivationRequest)
+}
+
+// TriggerSoftwareImageDistribution calls TriggerSoftwareImageDistributionFunc.
+func (m *SoftwareImageManagementSWIMAPI) TriggerSoftwareImageDistribution(triggerSoftwareImageDistributionRequest *[]dnac.TriggerSoftwareImageDistributionRequest) (*dnac.TriggerSoftwareImageDistributionResponse, *resty.Response, error) {
+	m.record("TriggerSoftwareImageDistribution", triggerSoftwareImageDistributionRequest)
+	if m.TriggerSoftwareImageDistributionFunc == nil {
+		panic("dnacmock: SoftwareImageManagementSWIMAPI.TriggerSoftwareImageDistribution called but TriggerSoftwareImageDistributionFunc is not set")
+	}
+	return m.TriggerSoftwareImageDistributionFunc(triggerSoftwareImageDistributionRequest)
+}
+
+// TriggerSoftwareImageDistributionWithContext calls TriggerSoftwareImageDistributionWithContextFunc.
+func (m *SoftwareImageManagementSWIMAPI) TriggerSoftwareImageDistributionWithContext(ctx context.Context, triggerSoftwareImageDistributionRequest *[]dnac.TriggerSoftwareImageDistributionRequest) (*dnac.TriggerSoftwareImageDistributionResponse, *resty.Response, error) {
+	m.record("TriggerSoftwareImageDistributionWithContext", ctx, triggerSoftwareImageDistributionRequest)
+	if m.TriggerSoftwareImageDistributionWithContextFunc == nil {
+		panic("dnacmock: SoftwareImageManagementSWIMAPI.TriggerSoftwareImageDistributionWithContext called but TriggerSoftwareImageDistributionWithContextFunc is not set")
+	}
+	return m.TriggerSoftwareImageDistributionWithContextFunc(ctx, triggerSoftwareImageDistributionRequest)
+}
+
+// UploadLocalSoftwareImage calls UploadLocalSoftwareImageFunc.
+func (m *SoftwareImageManagementSWIMAPI) UploadLocalSoftwareImage(ctx context.Context, fileName string, image io.Reader, size int64, importLocalSoftwareImageQueryParams *dnac.ImportLocalSoftwareImageQueryParams, progress dnac.UploadProgressFunc) (*dnac.ImportLocalSoftwareImageResponse, *resty.Response, error) {
+	m.record("UploadLocalSoftwareImage", ctx, fileName, image, size, importLocalSoftwareImageQueryParams, progress)
+	if m.UploadLocalSoftwareImageFunc == nil {
+		panic("dnacmock: SoftwareImageManagementSWIMAPI.UploadLocalSoftwareImage called but UploadLocalSoftwareImageFunc is not set")
+	}
+	return m.UploadLocalSoftwareImageFunc(ctx, fileName, image, size, importLocalSoftwareImageQueryParams, progress)
+}
+
+// UploadLocalSoftwareImageFile calls UploadLocalSoftwareImageFileFunc.
+func (m *SoftwareImageManagementSWIMAPI) UploadLocalSoftwareImageFile(ctx context.Context, filePath string, importLocalSoftwareImageQueryParams *dnac.ImportLocalSoftwareImageQueryParams, progress dnac.UploadProgressFunc) (*dnac.ImportLocalSoftwareImageResponse, *resty.Response, error) {
+	m.record("UploadLocalSoftwareImageFile", ctx, filePath, importLocalSoftwareImageQueryParams, progress)
+	if m.UploadLocalSoftwareImageFileFunc == nil {
+		panic("dnacmock: SoftwareImageManagementSWIMAPI.UploadLocalSoftwareImageFile called but UploadLocalSoftwareImageFileFunc is not set")
+	}
+	return m.UploadLocalSoftwareImageFileFunc(ctx, filePath, importLocalSoftwareImageQueryParams, progress)
+}
+
+// DeviceOnboardingPnPAPI is a mock of dnac.DeviceOnboardingPnPAPI. Each method calls the function of the
+// same name suffixed with Func, which must be set if the method is called.
+type DeviceOnboardingPnPAPI struct {
+	Recorder
+
+	AddAWorkflowFunc                              func(addAWorkflowRequest *dnac.AddAWorkflowRequest) (*dnac.AddAWorkflowResponse, *resty.Response, error)
+	AddAWorkflowWithContextFunc                   func(ctx context.Context, addAWorkflowRequest *dnac.AddAWorkflowRequest) (*dnac.AddAWorkflowResponse, *resty.Response, error)
+	AddDeviceToPnpDatabaseFunc                    func(addDeviceToPnpDatabaseRequest *dnac.AddDeviceToPnpDatabaseRequest) (*dnac.AddDeviceToPnpDatabaseResponse, *resty.Response, error)
+	AddDeviceToPnpDatabaseWithContextFunc         func(ctx context.Context, addDeviceToPnpDatabaseRequest *dnac.AddDeviceToPnpDatabaseRequest) (*dnac.AddDeviceToPnpDatabaseResponse, *resty.Response, error)
+	AddVirtualAccountFunc                         func(addVirtualAccountRequest *dnac.AddVirtualAccountRequest) (*dnac.AddVirtualAccountResponse, *resty.Response, error)
+	AddVirtualAccountWithContextFunc              func(ctx context.Context, addVirtualAccountRequest *dnac.AddVirtualAccountRequest) (*dnac.AddVirtualAccountResponse, *resty.Response, error)
+	ClaimADeviceToASiteFunc                       func(claimADeviceToASiteRequest *dnac.ClaimADeviceToASiteRequest) (*dnac.ClaimADeviceToASiteResponse, *resty.Response, error)
+	ClaimADeviceToASiteWithContextFunc            func(ctx context.Context, claimADeviceToASiteRequest *dnac.ClaimADeviceToASiteRequest) (*dnac.ClaimADeviceToASiteResponse, *resty.Response, error)
+	ClaimDeviceFunc                               func(claimDeviceRequest *dnac.ClaimDeviceRequest) (*dnac.ClaimDeviceResponse, *resty.Response, error)
+	ClaimDeviceWithContextFunc                    func(ctx context.Context, claimDeviceRequest *dnac.ClaimDeviceRequest) (*dnac.ClaimDeviceResponse, *resty.Response, error)
+	DeleteDeviceByIDFromPnPFunc                   func(id string) (*dnac.DeleteDeviceByIDFromPnPResponse, *resty.Response, error)
+	DeleteDeviceByIDFromPnPWithContextFunc        func(ctx context.Context, id string) (*dnac.DeleteDeviceByIDFromPnPResponse, *resty.Response, error)
+	DeleteWorkflowByIDFunc                        func(id string) (*dnac.DeleteWorkflowByIDResponse, *resty.Response, error)
+	DeleteWorkflowByIDWithContextFunc             func(ctx context.Context, id string) (*dnac.DeleteWorkflowByIDResponse, *resty.Response, error)
+	DeregisterVirtualAccountFunc                  func(deregisterVirtualAccountQueryParams *dnac.DeregisterVirtualAccountQueryParams) (*dnac.DeregisterVirtualAccountResponse, *resty.Response, error)
+	DeregisterVirtualAccountWithContextFunc       func(ctx context.Context, deregisterVirtualAccountQueryParams *dnac.DeregisterVirtualAccountQueryParams) (*dnac.DeregisterVirtualAccountResponse, *resty.Response, error)
+	GetDeviceByIDFunc                             func(id string) (*dnac.GetDeviceByIDResponse, *resty.Response, error)
+	GetDeviceByIDWithContextFunc                  func(ctx context.Context, id string) (*dnac.GetDeviceByIDResponse, *resty.Response, error)
+	GetDeviceHistoryFunc                          func(getDeviceHistoryQueryParams *dnac.GetDeviceHistoryQueryParams) (*dnac.GetDeviceHistoryResponse, *resty.Response, error)
+	GetDeviceHistoryWithContextFunc               func(ctx context.Context, getDeviceHistoryQueryParams *dnac.GetDeviceHistoryQueryParams) (*dnac.GetDeviceHistoryResponse, *resty.Response, error)
+	GetPnPGlobalSettingsFunc                      func() (*dnac.GetPnPGlobalSettingsResponse, *resty.Response, error)
+	GetPnPGlobalSettingsWithContextFunc           func(ctx context.Context) (*dnac.GetPnPGlobalSettingsResponse, *resty.Response, error)
+	GetPnpDeviceCountFunc                         func(getPnpDeviceCountQueryParams *dnac.GetPnpDeviceCountQueryParams) (*dnac.GetPnpDeviceCountResponse, *resty.Response, error)
+	GetPnpDeviceCountWithContextFunc              func(ctx context.Context, getPnpDeviceCountQueryParams *dnac.GetPnpDeviceCountQueryParams) (*dnac.GetPnpDeviceCountResponse, *resty.Response, error)
+	GetPnpDeviceListFunc                          func(getPnpDeviceListQueryParams *dnac.GetPnpDeviceListQueryParams) (*[]dnac.GetPnpDeviceListResponse, *resty.Response, error)
+	GetPnpDeviceListWithContextFunc               func(ctx context.Context, getPnpDeviceListQueryParams *dnac.GetPnpDeviceListQueryParams) (*[]dnac.GetPnpDeviceListResponse, *resty.Response, error)
+	GetSmartAccountListFunc                       func() (*dnac.GetSmartAccountListResponse, *resty.Response, error)
+	GetSmartAccountListWithContextFunc            func(ctx context.Context) (*dnac.GetSmartAccountListResponse, *resty.Response, error)
+	GetSyncResultForVirtualAccountFunc            func(domain string, name string) (*dnac.GetSyncResultForVirtualAccountResponse, *resty.Response, error)
+	GetSyncResultForVirtualAccountWithContextFunc func(ctx context.Context, domain string, name string) (*dnac.GetSyncResultForVirtualAccountResponse, *resty.Response, error)
+	GetVirtualAccountListFunc                     func(domain string) (*dnac.GetVirtualAccountListResponse, *resty.Response, error)
+	GetVirtualAccountListWithContextFunc          func(ctx context.Context, domain string) (*dnac.GetVirtualAccountListResponse, *resty.Response, error)
+	GetWorkflowByIDFunc                           func(id string) (*dnac.GetWorkflowByIDResponse, *resty.Response, error)
+	GetWorkflowByIDWithContextFunc                func(ctx context.Context, id string) (*dnac.GetWorkflowByIDResponse, *resty.Response, error)
+	GetWorkflowCountFunc                          func(getWorkflowCountQueryParams *dnac.GetWorkflowCountQueryParams) (*dnac.GetWorkflowCountResponse, *resty.Response, error)
+	GetWorkflowCountWithContextFunc               func(ctx context.Context, getWorkflowCountQueryParams *dnac.GetWorkflowCountQueryParams) (*dnac.GetWorkflowCountResponse, *resty.Response, error)
+	GetWorkflowsFunc                              func(getWorkflowsQueryParams *dnac.GetWorkflowsQueryParams) (*[]dnac.GetWorkflowsResponse, *resty.Response, error)
+	GetWorkflowsWithContextFunc                   func(ctx context.Context, getWorkflowsQueryParams *dnac.GetWorkflowsQueryParams) (*[]dnac.GetWorkflowsResponse, *resty.Response, error)
+	ImportDevicesInBulkFunc                       func(importDevicesInBulkRequest *dnac.ImportDevicesInBulkRequest) (*dnac.ImportDevicesInBulkResponse, *resty.Response, error)
+	ImportDevicesInBulkWithContextFunc            func(ctx context.Context, importDevicesInBulkRequest *dnac.ImportDevicesInBulkRequest) (*dnac.ImportDevicesInBulkResponse, *resty.Response, error)
+	ListAllDevicesFunc                            func(ctx context.Context, params *dnac.GetPnpDeviceListQueryParams, opts *dnac.PageOptions) *dnac.PnpDeviceIterator
+	PreviewConfigFunc                             func(previewConfigRequest *dnac.PreviewConfigRequest) (*dnac.PreviewConfigResponse, *resty.Response, error)
+	PreviewConfigWithContextFunc                  func(ctx context.Context, previewConfigRequest *dnac.PreviewConfigRequest) (*dnac.PreviewConfigResponse, *resty.Response, error)
+	ResetDeviceFunc                               func(resetDeviceRequest *dnac.ResetDeviceRequest) (*dnac.ResetDeviceResponse, *resty.Response, error)
+	ResetDeviceWithContextFunc                    func(ctx context.Context, resetDeviceRequest *dnac.ResetDeviceRequest) (*dnac.ResetDeviceResponse, *resty.Response, error)
+	SyncVirtualAccountDevicesFunc                 func(syncVirtualAccountDevicesRequest *dnac.SyncVirtualAccountDevicesRequest) (*dnac.SyncVirtualAccountDevicesResponse, *resty.Response, error)
+	SyncVirtualAccountDevicesWithContextFunc      func(ctx context.Context, syncVirtualAccountDevicesRequest *dnac.SyncVirtualAccountDevicesRequest) (*dnac.SyncVirtualAccountDevicesResponse, *resty.Response, error)
+	UnClaimDeviceFunc                             func(unClaimDeviceRequest *dnac.UnClaimDeviceRequest) (*dnac.UnClaimDeviceResponse, *resty.Response, error)
+	UnClaimDeviceWithContextFunc                  func(ctx context.Context, unClaimDeviceRequest *dnac.UnClaimDeviceRequest) (*dnac.UnClaimDeviceResponse, *resty.Response, error)
+	UpdateDeviceFunc                              func(id string, updateDeviceRequest *dnac.UpdateDeviceRequest) (*dnac.UpdateDeviceResponse, *resty.Response, error)
+	UpdateDeviceWithContextFunc                   func(ctx context.Context, id string, updateDeviceRequest *dnac.UpdateDeviceRequest) (*dnac.UpdateDeviceResponse, *resty.Response, error)
+	UpdatePnPGlobalSettingsFunc                   func(updatePnPGlobalSettingsRequest *dnac.UpdatePnPGlobalSettingsRequest) (*dnac.UpdatePnPGlobalSettingsResponse, *resty.Response, error)
+	UpdatePnPGlobalSettingsWithContextFunc        func(ctx context.Context, updatePnPGlobalSettingsRequest *dnac.UpdatePnPGlobalSettingsRequest) (*dnac.UpdatePnPGlobalSettingsResponse, *resty.Response, error)
+	UpdatePnPServerProfileFunc                    func(updatePnPServerProfileRequest *dnac.UpdatePnPServerProfileRequest) (*dnac.UpdatePnPServerProfileResponse, *resty.Response, error)
+	UpdatePnPServerProfileWithContextFunc         func(ctx context.Context, updatePnPServerProfileRequest *dnac.UpdatePnPServerProfileRequest) (*dnac.UpdatePnPServerProfileResponse, *resty.Response, error)
+	UpdateWorkflowFunc                            func(id string, updateWorkflowRequest *dnac.UpdateWorkflowRequest) (*dnac.UpdateWorkflowResponse, *resty.Response, error)
+	UpdateWorkflowWithContextFunc                 func(ctx context.Context, id string, updateWorkflowRequest *dnac.UpdateWorkflowRequest) (*dnac.UpdateWorkflowResponse, *resty.Response, error)
+}
+
+// AddAWorkflow calls AddAWorkflowFunc.
+func (m *DeviceOnboardingPnPAPI) AddAWorkflow(addAWorkflowRequest *dnac.AddAWorkflowRequest) (*dnac.AddAWorkflowResponse, *resty.Response, error) {
+	m.record("AddAWorkflow", addAWorkflowRequest)
+	if m.AddAWorkflowFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.AddAWorkflow called but AddAWorkflowFunc is not set")
+	}
+	return m.AddAWorkflowFunc(addAWorkflowRequest)
+}
+
+// AddAWorkflowWithContext calls AddAWorkflowWithContextFunc.
+func (m *DeviceOnboardingPnPAPI) AddAWorkflowWithContext(ctx context.Context, addAWorkflowRequest *dnac.AddAWorkflowRequest) (*dnac.AddAWorkflowResponse, *resty.Response, error) {
+	m.record("AddAWorkflowWithContext", ctx, addAWorkflowRequest)
+	if m.AddAWorkflowWithContextFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.AddAWorkflowWithContext called but AddAWorkflowWithContextFunc is not set")
+	}
+	return m.AddAWorkflowWithContextFunc(ctx, addAWorkflowRequest)
+}
+
+// AddDeviceToPnpDatabase calls AddDeviceToPnpDatabaseFunc.
+func (m *DeviceOnboardingPnPAPI) AddDeviceToPnpDatabase(addDeviceToPnpDatabaseRequest *dnac.AddDeviceToPnpDatabaseRequest) (*dnac.AddDeviceToPnpDatabaseResponse, *resty.Response, error) {
+	m.record("AddDeviceToPnpDatabase", addDeviceToPnpDatabaseRequest)
+	if m.AddDeviceToPnpDatabaseFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.AddDeviceToPnpDatabase called but AddDeviceToPnpDatabaseFunc is not set")
+	}
+	return m.AddDeviceToPnpDatabaseFunc(addDeviceToPnpDatabaseRequest)
+}
+
+// AddDeviceToPnpDatabaseWithContext calls AddDeviceToPnpDatabaseWithContextFunc.
+func (m *DeviceOnboardingPnPAPI) AddDeviceToPnpDatabaseWithContext(ctx context.Context, addDeviceToPnpDatabaseRequest *dnac.AddDeviceToPnpDatabaseRequest) (*dnac.AddDeviceToPnpDatabaseResponse, *resty.Response, error) {
+	m.record("AddDeviceToPnpDatabaseWithContext", ctx, addDeviceToPnpDatabaseRequest)
+	if m.AddDeviceToPnpDatabaseWithContextFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.AddDeviceToPnpDatabaseWithContext called but AddDeviceToPnpDatabaseWithContextFunc is not set")
+	}
+	return m.AddDeviceToPnpDatabaseWithContextFunc(ctx, addDeviceToPnpDatabaseRequest)
+}
+
+// AddVirtualAccount calls AddVirtualAccountFunc.
+func (m *DeviceOnboardingPnPAPI) AddVirtualAccount(addVirtualAccountRequest *dnac.AddVirtualAccountRequest) (*dnac.AddVirtualAccountResponse, *resty.Response, error) {
+	m.record("AddVirtualAccount", addVirtualAccountRequest)
+	if m.AddVirtualAccountFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.AddVirtualAccount called but AddVirtualAccountFunc is not set")
+	}
+	return m.AddVirtualAccountFunc(addVirtualAccountRequest)
+}
+
+// AddVirtualAccountWithContext calls AddVirtualAccountWithContextFunc.
+func (m *DeviceOnboardingPnPAPI) AddVirtualAccountWithContext(ctx context.Context, addVirtualAccountRequest *dnac.AddVirtualAccountRequest) (*dnac.AddVirtualAccountResponse, *resty.Response, error) {
+	m.record("AddVirtualAccountWithContext", ctx, addVirtualAccountRequest)
+	if m.AddVirtualAccountWithContextFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.AddVirtualAccountWithContext called but AddVirtualAccountWithContextFunc is not set")
+	}
+	return m.AddVirtualAccountWithContextFunc(ctx, addVirtualAccountRequest)
+}
+
+// ClaimADeviceToASite calls ClaimADeviceToASiteFunc.
+func (m *DeviceOnboardingPnPAPI) ClaimADeviceToASite(claimADeviceToASiteRequest *dnac.ClaimADeviceToASiteRequest) (*dnac.ClaimADeviceToASiteResponse, *resty.Response, error) {
+	m.record("ClaimADeviceToASite", claimADeviceToASiteRequest)
+	if m.ClaimADeviceToASiteFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.ClaimADeviceToASite called but ClaimADeviceToASiteFunc is not set")
+	}
+	return m.ClaimADeviceToASiteFunc(claimADeviceToASiteRequest)
+}
+
+// ClaimADeviceToASiteWithContext calls ClaimADeviceToASiteWithContextFunc.
+func (m *DeviceOnboardingPnPAPI) ClaimADeviceToASiteWithContext(ctx context.Context, claimADeviceToASiteRequest *dnac.ClaimADeviceToASiteRequest) (*dnac.ClaimADeviceToASiteResponse, *resty.Response, error) {
+	m.record("ClaimADeviceToASiteWithContext", ctx, claimADeviceToASiteRequest)
+	if m.ClaimADeviceToASiteWithContextFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.ClaimADeviceToASiteWithContext called but ClaimADeviceToASiteWithContextFunc is not set")
+	}
+	return m.ClaimADeviceToASiteWithContextFunc(ctx, claimADeviceToASiteRequest)
+}
+
+// ClaimDevice calls ClaimDeviceFunc.
+func (m *DeviceOnboardingPnPAPI) ClaimDevice(claimDeviceRequest *dnac.ClaimDeviceRequest) (*dnac.ClaimDeviceResponse, *resty.Response, error) {
+	m.record("ClaimDevice", claimDeviceRequest)
+	if m.ClaimDeviceFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.ClaimDevice called but ClaimDeviceFunc is not set")
+	}
+	return m.ClaimDeviceFunc(claimDeviceRequest)
+}
+
+// ClaimDeviceWithContext calls ClaimDeviceWithContextFunc.
+func (m *DeviceOnboardingPnPAPI) ClaimDeviceWithContext(ctx context.Context, claimDeviceRequest *dnac.ClaimDeviceRequest) (*dnac.ClaimDeviceResponse, *resty.Response, error) {
+	m.record("ClaimDeviceWithContext", ctx, claimDeviceRequest)
+	if m.ClaimDeviceWithContextFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.ClaimDeviceWithContext called but ClaimDeviceWithContextFunc is not set")
+	}
+	return m.ClaimDeviceWithContextFunc(ctx, claimDeviceRequest)
+}
+
+// DeleteDeviceByIDFromPnP calls DeleteDeviceByIDFromPnPFunc.
+func (m *DeviceOnboardingPnPAPI) DeleteDeviceByIDFromPnP(id string) (*dnac.DeleteDeviceByIDFromPnPResponse, *resty.Response, error) {
+	m.record("DeleteDeviceByIDFromPnP", id)
+	if m.DeleteDeviceByIDFromPnPFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.DeleteDeviceByIDFromPnP called but DeleteDeviceByIDFromPnPFunc is not set")
+	}
+	return m.DeleteDeviceByIDFromPnPFunc(id)
+}
+
+// DeleteDeviceByIDFromPnPWithContext calls DeleteDeviceByIDFromPnPWithContextFunc.
+func (m *DeviceOnboardingPnPAPI) DeleteDeviceByIDFromPnPWithContext(ctx context.Context, id string) (*dnac.DeleteDeviceByIDFromPnPResponse, *resty.Response, error) {
+	m.record("DeleteDeviceByIDFromPnPWithContext", ctx, id)
+	if m.DeleteDeviceByIDFromPnPWithContextFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.DeleteDeviceByIDFromPnPWithContext called but DeleteDeviceByIDFromPnPWithContextFunc is not set")
+	}
+	return m.DeleteDeviceByIDFromPnPWithContextFunc(ctx, id)
+}
+
+// DeleteWorkflowByID calls DeleteWorkflowByIDFunc.
+func (m *DeviceOnboardingPnPAPI) DeleteWorkflowByID(id string) (*dnac.DeleteWorkflowByIDResponse, *resty.Response, error) {
+	m.record("DeleteWorkflowByID", id)
+	if m.DeleteWorkflowByIDFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.DeleteWorkflowByID called but DeleteWorkflowByIDFunc is not set")
+	}
+	return m.DeleteWorkflowByIDFunc(id)
+}
+
+// DeleteWorkflowByIDWithContext calls DeleteWorkflowByIDWithContextFunc.
+func (m *DeviceOnboardingPnPAPI) DeleteWorkflowByIDWithContext(ctx context.Context, id string) (*dnac.DeleteWorkflowByIDResponse, *resty.Response, error) {
+	m.record("DeleteWorkflowByIDWithContext", ctx, id)
+	if m.DeleteWorkflowByIDWithContextFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.DeleteWorkflowByIDWithContext called but DeleteWorkflowByIDWithContextFunc is not set")
+	}
+	return m.DeleteWorkflowByIDWithContextFunc(ctx, id)
+}
+
+// DeregisterVirtualAccount calls DeregisterVirtualAccountFunc.
+func (m *DeviceOnboardingPnPAPI) DeregisterVirtualAccount(deregisterVirtualAccountQueryParams *dnac.DeregisterVirtualAccountQueryParams) (*dnac.DeregisterVirtualAccountResponse, *resty.Response, error) {
+	m.record("DeregisterVirtualAccount", deregisterVirtualAccountQueryParams)
+	if m.DeregisterVirtualAccountFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.DeregisterVirtualAccount called but DeregisterVirtualAccountFunc is not set")
+	}
+	return m.DeregisterVirtualAccountFunc(deregisterVirtualAccountQueryParams)
+}
+
+// DeregisterVirtualAccountWithContext calls DeregisterVirtualAccountWithContextFunc.
+func (m *DeviceOnboardingPnPAPI) DeregisterVirtualAccountWithContext(ctx context.Context, deregisterVirtualAccountQueryParams *dnac.DeregisterVirtualAccountQueryParams) (*dnac.DeregisterVirtualAccountResponse, *resty.Response, error) {
+	m.record("DeregisterVirtualAccountWithContext", ctx, deregisterVirtualAccountQueryParams)
+	if m.DeregisterVirtualAccountWithContextFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.DeregisterVirtualAccountWithContext called but DeregisterVirtualAccountWithContextFunc is not set")
+	}
+	return m.DeregisterVirtualAccountWithContextFunc(ctx, deregisterVirtualAccountQueryParams)
+}
+
+// GetDeviceByID calls GetDeviceByIDFunc.
+func (m *DeviceOnboardingPnPAPI) GetDeviceByID(id string) (*dnac.GetDeviceByIDResponse, *resty.Response, error) {
+	m.record("GetDeviceByID", id)
+	if m.GetDeviceByIDFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.GetDeviceByID called but GetDeviceByIDFunc is not set")
+	}
+	return m.GetDeviceByIDFunc(id)
+}
+
+// GetDeviceByIDWithContext calls GetDeviceByIDWithContextFunc.
+func (m *DeviceOnboardingPnPAPI) GetDeviceByIDWithContext(ctx context.Context, id string) (*dnac.GetDeviceByIDResponse, *resty.Response, error) {
+	m.record("GetDeviceByIDWithContext", ctx, id)
+	if m.GetDeviceByIDWithContextFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.GetDeviceByIDWithContext called but GetDeviceByIDWithContextFunc is not set")
+	}
+	return m.GetDeviceByIDWithContextFunc(ctx, id)
+}
+
+// GetDeviceHistory calls GetDeviceHistoryFunc.
+func (m *DeviceOnboardingPnPAPI) GetDeviceHistory(getDeviceHistoryQueryParams *dnac.GetDeviceHistoryQueryParams) (*dnac.GetDeviceHistoryResponse, *resty.Response, error) {
+	m.record("GetDeviceHistory", getDeviceHistoryQueryParams)
+	if m.GetDeviceHistoryFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.GetDeviceHistory called but GetDeviceHistoryFunc is not set")
+	}
+	return m.GetDeviceHistoryFunc(getDeviceHistoryQueryParams)
+}
+
+// GetDeviceHistoryWithContext calls GetDeviceHistoryWithContextFunc.
+func (m *DeviceOnboardingPnPAPI) GetDeviceHistoryWithContext(ctx context.Context, getDeviceHistoryQueryParams *dnac.GetDeviceHistoryQueryParams) (*dnac.GetDeviceHistoryResponse, *resty.Response, error) {
+	m.record("GetDeviceHistoryWithContext", ctx, getDeviceHistoryQueryParams)
+	if m.GetDeviceHistoryWithContextFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.GetDeviceHistoryWithContext called but GetDeviceHistoryWithContextFunc is not set")
+	}
+	return m.GetDeviceHistoryWithContextFunc(ctx, getDeviceHistoryQueryParams)
+}
+
+// GetPnPGlobalSettings calls GetPnPGlobalSettingsFunc.
+func (m *DeviceOnboardingPnPAPI) GetPnPGlobalSettings() (*dnac.GetPnPGlobalSettingsResponse, *resty.Response, error) {
+	m.record("GetPnPGlobalSettings")
+	if m.GetPnPGlobalSettingsFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.GetPnPGlobalSettings called but GetPnPGlobalSettingsFunc is not set")
+	}
+	return m.GetPnPGlobalSettingsFunc()
+}
+
+// GetPnPGlobalSettingsWithContext calls GetPnPGlobalSettingsWithContextFunc.
+func (m *DeviceOnboardingPnPAPI) GetPnPGlobalSettingsWithContext(ctx context.Context) (*dnac.GetPnPGlobalSettingsResponse, *resty.Response, error) {
+	m.record("GetPnPGlobalSettingsWithContext", ctx)
+	if m.GetPnPGlobalSettingsWithContextFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.GetPnPGlobalSettingsWithContext called but GetPnPGlobalSettingsWithContextFunc is not set")
+	}
+	return m.GetPnPGlobalSettingsWithContextFunc(ctx)
+}
+
+// GetPnpDeviceCount calls GetPnpDeviceCountFunc.
+func (m *DeviceOnboardingPnPAPI) GetPnpDeviceCount(getPnpDeviceCountQueryParams *dnac.GetPnpDeviceCountQueryParams) (*dnac.GetPnpDeviceCountResponse, *resty.Response, error) {
+	m.record("GetPnpDeviceCount", getPnpDeviceCountQueryParams)
+	if m.GetPnpDeviceCountFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.GetPnpDeviceCount called but GetPnpDeviceCountFunc is not set")
+	}
+	return m.GetPnpDeviceCountFunc(getPnpDeviceCountQueryParams)
+}
+
+// GetPnpDeviceCountWithContext calls GetPnpDeviceCountWithContextFunc.
+func (m *DeviceOnboardingPnPAPI) GetPnpDeviceCountWithContext(ctx context.Context, getPnpDeviceCountQueryParams *dnac.GetPnpDeviceCountQueryParams) (*dnac.GetPnpDeviceCountResponse, *resty.Response, error) {
+	m.record("GetPnpDeviceCountWithContext", ctx, getPnpDeviceCountQueryParams)
+	if m.GetPnpDeviceCountWithContextFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.GetPnpDeviceCountWithContext called but GetPnpDeviceCountWithContextFunc is not set")
+	}
+	return m.GetPnpDeviceCountWithContextFunc(ctx, getPnpDeviceCountQueryParams)
+}
+
+// GetPnpDeviceList calls GetPnpDeviceListFunc.
+func (m *DeviceOnboardingPnPAPI) GetPnpDeviceList(getPnpDeviceListQueryParams *dnac.GetPnpDeviceListQueryParams) (*[]dnac.GetPnpDeviceListResponse, *resty.Response, error) {
+	m.record("GetPnpDeviceList", getPnpDeviceListQueryParams)
+	if m.GetPnpDeviceListFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.GetPnpDeviceList called but GetPnpDeviceListFunc is not set")
+	}
+	return m.GetPnpDeviceListFunc(getPnpDeviceListQueryParams)
+}
+
+// GetPnpDeviceListWithContext calls GetPnpDeviceListWithContextFunc.
+func (m *DeviceOnboardingPnPAPI) GetPnpDeviceListWithContext(ctx context.Context, getPnpDeviceListQueryParams *dnac.GetPnpDeviceListQueryParams) (*[]dnac.GetPnpDeviceListResponse, *resty.Response, error) {
+	m.record("GetPnpDeviceListWithContext", ctx, getPnpDeviceListQueryParams)
+	if m.GetPnpDeviceListWithContextFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.GetPnpDeviceListWithContext called but GetPnpDeviceListWithContextFunc is not set")
+	}
+	return m.GetPnpDeviceListWithContextFunc(ctx, getPnpDeviceListQueryParams)
+}
+
+// GetSmartAccountList calls GetSmartAccountListFunc.
+func (m *DeviceOnboardingPnPAPI) GetSmartAccountList() (*dnac.GetSmartAccountListResponse, *resty.Response, error) {
+	m.record("GetSmartAccountList")
+	if m.GetSmartAccountListFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.GetSmartAccountList called but GetSmartAccountListFunc is not set")
+	}
+	return m.GetSmartAccountListFunc()
+}
+
+// GetSmartAccountListWithContext calls GetSmartAccountListWithContextFunc.
+func (m *DeviceOnboardingPnPAPI) GetSmartAccountListWithContext(ctx context.Context) (*dnac.GetSmartAccountListResponse, *resty.Response, error) {
+	m.record("GetSmartAccountListWithContext", ctx)
+	if m.GetSmartAccountListWithContextFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.GetSmartAccountListWithContext called but GetSmartAccountListWithContextFunc is not set")
+	}
+	return m.GetSmartAccountListWithContextFunc(ctx)
+}
+
+// GetSyncResultForVirtualAccount calls GetSyncResultForVirtualAccountFunc.
+func (m *DeviceOnboardingPnPAPI) GetSyncResultForVirtualAccount(domain string, name string) (*dnac.GetSyncResultForVirtualAccountResponse, *resty.Response, error) {
+	m.record("GetSyncResultForVirtualAccount", domain, name)
+	if m.GetSyncResultForVirtualAccountFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.GetSyncResultForVirtualAccount called but GetSyncResultForVirtualAccountFunc is not set")
+	}
+	return m.GetSyncResultForVirtualAccountFunc(domain, name)
+}
+
+// GetSyncResultForVirtualAccountWithContext calls GetSyncResultForVirtualAccountWithContextFunc.
+func (m *DeviceOnboardingPnPAPI) GetSyncResultForVirtualAccountWithContext(ctx context.Context, domain string, name string) (*dnac.GetSyncResultForVirtualAccountResponse, *resty.Response, error) {
+	m.record("GetSyncResultForVirtualAccountWithContext", ctx, domain, name)
+	if m.GetSyncResultForVirtualAccountWithContextFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.GetSyncResultForVirtualAccountWithContext called but GetSyncResultForVirtualAccountWithContextFunc is not set")
+	}
+	return m.GetSyncResultForVirtualAccountWithContextFunc(ctx, domain, name)
+}
+
+// GetVirtualAccountList calls GetVirtualAccountListFunc.
+func (m *DeviceOnboardingPnPAPI) GetVirtualAccountList(domain string) (*dnac.GetVirtualAccountListResponse, *resty.Response, error) {
+	m.record("GetVirtualAccountList", domain)
+	if m.GetVirtualAccountListFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.GetVirtualAccountList called but GetVirtualAccountListFunc is not set")
+	}
+	return m.GetVirtualAccountListFunc(domain)
+}
+
+// GetVirtualAccountListWithContext calls GetVirtualAccountListWithContextFunc.
+func (m *DeviceOnboardingPnPAPI) GetVirtualAccountListWithContext(ctx context.Context, domain string) (*dnac.GetVirtualAccountListResponse, *resty.Response, error) {
+	m.record("GetVirtualAccountListWithContext", ctx, domain)
+	if m.GetVirtualAccountListWithContextFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.GetVirtualAccountListWithContext called but GetVirtualAccountListWithContextFunc is not set")
+	}
+	return m.GetVirtualAccountListWithContextFunc(ctx, domain)
+}
+
+// GetWorkflowByID calls GetWorkflowByIDFunc.
+func (m *DeviceOnboardingPnPAPI) GetWorkflowByID(id string) (*dnac.GetWorkflowByIDResponse, *resty.Response, error) {
+	m.record("GetWorkflowByID", id)
+	if m.GetWorkflowByIDFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.GetWorkflowByID called but GetWorkflowByIDFunc is not set")
+	}
+	return m.GetWorkflowByIDFunc(id)
+}
+
+// GetWorkflowByIDWithContext calls GetWorkflowByIDWithContextFunc.
+func (m *DeviceOnboardingPnPAPI) GetWorkflowByIDWithContext(ctx context.Context, id string) (*dnac.GetWorkflowByIDResponse, *resty.Response, error) {
+	m.record("GetWorkflowByIDWithContext", ctx, id)
+	if m.GetWorkflowByIDWithContextFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.GetWorkflowByIDWithContext called but GetWorkflowByIDWithContextFunc is not set")
+	}
+	return m.GetWorkflowByIDWithContextFunc(ctx, id)
+}
+
+// GetWorkflowCount calls GetWorkflowCountFunc.
+func (m *DeviceOnboardingPnPAPI) GetWorkflowCount(getWorkflowCountQueryParams *dnac.GetWorkflowCountQueryParams) (*dnac.GetWorkflowCountResponse, *resty.Response, error) {
+	m.record("GetWorkflowCount", getWorkflowCountQueryParams)
+	if m.GetWorkflowCountFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.GetWorkflowCount called but GetWorkflowCountFunc is not set")
+	}
+	return m.GetWorkflowCountFunc(getWorkflowCountQueryParams)
+}
+
+// GetWorkflowCountWithContext calls GetWorkflowCountWithContextFunc.
+func (m *DeviceOnboardingPnPAPI) GetWorkflowCountWithContext(ctx context.Context, getWorkflowCountQueryParams *dnac.GetWorkflowCountQueryParams) (*dnac.GetWorkflowCountResponse, *resty.Response, error) {
+	m.record("GetWorkflowCountWithContext", ctx, getWorkflowCountQueryParams)
+	if m.GetWorkflowCountWithContextFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.GetWorkflowCountWithContext called but GetWorkflowCountWithContextFunc is not set")
+	}
+	return m.GetWorkflowCountWithContextFunc(ctx, getWorkflowCountQueryParams)
+}
+
+// GetWorkflows calls GetWorkflowsFunc.
+func (m *DeviceOnboardingPnPAPI) GetWorkflows(getWorkflowsQueryParams *dnac.GetWorkflowsQueryParams) (*[]dnac.GetWorkflowsResponse, *resty.Response, error) {
+	m.record("GetWorkflows", getWorkflowsQueryParams)
+	if m.GetWorkflowsFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.GetWorkflows called but GetWorkflowsFunc is not set")
+	}
+	return m.GetWorkflowsFunc(getWorkflowsQueryParams)
+}
+
+// GetWorkflowsWithContext calls GetWorkflowsWithContextFunc.
+func (m *DeviceOnboardingPnPAPI) GetWorkflowsWithContext(ctx context.Context, getWorkflowsQueryParams *dnac.GetWorkflowsQueryParams) (*[]dnac.GetWorkflowsResponse, *resty.Response, error) {
+	m.record("GetWorkflowsWithContext", ctx, getWorkflowsQueryParams)
+	if m.GetWorkflowsWithContextFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.GetWorkflowsWithContext called but GetWorkflowsWithContextFunc is not set")
+	}
+	return m.GetWorkflowsWithContextFunc(ctx, getWorkflowsQueryParams)
+}
+
+// ImportDevicesInBulk calls ImportDevicesInBulkFunc.
+func (m *DeviceOnboardingPnPAPI) ImportDevicesInBulk(importDevicesInBulkRequest *dnac.ImportDevicesInBulkRequest) (*dnac.ImportDevicesInBulkResponse, *resty.Response, error) {
+	m.record("ImportDevicesInBulk", importDevicesInBulkRequest)
+	if m.ImportDevicesInBulkFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.ImportDevicesInBulk called but ImportDevicesInBulkFunc is not set")
+	}
+	return m.ImportDevicesInBulkFunc(importDevicesInBulkRequest)
+}
+
+// ImportDevicesInBulkWithContext calls ImportDevicesInBulkWithContextFunc.
+func (m *DeviceOnboardingPnPAPI) ImportDevicesInBulkWithContext(ctx context.Context, importDevicesInBulkRequest *dnac.ImportDevicesInBulkRequest) (*dnac.ImportDevicesInBulkResponse, *resty.Response, error) {
+	m.record("ImportDevicesInBulkWithContext", ctx, importDevicesInBulkRequest)
+	if m.ImportDevicesInBulkWithContextFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.ImportDevicesInBulkWithContext called but ImportDevicesInBulkWithContextFunc is not set")
+	}
+	return m.ImportDevicesInBulkWithContextFunc(ctx, importDevicesInBulkRequest)
+}
+
+// ListAllDevices calls ListAllDevicesFunc.
+func (m *DeviceOnboardingPnPAPI) ListAllDevices(ctx context.Context, params *dnac.GetPnpDeviceListQueryParams, opts *dnac.PageOptions) *dnac.PnpDeviceIterator {
+	m.record("ListAllDevices", ctx, params, opts)
+	if m.ListAllDevicesFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.ListAllDevices called but ListAllDevicesFunc is not set")
+	}
+	return m.ListAllDevicesFunc(ctx, params, opts)
+}
+
+// PreviewConfig calls PreviewConfigFunc.
+func (m *DeviceOnboardingPnPAPI) PreviewConfig(previewConfigRequest *dnac.PreviewConfigRequest) (*dnac.PreviewConfigResponse, *resty.Response, error) {
+	m.record("PreviewConfig", previewConfigRequest)
+	if m.PreviewConfigFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.PreviewConfig called but PreviewConfigFunc is not set")
+	}
+	return m.PreviewConfigFunc(previewConfigRequest)
+}
+
+// PreviewConfigWithContext calls PreviewConfigWithContextFunc.
+func (m *DeviceOnboardingPnPAPI) PreviewConfigWithContext(ctx context.Context, previewConfigRequest *dnac.PreviewConfigRequest) (*dnac.PreviewConfigResponse, *resty.Response, error) {
+	m.record("PreviewConfigWithContext", ctx, previewConfigRequest)
+	if m.PreviewConfigWithContextFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.PreviewConfigWithContext called but PreviewConfigWithContextFunc is not set")
+	}
+	return m.PreviewConfigWithContextFunc(ctx, previewConfigRequest)
+}
+
+// ResetDevice calls ResetDeviceFunc.
+func (m *DeviceOnboardingPnPAPI) ResetDevice(resetDeviceRequest *dnac.ResetDeviceRequest) (*dnac.ResetDeviceResponse, *resty.Response, error) {
+	m.record("ResetDevice", resetDeviceRequest)
+	if m.ResetDeviceFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.ResetDevice called but ResetDeviceFunc is not set")
+	}
+	return m.ResetDeviceFunc(resetDeviceRequest)
+}
+
+// ResetDeviceWithContext calls ResetDeviceWithContextFunc.
+func (m *DeviceOnboardingPnPAPI) ResetDeviceWithContext(ctx context.Context, resetDeviceRequest *dnac.ResetDeviceRequest) (*dnac.ResetDeviceResponse, *resty.Response, error) {
+	m.record("ResetDeviceWithContext", ctx, resetDeviceRequest)
+	if m.ResetDeviceWithContextFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.ResetDeviceWithContext called but ResetDeviceWithContextFunc is not set")
+	}
+	return m.ResetDeviceWithContextFunc(ctx, resetDeviceRequest)
+}
+
+// SyncVirtualAccountDevices calls SyncVirtualAccountDevicesFunc.
+func (m *DeviceOnboardingPnPAPI) SyncVirtualAccountDevices(syncVirtualAccountDevicesRequest *dnac.SyncVirtualAccountDevicesRequest) (*dnac.SyncVirtualAccountDevicesResponse, *resty.Response, error) {
+	m.record("SyncVirtualAccountDevices", syncVirtualAccountDevicesRequest)
+	if m.SyncVirtualAccountDevicesFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.SyncVirtualAccountDevices called but SyncVirtualAccountDevicesFunc is not set")
+	}
+	return m.SyncVirtualAccountDevicesFunc(syncVirtualAccountDevicesRequest)
+}
+
+// SyncVirtualAccountDevicesWithContext calls SyncVirtualAccountDevicesWithContextFunc.
+func (m *DeviceOnboardingPnPAPI) SyncVirtualAccountDevicesWithContext(ctx context.Context, syncVirtualAccountDevicesRequest *dnac.SyncVirtualAccountDevicesRequest) (*dnac.SyncVirtualAccountDevicesResponse, *resty.Response, error) {
+	m.record("SyncVirtualAccountDevicesWithContext", ctx, syncVirtualAccountDevicesRequest)
+	if m.SyncVirtualAccountDevicesWithContextFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.SyncVirtualAccountDevicesWithContext called but SyncVirtualAccountDevicesWithContextFunc is not set")
+	}
+	return m.SyncVirtualAccountDevicesWithContextFunc(ctx, syncVirtualAccountDevicesRequest)
+}
+
+// UnClaimDevice calls UnClaimDeviceFunc.
+func (m *DeviceOnboardingPnPAPI) UnClaimDevice(unClaimDeviceRequest *dnac.UnClaimDeviceRequest) (*dnac.UnClaimDeviceResponse, *resty.Response, error) {
+	m.record("UnClaimDevice", unClaimDeviceRequest)
+	if m.UnClaimDeviceFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.UnClaimDevice called but UnClaimDeviceFunc is not set")
+	}
+	return m.UnClaimDeviceFunc(unClaimDeviceRequest)
+}
+
+// UnClaimDeviceWithContext calls UnClaimDeviceWithContextFunc.
+func (m *DeviceOnboardingPnPAPI) UnClaimDeviceWithContext(ctx context.Context, unClaimDeviceRequest *dnac.UnClaimDeviceRequest) (*dnac.UnClaimDeviceResponse, *resty.Response, error) {
+	m.record("UnClaimDeviceWithContext", ctx, unClaimDeviceRequest)
+	if m.UnClaimDeviceWithContextFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.UnClaimDeviceWithContext called but UnClaimDeviceWithContextFunc is not set")
+	}
+	return m.UnClaimDeviceWithContextFunc(ctx, unClaimDeviceRequest)
+}
+
+// UpdateDevice calls UpdateDeviceFunc.
+func (m *DeviceOnboardingPnPAPI) UpdateDevice(id string, updateDeviceRequest *dnac.UpdateDeviceRequest) (*dnac.UpdateDeviceResponse, *resty.Response, error) {
+	m.record("UpdateDevice", id, updateDeviceRequest)
+	if m.UpdateDeviceFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.UpdateDevice called but UpdateDeviceFunc is not set")
+	}
+	return m.UpdateDeviceFunc(id, updateDeviceRequest)
+}
+
+// UpdateDeviceWithContext calls UpdateDeviceWithContextFunc.
+func (m *DeviceOnboardingPnPAPI) UpdateDeviceWithContext(ctx context.Context, id string, updateDeviceRequest *dnac.UpdateDeviceRequest) (*dnac.UpdateDeviceResponse, *resty.Response, error) {
+	m.record("UpdateDeviceWithContext", ctx, id, updateDeviceRequest)
+	if m.UpdateDeviceWithContextFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.UpdateDeviceWithContext called but UpdateDeviceWithContextFunc is not set")
+	}
+	return m.UpdateDeviceWithContextFunc(ctx, id, updateDeviceRequest)
+}
+
+// UpdatePnPGlobalSettings calls UpdatePnPGlobalSettingsFunc.
+func (m *DeviceOnboardingPnPAPI) UpdatePnPGlobalSettings(updatePnPGlobalSettingsRequest *dnac.UpdatePnPGlobalSettingsRequest) (*dnac.UpdatePnPGlobalSettingsResponse, *resty.Response, error) {
+	m.record("UpdatePnPGlobalSettings", updatePnPGlobalSettingsRequest)
+	if m.UpdatePnPGlobalSettingsFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.UpdatePnPGlobalSettings called but UpdatePnPGlobalSettingsFunc is not set")
+	}
+	return m.UpdatePnPGlobalSettingsFunc(updatePnPGlobalSettingsRequest)
+}
+
+// UpdatePnPGlobalSettingsWithContext calls UpdatePnPGlobalSettingsWithContextFunc.
+func (m *DeviceOnboardingPnPAPI) UpdatePnPGlobalSettingsWithContext(ctx context.Context, updatePnPGlobalSettingsRequest *dnac.UpdatePnPGlobalSettingsRequest) (*dnac.UpdatePnPGlobalSettingsResponse, *resty.Response, error) {
+	m.record("UpdatePnPGlobalSettingsWithContext", ctx, updatePnPGlobalSettingsRequest)
+	if m.UpdatePnPGlobalSettingsWithContextFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.UpdatePnPGlobalSettingsWithContext called but UpdatePnPGlobalSettingsWithContextFunc is not set")
+	}
+	return m.UpdatePnPGlobalSettingsWithContextFunc(ctx, updatePnPGlobalSettingsRequest)
+}
+
+// UpdatePnPServerProfile calls UpdatePnPServerProfileFunc.
+func (m *DeviceOnboardingPnPAPI) UpdatePnPServerProfile(updatePnPServerProfileRequest *dnac.UpdatePnPServerProfileRequest) (*dnac.UpdatePnPServerProfileResponse, *resty.Response, error) {
+	m.record("UpdatePnPServerProfile", updatePnPServerProfileRequest)
+	if m.UpdatePnPServerProfileFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.UpdatePnPServerProfile called but UpdatePnPServerProfileFunc is not set")
+	}
+	return m.UpdatePnPServerProfileFunc(updatePnPServerProfileRequest)
+}
+
+// UpdatePnPServerProfileWithContext calls UpdatePnPServerProfileWithContextFunc.
+func (m *DeviceOnboardingPnPAPI) UpdatePnPServerProfileWithContext(ctx context.Context, updatePnPServerProfileRequest *dnac.UpdatePnPServerProfileRequest) (*dnac.UpdatePnPServerProfileResponse, *resty.Response, error) {
+	m.record("UpdatePnPServerProfileWithContext", ctx, updatePnPServerProfileRequest)
+	if m.UpdatePnPServerProfileWithContextFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.UpdatePnPServerProfileWithContext called but UpdatePnPServerProfileWithContextFunc is not set")
+	}
+	return m.UpdatePnPServerProfileWithContextFunc(ctx, updatePnPServerProfileRequest)
+}
+
+// UpdateWorkflow calls UpdateWorkflowFunc.
+func (m *DeviceOnboardingPnPAPI) UpdateWorkflow(id string, updateWorkflowRequest *dnac.UpdateWorkflowRequest) (*dnac.UpdateWorkflowResponse, *resty.Response, error) {
+	m.record("UpdateWorkflow", id, updateWorkflowRequest)
+	if m.UpdateWorkflowFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.UpdateWorkflow called but UpdateWorkflowFunc is not set")
+	}
+	return m.UpdateWorkflowFunc(id, updateWorkflowRequest)
+}
+
+// UpdateWorkflowWithContext calls UpdateWorkflowWithContextFunc.
+func (m *DeviceOnboardingPnPAPI) UpdateWorkflowWithContext(ctx context.Context, id string, updateWorkflowRequest *dnac.UpdateWorkflowRequest) (*dnac.UpdateWorkflowResponse, *resty.Response, error) {
+	m.record("UpdateWorkflowWithContext", ctx, id, updateWorkflowRequest)
+	if m.UpdateWorkflowWithContextFunc == nil {
+		panic("dnacmock: DeviceOnboardingPnPAPI.UpdateWorkflowWithContext called but UpdateWorkflowWithContextFunc is not set")
+	}
+	return m.UpdateWorkflowWithContextFunc(ctx, id, updateWorkflowRequest)
+}
+
+// ConfigurationTemplatesAPI is a mock of dnac.ConfigurationTemplatesAPI. Each method calls the function of the
+// same name suffixed with Func, which must be set if the method is called.
+type ConfigurationTemplatesAPI struct {
+	Recorder
+
+	CreateProjectFunc                          func(createProjectRequest *dnac.CreateProjectRequest) (*dnac.CreateProjectResponse, *resty.Response, error)
+	CreateProjectWithContextFunc               func(ctx context.Context, createProjectRequest *dnac.CreateProjectRequest) (*dnac.CreateProjectResponse, *resty.Response, error)
+	CreateTemplateFunc                         func(projectID string, createTemplateRequest *dnac.CreateTemplateRequest) (*dnac.CreateTemplateResponse, *resty.Response, error)
+	CreateTemplateWithContextFunc              func(ctx context.Context, projectID string, createTemplateRequest *dnac.CreateTemplateRequest) (*dnac.CreateTemplateResponse, *resty.Response, error)
+	DeleteProjectFunc                          func(projectID string) (*dnac.DeleteProjectResponse, *resty.Response, error)
+	DeleteProjectWithContextFunc               func(ctx context.Context, projectID string) (*dnac.DeleteProjectResponse, *resty.Response, error)
+	DeleteTemplateFunc                         func(templateID string) (*dnac.DeleteTemplateResponse, *resty.Response, error)
+	DeleteTemplateWithContextFunc              func(ctx context.Context, templateID string) (*dnac.DeleteTemplateResponse, *resty.Response, error)
+	DeployTemplateFunc                         func(deployTemplateRequest *dnac.DeployTemplateRequest) (*dnac.DeployTemplateResponse, *resty.Response, error)
+	DeployTemplateCheckedFunc                  func(ctx context.Context, request *dnac.DeployTemplateRequest) (*dnac.DeployTemplateResponse, *resty.Response, error)
+	DeployTemplateWithContextFunc              func(ctx context.Context, deployTemplateRequest *dnac.DeployTemplateRequest) (*dnac.DeployTemplateResponse, *resty.Response, error)
+	GetProjectsFunc                            func(getProjectsQueryParams *dnac.GetProjectsQueryParams) (*[]dnac.GetProjectsResponse, *resty.Response, error)
+	GetProjectsWithContextFunc                 func(ctx context.Context, getProjectsQueryParams *dnac.GetProjectsQueryParams) (*[]dnac.GetProjectsResponse, *resty.Response, error)
+	GetTemplateDeploymentStatusFunc            func(deploymentID string) (*dnac.GetTemplateDeploymentStatusResponse, *resty.Response, error)
+	GetTemplateDeploymentStatusWithContextFunc func(ctx context.Context, deploymentID string) (*dnac.GetTemplateDeploymentStatusResponse, *resty.Response, error)
+	GetTemplateDetailsFunc                     func(templateID string, getTemplateDetailsQueryParams *dnac.GetTemplateDetailsQueryParams) (*dnac.GetTemplateDetailsResponse, *resty.Response, error)
+	GetTemplateDetailsWithContextFunc          func(ctx context.Context, templateID string, getTemplateDetailsQueryParams *dnac.GetTemplateDetailsQueryParams) (*dnac.GetTemplateDetailsResponse, *resty.Response, error)
+	GetTemplateVersionsFunc                    func(templateID string) (*[]dnac.GetTemplateVersionsResponse, *resty.Response, error)
+	GetTemplateVersionsWithContextFunc         func(ctx context.Context, templateID string) (*[]dnac.GetTemplateVersionsResponse, *resty.Response, error)
+	GetsTheTemplatesAvailableFunc              func(getsTheTemplatesAvailableQueryParams *dnac.GetsTheTemplatesAvailableQueryParams) (*[]dnac.GetsTheTemplatesAvailableResponse, *resty.Response, error)
+	GetsTheTemplatesAvailableWithContextFunc   func(ctx context.Context, getsTheTemplatesAvailableQueryParams *dnac.GetsTheTemplatesAvailableQueryParams) (*[]dnac.GetsTheTemplatesAvailableResponse, *resty.Response, error)
+	PreviewTemplateFunc                        func(previewTemplateRequest *dnac.PreviewTemplateRequest) (*dnac.PreviewTemplateResponse, *resty.Response, error)
+	PreviewTemplateCheckedFunc                 func(ctx context.Context, request *dnac.PreviewTemplateRequest) (*dnac.PreviewTemplateResponse, *resty.Response, error)
+	PreviewTemplateWithContextFunc             func(ctx context.Context, previewTemplateRequest *dnac.PreviewTemplateRequest) (*dnac.PreviewTemplateResponse, *resty.Response, error)
+	UpdateProjectFunc                          func(updateProjectRequest *dnac.UpdateProjectRequest) (*dnac.UpdateProjectResponse, *resty.Response, error)
+	UpdateProjectWithContextFunc               func(ctx context.Context, updateProjectRequest *dnac.UpdateProjectRequest) (*dnac.UpdateProjectResponse, *resty.Response, error)
+	UpdateTemplateFunc                         func(updateTemplateRequest *dnac.UpdateTemplateRequest) (*dnac.UpdateTemplateResponse, *resty.Response, error)
+	UpdateTemplateWithContextFunc              func(ctx context.Context, updateTemplateRequest *dnac.UpdateTemplateRequest) (*dnac.UpdateTemplateResponse, *resty.Response, error)
+	ValidateDeployTemplateFunc                 func(ctx context.Context, request *dnac.DeployTemplateRequest) error
+	ValidatePreviewTemplateFunc                func(ctx context.Context, request *dnac.PreviewTemplateRequest) error
+	VersionTemplateFunc                        func(versionTemplateRequest *dnac.VersionTemplateRequest) (*dnac.VersionTemplateResponse, *resty.Response, error)
+	VersionTemplateWithContextFunc             func(ctx context.Context, versionTemplateRequest *dnac.VersionTemplateRequest) (*dnac.VersionTemplateResponse, *resty.Response, error)
+}
+
+// CreateProject calls CreateProjectFunc.
+func (m *ConfigurationTemplatesAPI) CreateProject(createProjectRequest *dnac.CreateProjectRequest) (*dnac.CreateProjectResponse, *resty.Response, error) {
+	m.record("CreateProject", createProjectRequest)
+	if m.CreateProjectFunc == nil {
+		panic("dnacmock: ConfigurationTemplatesAPI.CreateProject called but CreateProjectFunc is not set")
+	}
+	return m.CreateProjectFunc(createProjectRequest)
+}
+
+// CreateProjectWithContext calls CreateProjectWithContextFunc.
+func (m *ConfigurationTemplatesAPI) CreateProjectWithContext(ctx context.Context, createProjectRequest *dnac.CreateProjectRequest) (*dnac.CreateProjectResponse, *resty.Response, error) {
+	m.record("CreateProjectWithContext", ctx, createProjectRequest)
+	if m.CreateProjectWithContextFunc == nil {
+		panic("dnacmock: ConfigurationTemplatesAPI.CreateProjectWithContext called but CreateProjectWithContextFunc is not set")
+	}
+	return m.CreateProjectWithContextFunc(ctx, createProjectRequest)
+}
+
+// CreateTemplate calls CreateTemplateFunc.
+func (m *ConfigurationTemplatesAPI) CreateTemplate(projectID string, createTemplateRequest *dnac.CreateTemplateRequest) (*dnac.CreateTemplateResponse, *resty.Response, error) {
+	m.record("CreateTemplate", projectID, createTemplateRequest)
+	if m.CreateTemplateFunc == nil {
+		panic("dnacmock: ConfigurationTemplatesAPI.CreateTemplate called but CreateTemplateFunc is not set")
+	}
+	return m.CreateTemplateFunc(projectID, createTemplateRequest)
+}
+
+// CreateTemplateWithContext calls CreateTemplateWithContextFunc.
+func (m *ConfigurationTemplatesAPI) CreateTemplateWithContext(ctx context.Context, projectID string, createTemplateRequest *dnac.CreateTemplateRequest) (*dnac.CreateTemplateResponse, *resty.Response, error) {
+	m.record("CreateTemplateWithContext", ctx, projectID, createTemplateRequest)
+	if m.CreateTemplateWithContextFunc == nil {
+		panic("dnacmock: ConfigurationTemplatesAPI.CreateTemplateWithContext called but CreateTemplateWithContextFunc is not set")
+	}
+	return m.CreateTemplateWithContextFunc(ctx, projectID, createTemplateRequest)
+}
+
+// DeleteProject calls DeleteProjectFunc.
+func (m *ConfigurationTemplatesAPI) DeleteProject(projectID string) (*dnac.DeleteProjectResponse, *resty.Response, error) {
+	m.record("DeleteProject", projectID)
+	if m.DeleteProjectFunc == nil {
+		panic("dnacmock: ConfigurationTemplatesAPI.DeleteProject called but DeleteProjectFunc is not set")
+	}
+	return m.DeleteProjectFunc(projectID)
+}
+
+// DeleteProjectWithContext calls DeleteProjectWithContextFunc.
+func (m *ConfigurationTemplatesAPI) DeleteProjectWithContext(ctx context.Context, projectID string) (*dnac.DeleteProjectResponse, *resty.Response, error) {
+	m.record("DeleteProjectWithContext", ctx, projectID)
+	if m.DeleteProjectWithContextFunc == nil {
+		panic("dnacmock: ConfigurationTemplatesAPI.DeleteProjectWithContext called but DeleteProjectWithContextFunc is not set")
+	}
+	return m.DeleteProjectWithContextFunc(ctx, projectID)
+}
+
+// DeleteTemplate calls DeleteTemplateFunc.
+func (m *ConfigurationTemplatesAPI) DeleteTemplate(templateID string) (*dnac.DeleteTemplateResponse, *resty.Response, error) {
+	m.record("DeleteTemplate", templateID)
+	if m.DeleteTemplateFunc == nil {
+		panic("dnacmock: ConfigurationTemplatesAPI.DeleteTemplate called but DeleteTemplateFunc is not set")
+	}
+	return m.DeleteTemplateFunc(templateID)
+}
+
+// DeleteTemplateWithContext calls DeleteTemplateWithContextFunc.
+func (m *ConfigurationTemplatesAPI) DeleteTemplateWithContext(ctx context.Context, templateID string) (*dnac.DeleteTemplateResponse, *resty.Response, error) {
+	m.record("DeleteTemplateWithContext", ctx, templateID)
+	if m.DeleteTemplateWithContextFunc == nil {
+		panic("dnacmock: ConfigurationTemplatesAPI.DeleteTemplateWithContext called but DeleteTemplateWithContextFunc is not set")
+	}
+	return m.DeleteTemplateWithContextFunc(ctx, templateID)
+}
+
+// DeployTemplate calls DeployTemplateFunc.
+func (m *ConfigurationTemplatesAPI) DeployTemplate(deployTemplateRequest *dnac.DeployTemplateRequest) (*dnac.DeployTemplateResponse, *resty.Response, error) {
+	m.record("DeployTemplate", deployTemplateRequest)
+	if m.DeployTemplateFunc == nil {
+		panic("dnacmock: ConfigurationTemplatesAPI.DeployTemplate called but DeployTemplateFunc is not set")
+	}
+	return m.DeployTemplateFunc(deployTemplateRequest)
+}
+
+// DeployTemplateChecked calls DeployTemplateCheckedFunc.
+func (m *ConfigurationTemplatesAPI) DeployTemplateChecked(ctx context.Context, request *dnac.DeployTemplateRequest) (*dnac.DeployTemplateResponse, *resty.Response, error) {
+	m.record("DeployTemplateChecked", ctx, request)
+	if m.DeployTemplateCheckedFunc == nil {
+		panic("dnacmock: ConfigurationTemplatesAPI.DeployTemplateChecked called but DeployTemplateCheckedFunc is not set")
+	}
+	return m.DeployTemplateCheckedFunc(ctx, request)
+}
+
+// DeployTemplateWithContext calls DeployTemplateWithContextFunc.
+func (m *ConfigurationTemplatesAPI) DeployTemplateWithContext(ctx context.Context, deployTemplateRequest *dnac.DeployTemplateRequest) (*dnac.DeployTemplateResponse, *resty.Response, error) {
+	m.record("DeployTemplateWithContext", ctx, deployTemplateRequest)
+	if m.DeployTemplateWithContextFunc == nil {
+		panic("dnacmock: ConfigurationTemplatesAPI.DeployTemplateWithContext called but DeployTemplateWithContextFunc is not set")
+	}
+	return m.DeployTemplateWithContextFunc(ctx, deployTemplateRequest)
+}
+
+// GetProjects calls GetProjectsFunc.
+func (m *ConfigurationTemplatesAPI) GetProjects(getProjectsQueryParams *dnac.GetProjectsQueryParams) (*[]dnac.GetProjectsResponse, *resty.Response, error) {
+	m.record("GetProjects", getProjectsQueryParams)
+	if m.GetProjectsFunc == nil {
+		panic("dnacmock: ConfigurationTemplatesAPI.GetProjects called but GetProjectsFunc is not set")
+	}
+	return m.GetProjectsFunc(getProjectsQueryParams)
+}
+
+// GetProjectsWithContext calls GetProjectsWithContextFunc.
+func (m *ConfigurationTemplatesAPI) GetProjectsWithContext(ctx context.Context, getProjectsQueryParams *dnac.GetProjectsQueryParams) (*[]dnac.GetProjectsResponse, *resty.Response, error) {
+	m.record("GetProjectsWithContext", ctx, getProjectsQueryParams)
+	if m.GetProjectsWithContextFunc == nil {
+		panic("dnacmock: ConfigurationTemplatesAPI.GetProjectsWithContext called but GetProjectsWithContextFunc is not set")
+	}
+	return m.GetProjectsWithContextFunc(ctx, getProjectsQueryParams)
+}
+
+// GetTemplateDeploymentStatus calls GetTemplateDeploymentStatusFunc.
+func (m *ConfigurationTemplatesAPI) GetTemplateDeploymentStatus(deploymentID string) (*dnac.GetTemplateDeploymentStatusResponse, *resty.Response, error) {
+	m.record("GetTemplateDeploymentStatus", deploymentID)
+	if m.GetTemplateDeploymentStatusFunc == nil {
+		panic("dnacmock: ConfigurationTemplatesAPI.GetTemplateDeploymentStatus called but GetTemplateDeploymentStatusFunc is not set")
+	}
+	return m.GetTemplateDeploymentStatusFunc(deploymentID)
+}
+
+// GetTemplateDeploymentStatusWithContext calls GetTemplateDeploymentStatusWithContextFunc.
+func (m *ConfigurationTemplatesAPI) GetTemplateDeploymentStatusWithContext(ctx context.Context, deploymentID string) (*dnac.GetTemplateDeploymentStatusResponse, *resty.Response, error) {
+	m.record("GetTemplateDeploymentStatusWithContext", ctx, deploymentID)
+	if m.GetTemplateDeploymentStatusWithContextFunc == nil {
+		panic("dnacmock: ConfigurationTemplatesAPI.GetTemplateDeploymentStatusWithContext called but GetTemplateDeploymentStatusWithContextFunc is not set")
+	}
+	return m.GetTemplateDeploymentStatusWithContextFunc(ctx, deploymentID)
+}
+
+// GetTemplateDetails calls GetTemplateDetailsFunc.
+func (m *ConfigurationTemplatesAPI) GetTemplateDetails(templateID string, getTemplateDetailsQueryParams *dnac.GetTemplateDetailsQueryParams) (*dnac.GetTemplateDetailsResponse, *resty.Response, error) {
+	m.record("GetTemplateDetails", templateID, getTemplateDetailsQueryParams)
+	if m.GetTemplateDetailsFunc == nil {
+		panic("dnacmock: ConfigurationTemplatesAPI.GetTemplateDetails called but GetTemplateDetailsFunc is not set")
+	}
+	return m.GetTemplateDetailsFunc(templateID, getTemplateDetailsQueryParams)
+}
+
+// GetTemplateDetailsWithContext calls GetTemplateDetailsWithContextFunc.
+func (m *ConfigurationTemplatesAPI) GetTemplateDetailsWithContext(ctx context.Context, templateID string, getTemplateDetailsQueryParams *dnac.GetTemplateDetailsQueryParams) (*dnac.GetTemplateDetailsResponse, *resty.Response, error) {
+	m.record("GetTemplateDetailsWithContext", ctx, templateID, getTemplateDetailsQueryParams)
+	if m.GetTemplateDetailsWithContextFunc == nil {
+		panic("dnacmock: ConfigurationTemplatesAPI.GetTemplateDetailsWithContext called but GetTemplateDetailsWithContextFunc is not set")
+	}
+	return m.GetTemplateDetailsWithContextFunc(ctx, templateID, getTemplateDetailsQueryParams)
+}
+
+// GetTemplateVersions calls GetTemplateVersionsFunc.
+func (m *ConfigurationTemplatesAPI) GetTemplateVersions(templateID string) (*[]dnac.GetTemplateVersionsResponse, *resty.Response, error) {
+	m.record("GetTemplateVersions", templateID)
+	if m.GetTemplateVersionsFunc == nil {
+		panic("dnacmock: ConfigurationTemplatesAPI.GetTemplateVersions called but GetTemplateVersionsFunc is not set")
+	}
+	return m.GetTemplateVersionsFunc(templateID)
+}
+
+// GetTemplateVersionsWithContext calls GetTemplateVersionsWithContextFunc.
+func (m *ConfigurationTemplatesAPI) GetTemplateVersionsWithContext(ctx context.Context, templateID string) (*[]dnac.GetTemplateVersionsResponse, *resty.Response, error) {
+	m.record("GetTemplateVersionsWithContext", ctx, templateID)
+	if m.GetTemplateVersionsWithContextFunc == nil {
+		panic("dnacmock: ConfigurationTemplatesAPI.GetTemplateVersionsWithContext called but GetTemplateVersionsWithContextFunc is not set")
+	}
+	return m.GetTemplateVersionsWithContextFunc(ctx, templateID)
+}
+
+// GetsTheTemplatesAvailable calls GetsTheTemplatesAvailableFunc.
+func (m *ConfigurationTemplatesAPI) GetsTheTemplatesAvailable(getsTheTemplatesAvailableQueryParams *dnac.GetsTheTemplatesAvailableQueryParams) (*[]dnac.GetsTheTemplatesAvailableResponse, *resty.Response, error) {
+	m.record("GetsTheTemplatesAvailable", getsTheTemplatesAvailableQueryParams)
+	if m.GetsTheTemplatesAvailableFunc == nil {
+		panic("dnacmock: ConfigurationTemplatesAPI.GetsTheTemplatesAvailable called but GetsTheTemplatesAvailableFunc is not set")
+	}
+	return m.GetsTheTemplatesAvailableFunc(getsTheTemplatesAvailableQueryParams)
+}
+
+// GetsTheTemplatesAvailableWithContext calls GetsTheTemplatesAvailableWithContextFunc.
+func (m *ConfigurationTemplatesAPI) GetsTheTemplatesAvailableWithContext(ctx context.Context, getsTheTemplatesAvailableQueryParams *dnac.GetsTheTemplatesAvailableQueryParams) (*[]dnac.GetsTheTemplatesAvailableResponse, *resty.Response, error) {
+	m.record("GetsTheTemplatesAvailableWithContext", ctx, getsTheTemplatesAvailableQueryParams)
+	if m.GetsTheTemplatesAvailableWithContextFunc == nil {
+		panic("dnacmock: ConfigurationTemplatesAPI.GetsTheTemplatesAvailableWithContext called but GetsTheTemplatesAvailableWithContextFunc is not set")
+	}
+	return m.GetsTheTemplatesAvailableWithContextFunc(ctx, getsTheTemplatesAvailableQueryParams)
+}
+
+// PreviewTemplate calls PreviewTemplateFunc.
+func (m *ConfigurationTemplatesAPI) PreviewTemplate(previewTemplateRequest *dnac.PreviewTemplateRequest) (*dnac.PreviewTemplateResponse, *resty.Response, error) {
+	m.record("PreviewTemplate", previewTemplateRequest)
+	if m.PreviewTemplateFunc == nil {
+		panic("dnacmock: ConfigurationTemplatesAPI.PreviewTemplate called but PreviewTemplateFunc is not set")
+	}
+	return m.PreviewTemplateFunc(previewTemplateRequest)
+}
+
+// PreviewTemplateChecked calls PreviewTemplateCheckedFunc.
+func (m *ConfigurationTemplatesAPI) PreviewTemplateChecked(ctx context.Context, request *dnac.PreviewTemplateRequest) (*dnac.PreviewTemplateResponse, *resty.Response, error) {
+	m.record("PreviewTemplateChecked", ctx, request)
+	if m.PreviewTemplateCheckedFunc == nil {
+		panic("dnacmock: ConfigurationTemplatesAPI.PreviewTemplateChecked called but PreviewTemplateCheckedFunc is not set")
+	}
+	return m.PreviewTemplateCheckedFunc(ctx, request)
+}
+
+// PreviewTemplateWithContext calls PreviewTemplateWithContextFunc.
+func (m *ConfigurationTemplatesAPI) PreviewTemplateWithContext(ctx context.Context, previewTemplateRequest *dnac.PreviewTemplateRequest) (*dnac.PreviewTemplateResponse, *resty.Response, error) {
+	m.record("PreviewTemplateWithContext", ctx, previewTemplateRequest)
+	if m.PreviewTemplateWithContextFunc == nil {
+		panic("dnacmock: ConfigurationTemplatesAPI.PreviewTemplateWithContext called but PreviewTemplateWithContextFunc is not set")
+	}
+	return m.PreviewTemplateWithContextFunc(ctx, previewTemplateRequest)
+}
+
+// UpdateProject calls UpdateProjectFunc.
+func (m *ConfigurationTemplatesAPI) UpdateProject(updateProjectRequest *dnac.UpdateProjectRequest) (*dnac.UpdateProjectResponse, *resty.Response, error) {
+	m.record("UpdateProject", updateProjectRequest)
+	if m.UpdateProjectFunc == nil {
+		panic("dnacmock: ConfigurationTemplatesAPI.UpdateProject called but UpdateProjectFunc is not set")
+	}
+	return m.UpdateProjectFunc(updateProjectRequest)
+}
+
+// UpdateProjectWithContext calls UpdateProjectWithContextFunc.
+func (m *ConfigurationTemplatesAPI) UpdateProjectWithContext(ctx context.Context, updateProjectRequest *dnac.UpdateProjectRequest) (*dnac.UpdateProjectResponse, *resty.Response, error) {
+	m.record("UpdateProjectWithContext", ctx, updateProjectRequest)
+	if m.UpdateProjectWithContextFunc == nil {
+		panic("dnacmock: ConfigurationTemplatesAPI.UpdateProjectWithContext called but UpdateProjectWithContextFunc is not set")
+	}
+	return m.UpdateProjectWithContextFunc(ctx, updateProjectRequest)
+}
+
+// UpdateTemplate calls UpdateTemplateFunc.
+func (m *ConfigurationTemplatesAPI) UpdateTemplate(updateTemplateRequest *dnac.UpdateTemplateRequest) (*dnac.UpdateTemplateResponse, *resty.Response, error) {
+	m.record("UpdateTemplate", updateTemplateRequest)
+	if m.UpdateTemplateFunc == nil {
+		panic("dnacmock: ConfigurationTemplatesAPI.UpdateTemplate called but UpdateTemplateFunc is not set")
+	}
+	return m.UpdateTemplateFunc(updateTemplateRequest)
+}
+
+// UpdateTemplateWithContext calls UpdateTemplateWithContextFunc.
+func (m *ConfigurationTemplatesAPI) UpdateTemplateWithContext(ctx context.Context, updateTemplateRequest *dnac.UpdateTemplateRequest) (*dnac.UpdateTemplateResponse, *resty.Response, error) {
+	m.record("UpdateTemplateWithContext", ctx, updateTemplateRequest)
+	if m.UpdateTemplateWithContextFunc == nil {
+		panic("dnacmock: ConfigurationTemplatesAPI.UpdateTemplateWithContext called but UpdateTemplateWithContextFunc is not set")
+	}
+	return m.UpdateTemplateWithContextFunc(ctx, updateTemplateRequest)
+}
+
+// ValidateDeployTemplate calls ValidateDeployTemplateFunc.
+func (m *ConfigurationTemplatesAPI) ValidateDeployTemplate(ctx context.Context, request *dnac.DeployTemplateRequest) error {
+	m.record("ValidateDeployTemplate", ctx, request)
+	if m.ValidateDeployTemplateFunc == nil {
+		panic("dnacmock: ConfigurationTemplatesAPI.ValidateDeployTemplate called but ValidateDeployTemplateFunc is not set")
+	}
+	return m.ValidateDeployTemplateFunc(ctx, request)
+}
+
+// ValidatePreviewTemplate calls ValidatePreviewTemplateFunc.
+func (m *ConfigurationTemplatesAPI) ValidatePreviewTemplate(ctx context.Context, request *dnac.PreviewTemplateRequest) error {
+	m.record("ValidatePreviewTemplate", ctx, request)
+	if m.ValidatePreviewTemplateFunc == nil {
+		panic("dnacmock: ConfigurationTemplatesAPI.ValidatePreviewTemplate called but ValidatePreviewTemplateFunc is not set")
+	}
+	return m.ValidatePreviewTemplateFunc(ctx, request)
+}
+
+// VersionTemplate calls VersionTemplateFunc.
+func (m *ConfigurationTemplatesAPI) VersionTemplate(versionTemplateRequest *dnac.VersionTemplateRequest) (*dnac.VersionTemplateResponse, *resty.Response, error) {
+	m.record("VersionTemplate", versionTemplateRequest)
+	if m.VersionTemplateFunc == nil {
+		panic("dnacmock: ConfigurationTemplatesAPI.VersionTemplate called but VersionTemplateFunc is not set")
+	}
+	return m.VersionTemplateFunc(versionTemplateRequest)
+}
+
+// VersionTemplateWithContext calls VersionTemplateWithContextFunc.
+func (m *ConfigurationTemplatesAPI) VersionTemplateWithContext(ctx context.Context, versionTemplateRequest *dnac.VersionTemplateRequest) (*dnac.VersionTemplateResponse, *resty.Response, error) {
+	m.record("VersionTemplateWithContext", ctx, versionTemplateRequest)
+	if m.VersionTemplateWithContextFunc == nil {
+		panic("dnacmock: ConfigurationTemplatesAPI.VersionTemplateWithContext called but VersionTemplateWithContextFunc is not set")
+	}
+	return m.VersionTemplateWithContextFunc(ctx, versionTemplateRequest)
+}
+
+// ConfigurationArchiveAPI is a mock of dnac.ConfigurationArchiveAPI. Each method calls the function of the
+// same name suffixed with Func, which must be set if the method is called.
+type ConfigurationArchiveAPI struct {
+	Recorder
+
+	ExportAndExtractFunc                      func(ctx context.Context, deviceIDs []string, password string, waitOpts *dnac.WaitForTaskOptions) (map[string]*dnac.DeviceConfiguration, error)
+	ExportDeviceConfigurationsFunc            func(exportDeviceConfigurationsRequest *dnac.ExportDeviceConfigurationsRequest) (*dnac.ExportDeviceConfigurationsResponse, *resty.Response, error)
+	ExportDeviceConfigurationsWithContextFunc func(ctx context.Context, exportDeviceConfigurationsRequest *dnac.ExportDeviceConfigurationsRequest) (*dnac.ExportDeviceConfigurationsResponse, *resty.Response, error)
+}
+
+// ExportAndExtract calls ExportAndExtractFunc.
+func (m *ConfigurationArchiveAPI) ExportAndExtract(ctx context.Context, deviceIDs []string, password string, waitOpts *dnac.WaitForTaskOptions) (map[string]*dnac.DeviceConfiguration, error) {
+	m.record("ExportAndExtract", ctx, deviceIDs, password, waitOpts)
+	if m.ExportAndExtractFunc == nil {
+		panic("dnacmock: ConfigurationArchiveAPI.ExportAndExtract called but ExportAndExtractFunc is not set")
+	}
+	return m.ExportAndExtractFunc(ctx, deviceIDs, password, waitOpts)
+}
+
+// ExportDeviceConfigurations calls ExportDeviceConfigurationsFunc.
+func (m *ConfigurationArchiveAPI) ExportDeviceConfigurations(exportDeviceConfigurationsRequest *dnac.ExportDeviceConfigurationsRequest) (*dnac.ExportDeviceConfigurationsResponse, *resty.Response, error) {
+	m.record("ExportDeviceConfigurations", exportDeviceConfigurationsRequest)
+	if m.ExportDeviceConfigurationsFunc == nil {
+		panic("dnacmock: ConfigurationArchiveAPI.ExportDeviceConfigurations called but ExportDeviceConfigurationsFunc is not set")
+	}
+	return m.ExportDeviceConfigurationsFunc(exportDeviceConfigurationsRequest)
+}
+
+// ExportDeviceConfigurationsWithContext calls ExportDeviceConfigurationsWithContextFunc.
+func (m *ConfigurationArchiveAPI) ExportDeviceConfigurationsWithContext(ctx context.Context, exportDeviceConfigurationsRequest *dnac.ExportDeviceConfigurationsRequest) (*dnac.ExportDeviceConfigurationsResponse, *resty.Response, error) {
+	m.record("ExportDeviceConfigurationsWithContext", ctx, exportDeviceConfigurationsRequest)
+	if m.ExportDeviceConfigurationsWithContextFunc == nil {
+		panic("dnacmock: ConfigurationArchiveAPI.ExportDeviceConfigurationsWithContext called but ExportDeviceConfigurationsWithContextFunc is not set")
+	}
+	return m.ExportDeviceConfigurationsWithContextFunc(ctx, exportDeviceConfigurationsRequest)
+}
+
+// SDAAPI is a mock of dnac.SDAAPI. Each method calls the function of the
+// same name suffixed with Func, which must be set if the method is called.
+type SDAAPI struct {
+	Recorder
+
+	AddControlPlaneDeviceInSDAFabricFunc                           func(addControlPlaneDeviceInSDAFabricRequest *[]dnac.AddControlPlaneDeviceInSDAFabricRequest) (*dnac.AddControlPlaneDeviceInSDAFabricResponse, *resty.Response, error)
+	AddControlPlaneDeviceInSDAFabricWithContextFunc                func(ctx context.Context, addControlPlaneDeviceInSDAFabricRequest *[]dnac.AddControlPlaneDeviceInSDAFabricRequest) (*dnac.AddControlPlaneDeviceInSDAFabricResponse, *resty.Response, error)
+	AddDefaultAuthenticationProfileInSDAFabricFunc                 func(addDefaultAuthenticationProfileInSDAFabricRequest *[]dnac.AddDefaultAuthenticationProfileInSDAFabricRequest) (*dnac.AddDefaultAuthenticationProfileInSDAFabricResponse, *resty.Response, error)
+	AddDefaultAuthenticationProfileInSDAFabricWithContextFunc      func(ctx context.Context, addDefaultAuthenticationProfileInSDAFabricRequest *[]dnac.AddDefaultAuthenticationProfileInSDAFabricRequest) (*dnac.AddDefaultAuthenticationProfileInSDAFabricResponse, *resty.Response, error)
+	AddEdgeDeviceInSDAFabricFunc                                   func(addEdgeDeviceInSDAFabricRequest *[]dnac.AddEdgeDeviceInSDAFabricRequest) (*dnac.AddEdgeDeviceInSDAFabricResponse, *resty.Response, error)
+	AddEdgeDeviceInSDAFabricWithContextFunc                        func(ctx context.Context, addEdgeDeviceInSDAFabricRequest *[]dnac.AddEdgeDeviceInSDAFabricRequest) (*dnac.AddEdgeDeviceInSDAFabricResponse, *resty.Response, error)
+	AddFabricFunc                                                  func(addFabricRequest *[]dnac.AddFabricRequest) (*dnac.AddFabricResponse, *resty.Response, error)
+	AddFabricWithContextFunc                                       func(ctx context.Context, addFabricRequest *[]dnac.AddFabricRequest) (*dnac.AddFabricResponse, *resty.Response, error)
+	AddIPPoolInSDAVirtualNetworkFunc                               func(addIPPoolInSDAVirtualNetworkRequest *[]dnac.AddIPPoolInSDAVirtualNetworkRequest) (*dnac.AddIPPoolInSDAVirtualNetworkResponse, *resty.Response, error)
+	AddIPPoolInSDAVirtualNetworkWithContextFunc                    func(ctx context.Context, addIPPoolInSDAVirtualNetworkRequest *[]dnac.AddIPPoolInSDAVirtualNetworkRequest) (*dnac.AddIPPoolInSDAVirtualNetworkResponse, *resty.Response, error)
+	AddPortAssignmentForAccessPointInSDAFabricFunc                 func(addPortAssignmentForAccessPointInSDAFabricRequest *[]dnac.AddPortAssignmentForAccessPointInSDAFabricRequest) (*dnac.AddPortAssignmentForAccessPointInSDAFabricResponse, *resty.Response, error)
+	AddPortAssignmentForAccessPointInSDAFabricWithContextFunc      func(ctx context.Context, addPortAssignmentForAccessPointInSDAFabricRequest *[]dnac.AddPortAssignmentForAccessPointInSDAFabricRequest) (*dnac.AddPortAssignmentForAccessPointInSDAFabricResponse, *resty.Response, error)
+	AddPortAssignmentForUserDeviceInSDAFabricFunc                  func(addPortAssignmentForUserDeviceInSDAFabricRequest *[]dnac.AddPortAssignmentForUserDeviceInSDAFabricRequest) (*dnac.AddPortAssignmentForUserDeviceInSDAFabricResponse, *resty.Response, error)
+	AddPortAssignmentForUserDeviceInSDAFabricWithContextFunc       func(ctx context.Context, addPortAssignmentForUserDeviceInSDAFabricRequest *[]dnac.AddPortAssignmentForUserDeviceInSDAFabricRequest) (*dnac.AddPortAssignmentForUserDeviceInSDAFabricResponse, *resty.Response, error)
+	AddSiteInSDAFabricFunc                                         func(addSiteInSDAFabricRequest *[]dnac.AddSiteInSDAFabricRequest) (*dnac.AddSiteInSDAFabricResponse, *resty.Response, error)
+	AddSiteInSDAFabricWithContextFunc                              func(ctx context.Context, addSiteInSDAFabricRequest *[]dnac.AddSiteInSDAFabricRequest) (*dnac.AddSiteInSDAFabricResponse, *resty.Response, error)
+	AddVNInSDAFabricFunc                                           func(addVNInSDAFabricRequest *[]dnac.AddVNInSDAFabricRequest) (*dnac.AddVNInSDAFabricResponse, *resty.Response, error)
+	AddVNInSDAFabricWithContextFunc                                func(ctx context.Context, addVNInSDAFabricRequest *[]dnac.AddVNInSDAFabricRequest) (*dnac.AddVNInSDAFabricResponse, *resty.Response, error)
+	AddsBorderDeviceInSDAFabricFunc                                func(addsBorderDeviceInSDAFabricRequest *[]dnac.AddsBorderDeviceInSDAFabricRequest) (*dnac.AddsBorderDeviceInSDAFabricResponse, *resty.Response, error)
+	AddsBorderDeviceInSDAFabricWithContextFunc                     func(ctx context.Context, addsBorderDeviceInSDAFabricRequest *[]dnac.AddsBorderDeviceInSDAFabricRequest) (*dnac.AddsBorderDeviceInSDAFabricResponse, *resty.Response, error)
+	ApplyFabricPlanFunc                                            func(ctx context.Context, plan *dnac.FabricPlan, opts *dnac.WaitForTaskOptions) error
+	DeleteControlPlaneDeviceInSDAFabricFunc                        func(deleteControlPlaneDeviceInSDAFabricQueryParams *dnac.DeleteControlPlaneDeviceInSDAFabricQueryParams) (*dnac.DeleteControlPlaneDeviceInSDAFabricResponse, *resty.Response, error)
+	DeleteControlPlaneDeviceInSDAFabricWithContextFunc             func(ctx context.Context, deleteControlPlaneDeviceInSDAFabricQueryParams *dnac.DeleteControlPlaneDeviceInSDAFabricQueryParams) (*dnac.DeleteControlPlaneDeviceInSDAFabricResponse, *resty.Response, error)
+	DeleteDefaultAuthenticationProfileFromSDAFabricFunc            func(deleteDefaultAuthenticationProfileFromSDAFabricQueryParams *dnac.DeleteDefaultAuthenticationProfileFromSDAFabricQueryParams, deleteDefaultAuthenticationProfileFromSDAFabricRequest *[]dnac.DeleteDefaultAuthenticationProfileFromSDAFabricRequest) (*dnac.DeleteDefaultAuthenticationProfileFromSDAFabricResponse, *resty.Response, error)
+	DeleteDefaultAuthenticationProfileFromSDAFabricWithContextFunc func(ctx context.Context, deleteDefaultAuthenticationProfileFromSDAFabricQueryParams *dnac.DeleteDefaultAuthenticationProfileFromSDAFabricQueryParams, deleteDefaultAuthenticationProfileFromSDAFabricRequest *[]dnac.DeleteDefaultAuthenticationProfileFromSDAFabricRequest) (*dnac.DeleteDefaultAuthenticationProfileFromSDAFabricResponse, *resty.Response, error)
+	DeleteEdgeDeviceFromSDAFabricFunc                              func(deleteEdgeDeviceFromSDAFabricQueryParams *dnac.DeleteEdgeDeviceFromSDAFabricQueryParams) (*dnac.DeleteEdgeDeviceFromSDAFabricResponse, *resty.Response, error)
+	DeleteEdgeDeviceFromSDAFabricWithContextFunc                   func(ctx context.Context, deleteEdgeDeviceFromSDAFabricQueryParams *dnac.DeleteEdgeDeviceFromSDAFabricQueryParams) (*dnac.DeleteEdgeDeviceFromSDAFabricResponse, *resty.Response, error)
+	DeleteIPPoolFromSDAVirtualNetworkFunc                          func(deleteIPPoolFromSDAVirtualNetworkQueryParams *dnac.DeleteIPPoolFromSDAVirtualNetworkQueryParams, deleteIPPoolFromSDAVirtualNetworkRequest *[]dnac.DeleteIPPoolFromSDAVirtualNetworkRequest) (*dnac.DeleteIPPoolFromSDAVirtualNetworkResponse, *resty.Response, error)
+	DeleteIPPoolFromSDAVirtualNetworkWithContextFunc               func(ctx context.Context, deleteIPPoolFromSDAVirtualNetworkQueryParams *dnac.DeleteIPPoolFromSDAVirtualNetworkQueryParams, deleteIPPoolFromSDAVirtualNetworkRequest *[]dnac.DeleteIPPoolFromSDAVirtualNetworkRequest) (*dnac.DeleteIPPoolFromSDAVirtualNetworkResponse, *resty.Response, error)
+	DeletePortAssignmentForAccessPointInSDAFabricFunc              func(deletePortAssignmentForAccessPointInSDAFabricQueryParams *dnac.DeletePortAssignmentForAccessPointInSDAFabricQueryParams, deletePortAssignmentForAccessPointInSDAFabricRequest *[]dnac.DeletePortAssignmentForAccessPointInSDAFabricRequest) (*dnac.DeletePortAssignmentForAccessPointInSDAFabricResponse, *resty.Response, error)
+	DeletePortAssignmentForAccessPointInSDAFabricWithContextFunc   func(ctx context.Context, deletePortAssignmentForAccessPointInSDAFabricQueryParams *dnac.DeletePortAssignmentForAccessPointInSDAFabricQueryParams, deletePortAssignmentForAccessPointInSDAFabricRequest *[]dnac.DeletePortAssignmentForAccessPointInSDAFabricRequest) (*dnac.DeletePortAssignmentForAccessPointInSDAFabricResponse, *resty.Response, error)
+	DeletePortAssignmentForUserDeviceInSDAFabricFunc               func(deletePortAssignmentForUserDeviceInSDAFabricQueryParams *dnac.DeletePortAssignmentForUserDeviceInSDAFabricQueryParams, deletePortAssignmentForUserDeviceInSDAFabricRequest *[]dnac.DeletePortAssignmentForUserDeviceInSDAFabricRequest) (*dnac.DeletePortAssignmentForUserDeviceInSDAFabricResponse, *resty.Response, error)
+	DeletePortAssignmentForUserDeviceInSDAFabricWithContextFunc    func(ctx context.Context, deletePortAssignmentForUserDeviceInSDAFabricQueryParams *dnac.DeletePortAssignmentForUserDeviceInSDAFabricQueryParams, deletePortAssignmentForUserDeviceInSDAFabricRequest *[]dnac.DeletePortAssignmentForUserDeviceInSDAFabricRequest) (*dnac.DeletePortAssignmentForUserDeviceInSDAFabricResponse, *resty.Response, error)
+	DeleteSDAFabricFunc                                            func(deleteSDAFabricQueryParams *dnac.DeleteSDAFabricQueryParams, deleteSDAFabricRequest *[]dnac.DeleteSDAFabricRequest) (*dnac.DeleteSDAFabricResponse, *resty.Response, error)
+	DeleteSDAFabricWithContextFunc                                 func(ctx context.Context, deleteSDAFabricQueryParams *dnac.DeleteSDAFabricQueryParams, deleteSDAFabricRequest *[]dnac.DeleteSDAFabricRequest) (*dnac.DeleteSDAFabricResponse, *resty.Response, error)
+	DeleteSiteFromSDAFabricFunc                                    func(deleteSiteFromSDAFabricQueryParams *dnac.DeleteSiteFromSDAFabricQueryParams, deleteSiteFromSDAFabricRequest *[]dnac.DeleteSiteFromSDAFabricRequest) (*dnac.DeleteSiteFromSDAFabricResponse, *resty.Response, error)
+	DeleteSiteFromSDAFabricWithContextFunc                         func(ctx context.Context, deleteSiteFromSDAFabricQueryParams *dnac.DeleteSiteFromSDAFabricQueryParams, deleteSiteFromSDAFabricRequest *[]dnac.DeleteSiteFromSDAFabricRequest) (*dnac.DeleteSiteFromSDAFabricResponse, *resty.Response, error)
+	DeleteVNFromSDAFabricFunc                                      func(deleteVNFromSDAFabricQueryParams *dnac.DeleteVNFromSDAFabricQueryParams) (*dnac.DeleteVNFromSDAFabricResponse, *resty.Response, error)
+	DeleteVNFromSDAFabricWithContextFunc                           func(ctx context.Context, deleteVNFromSDAFabricQueryParams *dnac.DeleteVNFromSDAFabricQueryParams) (*dnac.DeleteVNFromSDAFabricResponse, *resty.Response, error)
+	DeletesBorderDeviceFromSDAFabricFunc                           func(deletesBorderDeviceFromSDAFabricQueryParams *dnac.DeletesBorderDeviceFromSDAFabricQueryParams) (*dnac.DeletesBorderDeviceFromSDAFabricResponse, *resty.Response, error)
+	DeletesBorderDeviceFromSDAFabricWithContextFunc                func(ctx context.Context, deletesBorderDeviceFromSDAFabricQueryParams *dnac.DeletesBorderDeviceFromSDAFabricQueryParams) (*dnac.DeletesBorderDeviceFromSDAFabricResponse, *resty.Response, error)
+	GetControlPlaneDeviceFromSDAFabricFunc                         func(getControlPlaneDeviceFromSDAFabricQueryParams *dnac.GetControlPlaneDeviceFromSDAFabricQueryParams) (*dnac.GetControlPlaneDeviceFromSDAFabricResponse, *resty.Response, error)
+	GetControlPlaneDeviceFromSDAFabricWithContextFunc              func(ctx context.Context, getControlPlaneDeviceFromSDAFabricQueryParams *dnac.GetControlPlaneDeviceFromSDAFabricQueryParams) (*dnac.GetControlPlaneDeviceFromSDAFabricResponse, *resty.Response, error)
+	GetDefaultAuthenticationProfileFromSDAFabricFunc               func(getDefaultAuthenticationProfileFromSDAFabricQueryParams *dnac.GetDefaultAuthenticationProfileFromSDAFabricQueryParams) (*dnac.GetDefaultAuthenticationProfileFromSDAFabricResponse, *resty.Response, error)
+	GetDefaultAuthenticationProfileFromSDAFabricWithContextFunc    func(ctx context.Context, getDefaultAuthenticationProfileFromSDAFabricQueryParams *dnac.GetDefaultAuthenticationProfileFromSDAFabricQueryParams) (*dnac.GetDefaultAuthenticationProfileFromSDAFabricResponse, *resty.Response, error)
+	GetDeviceInfoFromSDAFabricFunc                                 func(getDeviceInfoFromSDAFabricQueryParams *dnac.GetDeviceInfoFromSDAFabricQueryParams) (*dnac.GetDeviceInfoFromSDAFabricResponse, *resty.Response, error)
+	GetDeviceInfoFromSDAFabricWithContextFunc                      func(ctx context.Context, getDeviceInfoFromSDAFabricQueryParams *dnac.GetDeviceInfoFromSDAFabricQueryParams) (*dnac.GetDeviceInfoFromSDAFabricResponse, *resty.Response, error)
+	GetDeviceRoleInSDAFabricFunc                                   func(getDeviceRoleInSDAFabricQueryParams *dnac.GetDeviceRoleInSDAFabricQueryParams) (*dnac.GetDeviceRoleInSDAFabricResponse, *resty.Response, error)
+	GetDeviceRoleInSDAFabricWithContextFunc                        func(ctx context.Context, getDeviceRoleInSDAFabricQueryParams *dnac.GetDeviceRoleInSDAFabricQueryParams) (*dnac.GetDeviceRoleInSDAFabricResponse, *resty.Response, error)
+	GetEdgeDeviceFromSDAFabricFunc                                 func(getEdgeDeviceFromSDAFabricQueryParams *dnac.GetEdgeDeviceFromSDAFabricQueryParams) (*dnac.GetEdgeDeviceFromSDAFabricResponse, *resty.Response, error)
+	GetEdgeDeviceFromSDAFabricWithContextFunc                      func(ctx context.Context, getEdgeDeviceFromSDAFabricQueryParams *dnac.GetEdgeDeviceFromSDAFabricQueryParams) (*dnac.GetEdgeDeviceFromSDAFabricResponse, *resty.Response, error)
+	GetIPPoolFromSDAVirtualNetworkFunc                             func(getIPPoolFromSDAVirtualNetworkQueryParams *dnac.GetIPPoolFromSDAVirtualNetworkQueryParams) (*dnac.GetIPPoolFromSDAVirtualNetworkResponse, *resty.Response, error)
+	GetIPPoolFromSDAVirtualNetworkWithContextFunc                  func(ctx context.Context, getIPPoolFromSDAVirtualNetworkQueryParams *dnac.GetIPPoolFromSDAVirtualNetworkQueryParams) (*dnac.GetIPPoolFromSDAVirtualNetworkResponse, *resty.Response, error)
+	GetPortAssignmentForAccessPointInSDAFabricFunc                 func(getPortAssignmentForAccessPointInSDAFabricQueryParams *dnac.GetPortAssignmentForAccessPointInSDAFabricQueryParams) (*dnac.GetPortAssignmentForAccessPointInSDAFabricResponse, *resty.Response, error)
+	GetPortAssignmentForAccessPointInSDAFabricWithContextFunc      func(ctx context.Context, getPortAssignmentForAccessPointInSDAFabricQueryParams *dnac.GetPortAssignmentForAccessPointInSDAFabricQueryParams) (*dnac.GetPortAssignmentForAccessPointInSDAFabricResponse, *resty.Response, error)
+	GetPortAssignmentForUserDeviceInSDAFabricFunc                  func(getPortAssignmentForUserDeviceInSDAFabricQueryParams *dnac.GetPortAssignmentForUserDeviceInSDAFabricQueryParams) (*dnac.GetPortAssignmentForUserDeviceInSDAFabricResponse, *resty.Response, error)
+	GetPortAssignmentForUserDeviceInSDAFabricWithContextFunc       func(ctx context.Context, getPortAssignmentForUserDeviceInSDAFabricQueryParams *dnac.GetPortAssignmentForUserDeviceInSDAFabricQueryParams) (*dnac.GetPortAssignmentForUserDeviceInSDAFabricResponse, *resty.Response, error)
+	GetSDAFabricCountFunc                                          func() (*dnac.GetSDAFabricCountResponse, *resty.Response, error)
+	GetSDAFabricCountWithContextFunc                               func(ctx context.Context) (*dnac.GetSDAFabricCountResponse, *resty.Response, error)
+	GetSDAFabricInfoFunc                                           func(getSDAFabricInfoQueryParams *dnac.GetSDAFabricInfoQueryParams) (*dnac.GetSDAFabricInfoResponse, *resty.Response, error)
+	GetSDAFabricInfoWithContextFunc                                func(ctx context.Context, getSDAFabricInfoQueryParams *dnac.GetSDAFabricInfoQueryParams) (*dnac.GetSDAFabricInfoResponse, *resty.Response, error)
+	GetSiteFromSDAFabricFunc                                       func(getSiteFromSDAFabricQueryParams *dnac.GetSiteFromSDAFabricQueryParams) (*dnac.GetSiteFromSDAFabricResponse, *resty.Response, error)
+	GetSiteFromSDAFabricWithContextFunc                            func(ctx context.Context, getSiteFromSDAFabricQueryParams *dnac.GetSiteFromSDAFabricQueryParams) (*dnac.GetSiteFromSDAFabricResponse, *resty.Response, error)
+	GetVNFromSDAFabricFunc                                         func(getVNFromSDAFabricQueryParams *dnac.GetVNFromSDAFabricQueryParams) (*dnac.GetVNFromSDAFabricResponse, *resty.Response, error)
+	GetVNFromSDAFabricWithContextFunc                              func(ctx context.Context, getVNFromSDAFabricQueryParams *dnac.GetVNFromSDAFabricQueryParams) (*dnac.GetVNFromSDAFabricResponse, *resty.Response, error)
+	GetsBorderDeviceDetailFromSDAFabricFunc                        func(getsBorderDeviceDetailFromSDAFabricQueryParams *dnac.GetsBorderDeviceDetailFromSDAFabricQueryParams) (*dnac.GetsBorderDeviceDetailFromSDAFabricResponse, *resty.Response, error)
+	GetsBorderDeviceDetailFromSDAFabricWithContextFunc             func(ctx context.Context, getsBorderDeviceDetailFromSDAFabricQueryParams *dnac.GetsBorderDeviceDetailFromSDAFabricQueryParams) (*dnac.GetsBorderDeviceDetailFromSDAFabricResponse, *resty.Response, error)
+	PlanFabricFunc                                                 func(ctx context.Context, spec *dnac.FabricSpec) (*dnac.FabricPlan, error)
+	ReconcileFunc                                                  func(ctx context.Context, spec *dnac.FabricSpec, opts *dnac.ReconcileOptions) (*dnac.FabricPlan, error)
+	UpdateDefaultAuthenticationProfileInSDAFabricFunc              func(updateDefaultAuthenticationProfileInSDAFabricRequest *[]dnac.UpdateDefaultAuthenticationProfileInSDAFabricRequest) (*dnac.UpdateDefaultAuthenticationProfileInSDAFabricResponse, *resty.Response, error)
+	UpdateDefaultAuthenticationProfileInSDAFabricWithContextFunc   func(ctx context.Context, updateDefaultAuthenticationProfileInSDAFabricRequest *[]dnac.UpdateDefaultAuthenticationProfileInSDAFabricRequest) (*dnac.UpdateDefaultAuthenticationProfileInSDAFabricResponse, *resty.Response, error)
+}
+
+// AddControlPlaneDeviceInSDAFabric calls AddControlPlaneDeviceInSDAFabricFunc.
+func (m *SDAAPI) AddControlPlaneDeviceInSDAFabric(addControlPlaneDeviceInSDAFabricRequest *[]dnac.AddControlPlaneDeviceInSDAFabricRequest) (*dnac.AddControlPlaneDeviceInSDAFabricResponse, *resty.Response, error) {
+	m.record("AddControlPlaneDeviceInSDAFabric", addControlPlaneDeviceInSDAFabricRequest)
+	if m.AddControlPlaneDeviceInSDAFabricFunc == nil {
+		panic("dnacmock: SDAAPI.AddControlPlaneDeviceInSDAFabric called but AddControlPlaneDeviceInSDAFabricFunc is not set")
+	}
+	return m.AddControlPlaneDeviceInSDAFabricFunc(addControlPlaneDeviceInSDAFabricRequest)
+}
+
+// AddControlPlaneDeviceInSDAFabricWithContext calls AddControlPlaneDeviceInSDAFabricWithContextFunc.
+func (m *SDAAPI) AddControlPlaneDeviceInSDAFabricWithContext(ctx context.Context, addControlPlaneDeviceInSDAFabricRequest *[]dnac.AddControlPlaneDeviceInSDAFabricRequest) (*dnac.AddControlPlaneDeviceInSDAFabricResponse, *resty.Response, error) {
+	m.record("AddControlPlaneDeviceInSDAFabricWithContext", ctx, addControlPlaneDeviceInSDAFabricRequest)
+	if m.AddControlPlaneDeviceInSDAFabricWithContextFunc == nil {
+		panic("dnacmock: SDAAPI.AddControlPlaneDeviceInSDAFabricWithContext called but AddControlPlaneDeviceInSDAFabricWithContextFunc is not set")
+	}
+	return m.AddControlPlaneDeviceInSDAFabricWithContextFunc(ctx, addControlPlaneDeviceInSDAFabricRequest)
+}
+
+// AddDefaultAuthenticationProfileInSDAFabric calls AddDefaultAuthenticationProfileInSDAFabricFunc.
+func (m *SDAAPI) AddDefaultAuthenticationProfileInSDAFabric(addDefaultAuthenticationProfileInSDAFabricRequest *[]dnac.AddDefaultAuthenticationProfileInSDAFabricRequest) (*dnac.AddDefaultAuthenticationProfileInSDAFabricResponse, *resty.Response, error) {
+	m.record("AddDefaultAuthenticationProfileInSDAFabric", addDefaultAuthenticationProfileInSDAFabricRequest)
+	if m.AddDefaultAuthenticationProfileInSDAFabricFunc == nil {
+		panic("dnacmock: SDAAPI.AddDefaultAuthenticationProfileInSDAFabric called but AddDefaultAuthenticationProfileInSDAFabricFunc is not set")
+	}
+	return m.AddDefaultAuthenticationProfileInSDAFabricFunc(addDefaultAuthenticationProfileInSDAFabricRequest)
+}
+
+// AddDefaultAuthenticationProfileInSDAFabricWithContext calls AddDefaultAuthenticationProfileInSDAFabricWithContextFunc.
+func (m *SDAAPI) AddDefaultAuthenticationProfileInSDAFabricWithContext(ctx context.Context, addDefaultAuthenticationProfileInSDAFabricRequest *[]dnac.AddDefaultAuthenticationProfileInSDAFabricRequest) (*dnac.AddDefaultAuthenticationProfileInSDAFabricResponse, *resty.Response, error) {
+	m.record("AddDefaultAuthenticationProfileInSDAFabricWithContext", ctx, addDefaultAuthenticationProfileInSDAFabricRequest)
+	if m.AddDefaultAuthenticationProfileInSDAFabricWithContextFunc == nil {
+		panic("dnacmock: SDAAPI.AddDefaultAuthenticationProfileInSDAFabricWithContext called but AddDefaultAuthenticationProfileInSDAFabricWithContextFunc is not set")
+	}
+	return m.AddDefaultAuthenticationProfileInSDAFabricWithContextFunc(ctx, addDefaultAuthenticationProfileInSDAFabricRequest)
+}
+
+// AddEdgeDeviceInSDAFabric calls AddEdgeDeviceInSDAFabricFunc.
+func (m *SDAAPI) AddEdgeDeviceInSDAFabric(addEdgeDeviceInSDAFabricRequest *[]dnac.AddEdgeDeviceInSDAFabricRequest) (*dnac.AddEdgeDeviceInSDAFabricResponse, *resty.Response, error) {
+	m.record("AddEdgeDeviceInSDAFabric", addEdgeDeviceInSDAFabricRequest)
+	if m.AddEdgeDeviceInSDAFabricFunc == nil {
+		panic("dnacmock: SDAAPI.AddEdgeDeviceInSDAFabric called but AddEdgeDeviceInSDAFabricFunc is not set")
+	}
+	return m.AddEdgeDeviceInSDAFabricFunc(addEdgeDeviceInSDAFabricRequest)
+}
+
+// AddEdgeDeviceInSDAFabricWithContext calls AddEdgeDeviceInSDAFabricWithContextFunc.
+func (m *SDAAPI) AddEdgeDeviceInSDAFabricWithContext(ctx context.Context, addEdgeDeviceInSDAFabricRequest *[]dnac.AddEdgeDeviceInSDAFabricRequest) (*dnac.AddEdgeDeviceInSDAFabricResponse, *resty.Response, error) {
+	m.record("AddEdgeDeviceInSDAFabricWithContext", ctx, addEdgeDeviceInSDAFabricRequest)
+	if m.AddEdgeDeviceInSDAFabricWithContextFunc == nil {
+		panic("dnacmock: SDAAPI.AddEdgeDeviceInSDAFabricWithContext called but AddEdgeDeviceInSDAFabricWithContextFunc is not set")
+	}
+	return m.AddEdgeDeviceInSDAFabricWithContextFunc(ctx, addEdgeDeviceInSDAFabricRequest)
+}
+
+// AddFabric calls AddFabricFunc.
+func (m *SDAAPI) AddFabric(addFabricRequest *[]dnac.AddFabricRequest) (*dnac.AddFabricResponse, *resty.Response, error) {
+	m.record("AddFabric", addFabricRequest)
+	if m.AddFabricFunc == nil {
+		panic("dnacmock: SDAAPI.AddFabric called but AddFabricFunc is not set")
+	}
+	return m.AddFabricFunc(addFabricRequest)
+}
+
+// AddFabricWithContext calls AddFabricWithContextFunc.
+func (m *SDAAPI) AddFabricWithContext(ctx context.Context, addFabricRequest *[]dnac.AddFabricRequest) (*dnac.AddFabricResponse, *resty.Response, error) {
+	m.record("AddFabricWithContext", ctx, addFabricRequest)
+	if m.AddFabricWithContextFunc == nil {
+		panic("dnacmock: SDAAPI.AddFabricWithContext called but AddFabricWithContextFunc is not set")
+	}
+	return m.AddFabricWithContextFunc(ctx, addFabricRequest)
+}
+
+// AddIPPoolInSDAVirtualNetwork calls AddIPPoolInSDAVirtualNetworkFunc.
+func (m *SDAAPI) AddIPPoolInSDAVirtualNetwork(addIPPoolInSDAVirtualNetworkRequest *[]dnac.AddIPPoolInSDAVirtualNetworkRequest) (*dnac.AddIPPoolInSDAVirtualNetworkResponse, *resty.Response, error) {
+	m.record("AddIPPoolInSDAVirtualNetwork", addIPPoolInSDAVirtualNetworkRequest)
+	if m.AddIPPoolInSDAVirtualNetworkFunc == nil {
+		panic("dnacmock: SDAAPI.AddIPPoolInSDAVirtualNetwork called but AddIPPoolInSDAVirtualNetworkFunc is not set")
+	}
+	return m.AddIPPoolInSDAVirtualNetworkFunc(addIPPoolInSDAVirtualNetworkRequest)
+}
+
+// AddIPPoolInSDAVirtualNetworkWithContext calls AddIPPoolInSDAVirtualNetworkWithContextFunc.
+func (m *SDAAPI) AddIPPoolInSDAVirtualNetworkWithContext(ctx context.Context, addIPPoolInSDAVirtualNetworkRequest *[]dnac.AddIPPoolInSDAVirtualNetworkRequest) (*dnac.AddIPPoolInSDAVirtualNetworkResponse, *resty.Response, error) {
+	m.record("AddIPPoolInSDAVirtualNetworkWithContext", ctx, addIPPoolInSDAVirtualNetworkRequest)
+	if m.AddIPPoolInSDAVirtualNetworkWithContextFunc == nil {
+		panic("dnacmock: SDAAPI.AddIPPoolInSDAVirtualNetworkWithContext called but AddIPPoolInSDAVirtualNetworkWithContextFunc is not set")
+	}
+	return m.AddIPPoolInSDAVirtualNetworkWithContextFunc(ctx, addIPPoolInSDAVirtualNetworkRequest)
+}
+
+// AddPortAssignmentForAccessPointInSDAFabric calls AddPortAssignmentForAccessPointInSDAFabricFunc.
+func (m *SDAAPI) AddPortAssignmentForAccessPointInSDAFabric(addPortAssignmentForAccessPointInSDAFabricRequest *[]dnac.AddPortAssignmentForAccessPointInSDAFabricRequest) (*dnac.AddPortAssignmentForAccessPointInSDAFabricResponse, *resty.Response, error) {
+	m.record("AddPortAssignmentForAccessPointInSDAFabric", addPortAssignmentForAccessPointInSDAFabricRequest)
+	if m.AddPortAssignmentForAccessPointInSDAFabricFunc == nil {
+		panic("dnacmock: SDAAPI.AddPortAssignmentForAccessPointInSDAFabric called but AddPortAssignmentForAccessPointInSDAFabricFunc is not set")
+	}
+	return m.AddPortAssignmentForAccessPointInSDAFabricFunc(addPortAssignmentForAccessPointInSDAFabricRequest)
+}
+
+// AddPortAssignmentForAccessPointInSDAFabricWithContext calls AddPortAssignmentForAccessPointInSDAFabricWithContextFunc.
+func (m *SDAAPI) AddPortAssignmentForAccessPointInSDAFabricWithContext(ctx context.Context, addPortAssignmentForAccessPointInSDAFabricRequest *[]dnac.AddPortAssignmentForAccessPointInSDAFabricRequest) (*dnac.AddPortAssignmentForAccessPointInSDAFabricResponse, *resty.Response, error) {
+	m.record("AddPortAssignmentForAccessPointInSDAFabricWithContext", ctx, addPortAssignmentForAccessPointInSDAFabricRequest)
+	if m.AddPortAssignmentForAccessPointInSDAFabricWithContextFunc == nil {
+		panic("dnacmock: SDAAPI.AddPortAssignmentForAccessPointInSDAFabricWithContext called but AddPortAssignmentForAccessPointInSDAFabricWithContextFunc is not set")
+	}
+	return m.AddPortAssignmentForAccessPointInSDAFabricWithContextFunc(ctx, addPortAssignmentForAccessPointInSDAFabricRequest)
+}
+
+// AddPortAssignmentForUserDeviceInSDAFabric calls AddPortAssignmentForUserDeviceInSDAFabricFunc.
+func (m *SDAAPI) AddPortAssignmentForUserDeviceInSDAFabric(addPortAssignmentForUserDeviceInSDAFabricRequest *[]dnac.AddPortAssignmentForUserDeviceInSDAFabricRequest) (*dnac.AddPortAssignmentForUserDeviceInSDAFabricResponse, *resty.Response, error) {
+	m.record("AddPortAssignmentForUserDeviceInSDAFabric", addPortAssignmentForUserDeviceInSDAFabricRequest)
+	if m.AddPortAssignmentForUserDeviceInSDAFabricFunc == nil {
+		panic("dnacmock: SDAAPI.AddPortAssignmentForUserDeviceInSDAFabric called but AddPortAssignmentForUserDeviceInSDAFabricFunc is not set")
+	}
+	return m.AddPortAssignmentForUserDeviceInSDAFabricFunc(addPortAssignmentForUserDeviceInSDAFabricRequest)
+}
+
+// AddPortAssignmentForUserDeviceInSDAFabricWithContext calls AddPortAssignmentForUserDeviceInSDAFabricWithContextFunc.
+func (m *SDAAPI) AddPortAssignmentForUserDeviceInSDAFabricWithContext(ctx context.Context, addPortAssignmentForUserDeviceInSDAFabricRequest *[]dnac.AddPortAssignmentForUserDeviceInSDAFabricRequest) (*dnac.AddPortAssignmentForUserDeviceInSDAFabricResponse, *resty.Response, error) {
+	m.record("AddPortAssignmentForUserDeviceInSDAFabricWithContext", ctx, addPortAssignmentForUserDeviceInSDAFabricRequest)
+	if m.AddPortAssignmentForUserDeviceInSDAFabricWithContextFunc == nil {
+		panic("dnacmock: SDAAPI.AddPortAssignmentForUserDeviceInSDAFabricWithContext called but AddPortAssignmentForUserDeviceInSDAFabricWithContextFunc is not set")
+	}
+	return m.AddPortAssignmentForUserDeviceInSDAFabricWithContextFunc(ctx, addPortAssignmentForUserDeviceInSDAFabricRequest)
+}
+
+// AddSiteInSDAFabric calls AddSiteInSDAFabricFunc.
+func (m *SDAAPI) AddSiteInSDAFabric(addSiteInSDAFabricRequest *[]dnac.AddSiteInSDAFabricRequest) (*dnac.AddSiteInSDAFabricResponse, *resty.Response, error) {
+	m.record("AddSiteInSDAFabric", addSiteInSDAFabricRequest)
+	if m.AddSiteInSDAFabricFunc == nil {
+		panic("dnacmock: SDAAPI.AddSiteInSDAFabric called but AddSiteInSDAFabricFunc is not set")
+	}
+	return m.AddSiteInSDAFabricFunc(addSiteInSDAFabricRequest)
+}
+
+// AddSiteInSDAFabricWithContext calls AddSiteInSDAFabricWithContextFunc.
+func (m *SDAAPI) AddSiteInSDAFabricWithContext(ctx context.Context, addSiteInSDAFabricRequest *[]dnac.AddSiteInSDAFabricRequest) (*dnac.AddSiteInSDAFabricResponse, *resty.Response, error) {
+	m.record("AddSiteInSDAFabricWithContext", ctx, addSiteInSDAFabricRequest)
+	if m.AddSiteInSDAFabricWithContextFunc == nil {
+		panic("dnacmock: SDAAPI.AddSiteInSDAFabricWithContext called but AddSiteInSDAFabricWithContextFunc is not set")
+	}
+	return m.AddSiteInSDAFabricWithContextFunc(ctx, addSiteInSDAFabricRequest)
+}
+
+// AddVNInSDAFabric calls AddVNInSDAFabricFunc.
+func (m *SDAAPI) AddVNInSDAFabric(addVNInSDAFabricRequest *[]dnac.AddVNInSDAFabricRequest) (*dnac.AddVNInSDAFabricResponse, *resty.Response, error) {
+	m.record("AddVNInSDAFabric", addVNInSDAFabricRequest)
+	if m.AddVNInSDAFabricFunc == nil {
+		panic("dnacmock: SDAAPI.AddVNInSDAFabric called but AddVNInSDAFabricFunc is not set")
+	}
+	return m.AddVNInSDAFabricFunc(addVNInSDAFabricRequest)
+}
+
+// AddVNInSDAFabricWithContext calls AddVNInSDAFabricWithContextFunc.
+func (m *SDAAPI) AddVNInSDAFabricWithContext(ctx context.Context, addVNInSDAFabricRequest *[]dnac.AddVNInSDAFabricRequest) (*dnac.AddVNInSDAFabricResponse, *resty.Response, error) {
+	m.record("AddVNInSDAFabricWithContext", ctx, addVNInSDAFabricRequest)
+	if m.AddVNInSDAFabricWithContextFunc == nil {
+		panic("dnacmock: SDAAPI.AddVNInSDAFabricWithContext called but AddVNInSDAFabricWithContextFunc is not set")
+	}
+	return m.AddVNInSDAFabricWithContextFunc(ctx, addVNInSDAFabricRequest)
+}
+
+// AddsBorderDeviceInSDAFabric calls AddsBorderDeviceInSDAFabricFunc.
+func (m *SDAAPI) AddsBorderDeviceInSDAFabric(addsBorderDeviceInSDAFabricRequest *[]dnac.AddsBorderDeviceInSDAFabricRequest) (*dnac.AddsBorderDeviceInSDAFabricResponse, *resty.Response, error) {
+	m.record("AddsBorderDeviceInSDAFabric", addsBorderDeviceInSDAFabricRequest)
+	if m.AddsBorderDeviceInSDAFabricFunc == nil {
+		panic("dnacmock: SDAAPI.AddsBorderDeviceInSDAFabric called but AddsBorderDeviceInSDAFabricFunc is not set")
+	}
+	return m.AddsBorderDeviceInSDAFabricFunc(addsBorderDeviceInSDAFabricRequest)
+}
+
+// AddsBorderDeviceInSDAFabricWithContext calls AddsBorderDeviceInSDAFabricWithContextFunc.
+func (m *SDAAPI) AddsBorderDeviceInSDAFabricWithContext(ctx context.Context, addsBorderDeviceInSDAFabricRequest *[]dnac.AddsBorderDeviceInSDAFabricRequest) (*dnac.AddsBorderDeviceInSDAFabricResponse, *resty.Response, error) {
+	m.record("AddsBorderDeviceInSDAFabricWithContext", ctx, addsBorderDeviceInSDAFabricRequest)
+	if m.AddsBorderDeviceInSDAFabricWithContextFunc == nil {
+		panic("dnacmock: SDAAPI.AddsBorderDeviceInSDAFabricWithContext called but AddsBorderDeviceInSDAFabricWithContextFunc is not set")
+	}
+	return m.AddsBorderDeviceInSDAFabricWithContextFunc(ctx, addsBorderDeviceInSDAFabricRequest)
+}
+
+// ApplyFabricPlan calls ApplyFabricPlanFunc.
+func (m *SDAAPI) ApplyFabricPlan(ctx context.Context, plan *dnac.FabricPlan, opts *dnac.WaitForTaskOptions) error {
+	m.record("ApplyFabricPlan", ctx, plan, opts)
+	if m.ApplyFabricPlanFunc == nil {
+		panic("dnacmock: SDAAPI.ApplyFabricPlan called but ApplyFabricPlanFunc is not set")
+	}
+	return m.ApplyFabricPlanFunc(ctx, plan, opts)
+}
+
+// DeleteControlPlaneDeviceInSDAFabric calls DeleteControlPlaneDeviceInSDAFabricFunc.
+func (m *SDAAPI) DeleteControlPlaneDeviceInSDAFabric(deleteControlPlaneDeviceInSDAFabricQueryParams *dnac.DeleteControlPlaneDeviceInSDAFabricQueryParams) (*dnac.DeleteControlPlaneDeviceInSDAFabricResponse, *resty.Response, error) {
+	m.record("DeleteControlPlaneDeviceInSDAFabric", deleteControlPlaneDeviceInSDAFabricQueryParams)
+	if m.DeleteControlPlaneDeviceInSDAFabricFunc == nil {
+		panic("dnacmock: SDAAPI.DeleteControlPlaneDeviceInSDAFabric called but DeleteControlPlaneDeviceInSDAFabricFunc is not set")
+	}
+	return m.DeleteControlPlaneDeviceInSDAFabricFunc(deleteControlPlaneDeviceInSDAFabricQueryParams)
+}
+
+// DeleteControlPlaneDeviceInSDAFabricWithContext calls DeleteControlPlaneDeviceInSDAFabricWithContextFunc.
+func (m *SDAAPI) DeleteControlPlaneDeviceInSDAFabricWithContext(ctx context.Context, deleteControlPlaneDeviceInSDAFabricQueryParams *dnac.DeleteControlPlaneDeviceInSDAFabricQueryParams) (*dnac.DeleteControlPlaneDeviceInSDAFabricResponse, *resty.Response, error) {
+	m.record("DeleteControlPlaneDeviceInSDAFabricWithContext", ctx, deleteControlPlaneDeviceInSDAFabricQueryParams)
+	if m.DeleteControlPlaneDeviceInSDAFabricWithContextFunc == nil {
+		panic("dnacmock: SDAAPI.DeleteControlPlaneDeviceInSDAFabricWithContext called but DeleteControlPlaneDeviceInSDAFabricWithContextFunc is not set")
+	}
+	return m.DeleteControlPlaneDeviceInSDAFabricWithContextFunc(ctx, deleteControlPlaneDeviceInSDAFabricQueryParams)
+}
+
+// DeleteDefaultAuthenticationProfileFromSDAFabric calls DeleteDefaultAuthenticationProfileFromSDAFabricFunc.
+func (m *SDAAPI) DeleteDefaultAuthenticationProfileFromSDAFabric(deleteDefaultAuthenticationProfileFromSDAFabricQueryParams *dnac.DeleteDefaultAuthenticationProfileFromSDAFabricQueryParams, deleteDefaultAuthenticationProfileFromSDAFabricRequest *[]dnac.DeleteDefaultAuthenticationProfileFromSDAFabricRequest) (*dnac.DeleteDefaultAuthenticationProfileFromSDAFabricResponse, *resty.Response, error) {
+	m.record("DeleteDefaultAuthenticationProfileFromSDAFabric", deleteDefaultAuthenticationProfileFromSDAFabricQueryParams, deleteDefaultAuthenticationProfileFromSDAFabricRequest)
+	if m.DeleteDefaultAuthenticationProfileFromSDAFabricFunc == nil {
+		panic("dnacmock: SDAAPI.DeleteDefaultAuthenticationProfileFromSDAFabric called but DeleteDefaultAuthenticationProfileFromSDAFabricFunc is not set")
+	}
+	return m.DeleteDefaultAuthenticationProfileFromSDAFabricFunc(deleteDefaultAuthenticationProfileFromSDAFabricQueryParams, deleteDefaultAuthenticationProfileFromSDAFabricRequest)
+}
+
+// DeleteDefaultAuthenticationProfileFromSDAFabricWithContext calls DeleteDefaultAuthenticationProfileFromSDAFabricWithContextFunc.
+func (m *SDAAPI) DeleteDefaultAuthenticationProfileFromSDAFabricWithContext(ctx context.Context, deleteDefaultAuthenticationProfileFromSDAFabricQueryParams *dnac.DeleteDefaultAuthenticationProfileFromSDAFabricQueryParams, deleteDefaultAuthenticationProfileFromSDAFabricRequest *[]dnac.DeleteDefaultAuthenticationProfileFromSDAFabricRequest) (*dnac.DeleteDefaultAuthenticationProfileFromSDAFabricResponse, *resty.Response, error) {
+	m.record("DeleteDefaultAuthenticationProfileFromSDAFabricWithContext", ctx, deleteDefaultAuthenticationProfileFromSDAFabricQueryParams, deleteDefaultAuthenticationProfileFromSDAFabricRequest)
+	if m.DeleteDefaultAuthenticationProfileFromSDAFabricWithContextFunc == nil {
+		panic("dnacmock: SDAAPI.DeleteDefaultAuthenticationProfileFromSDAFabricWithContext called but DeleteDefaultAuthenticationProfileFromSDAFabricWithContextFunc is not set")
+	}
+	return m.DeleteDefaultAuthenticationProfileFromSDAFabricWithContextFunc(ctx, deleteDefaultAuthenticationProfileFromSDAFabricQueryParams, deleteDefaultAuthenticationProfileFromSDAFabricRequest)
+}
+
+// DeleteEdgeDeviceFromSDAFabric calls DeleteEdgeDeviceFromSDAFabricFunc.
+func (m *SDAAPI) DeleteEdgeDeviceFromSDAFabric(deleteEdgeDeviceFromSDAFabricQueryParams *dnac.DeleteEdgeDeviceFromSDAFabricQueryParams) (*dnac.DeleteEdgeDeviceFromSDAFabricResponse, *resty.Response, error) {
+	m.record("DeleteEdgeDeviceFromSDAFabric", deleteEdgeDeviceFromSDAFabricQueryParams)
+	if m.DeleteEdgeDeviceFromSDAFabricFunc == nil {
+		panic("dnacmock: SDAAPI.DeleteEdgeDeviceFromSDAFabric called but DeleteEdgeDeviceFromSDAFabricFunc is not set")
+	}
+	return m.DeleteEdgeDeviceFromSDAFabricFunc(deleteEdgeDeviceFromSDAFabricQueryParams)
+}
+
+// DeleteEdgeDeviceFromSDAFabricWithContext calls DeleteEdgeDeviceFromSDAFabricWithContextFunc.
+func (m *SDAAPI) DeleteEdgeDeviceFromSDAFabricWithContext(ctx context.Context, deleteEdgeDeviceFromSDAFabricQueryParams *dnac.DeleteEdgeDeviceFromSDAFabricQueryParams) (*dnac.DeleteEdgeDeviceFromSDAFabricResponse, *resty.Response, error) {
+	m.record("DeleteEdgeDeviceFromSDAFabricWithContext", ctx, deleteEdgeDeviceFromSDAFabricQueryParams)
+	if m.DeleteEdgeDeviceFromSDAFabricWithContextFunc == nil {
+		panic("dnacmock: SDAAPI.DeleteEdgeDeviceFromSDAFabricWithContext called but DeleteEdgeDeviceFromSDAFabricWithContextFunc is not set")
+	}
+	return m.DeleteEdgeDeviceFromSDAFabricWithContextFunc(ctx, deleteEdgeDeviceFromSDAFabricQueryParams)
+}
+
+// DeleteIPPoolFromSDAVirtualNetwork calls DeleteIPPoolFromSDAVirtualNetworkFunc.
+func (m *SDAAPI) DeleteIPPoolFromSDAVirtualNetwork(deleteIPPoolFromSDAVirtualNetworkQueryParams *dnac.DeleteIPPoolFromSDAVirtualNetworkQueryParams, deleteIPPoolFromSDAVirtualNetworkRequest *[]dnac.DeleteIPPoolFromSDAVirtualNetworkRequest) (*dnac.DeleteIPPoolFromSDAVirtualNetworkResponse, *resty.Response, error) {
+	m.record("DeleteIPPoolFromSDAVirtualNetwork", deleteIPPoolFromSDAVirtualNetworkQueryParams, deleteIPPoolFromSDAVirtualNetworkRequest)
+	if m.DeleteIPPoolFromSDAVirtualNetworkFunc == nil {
+		panic("dnacmock: SDAAPI.DeleteIPPoolFromSDAVirtualNetwork called but DeleteIPPoolFromSDAVirtualNetworkFunc is not set")
+	}
+	return m.DeleteIPPoolFromSDAVirtualNetworkFunc(deleteIPPoolFromSDAVirtualNetworkQueryParams, deleteIPPoolFromSDAVirtualNetworkRequest)
+}
+
+// DeleteIPPoolFromSDAVirtualNetworkWithContext calls DeleteIPPoolFromSDAVirtualNetworkWithContextFunc.
+func (m *SDAAPI) DeleteIPPoolFromSDAVirtualNetworkWithContext(ctx context.Context, deleteIPPoolFromSDAVirtualNetworkQueryParams *dnac.DeleteIPPoolFromSDAVirtualNetworkQueryParams, deleteIPPoolFromSDAVirtualNetworkRequest *[]dnac.DeleteIPPoolFromSDAVirtualNetworkRequest) (*dnac.DeleteIPPoolFromSDAVirtualNetworkResponse, *resty.Response, error) {
+	m.record("DeleteIPPoolFromSDAVirtualNetworkWithContext", ctx, deleteIPPoolFromSDAVirtualNetworkQueryParams, deleteIPPoolFromSDAVirtualNetworkRequest)
+	if m.DeleteIPPoolFromSDAVirtualNetworkWithContextFunc == nil {
+		panic("dnacmock: SDAAPI.DeleteIPPoolFromSDAVirtualNetworkWithContext called but DeleteIPPoolFromSDAVirtualNetworkWithContextFunc is not set")
+	}
+	return m.DeleteIPPoolFromSDAVirtualNetworkWithContextFunc(ctx, deleteIPPoolFromSDAVirtualNetworkQueryParams, deleteIPPoolFromSDAVirtualNetworkRequest)
+}
+
+// DeletePortAssignmentForAccessPointInSDAFabric calls DeletePortAssignmentForAccessPointInSDAFabricFunc.
+func (m *SDAAPI) DeletePortAssignmentForAccessPointInSDAFabric(deletePortAssignmentForAccessPointInSDAFabricQueryParams *dnac.DeletePortAssignmentForAccessPointInSDAFabricQueryParams, deletePortAssignmentForAccessPointInSDAFabricRequest *[]dnac.DeletePortAssignmentForAccessPointInSDAFabricRequest) (*dnac.DeletePortAssignmentForAccessPointInSDAFabricResponse, *resty.Response, error) {
+	m.record("DeletePortAssignmentForAccessPointInSDAFabric", deletePortAssignmentForAccessPointInSDAFabricQueryParams, deletePortAssignmentForAccessPointInSDAFabricRequest)
+	if m.DeletePortAssignmentForAccessPointInSDAFabricFunc == nil {
+		panic("dnacmock: SDAAPI.DeletePortAssignmentForAccessPointInSDAFabric called but DeletePortAssignmentForAccessPointInSDAFabricFunc is not set")
+	}
+	return m.DeletePortAssignmentForAccessPointInSDAFabricFunc(deletePortAssignmentForAccessPointInSDAFabricQueryParams, deletePortAssignmentForAccessPointInSDAFabricRequest)
+}
+
+// DeletePortAssignmentForAccessPointInSDAFabricWithContext calls DeletePortAssignmentForAccessPointInSDAFabricWithContextFunc.
+func (m *SDAAPI) DeletePortAssignmentForAccessPointInSDAFabricWithContext(ctx context.Context, deletePortAssignmentForAccessPointInSDAFabricQueryParams *dnac.DeletePortAssignmentForAccessPointInSDAFabricQueryParams, deletePortAssignmentForAccessPointInSDAFabricRequest *[]dnac.DeletePortAssignmentForAccessPointInSDAFabricRequest) (*dnac.DeletePortAssignmentForAccessPointInSDAFabricResponse, *resty.Response, error) {
+	m.record("DeletePortAssignmentForAccessPointInSDAFabricWithContext", ctx, deletePortAssignmentForAccessPointInSDAFabricQueryParams, deletePortAssignmentForAccessPointInSDAFabricRequest)
+	if m.DeletePortAssignmentForAccessPointInSDAFabricWithContextFunc == nil {
+		panic("dnacmock: SDAAPI.DeletePortAssignmentForAccessPointInSDAFabricWithContext called but DeletePortAssignmentForAccessPointInSDAFabricWithContextFunc is not set")
+	}
+	return m.DeletePortAssignmentForAccessPointInSDAFabricWithContextFunc(ctx, deletePortAssignmentForAccessPointInSDAFabricQueryParams, deletePortAssignmentForAccessPointInSDAFabricRequest)
+}
+
+// DeletePortAssignmentForUserDeviceInSDAFabric calls DeletePortAssignmentForUserDeviceInSDAFabricFunc.
+func (m *SDAAPI) DeletePortAssignmentForUserDeviceInSDAFabric(deletePortAssignmentForUserDeviceInSDAFabricQueryParams *dnac.DeletePortAssignmentForUserDeviceInSDAFabricQueryParams, deletePortAssignmentForUserDeviceInSDAFabricRequest *[]dnac.DeletePortAssignmentForUserDeviceInSDAFabricRequest) (*dnac.DeletePortAssignmentForUserDeviceInSDAFabricResponse, *resty.Response, error) {
+	m.record("DeletePortAssignmentForUserDeviceInSDAFabric", deletePortAssignmentForUserDeviceInSDAFabricQueryParams, deletePortAssignmentForUserDeviceInSDAFabricRequest)
+	if m.DeletePortAssignmentForUserDeviceInSDAFabricFunc == nil {
+		panic("dnacmock: SDAAPI.DeletePortAssignmentForUserDeviceInSDAFabric called but DeletePortAssignmentForUserDeviceInSDAFabricFunc is not set")
+	}
+	return m.DeletePortAssignmentForUserDeviceInSDAFabricFunc(deletePortAssignmentForUserDeviceInSDAFabricQueryParams, deletePortAssignmentForUserDeviceInSDAFabricRequest)
+}
+
+// DeletePortAssignmentForUserDeviceInSDAFabricWithContext calls DeletePortAssignmentForUserDeviceInSDAFabricWithContextFunc.
+func (m *SDAAPI) DeletePortAssignmentForUserDeviceInSDAFabricWithContext(ctx context.Context, deletePortAssignmentForUserDeviceInSDAFabricQueryParams *dnac.DeletePortAssignmentForUserDeviceInSDAFabricQueryParams, deletePortAssignmentForUserDeviceInSDAFabricRequest *[]dnac.DeletePortAssignmentForUserDeviceInSDAFabricRequest) (*dnac.DeletePortAssignmentForUserDeviceInSDAFabricResponse, *resty.Response, error) {
+	m.record("DeletePortAssignmentForUserDeviceInSDAFabricWithContext", ctx, deletePortAssignmentForUserDeviceInSDAFabricQueryParams, deletePortAssignmentForUserDeviceInSDAFabricRequest)
+	if m.DeletePortAssignmentForUserDeviceInSDAFabricWithContextFunc == nil {
+		panic("dnacmock: SDAAPI.DeletePortAssignmentForUserDeviceInSDAFabricWithContext called but DeletePortAssignmentForUserDeviceInSDAFabricWithContextFunc is not set")
+	}
+	return m.DeletePortAssignmentForUserDeviceInSDAFabricWithContextFunc(ctx, deletePortAssignmentForUserDeviceInSDAFabricQueryParams, deletePortAssignmentForUserDeviceInSDAFabricRequest)
+}
+
+// DeleteSDAFabric calls DeleteSDAFabricFunc.
+func (m *SDAAPI) DeleteSDAFabric(deleteSDAFabricQueryParams *dnac.DeleteSDAFabricQueryParams, deleteSDAFabricRequest *[]dnac.DeleteSDAFabricRequest) (*dnac.DeleteSDAFabricResponse, *resty.Response, error) {
+	m.record("DeleteSDAFabric", deleteSDAFabricQueryParams, deleteSDAFabricRequest)
+	if m.DeleteSDAFabricFunc == nil {
+		panic("dnacmock: SDAAPI.DeleteSDAFabric called but DeleteSDAFabricFunc is not set")
+	}
+	return m.DeleteSDAFabricFunc(deleteSDAFabricQueryParams, deleteSDAFabricRequest)
+}
+
+// DeleteSDAFabricWithContext calls DeleteSDAFabricWithContextFunc.
+func (m *SDAAPI) DeleteSDAFabricWithContext(ctx context.Context, deleteSDAFabricQueryParams *dnac.DeleteSDAFabricQueryParams, deleteSDAFabricRequest *[]dnac.DeleteSDAFabricRequest) (*dnac.DeleteSDAFabricResponse, *resty.Response, error) {
+	m.record("DeleteSDAFabricWithContext", ctx, deleteSDAFabricQueryParams, deleteSDAFabricRequest)
+	if m.DeleteSDAFabricWithContextFunc == nil {
+		panic("dnacmock: SDAAPI.DeleteSDAFabricWithContext called but DeleteSDAFabricWithContextFunc is not set")
+	}
+	return m.DeleteSDAFabricWithContextFunc(ctx, deleteSDAFabricQueryParams, deleteSDAFabricRequest)
+}
+
+// DeleteSiteFromSDAFabric calls DeleteSiteFromSDAFabricFunc.
+func (m *SDAAPI) DeleteSiteFromSDAFabric(deleteSiteFromSDAFabricQueryParams *dnac.DeleteSiteFromSDAFabricQueryParams, deleteSiteFromSDAFabricRequest *[]dnac.DeleteSiteFromSDAFabricRequest) (*dnac.DeleteSiteFromSDAFabricResponse, *resty.Response, error) {
+	m.record("DeleteSiteFromSDAFabric", deleteSiteFromSDAFabricQueryParams, deleteSiteFromSDAFabricRequest)
+	if m.DeleteSiteFromSDAFabricFunc == nil {
+		panic("dnacmock: SDAAPI.DeleteSiteFromSDAFabric called but DeleteSiteFromSDAFabricFunc is not set")
+	}
+	return m.DeleteSiteFromSDAFabricFunc(deleteSiteFromSDAFabricQueryParams, deleteSiteFromSDAFabricRequest)
+}
+
+// DeleteSiteFromSDAFabricWithContext calls DeleteSiteFromSDAFabricWithContextFunc.
+func (m *SDAAPI) DeleteSiteFromSDAFabricWithContext(ctx context.Context, deleteSiteFromSDAFabricQueryParams *dnac.DeleteSiteFromSDAFabricQueryParams, deleteSiteFromSDAFabricRequest *[]dnac.DeleteSiteFromSDAFabricRequest) (*dnac.DeleteSiteFromSDAFabricResponse, *resty.Response, error) {
+	m.record("DeleteSiteFromSDAFabricWithContext", ctx, deleteSiteFromSDAFabricQueryParams, deleteSiteFromSDAFabricRequest)
+	if m.DeleteSiteFromSDAFabricWithContextFunc == nil {
+		panic("dnacmock: SDAAPI.DeleteSiteFromSDAFabricWithContext called but DeleteSiteFromSDAFabricWithContextFunc is not set")
+	}
+	return m.DeleteSiteFromSDAFabricWithContextFunc(ctx, deleteSiteFromSDAFabricQueryParams, deleteSiteFromSDAFabricRequest)
+}
+
+// DeleteVNFromSDAFabric calls DeleteVNFromSDAFabricFunc.
+func (m *SDAAPI) DeleteVNFromSDAFabric(deleteVNFromSDAFabricQueryParams *dnac.DeleteVNFromSDAFabricQueryParams) (*dnac.DeleteVNFromSDAFabricResponse, *resty.Response, error) {
+	m.record("DeleteVNFromSDAFabric", deleteVNFromSDAFabricQueryParams)
+	if m.DeleteVNFromSDAFabricFunc == nil {
+		panic("dnacmock: SDAAPI.DeleteVNFromSDAFabric called but DeleteVNFromSDAFabricFunc is not set")
+	}
+	return m.DeleteVNFromSDAFabricFunc(deleteVNFromSDAFabricQueryParams)
+}
+
+// DeleteVNFromSDAFabricWithContext calls DeleteVNFromSDAFabricWithContextFunc.
+func (m *SDAAPI) DeleteVNFromSDAFabricWithContext(ctx context.Context, deleteVNFromSDAFabricQueryParams *dnac.DeleteVNFromSDAFabricQueryParams) (*dnac.DeleteVNFromSDAFabricResponse, *resty.Response, error) {
+	m.record("DeleteVNFromSDAFabricWithContext", ctx, deleteVNFromSDAFabricQueryParams)
+	if m.DeleteVNFromSDAFabricWithContextFunc == nil {
+		panic("dnacmock: SDAAPI.DeleteVNFromSDAFabricWithContext called but DeleteVNFromSDAFabricWithContextFunc is not set")
+	}
+	return m.DeleteVNFromSDAFabricWithContextFunc(ctx, deleteVNFromSDAFabricQueryParams)
+}
+
+// DeletesBorderDeviceFromSDAFabric calls DeletesBorderDeviceFromSDAFabricFunc.
+func (m *SDAAPI) DeletesBorderDeviceFromSDAFabric(deletesBorderDeviceFromSDAFabricQueryParams *dnac.DeletesBorderDeviceFromSDAFabricQueryParams) (*dnac.DeletesBorderDeviceFromSDAFabricResponse, *resty.Response, error) {
+	m.record("DeletesBorderDeviceFromSDAFabric", deletesBorderDeviceFromSDAFabricQueryParams)
+	if m.DeletesBorderDeviceFromSDAFabricFunc == nil {
+		panic("dnacmock: SDAAPI.DeletesBorderDeviceFromSDAFabric called but DeletesBorderDeviceFromSDAFabricFunc is not set")
+	}
+	return m.DeletesBorderDeviceFromSDAFabricFunc(deletesBorderDeviceFromSDAFabricQueryParams)
+}
+
+// DeletesBorderDeviceFromSDAFabricWithContext calls DeletesBorderDeviceFromSDAFabricWithContextFunc.
+func (m *SDAAPI) DeletesBorderDeviceFromSDAFabricWithContext(ctx context.Context, deletesBorderDeviceFromSDAFabricQueryParams *dnac.DeletesBorderDeviceFromSDAFabricQueryParams) (*dnac.DeletesBorderDeviceFromSDAFabricResponse, *resty.Response, error) {
+	m.record("DeletesBorderDeviceFromSDAFabricWithContext", ctx, deletesBorderDeviceFromSDAFabricQueryParams)
+	if m.DeletesBorderDeviceFromSDAFabricWithContextFunc == nil {
+		panic("dnacmock: SDAAPI.DeletesBorderDeviceFromSDAFabricWithContext called but DeletesBorderDeviceFromSDAFabricWithContextFunc is not set")
+	}
+	return m.DeletesBorderDeviceFromSDAFabricWithContextFunc(ctx, deletesBorderDeviceFromSDAFabricQueryParams)
+}
+
+// GetControlPlaneDeviceFromSDAFabric calls GetControlPlaneDeviceFromSDAFabricFunc.
+func (m *SDAAPI) GetControlPlaneDeviceFromSDAFabric(getControlPlaneDeviceFromSDAFabricQueryParams *dnac.GetControlPlaneDeviceFromSDAFabricQueryParams) (*dnac.GetControlPlaneDeviceFromSDAFabricResponse, *resty.Response, error) {
+	m.record("GetControlPlaneDeviceFromSDAFabric", getControlPlaneDeviceFromSDAFabricQueryParams)
+	if m.GetControlPlaneDeviceFromSDAFabricFunc == nil {
+		panic("dnacmock: SDAAPI.GetControlPlaneDeviceFromSDAFabric called but GetControlPlaneDeviceFromSDAFabricFunc is not set")
+	}
+	return m.GetControlPlaneDeviceFromSDAFabricFunc(getControlPlaneDeviceFromSDAFabricQueryParams)
+}
+
+// GetControlPlaneDeviceFromSDAFabricWithContext calls GetControlPlaneDeviceFromSDAFabricWithContextFunc.
+func (m *SDAAPI) GetControlPlaneDeviceFromSDAFabricWithContext(ctx context.Context, getControlPlaneDeviceFromSDAFabricQueryParams *dnac.GetControlPlaneDeviceFromSDAFabricQueryParams) (*dnac.GetControlPlaneDeviceFromSDAFabricResponse, *resty.Response, error) {
+	m.record("GetControlPlaneDeviceFromSDAFabricWithContext", ctx, getControlPlaneDeviceFromSDAFabricQueryParams)
+	if m.GetControlPlaneDeviceFromSDAFabricWithContextFunc == nil {
+		panic("dnacmock: SDAAPI.GetControlPlaneDeviceFromSDAFabricWithContext called but GetControlPlaneDeviceFromSDAFabricWithContextFunc is not set")
+	}
+	return m.GetControlPlaneDeviceFromSDAFabricWithContextFunc(ctx, getControlPlaneDeviceFromSDAFabricQueryParams)
+}
+
+// GetDefaultAuthenticationProfileFromSDAFabric calls GetDefaultAuthenticationProfileFromSDAFabricFunc.
+func (m *SDAAPI) GetDefaultAuthenticationProfileFromSDAFabric(getDefaultAuthenticationProfileFromSDAFabricQueryParams *dnac.GetDefaultAuthenticationProfileFromSDAFabricQueryParams) (*dnac.GetDefaultAuthenticationProfileFromSDAFabricResponse, *resty.Response, error) {
+	m.record("GetDefaultAuthenticationProfileFromSDAFabric", getDefaultAuthenticationProfileFromSDAFabricQueryParams)
+	if m.GetDefaultAuthenticationProfileFromSDAFabricFunc == nil {
+		panic("dnacmock: SDAAPI.GetDefaultAuthenticationProfileFromSDAFabric called but GetDefaultAuthenticationProfileFromSDAFabricFunc is not set")
+	}
+	return m.GetDefaultAuthenticationProfileFromSDAFabricFunc(getDefaultAuthenticationProfileFromSDAFabricQueryParams)
+}
+
+// GetDefaultAuthenticationProfileFromSDAFabricWithContext calls GetDefaultAuthenticationProfileFromSDAFabricWithContextFunc.
+func (m *SDAAPI) GetDefaultAuthenticationProfileFromSDAFabricWithContext(ctx context.Context, getDefaultAuthenticationProfileFromSDAFabricQueryParams *dnac.GetDefaultAuthenticationProfileFromSDAFabricQueryParams) (*dnac.GetDefaultAuthenticationProfileFromSDAFabricResponse, *resty.Response, error) {
+	m.record("GetDefaultAuthenticationProfileFromSDAFabricWithContext", ctx, getDefaultAuthenticationProfileFromSDAFabricQueryParams)
+	if m.GetDefaultAuthenticationProfileFromSDAFabricWithContextFunc == nil {
+		panic("dnacmock: SDAAPI.GetDefaultAuthenticationProfileFromSDAFabricWithContext called but GetDefaultAuthenticationProfileFromSDAFabricWithContextFunc is not set")
+	}
+	return m.GetDefaultAuthenticationProfileFromSDAFabricWithContextFunc(ctx, getDefaultAuthenticationProfileFromSDAFabricQueryParams)
+}
+
+// GetDeviceInfoFromSDAFabric calls GetDeviceInfoFromSDAFabricFunc.
+func (m *SDAAPI) GetDeviceInfoFromSDAFabric(getDeviceInfoFromSDAFabricQueryParams *dnac.GetDeviceInfoFromSDAFabricQueryParams) (*dnac.GetDeviceInfoFromSDAFabricResponse, *resty.Response, error) {
+	m.record("GetDeviceInfoFromSDAFabric", getDeviceInfoFromSDAFabricQueryParams)
+	if m.GetDeviceInfoFromSDAFabricFunc == nil {
+		panic("dnacmock: SDAAPI.GetDeviceInfoFromSDAFabric called but GetDeviceInfoFromSDAFabricFunc is not set")
+	}
+	return m.GetDeviceInfoFromSDAFabricFunc(getDeviceInfoFromSDAFabricQueryParams)
+}
+
+// GetDeviceInfoFromSDAFabricWithContext calls GetDeviceInfoFromSDAFabricWithContextFunc.
+func (m *SDAAPI) GetDeviceInfoFromSDAFabricWithContext(ctx context.Context, getDeviceInfoFromSDAFabricQueryParams *dnac.GetDeviceInfoFromSDAFabricQueryParams) (*dnac.GetDeviceInfoFromSDAFabricResponse, *resty.Response, error) {
+	m.record("GetDeviceInfoFromSDAFabricWithContext", ctx, getDeviceInfoFromSDAFabricQueryParams)
+	if m.GetDeviceInfoFromSDAFabricWithContextFunc == nil {
+		panic("dnacmock: SDAAPI.GetDeviceInfoFromSDAFabricWithContext called but GetDeviceInfoFromSDAFabricWithContextFunc is not set")
+	}
+	return m.GetDeviceInfoFromSDAFabricWithContextFunc(ctx, getDeviceInfoFromSDAFabricQueryParams)
+}
+
+// GetDeviceRoleInSDAFabric calls GetDeviceRoleInSDAFabricFunc.
+func (m *SDAAPI) GetDeviceRoleInSDAFabric(getDeviceRoleInSDAFabricQueryParams *dnac.GetDeviceRoleInSDAFabricQueryParams) (*dnac.GetDeviceRoleInSDAFabricResponse, *resty.Response, error) {
+	m.record("GetDeviceRoleInSDAFabric", getDeviceRoleInSDAFabricQueryParams)
+	if m.GetDeviceRoleInSDAFabricFunc == nil {
+		panic("dnacmock: SDAAPI.GetDeviceRoleInSDAFabric called but GetDeviceRoleInSDAFabricFunc is not set")
+	}
+	return m.GetDeviceRoleInSDAFabricFunc(getDeviceRoleInSDAFabricQueryParams)
+}
+
+// GetDeviceRoleInSDAFabricWithContext calls GetDeviceRoleInSDAFabricWithContextFunc.
+func (m *SDAAPI) GetDeviceRoleInSDAFabricWithContext(ctx context.Context, getDeviceRoleInSDAFabricQueryParams *dnac.GetDeviceRoleInSDAFabricQueryParams) (*dnac.GetDeviceRoleInSDAFabricResponse, *resty.Response, error) {
+	m.record("GetDeviceRoleInSDAFabricWithContext", ctx, getDeviceRoleInSDAFabricQueryParams)
+	if m.GetDeviceRoleInSDAFabricWithContextFunc == nil {
+		panic("dnacmock: SDAAPI.GetDeviceRoleInSDAFabricWithContext called but GetDeviceRoleInSDAFabricWithContextFunc is not set")
+	}
+	return m.GetDeviceRoleInSDAFabricWithContextFunc(ctx, getDeviceRoleInSDAFabricQueryParams)
+}
+
+// GetEdgeDeviceFromSDAFabric calls GetEdgeDeviceFromSDAFabricFunc.
+func (m *SDAAPI) GetEdgeDeviceFromSDAFabric(getEdgeDeviceFromSDAFabricQueryParams *dnac.GetEdgeDeviceFromSDAFabricQueryParams) (*dnac.GetEdgeDeviceFromSDAFabricResponse, *resty.Response, error) {
+	m.record("GetEdgeDeviceFromSDAFabric", getEdgeDeviceFromSDAFabricQueryParams)
+	if m.GetEdgeDeviceFromSDAFabricFunc == nil {
+		panic("dnacmock: SDAAPI.GetEdgeDeviceFromSDAFabric called but GetEdgeDeviceFromSDAFabricFunc is not set")
+	}
+	return m.GetEdgeDeviceFromSDAFabricFunc(getEdgeDeviceFromSDAFabricQueryParams)
+}
+
+// GetEdgeDeviceFromSDAFabricWithContext calls GetEdgeDeviceFromSDAFabricWithContextFunc.
+func (m *SDAAPI) GetEdgeDeviceFromSDAFabricWithContext(ctx context.Context, getEdgeDeviceFromSDAFabricQueryParams *dnac.GetEdgeDeviceFromSDAFabricQueryParams) (*dnac.GetEdgeDeviceFromSDAFabricResponse, *resty.Response, error) {
+	m.record("GetEdgeDeviceFromSDAFabricWithContext", ctx, getEdgeDeviceFromSDAFabricQueryParams)
+	if m.GetEdgeDeviceFromSDAFabricWithContextFunc == nil {
+		panic("dnacmock: SDAAPI.GetEdgeDeviceFromSDAFabricWithContext called but GetEdgeDeviceFromSDAFabricWithContextFunc is not set")
+	}
+	return m.GetEdgeDeviceFromSDAFabricWithContextFunc(ctx, getEdgeDeviceFromSDAFabricQueryParams)
+}
+
+// GetIPPoolFromSDAVirtualNetwork calls GetIPPoolFromSDAVirtualNetworkFunc.
+func (m *SDAAPI) GetIPPoolFromSDAVirtualNetwork(getIPPoolFromSDAVirtualNetworkQueryParams *dnac.GetIPPoolFromSDAVirtualNetworkQueryParams) (*dnac.GetIPPoolFromSDAVirtualNetworkResponse, *resty.Response, error) {
+	m.record("GetIPPoolFromSDAVirtualNetwork", getIPPoolFromSDAVirtualNetworkQueryParams)
+	if m.GetIPPoolFromSDAVirtualNetworkFunc == nil {
+		panic("dnacmock: SDAAPI.GetIPPoolFromSDAVirtualNetwork called but GetIPPoolFromSDAVirtualNetworkFunc is not set")
+	}
+	return m.GetIPPoolFromSDAVirtualNetworkFunc(getIPPoolFromSDAVirtualNetworkQueryParams)
+}
+
+// GetIPPoolFromSDAVirtualNetworkWithContext calls GetIPPoolFromSDAVirtualNetworkWithContextFunc.
+func (m *SDAAPI) GetIPPoolFromSDAVirtualNetworkWithContext(ctx context.Context, getIPPoolFromSDAVirtualNetworkQueryParams *dnac.GetIPPoolFromSDAVirtualNetworkQueryParams) (*dnac.GetIPPoolFromSDAVirtualNetworkResponse, *resty.Response, error) {
+	m.record("GetIPPoolFromSDAVirtualNetworkWithContext", ctx, getIPPoolFromSDAVirtualNetworkQueryParams)
+	if m.GetIPPoolFromSDAVirtualNetworkWithContextFunc == nil {
+		panic("dnacmock: SDAAPI.GetIPPoolFromSDAVirtualNetworkWithContext called but GetIPPoolFromSDAVirtualNetworkWithContextFunc is not set")
+	}
+	return m.GetIPPoolFromSDAVirtualNetworkWithContextFunc(ctx, getIPPoolFromSDAVirtualNetworkQueryParams)
+}
+
+// GetPortAssignmentForAccessPointInSDAFabric calls GetPortAssignmentForAccessPointInSDAFabricFunc.
+func (m *SDAAPI) GetPortAssignmentForAccessPointInSDAFabric(getPortAssignmentForAccessPointInSDAFabricQueryParams *dnac.GetPortAssignmentForAccessPointInSDAFabricQueryParams) (*dnac.GetPortAssignmentForAccessPointInSDAFabricResponse, *resty.Response, error) {
+	m.record("GetPortAssignmentForAccessPointInSDAFabric", getPortAssignmentForAccessPointInSDAFabricQueryParams)
+	if m.GetPortAssignmentForAccessPointInSDAFabricFunc == nil {
+		panic("dnacmock: SDAAPI.GetPortAssignmentForAccessPointInSDAFabric called but GetPortAssignmentForAccessPointInSDAFabricFunc is not set")
+	}
+	return m.GetPortAssignmentForAccessPointInSDAFabricFunc(getPortAssignmentForAccessPointInSDAFabricQueryParams)
+}
+
+// GetPortAssignmentForAccessPointInSDAFabricWithContext calls GetPortAssignmentForAccessPointInSDAFabricWithContextFunc.
+func (m *SDAAPI) GetPortAssignmentForAccessPointInSDAFabricWithContext(ctx context.Context, getPortAssignmentForAccessPointInSDAFabricQueryParams *dnac.GetPortAssignmentForAccessPointInSDAFabricQueryParams) (*dnac.GetPortAssignmentForAccessPointInSDAFabricResponse, *resty.Response, error) {
+	m.record("GetPortAssignmentForAccessPointInSDAFabricWithContext", ctx, getPortAssignmentForAccessPointInSDAFabricQueryParams)
+	if m.GetPortAssignmentForAccessPointInSDAFabricWithContextFunc == nil {
+		panic("dnacmock: SDAAPI.GetPortAssignmentForAccessPointInSDAFabricWithContext called but GetPortAssignmentForAccessPointInSDAFabricWithContextFunc is not set")
+	}
+	return m.GetPortAssignmentForAccessPointInSDAFabricWithContextFunc(ctx, getPortAssignmentForAccessPointInSDAFabricQueryParams)
+}
+
+// GetPortAssignmentForUserDeviceInSDAFabric calls GetPortAssignmentForUserDeviceInSDAFabricFunc.
+func (m *SDAAPI) GetPortAssignmentForUserDeviceInSDAFabric(getPortAssignmentForUserDeviceInSDAFabricQueryParams *dnac.GetPortAssignmentForUserDeviceInSDAFabricQueryParams) (*dnac.GetPortAssignmentForUserDeviceInSDAFabricResponse, *resty.Response, error) {
+	m.record("GetPortAssignmentForUserDeviceInSDAFabric", getPortAssignmentForUserDeviceInSDAFabricQueryParams)
+	if m.GetPortAssignmentForUserDeviceInSDAFabricFunc == nil {
+		panic("dnacmock: SDAAPI.GetPortAssignmentForUserDeviceInSDAFabric called but GetPortAssignmentForUserDeviceInSDAFabricFunc is not set")
+	}
+	return m.GetPortAssignmentForUserDeviceInSDAFabricFunc(getPortAssignmentForUserDeviceInSDAFabricQueryParams)
+}
+
+// GetPortAssignmentForUserDeviceInSDAFabricWithContext calls GetPortAssignmentForUserDeviceInSDAFabricWithContextFunc.
+func (m *SDAAPI) GetPortAssignmentForUserDeviceInSDAFabricWithContext(ctx context.Context, getPortAssignmentForUserDeviceInSDAFabricQueryParams *dnac.GetPortAssignmentForUserDeviceInSDAFabricQueryParams) (*dnac.GetPortAssignmentForUserDeviceInSDAFabricResponse, *resty.Response, error) {
+	m.record("GetPortAssignmentForUserDeviceInSDAFabricWithContext", ctx, getPortAssignmentForUserDeviceInSDAFabricQueryParams)
+	if m.GetPortAssignmentForUserDeviceInSDAFabricWithContextFunc == nil {
+		panic("dnacmock: SDAAPI.GetPortAssignmentForUserDeviceInSDAFabricWithContext called but GetPortAssignmentForUserDeviceInSDAFabricWithContextFunc is not set")
+	}
+	return m.GetPortAssignmentForUserDeviceInSDAFabricWithContextFunc(ctx, getPortAssignmentForUserDeviceInSDAFabricQueryParams)
+}
+
+// GetSDAFabricCount calls GetSDAFabricCountFunc.
+func (m *SDAAPI) GetSDAFabricCount() (*dnac.GetSDAFabricCountResponse, *resty.Response, error) {
+	m.record("GetSDAFabricCount")
+	if m.GetSDAFabricCountFunc == nil {
+		panic("dnacmock: SDAAPI.GetSDAFabricCount called but GetSDAFabricCountFunc is not set")
+	}
+	return m.GetSDAFabricCountFunc()
+}
+
+// GetSDAFabricCountWithContext calls GetSDAFabricCountWithContextFunc.
+func (m *SDAAPI) GetSDAFabricCountWithContext(ctx context.Context) (*dnac.GetSDAFabricCountResponse, *resty.Response, error) {
+	m.record("GetSDAFabricCountWithContext", ctx)
+	if m.GetSDAFabricCountWithContextFunc == nil {
+		panic("dnacmock: SDAAPI.GetSDAFabricCountWithContext called but GetSDAFabricCountWithContextFunc is not set")
+	}
+	return m.GetSDAFabricCountWithContextFunc(ctx)
+}
+
+// GetSDAFabricInfo calls GetSDAFabricInfoFunc.
+func (m *SDAAPI) GetSDAFabricInfo(getSDAFabricInfoQueryParams *dnac.GetSDAFabricInfoQueryParams) (*dnac.GetSDAFabricInfoResponse, *resty.Response, error) {
+	m.record("GetSDAFabricInfo", getSDAFabricInfoQueryParams)
+	if m.GetSDAFabricInfoFunc == nil {
+		panic("dnacmock: SDAAPI.GetSDAFabricInfo called but GetSDAFabricInfoFunc is not set")
+	}
+	return m.GetSDAFabricInfoFunc(getSDAFabricInfoQueryParams)
+}
+
+// GetSDAFabricInfoWithContext calls GetSDAFabricInfoWithContextFunc.
+func (m *SDAAPI) GetSDAFabricInfoWithContext(ctx context.Context, getSDAFabricInfoQueryParams *dnac.GetSDAFabricInfoQueryParams) (*dnac.GetSDAFabricInfoResponse, *resty.Response, error) {
+	m.record("GetSDAFabricInfoWithContext", ctx, getSDAFabricInfoQueryParams)
+	if m.GetSDAFabricInfoWithContextFunc == nil {
+		panic("dnacmock: SDAAPI.GetSDAFabricInfoWithContext called but GetSDAFabricInfoWithContextFunc is not set")
+	}
+	return m.GetSDAFabricInfoWithContextFunc(ctx, getSDAFabricInfoQueryParams)
+}
+
+// GetSiteFromSDAFabric calls GetSiteFromSDAFabricFunc.
+func (m *SDAAPI) GetSiteFromSDAFabric(getSiteFromSDAFabricQueryParams *dnac.GetSiteFromSDAFabricQueryParams) (*dnac.GetSiteFromSDAFabricResponse, *resty.Response, error) {
+	m.record("GetSiteFromSDAFabric", getSiteFromSDAFabricQueryParams)
+	if m.GetSiteFromSDAFabricFunc == nil {
+		panic("dnacmock: SDAAPI.GetSiteFromSDAFabric called but GetSiteFromSDAFabricFunc is not set")
+	}
+	return m.GetSiteFromSDAFabricFunc(getSiteFromSDAFabricQueryParams)
+}
+
+// GetSiteFromSDAFabricWithContext calls GetSiteFromSDAFabricWithContextFunc.
+func (m *SDAAPI) GetSiteFromSDAFabricWithContext(ctx context.Context, getSiteFromSDAFabricQueryParams *dnac.GetSiteFromSDAFabricQueryParams) (*dnac.GetSiteFromSDAFabricResponse, *resty.Response, error) {
+	m.record("GetSiteFromSDAFabricWithContext", ctx, getSiteFromSDAFabricQueryParams)
+	if m.GetSiteFromSDAFabricWithContextFunc == nil {
+		panic("dnacmock: SDAAPI.GetSiteFromSDAFabricWithContext called but GetSiteFromSDAFabricWithContextFunc is not set")
+	}
+	return m.GetSiteFromSDAFabricWithContextFunc(ctx, getSiteFromSDAFabricQueryParams)
+}
+
+// GetVNFromSDAFabric calls GetVNFromSDAFabricFunc.
+func (m *SDAAPI) GetVNFromSDAFabric(getVNFromSDAFabricQueryParams *dnac.GetVNFromSDAFabricQueryParams) (*dnac.GetVNFromSDAFabricResponse, *resty.Response, error) {
+	m.record("GetVNFromSDAFabric", getVNFromSDAFabricQueryParams)
+	if m.GetVNFromSDAFabricFunc == nil {
+		panic("dnacmock: SDAAPI.GetVNFromSDAFabric called but GetVNFromSDAFabricFunc is not set")
+	}
+	return m.GetVNFromSDAFabricFunc(getVNFromSDAFabricQueryParams)
+}
+
+// GetVNFromSDAFabricWithContext calls GetVNFromSDAFabricWithContextFunc.
+func (m *SDAAPI) GetVNFromSDAFabricWithContext(ctx context.Context, getVNFromSDAFabricQueryParams *dnac.GetVNFromSDAFabricQueryParams) (*dnac.GetVNFromSDAFabricResponse, *resty.Response, error) {
+	m.record("GetVNFromSDAFabricWithContext", ctx, getVNFromSDAFabricQueryParams)
+	if m.GetVNFromSDAFabricWithContextFunc == nil {
+		panic("dnacmock: SDAAPI.GetVNFromSDAFabricWithContext called but GetVNFromSDAFabricWithContextFunc is not set")
+	}
+	return m.GetVNFromSDAFabricWithContextFunc(ctx, getVNFromSDAFabricQueryParams)
+}
+
+// GetsBorderDeviceDetailFromSDAFabric calls GetsBorderDeviceDetailFromSDAFabricFunc.
+func (m *SDAAPI) GetsBorderDeviceDetailFromSDAFabric(getsBorderDeviceDetailFromSDAFabricQueryParams *dnac.GetsBorderDeviceDetailFromSDAFabricQueryParams) (*dnac.GetsBorderDeviceDetailFromSDAFabricResponse, *resty.Response, error) {
+	m.record("GetsBorderDeviceDetailFromSDAFabric", getsBorderDeviceDetailFromSDAFabricQueryParams)
+	if m.GetsBorderDeviceDetailFromSDAFabricFunc == nil {
+		panic("dnacmock: SDAAPI.GetsBorderDeviceDetailFromSDAFabric called but GetsBorderDeviceDetailFromSDAFabricFunc is not set")
+	}
+	return m.GetsBorderDeviceDetailFromSDAFabricFunc(getsBorderDeviceDetailFromSDAFabricQueryParams)
+}
+
+// GetsBorderDeviceDetailFromSDAFabricWithContext calls GetsBorderDeviceDetailFromSDAFabricWithContextFunc.
+func (m *SDAAPI) GetsBorderDeviceDetailFromSDAFabricWithContext(ctx context.Context, getsBorderDeviceDetailFromSDAFabricQueryParams *dnac.GetsBorderDeviceDetailFromSDAFabricQueryParams) (*dnac.GetsBorderDeviceDetailFromSDAFabricResponse, *resty.Response, error) {
+	m.record("GetsBorderDeviceDetailFromSDAFabricWithContext", ctx, getsBorderDeviceDetailFromSDAFabricQueryParams)
+	if m.GetsBorderDeviceDetailFromSDAFabricWithContextFunc == nil {
+		panic("dnacmock: SDAAPI.GetsBorderDeviceDetailFromSDAFabricWithContext called but GetsBorderDeviceDetailFromSDAFabricWithContextFunc is not set")
+	}
+	return m.GetsBorderDeviceDetailFromSDAFabricWithContextFunc(ctx, getsBorderDeviceDetailFromSDAFabricQueryParams)
+}
+
+// PlanFabric calls PlanFabricFunc.
+func (m *SDAAPI) PlanFabric(ctx context.Context, spec *dnac.FabricSpec) (*dnac.FabricPlan, error) {
+	m.record("PlanFabric", ctx, spec)
+	if m.PlanFabricFunc == nil {
+		panic("dnacmock: SDAAPI.PlanFabric called but PlanFabricFunc is not set")
+	}
+	return m.PlanFabricFunc(ctx, spec)
+}
+
+// Reconcile calls ReconcileFunc.
+func (m *SDAAPI) Reconcile(ctx context.Context, spec *dnac.FabricSpec, opts *dnac.ReconcileOptions) (*dnac.FabricPlan, error) {
+	m.record("Reconcile", ctx, spec, opts)
+	if m.ReconcileFunc == nil {
+		panic("dnacmock: SDAAPI.Reconcile called but ReconcileFunc is not set")
+	}
+	return m.ReconcileFunc(ctx, spec, opts)
+}
+
+// UpdateDefaultAuthenticationProfileInSDAFabric calls UpdateDefaultAuthenticationProfileInSDAFabricFunc.
+func (m *SDAAPI) UpdateDefaultAuthenticationProfileInSDAFabric(updateDefaultAuthenticationProfileInSDAFabricRequest *[]dnac.UpdateDefaultAuthenticationProfileInSDAFabricRequest) (*dnac.UpdateDefaultAuthenticationProfileInSDAFabricResponse, *resty.Response, error) {
+	m.record("UpdateDefaultAuthenticationProfileInSDAFabric", updateDefaultAuthenticationProfileInSDAFabricRequest)
+	if m.UpdateDefaultAuthenticationProfileInSDAFabricFunc == nil {
+		panic("dnacmock: SDAAPI.UpdateDefaultAuthenticationProfileInSDAFabric called but UpdateDefaultAuthenticationProfileInSDAFabricFunc is not set")
+	}
+	return m.UpdateDefaultAuthenticationProfileInSDAFabricFunc(updateDefaultAuthenticationProfileInSDAFabricRequest)
+}
+
+// UpdateDefaultAuthenticationProfileInSDAFabricWithContext calls UpdateDefaultAuthenticationProfileInSDAFabricWithContextFunc.
+func (m *SDAAPI) UpdateDefaultAuthenticationProfileInSDAFabricWithContext(ctx context.Context, updateDefaultAuthenticationProfileInSDAFabricRequest *[]dnac.UpdateDefaultAuthenticationProfileInSDAFabricRequest) (*dnac.UpdateDefaultAuthenticationProfileInSDAFabricResponse, *resty.Response, error) {
+	m.record("UpdateDefaultAuthenticationProfileInSDAFabricWithContext", ctx, updateDefaultAuthenticationProfileInSDAFabricRequest)
+	if m.UpdateDefaultAuthenticationProfileInSDAFabricWithContextFunc == nil {
+		panic("dnacmock: SDAAPI.UpdateDefaultAuthenticationProfileInSDAFabricWithContext called but UpdateDefaultAuthenticationProfileInSDAFabricWithContextFunc is not set")
+	}
+	return m.UpdateDefaultAuthenticationProfileInSDAFabricWithContextFunc(ctx, updateDefaultAuthenticationProfileInSDAFabricRequest)
+}
+
+// SensorsAPI is a mock of dnac.SensorsAPI. Each method calls the function of the
+// same name suffixed with Func, which must be set if the method is called.
+type SensorsAPI struct {
+	Recorder
+
+	CreateSensorTestTemplateFunc               func(createSensorTestTemplateRequest *dnac.CreateSensorTestTemplateRequest) (*dnac.CreateSensorTestTemplateResponse, *resty.Response, error)
+	CreateSensorTestTemplateWithContextFunc    func(ctx context.Context, createSensorTestTemplateRequest *dnac.CreateSensorTestTemplateRequest) (*dnac.CreateSensorTestTemplateResponse, *resty.Response, error)
+	DeleteSensorTestFunc                       func(deleteSensorTestQueryParams *dnac.DeleteSensorTestQueryParams) (*dnac.DeleteSensorTestResponse, *resty.Response, error)
+	DeleteSensorTestWithContextFunc            func(ctx context.Context, deleteSensorTestQueryParams *dnac.DeleteSensorTestQueryParams) (*dnac.DeleteSensorTestResponse, *resty.Response, error)
+	DuplicateSensorTestTemplateFunc            func(duplicateSensorTestTemplateRequest *dnac.DuplicateSensorTestTemplateRequest) (*dnac.DuplicateSensorTestTemplateResponse, *resty.Response, error)
+	DuplicateSensorTestTemplateWithContextFunc func(ctx context.Context, duplicateSensorTestTemplateRequest *dnac.DuplicateSensorTestTemplateRequest) (*dnac.DuplicateSensorTestTemplateResponse, *resty.Response, error)
+	EditSensorTestTemplateFunc                 func(editSensorTestTemplateRequest *dnac.EditSensorTestTemplateRequest) (*dnac.EditSensorTestTemplateResponse, *resty.Response, error)
+	EditSensorTestTemplateWithContextFunc      func(ctx context.Context, editSensorTestTemplateRequest *dnac.EditSensorTestTemplateRequest) (*dnac.EditSensorTestTemplateResponse, *resty.Response, error)
+	RunNowSensorTestFunc                       func(runNowSensorTestRequest *dnac.RunNowSensorTestRequest) (*resty.Response, error)
+	RunNowSensorTestWithContextFunc            func(ctx context.Context, runNowSensorTestRequest *dnac.RunNowSensorTestRequest) (*resty.Response, error)
+	SensorsFunc                                func(sensorsQueryParams *dnac.SensorsQueryParams) (*dnac.SensorsResponse, *resty.Response, error)
+	SensorsWithContextFunc                     func(ctx context.Context, sensorsQueryParams *dnac.SensorsQueryParams) (*dnac.SensorsResponse, *resty.Response, error)
+}
+
+// CreateSensorTestTemplate calls CreateSensorTestTemplateFunc.
+func (m *SensorsAPI) CreateSensorTestTemplate(createSensorTestTemplateRequest *dnac.CreateSensorTestTemplateRequest) (*dnac.CreateSensorTestTemplateResponse, *resty.Response, error) {
+	m.record("CreateSensorTestTemplate", createSensorTestTemplateRequest)
+	if m.CreateSensorTestTemplateFunc == nil {
+		panic("dnacmock: SensorsAPI.CreateSensorTestTemplate called but CreateSensorTestTemplateFunc is not set")
+	}
+	return m.CreateSensorTestTemplateFunc(createSensorTestTemplateRequest)
+}
+
+// CreateSensorTestTemplateWithContext calls CreateSensorTestTemplateWithContextFunc.
+func (m *SensorsAPI) CreateSensorTestTemplateWithContext(ctx context.Context, createSensorTestTemplateRequest *dnac.CreateSensorTestTemplateRequest) (*dnac.CreateSensorTestTemplateResponse, *resty.Response, error) {
+	m.record("CreateSensorTestTemplateWithContext", ctx, createSensorTestTemplateRequest)
+	if m.CreateSensorTestTemplateWithContextFunc == nil {
+		panic("dnacmock: SensorsAPI.CreateSensorTestTemplateWithContext called but CreateSensorTestTemplateWithContextFunc is not set")
+	}
+	return m.CreateSensorTestTemplateWithContextFunc(ctx, createSensorTestTemplateRequest)
+}
+
+// DeleteSensorTest calls DeleteSensorTestFunc.
+func (m *SensorsAPI) DeleteSensorTest(deleteSensorTestQueryParams *dnac.DeleteSensorTestQueryParams) (*dnac.DeleteSensorTestResponse, *resty.Response, error) {
+	m.record("DeleteSensorTest", deleteSensorTestQueryParams)
+	if m.DeleteSensorTestFunc == nil {
+		panic("dnacmock: SensorsAPI.DeleteSensorTest called but DeleteSensorTestFunc is not set")
+	}
+	return m.DeleteSensorTestFunc(deleteSensorTestQueryParams)
+}
+
+// DeleteSensorTestWithContext calls DeleteSensorTestWithContextFunc.
+func (m *SensorsAPI) DeleteSensorTestWithContext(ctx context.Context, deleteSensorTestQueryParams *dnac.DeleteSensorTestQueryParams) (*dnac.DeleteSensorTestResponse, *resty.Response, error) {
+	m.record("DeleteSensorTestWithContext", ctx, deleteSensorTestQueryParams)
+	if m.DeleteSensorTestWithContextFunc == nil {
+		panic("dnacmock: SensorsAPI.DeleteSensorTestWithContext called but DeleteSensorTestWithContextFunc is not set")
+	}
+	return m.DeleteSensorTestWithContextFunc(ctx, deleteSensorTestQueryParams)
+}
+
+// DuplicateSensorTestTemplate calls DuplicateSensorTestTemplateFunc.
+func (m *SensorsAPI) DuplicateSensorTestTemplate(duplicateSensorTestTemplateRequest *dnac.DuplicateSensorTestTemplateRequest) (*dnac.DuplicateSensorTestTemplateResponse, *resty.Response, error) {
+	m.record("DuplicateSensorTestTemplate", duplicateSensorTestTemplateRequest)
+	if m.DuplicateSensorTestTemplateFunc == nil {
+		panic("dnacmock: SensorsAPI.DuplicateSensorTestTemplate called but DuplicateSensorTestTemplateFunc is not set")
+	}
+	return m.DuplicateSensorTestTemplateFunc(duplicateSensorTestTemplateRequest)
+}
+
+// DuplicateSensorTestTemplateWithContext calls DuplicateSensorTestTemplateWithContextFunc.
+func (m *SensorsAPI) DuplicateSensorTestTemplateWithContext(ctx context.Context, duplicateSensorTestTemplateRequest *dnac.DuplicateSensorTestTemplateRequest) (*dnac.DuplicateSensorTestTemplateResponse, *resty.Response, error) {
+	m.record("DuplicateSensorTestTemplateWithContext", ctx, duplicateSensorTestTemplateRequest)
+	if m.DuplicateSensorTestTemplateWithContextFunc == nil {
+		panic("dnacmock: SensorsAPI.DuplicateSensorTestTemplateWithContext called but DuplicateSensorTestTemplateWithContextFunc is not set")
+	}
+	return m.DuplicateSensorTestTemplateWithContextFunc(ctx, duplicateSensorTestTemplateRequest)
+}
+
+// EditSensorTestTemplate calls EditSensorTestTemplateFunc.
+func (m *SensorsAPI) EditSensorTestTemplate(editSensorTestTemplateRequest *dnac.EditSensorTestTemplateRequest) (*dnac.EditSensorTestTemplateResponse, *resty.Response, error) {
+	m.record("EditSensorTestTemplate", editSensorTestTemplateRequest)
+	if m.EditSensorTestTemplateFunc == nil {
+		panic("dnacmock: SensorsAPI.EditSensorTestTemplate called but EditSensorTestTemplateFunc is not set")
+	}
+	return m.EditSensorTestTemplateFunc(editSensorTestTemplateRequest)
+}
+
+// EditSensorTestTemplateWithContext calls EditSensorTestTemplateWithContextFunc.
+func (m *SensorsAPI) EditSensorTestTemplateWithContext(ctx context.Context, editSensorTestTemplateRequest *dnac.EditSensorTestTemplateRequest) (*dnac.EditSensorTestTemplateResponse, *resty.Response, error) {
+	m.record("EditSensorTestTemplateWithContext", ctx, editSensorTestTemplateRequest)
+	if m.EditSensorTestTemplateWithContextFunc == nil {
+		panic("dnacmock: SensorsAPI.EditSensorTestTemplateWithContext called but EditSensorTestTemplateWithContextFunc is not set")
+	}
+	return m.EditSensorTestTemplateWithContextFunc(ctx, editSensorTestTemplateRequest)
+}
+
+// RunNowSensorTest calls RunNowSensorTestFunc.
+func (m *SensorsAPI) RunNowSensorTest(runNowSensorTestRequest *dnac.RunNowSensorTestRequest) (*resty.Response, error) {
+	m.record("RunNowSensorTest", runNowSensorTestRequest)
+	if m.RunNowSensorTestFunc == nil {
+		panic("dnacmock: SensorsAPI.RunNowSensorTest called but RunNowSensorTestFunc is not set")
+	}
+	return m.RunNowSensorTestFunc(runNowSensorTestRequest)
+}
+
+// RunNowSensorTestWithContext calls RunNowSensorTestWithContextFunc.
+func (m *SensorsAPI) RunNowSensorTestWithContext(ctx context.Context, runNowSensorTestRequest *dnac.RunNowSensorTestRequest) (*resty.Response, error) {
+	m.record("RunNowSensorTestWithContext", ctx, runNowSensorTestRequest)
+	if m.RunNowSensorTestWithContextFunc == nil {
+		panic("dnacmock: SensorsAPI.RunNowSensorTestWithContext called but RunNowSensorTestWithContextFunc is not set")
+	}
+	return m.RunNowSensorTestWithContextFunc(ctx, runNowSensorTestRequest)
+}
+
+// Sensors calls SensorsFunc.
+func (m *SensorsAPI) Sensors(sensorsQueryParams *dnac.SensorsQueryParams) (*dnac.SensorsResponse, *resty.Response, error) {
+	m.record("Sensors", sensorsQueryParams)
+	if m.SensorsFunc == nil {
+		panic("dnacmock: SensorsAPI.Sensors called but SensorsFunc is not set")
+	}
+	return m.SensorsFunc(sensorsQueryParams)
+}
+
+// SensorsWithContext calls SensorsWithContextFunc.
+func (m *SensorsAPI) SensorsWithContext(ctx context.Context, sensorsQueryParams *dnac.SensorsQueryParams) (*dnac.SensorsResponse, *resty.Response, error) {
+	m.record("SensorsWithContext", ctx, sensorsQueryParams)
+	if m.SensorsWithContextFunc == nil {
+		panic("dnacmock: SensorsAPI.SensorsWithContext called but SensorsWithContextFunc is not set")
+	}
+	return m.SensorsWithContextFunc(ctx, sensorsQueryParams)
+}
+
+// WirelessAPI is a mock of dnac.WirelessAPI. Each method calls the function of the
+// same name suffixed with Func, which must be set if the method is called.
+type WirelessAPI struct {
+	Recorder
+
+	APProvisionFunc                                  func(aPProvisionRequest *[]dnac.APProvisionRequest) (*dnac.APProvisionResponse, *resty.Response, error)
+	APProvisionWithContextFunc                       func(ctx context.Context, aPProvisionRequest *[]dnac.APProvisionRequest) (*dnac.APProvisionResponse, *resty.Response, error)
+	CreateAndProvisionSSIDFunc                       func(createAndProvisionSSIDRequest *dnac.CreateAndProvisionSSIDRequest) (*dnac.CreateAndProvisionSSIDResponse, *resty.Response, error)
+	CreateAndProvisionSSIDWithContextFunc            func(ctx context.Context, createAndProvisionSSIDRequest *dnac.CreateAndProvisionSSIDRequest) (*dnac.CreateAndProvisionSSIDResponse, *resty.Response, error)
+	CreateEnterpriseSSIDFunc                         func(createEnterpriseSSIDRequest *dnac.CreateEnterpriseSSIDRequest) (*dnac.CreateEnterpriseSSIDResponse, *resty.Response, error)
+	CreateEnterpriseSSIDWithContextFunc              func(ctx context.Context, createEnterpriseSSIDRequest *dnac.CreateEnterpriseSSIDRequest) (*dnac.CreateEnterpriseSSIDResponse, *resty.Response, error)
+	CreateOrUpdateRFProfileFunc                      func(createOrUpdateRFProfileRequest *dnac.CreateOrUpdateRFProfileRequest) (*dnac.CreateOrUpdateRFProfileResponse, *resty.Response, error)
+	CreateOrUpdateRFProfileWithContextFunc           func(ctx context.Context, createOrUpdateRFProfileRequest *dnac.CreateOrUpdateRFProfileRequest) (*dnac.CreateOrUpdateRFProfileResponse, *resty.Response, error)
+	CreateWirelessProfileFunc                        func(createWirelessProfileRequest *dnac.CreateWirelessProfileRequest) (*dnac.CreateWirelessProfileResponse, *resty.Response, error)
+	CreateWirelessProfileWithContextFunc             func(ctx context.Context, createWirelessProfileRequest *dnac.CreateWirelessProfileRequest) (*dnac.CreateWirelessProfileResponse, *resty.Response, error)
+	DeleteEnterpriseSSIDFunc                         func(ssidName string) (*dnac.DeleteEnterpriseSSIDResponse, *resty.Response, error)
+	DeleteEnterpriseSSIDWithContextFunc              func(ctx context.Context, ssidName string) (*dnac.DeleteEnterpriseSSIDResponse, *resty.Response, error)
+	DeleteRFProfilesFunc                             func(rfProfileName string) (*dnac.DeleteRFProfilesResponse, *resty.Response, error)
+	DeleteRFProfilesWithContextFunc                  func(ctx context.Context, rfProfileName string) (*dnac.DeleteRFProfilesResponse, *resty.Response, error)
+	DeleteSSIDAndProvisionItToDevicesFunc            func(ssidName string, managedAPLocations string) (*dnac.DeleteSSIDAndProvisionItToDevicesResponse, *resty.Response, error)
+	DeleteSSIDAndProvisionItToDevicesWithContextFunc func(ctx context.Context, ssidName string, managedAPLocations string) (*dnac.DeleteSSIDAndProvisionItToDevicesResponse, *resty.Response, error)
+	GetEnterpriseSSIDFunc                            func(getEnterpriseSSIDQueryParams *dnac.GetEnterpriseSSIDQueryParams) (*dnac.GetEnterpriseSSIDResponse, *resty.Response, error)
+	GetEnterpriseSSIDWithContextFunc                 func(ctx context.Context, getEnterpriseSSIDQueryParams *dnac.GetEnterpriseSSIDQueryParams) (*dnac.GetEnterpriseSSIDResponse, *resty.Response, error)
+	GetWirelessProfileFunc                           func(getWirelessProfileQueryParams *dnac.GetWirelessProfileQueryParams) (*dnac.GetWirelessProfileResponse, *resty.Response, error)
+	GetWirelessProfileWithContextFunc                func(ctx context.Context, getWirelessProfileQueryParams *dnac.GetWirelessProfileQueryParams) (*dnac.GetWirelessProfileResponse, *resty.Response, error)
+	ProvisionFunc                                    func(provisionRequest *[]dnac.ProvisionRequest) (*dnac.ProvisionResponse, *resty.Response, error)
+	ProvisionUpdateFunc                              func(provisionUpdateRequest *[]dnac.ProvisionUpdateRequest) (*dnac.ProvisionUpdateResponse, *resty.Response, error)
+	ProvisionUpdateWithContextFunc                   func(ctx context.Context, provisionUpdateRequest *[]dnac.ProvisionUpdateRequest) (*dnac.ProvisionUpdateResponse, *resty.Response, error)
+	ProvisionWithContextFunc                         func(ctx context.Context, provisionRequest *[]dnac.ProvisionRequest) (*dnac.ProvisionResponse, *resty.Response, error)
+	RetrieveRFProfilesFunc                           func(retrieveRFProfilesQueryParams *dnac.RetrieveRFProfilesQueryParams) (*dnac.RetrieveRFProfilesResponse, *resty.Response, error)
+	RetrieveRFProfilesWithContextFunc                func(ctx context.Context, retrieveRFProfilesQueryParams *dnac.RetrieveRFProfilesQueryParams) (*dnac.RetrieveRFProfilesResponse, *resty.Response, error)
+	SensorTestResultsFunc                            func(sensorTestResultsQueryParams *dnac.SensorTestResultsQueryParams) (*dnac.SensorTestResultsResponse, *resty.Response, error)
+	SensorTestResultsWithContextFunc                 func(ctx context.Context, sensorTestResultsQueryParams *dnac.SensorTestResultsQueryParams) (*dnac.SensorTestResultsResponse, *resty.Response, error)
+	UpdateWirelessProfileFunc                        func(updateWirelessProfileRequest *dnac.UpdateWirelessProfileRequest) (*dnac.UpdateWirelessProfileResponse, *resty.Response, error)
+	UpdateWirelessProfileWithContextFunc             func(ctx context.Context, updateWirelessProfileRequest *dnac.UpdateWirelessProfileRequest) (*dnac.UpdateWirelessProfileResponse, *resty.Response, error)
+}
+
+// APProvision calls APProvisionFunc.
+func (m *WirelessAPI) APProvision(aPProvisionRequest *[]dnac.APProvisionRequest) (*dnac.APProvisionResponse, *resty.Response, error) {
+	m.record("APProvision", aPProvisionRequest)
+	if m.APProvisionFunc == nil {
+		panic("dnacmock: WirelessAPI.APProvision called but APProvisionFunc is not set")
+	}
+	return m.APProvisionFunc(aPProvisionRequest)
+}
+
+// APProvisionWithContext calls APProvisionWithContextFunc.
+func (m *WirelessAPI) APProvisionWithContext(ctx context.Context, aPProvisionRequest *[]dnac.APProvisionRequest) (*dnac.APProvisionResponse, *resty.Response, error) {
+	m.record("APProvisionWithContext", ctx, aPProvisionRequest)
+	if m.APProvisionWithContextFunc == nil {
+		panic("dnacmock: WirelessAPI.APProvisionWithContext called but APProvisionWithContextFunc is not set")
+	}
+	return m.APProvisionWithContextFunc(ctx, aPProvisionRequest)
+}
+
+// CreateAndProvisionSSID calls CreateAndProvisionSSIDFunc.
+func (m *WirelessAPI) CreateAndProvisionSSID(createAndProvisionSSIDRequest *dnac.CreateAndProvisionSSIDRequest) (*dnac.CreateAndProvisionSSIDResponse, *resty.Response, error) {
+	m.record("CreateAndProvisionSSID", createAndProvisionSSIDRequest)
+	if m.CreateAndProvisionSSIDFunc == nil {
+		panic("dnacmock: WirelessAPI.CreateAndProvisionSSID called but CreateAndProvisionSSIDFunc is not set")
+	}
+	return m.CreateAndProvisionSSIDFunc(createAndProvisionSSIDRequest)
+}
+
+// CreateAndProvisionSSIDWithContext calls CreateAndProvisionSSIDWithContextFunc.
+func (m *WirelessAPI) CreateAndProvisionSSIDWithContext(ctx context.Context, createAndProvisionSSIDRequest *dnac.CreateAndProvisionSSIDRequest) (*dnac.CreateAndProvisionSSIDResponse, *resty.Response, error) {
+	m.record("CreateAndProvisionSSIDWithContext", ctx, createAndProvisionSSIDRequest)
+	if m.CreateAndProvisionSSIDWithContextFunc == nil {
+		panic("dnacmock: WirelessAPI.CreateAndProvisionSSIDWithContext called but CreateAndProvisionSSIDWithContextFunc is not set")
+	}
+	return m.CreateAndProvisionSSIDWithContextFunc(ctx, createAndProvisionSSIDRequest)
+}
+
+// CreateEnterpriseSSID calls CreateEnterpriseSSIDFunc.
+func (m *WirelessAPI) CreateEnterpriseSSID(createEnterpriseSSIDRequest *dnac.CreateEnterpriseSSIDRequest) (*dnac.CreateEnterpriseSSIDResponse, *resty.Response, error) {
+	m.record("CreateEnterpriseSSID", createEnterpriseSSIDRequest)
+	if m.CreateEnterpriseSSIDFunc == nil {
+		panic("dnacmock: WirelessAPI.CreateEnterpriseSSID called but CreateEnterpriseSSIDFunc is not set")
+	}
+	return m.CreateEnterpriseSSIDFunc(createEnterpriseSSIDRequest)
+}
+
+// CreateEnterpriseSSIDWithContext calls CreateEnterpriseSSIDWithContextFunc.
+func (m *WirelessAPI) CreateEnterpriseSSIDWithContext(ctx context.Context, createEnterpriseSSIDRequest *dnac.CreateEnterpriseSSIDRequest) (*dnac.CreateEnterpriseSSIDResponse, *resty.Response, error) {
+	m.record("CreateEnterpriseSSIDWithContext", ctx, createEnterpriseSSIDRequest)
+	if m.CreateEnterpriseSSIDWithContextFunc == nil {
+		panic("dnacmock: WirelessAPI.CreateEnterpriseSSIDWithContext called but CreateEnterpriseSSIDWithContextFunc is not set")
+	}
+	return m.CreateEnterpriseSSIDWithContextFunc(ctx, createEnterpriseSSIDRequest)
+}
+
+// CreateOrUpdateRFProfile calls CreateOrUpdateRFProfileFunc.
+func (m *WirelessAPI) CreateOrUpdateRFProfile(createOrUpdateRFProfileRequest *dnac.CreateOrUpdateRFProfileRequest) (*dnac.CreateOrUpdateRFProfileResponse, *resty.Response, error) {
+	m.record("CreateOrUpdateRFProfile", createOrUpdateRFProfileRequest)
+	if m.CreateOrUpdateRFProfileFunc == nil {
+		panic("dnacmock: WirelessAPI.CreateOrUpdateRFProfile called but CreateOrUpdateRFProfileFunc is not set")
+	}
+	return m.CreateOrUpdateRFProfileFunc(createOrUpdateRFProfileRequest)
+}
+
+// CreateOrUpdateRFProfileWithContext calls CreateOrUpdateRFProfileWithContextFunc.
+func (m *WirelessAPI) CreateOrUpdateRFProfileWithContext(ctx context.Context, createOrUpdateRFProfileRequest *dnac.CreateOrUpdateRFProfileRequest) (*dnac.CreateOrUpdateRFProfileResponse, *resty.Response, error) {
+	m.record("CreateOrUpdateRFProfileWithContext", ctx, createOrUpdateRFProfileRequest)
+	if m.CreateOrUpdateRFProfileWithContextFunc == nil {
+		panic("dnacmock: WirelessAPI.CreateOrUpdateRFProfileWithContext called but CreateOrUpdateRFProfileWithContextFunc is not set")
+	}
+	return m.CreateOrUpdateRFProfileWithContextFunc(ctx, createOrUpdateRFProfileRequest)
+}
+
+// CreateWirelessProfile calls CreateWirelessProfileFunc.
+func (m *WirelessAPI) CreateWirelessProfile(createWirelessProfileRequest *dnac.CreateWirelessProfileRequest) (*dnac.CreateWirelessProfileResponse, *resty.Response, error) {
+	m.record("CreateWirelessProfile", createWirelessProfileRequest)
+	if m.CreateWirelessProfileFunc == nil {
+		panic("dnacmock: WirelessAPI.CreateWirelessProfile called but CreateWirelessProfileFunc is not set")
+	}
+	return m.CreateWirelessProfileFunc(createWirelessProfileRequest)
+}
+
+// CreateWirelessProfileWithContext calls CreateWirelessProfileWithContextFunc.
+func (m *WirelessAPI) CreateWirelessProfileWithContext(ctx context.Context, createWirelessProfileRequest *dnac.CreateWirelessProfileRequest) (*dnac.CreateWirelessProfileResponse, *resty.Response, error) {
+	m.record("CreateWirelessProfileWithContext", ctx, createWirelessProfileRequest)
+	if m.CreateWirelessProfileWithContextFunc == nil {
+		panic("dnacmock: WirelessAPI.CreateWirelessProfileWithContext called but CreateWirelessProfileWithContextFunc is not set")
+	}
+	return m.CreateWirelessProfileWithContextFunc(ctx, createWirelessProfileRequest)
+}
+
+// DeleteEnterpriseSSID calls DeleteEnterpriseSSIDFunc.
+func (m *WirelessAPI) DeleteEnterpriseSSID(ssidName string) (*dnac.DeleteEnterpriseSSIDResponse, *resty.Response, error) {
+	m.record("DeleteEnterpriseSSID", ssidName)
+	if m.DeleteEnterpriseSSIDFunc == nil {
+		panic("dnacmock: WirelessAPI.DeleteEnterpriseSSID called but DeleteEnterpriseSSIDFunc is not set")
+	}
+	return m.DeleteEnterpriseSSIDFunc(ssidName)
+}
+
+// DeleteEnterpriseSSIDWithContext calls DeleteEnterpriseSSIDWithContextFunc.
+func (m *WirelessAPI) DeleteEnterpriseSSIDWithContext(ctx context.Context, ssidName string) (*dnac.DeleteEnterpriseSSIDResponse, *resty.Response, error) {
+	m.record("DeleteEnterpriseSSIDWithContext", ctx, ssidName)
+	if m.DeleteEnterpriseSSIDWithContextFunc == nil {
+		panic("dnacmock: WirelessAPI.DeleteEnterpriseSSIDWithContext called but DeleteEnterpriseSSIDWithContextFunc is not set")
+	}
+	return m.DeleteEnterpriseSSIDWithContextFunc(ctx, ssidName)
+}
+
+// DeleteRFProfiles calls DeleteRFProfilesFunc.
+func (m *WirelessAPI) DeleteRFProfiles(rfProfileName string) (*dnac.DeleteRFProfilesResponse, *resty.Response, error) {
+	m.record("DeleteRFProfiles", rfProfileName)
+	if m.DeleteRFProfilesFunc == nil {
+		panic("dnacmock: WirelessAPI.DeleteRFProfiles called but DeleteRFProfilesFunc is not set")
+	}
+	return m.DeleteRFProfilesFunc(rfProfileName)
+}
+
+// DeleteRFProfilesWithContext calls DeleteRFProfilesWithContextFunc.
+func (m *WirelessAPI) DeleteRFProfilesWithContext(ctx context.Context, rfProfileName string) (*dnac.DeleteRFProfilesResponse, *resty.Response, error) {
+	m.record("DeleteRFProfilesWithContext", ctx, rfProfileName)
+	if m.DeleteRFProfilesWithContextFunc == nil {
+		panic("dnacmock: WirelessAPI.DeleteRFProfilesWithContext called but DeleteRFProfilesWithContextFunc is not set")
+	}
+	return m.DeleteRFProfilesWithContextFunc(ctx, rfProfileName)
+}
+
+// DeleteSSIDAndProvisionItToDevices calls DeleteSSIDAndProvisionItToDevicesFunc.
+func (m *WirelessAPI) DeleteSSIDAndProvisionItToDevices(ssidName string, managedAPLocations string) (*dnac.DeleteSSIDAndProvisionItToDevicesResponse, *resty.Response, error) {
+	m.record("DeleteSSIDAndProvisionItToDevices", ssidName, managedAPLocations)
+	if m.DeleteSSIDAndProvisionItToDevicesFunc == nil {
+		panic("dnacmock: WirelessAPI.DeleteSSIDAndProvisionItToDevices called but DeleteSSIDAndProvisionItToDevicesFunc is not set")
+	}
+	return m.DeleteSSIDAndProvisionItToDevicesFunc(ssidName, managedAPLocations)
+}
+
+// DeleteSSIDAndProvisionItToDevicesWithContext calls DeleteSSIDAndProvisionItToDevicesWithContextFunc.
+func (m *WirelessAPI) DeleteSSIDAndProvisionItToDevicesWithContext(ctx context.Context, ssidName string, managedAPLocations string) (*dnac.DeleteSSIDAndProvisionItToDevicesResponse, *resty.Response, error) {
+	m.record("DeleteSSIDAndProvisionItToDevicesWithContext", ctx, ssidName, managedAPLocations)
+	if m.DeleteSSIDAndProvisionItToDevicesWithContextFunc == nil {
+		panic("dnacmock: WirelessAPI.DeleteSSIDAndProvisionItToDevicesWithContext called but DeleteSSIDAndProvisionItToDevicesWithContextFunc is not set")
+	}
+	return m.DeleteSSIDAndProvisionItToDevicesWithContextFunc(ctx, ssidName, managedAPLocations)
+}
+
+// GetEnterpriseSSID calls GetEnterpriseSSIDFunc.
+func (m *WirelessAPI) GetEnterpriseSSID(getEnterpriseSSIDQueryParams *dnac.GetEnterpriseSSIDQueryParams) (*dnac.GetEnterpriseSSIDResponse, *resty.Response, error) {
+	m.record("GetEnterpriseSSID", getEnterpriseSSIDQueryParams)
+	if m.GetEnterpriseSSIDFunc == nil {
+		panic("dnacmock: WirelessAPI.GetEnterpriseSSID called but GetEnterpriseSSIDFunc is not set")
+	}
+	return m.GetEnterpriseSSIDFunc(getEnterpriseSSIDQueryParams)
+}
+
+// GetEnterpriseSSIDWithContext calls GetEnterpriseSSIDWithContextFunc.
+func (m *WirelessAPI) GetEnterpriseSSIDWithContext(ctx context.Context, getEnterpriseSSIDQueryParams *dnac.GetEnterpriseSSIDQueryParams) (*dnac.GetEnterpriseSSIDResponse, *resty.Response, error) {
+	m.record("GetEnterpriseSSIDWithContext", ctx, getEnterpriseSSIDQueryParams)
+	if m.GetEnterpriseSSIDWithContextFunc == nil {
+		panic("dnacmock: WirelessAPI.GetEnterpriseSSIDWithContext called but GetEnterpriseSSIDWithContextFunc is not set")
+	}
+	return m.GetEnterpriseSSIDWithContextFunc(ctx, getEnterpriseSSIDQueryParams)
+}
+
+// GetWirelessProfile calls GetWirelessProfileFunc.
+func (m *WirelessAPI) GetWirelessProfile(getWirelessProfileQueryParams *dnac.GetWirelessProfileQueryParams) (*dnac.GetWirelessProfileResponse, *resty.Response, error) {
+	m.record("GetWirelessProfile", getWirelessProfileQueryParams)
+	if m.GetWirelessProfileFunc == nil {
+		panic("dnacmock: WirelessAPI.GetWirelessProfile called but GetWirelessProfileFunc is not set")
+	}
+	return m.GetWirelessProfileFunc(getWirelessProfileQueryParams)
+}
+
+// GetWirelessProfileWithContext calls GetWirelessProfileWithContextFunc.
+func (m *WirelessAPI) GetWirelessProfileWithContext(ctx context.Context, getWirelessProfileQueryParams *dnac.GetWirelessProfileQueryParams) (*dnac.GetWirelessProfileResponse, *resty.Response, error) {
+	m.record("GetWirelessProfileWithContext", ctx, getWirelessProfileQueryParams)
+	if m.GetWirelessProfileWithContextFunc == nil {
+		panic("dnacmock: WirelessAPI.GetWirelessProfileWithContext called but GetWirelessProfileWithContextFunc is not set")
+	}
+	return m.GetWirelessProfileWithContextFunc(ctx, getWirelessProfileQueryParams)
+}
+
+// Provision calls ProvisionFunc.
+func (m *WirelessAPI) Provision(provisionRequest *[]dnac.ProvisionRequest) (*dnac.ProvisionResponse, *resty.Response, error) {
+	m.record("Provision", provisionRequest)
+	if m.ProvisionFunc == nil {
+		panic("dnacmock: WirelessAPI.Provision called but ProvisionFunc is not set")
+	}
+	return m.ProvisionFunc(provisionRequest)
+}
+
+// ProvisionUpdate calls ProvisionUpdateFunc.
+func (m *WirelessAPI) ProvisionUpdate(provisionUpdateRequest *[]dnac.ProvisionUpdateRequest) (*dnac.ProvisionUpdateResponse, *resty.Response, error) {
+	m.record("ProvisionUpdate", provisionUpdateRequest)
+	if m.ProvisionUpdateFunc == nil {
+		panic("dnacmock: WirelessAPI.ProvisionUpdate called but ProvisionUpdateFunc is not set")
+	}
+	return m.ProvisionUpdateFunc(provisionUpdateRequest)
+}
+
+// ProvisionUpdateWithContext calls ProvisionUpdateWithContextFunc.
+func (m *WirelessAPI) ProvisionUpdateWithContext(ctx context.Context, provisionUpdateRequest *[]dnac.ProvisionUpdateRequest) (*dnac.ProvisionUpdateResponse, *resty.Response, error) {
+	m.record("ProvisionUpdateWithContext", ctx, provisionUpdateRequest)
+	if m.ProvisionUpdateWithContextFunc == nil {
+		panic("dnacmock: WirelessAPI.ProvisionUpdateWithContext called but ProvisionUpdateWithContextFunc is not set")
+	}
+	return m.ProvisionUpdateWithContextFunc(ctx, provisionUpdateRequest)
+}
+
+// ProvisionWithContext calls ProvisionWithContextFunc.
+func (m *WirelessAPI) ProvisionWithContext(ctx context.Context, provisionRequest *[]dnac.ProvisionRequest) (*dnac.ProvisionResponse, *resty.Response, error) {
+	m.record("ProvisionWithContext", ctx, provisionRequest)
+	if m.ProvisionWithContextFunc == nil {
+		panic("dnacmock: WirelessAPI.ProvisionWithContext called but ProvisionWithContextFunc is not set")
+	}
+	return m.ProvisionWithContextFunc(ctx, provisionRequest)
+}
+
+// RetrieveRFProfiles calls RetrieveRFProfilesFunc.
+func (m *WirelessAPI) RetrieveRFProfiles(retrieveRFProfilesQueryParams *dnac.RetrieveRFProfilesQueryParams) (*dnac.RetrieveRFProfilesResponse, *resty.Response, error) {
+	m.record("RetrieveRFProfiles", retrieveRFProfilesQueryParams)
+	if m.RetrieveRFProfilesFunc == nil {
+		panic("dnacmock: WirelessAPI.RetrieveRFProfiles called but RetrieveRFProfilesFunc is not set")
+	}
+	return m.RetrieveRFProfilesFunc(retrieveRFProfilesQueryParams)
+}
+
+// RetrieveRFProfilesWithContext calls RetrieveRFProfilesWithContextFunc.
+func (m *WirelessAPI) RetrieveRFProfilesWithContext(ctx context.Context, retrieveRFProfilesQueryParams *dnac.RetrieveRFProfilesQueryParams) (*dnac.RetrieveRFProfilesResponse, *resty.Response, error) {
+	m.record("RetrieveRFProfilesWithContext", ctx, retrieveRFProfilesQueryParams)
+	if m.RetrieveRFProfilesWithContextFunc == nil {
+		panic("dnacmock: WirelessAPI.RetrieveRFProfilesWithContext called but RetrieveRFProfilesWithContextFunc is not set")
+	}
+	return m.RetrieveRFProfilesWithContextFunc(ctx, retrieveRFProfilesQueryParams)
+}
+
+// SensorTestResults calls SensorTestResultsFunc.
+func (m *WirelessAPI) SensorTestResults(sensorTestResultsQueryParams *dnac.SensorTestResultsQueryParams) (*dnac.SensorTestResultsResponse, *resty.Response, error) {
+	m.record("SensorTestResults", sensorTestResultsQueryParams)
+	if m.SensorTestResultsFunc == nil {
+		panic("dnacmock: WirelessAPI.SensorTestResults called but SensorTestResultsFunc is not set")
+	}
+	return m.SensorTestResultsFunc(sensorTestResultsQueryParams)
+}
+
+// SensorTestResultsWithContext calls SensorTestResultsWithContextFunc.
+func (m *WirelessAPI) SensorTestResultsWithContext(ctx context.Context, sensorTestResultsQueryParams *dnac.SensorTestResultsQueryParams) (*dnac.SensorTestResultsResponse, *resty.Response, error) {
+	m.record("SensorTestResultsWithContext", ctx, sensorTestResultsQueryParams)
+	if m.SensorTestResultsWithContextFunc == nil {
+		panic("dnacmock: WirelessAPI.SensorTestResultsWithContext called but SensorTestResultsWithContextFunc is not set")
+	}
+	return m.SensorTestResultsWithContextFunc(ctx, sensorTestResultsQueryParams)
+}
+
+// UpdateWirelessProfile calls UpdateWirelessProfileFunc.
+func (m *WirelessAPI) UpdateWirelessProfile(updateWirelessProfileRequest *dnac.UpdateWirelessProfileRequest) (*dnac.UpdateWirelessProfileResponse, *resty.Response, error) {
+	m.record("UpdateWirelessProfile", updateWirelessProfileRequest)
+	if m.UpdateWirelessProfileFunc == nil {
+		panic("dnacmock: WirelessAPI.UpdateWirelessProfile called but UpdateWirelessProfileFunc is not set")
+	}
+	return m.UpdateWirelessProfileFunc(updateWirelessProfileRequest)
+}
+
+// UpdateWirelessProfileWithContext calls UpdateWirelessProfileWithContextFunc.
+func (m *WirelessAPI) UpdateWirelessProfileWithContext(ctx context.Context, updateWirelessProfileRequest *dnac.UpdateWirelessProfileRequest) (*dnac.UpdateWirelessProfileResponse, *resty.Response, error) {
+	m.record("UpdateWirelessProfileWithContext", ctx, updateWirelessProfileRequest)
+	if m.UpdateWirelessProfileWithContextFunc == nil {
+		panic("dnacmock: WirelessAPI.UpdateWirelessProfileWithContext called but UpdateWirelessProfileWithContextFunc is not set")
+	}
+	return m.UpdateWirelessProfileWithContextFunc(ctx, updateWirelessProfileRequest)
+}
+
+// CommandRunnerAPI is a mock of dnac.CommandRunnerAPI. Each method calls the function of the
+// same name suffixed with Func, which must be set if the method is called.
+type CommandRunnerAPI struct {
+	Recorder
+
+	GetAllKeywordsOfCLIsAcceptedByCommandRunnerFunc                            func() (*dnac.GetAllKeywordsOfCLIsAcceptedByCommandRunnerResponse, *resty.Response, error)
+	GetAllKeywordsOfCLIsAcceptedByCommandRunnerWithContextFunc                 func(ctx context.Context) (*dnac.GetAllKeywordsOfCLIsAcceptedByCommandRunnerResponse, *resty.Response, error)
+	RunFunc                                                                    func(ctx context.Context, deviceUUIDs []string, commands []string, opts *dnac.CommandRunnerOptions) (dnac.CommandRunnerResult, error)
+	RunReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationFunc            func(runReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationRequest *dnac.RunReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationRequest) (*dnac.RunReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationResponse, *resty.Response, error)
+	RunReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationWithContextFunc func(ctx context.Context, runReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationRequest *dnac.RunReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationRequest) (*dnac.RunReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationResponse, *resty.Response, error)
+}
+
+// GetAllKeywordsOfCLIsAcceptedByCommandRunner calls GetAllKeywordsOfCLIsAcceptedByCommandRunnerFunc.
+func (m *CommandRunnerAPI) GetAllKeywordsOfCLIsAcceptedByCommandRunner() (*dnac.GetAllKeywordsOfCLIsAcceptedByCommandRunnerResponse, *resty.Response, error) {
+	m.record("GetAllKeywordsOfCLIsAcceptedByCommandRunner")
+	if m.GetAllKeywordsOfCLIsAcceptedByCommandRunnerFunc == nil {
+		panic("dnacmock: CommandRunnerAPI.GetAllKeywordsOfCLIsAcceptedByCommandRunner called but GetAllKeywordsOfCLIsAcceptedByCommandRunnerFunc is not set")
+	}
+	return m.GetAllKeywordsOfCLIsAcceptedByCommandRunnerFunc()
+}
+
+// GetAllKeywordsOfCLIsAcceptedByCommandRunnerWithContext calls GetAllKeywordsOfCLIsAcceptedByCommandRunnerWithContextFunc.
+func (m *CommandRunnerAPI) GetAllKeywordsOfCLIsAcceptedByCommandRunnerWithContext(ctx context.Context) (*dnac.GetAllKeywordsOfCLIsAcceptedByCommandRunnerResponse, *resty.Response, error) {
+	m.record("GetAllKeywordsOfCLIsAcceptedByCommandRunnerWithContext", ctx)
+	if m.GetAllKeywordsOfCLIsAcceptedByCommandRunnerWithContextFunc == nil {
+		panic("dnacmock: CommandRunnerAPI.GetAllKeywordsOfCLIsAcceptedByCommandRunnerWithContext called but GetAllKeywordsOfCLIsAcceptedByCommandRunnerWithContextFunc is not set")
+	}
+	return m.GetAllKeywordsOfCLIsAcceptedByCommandRunnerWithContextFunc(ctx)
+}
+
+// Run calls RunFunc.
+func (m *CommandRunnerAPI) Run(ctx context.Context, deviceUUIDs []string, commands []string, opts *dnac.CommandRunnerOptions) (dnac.CommandRunnerResult, error) {
+	m.record("Run", ctx, deviceUUIDs, commands, opts)
+	if m.RunFunc == nil {
+		panic("dnacmock: CommandRunnerAPI.Run called but RunFunc is not set")
+	}
+	return m.RunFunc(ctx, deviceUUIDs, commands, opts)
+}
+
+// RunReadOnlyCommandsOnDevicesToGetTheirRealTimeConfiguration calls RunReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationFunc.
+func (m *CommandRunnerAPI) RunReadOnlyCommandsOnDevicesToGetTheirRealTimeConfiguration(runReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationRequest *dnac.RunReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationRequest) (*dnac.RunReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationResponse, *resty.Response, error) {
+	m.record("RunReadOnlyCommandsOnDevicesToGetTheirRealTimeConfiguration", runReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationRequest)
+	if m.RunReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationFunc == nil {
+		panic("dnacmock: CommandRunnerAPI.RunReadOnlyCommandsOnDevicesToGetTheirRealTimeConfiguration called but RunReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationFunc is not set")
+	}
+	return m.RunReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationFunc(runReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationRequest)
+}
+
+// RunReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationWithContext calls RunReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationWithContextFunc.
+func (m *CommandRunnerAPI) RunReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationWithContext(ctx context.Context, runReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationRequest *dnac.RunReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationRequest) (*dnac.RunReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationResponse, *resty.Response, error) {
+	m.record("RunReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationWithContext", ctx, runReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationRequest)
+	if m.RunReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationWithContextFunc == nil {
+		panic("dnacmock: CommandRunnerAPI.RunReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationWithContext called but RunReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationWithContextFunc is not set")
+	}
+	return m.RunReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationWithContextFunc(ctx, runReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationRequest)
+}
+
+// DiscoveryAPI is a mock of dnac.DiscoveryAPI. Each method calls the function of the
+// same name suffixed with Func, which must be set if the method is called.
+type DiscoveryAPI struct {
+	Recorder
+
+	CreateCLICredentialsFunc                                func(createCLICredentialsRequest *[]dnac.CreateCLICredentialsRequest) (*dnac.CreateCLICredentialsResponse, *resty.Response, error)
+	CreateCLICredentialsWithContextFunc                     func(ctx context.Context, createCLICredentialsRequest *[]dnac.CreateCLICredentialsRequest) (*dnac.CreateCLICredentialsResponse, *resty.Response, error)
+	CreateHTTPReadCredentialsFunc                           func(createHTTPReadCredentialsRequest *[]dnac.CreateHTTPReadCredentialsRequest) (*dnac.CreateHTTPReadCredentialsResponse, *resty.Response, error)
+	CreateHTTPReadCredentialsWithContextFunc                func(ctx context.Context, createHTTPReadCredentialsRequest *[]dnac.CreateHTTPReadCredentialsRequest) (*dnac.CreateHTTPReadCredentialsResponse, *resty.Response, error)
+	CreateHTTPWriteCredentialsFunc                          func(createHTTPWriteCredentialsRequest *[]dnac.CreateHTTPWriteCredentialsRequest) (*dnac.CreateHTTPWriteCredentialsResponse, *resty.Response, error)
+	CreateHTTPWriteCredentialsWithContextFunc               func(ctx context.Context, createHTTPWriteCredentialsRequest *[]dnac.CreateHTTPWriteCredentialsRequest) (*dnac.CreateHTTPWriteCredentialsResponse, *resty.Response, error)
+	CreateNetconfCredentialsFunc                            func(createNetconfCredentialsRequest *[]dnac.CreateNetconfCredentialsRequest) (*dnac.CreateNetconfCredentialsResponse, *resty.Response, error)
+	CreateNetconfCredentialsWithContextFunc                 func(ctx context.Context, createNetconfCredentialsRequest *[]dnac.CreateNetconfCredentialsRequest) (*dnac.CreateNetconfCredentialsResponse, *resty.Response, error)
+	CreateSNMPReadCommunityFunc                             func(createSNMPReadCommunityRequest *[]dnac.CreateSNMPReadCommunityRequest) (*dnac.CreateSNMPReadCommunityResponse, *resty.Response, error)
+	CreateSNMPReadCommunityWithContextFunc                  func(ctx context.Context, createSNMPReadCommunityRequest *[]dnac.CreateSNMPReadCommunityRequest) (*dnac.CreateSNMPReadCommunityResponse, *resty.Response, error)
+	CreateSNMPWriteCommunityFunc                            func(createSNMPWriteCommunityRequest *[]dnac.CreateSNMPWriteCommunityRequest) (*dnac.CreateSNMPWriteCommunityResponse, *resty.Response, error)
+	CreateSNMPWriteCommunityWithContextFunc                 func(ctx context.Context, createSNMPWriteCommunityRequest *[]dnac.CreateSNMPWriteCommunityRequest) (*dnac.CreateSNMPWriteCommunityResponse, *resty.Response, error)
+	CreateSNMPv3CredentialsFunc                             func(createSNMPv3CredentialsRequest *[]dnac.CreateSNMPv3CredentialsRequest) (*dnac.CreateSNMPv3CredentialsResponse, *resty.Response, error)
+	CreateSNMPv3CredentialsWithContextFunc                  func(ctx context.Context, createSNMPv3CredentialsRequest *[]dnac.CreateSNMPv3CredentialsRequest) (*dnac.CreateSNMPv3CredentialsResponse, *resty.Response, error)
+	CreateUpdateSNMPPropertiesFunc                          func(createUpdateSNMPPropertiesRequest *[]dnac.CreateUpdateSNMPPropertiesRequest) (*dnac.CreateUpdateSNMPPropertiesResponse, *resty.Response, error)
+	CreateUpdateSNMPPropertiesWithContextFunc               func(ctx context.Context, createUpdateSNMPPropertiesRequest *[]dnac.CreateUpdateSNMPPropertiesRequest) (*dnac.CreateUpdateSNMPPropertiesResponse, *resty.Response, error)
+	DeleteAllDiscoveryFunc                                  func() (*dnac.DeleteAllDiscoveryResponse, *resty.Response, error)
+	DeleteAllDiscoveryWithContextFunc                       func(ctx context.Context) (*dnac.DeleteAllDiscoveryResponse, *resty.Response, error)
+	DeleteDiscoveryByIDFunc                                 func(id string) (*dnac.DeleteDiscoveryByIDResponse, *resty.Response, error)
+	DeleteDiscoveryByIDWithContextFunc                      func(ctx context.Context, id string) (*dnac.DeleteDiscoveryByIDResponse, *resty.Response, error)
+	DeleteDiscoveryBySpecifiedRangeFunc                     func(startIndex int, recordsToDelete int) (*dnac.DeleteDiscoveryBySpecifiedRangeResponse, *resty.Response, error)
+	DeleteDiscoveryBySpecifiedRangeWithContextFunc          func(ctx context.Context, startIndex int, recordsToDelete int) (*dnac.DeleteDiscoveryBySpecifiedRangeResponse, *resty.Response, error)
+	DeleteGlobalCredentialsByIDFunc                         func(globalCredentialID string) (*dnac.DeleteGlobalCredentialsByIDResponse, *resty.Response, error)
+	DeleteGlobalCredentialsByIDWithContextFunc              func(ctx context.Context, globalCredentialID string) (*dnac.DeleteGlobalCredentialsByIDResponse, *resty.Response, error)
+	GetCountOfAllDiscoveryJobsFunc                          func() (*dnac.GetCountOfAllDiscoveryJobsResponse, *resty.Response, error)
+	GetCountOfAllDiscoveryJobsWithContextFunc               func(ctx context.Context) (*dnac.GetCountOfAllDiscoveryJobsResponse, *resty.Response, error)
+	GetCredentialSubTypeByCredentialIDFunc                  func(id string) (*dnac.GetCredentialSubTypeByCredentialIDResponse, *resty.Response, error)
+	GetCredentialSubTypeByCredentialIDWithContextFunc       func(ctx context.Context, id string) (*dnac.GetCredentialSubTypeByCredentialIDResponse, *resty.Response, error)
+	GetDevicesDiscoveredByIDFunc                            func(id string, getDevicesDiscoveredByIDQueryParams *dnac.GetDevicesDiscoveredByIDQueryParams) (*dnac.GetDevicesDiscoveredByIDResponse, *resty.Response, error)
+	GetDevicesDiscoveredByIDWithContextFunc                 func(ctx context.Context, id string, getDevicesDiscoveredByIDQueryParams *dnac.GetDevicesDiscoveredByIDQueryParams) (*dnac.GetDevicesDiscoveredByIDResponse, *resty.Response, error)
+	GetDiscoveredDevicesByRangeFunc                         func(id string, startIndex int, recordsToReturn int, getDiscoveredDevicesByRangeQueryParams *dnac.GetDiscoveredDevicesByRangeQueryParams) (*dnac.GetDiscoveredDevicesByRangeResponse, *resty.Response, error)
+	GetDiscoveredDevicesByRangeWithContextFunc              func(ctx context.Context, id string, startIndex int, recordsToReturn int, getDiscoveredDevicesByRangeQueryParams *dnac.GetDiscoveredDevicesByRangeQueryParams) (*dnac.GetDiscoveredDevicesByRangeResponse, *resty.Response, error)
+	GetDiscoveredNetworkDevicesByDiscoveryIDFunc            func(id string, getDiscoveredNetworkDevicesByDiscoveryIDQueryParams *dnac.GetDiscoveredNetworkDevicesByDiscoveryIDQueryParams) (*dnac.GetDiscoveredNetworkDevicesByDiscoveryIDResponse, *resty.Response, error)
+	GetDiscoveredNetworkDevicesByDiscoveryIDWithContextFunc func(ctx context.Context, id string, getDiscoveredNetworkDevicesByDiscoveryIDQueryParams *dnac.GetDiscoveredNetworkDevicesByDiscoveryIDQueryParams) (*dnac.GetDiscoveredNetworkDevicesByDiscoveryIDResponse, *resty.Response, error)
+	GetDiscoveriesByRangeFunc                               func(startIndex int, recordsToReturn int) (*dnac.GetDiscoveriesByRangeResponse, *resty.Response, error)
+	GetDiscoveriesByRangeWithContextFunc                    func(ctx context.Context, startIndex int, recordsToReturn int) (*dnac.GetDiscoveriesByRangeResponse, *resty.Response, error)
+	GetDiscoveryByIDFunc                                    func(id string) (*dnac.GetDiscoveryByIDResponse, *resty.Response, error)
+	GetDiscoveryByIDWithContextFunc                         func(ctx context.Context, id string) (*dnac.GetDiscoveryByIDResponse, *resty.Response, error)
+	GetDiscoveryJobsByIPFunc                                func(getDiscoveryJobsByIPQueryParams *dnac.GetDiscoveryJobsByIPQueryParams) (*dnac.GetDiscoveryJobsByIPResponse, *resty.Response, error)
+	GetDiscoveryJobsByIPWithContextFunc                     func(ctx context.Context, getDiscoveryJobsByIPQueryParams *dnac.GetDiscoveryJobsByIPQueryParams) (*dnac.GetDiscoveryJobsByIPResponse, *resty.Response, error)
+	GetGlobalCredentialsFunc                                func(getGlobalCredentialsQueryParams *dnac.GetGlobalCredentialsQueryParams) (*dnac.GetGlobalCredentialsResponse, *resty.Response, error)
+	GetGlobalCredentialsWithContextFunc                     func(ctx context.Context, getGlobalCredentialsQueryParams *dnac.GetGlobalCredentialsQueryParams) (*dnac.GetGlobalCredentialsResponse, *resty.Response, error)
+	GetListOfDiscoveriesByDiscoveryIDFunc                   func(id string, getListOfDiscoveriesByDiscoveryIDQueryParams *dnac.GetListOfDiscoveriesByDiscoveryIDQueryParams) (*dnac.GetListOfDiscoveriesByDiscoveryIDResponse, *resty.Response, error)
+	GetListOfDiscoveriesByDiscoveryIDWithContextFunc        func(ctx context.Context, id string, getListOfDiscoveriesByDiscoveryIDQueryParams *dnac.GetListOfDiscoveriesByDiscoveryIDQueryParams) (*dnac.GetListOfDiscoveriesByDiscoveryIDResponse, *resty.Response, error)
+	GetNetworkDevicesFromDiscoveryFunc                      func(id string, getNetworkDevicesFromDiscoveryQueryParams *dnac.GetNetworkDevicesFromDiscoveryQueryParams) (*dnac.GetNetworkDevicesFromDiscoveryResponse, *resty.Response, error)
+	GetNetworkDevicesFromDiscoveryWithContextFunc           func(ctx context.Context, id string, getNetworkDevicesFromDiscoveryQueryParams *dnac.GetNetworkDevicesFromDiscoveryQueryParams) (*dnac.GetNetworkDevicesFromDiscoveryResponse, *resty.Response, error)
+	GetSNMPPropertiesFunc                                   func() (*dnac.GetSNMPPropertiesResponse, *resty.Response, error)
+	GetSNMPPropertiesWithContextFunc                        func(ctx context.Context) (*dnac.GetSNMPPropertiesResponse, *resty.Response, error)
+	ListAllFunc                                             func(ctx context.Context, opts *dnac.PageOptions) *dnac.DiscoveryIterator
+	StartDiscoveryFunc                                      func(startDiscoveryRequest *dnac.StartDiscoveryRequest) (*dnac.StartDiscoveryResponse, *resty.Response, error)
+	StartDiscoveryWithContextFunc                           func(ctx context.Context, startDiscoveryRequest *dnac.StartDiscoveryRequest) (*dnac.StartDiscoveryResponse, *resty.Response, error)
+	UpdateCLICredentialsFunc                                func(updateCLICredentialsRequest *dnac.UpdateCLICredentialsRequest) (*dnac.UpdateCLICredentialsResponse, *resty.Response, error)
+	UpdateCLICredentialsWithContextFunc                     func(ctx context.Context, updateCLICredentialsRequest *dnac.UpdateCLICredentialsRequest) (*dnac.UpdateCLICredentialsResponse, *resty.Response, error)
+	UpdateGlobalCredentialsFunc                             func(globalCredentialID string, updateGlobalCredentialsRequest *dnac.UpdateGlobalCredentialsRequest) (*dnac.UpdateGlobalCredentialsResponse, *resty.Response, error)
+	UpdateGlobalCredentialsWithContextFunc                  func(ctx context.Context, globalCredentialID string, updateGlobalCredentialsRequest *dnac.UpdateGlobalCredentialsRequest) (*dnac.UpdateGlobalCredentialsResponse, *resty.Response, error)
+	UpdateHTTPReadCredentialFunc                            func(updateHTTPReadCredentialRequest *dnac.UpdateHTTPReadCredentialRequest) (*dnac.UpdateHTTPReadCredentialResponse, *resty.Response, error)
+	UpdateHTTPReadCredentialWithContextFunc                 func(ctx context.Context, updateHTTPReadCredentialRequest *dnac.UpdateHTTPReadCredentialRequest) (*dnac.UpdateHTTPReadCredentialResponse, *resty.Response, error)
+	UpdateHTTPWriteCredentialsFunc                          func(updateHTTPWriteCredentialsRequest *dnac.UpdateHTTPWriteCredentialsRequest) (*dnac.UpdateHTTPWriteCredentialsResponse, *resty.Response, error)
+	UpdateHTTPWriteCredentialsWithContextFunc               func(ctx context.Context, updateHTTPWriteCredentialsRequest *dnac.UpdateHTTPWriteCredentialsRequest) (*dnac.UpdateHTTPWriteCredentialsResponse, *resty.Response, error)
+	UpdateNetconfCredentialsFunc                            func(updateNetconfCredentialsRequest *dnac.UpdateNetconfCredentialsRequest) (*dnac.UpdateNetconfCredentialsResponse, *resty.Response, error)
+	UpdateNetconfCredentialsWithContextFunc                 func(ctx context.Context, updateNetconfCredentialsRequest *dnac.UpdateNetconfCredentialsRequest) (*dnac.UpdateNetconfCredentialsResponse, *resty.Response, error)
+	UpdateSNMPReadCommunityFunc                             func(updateSNMPReadCommunityRequest *dnac.UpdateSNMPReadCommunityRequest) (*dnac.UpdateSNMPReadCommunityResponse, *resty.Response, error)
+	UpdateSNMPReadCommunityWithContextFunc                  func(ctx context.Context, updateSNMPReadCommunityRequest *dnac.UpdateSNMPReadCommunityRequest) (*dnac.UpdateSNMPReadCommunityResponse, *resty.Response, error)
+	UpdateSNMPWriteCommunityFunc                            func(updateSNMPWriteCommunityRequest *dnac.UpdateSNMPWriteCommunityRequest) (*dnac.UpdateSNMPWriteCommunityResponse, *resty.Response, error)
+	UpdateSNMPWriteCommunityWithContextFunc                 func(ctx context.Context, updateSNMPWriteCommunityRequest *dnac.UpdateSNMPWriteCommunityRequest) (*dnac.UpdateSNMPWriteCommunityResponse, *resty.Response, error)
+	UpdateSNMPv3CredentialsFunc                             func(updateSNMPv3CredentialsRequest *dnac.UpdateSNMPv3CredentialsRequest) (*dnac.UpdateSNMPv3CredentialsResponse, *resty.Response, error)
+	UpdateSNMPv3CredentialsWithContextFunc                  func(ctx context.Context, updateSNMPv3CredentialsRequest *dnac.UpdateSNMPv3CredentialsRequest) (*dnac.UpdateSNMPv3CredentialsResponse, *resty.Response, error)
+	UpdatesAnExistingDiscoveryBySpecifiedIDFunc             func(updatesAnExistingDiscoveryBySpecifiedIDRequest *dnac.UpdatesAnExistingDiscoveryBySpecifiedIDRequest) (*dnac.UpdatesAnExistingDiscoveryBySpecifiedIDResponse, *resty.Response, error)
+	UpdatesAnExistingDiscoveryBySpecifiedIDWithContextFunc  func(ctx context.Context, updatesAnExistingDiscoveryBySpecifiedIDRequest *dnac.UpdatesAnExistingDiscoveryBySpecifiedIDRequest) (*dnac.UpdatesAnExistingDiscoveryBySpecifiedIDResponse, *resty.Response, error)
+}
+
+// CreateCLICredentials calls CreateCLICredentialsFunc.
+func (m *DiscoveryAPI) CreateCLICredentials(createCLICredentialsRequest *[]dnac.CreateCLICredentialsRequest) (*dnac.CreateCLICredentialsResponse, *resty.Response, error) {
+	m.record("CreateCLICredentials", createCLICredentialsRequest)
+	if m.CreateCLICredentialsFunc == nil {
+		panic("dnacmock: DiscoveryAPI.CreateCLICredentials called but CreateCLICredentialsFunc is not set")
+	}
+	return m.CreateCLICredentialsFunc(createCLICredentialsRequest)
+}
+
+// CreateCLICredentialsWithContext calls CreateCLICredentialsWithContextFunc.
+func (m *DiscoveryAPI) CreateCLICredentialsWithContext(ctx context.Context, createCLICredentialsRequest *[]dnac.CreateCLICredentialsRequest) (*dnac.CreateCLICredentialsResponse, *resty.Response, error) {
+	m.record("CreateCLICredentialsWithContext", ctx, createCLICredentialsRequest)
+	if m.CreateCLICredentialsWithContextFunc == nil {
+		panic("dnacmock: DiscoveryAPI.CreateCLICredentialsWithContext called but CreateCLICredentialsWithContextFunc is not set")
+	}
+	return m.CreateCLICredentialsWithContextFunc(ctx, createCLICredentialsRequest)
+}
+
+// CreateHTTPReadCredentials calls CreateHTTPReadCredentialsFunc.
+func (m *DiscoveryAPI) CreateHTTPReadCredentials(createHTTPReadCredentialsRequest *[]dnac.CreateHTTPReadCredentialsRequest) (*dnac.CreateHTTPReadCredentialsResponse, *resty.Response, error) {
+	m.record("CreateHTTPReadCredentials", createHTTPReadCredentialsRequest)
+	if m.CreateHTTPReadCredentialsFunc == nil {
+		panic("dnacmock: DiscoveryAPI.CreateHTTPReadCredentials called but CreateHTTPReadCredentialsFunc is not set")
+	}
+	return m.CreateHTTPReadCredentialsFunc(createHTTPReadCredentialsRequest)
+}
+
+// CreateHTTPReadCredentialsWithContext calls CreateHTTPReadCredentialsWithContextFunc.
+func (m *DiscoveryAPI) CreateHTTPReadCredentialsWithContext(ctx context.Context, createHTTPReadCredentialsRequest *[]dnac.CreateHTTPReadCredentialsRequest) (*dnac.CreateHTTPReadCredentialsResponse, *resty.Response, error) {
+	m.record("CreateHTTPReadCredentialsWithContext", ctx, createHTTPReadCredentialsRequest)
+	if m.CreateHTTPReadCredentialsWithContextFunc == nil {
+		panic("dnacmock: DiscoveryAPI.CreateHTTPReadCredentialsWithContext called but CreateHTTPReadCredentialsWithContextFunc is not set")
+	}
+	return m.CreateHTTPReadCredentialsWithContextFunc(ctx, createHTTPReadCredentialsRequest)
+}
+
+// CreateHTTPWriteCredentials calls CreateHTTPWriteCredentialsFunc.
+func (m *DiscoveryAPI) CreateHTTPWriteCredentials(createHTTPWriteCredentialsRequest *[]dnac.CreateHTTPWriteCredentialsRequest) (*dnac.CreateHTTPWriteCredentialsResponse, *resty.Response, error) {
+	m.record("CreateHTTPWriteCredentials", createHTTPWriteCredentialsRequest)
+	if m.CreateHTTPWriteCredentialsFunc == nil {
+		panic("dnacmock: DiscoveryAPI.CreateHTTPWriteCredentials called but CreateHTTPWriteCredentialsFunc is not set")
+	}
+	return m.CreateHTTPWriteCredentialsFunc(createHTTPWriteCredentialsRequest)
+}
+
+// CreateHTTPWriteCredentialsWithContext calls CreateHTTPWriteCredentialsWithContextFunc.
+func (m *DiscoveryAPI) CreateHTTPWriteCredentialsWithContext(ctx context.Context, createHTTPWriteCredentialsRequest *[]dnac.CreateHTTPWriteCredentialsRequest) (*dnac.CreateHTTPWriteCredentialsResponse, *resty.Response, error) {
+	m.record("CreateHTTPWriteCredentialsWithContext", ctx, createHTTPWriteCredentialsRequest)
+	if m.CreateHTTPWriteCredentialsWithContextFunc == nil {
+		panic("dnacmock: DiscoveryAPI.CreateHTTPWriteCredentialsWithContext called but CreateHTTPWriteCredentialsWithContextFunc is not set")
+	}
+	return m.CreateHTTPWriteCredentialsWithContextFunc(ctx, createHTTPWriteCredentialsRequest)
+}
+
+// CreateNetconfCredentials calls CreateNetconfCredentialsFunc.
+func (m *DiscoveryAPI) CreateNetconfCredentials(createNetconfCredentialsRequest *[]dnac.CreateNetconfCredentialsRequest) (*dnac.CreateNetconfCredentialsResponse, *resty.Response, error) {
+	m.record("CreateNetconfCredentials", createNetconfCredentialsRequest)
+	if m.CreateNetconfCredentialsFunc == nil {
+		panic("dnacmock: DiscoveryAPI.CreateNetconfCredentials called but CreateNetconfCredentialsFunc is not set")
+	}
+	return m.CreateNetconfCredentialsFunc(createNetconfCredentialsRequest)
+}
+
+// CreateNetconfCredentialsWithContext calls CreateNetconfCredentialsWithContextFunc.
+func (m *DiscoveryAPI) CreateNetconfCredentialsWithContext(ctx context.Context, createNetconfCredentialsRequest *[]dnac.CreateNetconfCredentialsRequest) (*dnac.CreateNetconfCredentialsResponse, *resty.Response, error) {
+	m.record("CreateNetconfCredentialsWithContext", ctx, createNetconfCredentialsRequest)
+	if m.CreateNetconfCredentialsWithContextFunc == nil {
+		panic("dnacmock: DiscoveryAPI.CreateNetconfCredentialsWithContext called but CreateNetconfCredentialsWithContextFunc is not set")
+	}
+	return m.CreateNetconfCredentialsWithContextFunc(ctx, createNetconfCredentialsRequest)
+}
+
+// CreateSNMPReadCommunity calls CreateSNMPReadCommunityFunc.
+func (m *DiscoveryAPI) CreateSNMPReadCommunity(createSNMPReadCommunityRequest *[]dnac.CreateSNMPReadCommunityRequest) (*dnac.CreateSNMPReadCommunityResponse, *resty.Response, error) {
+	m.record("CreateSNMPReadCommunity", createSNMPReadCommunityRequest)
+	if m.CreateSNMPReadCommunityFunc == nil {
+		panic("dnacmock: DiscoveryAPI.CreateSNMPReadCommunity called but CreateSNMPReadCommunityFunc is not set")
+	}
+	return m.CreateSNMPReadCommunityFunc(createSNMPReadCommunityRequest)
+}
+
+// CreateSNMPReadCommunityWithContext calls CreateSNMPReadCommunityWithContextFunc.
+func (m *DiscoveryAPI) CreateSNMPReadCommunityWithContext(ctx context.Context, createSNMPReadCommunityRequest *[]dnac.CreateSNMPReadCommunityRequest) (*dnac.CreateSNMPReadCommunityResponse, *resty.Response, error) {
+	m.record("CreateSNMPReadCommunityWithContext", ctx, createSNMPReadCommunityRequest)
+	if m.CreateSNMPReadCommunityWithContextFunc == nil {
+		panic("dnacmock: DiscoveryAPI.CreateSNMPReadCommunityWithContext called but CreateSNMPReadCommunityWithContextFunc is not set")
+	}
+	return m.CreateSNMPReadCommunityWithContextFunc(ctx, createSNMPReadCommunityRequest)
+}
+
+// CreateSNMPWriteCommunity calls CreateSNMPWriteCommunityFunc.
+func (m *DiscoveryAPI) CreateSNMPWriteCommunity(createSNMPWriteCommunityRequest *[]dnac.CreateSNMPWriteCommunityRequest) (*dnac.CreateSNMPWriteCommunityResponse, *resty.Response, error) {
+	m.record("CreateSNMPWriteCommunity", createSNMPWriteCommunityRequest)
+	if m.CreateSNMPWriteCommunityFunc == nil {
+		panic("dnacmock: DiscoveryAPI.CreateSNMPWriteCommunity called but CreateSNMPWriteCommunityFunc is not set")
+	}
+	return m.CreateSNMPWriteCommunityFunc(createSNMPWriteCommunityRequest)
+}
+
+// CreateSNMPWriteCommunityWithContext calls CreateSNMPWriteCommunityWithContextFunc.
+func (m *DiscoveryAPI) CreateSNMPWriteCommunityWithContext(ctx context.Context, createSNMPWriteCommunityRequest *[]dnac.CreateSNMPWriteCommunityRequest) (*dnac.CreateSNMPWriteCommunityResponse, *resty.Response, error) {
+	m.record("CreateSNMPWriteCommunityWithContext", ctx, createSNMPWriteCommunityRequest)
+	if m.CreateSNMPWriteCommunityWithContextFunc == nil {
+		panic("dnacmock: DiscoveryAPI.CreateSNMPWriteCommunityWithContext called but CreateSNMPWriteCommunityWithContextFunc is not set")
+	}
+	return m.CreateSNMPWriteCommunityWithContextFunc(ctx, createSNMPWriteCommunityRequest)
+}
+
+// CreateSNMPv3Credentials calls CreateSNMPv3CredentialsFunc.
+func (m *DiscoveryAPI) CreateSNMPv3Credentials(createSNMPv3CredentialsRequest *[]dnac.CreateSNMPv3CredentialsRequest) (*dnac.CreateSNMPv3CredentialsResponse, *resty.Response, error) {
+	m.record("CreateSNMPv3Credentials", createSNMPv3CredentialsRequest)
+	if m.CreateSNMPv3CredentialsFunc == nil {
+		panic("dnacmock: DiscoveryAPI.CreateSNMPv3Credentials called but CreateSNMPv3CredentialsFunc is not set")
+	}
+	return m.CreateSNMPv3CredentialsFunc(createSNMPv3CredentialsRequest)
+}
+
+// CreateSNMPv3CredentialsWithContext calls CreateSNMPv3CredentialsWithContextFunc.
+func (m *DiscoveryAPI) CreateSNMPv3CredentialsWithContext(ctx context.Context, createSNMPv3CredentialsRequest *[]dnac.CreateSNMPv3CredentialsRequest) (*dnac.CreateSNMPv3CredentialsResponse, *resty.Response, error) {
+	m.record("CreateSNMPv3CredentialsWithContext", ctx, createSNMPv3CredentialsRequest)
+	if m.CreateSNMPv3CredentialsWithContextFunc == nil {
+		panic("dnacmock: DiscoveryAPI.CreateSNMPv3CredentialsWithContext called but CreateSNMPv3CredentialsWithContextFunc is not set")
+	}
+	return m.CreateSNMPv3CredentialsWithContextFunc(ctx, createSNMPv3CredentialsRequest)
+}
+
+// CreateUpdateSNMPProperties calls CreateUpdateSNMPPropertiesFunc.
+func (m *DiscoveryAPI) CreateUpdateSNMPProperties(createUpdateSNMPPropertiesRequest *[]dnac.CreateUpdateSNMPPropertiesRequest) (*dnac.CreateUpdateSNMPPropertiesResponse, *resty.Response, error) {
+	m.record("CreateUpdateSNMPProperties", createUpdateSNMPPropertiesRequest)
+	if m.CreateUpdateSNMPPropertiesFunc == nil {
+		panic("dnacmock: DiscoveryAPI.CreateUpdateSNMPProperties called but CreateUpdateSNMPPropertiesFunc is not set")
+	}
+	return m.CreateUpdateSNMPPropertiesFunc(createUpdateSNMPPropertiesRequest)
+}
+
+// CreateUpdateSNMPPropertiesWithContext calls CreateUpdateSNMPPropertiesWithContextFunc.
+func (m *DiscoveryAPI) CreateUpdateSNMPPropertiesWithContext(ctx context.Context, createUpdateSNMPPropertiesRequest *[]dnac.CreateUpdateSNMPPropertiesRequest) (*dnac.CreateUpdateSNMPPropertiesResponse, *resty.Response, error) {
+	m.record("CreateUpdateSNMPPropertiesWithContext", ctx, createUpdateSNMPPropertiesRequest)
+	if m.CreateUpdateSNMPPropertiesWithContextFunc == nil {
+		panic("dnacmock: DiscoveryAPI.CreateUpdateSNMPPropertiesWithContext called but CreateUpdateSNMPPropertiesWithContextFunc is not set")
+	}
+	return m.CreateUpdateSNMPPropertiesWithContextFunc(ctx, createUpdateSNMPPropertiesRequest)
+}
+
+// DeleteAllDiscovery calls DeleteAllDiscoveryFunc.
+func (m *DiscoveryAPI) DeleteAllDiscovery() (*dnac.DeleteAllDiscoveryResponse, *resty.Response, error) {
+	m.record("DeleteAllDiscovery")
+	if m.DeleteAllDiscoveryFunc == nil {
+		panic("dnacmock: DiscoveryAPI.DeleteAllDiscovery called but DeleteAllDiscoveryFunc is not set")
+	}
+	return m.DeleteAllDiscoveryFunc()
+}
+
+// DeleteAllDiscoveryWithContext calls DeleteAllDiscoveryWithContextFunc.
+func (m *DiscoveryAPI) DeleteAllDiscoveryWithContext(ctx context.Context) (*dnac.DeleteAllDiscoveryResponse, *resty.Response, error) {
+	m.record("DeleteAllDiscoveryWithContext", ctx)
+	if m.DeleteAllDiscoveryWithContextFunc == nil {
+		panic("dnacmock: DiscoveryAPI.DeleteAllDiscoveryWithContext called but DeleteAllDiscoveryWithContextFunc is not set")
+	}
+	return m.DeleteAllDiscoveryWithContextFunc(ctx)
+}
+
+// DeleteDiscoveryByID calls DeleteDiscoveryByIDFunc.
+func (m *DiscoveryAPI) DeleteDiscoveryByID(id string) (*dnac.DeleteDiscoveryByIDResponse, *resty.Response, error) {
+	m.record("DeleteDiscoveryByID", id)
+	if m.DeleteDiscoveryByIDFunc == nil {
+		panic("dnacmock: DiscoveryAPI.DeleteDiscoveryByID called but DeleteDiscoveryByIDFunc is not set")
+	}
+	return m.DeleteDiscoveryByIDFunc(id)
+}
+
+// DeleteDiscoveryByIDWithContext calls DeleteDiscoveryByIDWithContextFunc.
+func (m *DiscoveryAPI) DeleteDiscoveryByIDWithContext(ctx context.Context, id string) (*dnac.DeleteDiscoveryByIDResponse, *resty.Response, error) {
+	m.record("DeleteDiscoveryByIDWithContext", ctx, id)
+	if m.DeleteDiscoveryByIDWithContextFunc == nil {
+		panic("dnacmock: DiscoveryAPI.DeleteDiscoveryByIDWithContext called but DeleteDiscoveryByIDWithContextFunc is not set")
+	}
+	return m.DeleteDiscoveryByIDWithContextFunc(ctx, id)
+}
+
+// DeleteDiscoveryBySpecifiedRange calls DeleteDiscoveryBySpecifiedRangeFunc.
+func (m *DiscoveryAPI) DeleteDiscoveryBySpecifiedRange(startIndex int, recordsToDelete int) (*dnac.DeleteDiscoveryBySpecifiedRangeResponse, *resty.Response, error) {
+	m.record("DeleteDiscoveryBySpecifiedRange", startIndex, recordsToDelete)
+	if m.DeleteDiscoveryBySpecifiedRangeFunc == nil {
+		panic("dnacmock: DiscoveryAPI.DeleteDiscoveryBySpecifiedRange called but DeleteDiscoveryBySpecifiedRangeFunc is not set")
+	}
+	return m.DeleteDiscoveryBySpecifiedRangeFunc(startIndex, recordsToDelete)
+}
+
+// DeleteDiscoveryBySpecifiedRangeWithContext calls DeleteDiscoveryBySpecifiedRangeWithContextFunc.
+func (m *DiscoveryAPI) DeleteDiscoveryBySpecifiedRangeWithContext(ctx context.Context, startIndex int, recordsToDelete int) (*dnac.DeleteDiscoveryBySpecifiedRangeResponse, *resty.Response, error) {
+	m.record("DeleteDiscoveryBySpecifiedRangeWithContext", ctx, startIndex, recordsToDelete)
+	if m.DeleteDiscoveryBySpecifiedRangeWithContextFunc == nil {
+		panic("dnacmock: DiscoveryAPI.DeleteDiscoveryBySpecifiedRangeWithContext called but DeleteDiscoveryBySpecifiedRangeWithContextFunc is not set")
+	}
+	return m.DeleteDiscoveryBySpecifiedRangeWithContextFunc(ctx, startIndex, recordsToDelete)
+}
+
+// DeleteGlobalCredentialsByID calls DeleteGlobalCredentialsByIDFunc.
+func (m *DiscoveryAPI) DeleteGlobalCredentialsByID(globalCredentialID string) (*dnac.DeleteGlobalCredentialsByIDResponse, *resty.Response, error) {
+	m.record("DeleteGlobalCredentialsByID", globalCredentialID)
+	if m.DeleteGlobalCredentialsByIDFunc == nil {
+		panic("dnacmock: DiscoveryAPI.DeleteGlobalCredentialsByID called but DeleteGlobalCredentialsByIDFunc is not set")
+	}
+	return m.DeleteGlobalCredentialsByIDFunc(globalCredentialID)
+}
+
+// DeleteGlobalCredentialsByIDWithContext calls DeleteGlobalCredentialsByIDWithContextFunc.
+func (m *DiscoveryAPI) DeleteGlobalCredentialsByIDWithContext(ctx context.Context, globalCredentialID string) (*dnac.DeleteGlobalCredentialsByIDResponse, *resty.Response, error) {
+	m.record("DeleteGlobalCredentialsByIDWithContext", ctx, globalCredentialID)
+	if m.DeleteGlobalCredentialsByIDWithContextFunc == nil {
+		panic("dnacmock: DiscoveryAPI.DeleteGlobalCredentialsByIDWithContext called but DeleteGlobalCredentialsByIDWithContextFunc is not set")
+	}
+	return m.DeleteGlobalCredentialsByIDWithContextFunc(ctx, globalCredentialID)
+}
+
+// GetCountOfAllDiscoveryJobs calls GetCountOfAllDiscoveryJobsFunc.
+func (m *DiscoveryAPI) GetCountOfAllDiscoveryJobs() (*dnac.GetCountOfAllDiscoveryJobsResponse, *resty.Response, error) {
+	m.record("GetCountOfAllDiscoveryJobs")
+	if m.GetCountOfAllDiscoveryJobsFunc == nil {
+		panic("dnacmock: DiscoveryAPI.GetCountOfAllDiscoveryJobs called but GetCountOfAllDiscoveryJobsFunc is not set")
+	}
+	return m.GetCountOfAllDiscoveryJobsFunc()
+}
+
+// GetCountOfAllDiscoveryJobsWithContext calls GetCountOfAllDiscoveryJobsWithContextFunc.
+func (m *DiscoveryAPI) GetCountOfAllDiscoveryJobsWithContext(ctx context.Context) (*dnac.GetCountOfAllDiscoveryJobsResponse, *resty.Response, error) {
+	m.record("GetCountOfAllDiscoveryJobsWithContext", ctx)
+	if m.GetCountOfAllDiscoveryJobsWithContextFunc == nil {
+		panic("dnacmock: DiscoveryAPI.GetCountOfAllDiscoveryJobsWithContext called but GetCountOfAllDiscoveryJobsWithContextFunc is not set")
+	}
+	return m.GetCountOfAllDiscoveryJobsWithContextFunc(ctx)
+}
+
+// GetCredentialSubTypeByCredentialID calls GetCredentialSubTypeByCredentialIDFunc.
+func (m *DiscoveryAPI) GetCredentialSubTypeByCredentialID(id string) (*dnac.GetCredentialSubTypeByCredentialIDResponse, *resty.Response, error) {
+	m.record("GetCredentialSubTypeByCredentialID", id)
+	if m.GetCredentialSubTypeByCredentialIDFunc == nil {
+		panic("dnacmock: DiscoveryAPI.GetCredentialSubTypeByCredentialID called but GetCredentialSubTypeByCredentialIDFunc is not set")
+	}
+	return m.GetCredentialSubTypeByCredentialIDFunc(id)
+}
+
+// GetCredentialSubTypeByCredentialIDWithContext calls GetCredentialSubTypeByCredentialIDWithContextFunc.
+func (m *DiscoveryAPI) GetCredentialSubTypeByCredentialIDWithContext(ctx context.Context, id string) (*dnac.GetCredentialSubTypeByCredentialIDResponse, *resty.Response, error) {
+	m.record("GetCredentialSubTypeByCredentialIDWithContext", ctx, id)
+	if m.GetCredentialSubTypeByCredentialIDWithContextFunc == nil {
+		panic("dnacmock: DiscoveryAPI.GetCredentialSubTypeByCredentialIDWithContext called but GetCredentialSubTypeByCredentialIDWithContextFunc is not set")
+	}
+	return m.GetCredentialSubTypeByCredentialIDWithContextFunc(ctx, id)
+}
+
+// GetDevicesDiscoveredByID calls GetDevicesDiscoveredByIDFunc.
+func (m *DiscoveryAPI) GetDevicesDiscoveredByID(id string, getDevicesDiscoveredByIDQueryParams *dnac.GetDevicesDiscoveredByIDQueryParams) (*dnac.GetDevicesDiscoveredByIDResponse, *resty.Response, error) {
+	m.record("GetDevicesDiscoveredByID", id, getDevicesDiscoveredByIDQueryParams)
+	if m.GetDevicesDiscoveredByIDFunc == nil {
+		panic("dnacmock: DiscoveryAPI.GetDevicesDiscoveredByID called but GetDevicesDiscoveredByIDFunc is not set")
+	}
+	return m.GetDevicesDiscoveredByIDFunc(id, getDevicesDiscoveredByIDQueryParams)
+}
+
+// GetDevicesDiscoveredByIDWithContext calls GetDevicesDiscoveredByIDWithContextFunc.
+func (m *DiscoveryAPI) GetDevicesDiscoveredByIDWithContext(ctx context.Context, id string, getDevicesDiscoveredByIDQueryParams *dnac.GetDevicesDiscoveredByIDQueryParams) (*dnac.GetDevicesDiscoveredByIDResponse, *resty.Response, error) {
+	m.record("GetDevicesDiscoveredByIDWithContext", ctx, id, getDevicesDiscoveredByIDQueryParams)
+	if m.GetDevicesDiscoveredByIDWithContextFunc == nil {
+		panic("dnacmock: DiscoveryAPI.GetDevicesDiscoveredByIDWithContext called but GetDevicesDiscoveredByIDWithContextFunc is not set")
+	}
+	return m.GetDevicesDiscoveredByIDWithContextFunc(ctx, id, getDevicesDiscoveredByIDQueryParams)
+}
+
+// GetDiscoveredDevicesByRange calls GetDiscoveredDevicesByRangeFunc.
+func (m *DiscoveryAPI) GetDiscoveredDevicesByRange(id string, startIndex int, recordsToReturn int, getDiscoveredDevicesByRangeQueryParams *dnac.GetDiscoveredDevicesByRangeQueryParams) (*dnac.GetDiscoveredDevicesByRangeResponse, *resty.Response, error) {
+	m.record("GetDiscoveredDevicesByRange", id, startIndex, recordsToReturn, getDiscoveredDevicesByRangeQueryParams)
+	if m.GetDiscoveredDevicesByRangeFunc == nil {
+		panic("dnacmock: DiscoveryAPI.GetDiscoveredDevicesByRange called but GetDiscoveredDevicesByRangeFunc is not set")
+	}
+	return m.GetDiscoveredDevicesByRangeFunc(id, startIndex, recordsToReturn, getDiscoveredDevicesByRangeQueryParams)
+}
+
+// GetDiscoveredDevicesByRangeWithContext calls GetDiscoveredDevicesByRangeWithContextFunc.
+func (m *DiscoveryAPI) GetDiscoveredDevicesByRangeWithContext(ctx context.Context, id string, startIndex int, recordsToReturn int, getDiscoveredDevicesByRangeQueryParams *dnac.GetDiscoveredDevicesByRangeQueryParams) (*dnac.GetDiscoveredDevicesByRangeResponse, *resty.Response, error) {
+	m.record("GetDiscoveredDevicesByRangeWithContext", ctx, id, startIndex, recordsToReturn, getDiscoveredDevicesByRangeQueryParams)
+	if m.GetDiscoveredDevicesByRangeWithContextFunc == nil {
+		panic("dnacmock: DiscoveryAPI.GetDiscoveredDevicesByRangeWithContext called but GetDiscoveredDevicesByRangeWithContextFunc is not set")
+	}
+	return m.GetDiscoveredDevicesByRangeWithContextFunc(ctx, id, startIndex, recordsToReturn, getDiscoveredDevicesByRangeQueryParams)
+}
+
+// GetDiscoveredNetworkDevicesByDiscoveryID calls GetDiscoveredNetworkDevicesByDiscoveryIDFunc.
+func (m *DiscoveryAPI) GetDiscoveredNetworkDevicesByDiscoveryID(id string, getDiscoveredNetworkDevicesByDiscoveryIDQueryParams *dnac.GetDiscoveredNetworkDevicesByDiscoveryIDQueryParams) (*dnac.GetDiscoveredNetworkDevicesByDiscoveryIDResponse, *resty.Response, error) {
+	m.record("GetDiscoveredNetworkDevicesByDiscoveryID", id, getDiscoveredNetworkDevicesByDiscoveryIDQueryParams)
+	if m.GetDiscoveredNetworkDevicesByDiscoveryIDFunc == nil {
+		panic("dnacmock: DiscoveryAPI.GetDiscoveredNetworkDevicesByDiscoveryID called but GetDiscoveredNetworkDevicesByDiscoveryIDFunc is not set")
+	}
+	return m.GetDiscoveredNetworkDevicesByDiscoveryIDFunc(id, getDiscoveredNetworkDevicesByDiscoveryIDQueryParams)
+}
+
+// GetDiscoveredNetworkDevicesByDiscoveryIDWithContext calls GetDiscoveredNetworkDevicesByDiscoveryIDWithContextFunc.
+func (m *DiscoveryAPI) GetDiscoveredNetworkDevicesByDiscoveryIDWithContext(ctx context.Context, id string, getDiscoveredNetworkDevicesByDiscoveryIDQueryParams *dnac.GetDiscoveredNetworkDevicesByDiscoveryIDQueryParams) (*dnac.GetDiscoveredNetworkDevicesByDiscoveryIDResponse, *resty.Response, error) {
+	m.record("GetDiscoveredNetworkDevicesByDiscoveryIDWithContext", ctx, id, getDiscoveredNetworkDevicesByDiscoveryIDQueryParams)
+	if m.GetDiscoveredNetworkDevicesByDiscoveryIDWithContextFunc == nil {
+		panic("dnacmock: DiscoveryAPI.GetDiscoveredNetworkDevicesByDiscoveryIDWithContext called but GetDiscoveredNetworkDevicesByDiscoveryIDWithContextFunc is not set")
+	}
+	return m.GetDiscoveredNetworkDevicesByDiscoveryIDWithContextFunc(ctx, id, getDiscoveredNetworkDevicesByDiscoveryIDQueryParams)
+}
+
+// GetDiscoveriesByRange calls GetDiscoveriesByRangeFunc.
+func (m *DiscoveryAPI) GetDiscoveriesByRange(startIndex int, recordsToReturn int) (*dnac.GetDiscoveriesByRangeResponse, *resty.Response, error) {
+	m.record("GetDiscoveriesByRange", startIndex, recordsToReturn)
+	if m.GetDiscoveriesByRangeFunc == nil {
+		panic("dnacmock: DiscoveryAPI.GetDiscoveriesByRange called but GetDiscoveriesByRangeFunc is not set")
+	}
+	return m.GetDiscoveriesByRangeFunc(startIndex, recordsToReturn)
+}
+
+// GetDiscoveriesByRangeWithContext calls GetDiscoveriesByRangeWithContextFunc.
+func (m *DiscoveryAPI) GetDiscoveriesByRangeWithContext(ctx context.Context, startIndex int, recordsToReturn int) (*dnac.GetDiscoveriesByRangeResponse, *resty.Response, error) {
+	m.record("GetDiscoveriesByRangeWithContext", ctx, startIndex, recordsToReturn)
+	if m.GetDiscoveriesByRangeWithContextFunc == nil {
+		panic("dnacmock: DiscoveryAPI.GetDiscoveriesByRangeWithContext called but GetDiscoveriesByRangeWithContextFunc is not set")
+	}
+	return m.GetDiscoveriesByRangeWithContextFunc(ctx, startIndex, recordsToReturn)
+}
+
+// GetDiscoveryByID calls GetDiscoveryByIDFunc.
+func (m *DiscoveryAPI) GetDiscoveryByID(id string) (*dnac.GetDiscoveryByIDResponse, *resty.Response, error) {
+	m.record("GetDiscoveryByID", id)
+	if m.GetDiscoveryByIDFunc == nil {
+		panic("dnacmock: DiscoveryAPI.GetDiscoveryByID called but GetDiscoveryByIDFunc is not set")
+	}
+	return m.GetDiscoveryByIDFunc(id)
+}
+
+// GetDiscoveryByIDWithContext calls GetDiscoveryByIDWithContextFunc.
+func (m *DiscoveryAPI) GetDiscoveryByIDWithContext(ctx context.Context, id string) (*dnac.GetDiscoveryByIDResponse, *resty.Response, error) {
+	m.record("GetDiscoveryByIDWithContext", ctx, id)
+	if m.GetDiscoveryByIDWithContextFunc == nil {
+		panic("dnacmock: DiscoveryAPI.GetDiscoveryByIDWithContext called but GetDiscoveryByIDWithContextFunc is not set")
+	}
+	return m.GetDiscoveryByIDWithContextFunc(ctx, id)
+}
+
+// GetDiscoveryJobsByIP calls GetDiscoveryJobsByIPFunc.
+func (m *DiscoveryAPI) GetDiscoveryJobsByIP(getDiscoveryJobsByIPQueryParams *dnac.GetDiscoveryJobsByIPQueryParams) (*dnac.GetDiscoveryJobsByIPResponse, *resty.Response, error) {
+	m.record("GetDiscoveryJobsByIP", getDiscoveryJobsByIPQueryParams)
+	if m.GetDiscoveryJobsByIPFunc == nil {
+		panic("dnacmock: DiscoveryAPI.GetDiscoveryJobsByIP called but GetDiscoveryJobsByIPFunc is not set")
+	}
+	return m.GetDiscoveryJobsByIPFunc(getDiscoveryJobsByIPQueryParams)
+}
+
+// GetDiscoveryJobsByIPWithContext calls GetDiscoveryJobsByIPWithContextFunc.
+func (m *DiscoveryAPI) GetDiscoveryJobsByIPWithContext(ctx context.Context, getDiscoveryJobsByIPQueryParams *dnac.GetDiscoveryJobsByIPQueryParams) (*dnac.GetDiscoveryJobsByIPResponse, *resty.Response, error) {
+	m.record("GetDiscoveryJobsByIPWithContext", ctx, getDiscoveryJobsByIPQueryParams)
+	if m.GetDiscoveryJobsByIPWithContextFunc == nil {
+		panic("dnacmock: DiscoveryAPI.GetDiscoveryJobsByIPWithContext called but GetDiscoveryJobsByIPWithContextFunc is not set")
+	}
+	return m.GetDiscoveryJobsByIPWithContextFunc(ctx, getDiscoveryJobsByIPQueryParams)
+}
+
+// GetGlobalCredentials calls GetGlobalCredentialsFunc.
+func (m *DiscoveryAPI) GetGlobalCredentials(getGlobalCredentialsQueryParams *dnac.GetGlobalCredentialsQueryParams) (*dnac.GetGlobalCredentialsResponse, *resty.Response, error) {
+	m.record("GetGlobalCredentials", getGlobalCredentialsQueryParams)
+	if m.GetGlobalCredentialsFunc == nil {
+		panic("dnacmock: DiscoveryAPI.GetGlobalCredentials called but GetGlobalCredentialsFunc is not set")
+	}
+	return m.GetGlobalCredentialsFunc(getGlobalCredentialsQueryParams)
+}
+
+// GetGlobalCredentialsWithContext calls GetGlobalCredentialsWithContextFunc.
+func (m *DiscoveryAPI) GetGlobalCredentialsWithContext(ctx context.Context, getGlobalCredentialsQueryParams *dnac.GetGlobalCredentialsQueryParams) (*dnac.GetGlobalCredentialsResponse, *resty.Response, error) {
+	m.record("GetGlobalCredentialsWithContext", ctx, getGlobalCredentialsQueryParams)
+	if m.GetGlobalCredentialsWithContextFunc == nil {
+		panic("dnacmock: DiscoveryAPI.GetGlobalCredentialsWithContext called but GetGlobalCredentialsWithContextFunc is not set")
+	}
+	return m.GetGlobalCredentialsWithContextFunc(ctx, getGlobalCredentialsQueryParams)
+}
+
+// GetListOfDiscoveriesByDiscoveryID calls GetListOfDiscoveriesByDiscoveryIDFunc.
+func (m *DiscoveryAPI) GetListOfDiscoveriesByDiscoveryID(id string, getListOfDiscoveriesByDiscoveryIDQueryParams *dnac.GetListOfDiscoveriesByDiscoveryIDQueryParams) (*dnac.GetListOfDiscoveriesByDiscoveryIDResponse, *resty.Response, error) {
+	m.record("GetListOfDiscoveriesByDiscoveryID", id, getListOfDiscoveriesByDiscoveryIDQueryParams)
+	if m.GetListOfDiscoveriesByDiscoveryIDFunc == nil {
+		panic("dnacmock: DiscoveryAPI.GetListOfDiscoveriesByDiscoveryID called but GetListOfDiscoveriesByDiscoveryIDFunc is not set")
+	}
+	return m.GetListOfDiscoveriesByDiscoveryIDFunc(id, getListOfDiscoveriesByDiscoveryIDQueryParams)
+}
+
+// GetListOfDiscoveriesByDiscoveryIDWithContext calls GetListOfDiscoveriesByDiscoveryIDWithContextFunc.
+func (m *DiscoveryAPI) GetListOfDiscoveriesByDiscoveryIDWithContext(ctx context.Context, id string, getListOfDiscoveriesByDiscoveryIDQueryParams *dnac.GetListOfDiscoveriesByDiscoveryIDQueryParams) (*dnac.GetListOfDiscoveriesByDiscoveryIDResponse, *resty.Response, error) {
+	m.record("GetListOfDiscoveriesByDiscoveryIDWithContext", ctx, id, getListOfDiscoveriesByDiscoveryIDQueryParams)
+	if m.GetListOfDiscoveriesByDiscoveryIDWithContextFunc == nil {
+		panic("dnacmock: DiscoveryAPI.GetListOfDiscoveriesByDiscoveryIDWithContext called but GetListOfDiscoveriesByDiscoveryIDWithContextFunc is not set")
+	}
+	return m.GetListOfDiscoveriesByDiscoveryIDWithContextFunc(ctx, id, getListOfDiscoveriesByDiscoveryIDQueryParams)
+}
+
+// GetNetworkDevicesFromDiscovery calls GetNetworkDevicesFromDiscoveryFunc.
+func (m *DiscoveryAPI) GetNetworkDevicesFromDiscovery(id string, getNetworkDevicesFromDiscoveryQueryParams *dnac.GetNetworkDevicesFromDiscoveryQueryParams) (*dnac.GetNetworkDevicesFromDiscoveryResponse, *resty.Response, error) {
+	m.record("GetNetworkDevicesFromDiscovery", id, getNetworkDevicesFromDiscoveryQueryParams)
+	if m.GetNetworkDevicesFromDiscoveryFunc == nil {
+		panic("dnacmock: DiscoveryAPI.GetNetworkDevicesFromDiscovery called but GetNetworkDevicesFromDiscoveryFunc is not set")
+	}
+	return m.GetNetworkDevicesFromDiscoveryFunc(id, getNetworkDevicesFromDiscoveryQueryParams)
+}
+
+// GetNetworkDevicesFromDiscoveryWithContext calls GetNetworkDevicesFromDiscoveryWithContextFunc.
+func (m *DiscoveryAPI) GetNetworkDevicesFromDiscoveryWithContext(ctx context.Context, id string, getNetworkDevicesFromDiscoveryQueryParams *dnac.GetNetworkDevicesFromDiscoveryQueryParams) (*dnac.GetNetworkDevicesFromDiscoveryResponse, *resty.Response, error) {
+	m.record("GetNetworkDevicesFromDiscoveryWithContext", ctx, id, getNetworkDevicesFromDiscoveryQueryParams)
+	if m.GetNetworkDevicesFromDiscoveryWithContextFunc == nil {
+		panic("dnacmock: DiscoveryAPI.GetNetworkDevicesFromDiscoveryWithContext called but GetNetworkDevicesFromDiscoveryWithContextFunc is not set")
+	}
+	return m.GetNetworkDevicesFromDiscoveryWithContextFunc(ctx, id, getNetworkDevicesFromDiscoveryQueryParams)
+}
+
+// GetSNMPProperties calls GetSNMPPropertiesFunc.
+func (m *DiscoveryAPI) GetSNMPProperties() (*dnac.GetSNMPPropertiesResponse, *resty.Response, error) {
+	m.record("GetSNMPProperties")
+	if m.GetSNMPPropertiesFunc == nil {
+		panic("dnacmock: DiscoveryAPI.GetSNMPProperties called but GetSNMPPropertiesFunc is not set")
+	}
+	return m.GetSNMPPropertiesFunc()
+}
+
+// GetSNMPPropertiesWithContext calls GetSNMPPropertiesWithContextFunc.
+func (m *DiscoveryAPI) GetSNMPPropertiesWithContext(ctx context.Context) (*dnac.GetSNMPPropertiesResponse, *resty.Response, error) {
+	m.record("GetSNMPPropertiesWithContext", ctx)
+	if m.GetSNMPPropertiesWithContextFunc == nil {
+		panic("dnacmock: DiscoveryAPI.GetSNMPPropertiesWithContext called but GetSNMPPropertiesWithContextFunc is not set")
+	}
+	return m.GetSNMPPropertiesWithContextFunc(ctx)
+}
+
+// ListAll calls ListAllFunc.
+func (m *DiscoveryAPI) ListAll(ctx context.Context, opts *dnac.PageOptions) *dnac.DiscoveryIterator {
+	m.record("ListAll", ctx, opts)
+	if m.ListAllFunc == nil {
+		panic("dnacmock: DiscoveryAPI.ListAll called but ListAllFunc is not set")
+	}
+	return m.ListAllFunc(ctx, opts)
+}
+
+// StartDiscovery calls StartDiscoveryFunc.
+func (m *DiscoveryAPI) StartDiscovery(startDiscoveryRequest *dnac.StartDiscoveryRequest) (*dnac.StartDiscoveryResponse, *resty.Response, error) {
+	m.record("StartDiscovery", startDiscoveryRequest)
+	if m.StartDiscoveryFunc == nil {
+		panic("dnacmock: DiscoveryAPI.StartDiscovery called but StartDiscoveryFunc is not set")
+	}
+	return m.StartDiscoveryFunc(startDiscoveryRequest)
+}
+
+// StartDiscoveryWithContext calls StartDiscoveryWithContextFunc.
+func (m *DiscoveryAPI) StartDiscoveryWithContext(ctx context.Context, startDiscoveryRequest *dnac.StartDiscoveryRequest) (*dnac.StartDiscoveryResponse, *resty.Response, error) {
+	m.record("StartDiscoveryWithContext", ctx, startDiscoveryRequest)
+	if m.StartDiscoveryWithContextFunc == nil {
+		panic("dnacmock: DiscoveryAPI.StartDiscoveryWithContext called but StartDiscoveryWithContextFunc is not set")
+	}
+	return m.StartDiscoveryWithContextFunc(ctx, startDiscoveryRequest)
+}
+
+// UpdateCLICredentials calls UpdateCLICredentialsFunc.
+func (m *DiscoveryAPI) UpdateCLICredentials(updateCLICredentialsRequest *dnac.UpdateCLICredentialsRequest) (*dnac.UpdateCLICredentialsResponse, *resty.Response, error) {
+	m.record("UpdateCLICredentials", updateCLICredentialsRequest)
+	if m.UpdateCLICredentialsFunc == nil {
+		panic("dnacmock: DiscoveryAPI.UpdateCLICredentials called but UpdateCLICredentialsFunc is not set")
+	}
+	return m.UpdateCLICredentialsFunc(updateCLICredentialsRequest)
+}
+
+// UpdateCLICredentialsWithContext calls UpdateCLICredentialsWithContextFunc.
+func (m *DiscoveryAPI) UpdateCLICredentialsWithContext(ctx context.Context, updateCLICredentialsRequest *dnac.UpdateCLICredentialsRequest) (*dnac.UpdateCLICredentialsResponse, *resty.Response, error) {
+	m.record("UpdateCLICredentialsWithContext", ctx, updateCLICredentialsRequest)
+	if m.UpdateCLICredentialsWithContextFunc == nil {
+		panic("dnacmock: DiscoveryAPI.UpdateCLICredentialsWithContext called but UpdateCLICredentialsWithContextFunc is not set")
+	}
+	return m.UpdateCLICredentialsWithContextFunc(ctx, updateCLICredentialsRequest)
+}
+
+// UpdateGlobalCredentials calls UpdateGlobalCredentialsFunc.
+func (m *DiscoveryAPI) UpdateGlobalCredentials(globalCredentialID string, updateGlobalCredentialsRequest *dnac.UpdateGlobalCredentialsRequest) (*dnac.UpdateGlobalCredentialsResponse, *resty.Response, error) {
+	m.record("UpdateGlobalCredentials", globalCredentialID, updateGlobalCredentialsRequest)
+	if m.UpdateGlobalCredentialsFunc == nil {
+		panic("dnacmock: DiscoveryAPI.UpdateGlobalCredentials called but UpdateGlobalCredentialsFunc is not set")
+	}
+	return m.UpdateGlobalCredentialsFunc(globalCredentialID, updateGlobalCredentialsRequest)
+}
+
+// UpdateGlobalCredentialsWithContext calls UpdateGlobalCredentialsWithContextFunc.
+func (m *DiscoveryAPI) UpdateGlobalCredentialsWithContext(ctx context.Context, globalCredentialID string, updateGlobalCredentialsRequest *dnac.UpdateGlobalCredentialsRequest) (*dnac.UpdateGlobalCredentialsResponse, *resty.Response, error) {
+	m.record("UpdateGlobalCredentialsWithContext", ctx, globalCredentialID, updateGlobalCredentialsRequest)
+	if m.UpdateGlobalCredentialsWithContextFunc == nil {
+		panic("dnacmock: DiscoveryAPI.UpdateGlobalCredentialsWithContext called but UpdateGlobalCredentialsWithContextFunc is not set")
+	}
+	return m.UpdateGlobalCredentialsWithContextFunc(ctx, globalCredentialID, updateGlobalCredentialsRequest)
+}
+
+// UpdateHTTPReadCredential calls UpdateHTTPReadCredentialFunc.
+func (m *DiscoveryAPI) UpdateHTTPReadCredential(updateHTTPReadCredentialRequest *dnac.UpdateHTTPReadCredentialRequest) (*dnac.UpdateHTTPReadCredentialResponse, *resty.Response, error) {
+	m.record("UpdateHTTPReadCredential", updateHTTPReadCredentialRequest)
+	if m.UpdateHTTPReadCredentialFunc == nil {
+		panic("dnacmock: DiscoveryAPI.UpdateHTTPReadCredential called but UpdateHTTPReadCredentialFunc is not set")
+	}
+	return m.UpdateHTTPReadCredentialFunc(updateHTTPReadCredentialRequest)
+}
+
+// UpdateHTTPReadCredentialWithContext calls UpdateHTTPReadCredentialWithContextFunc.
+func (m *DiscoveryAPI) UpdateHTTPReadCredentialWithContext(ctx context.Context, updateHTTPReadCredentialRequest *dnac.UpdateHTTPReadCredentialRequest) (*dnac.UpdateHTTPReadCredentialResponse, *resty.Response, error) {
+	m.record("UpdateHTTPReadCredentialWithContext", ctx, updateHTTPReadCredentialRequest)
+	if m.UpdateHTTPReadCredentialWithContextFunc == nil {
+		panic("dnacmock: DiscoveryAPI.UpdateHTTPReadCredentialWithContext called but UpdateHTTPReadCredentialWithContextFunc is not set")
+	}
+	return m.UpdateHTTPReadCredentialWithContextFunc(ctx, updateHTTPReadCredentialRequest)
+}
+
+// UpdateHTTPWriteCredentials calls UpdateHTTPWriteCredentialsFunc.
+func (m *DiscoveryAPI) UpdateHTTPWriteCredentials(updateHTTPWriteCredentialsRequest *dnac.UpdateHTTPWriteCredentialsRequest) (*dnac.UpdateHTTPWriteCredentialsResponse, *resty.Response, error) {
+	m.record("UpdateHTTPWriteCredentials", updateHTTPWriteCredentialsRequest)
+	if m.UpdateHTTPWriteCredentialsFunc == nil {
+		panic("dnacmock: DiscoveryAPI.UpdateHTTPWriteCredentials called but UpdateHTTPWriteCredentialsFunc is not set")
+	}
+	return m.UpdateHTTPWriteCredentialsFunc(updateHTTPWriteCredentialsRequest)
+}
+
+// UpdateHTTPWriteCredentialsWithContext calls UpdateHTTPWriteCredentialsWithContextFunc.
+func (m *DiscoveryAPI) UpdateHTTPWriteCredentialsWithContext(ctx context.Context, updateHTTPWriteCredentialsRequest *dnac.UpdateHTTPWriteCredentialsRequest) (*dnac.UpdateHTTPWriteCredentialsResponse, *resty.Response, error) {
+	m.record("UpdateHTTPWriteCredentialsWithContext", ctx, updateHTTPWriteCredentialsRequest)
+	if m.UpdateHTTPWriteCredentialsWithContextFunc == nil {
+		panic("dnacmock: DiscoveryAPI.UpdateHTTPWriteCredentialsWithContext called but UpdateHTTPWriteCredentialsWithContextFunc is not set")
+	}
+	return m.UpdateHTTPWriteCredentialsWithContextFunc(ctx, updateHTTPWriteCredentialsRequest)
+}
+
+// UpdateNetconfCredentials calls UpdateNetconfCredentialsFunc.
+func (m *DiscoveryAPI) UpdateNetconfCredentials(updateNetconfCredentialsRequest *dnac.UpdateNetconfCredentialsRequest) (*dnac.UpdateNetconfCredentialsResponse, *resty.Response, error) {
+	m.record("UpdateNetconfCredentials", updateNetconfCredentialsRequest)
+	if m.UpdateNetconfCredentialsFunc == nil {
+		panic("dnacmock: DiscoveryAPI.UpdateNetconfCredentials called but UpdateNetconfCredentialsFunc is not set")
+	}
+	return m.UpdateNetconfCredentialsFunc(updateNetconfCredentialsRequest)
+}
+
+// UpdateNetconfCredentialsWithContext calls UpdateNetconfCredentialsWithContextFunc.
+func (m *DiscoveryAPI) UpdateNetconfCredentialsWithContext(ctx context.Context, updateNetconfCredentialsRequest *dnac.UpdateNetconfCredentialsRequest) (*dnac.UpdateNetconfCredentialsResponse, *resty.Response, error) {
+	m.record("UpdateNetconfCredentialsWithContext", ctx, updateNetconfCredentialsRequest)
+	if m.UpdateNetconfCredentialsWithContextFunc == nil {
+		panic("dnacmock: DiscoveryAPI.UpdateNetconfCredentialsWithContext called but UpdateNetconfCredentialsWithContextFunc is not set")
+	}
+	return m.UpdateNetconfCredentialsWithContextFunc(ctx, updateNetconfCredentialsRequest)
+}
+
+// UpdateSNMPReadCommunity calls UpdateSNMPReadCommunityFunc.
+func (m *DiscoveryAPI) UpdateSNMPReadCommunity(updateSNMPReadCommunityRequest *dnac.UpdateSNMPReadCommunityRequest) (*dnac.UpdateSNMPReadCommunityResponse, *resty.Response, error) {
+	m.record("UpdateSNMPReadCommunity", updateSNMPReadCommunityRequest)
+	if m.UpdateSNMPReadCommunityFunc == nil {
+		panic("dnacmock: DiscoveryAPI.UpdateSNMPReadCommunity called but UpdateSNMPReadCommunityFunc is not set")
+	}
+	return m.UpdateSNMPReadCommunityFunc(updateSNMPReadCommunityRequest)
+}
+
+// UpdateSNMPReadCommunityWithContext calls UpdateSNMPReadCommunityWithContextFunc.
+func (m *DiscoveryAPI) UpdateSNMPReadCommunityWithContext(ctx context.Context, updateSNMPReadCommunityRequest *dnac.UpdateSNMPReadCommunityRequest) (*dnac.UpdateSNMPReadCommunityResponse, *resty.Response, error) {
+	m.record("UpdateSNMPReadCommunityWithContext", ctx, updateSNMPReadCommunityRequest)
+	if m.UpdateSNMPReadCommunityWithContextFunc == nil {
+		panic("dnacmock: DiscoveryAPI.UpdateSNMPReadCommunityWithContext called but UpdateSNMPReadCommunityWithContextFunc is not set")
+	}
+	return m.UpdateSNMPReadCommunityWithContextFunc(ctx, updateSNMPReadCommunityRequest)
+}
+
+// UpdateSNMPWriteCommunity calls UpdateSNMPWriteCommunityFunc.
+func (m *DiscoveryAPI) UpdateSNMPWriteCommunity(updateSNMPWriteCommunityRequest *dnac.UpdateSNMPWriteCommunityRequest) (*dnac.UpdateSNMPWriteCommunityResponse, *resty.Response, error) {
+	m.record("UpdateSNMPWriteCommunity", updateSNMPWriteCommunityRequest)
+	if m.UpdateSNMPWriteCommunityFunc == nil {
+		panic("dnacmock: DiscoveryAPI.UpdateSNMPWriteCommunity called but UpdateSNMPWriteCommunityFunc is not set")
+	}
+	return m.UpdateSNMPWriteCommunityFunc(updateSNMPWriteCommunityRequest)
+}
+
+// UpdateSNMPWriteCommunityWithContext calls UpdateSNMPWriteCommunityWithContextFunc.
+func (m *DiscoveryAPI) UpdateSNMPWriteCommunityWithContext(ctx context.Context, updateSNMPWriteCommunityRequest *dnac.UpdateSNMPWriteCommunityRequest) (*dnac.UpdateSNMPWriteCommunityResponse, *resty.Response, error) {
+	m.record("UpdateSNMPWriteCommunityWithContext", ctx, updateSNMPWriteCommunityRequest)
+	if m.UpdateSNMPWriteCommunityWithContextFunc == nil {
+		panic("dnacmock: DiscoveryAPI.UpdateSNMPWriteCommunityWithContext called but UpdateSNMPWriteCommunityWithContextFunc is not set")
+	}
+	return m.UpdateSNMPWriteCommunityWithContextFunc(ctx, updateSNMPWriteCommunityRequest)
+}
+
+// UpdateSNMPv3Credentials calls UpdateSNMPv3CredentialsFunc.
+func (m *DiscoveryAPI) UpdateSNMPv3Credentials(updateSNMPv3CredentialsRequest *dnac.UpdateSNMPv3CredentialsRequest) (*dnac.UpdateSNMPv3CredentialsResponse, *resty.Response, error) {
+	m.record("UpdateSNMPv3Credentials", updateSNMPv3CredentialsRequest)
+	if m.UpdateSNMPv3CredentialsFunc == nil {
+		panic("dnacmock: DiscoveryAPI.UpdateSNMPv3Credentials called but UpdateSNMPv3CredentialsFunc is not set")
+	}
+	return m.UpdateSNMPv3CredentialsFunc(updateSNMPv3CredentialsRequest)
+}
+
+// UpdateSNMPv3CredentialsWithContext calls UpdateSNMPv3CredentialsWithContextFunc.
+func (m *DiscoveryAPI) UpdateSNMPv3CredentialsWithContext(ctx context.Context, updateSNMPv3CredentialsRequest *dnac.UpdateSNMPv3CredentialsRequest) (*dnac.UpdateSNMPv3CredentialsResponse, *resty.Response, error) {
+	m.record("UpdateSNMPv3CredentialsWithContext", ctx, updateSNMPv3CredentialsRequest)
+	if m.UpdateSNMPv3CredentialsWithContextFunc == nil {
+		panic("dnacmock: DiscoveryAPI.UpdateSNMPv3CredentialsWithContext called but UpdateSNMPv3CredentialsWithContextFunc is not set")
+	}
+	return m.UpdateSNMPv3CredentialsWithContextFunc(ctx, updateSNMPv3CredentialsRequest)
+}
+
+// UpdatesAnExistingDiscoveryBySpecifiedID calls UpdatesAnExistingDiscoveryBySpecifiedIDFunc.
+func (m *DiscoveryAPI) UpdatesAnExistingDiscoveryBySpecifiedID(updatesAnExistingDiscoveryBySpecifiedIDRequest *dnac.UpdatesAnExistingDiscoveryBySpecifiedIDRequest) (*dnac.UpdatesAnExistingDiscoveryBySpecifiedIDResponse, *resty.Response, error) {
+	m.record("UpdatesAnExistingDiscoveryBySpecifiedID", updatesAnExistingDiscoveryBySpecifiedIDRequest)
+	if m.UpdatesAnExistingDiscoveryBySpecifiedIDFunc == nil {
+		panic("dnacmock: DiscoveryAPI.UpdatesAnExistingDiscoveryBySpecifiedID called but UpdatesAnExistingDiscoveryBySpecifiedIDFunc is not set")
+	}
+	return m.UpdatesAnExistingDiscoveryBySpecifiedIDFunc(updatesAnExistingDiscoveryBySpecifiedIDRequest)
+}
+
+// UpdatesAnExistingDiscoveryBySpecifiedIDWithContext calls UpdatesAnExistingDiscoveryBySpecifiedIDWithContextFunc.
+func (m *DiscoveryAPI) UpdatesAnExistingDiscoveryBySpecifiedIDWithContext(ctx context.Context, updatesAnExistingDiscoveryBySpecifiedIDRequest *dnac.UpdatesAnExistingDiscoveryBySpecifiedIDRequest) (*dnac.UpdatesAnExistingDiscoveryBySpecifiedIDResponse, *resty.Response, error) {
+	m.record("UpdatesAnExistingDiscoveryBySpecifiedIDWithContext", ctx, updatesAnExistingDiscoveryBySpecifiedIDRequest)
+	if m.UpdatesAnExistingDiscoveryBySpecifiedIDWithContextFunc == nil {
+		panic("dnacmock: DiscoveryAPI.UpdatesAnExistingDiscoveryBySpecifiedIDWithContext called but UpdatesAnExistingDiscoveryBySpecifiedIDWithContextFunc is not set")
+	}
+	return m.UpdatesAnExistingDiscoveryBySpecifiedIDWithContextFunc(ctx, updatesAnExistingDiscoveryBySpecifiedIDRequest)
+}
+
+// PathTraceAPI is a mock of dnac.PathTraceAPI. Each method calls the function of the
+// same name suffixed with Func, which must be set if the method is called.
+type PathTraceAPI struct {
+	Recorder
+
+	DeletesPathtraceByIDFunc                            func(flowAnalysisID string) (*dnac.DeletesPathtraceByIDResponse, *resty.Response, error)
+	DeletesPathtraceByIDWithContextFunc                 func(ctx context.Context, flowAnalysisID string) (*dnac.DeletesPathtraceByIDResponse, *resty.Response, error)
+	InitiateANewPathtraceFunc                           func(initiateANewPathtraceRequest *dnac.InitiateANewPathtraceRequest) (*dnac.InitiateANewPathtraceResponse, *resty.Response, error)
+	InitiateANewPathtraceWithContextFunc                func(ctx context.Context, initiateANewPathtraceRequest *dnac.InitiateANewPathtraceRequest) (*dnac.InitiateANewPathtraceResponse, *resty.Response, error)
+	RetrievesPreviousPathtraceFunc                      func(flowAnalysisID string) (*dnac.RetrievesPreviousPathtraceResponse, *resty.Response, error)
+	RetrievesPreviousPathtraceWithContextFunc           func(ctx context.Context, flowAnalysisID string) (*dnac.RetrievesPreviousPathtraceResponse, *resty.Response, error)
+	RetrivesAllPreviousPathtracesSummaryFunc            func(retrivesAllPreviousPathtracesSummaryQueryParams *dnac.RetrivesAllPreviousPathtracesSummaryQueryParams) (*dnac.RetrivesAllPreviousPathtracesSummaryResponse, *resty.Response, error)
+	RetrivesAllPreviousPathtracesSummaryWithContextFunc func(ctx context.Context, retrivesAllPreviousPathtracesSummaryQueryParams *dnac.RetrivesAllPreviousPathtracesSummaryQueryParams) (*dnac.RetrivesAllPreviousPathtracesSummaryResponse, *resty.Response, error)
+}
+
+// DeletesPathtraceByID calls DeletesPathtraceByIDFunc.
+func (m *PathTraceAPI) DeletesPathtraceByID(flowAnalysisID string) (*dnac.DeletesPathtraceByIDResponse, *resty.Response, error) {
+	m.record("DeletesPathtraceByID", flowAnalysisID)
+	if m.DeletesPathtraceByIDFunc == nil {
+		panic("dnacmock: PathTraceAPI.DeletesPathtraceByID called but DeletesPathtraceByIDFunc is not set")
+	}
+	return m.DeletesPathtraceByIDFunc(flowAnalysisID)
+}
+
+// DeletesPathtraceByIDWithContext calls DeletesPathtraceByIDWithContextFunc.
+func (m *PathTraceAPI) DeletesPathtraceByIDWithContext(ctx context.Context, flowAnalysisID string) (*dnac.DeletesPathtraceByIDResponse, *resty.Response, error) {
+	m.record("DeletesPathtraceByIDWithContext", ctx, flowAnalysisID)
+	if m.DeletesPathtraceByIDWithContextFunc == nil {
+		panic("dnacmock: PathTraceAPI.DeletesPathtraceByIDWithContext called but DeletesPathtraceByIDWithContextFunc is not set")
+	}
+	return m.DeletesPathtraceByIDWithContextFunc(ctx, flowAnalysisID)
+}
+
+// InitiateANewPathtrace calls InitiateANewPathtraceFunc.
+func (m *PathTraceAPI) InitiateANewPathtrace(initiateANewPathtraceRequest *dnac.InitiateANewPathtraceRequest) (*dnac.InitiateANewPathtraceResponse, *resty.Response, error) {
+	m.record("InitiateANewPathtrace", initiateANewPathtraceRequest)
+	if m.InitiateANewPathtraceFunc == nil {
+		panic("dnacmock: PathTraceAPI.InitiateANewPathtrace called but InitiateANewPathtraceFunc is not set")
+	}
+	return m.InitiateANewPathtraceFunc(initiateANewPathtraceRequest)
+}
+
+// InitiateANewPathtraceWithContext calls InitiateANewPathtraceWithContextFunc.
+func (m *PathTraceAPI) InitiateANewPathtraceWithContext(ctx context.Context, initiateANewPathtraceRequest *dnac.InitiateANewPathtraceRequest) (*dnac.InitiateANewPathtraceResponse, *resty.Response, error) {
+	m.record("InitiateANewPathtraceWithContext", ctx, initiateANewPathtraceRequest)
+	if m.InitiateANewPathtraceWithContextFunc == nil {
+		panic("dnacmock: PathTraceAPI.InitiateANewPathtraceWithContext called but InitiateANewPathtraceWithContextFunc is not set")
+	}
+	return m.InitiateANewPathtraceWithContextFunc(ctx, initiateANewPathtraceRequest)
+}
+
+// RetrievesPreviousPathtrace calls RetrievesPreviousPathtraceFunc.
+func (m *PathTraceAPI) RetrievesPreviousPathtrace(flowAnalysisID string) (*dnac.RetrievesPreviousPathtraceResponse, *resty.Response, error) {
+	m.record("RetrievesPreviousPathtrace", flowAnalysisID)
+	if m.RetrievesPreviousPathtraceFunc == nil {
+		panic("dnacmock: PathTraceAPI.RetrievesPreviousPathtrace called but RetrievesPreviousPathtraceFunc is not set")
+	}
+	return m.RetrievesPreviousPathtraceFunc(flowAnalysisID)
+}
+
+// RetrievesPreviousPathtraceWithContext calls RetrievesPreviousPathtraceWithContextFunc.
+func (m *PathTraceAPI) RetrievesPreviousPathtraceWithContext(ctx context.Context, flowAnalysisID string) (*dnac.RetrievesPreviousPathtraceResponse, *resty.Response, error) {
+	m.record("RetrievesPreviousPathtraceWithContext", ctx, flowAnalysisID)
+	if m.RetrievesPreviousPathtraceWithContextFunc == nil {
+		panic("dnacmock: PathTraceAPI.RetrievesPreviousPathtraceWithContext called but RetrievesPreviousPathtraceWithContextFunc is not set")
+	}
+	return m.RetrievesPreviousPathtraceWithContextFunc(ctx, flowAnalysisID)
+}
+
+// RetrivesAllPreviousPathtracesSummary calls RetrivesAllPreviousPathtracesSummaryFunc.
+func (m *PathTraceAPI) RetrivesAllPreviousPathtracesSummary(retrivesAllPreviousPathtracesSummaryQueryParams *dnac.RetrivesAllPreviousPathtracesSummaryQueryParams) (*dnac.RetrivesAllPreviousPathtracesSummaryResponse, *resty.Response, error) {
+	m.record("RetrivesAllPreviousPathtracesSummary", retrivesAllPreviousPathtracesSummaryQueryParams)
+	if m.RetrivesAllPreviousPathtracesSummaryFunc == nil {
+		panic("dnacmock: PathTraceAPI.RetrivesAllPreviousPathtracesSummary called but RetrivesAllPreviousPathtracesSummaryFunc is not set")
+	}
+	return m.RetrivesAllPreviousPathtracesSummaryFunc(retrivesAllPreviousPathtracesSummaryQueryParams)
+}
+
+// RetrivesAllPreviousPathtracesSummaryWithContext calls RetrivesAllPreviousPathtracesSummaryWithContextFunc.
+func (m *PathTraceAPI) RetrivesAllPreviousPathtracesSummaryWithContext(ctx context.Context, retrivesAllPreviousPathtracesSummaryQueryParams *dnac.RetrivesAllPreviousPathtracesSummaryQueryParams) (*dnac.RetrivesAllPreviousPathtracesSummaryResponse, *resty.Response, error) {
+	m.record("RetrivesAllPreviousPathtracesSummaryWithContext", ctx, retrivesAllPreviousPathtracesSummaryQueryParams)
+	if m.RetrivesAllPreviousPathtracesSummaryWithContextFunc == nil {
+		panic("dnacmock: PathTraceAPI.RetrivesAllPreviousPathtracesSummaryWithContext called but RetrivesAllPreviousPathtracesSummaryWithContextFunc is not set")
+	}
+	return m.RetrivesAllPreviousPathtracesSummaryWithContextFunc(ctx, retrivesAllPreviousPathtracesSummaryQueryParams)
+}
+
+// FileAPI is a mock of dnac.FileAPI. Each method calls the function of the
+// same name suffixed with Func, which must be set if the method is called.
+type FileAPI struct {
+	Recorder
+
+	DownloadAFileByFileIDFunc                   func(fileID string) (string, *resty.Response, error)
+	DownloadAFileByFileIDWithContextFunc        func(ctx context.Context, fileID string) (string, *resty.Response, error)
+	DownloadToWriterFunc                        func(ctx context.Context, fileID string, w io.Writer, opts *dnac.DownloadOptions) (*dnac.DownloadedFile, error)
+	GetListOfAvailableNamespacesFunc            func() (*dnac.GetListOfAvailableNamespacesResponse, *resty.Response, error)
+	GetListOfAvailableNamespacesWithContextFunc func(ctx context.Context) (*dnac.GetListOfAvailableNamespacesResponse, *resty.Response, error)
+	GetListOfFilesFunc                          func(nameSpace string) (*dnac.GetListOfFilesResponse, *resty.Response, error)
+	GetListOfFilesWithContextFunc               func(ctx context.Context, nameSpace string) (*dnac.GetListOfFilesResponse, *resty.Response, error)
+}
+
+// DownloadAFileByFileID calls DownloadAFileByFileIDFunc.
+func (m *FileAPI) DownloadAFileByFileID(fileID string) (string, *resty.Response, error) {
+	m.record("DownloadAFileByFileID", fileID)
+	if m.DownloadAFileByFileIDFunc == nil {
+		panic("dnacmock: FileAPI.DownloadAFileByFileID called but DownloadAFileByFileIDFunc is not set")
+	}
+	return m.DownloadAFileByFileIDFunc(fileID)
+}
+
+// DownloadAFileByFileIDWithContext calls DownloadAFileByFileIDWithContextFunc.
+func (m *FileAPI) DownloadAFileByFileIDWithContext(ctx context.Context, fileID string) (string, *resty.Response, error) {
+	m.record("DownloadAFileByFileIDWithContext", ctx, fileID)
+	if m.DownloadAFileByFileIDWithContextFunc == nil {
+		panic("dnacmock: FileAPI.DownloadAFileByFileIDWithContext called but DownloadAFileByFileIDWithContextFunc is not set")
+	}
+	return m.DownloadAFileByFileIDWithContextFunc(ctx, fileID)
+}
+
+// DownloadToWriter calls DownloadToWriterFunc.
+func (m *FileAPI) DownloadToWriter(ctx context.Context, fileID string, w io.Writer, opts *dnac.DownloadOptions) (*dnac.DownloadedFile, error) {
+	m.record("DownloadToWriter", ctx, fileID, w, opts)
+	if m.DownloadToWriterFunc == nil {
+		panic("dnacmock: FileAPI.DownloadToWriter called but DownloadToWriterFunc is not set")
+	}
+	return m.DownloadToWriterFunc(ctx, fileID, w, opts)
+}
+
+// GetListOfAvailableNamespaces calls GetListOfAvailableNamespacesFunc.
+func (m *FileAPI) GetListOfAvailableNamespaces() (*dnac.GetListOfAvailableNamespacesResponse, *resty.Response, error) {
+	m.record("GetListOfAvailableNamespaces")
+	if m.GetListOfAvailableNamespacesFunc == nil {
+		panic("dnacmock: FileAPI.GetListOfAvailableNamespaces called but GetListOfAvailableNamespacesFunc is not set")
+	}
+	return m.GetListOfAvailableNamespacesFunc()
+}
+
+// GetListOfAvailableNamespacesWithContext calls GetListOfAvailableNamespacesWithContextFunc.
+func (m *FileAPI) GetListOfAvailableNamespacesWithContext(ctx context.Context) (*dnac.GetListOfAvailableNamespacesResponse, *resty.Response, error) {
+	m.record("GetListOfAvailableNamespacesWithContext", ctx)
+	if m.GetListOfAvailableNamespacesWithContextFunc == nil {
+		panic("dnacmock: FileAPI.GetListOfAvailableNamespacesWithContext called but GetListOfAvailableNamespacesWithContextFunc is not set")
+	}
+	return m.GetListOfAvailableNamespacesWithContextFunc(ctx)
+}
+
+// GetListOfFiles calls GetListOfFilesFunc.
+func (m *FileAPI) GetListOfFiles(nameSpace string) (*dnac.GetListOfFilesResponse, *resty.Response, error) {
+	m.record("GetListOfFiles", nameSpace)
+	if m.GetListOfFilesFunc == nil {
+		panic("dnacmock: FileAPI.GetListOfFiles called but GetListOfFilesFunc is not set")
+	}
+	return m.GetListOfFilesFunc(nameSpace)
+}
+
+// GetListOfFilesWithContext calls GetListOfFilesWithContextFunc.
+func (m *FileAPI) GetListOfFilesWithContext(ctx context.Context, nameSpace string) (*dnac.GetListOfFilesResponse, *resty.Response, error) {
+	m.record("GetListOfFilesWithContext", ctx, nameSpace)
+	if m.GetListOfFilesWithContextFunc == nil {
+		panic("dnacmock: FileAPI.GetListOfFilesWithContext called but GetListOfFilesWithContextFunc is not set")
+	}
+	return m.GetListOfFilesWithContextFunc(ctx, nameSpace)
+}
+
+// TaskAPI is a mock of dnac.TaskAPI. Each method calls the function of the
+// same name suffixed with Func, which must be set if the method is called.
+type TaskAPI struct {
+	Recorder
+
+	GetTaskByIDFunc                     func(taskID string) (*dnac.GetTaskByIDResponse, *resty.Response, error)
+	GetTaskByIDWithContextFunc          func(ctx context.Context, taskID string) (*dnac.GetTaskByIDResponse, *resty.Response, error)
+	GetTaskByOperationIDFunc            func(operationID string, offset int, limit int) (*dnac.GetTaskByOperationIDResponse, *resty.Response, error)
+	GetTaskByOperationIDWithContextFunc func(ctx context.Context, operationID string, offset int, limit int) (*dnac.GetTaskByOperationIDResponse, *resty.Response, error)
+	GetTaskCountFunc                    func(getTaskCountQueryParams *dnac.GetTaskCountQueryParams) (*dnac.GetTaskCountResponse, *resty.Response, error)
+	GetTaskCountWithContextFunc         func(ctx context.Context, getTaskCountQueryParams *dnac.GetTaskCountQueryParams) (*dnac.GetTaskCountResponse, *resty.Response, error)
+	GetTaskTreeFunc                     func(taskID string) (*dnac.GetTaskTreeResponse, *resty.Response, error)
+	GetTaskTreeWithContextFunc          func(ctx context.Context, taskID string) (*dnac.GetTaskTreeResponse, *resty.Response, error)
+	GetTasksFunc                        func(getTasksQueryParams *dnac.GetTasksQueryParams) (*dnac.GetTasksResponse, *resty.Response, error)
+	GetTasksWithContextFunc             func(ctx context.Context, getTasksQueryParams *dnac.GetTasksQueryParams) (*dnac.GetTasksResponse, *resty.Response, error)
+	WaitForTaskFunc                     func(ctx context.Context, taskID string, opts *dnac.WaitForTaskOptions) (*dnac.TaskResult, error)
+}
+
+// GetTaskByID calls GetTaskByIDFunc.
+func (m *TaskAPI) GetTaskByID(taskID string) (*dnac.GetTaskByIDResponse, *resty.Response, error) {
+	m.record("GetTaskByID", taskID)
+	if m.GetTaskByIDFunc == nil {
+		panic("dnacmock: TaskAPI.GetTaskByID called but GetTaskByIDFunc is not set")
+	}
+	return m.GetTaskByIDFunc(taskID)
+}
+
+// GetTaskByIDWithContext calls GetTaskByIDWithContextFunc.
+func (m *TaskAPI) GetTaskByIDWithContext(ctx context.Context, taskID string) (*dnac.GetTaskByIDResponse, *resty.Response, error) {
+	m.record("GetTaskByIDWithContext", ctx, taskID)
+	if m.GetTaskByIDWithContextFunc == nil {
+		panic("dnacmock: TaskAPI.GetTaskByIDWithContext called but GetTaskByIDWithContextFunc is not set")
+	}
+	return m.GetTaskByIDWithContextFunc(ctx, taskID)
+}
+
+// GetTaskByOperationID calls GetTaskByOperationIDFunc.
+func (m *TaskAPI) GetTaskByOperationID(operationID string, offset int, limit int) (*dnac.GetTaskByOperationIDResponse, *resty.Response, error) {
+	m.record("GetTaskByOperationID", operationID, offset, limit)
+	if m.GetTaskByOperationIDFunc == nil {
+		panic("dnacmock: TaskAPI.GetTaskByOperationID called but GetTaskByOperationIDFunc is not set")
+	}
+	return m.GetTaskByOperationIDFunc(operationID, offset, limit)
+}
+
+// GetTaskByOperationIDWithContext calls GetTaskByOperationIDWithContextFunc.
+func (m *TaskAPI) GetTaskByOperationIDWithContext(ctx context.Context, operationID string, offset int, limit int) (*dnac.GetTaskByOperationIDResponse, *resty.Response, error) {
+	m.record("GetTaskByOperationIDWithContext", ctx, operationID, offset, limit)
+	if m.GetTaskByOperationIDWithContextFunc == nil {
+		panic("dnacmock: TaskAPI.GetTaskByOperationIDWithContext called but GetTaskByOperationIDWithContextFunc is not set")
+	}
+	return m.GetTaskByOperationIDWithContextFunc(ctx, operationID, offset, limit)
+}
+
+// GetTaskCount calls GetTaskCountFunc.
+func (m *TaskAPI) GetTaskCount(getTaskCountQueryParams *dnac.GetTaskCountQueryParams) (*dnac.GetTaskCountResponse, *resty.Response, error) {
+	m.record("GetTaskCount", getTaskCountQueryParams)
+	if m.GetTaskCountFunc == nil {
+		panic("dnacmock: TaskAPI.GetTaskCount called but GetTaskCountFunc is not set")
+	}
+	return m.GetTaskCountFunc(getTaskCountQueryParams)
+}
+
+// GetTaskCountWithContext calls GetTaskCountWithContextFunc.
+func (m *TaskAPI) GetTaskCountWithContext(ctx context.Context, getTaskCountQueryParams *dnac.GetTaskCountQueryParams) (*dnac.GetTaskCountResponse, *resty.Response, error) {
+	m.record("GetTaskCountWithContext", ctx, getTaskCountQueryParams)
+	if m.GetTaskCountWithContextFunc == nil {
+		panic("dnacmock: TaskAPI.GetTaskCountWithContext called but GetTaskCountWithContextFunc is not set")
+	}
+	return m.GetTaskCountWithContextFunc(ctx, getTaskCountQueryParams)
+}
+
+// GetTaskTree calls GetTaskTreeFunc.
+func (m *TaskAPI) GetTaskTree(taskID string) (*dnac.GetTaskTreeResponse, *resty.Response, error) {
+	m.record("GetTaskTree", taskID)
+	if m.GetTaskTreeFunc == nil {
+		panic("dnacmock: TaskAPI.GetTaskTree called but GetTaskTreeFunc is not set")
+	}
+	return m.GetTaskTreeFunc(taskID)
+}
+
+// GetTaskTreeWithContext calls GetTaskTreeWithContextFunc.
+func (m *TaskAPI) GetTaskTreeWithContext(ctx context.Context, taskID string) (*dnac.GetTaskTreeResponse, *resty.Response, error) {
+	m.record("GetTaskTreeWithContext", ctx, taskID)
+	if m.GetTaskTreeWithContextFunc == nil {
+		panic("dnacmock: TaskAPI.GetTaskTreeWithContext called but GetTaskTreeWithContextFunc is not set")
+	}
+	return m.GetTaskTreeWithContextFunc(ctx, taskID)
+}
+
+// GetTasks calls GetTasksFunc.
+func (m *TaskAPI) GetTasks(getTasksQueryParams *dnac.GetTasksQueryParams) (*dnac.GetTasksResponse, *resty.Response, error) {
+	m.record("GetTasks", getTasksQueryParams)
+	if m.GetTasksFunc == nil {
+		panic("dnacmock: TaskAPI.GetTasks called but GetTasksFunc is not set")
+	}
+	return m.GetTasksFunc(getTasksQueryParams)
+}
+
+// GetTasksWithContext calls GetTasksWithContextFunc.
+func (m *TaskAPI) GetTasksWithContext(ctx context.Context, getTasksQueryParams *dnac.GetTasksQueryParams) (*dnac.GetTasksResponse, *resty.Response, error) {
+	m.record("GetTasksWithContext", ctx, getTasksQueryParams)
+	if m.GetTasksWithContextFunc == nil {
+		panic("dnacmock: TaskAPI.GetTasksWithContext called but GetTasksWithContextFunc is not set")
+	}
+	return m.GetTasksWithContextFunc(ctx, getTasksQueryParams)
+}
+
+// WaitForTask calls WaitForTaskFunc.
+func (m *TaskAPI) WaitForTask(ctx context.Context, taskID string, opts *dnac.WaitForTaskOptions) (*dnac.TaskResult, error) {
+	m.record("WaitForTask", ctx, taskID, opts)
+	if m.WaitForTaskFunc == nil {
+		panic("dnacmock: TaskAPI.WaitForTask called but WaitForTaskFunc is not set")
+	}
+	return m.WaitForTaskFunc(ctx, taskID, opts)
+}
+
+// TagAPI is a mock of dnac.TagAPI. Each method calls the function of the
+// same name suffixed with Func, which must be set if the method is called.
+type TagAPI struct {
+	Recorder
+
+	CreateTagFunc                       func(createTagRequest *dnac.CreateTagRequest) (*dnac.CreateTagResponse, *resty.Response, error)
+	CreateTagWithContextFunc            func(ctx context.Context, createTagRequest *dnac.CreateTagRequest) (*dnac.CreateTagResponse, *resty.Response, error)
+	DeleteTagFunc                       func(id string) (*dnac.DeleteTagResponse, *resty.Response, error)
+	DeleteTagWithContextFunc            func(ctx context.Context, id string) (*dnac.DeleteTagResponse, *resty.Response, error)
+	GetTagFunc                          func(getTagQueryParams *dnac.GetTagQueryParams) (*dnac.GetTagResponse, *resty.Response, error)
+	GetTagByIDFunc                      func(id string) (*dnac.GetTagByIDResponse, *resty.Response, error)
+	GetTagByIDWithContextFunc           func(ctx context.Context, id string) (*dnac.GetTagByIDResponse, *resty.Response, error)
+	GetTagCountFunc                     func(getTagCountQueryParams *dnac.GetTagCountQueryParams) (*dnac.GetTagCountResponse, *resty.Response, error)
+	GetTagCountWithContextFunc          func(ctx context.Context, getTagCountQueryParams *dnac.GetTagCountQueryParams) (*dnac.GetTagCountResponse, *resty.Response, error)
+	GetTagMemberCountFunc               func(id string, getTagMemberCountQueryParams *dnac.GetTagMemberCountQueryParams) (*dnac.GetTagMemberCountResponse, *resty.Response, error)
+	GetTagMemberCountWithContextFunc    func(ctx context.Context, id string, getTagMemberCountQueryParams *dnac.GetTagMemberCountQueryParams) (*dnac.GetTagMemberCountResponse, *resty.Response, error)
+	GetTagMembersByIDFunc               func(id string, getTagMembersByIDQueryParams *dnac.GetTagMembersByIDQueryParams) (*dnac.GetTagMembersByIDResponse, *resty.Response, error)
+	GetTagMembersByIDWithContextFunc    func(ctx context.Context, id string, getTagMembersByIDQueryParams *dnac.GetTagMembersByIDQueryParams) (*dnac.GetTagMembersByIDResponse, *resty.Response, error)
+	GetTagResourceTypesFunc             func() (*dnac.GetTagResourceTypesResponse, *resty.Response, error)
+	GetTagResourceTypesWithContextFunc  func(ctx context.Context) (*dnac.GetTagResourceTypesResponse, *resty.Response, error)
+	GetTagWithContextFunc               func(ctx context.Context, getTagQueryParams *dnac.GetTagQueryParams) (*dnac.GetTagResponse, *resty.Response, error)
+	ListAllFunc                         func(ctx context.Context, params *dnac.GetTagQueryParams, opts *dnac.PageOptions) *dnac.TagIterator
+	ListAllMembersFunc                  func(ctx context.Context, id string, params *dnac.GetTagMembersByIDQueryParams, opts *dnac.PageOptions) *dnac.TagMemberIterator
+	RemoveTagMemberFunc                 func(id string, memberID string) (*dnac.RemoveTagMemberResponse, *resty.Response, error)
+	RemoveTagMemberWithContextFunc      func(ctx context.Context, id string, memberID string) (*dnac.RemoveTagMemberResponse, *resty.Response, error)
+	SetTagMembersFunc                   func(ctx context.Context, tagID string, memberType string, memberIDs []string, opts *dnac.TagMembershipOptions) (*dnac.TagMembershipReport, error)
+	UpdateTagFunc                       func(updateTagRequest *dnac.UpdateTagRequest) (*dnac.UpdateTagResponse, *resty.Response, error)
+	UpdateTagWithContextFunc            func(ctx context.Context, updateTagRequest *dnac.UpdateTagRequest) (*dnac.UpdateTagResponse, *resty.Response, error)
+	UpdatesTagMembershipFunc            func(updatesTagMembershipRequest *dnac.UpdatesTagMembershipRequest) (*dnac.UpdatesTagMembershipResponse, *resty.Response, error)
+	UpdatesTagMembershipWithContextFunc func(ctx context.Context, updatesTagMembershipRequest *dnac.UpdatesTagMembershipRequest) (*dnac.UpdatesTagMembershipResponse, *resty.Response, error)
+}
+
+// CreateTag calls CreateTagFunc.
+func (m *TagAPI) CreateTag(createTagRequest *dnac.CreateTagRequest) (*dnac.CreateTagResponse, *resty.Response, error) {
+	m.record("CreateTag", createTagRequest)
+	if m.CreateTagFunc == nil {
+		panic("dnacmock: TagAPI.CreateTag called but CreateTagFunc is not set")
+	}
+	return m.CreateTagFunc(createTagRequest)
+}
+
+// CreateTagWithContext calls CreateTagWithContextFunc.
+func (m *TagAPI) CreateTagWithContext(ctx context.Context, createTagRequest *dnac.CreateTagRequest) (*dnac.CreateTagResponse, *resty.Response, error) {
+	m.record("CreateTagWithContext", ctx, createTagRequest)
+	if m.CreateTagWithContextFunc == nil {
+		panic("dnacmock: TagAPI.CreateTagWithContext called but CreateTagWithContextFunc is not set")
+	}
+	return m.CreateTagWithContextFunc(ctx, createTagRequest)
+}
+
+// DeleteTag calls DeleteTagFunc.
+func (m *TagAPI) DeleteTag(id string) (*dnac.DeleteTagResponse, *resty.Response, error) {
+	m.record("DeleteTag", id)
+	if m.DeleteTagFunc == nil {
+		panic("dnacmock: TagAPI.DeleteTag called but DeleteTagFunc is not set")
+	}
+	return m.DeleteTagFunc(id)
+}
+
+// DeleteTagWithContext calls DeleteTagWithContextFunc.
+func (m *TagAPI) DeleteTagWithContext(ctx context.Context, id string) (*dnac.DeleteTagResponse, *resty.Response, error) {
+	m.record("DeleteTagWithContext", ctx, id)
+	if m.DeleteTagWithContextFunc == nil {
+		panic("dnacmock: TagAPI.DeleteTagWithContext called but DeleteTagWithContextFunc is not set")
+	}
+	return m.DeleteTagWithContextFunc(ctx, id)
+}
+
+// GetTag calls GetTagFunc.
+func (m *TagAPI) GetTag(getTagQueryParams *dnac.GetTagQueryParams) (*dnac.GetTagResponse, *resty.Response, error) {
+	m.record("GetTag", getTagQueryParams)
+	if m.GetTagFunc == nil {
+		panic("dnacmock: TagAPI.GetTag called but GetTagFunc is not set")
+	}
+	return m.GetTagFunc(getTagQueryParams)
+}
+
+// GetTagByID calls GetTagByIDFunc.
+func (m *TagAPI) GetTagByID(id string) (*dnac.GetTagByIDResponse, *resty.Response, error) {
+	m.record("GetTagByID", id)
+	if m.GetTagByIDFunc == nil {
+		panic("dnacmock: TagAPI.GetTagByID called but GetTagByIDFunc is not set")
+	}
+	return m.GetTagByIDFunc(id)
+}
+
+// GetTagByIDWithContext calls GetTagByIDWithContextFunc.
+func (m *TagAPI) GetTagByIDWithContext(ctx context.Context, id string) (*dnac.GetTagByIDResponse, *resty.Response, error) {
+	m.record("GetTagByIDWithContext", ctx, id)
+	if m.GetTagByIDWithContextFunc == nil {
+		panic("dnacmock: TagAPI.GetTagByIDWithContext called but GetTagByIDWithContextFunc is not set")
+	}
+	return m.GetTagByIDWithContextFunc(ctx, id)
+}
+
+// GetTagCount calls GetTagCountFunc.
+func (m *TagAPI) GetTagCount(getTagCountQueryParams *dnac.GetTagCountQueryParams) (*dnac.GetTagCountResponse, *resty.Response, error) {
+	m.record("GetTagCount", getTagCountQueryParams)
+	if m.GetTagCountFunc == nil {
+		panic("dnacmock: TagAPI.GetTagCount called but GetTagCountFunc is not set")
+	}
+	return m.GetTagCountFunc(getTagCountQueryParams)
+}
+
+// GetTagCountWithContext calls GetTagCountWithContextFunc.
+func (m *TagAPI) GetTagCountWithContext(ctx context.Context, getTagCountQueryParams *dnac.GetTagCountQueryParams) (*dnac.GetTagCountResponse, *resty.Response, error) {
+	m.record("GetTagCountWithContext", ctx, getTagCountQueryParams)
+	if m.GetTagCountWithContextFunc == nil {
+		panic("dnacmock: TagAPI.GetTagCountWithContext called but GetTagCountWithContextFunc is not set")
+	}
+	return m.GetTagCountWithContextFunc(ctx, getTagCountQueryParams)
+}
+
+// GetTagMemberCount calls GetTagMemberCountFunc.
+func (m *TagAPI) GetTagMemberCount(id string, getTagMemberCountQueryParams *dnac.GetTagMemberCountQueryParams) (*dnac.GetTagMemberCountResponse, *resty.Response, error) {
+	m.record("GetTagMemberCount", id, getTagMemberCountQueryParams)
+	if m.GetTagMemberCountFunc == nil {
+		panic("dnacmock: TagAPI.GetTagMemberCount called but GetTagMemberCountFunc is not set")
+	}
+	return m.GetTagMemberCountFunc(id, getTagMemberCountQueryParams)
+}
+
+// GetTagMemberCountWithContext calls GetTagMemberCountWithContextFunc.
+func (m *TagAPI) GetTagMemberCountWithContext(ctx context.Context, id string, getTagMemberCountQueryParams *dnac.GetTagMemberCountQueryParams) (*dnac.GetTagMemberCountResponse, *resty.Response, error) {
+	m.record("GetTagMemberCountWithContext", ctx, id, getTagMemberCountQueryParams)
+	if m.GetTagMemberCountWithContextFunc == nil {
+		panic("dnacmock: TagAPI.GetTagMemberCountWithContext called but GetTagMemberCountWithContextFunc is not set")
+	}
+	return m.GetTagMemberCountWithContextFunc(ctx, id, getTagMemberCountQueryParams)
+}
+
+// GetTagMembersByID calls GetTagMembersByIDFunc.
+func (m *TagAPI) GetTagMembersByID(id string, getTagMembersByIDQueryParams *dnac.GetTagMembersByIDQueryParams) (*dnac.GetTagMembersByIDResponse, *resty.Response, error) {
+	m.record("GetTagMembersByID", id, getTagMembersByIDQueryParams)
+	if m.GetTagMembersByIDFunc == nil {
+		panic("dnacmock: TagAPI.GetTagMembersByID called but GetTagMembersByIDFunc is not set")
+	}
+	return m.GetTagMembersByIDFunc(id, getTagMembersByIDQueryParams)
+}
+
+// GetTagMembersByIDWithContext calls GetTagMembersByIDWithContextFunc.
+func (m *TagAPI) GetTagMembersByIDWithContext(ctx context.Context, id string, getTagMembersByIDQueryParams *dnac.GetTagMembersByIDQueryParams) (*dnac.GetTagMembersByIDResponse, *resty.Response, error) {
+	m.record("GetTagMembersByIDWithContext", ctx, id, getTagMembersByIDQueryParams)
+	if m.GetTagMembersByIDWithContextFunc == nil {
+		panic("dnacmock: TagAPI.GetTagMembersByIDWithContext called but GetTagMembersByIDWithContextFunc is not set")
+	}
+	return m.GetTagMembersByIDWithContextFunc(ctx, id, getTagMembersByIDQueryParams)
+}
+
+// GetTagResourceTypes calls GetTagResourceTypesFunc.
+func (m *TagAPI) GetTagResourceTypes() (*dnac.GetTagResourceTypesResponse, *resty.Response, error) {
+	m.record("GetTagResourceTypes")
+	if m.GetTagResourceTypesFunc == nil {
+		panic("dnacmock: TagAPI.GetTagResourceTypes called but GetTagResourceTypesFunc is not set")
+	}
+	return m.GetTagResourceTypesFunc()
+}
+
+// GetTagResourceTypesWithContext calls GetTagResourceTypesWithContextFunc.
+func (m *TagAPI) GetTagResourceTypesWithContext(ctx context.Context) (*dnac.GetTagResourceTypesResponse, *resty.Response, error) {
+	m.record("GetTagResourceTypesWithContext", ctx)
+	if m.GetTagResourceTypesWithContextFunc == nil {
+		panic("dnacmock: TagAPI.GetTagResourceTypesWithContext called but GetTagResourceTypesWithContextFunc is not set")
+	}
+	return m.GetTagResourceTypesWithContextFunc(ctx)
+}
+
+// GetTagWithContext calls GetTagWithContextFunc.
+func (m *TagAPI) GetTagWithContext(ctx context.Context, getTagQueryParams *dnac.GetTagQueryParams) (*dnac.GetTagResponse, *resty.Response, error) {
+	m.record("GetTagWithContext", ctx, getTagQueryParams)
+	if m.GetTagWithContextFunc == nil {
+		panic("dnacmock: TagAPI.GetTagWithContext called but GetTagWithContextFunc is not set")
+	}
+	return m.GetTagWithContextFunc(ctx, getTagQueryParams)
+}
+
+// ListAll calls ListAllFunc.
+func (m *TagAPI) ListAll(ctx context.Context, params *dnac.GetTagQueryParams, opts *dnac.PageOptions) *dnac.TagIterator {
+	m.record("ListAll", ctx, params, opts)
+	if m.ListAllFunc == nil {
+		panic("dnacmock: TagAPI.ListAll called but ListAllFunc is not set")
+	}
+	return m.ListAllFunc(ctx, params, opts)
+}
+
+// ListAllMembers calls ListAllMembersFunc.
+func (m *TagAPI) ListAllMembers(ctx context.Context, id string, params *dnac.GetTagMembersByIDQueryParams, opts *dnac.PageOptions) *dnac.TagMemberIterator {
+	m.record("ListAllMembers", ctx, id, params, opts)
+	if m.ListAllMembersFunc == nil {
+		panic("dnacmock: TagAPI.ListAllMembers called but ListAllMembersFunc is not set")
+	}
+	return m.ListAllMembersFunc(ctx, id, params, opts)
+}
+
+// RemoveTagMember calls RemoveTagMemberFunc.
+func (m *TagAPI) RemoveTagMember(id string, memberID string) (*dnac.RemoveTagMemberResponse, *resty.Response, error) {
+	m.record("RemoveTagMember", id, memberID)
+	if m.RemoveTagMemberFunc == nil {
+		panic("dnacmock: TagAPI.RemoveTagMember called but RemoveTagMemberFunc is not set")
+	}
+	return m.RemoveTagMemberFunc(id, memberID)
+}
+
+// RemoveTagMemberWithContext calls RemoveTagMemberWithContextFunc.
+func (m *TagAPI) RemoveTagMemberWithContext(ctx context.Context, id string, memberID string) (*dnac.RemoveTagMemberResponse, *resty.Response, error) {
+	m.record("RemoveTagMemberWithContext", ctx, id, memberID)
+	if m.RemoveTagMemberWithContextFunc == nil {
+		panic("dnacmock: TagAPI.RemoveTagMemberWithContext called but RemoveTagMemberWithContextFunc is not set")
+	}
+	return m.RemoveTagMemberWithContextFunc(ctx, id, memberID)
+}
+
+// SetTagMembers calls SetTagMembersFunc.
+func (m *TagAPI) SetTagMembers(ctx context.Context, tagID string, memberType string, memberIDs []string, opts *dnac.TagMembershipOptions) (*dnac.TagMembershipReport, error) {
+	m.record("SetTagMembers", ctx, tagID, memberType, memberIDs, opts)
+	if m.SetTagMembersFunc == nil {
+		panic("dnacmock: TagAPI.SetTagMembers called but SetTagMembersFunc is not set")
+	}
+	return m.SetTagMembersFunc(ctx, tagID, memberType, memberIDs, opts)
+}
+
+// UpdateTag calls UpdateTagFunc.
+func (m *TagAPI) UpdateTag(updateTagRequest *dnac.UpdateTagRequest) (*dnac.UpdateTagResponse, *resty.Response, error) {
+	m.record("UpdateTag", updateTagRequest)
+	if m.UpdateTagFunc == nil {
+		panic("dnacmock: TagAPI.UpdateTag called but UpdateTagFunc is not set")
+	}
+	return m.UpdateTagFunc(updateTagRequest)
+}
+
+// UpdateTagWithContext calls UpdateTagWithContextFunc.
+func (m *TagAPI) UpdateTagWithContext(ctx context.Context, updateTagRequest *dnac.UpdateTagRequest) (*dnac.UpdateTagResponse, *resty.Response, error) {
+	m.record("UpdateTagWithContext", ctx, updateTagRequest)
+	if m.UpdateTagWithContextFunc == nil {
+		panic("dnacmock: TagAPI.UpdateTagWithContext called but UpdateTagWithContextFunc is not set")
+	}
+	return m.UpdateTagWithContextFunc(ctx, updateTagRequest)
+}
+
+// UpdatesTagMembership calls UpdatesTagMembershipFunc.
+func (m *TagAPI) UpdatesTagMembership(updatesTagMembershipRequest *dnac.UpdatesTagMembershipRequest) (*dnac.UpdatesTagMembershipResponse, *resty.Response, error) {
+	m.record("UpdatesTagMembership", updatesTagMembershipRequest)
+	if m.UpdatesTagMembershipFunc == nil {
+		panic("dnacmock: TagAPI.UpdatesTagMembership called but UpdatesTagMembershipFunc is not set")
+	}
+	return m.UpdatesTagMembershipFunc(updatesTagMembershipRequest)
+}
+
+// UpdatesTagMembershipWithContext calls UpdatesTagMembershipWithContextFunc.
+func (m *TagAPI) UpdatesTagMembershipWithContext(ctx context.Context, updatesTagMembershipRequest *dnac.UpdatesTagMembershipRequest) (*dnac.UpdatesTagMembershipResponse, *resty.Response, error) {
+	m.record("UpdatesTagMembershipWithContext", ctx, updatesTagMembershipRequest)
+	if m.UpdatesTagMembershipWithContextFunc == nil {
+		panic("dnacmock: TagAPI.UpdatesTagMembershipWithContext called but UpdatesTagMembershipWithContextFunc is not set")
+	}
+	return m.UpdatesTagMembershipWithContextFunc(ctx, updatesTagMembershipRequest)
+}
+
+// ApplicationPolicyAPI is a mock of dnac.ApplicationPolicyAPI. Each method calls the function of the
+// same name suffixed with Func, which must be set if the method is called.
+type ApplicationPolicyAPI struct {
+	Recorder
+
+	CreateApplicationFunc                  func(createApplicationRequest *[]dnac.CreateApplicationRequest) (*dnac.CreateApplicationResponse, *resty.Response, error)
+	CreateApplicationSetFunc               func(createApplicationSetRequest *[]dnac.CreateApplicationSetRequest) (*dnac.CreateApplicationSetResponse, *resty.Response, error)
+	CreateApplicationSetWithContextFunc    func(ctx context.Context, createApplicationSetRequest *[]dnac.CreateApplicationSetRequest) (*dnac.CreateApplicationSetResponse, *resty.Response, error)
+	CreateApplicationWithContextFunc       func(ctx context.Context, createApplicationRequest *[]dnac.CreateApplicationRequest) (*dnac.CreateApplicationResponse, *resty.Response, error)
+	DeleteApplicationFunc                  func(deleteApplicationQueryParams *dnac.DeleteApplicationQueryParams) (*dnac.DeleteApplicationResponse, *resty.Response, error)
+	DeleteApplicationSetFunc               func(deleteApplicationSetQueryParams *dnac.DeleteApplicationSetQueryParams) (*dnac.DeleteApplicationSetResponse, *resty.Response, error)
+	DeleteApplicationSetWithContextFunc    func(ctx context.Context, deleteApplicationSetQueryParams *dnac.DeleteApplicationSetQueryParams) (*dnac.DeleteApplicationSetResponse, *resty.Response, error)
+	DeleteApplicationWithContextFunc       func(ctx context.Context, deleteApplicationQueryParams *dnac.DeleteApplicationQueryParams) (*dnac.DeleteApplicationResponse, *resty.Response, error)
+	EditApplicationFunc                    func(editApplicationRequest *[]dnac.EditApplicationRequest) (*dnac.EditApplicationResponse, *resty.Response, error)
+	EditApplicationWithContextFunc         func(ctx context.Context, editApplicationRequest *[]dnac.EditApplicationRequest) (*dnac.EditApplicationResponse, *resty.Response, error)
+	GetApplicationSetsFunc                 func(getApplicationSetsQueryParams *dnac.GetApplicationSetsQueryParams) (*dnac.GetApplicationSetsResponse, *resty.Response, error)
+	GetApplicationSetsCountFunc            func() (*dnac.GetApplicationSetsCountResponse, *resty.Response, error)
+	GetApplicationSetsCountWithContextFunc func(ctx context.Context) (*dnac.GetApplicationSetsCountResponse, *resty.Response, error)
+	GetApplicationSetsWithContextFunc      func(ctx context.Context, getApplicationSetsQueryParams *dnac.GetApplicationSetsQueryParams) (*dnac.GetApplicationSetsResponse, *resty.Response, error)
+	GetApplicationsFunc                    func(getApplicationsQueryParams *dnac.GetApplicationsQueryParams) (*dnac.GetApplicationsResponse, *resty.Response, error)
+	GetApplicationsCountFunc               func() (*dnac.GetApplicationsCountResponse, *resty.Response, error)
+	GetApplicationsCountWithContextFunc    func(ctx context.Context) (*dnac.GetApplicationsCountResponse, *resty.Response, error)
+	GetApplicationsWithContextFunc         func(ctx context.Context, getApplicationsQueryParams *dnac.GetApplicationsQueryParams) (*dnac.GetApplicationsResponse, *resty.Response, error)
+	ListAllApplicationsFunc                func(ctx context.Context, params *dnac.GetApplicationsQueryParams, opts *dnac.PageOptions) *dnac.ApplicationIterator
+}
+
+// CreateApplication calls CreateApplicationFunc.
+func (m *ApplicationPolicyAPI) CreateApplication(createApplicationRequest *[]dnac.CreateApplicationRequest) (*dnac.CreateApplicationResponse, *resty.Response, error) {
+	m.record("CreateApplication", createApplicationRequest)
+	if m.CreateApplicationFunc == nil {
+		panic("dnacmock: ApplicationPolicyAPI.CreateApplication called but CreateApplicationFunc is not set")
+	}
+	return m.CreateApplicationFunc(createApplicationRequest)
+}
+
+// CreateApplicationSet calls CreateApplicationSetFunc.
+func (m *ApplicationPolicyAPI) CreateApplicationSet(createApplicationSetRequest *[]dnac.CreateApplicationSetRequest) (*dnac.CreateApplicationSetResponse, *resty.Response, error) {
+	m.record("CreateApplicationSet", createApplicationSetRequest)
+	if m.CreateApplicationSetFunc == nil {
+		panic("dnacmock: ApplicationPolicyAPI.CreateApplicationSet called but CreateApplicationSetFunc is not set")
+	}
+	return m.CreateApplicationSetFunc(createApplicationSetRequest)
+}
+
+// CreateApplicationSetWithContext calls CreateApplicationSetWithContextFunc.
+func (m *ApplicationPolicyAPI) CreateApplicationSetWithContext(ctx context.Context, createApplicationSetRequest *[]dnac.CreateApplicationSetRequest) (*dnac.CreateApplicationSetResponse, *resty.Response, error) {
+	m.record("CreateApplicationSetWithContext", ctx, createApplicationSetRequest)
+	if m.CreateApplicationSetWithContextFunc == nil {
+		panic("dnacmock: ApplicationPolicyAPI.CreateApplicationSetWithContext called but CreateApplicationSetWithContextFunc is not set")
+	}
+	return m.CreateApplicationSetWithContextFunc(ctx, createApplicationSetRequest)
+}
+
+// CreateApplicationWithContext calls CreateApplicationWithContextFunc.
+func (m *ApplicationPolicyAPI) CreateApplicationWithContext(ctx context.Context, createApplicationRequest *[]dnac.CreateApplicationRequest) (*dnac.CreateApplicationResponse, *resty.Response, error) {
+	m.record("CreateApplicationWithContext", ctx, createApplicationRequest)
+	if m.CreateApplicationWithContextFunc == nil {
+		panic("dnacmock: ApplicationPolicyAPI.CreateApplicationWithContext called but CreateApplicationWithContextFunc is not set")
+	}
+	return m.CreateApplicationWithContextFunc(ctx, createApplicationRequest)
+}
+
+// DeleteApplication calls DeleteApplicationFunc.
+func (m *ApplicationPolicyAPI) DeleteApplication(deleteApplicationQueryParams *dnac.DeleteApplicationQueryParams) (*dnac.DeleteApplicationResponse, *resty.Response, error) {
+	m.record("DeleteApplication", deleteApplicationQueryParams)
+	if m.DeleteApplicationFunc == nil {
+		panic("dnacmock: ApplicationPolicyAPI.DeleteApplication called but DeleteApplicationFunc is not set")
+	}
+	return m.DeleteApplicationFunc(deleteApplicationQueryParams)
+}
+
+// DeleteApplicationSet calls DeleteApplicationSetFunc.
+func (m *ApplicationPolicyAPI) DeleteApplicationSet(deleteApplicationSetQueryParams *dnac.DeleteApplicationSetQueryParams) (*dnac.DeleteApplicationSetResponse, *resty.Response, error) {
+	m.record("DeleteApplicationSet", deleteApplicationSetQueryParams)
+	if m.DeleteApplicationSetFunc == nil {
+		panic("dnacmock: ApplicationPolicyAPI.DeleteApplicationSet called but DeleteApplicationSetFunc is not set")
+	}
+	return m.DeleteApplicationSetFunc(deleteApplicationSetQueryParams)
+}
+
+// DeleteApplicationSetWithContext calls DeleteApplicationSetWithContextFunc.
+func (m *ApplicationPolicyAPI) DeleteApplicationSetWithContext(ctx context.Context, deleteApplicationSetQueryParams *dnac.DeleteApplicationSetQueryParams) (*dnac.DeleteApplicationSetResponse, *resty.Response, error) {
+	m.record("DeleteApplicationSetWithContext", ctx, deleteApplicationSetQueryParams)
+	if m.DeleteApplicationSetWithContextFunc == nil {
+		panic("dnacmock: ApplicationPolicyAPI.DeleteApplicationSetWithContext called but DeleteApplicationSetWithContextFunc is not set")
+	}
+	return m.DeleteApplicationSetWithContextFunc(ctx, deleteApplicationSetQueryParams)
+}
+
+// DeleteApplicationWithContext calls DeleteApplicationWithContextFunc.
+func (m *ApplicationPolicyAPI) DeleteApplicationWithContext(ctx context.Context, deleteApplicationQueryParams *dnac.DeleteApplicationQueryParams) (*dnac.DeleteApplicationResponse, *resty.Response, error) {
+	m.record("DeleteApplicationWithContext", ctx, deleteApplicationQueryParams)
+	if m.DeleteApplicationWithContextFunc == nil {
+		panic("dnacmock: ApplicationPolicyAPI.DeleteApplicationWithContext called but DeleteApplicationWithContextFunc is not set")
+	}
+	return m.DeleteApplicationWithContextFunc(ctx, deleteApplicationQueryParams)
+}
+
+// EditApplication calls EditApplicationFunc.
+func (m *ApplicationPolicyAPI) EditApplication(editApplicationRequest *[]dnac.EditApplicationRequest) (*dnac.EditApplicationResponse, *resty.Response, error) {
+	m.record("EditApplication", editApplicationRequest)
+	if m.EditApplicationFunc == nil {
+		panic("dnacmock: ApplicationPolicyAPI.EditApplication called but EditApplicationFunc is not set")
+	}
+	return m.EditApplicationFunc(editApplicationRequest)
+}
+
+// EditApplicationWithContext calls EditApplicationWithContextFunc.
+func (m *ApplicationPolicyAPI) EditApplicationWithContext(ctx context.Context, editApplicationRequest *[]dnac.EditApplicationRequest) (*dnac.EditApplicationResponse, *resty.Response, error) {
+	m.record("EditApplicationWithContext", ctx, editApplicationRequest)
+	if m.EditApplicationWithContextFunc == nil {
+		panic("dnacmock: ApplicationPolicyAPI.EditApplicationWithContext called but EditApplicationWithContextFunc is not set")
+	}
+	return m.EditApplicationWithContextFunc(ctx, editApplicationRequest)
+}
+
+// GetApplicationSets calls GetApplicationSetsFunc.
+func (m *ApplicationPolicyAPI) GetApplicationSets(getApplicationSetsQueryParams *dnac.GetApplicationSetsQueryParams) (*dnac.GetApplicationSetsResponse, *resty.Response, error) {
+	m.record("GetApplicationSets", getApplicationSetsQueryParams)
+	if m.GetApplicationSetsFunc == nil {
+		panic("dnacmock: ApplicationPolicyAPI.GetApplicationSets called but GetApplicationSetsFunc is not set")
+	}
+	return m.GetApplicationSetsFunc(getApplicationSetsQueryParams)
+}
+
+// GetApplicationSetsCount calls GetApplicationSetsCountFunc.
+func (m *ApplicationPolicyAPI) GetApplicationSetsCount() (*dnac.GetApplicationSetsCountResponse, *resty.Response, error) {
+	m.record("GetApplicationSetsCount")
+	if m.GetApplicationSetsCountFunc == nil {
+		panic("dnacmock: ApplicationPolicyAPI.GetApplicationSetsCount called but GetApplicationSetsCountFunc is not set")
+	}
+	return m.GetApplicationSetsCountFunc()
+}
+
+// GetApplicationSetsCountWithContext calls GetApplicationSetsCountWithContextFunc.
+func (m *ApplicationPolicyAPI) GetApplicationSetsCountWithContext(ctx context.Context) (*dnac.GetApplicationSetsCountResponse, *resty.Response, error) {
+	m.record("GetApplicationSetsCountWithContext", ctx)
+	if m.GetApplicationSetsCountWithContextFunc == nil {
+		panic("dnacmock: ApplicationPolicyAPI.GetApplicationSetsCountWithContext called but GetApplicationSetsCountWithContextFunc is not set")
+	}
+	return m.GetApplicationSetsCountWithContextFunc(ctx)
+}
+
+// GetApplicationSetsWithContext calls GetApplicationSetsWithContextFunc.
+func (m *ApplicationPolicyAPI) GetApplicationSetsWithContext(ctx context.Context, getApplicationSetsQueryParams *dnac.GetApplicationSetsQueryParams) (*dnac.GetApplicationSetsResponse, *resty.Response, error) {
+	m.record("GetApplicationSetsWithContext", ctx, getApplicationSetsQueryParams)
+	if m.GetApplicationSetsWithContextFunc == nil {
+		panic("dnacmock: ApplicationPolicyAPI.GetApplicationSetsWithContext called but GetApplicationSetsWithContextFunc is not set")
+	}
+	return m.GetApplicationSetsWithContextFunc(ctx, getApplicationSetsQueryParams)
+}
+
+// GetApplications calls GetApplicationsFunc.
+func (m *ApplicationPolicyAPI) GetApplications(getApplicationsQueryParams *dnac.GetApplicationsQueryParams) (*dnac.GetApplicationsResponse, *resty.Response, error) {
+	m.record("GetApplications", getApplicationsQueryParams)
+	if m.GetApplicationsFunc == nil {
+		panic("dnacmock: ApplicationPolicyAPI.GetApplications called but GetApplicationsFunc is not set")
+	}
+	return m.GetApplicationsFunc(getApplicationsQueryParams)
+}
+
+// GetApplicationsCount calls GetApplicationsCountFunc.
+func (m *ApplicationPolicyAPI) GetApplicationsCount() (*dnac.GetApplicationsCountResponse, *resty.Response, error) {
+	m.record("GetApplicationsCount")
+	if m.GetApplicationsCountFunc == nil {
+		panic("dnacmock: ApplicationPolicyAPI.GetApplicationsCount called but GetApplicationsCountFunc is not set")
+	}
+	return m.GetApplicationsCountFunc()
+}
+
+// GetApplicationsCountWithContext calls GetApplicationsCountWithContextFunc.
+func (m *ApplicationPolicyAPI) GetApplicationsCountWithContext(ctx context.Context) (*dnac.GetApplicationsCountResponse, *resty.Response, error) {
+	m.record("GetApplicationsCountWithContext", ctx)
+	if m.GetApplicationsCountWithContextFunc == nil {
+		panic("dnacmock: ApplicationPolicyAPI.GetApplicationsCountWithContext called but GetApplicationsCountWithContextFunc is not set")
+	}
+	return m.GetApplicationsCountWithContextFunc(ctx)
+}
+
+// GetApplicationsWithContext calls GetApplicationsWithContextFunc.
+func (m *ApplicationPolicyAPI) GetApplicationsWithContext(ctx context.Context, getApplicationsQueryParams *dnac.GetApplicationsQueryParams) (*dnac.GetApplicationsResponse, *resty.Response, error) {
+	m.record("GetApplicationsWithContext", ctx, getApplicationsQueryParams)
+	if m.GetApplicationsWithContextFunc == nil {
+		panic("dnacmock: ApplicationPolicyAPI.GetApplicationsWithContext called but GetApplicationsWithContextFunc is not set")
+	}
+	return m.GetApplicationsWithContextFunc(ctx, getApplicationsQueryParams)
+}
+
+// ListAllApplications calls ListAllApplicationsFunc.
+func (m *ApplicationPolicyAPI) ListAllApplications(ctx context.Context, params *dnac.GetApplicationsQueryParams, opts *dnac.PageOptions) *dnac.ApplicationIterator {
+	m.record("ListAllApplications", ctx, params, opts)
+	if m.ListAllApplicationsFunc == nil {
+		panic("dnacmock: ApplicationPolicyAPI.ListAllApplications called but ListAllApplicationsFunc is not set")
+	}
+	return m.ListAllApplicationsFunc(ctx, params, opts)
+}
+
+// ApplicationsAPI is a mock of dnac.ApplicationsAPI. Each method calls the function of the
+// same name suffixed with Func, which must be set if the method is called.
+type ApplicationsAPI struct {
+	Recorder
+
+	ApplicationsFunc            func(applicationsQueryParams *dnac.ApplicationsQueryParams) (*dnac.ApplicationsResponse, *resty.Response, error)
+	ApplicationsWithContextFunc func(ctx context.Context, applicationsQueryParams *dnac.ApplicationsQueryParams) (*dnac.ApplicationsResponse, *resty.Response, error)
+}
+
+// Applications calls ApplicationsFunc.
+func (m *ApplicationsAPI) Applications(applicationsQueryParams *dnac.ApplicationsQueryParams) (*dnac.ApplicationsResponse, *resty.Response, error) {
+	m.record("Applications", applicationsQueryParams)
+	if m.ApplicationsFunc == nil {
+		panic("dnacmock: ApplicationsAPI.Applications called but ApplicationsFunc is not set")
+	}
+	return m.ApplicationsFunc(applicationsQueryParams)
+}
+
+// ApplicationsWithContext calls ApplicationsWithContextFunc.
+func (m *ApplicationsAPI) ApplicationsWithContext(ctx context.Context, applicationsQueryParams *dnac.ApplicationsQueryParams) (*dnac.ApplicationsResponse, *resty.Response, error) {
+	m.record("ApplicationsWithContext", ctx, applicationsQueryParams)
+	if m.ApplicationsWithContextFunc == nil {
+		panic("dnacmock: ApplicationsAPI.ApplicationsWithContext called but ApplicationsWithContextFunc is not set")
+	}
+	return m.ApplicationsWithContextFunc(ctx, applicationsQueryParams)
+}
+
+// ITSMAPI is a mock of dnac.ITSMAPI. Each method calls the function of the
+// same name suffixed with Func, which must be set if the method is called.
+type ITSMAPI struct {
+	Recorder
+
+	GetFailedITSMEventsFunc               func(getFailedITSMEventsQueryParams *dnac.GetFailedITSMEventsQueryParams) (*dnac.GetFailedITSMEventsResponse, *resty.Response, error)
+	GetFailedITSMEventsWithContextFunc    func(ctx context.Context, getFailedITSMEventsQueryParams *dnac.GetFailedITSMEventsQueryParams) (*dnac.GetFailedITSMEventsResponse, *resty.Response, error)
+	RetryIntegrationEventsFunc            func(retryIntegrationEventsRequest *[]dnac.RetryIntegrationEventsRequest) (*dnac.RetryIntegrationEventsResponse, *resty.Response, error)
+	RetryIntegrationEventsWithContextFunc func(ctx context.Context, retryIntegrationEventsRequest *[]dnac.RetryIntegrationEventsRequest) (*dnac.RetryIntegrationEventsResponse, *resty.Response, error)
+}
+
+// GetFailedITSMEvents calls GetFailedITSMEventsFunc.
+func (m *ITSMAPI) GetFailedITSMEvents(getFailedITSMEventsQueryParams *dnac.GetFailedITSMEventsQueryParams) (*dnac.GetFailedITSMEventsResponse, *resty.Response, error) {
+	m.record("GetFailedITSMEvents", getFailedITSMEventsQueryParams)
+	if m.GetFailedITSMEventsFunc == nil {
+		panic("dnacmock: ITSMAPI.GetFailedITSMEvents called but GetFailedITSMEventsFunc is not set")
+	}
+	return m.GetFailedITSMEventsFunc(getFailedITSMEventsQueryParams)
+}
+
+// GetFailedITSMEventsWithContext calls GetFailedITSMEventsWithContextFunc.
+func (m *ITSMAPI) GetFailedITSMEventsWithContext(ctx context.Context, getFailedITSMEventsQueryParams *dnac.GetFailedITSMEventsQueryParams) (*dnac.GetFailedITSMEventsResponse, *resty.Response, error) {
+	m.record("GetFailedITSMEventsWithContext", ctx, getFailedITSMEventsQueryParams)
+	if m.GetFailedITSMEventsWithContextFunc == nil {
+		panic("dnacmock: ITSMAPI.GetFailedITSMEventsWithContext called but GetFailedITSMEventsWithContextFunc is not set")
+	}
+	return m.GetFailedITSMEventsWithContextFunc(ctx, getFailedITSMEventsQueryParams)
+}
+
+// RetryIntegrationEvents calls RetryIntegrationEventsFunc.
+func (m *ITSMAPI) RetryIntegrationEvents(retryIntegrationEventsRequest *[]dnac.RetryIntegrationEventsRequest) (*dnac.RetryIntegrationEventsResponse, *resty.Response, error) {
+	m.record("RetryIntegrationEvents", retryIntegrationEventsRequest)
+	if m.RetryIntegrationEventsFunc == nil {
+		panic("dnacmock: ITSMAPI.RetryIntegrationEvents called but RetryIntegrationEventsFunc is not set")
+	}
+	return m.RetryIntegrationEventsFunc(retryIntegrationEventsRequest)
+}
+
+// RetryIntegrationEventsWithContext calls RetryIntegrationEventsWithContextFunc.
+func (m *ITSMAPI) RetryIntegrationEventsWithContext(ctx context.Context, retryIntegrationEventsRequest *[]dnac.RetryIntegrationEventsRequest) (*dnac.RetryIntegrationEventsResponse, *resty.Response, error) {
+	m.record("RetryIntegrationEventsWithContext", ctx, retryIntegrationEventsRequest)
+	if m.RetryIntegrationEventsWithContextFunc == nil {
+		panic("dnacmock: ITSMAPI.RetryIntegrationEventsWithContext called but RetryIntegrationEventsWithContextFunc is not set")
+	}
+	return m.RetryIntegrationEventsWithContextFunc(ctx, retryIntegrationEventsRequest)
+}
+
+// EventManagementAPI is a mock of dnac.EventManagementAPI. Each method calls the function of the
+// same name suffixed with Func, which must be set if the method is called.
+type EventManagementAPI struct {
+	Recorder
+
+	CountOfEventSubscriptionsFunc            func(countOfEventSubscriptionsQueryParams *dnac.CountOfEventSubscriptionsQueryParams) (*dnac.CountOfEventSubscriptionsResponse, *resty.Response, error)
+	CountOfEventSubscriptionsWithContextFunc func(ctx context.Context, countOfEventSubscriptionsQueryParams *dnac.CountOfEventSubscriptionsQueryParams) (*dnac.CountOfEventSubscriptionsResponse, *resty.Response, error)
+	CountOfEventsFunc                        func(countOfEventsQueryParams *dnac.CountOfEventsQueryParams) (*dnac.CountOfEventsResponse, *resty.Response, error)
+	CountOfEventsWithContextFunc             func(ctx context.Context, countOfEventsQueryParams *dnac.CountOfEventsQueryParams) (*dnac.CountOfEventsResponse, *resty.Response, error)
+	CountOfNotificationsFunc                 func(countOfNotificationsQueryParams *dnac.CountOfNotificationsQueryParams) (*dnac.CountOfNotificationsResponse, *resty.Response, error)
+	CountOfNotificationsWithContextFunc      func(ctx context.Context, countOfNotificationsQueryParams *dnac.CountOfNotificationsQueryParams) (*dnac.CountOfNotificationsResponse, *resty.Response, error)
+	CreateEventSubscriptionsFunc             func(createEventSubscriptionsRequest *[]dnac.CreateEventSubscriptionsRequest) (*dnac.CreateEventSubscriptionsResponse, *resty.Response, error)
+	CreateEventSubscriptionsWithContextFunc  func(ctx context.Context, createEventSubscriptionsRequest *[]dnac.CreateEventSubscriptionsRequest) (*dnac.CreateEventSubscriptionsResponse, *resty.Response, error)
+	DeleteEventSubscriptionsFunc             func(deleteEventSubscriptionsQueryParams *dnac.DeleteEventSubscriptionsQueryParams) (*dnac.DeleteEventSubscriptionsResponse, *resty.Response, error)
+	DeleteEventSubscriptionsWithContextFunc  func(ctx context.Context, deleteEventSubscriptionsQueryParams *dnac.DeleteEventSubscriptionsQueryParams) (*dnac.DeleteEventSubscriptionsResponse, *resty.Response, error)
+	GetEventSubscriptionsFunc                func(getEventSubscriptionsQueryParams *dnac.GetEventSubscriptionsQueryParams) (*dnac.GetEventSubscriptionsResponse, *resty.Response, error)
+	GetEventSubscriptionsWithContextFunc     func(ctx context.Context, getEventSubscriptionsQueryParams *dnac.GetEventSubscriptionsQueryParams) (*dnac.GetEventSubscriptionsResponse, *resty.Response, error)
+	GetEventsFunc                            func(getEventsQueryParams *dnac.GetEventsQueryParams) (*[]dnac.GetEventsResponse, *resty.Response, error)
+	GetEventsWithContextFunc                 func(ctx context.Context, getEventsQueryParams *dnac.GetEventsQueryParams) (*[]dnac.GetEventsResponse, *resty.Response, error)
+	GetNotificationsFunc                     func(getNotificationsQueryParams *dnac.GetNotificationsQueryParams) (*dnac.GetNotificationsResponse, *resty.Response, error)
+	GetNotificationsWithContextFunc          func(ctx context.Context, getNotificationsQueryParams *dnac.GetNotificationsQueryParams) (*dnac.GetNotificationsResponse, *resty.Response, error)
+	GetStatusAPIForEventsFunc                func(executionID string) (*dnac.GetStatusAPIForEventsResponse, *resty.Response, error)
+	GetStatusAPIForEventsWithContextFunc     func(ctx context.Context, executionID string) (*dnac.GetStatusAPIForEventsResponse, *resty.Response, error)
+	ListAllEventsFunc                        func(ctx context.Context, params *dnac.GetEventsQueryParams, opts *dnac.PageOptions) *dnac.EventIterator
+	UpdateEventSubscriptionsFunc             func(updateEventSubscriptionsRequest *[]dnac.UpdateEventSubscriptionsRequest) (*dnac.UpdateEventSubscriptionsResponse, *resty.Response, error)
+	UpdateEventSubscriptionsWithContextFunc  func(ctx context.Context, updateEventSubscriptionsRequest *[]dnac.UpdateEventSubscriptionsRequest) (*dnac.UpdateEventSubscriptionsResponse, *resty.Response, error)
+}
+
+// CountOfEventSubscriptions calls CountOfEventSubscriptionsFunc.
+func (m *EventManagementAPI) CountOfEventSubscriptions(countOfEventSubscriptionsQueryParams *dnac.CountOfEventSubscriptionsQueryParams) (*dnac.CountOfEventSubscriptionsResponse, *resty.Response, error) {
+	m.record("CountOfEventSubscriptions", countOfEventSubscriptionsQueryParams)
+	if m.CountOfEventSubscriptionsFunc == nil {
+		panic("dnacmock: EventManagementAPI.CountOfEventSubscriptions called but CountOfEventSubscriptionsFunc is not set")
+	}
+	return m.CountOfEventSubscriptionsFunc(countOfEventSubscriptionsQueryParams)
+}
+
+// CountOfEventSubscriptionsWithContext calls CountOfEventSubscriptionsWithContextFunc.
+func (m *EventManagementAPI) CountOfEventSubscriptionsWithContext(ctx context.Context, countOfEventSubscriptionsQueryParams *dnac.CountOfEventSubscriptionsQueryParams) (*dnac.CountOfEventSubscriptionsResponse, *resty.Response, error) {
+	m.record("CountOfEventSubscriptionsWithContext", ctx, countOfEventSubscriptionsQueryParams)
+	if m.CountOfEventSubscriptionsWithContextFunc == nil {
+		panic("dnacmock: EventManagementAPI.CountOfEventSubscriptionsWithContext called but CountOfEventSubscriptionsWithContextFunc is not set")
+	}
+	return m.CountOfEventSubscriptionsWithContextFunc(ctx, countOfEventSubscriptionsQueryParams)
+}
+
+// CountOfEvents calls CountOfEventsFunc.
+func (m *EventManagementAPI) CountOfEvents(countOfEventsQueryParams *dnac.CountOfEventsQueryParams) (*dnac.CountOfEventsResponse, *resty.Response, error) {
+	m.record("CountOfEvents", countOfEventsQueryParams)
+	if m.CountOfEventsFunc == nil {
+		panic("dnacmock: EventManagementAPI.CountOfEvents called but CountOfEventsFunc is not set")
+	}
+	return m.CountOfEventsFunc(countOfEventsQueryParams)
+}
+
+// CountOfEventsWithContext calls CountOfEventsWithContextFunc.
+func (m *EventManagementAPI) CountOfEventsWithContext(ctx context.Context, countOfEventsQueryParams *dnac.CountOfEventsQueryParams) (*dnac.CountOfEventsResponse, *resty.Response, error) {
+	m.record("CountOfEventsWithContext", ctx, countOfEventsQueryParams)
+	if m.CountOfEventsWithContextFunc == nil {
+		panic("dnacmock: EventManagementAPI.CountOfEventsWithContext called but CountOfEventsWithContextFunc is not set")
+	}
+	return m.CountOfEventsWithContextFunc(ctx, countOfEventsQueryParams)
+}
+
+// CountOfNotifications calls CountOfNotificationsFunc.
+func (m *EventManagementAPI) CountOfNotifications(countOfNotificationsQueryParams *dnac.CountOfNotificationsQueryParams) (*dnac.CountOfNotificationsResponse, *resty.Response, error) {
+	m.record("CountOfNotifications", countOfNotificationsQueryParams)
+	if m.CountOfNotificationsFunc == nil {
+		panic("dnacmock: EventManagementAPI.CountOfNotifications called but CountOfNotificationsFunc is not set")
+	}
+	return m.CountOfNotificationsFunc(countOfNotificationsQueryParams)
+}
+
+// CountOfNotificationsWithContext calls CountOfNotificationsWithContextFunc.
+func (m *EventManagementAPI) CountOfNotificationsWithContext(ctx context.Context, countOfNotificationsQueryParams *dnac.CountOfNotificationsQueryParams) (*dnac.CountOfNotificationsResponse, *resty.Response, error) {
+	m.record("CountOfNotificationsWithContext", ctx, countOfNotificationsQueryParams)
+	if m.CountOfNotificationsWithContextFunc == nil {
+		panic("dnacmock: EventManagementAPI.CountOfNotificationsWithContext called but CountOfNotificationsWithContextFunc is not set")
+	}
+	return m.CountOfNotificationsWithContextFunc(ctx, countOfNotificationsQueryParams)
+}
+
+// CreateEventSubscriptions calls CreateEventSubscriptionsFunc.
+func (m *EventManagementAPI) CreateEventSubscriptions(createEventSubscriptionsRequest *[]dnac.CreateEventSubscriptionsRequest) (*dnac.CreateEventSubscriptionsResponse, *resty.Response, error) {
+	m.record("CreateEventSubscriptions", createEventSubscriptionsRequest)
+	if m.CreateEventSubscriptionsFunc == nil {
+		panic("dnacmock: EventManagementAPI.CreateEventSubscriptions called but CreateEventSubscriptionsFunc is not set")
+	}
+	return m.CreateEventSubscriptionsFunc(createEventSubscriptionsRequest)
+}
+
+// CreateEventSubscriptionsWithContext calls CreateEventSubscriptionsWithContextFunc.
+func (m *EventManagementAPI) CreateEventSubscriptionsWithContext(ctx context.Context, createEventSubscriptionsRequest *[]dnac.CreateEventSubscriptionsRequest) (*dnac.CreateEventSubscriptionsResponse, *resty.Response, error) {
+	m.record("CreateEventSubscriptionsWithContext", ctx, createEventSubscriptionsRequest)
+	if m.CreateEventSubscriptionsWithContextFunc == nil {
+		panic("dnacmock: EventManagementAPI.CreateEventSubscriptionsWithContext called but CreateEventSubscriptionsWithContextFunc is not set")
+	}
+	return m.CreateEventSubscriptionsWithContextFunc(ctx, createEventSubscriptionsRequest)
+}
+
+// DeleteEventSubscriptions calls DeleteEventSubscriptionsFunc.
+func (m *EventManagementAPI) DeleteEventSubscriptions(deleteEventSubscriptionsQueryParams *dnac.DeleteEventSubscriptionsQueryParams) (*dnac.DeleteEventSubscriptionsResponse, *resty.Response, error) {
+	m.record("DeleteEventSubscriptions", deleteEventSubscriptionsQueryParams)
+	if m.DeleteEventSubscriptionsFunc == nil {
+		panic("dnacmock: EventManagementAPI.DeleteEventSubscriptions called but DeleteEventSubscriptionsFunc is not set")
+	}
+	return m.DeleteEventSubscriptionsFunc(deleteEventSubscriptionsQueryParams)
+}
+
+// DeleteEventSubscriptionsWithContext calls DeleteEventSubscriptionsWithContextFunc.
+func (m *EventManagementAPI) DeleteEventSubscriptionsWithContext(ctx context.Context, deleteEventSubscriptionsQueryParams *dnac.DeleteEventSubscriptionsQueryParams) (*dnac.DeleteEventSubscriptionsResponse, *resty.Response, error) {
+	m.record("DeleteEventSubscriptionsWithContext", ctx, deleteEventSubscriptionsQueryParams)
+	if m.DeleteEventSubscriptionsWithContextFunc == nil {
+		panic("dnacmock: EventManagementAPI.DeleteEventSubscriptionsWithContext called but DeleteEventSubscriptionsWithContextFunc is not set")
+	}
+	return m.DeleteEventSubscriptionsWithContextFunc(ctx, deleteEventSubscriptionsQueryParams)
+}
+
+// GetEventSubscriptions calls GetEventSubscriptionsFunc.
+func (m *EventManagementAPI) GetEventSubscriptions(getEventSubscriptionsQueryParams *dnac.GetEventSubscriptionsQueryParams) (*dnac.GetEventSubscriptionsResponse, *resty.Response, error) {
+	m.record("GetEventSubscriptions", getEventSubscriptionsQueryParams)
+	if m.GetEventSubscriptionsFunc == nil {
+		panic("dnacmock: EventManagementAPI.GetEventSubscriptions called but GetEventSubscriptionsFunc is not set")
+	}
+	return m.GetEventSubscriptionsFunc(getEventSubscriptionsQueryParams)
+}
+
+// GetEventSubscriptionsWithContext calls GetEventSubscriptionsWithContextFunc.
+func (m *EventManagementAPI) GetEventSubscriptionsWithContext(ctx context.Context, getEventSubscriptionsQueryParams *dnac.GetEventSubscriptionsQueryParams) (*dnac.GetEventSubscriptionsResponse, *resty.Response, error) {
+	m.record("GetEventSubscriptionsWithContext", ctx, getEventSubscriptionsQueryParams)
+	if m.GetEventSubscriptionsWithContextFunc == nil {
+		panic("dnacmock: EventManagementAPI.GetEventSubscriptionsWithContext called but GetEventSubscriptionsWithContextFunc is not set")
+	}
+	return m.GetEventSubscriptionsWithContextFunc(ctx, getEventSubscriptionsQueryParams)
+}
+
+// GetEvents calls GetEventsFunc.
+func (m *EventManagementAPI) GetEvents(getEventsQueryParams *dnac.GetEventsQueryParams) (*[]dnac.GetEventsResponse, *resty.Response, error) {
+	m.record("GetEvents", getEventsQueryParams)
+	if m.GetEventsFunc == nil {
+		panic("dnacmock: EventManagementAPI.GetEvents called but GetEventsFunc is not set")
+	}
+	return m.GetEventsFunc(getEventsQueryParams)
+}
+
+// GetEventsWithContext calls GetEventsWithContextFunc.
+func (m *EventManagementAPI) GetEventsWithContext(ctx context.Context, getEventsQueryParams *dnac.GetEventsQueryParams) (*[]dnac.GetEventsResponse, *resty.Response, error) {
+	m.record("GetEventsWithContext", ctx, getEventsQueryParams)
+	if m.GetEventsWithContextFunc == nil {
+		panic("dnacmock: EventManagementAPI.GetEventsWithContext called but GetEventsWithContextFunc is not set")
+	}
+	return m.GetEventsWithContextFunc(ctx, getEventsQueryParams)
+}
+
+// GetNotifications calls GetNotificationsFunc.
+func (m *EventManagementAPI) GetNotifications(getNotificationsQueryParams *dnac.GetNotificationsQueryParams) (*dnac.GetNotificationsResponse, *resty.Response, error) {
+	m.record("GetNotifications", getNotificationsQueryParams)
+	if m.GetNotificationsFunc == nil {
+		panic("dnacmock: EventManagementAPI.GetNotifications called but GetNotificationsFunc is not set")
+	}
+	return m.GetNotificationsFunc(getNotificationsQueryParams)
+}
+
+// GetNotificationsWithContext calls GetNotificationsWithContextFunc.
+func (m *EventManagementAPI) GetNotificationsWithContext(ctx context.Context, getNotificationsQueryParams *dnac.GetNotificationsQueryParams) (*dnac.GetNotificationsResponse, *resty.Response, error) {
+	m.record("GetNotificationsWithContext", ctx, getNotificationsQueryParams)
+	if m.GetNotificationsWithContextFunc == nil {
+		panic("dnacmock: EventManagementAPI.GetNotificationsWithContext called but GetNotificationsWithContextFunc is not set")
+	}
+	return m.GetNotificationsWithContextFunc(ctx, getNotificationsQueryParams)
+}
+
+// GetStatusAPIForEvents calls GetStatusAPIForEventsFunc.
+func (m *EventManagementAPI) GetStatusAPIForEvents(executionID string) (*dnac.GetStatusAPIForEventsResponse, *resty.Response, error) {
+	m.record("GetStatusAPIForEvents", executionID)
+	if m.GetStatusAPIForEventsFunc == nil {
+		panic("dnacmock: EventManagementAPI.GetStatusAPIForEvents called but GetStatusAPIForEventsFunc is not set")
+	}
+	return m.GetStatusAPIForEventsFunc(executionID)
+}
+
+// GetStatusAPIForEventsWithContext calls GetStatusAPIForEventsWithContextFunc.
+func (m *EventManagementAPI) GetStatusAPIForEventsWithContext(ctx context.Context, executionID string) (*dnac.GetStatusAPIForEventsResponse, *resty.Response, error) {
+	m.record("GetStatusAPIForEventsWithContext", ctx, executionID)
+	if m.GetStatusAPIForEventsWithContextFunc == nil {
+		panic("dnacmock: EventManagementAPI.GetStatusAPIForEventsWithContext called but GetStatusAPIForEventsWithContextFunc is not set")
+	}
+	return m.GetStatusAPIForEventsWithContextFunc(ctx, executionID)
+}
+
+// ListAllEvents calls ListAllEventsFunc.
+func (m *EventManagementAPI) ListAllEvents(ctx context.Context, params *dnac.GetEventsQueryParams, opts *dnac.PageOptions) *dnac.EventIterator {
+	m.record("ListAllEvents", ctx, params, opts)
+	if m.ListAllEventsFunc == nil {
+		panic("dnacmock: EventManagementAPI.ListAllEvents called but ListAllEventsFunc is not set")
+	}
+	return m.ListAllEventsFunc(ctx, params, opts)
+}
+
+// UpdateEventSubscriptions calls UpdateEventSubscriptionsFunc.
+func (m *EventManagementAPI) UpdateEventSubscriptions(updateEventSubscriptionsRequest *[]dnac.UpdateEventSubscriptionsRequest) (*dnac.UpdateEventSubscriptionsResponse, *resty.Response, error) {
+	m.record("UpdateEventSubscriptions", updateEventSubscriptionsRequest)
+	if m.UpdateEventSubscriptionsFunc == nil {
+		panic("dnacmock: EventManagementAPI.UpdateEventSubscriptions called but UpdateEventSubscriptionsFunc is not set")
+	}
+	return m.UpdateEventSubscriptionsFunc(updateEventSubscriptionsRequest)
+}
+
+// UpdateEventSubscriptionsWithContext calls UpdateEventSubscriptionsWithContextFunc.
+func (m *EventManagementAPI) UpdateEventSubscriptionsWithContext(ctx context.Context, updateEventSubscriptionsRequest *[]dnac.UpdateEventSubscriptionsRequest) (*dnac.UpdateEventSubscriptionsResponse, *resty.Response, error) {
+	m.record("UpdateEventSubscriptionsWithContext", ctx, updateEventSubscriptionsRequest)
+	if m.UpdateEventSubscriptionsWithContextFunc == nil {
+		panic("dnacmock: EventManagementAPI.UpdateEventSubscriptionsWithContext called but UpdateEventSubscriptionsWithContextFunc is not set")
+	}
+	return m.UpdateEventSubscriptionsWithContextFunc(ctx, updateEventSubscriptionsRequest)
+}
+
+// DeviceReplacementAPI is a mock of dnac.DeviceReplacementAPI. Each method calls the function of the
+// same name suffixed with Func, which must be set if the method is called.
+type DeviceReplacementAPI struct {
+	Recorder
+
+	DeployDeviceReplacementWorkflowFunc                                 func(deployDeviceReplacementWorkflowRequest *dnac.DeployDeviceReplacementWorkflowRequest) (*dnac.DeployDeviceReplacementWorkflowResponse, *resty.Response, error)
+	DeployDeviceReplacementWorkflowWithContextFunc                      func(ctx context.Context, deployDeviceReplacementWorkflowRequest *dnac.DeployDeviceReplacementWorkflowRequest) (*dnac.DeployDeviceReplacementWorkflowResponse, *resty.Response, error)
+	MarkDeviceForReplacementFunc                                        func(markDeviceForReplacementRequest *[]dnac.MarkDeviceForReplacementRequest) (*dnac.MarkDeviceForReplacementResponse, *resty.Response, error)
+	MarkDeviceForReplacementWithContextFunc                             func(ctx context.Context, markDeviceForReplacementRequest *[]dnac.MarkDeviceForReplacementRequest) (*dnac.MarkDeviceForReplacementResponse, *resty.Response, error)
+	ReturnListOfReplacementDevicesWithReplacementDetailsFunc            func(returnListOfReplacementDevicesWithReplacementDetailsQueryParams *dnac.ReturnListOfReplacementDevicesWithReplacementDetailsQueryParams) (*dnac.ReturnListOfReplacementDevicesWithReplacementDetailsResponse, *resty.Response, error)
+	ReturnListOfReplacementDevicesWithReplacementDetailsWithContextFunc func(ctx context.Context, returnListOfReplacementDevicesWithReplacementDetailsQueryParams *dnac.ReturnListOfReplacementDevicesWithReplacementDetailsQueryParams) (*dnac.ReturnListOfReplacementDevicesWithReplacementDetailsResponse, *resty.Response, error)
+	ReturnReplacementDevicesCountFunc                                   func(returnReplacementDevicesCountQueryParams *dnac.ReturnReplacementDevicesCountQueryParams) (*dnac.ReturnReplacementDevicesCountResponse, *resty.Response, error)
+	ReturnReplacementDevicesCountWithContextFunc                        func(ctx context.Context, returnReplacementDevicesCountQueryParams *dnac.ReturnReplacementDevicesCountQueryParams) (*dnac.ReturnReplacementDevicesCountResponse, *resty.Response, error)
+	UnMarkDeviceForReplacementFunc                                      func(unMarkDeviceForReplacementRequest *[]dnac.UnMarkDeviceForReplacementRequest) (*dnac.UnMarkDeviceForReplacementResponse, *resty.Response, error)
+	UnMarkDeviceForReplacementWithContextFunc                           func(ctx context.Context, unMarkDeviceForReplacementRequest *[]dnac.UnMarkDeviceForReplacementRequest) (*dnac.UnMarkDeviceForReplacementResponse, *resty.Response, error)
+}
+
+// DeployDeviceReplacementWorkflow calls DeployDeviceReplacementWorkflowFunc.
+func (m *DeviceReplacementAPI) DeployDeviceReplacementWorkflow(deployDeviceReplacementWorkflowRequest *dnac.DeployDeviceReplacementWorkflowRequest) (*dnac.DeployDeviceReplacementWorkflowResponse, *resty.Response, error) {
+	m.record("DeployDeviceReplacementWorkflow", deployDeviceReplacementWorkflowRequest)
+	if m.DeployDeviceReplacementWorkflowFunc == nil {
+		panic("dnacmock: DeviceReplacementAPI.DeployDeviceReplacementWorkflow called but DeployDeviceReplacementWorkflowFunc is not set")
+	}
+	return m.DeployDeviceReplacementWorkflowFunc(deployDeviceReplacementWorkflowRequest)
+}
+
+// DeployDeviceReplacementWorkflowWithContext calls DeployDeviceReplacementWorkflowWithContextFunc.
+func (m *DeviceReplacementAPI) DeployDeviceReplacementWorkflowWithContext(ctx context.Context, deployDeviceReplacementWorkflowRequest *dnac.DeployDeviceReplacementWorkflowRequest) (*dnac.DeployDeviceReplacementWorkflowResponse, *resty.Response, error) {
+	m.record("DeployDeviceReplacementWorkflowWithContext", ctx, deployDeviceReplacementWorkflowRequest)
+	if m.DeployDeviceReplacementWorkflowWithContextFunc == nil {
+		panic("dnacmock: DeviceReplacementAPI.DeployDeviceReplacementWorkflowWithContext called but DeployDeviceReplacementWorkflowWithContextFunc is not set")
+	}
+	return m.DeployDeviceReplacementWorkflowWithContextFunc(ctx, deployDeviceReplacementWorkflowRequest)
+}
+
+// MarkDeviceForReplacement calls MarkDeviceForReplacementFunc.
+func (m *DeviceReplacementAPI) MarkDeviceForReplacement(markDeviceForReplacementRequest *[]dnac.MarkDeviceForReplacementRequest) (*dnac.MarkDeviceForReplacementResponse, *resty.Response, error) {
+	m.record("MarkDeviceForReplacement", markDeviceForReplacementRequest)
+	if m.MarkDeviceForReplacementFunc == nil {
+		panic("dnacmock: DeviceReplacementAPI.MarkDeviceForReplacement called but MarkDeviceForReplacementFunc is not set")
+	}
+	return m.MarkDeviceForReplacementFunc(markDeviceForReplacementRequest)
+}
+
+// MarkDeviceForReplacementWithContext calls MarkDeviceForReplacementWithContextFunc.
+func (m *DeviceReplacementAPI) MarkDeviceForReplacementWithContext(ctx context.Context, markDeviceForReplacementRequest *[]dnac.MarkDeviceForReplacementRequest) (*dnac.MarkDeviceForReplacementResponse, *resty.Response, error) {
+	m.record("MarkDeviceForReplacementWithContext", ctx, markDeviceForReplacementRequest)
+	if m.MarkDeviceForReplacementWithContextFunc == nil {
+		panic("dnacmock: DeviceReplacementAPI.MarkDeviceForReplacementWithContext called but MarkDeviceForReplacementWithContextFunc is not set")
+	}
+	return m.MarkDeviceForReplacementWithContextFunc(ctx, markDeviceForReplacementRequest)
+}
+
+// ReturnListOfReplacementDevicesWithReplacementDetails calls ReturnListOfReplacementDevicesWithReplacementDetailsFunc.
+func (m *DeviceReplacementAPI) ReturnListOfReplacementDevicesWithReplacementDetails(returnListOfReplacementDevicesWithReplacementDetailsQueryParams *dnac.ReturnListOfReplacementDevicesWithReplacementDetailsQueryParams) (*dnac.ReturnListOfReplacementDevicesWithReplacementDetailsResponse, *resty.Response, error) {
+	m.record("ReturnListOfReplacementDevicesWithReplacementDetails", returnListOfReplacementDevicesWithReplacementDetailsQueryParams)
+	if m.ReturnListOfReplacementDevicesWithReplacementDetailsFunc == nil {
+		panic("dnacmock: DeviceReplacementAPI.ReturnListOfReplacementDevicesWithReplacementDetails called but ReturnListOfReplacementDevicesWithReplacementDetailsFunc is not set")
+	}
+	return m.ReturnListOfReplacementDevicesWithReplacementDetailsFunc(returnListOfReplacementDevicesWithReplacementDetailsQueryParams)
+}
+
+// ReturnListOfReplacementDevicesWithReplacementDetailsWithContext calls ReturnListOfReplacementDevicesWithReplacementDetailsWithContextFunc.
+func (m *DeviceReplacementAPI) ReturnListOfReplacementDevicesWithReplacementDetailsWithContext(ctx context.Context, returnListOfReplacementDevicesWithReplacementDetailsQueryParams *dnac.ReturnListOfReplacementDevicesWithReplacementDetailsQueryParams) (*dnac.ReturnListOfReplacementDevicesWithReplacementDetailsResponse, *resty.Response, error) {
+	m.record("ReturnListOfReplacementDevicesWithReplacementDetailsWithContext", ctx, returnListOfReplacementDevicesWithReplacementDetailsQueryParams)
+	if m.ReturnListOfReplacementDevicesWithReplacementDetailsWithContextFunc == nil {
+		panic("dnacmock: DeviceReplacementAPI.ReturnListOfReplacementDevicesWithReplacementDetailsWithContext called but ReturnListOfReplacementDevicesWithReplacementDetailsWithContextFunc is not set")
+	}
+	return m.ReturnListOfReplacementDevicesWithReplacementDetailsWithContextFunc(ctx, returnListOfReplacementDevicesWithReplacementDetailsQueryParams)
+}
+
+// ReturnReplacementDevicesCount calls ReturnReplacementDevicesCountFunc.
+func (m *DeviceReplacementAPI) ReturnReplacementDevicesCount(returnReplacementDevicesCountQueryParams *dnac.ReturnReplacementDevicesCountQueryParams) (*dnac.ReturnReplacementDevicesCountResponse, *resty.Response, error) {
+	m.record("ReturnReplacementDevicesCount", returnReplacementDevicesCountQueryParams)
+	if m.ReturnReplacementDevicesCountFunc == nil {
+		panic("dnacmock: DeviceReplacementAPI.ReturnReplacementDevicesCount called but ReturnReplacementDevicesCountFunc is not set")
+	}
+	return m.ReturnReplacementDevicesCountFunc(returnReplacementDevicesCountQueryParams)
+}
+
+// ReturnReplacementDevicesCountWithContext calls ReturnReplacementDevicesCountWithContextFunc.
+func (m *DeviceReplacementAPI) ReturnReplacementDevicesCountWithContext(ctx context.Context, returnReplacementDevicesCountQueryParams *dnac.ReturnReplacementDevicesCountQueryParams) (*dnac.ReturnReplacementDevicesCountResponse, *resty.Response, error) {
+	m.record("ReturnReplacementDevicesCountWithContext", ctx, returnReplacementDevicesCountQueryParams)
+	if m.ReturnReplacementDevicesCountWithContextFunc == nil {
+		panic("dnacmock: DeviceReplacementAPI.ReturnReplacementDevicesCountWithContext called but ReturnReplacementDevicesCountWithContextFunc is not set")
+	}
+	return m.ReturnReplacementDevicesCountWithContextFunc(ctx, returnReplacementDevicesCountQueryParams)
+}
+
+// UnMarkDeviceForReplacement calls UnMarkDeviceForReplacementFunc.
+func (m *DeviceReplacementAPI) UnMarkDeviceForReplacement(unMarkDeviceForReplacementRequest *[]dnac.UnMarkDeviceForReplacementRequest) (*dnac.UnMarkDeviceForReplacementResponse, *resty.Response, error) {
+	m.record("UnMarkDeviceForReplacement", unMarkDeviceForReplacementRequest)
+	if m.UnMarkDeviceForReplacementFunc == nil {
+		panic("dnacmock: DeviceReplacementAPI.UnMarkDeviceForReplacement called but UnMarkDeviceForReplacementFunc is not set")
+	}
+	return m.UnMarkDeviceForReplacementFunc(unMarkDeviceForReplacementRequest)
+}
+
+// UnMarkDeviceForReplacementWithContext calls UnMarkDeviceForReplacementWithContextFunc.
+func (m *DeviceReplacementAPI) UnMarkDeviceForReplacementWithContext(ctx context.Context, unMarkDeviceForReplacementRequest *[]dnac.UnMarkDeviceForReplacementRequest) (*dnac.UnMarkDeviceForReplacementResponse, *resty.Response, error) {
+	m.record("UnMarkDeviceForReplacementWithContext", ctx, unMarkDeviceForReplacementRequest)
+	if m.UnMarkDeviceForReplacementWithContextFunc == nil {
+		panic("dnacmock: DeviceReplacementAPI.UnMarkDeviceForReplacementWithContext called but UnMarkDeviceForReplacementWithContextFunc is not set")
+	}
+	return m.UnMarkDeviceForReplacementWithContextFunc(ctx, unMarkDeviceForReplacementRequest)
+}
+
+var (
+	_ dnac.AuthenticationAPI              = (*AuthenticationAPI)(nil)
+	_ dnac.SitesAPI                       = (*SitesAPI)(nil)
+	_ dnac.TopologyAPI                    = (*TopologyAPI)(nil)
+	_ dnac.DevicesAPI                     = (*DevicesAPI)(nil)
+	_ dnac.ClientsAPI                     = (*ClientsAPI)(nil)
+	_ dnac.UsersAPI                       = (*UsersAPI)(nil)
+	_ dnac.IssuesAPI                      = (*IssuesAPI)(nil)
+	_ dnac.SiteDesignAPI                  = (*SiteDesignAPI)(nil)
+	_ dnac.NetworkSettingsAPI             = (*NetworkSettingsAPI)(nil)
+	_ dnac.SoftwareImageManagementSWIMAPI = (*SoftwareImageManagementSWIMAPI)(nil)
+	_ dnac.DeviceOnboardingPnPAPI         = (*DeviceOnboardingPnPAPI)(nil)
+	_ dnac.ConfigurationTemplatesAPI      = (*ConfigurationTemplatesAPI)(nil)
+	_ dnac.ConfigurationArchiveAPI        = (*ConfigurationArchiveAPI)(nil)
+	_ dnac.SDAAPI                         = (*SDAAPI)(nil)
+	_ dnac.SensorsAPI                     = (*SensorsAPI)(nil)
+	_ dnac.WirelessAPI                    = (*WirelessAPI)(nil)
+	_ dnac.CommandRunnerAPI               = (*CommandRunnerAPI)(nil)
+	_ dnac.DiscoveryAPI                   = (*DiscoveryAPI)(nil)
+	_ dnac.PathTraceAPI                   = (*PathTraceAPI)(nil)
+	_ dnac.FileAPI                        = (*FileAPI)(nil)
+	_ dnac.TaskAPI                        = (*TaskAPI)(nil)
+	_ dnac.TagAPI                         = (*TagAPI)(nil)
+	_ dnac.ApplicationPolicyAPI           = (*ApplicationPolicyAPI)(nil)
+	_ dnac.ApplicationsAPI                = (*ApplicationsAPI)(nil)
+	_ dnac.ITSMAPI                        = (*ITSMAPI)(nil)
+	_ dnac.EventManagementAPI             = (*EventManagementAPI)(nil)
+	_ dnac.DeviceReplacementAPI           = (*DeviceReplacementAPI)(nil)
+)
+
+// API holds a mock of every service.
+type API struct {
+	Authentication              *AuthenticationAPI
+	Sites                       *SitesAPI
+	Topology                    *TopologyAPI
+	Devices                     *DevicesAPI
+	Clients                     *ClientsAPI
+	Users                       *UsersAPI
+	Issues                      *IssuesAPI
+	SiteDesign                  *SiteDesignAPI
+	NetworkSettings             *NetworkSettingsAPI
+	SoftwareImageManagementSWIM *SoftwareImageManagementSWIMAPI
+	DeviceOnboardingPnP         *DeviceOnboardingPnPAPI
+	ConfigurationTemplates      *ConfigurationTemplatesAPI
+	ConfigurationArchive        *ConfigurationArchiveAPI
+	SDA                         *SDAAPI
+	Sensors                     *SensorsAPI
+	Wireless                    *WirelessAPI
+	CommandRunner               *CommandRunnerAPI
+	Discovery                   *DiscoveryAPI
+	PathTrace                   *PathTraceAPI
+	File                        *FileAPI
+	Task                        *TaskAPI
+	Tag                         *TagAPI
+	ApplicationPolicy           *ApplicationPolicyAPI
+	Applications                *ApplicationsAPI
+	ITSM                        *ITSMAPI
+	EventManagement             *EventManagementAPI
+	DeviceReplacement           *DeviceReplacementAPI
+}
+
+// NewAPI returns mocks of every service with no function set.
+func NewAPI() *API {
+	return &API{
+		Authentication:              &AuthenticationAPI{},
+		Sites:                       &SitesAPI{},
+		Topology:                    &TopologyAPI{},
+		Devices:                     &DevicesAPI{},
+		Clients:                     &ClientsAPI{},
+		Users:                       &UsersAPI{},
+		Issues:                      &IssuesAPI{},
+		SiteDesign:                  &SiteDesignAPI{},
+		NetworkSettings:             &NetworkSettingsAPI{},
+		SoftwareImageManagementSWIM: &SoftwareImageManagementSWIMAPI{},
+		DeviceOnboardingPnP:         &DeviceOnboardingPnPAPI{},
+		ConfigurationTemplates:      &ConfigurationTemplatesAPI{},
+		ConfigurationArchive:        &ConfigurationArchiveAPI{},
+		SDA:                         &SDAAPI{},
+		Sensors:                     &SensorsAPI{},
+		Wireless:                    &WirelessAPI{},
+		CommandRunner:               &CommandRunnerAPI{},
+		Discovery:                   &DiscoveryAPI{},
+		PathTrace:                   &PathTraceAPI{},
+		File:                        &FileAPI{},
+		Task:                        &TaskAPI{},
+		Tag:                         &TagAPI{},
+		ApplicationPolicy:           &ApplicationPolicyAPI{},
+		Applications:                &ApplicationsAPI{},
+		ITSM:                        &ITSMAPI{},
+		EventManagement:             &EventManagementAPI{},
+		DeviceReplacement:           &DeviceReplacementAPI{},
+	}
+}
+
+// API returns the mocks as a dnac.API.
+func (m *API) API() *dnac.API {
+	return &dnac.API{
+		Authentication:              m.Authentication,
+		Sites:                       m.Sites,
+		Topology:                    m.Topology,
+		Devices:                     m.Devices,
+		Clients:                     m.Clients,
+		Users:                       m.Users,
+		Issues:                      m.Issues,
+		SiteDesign:                  m.SiteDesign,
+		NetworkSettings:             m.NetworkSettings,
+		SoftwareImageManagementSWIM: m.SoftwareImageManagementSWIM,
+		DeviceOnboardingPnP:         m.DeviceOnboardingPnP,
+		ConfigurationTemplates:      m.ConfigurationTemplates,
+		ConfigurationArchive:        m.ConfigurationArchive,
+		SDA:                         m.SDA,
+		Sensors:                     m.Sensors,
+		Wireless:                    m.Wireless,
+		CommandRunner:               m.CommandRunner,
+		Discovery:                   m.Discovery,
+		PathTrace:                   m.PathTrace,
+		File:                        m.File,
+		Task:                        m.Task,
+		Tag:                         m.Tag,
+		ApplicationPolicy:           m.ApplicationPolicy,
+		Applications:                m.Applications,
+		ITSM:                        m.ITSM,
+		EventManagement:             m.EventManagement,
+		DeviceReplacement:           m.DeviceReplacement,
+	}
+}
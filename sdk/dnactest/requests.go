@@ -0,0 +1,153 @@
+package dnactest
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+// Request is a request received by the Server.
+type Request struct {
+	Method string
+	Path   string
+	Query  url.Values
+	Header http.Header
+	Body   []byte
+	Params map[string]string // Path parameters matched by the handler pattern
+}
+
+func newRequest(r *http.Request) (*Request, error) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	r.Body.Close()
+	r.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return &Request{
+		Method: r.Method,
+		Path:   r.URL.Path,
+		Query:  r.URL.Query(),
+		Header: r.Header.Clone(),
+		Body:   body,
+	}, nil
+}
+
+// DecodeJSON decodes the JSON body of the request into v.
+func (r *Request) DecodeJSON(v interface{}) error {
+	return json.Unmarshal(r.Body, v)
+}
+
+// Requests returns all the requests received so far, in order, including
+// the ones to the token and task endpoints.
+func (s *Server) Requests() []*Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*Request(nil), s.requests...)
+}
+
+// RequestsTo returns the requests with the given method whose path matches
+// pattern, with {name} segments matching any single segment. An empty
+// method matches any method.
+func (s *Server) RequestsTo(method string, pattern string) []*Request {
+	var matched []*Request
+	for _, r := range s.Requests() {
+		if method != "" && r.Method != method {
+			continue
+		}
+		if _, ok := matchPath(pattern, r.Path); ok {
+			matched = append(matched, r)
+		}
+	}
+	return matched
+}
+
+// LastRequestTo returns the last request matching method and pattern, as
+// RequestsTo does, or nil if there is none.
+func (s *Server) LastRequestTo(method string, pattern string) *Request {
+	requests := s.RequestsTo(method, pattern)
+	if len(requests) == 0 {
+		return nil
+	}
+	return requests[len(requests)-1]
+}
+
+// Unmatched returns the requests no handler was registered for.
+func (s *Server) Unmatched() []*Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*Request(nil), s.unmatched...)
+}
+
+// Reset forgets the recorded requests. Handlers and tasks are kept.
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = nil
+	s.unmatched = nil
+}
+
+// AssertCalled fails the test unless a request matching method and pattern
+// was received.
+func (s *Server) AssertCalled(t testing.TB, method string, pattern string) {
+	t.Helper()
+	if len(s.RequestsTo(method, pattern)) == 0 {
+		t.Errorf("dnactest: expected a request to %s %s, got none", method, pattern)
+	}
+}
+
+// AssertNotCalled fails the test if a request matching method and pattern
+// was received.
+func (s *Server) AssertNotCalled(t testing.TB, method string, pattern string) {
+	t.Helper()
+	if n := len(s.RequestsTo(method, pattern)); n > 0 {
+		t.Errorf("dnactest: expected no request to %s %s, got %d", method, pattern, n)
+	}
+}
+
+// AssertCallCount fails the test unless exactly n requests matching method
+// and pattern were received.
+func (s *Server) AssertCallCount(t testing.TB, method string, pattern string, n int) {
+	t.Helper()
+	if got := len(s.RequestsTo(method, pattern)); got != n {
+		t.Errorf("dnactest: expected %d requests to %s %s, got %d", n, method, pattern, got)
+	}
+}
+
+// AssertJSONBody fails the test unless the last request matching method and
+// pattern has a JSON body equal to want once both are encoded as JSON.
+func (s *Server) AssertJSONBody(t testing.TB, method string, pattern string, want interface{}) {
+	t.Helper()
+	r := s.LastRequestTo(method, pattern)
+	if r == nil {
+		t.Errorf("dnactest: expected a request to %s %s, got none", method, pattern)
+		return
+	}
+	var got, expected interface{}
+	if err := json.Unmarshal(r.Body, &got); err != nil {
+		t.Errorf("dnactest: body of %s %s is not JSON: %v", method, r.Path, err)
+		return
+	}
+	b, err := json.Marshal(want)
+	if err != nil {
+		t.Errorf("dnactest: cannot encode expected body: %v", err)
+		return
+	}
+	json.Unmarshal(b, &expected)
+	gotJSON, _ := json.Marshal(got)
+	expectedJSON, _ := json.Marshal(expected)
+	if string(gotJSON) != string(expectedJSON) {
+		t.Errorf("dnactest: body of %s %s\n got: %s\nwant: %s", method, r.Path, gotJSON, expectedJSON)
+	}
+}
+
+// AssertNoUnmatched fails the test if a request was received that no
+// handler was registered for.
+func (s *Server) AssertNoUnmatched(t testing.TB) {
+	t.Helper()
+	for _, r := range s.Unmatched() {
+		t.Errorf("dnactest: unexpected request %s %s", r.Method, r.Path)
+	}
+}
@@ -0,0 +1,119 @@
+package dnactest
+
+import (
+	"net/http"
+	"sync"
+)
+
+// route is a handler registered for a method and a path pattern.
+type route struct {
+	method  string
+	pattern string
+	handler http.Handler
+}
+
+// paramsKey is the context key of the path parameters of a request.
+type paramsKey struct{}
+
+// PathParam returns the path segment matched by {name} in the pattern the
+// handler serving r was registered with.
+func PathParam(r *http.Request, name string) string {
+	params, _ := r.Context().Value(paramsKey{}).(map[string]string)
+	return params[name]
+}
+
+// Handle registers handler for requests with the given method whose path
+// matches pattern. A {name} segment of the pattern matches any single
+// segment and can be read with PathParam. An empty method matches any
+// method. When several handlers match, the one registered last wins, so a
+// test can override a response midway.
+func (s *Server) Handle(method string, pattern string, handler http.Handler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.routes = append(s.routes, &route{method: method, pattern: pattern, handler: handler})
+}
+
+// HandleFunc is like Handle for a handler function.
+func (s *Server) HandleFunc(method string, pattern string, handler func(w http.ResponseWriter, r *http.Request)) {
+	s.Handle(method, pattern, http.HandlerFunc(handler))
+}
+
+// RespondJSON registers a canned response: every matching request is
+// answered with status and body encoded as JSON.
+func (s *Server) RespondJSON(method string, pattern string, status int, body interface{}) {
+	s.HandleFunc(method, pattern, func(w http.ResponseWriter, r *http.Request) {
+		WriteJSON(w, status, body)
+	})
+}
+
+// Response is one of the responses served by RespondSequence.
+type Response struct {
+	Status int         // Defaults to 200
+	Header http.Header // Extra headers, e.g. Retry-After
+	Body   interface{} // Encoded as JSON unless it is a string or []byte
+}
+
+// RespondSequence registers stateful responses: the n-th matching request
+// is answered with the n-th response, and the last response is repeated
+// once the sequence is exhausted.
+func (s *Server) RespondSequence(method string, pattern string, responses ...Response) {
+	var mu sync.Mutex
+	next := 0
+	s.HandleFunc(method, pattern, func(w http.ResponseWriter, r *http.Request) {
+		if len(responses) == 0 {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		mu.Lock()
+		response := responses[next]
+		if next < len(responses)-1 {
+			next++
+		}
+		mu.Unlock()
+
+		for key, values := range response.Header {
+			for _, value := range values {
+				w.Header().Add(key, value)
+			}
+		}
+		status := response.Status
+		if status == 0 {
+			status = http.StatusOK
+		}
+		WriteJSON(w, status, response.Body)
+	})
+}
+
+// RespondTask registers an endpoint that starts an asynchronous task: every
+// matching request creates a task from task, as AddTask does, and is
+// answered with its ID in a dnac.TaskResponse envelope.
+func (s *Server) RespondTask(method string, pattern string, status int, task Task) {
+	s.HandleFunc(method, pattern, func(w http.ResponseWriter, r *http.Request) {
+		t := task
+		t.ID = ""
+		id := s.AddTask(t)
+		WriteJSON(w, status, map[string]interface{}{
+			"response": map[string]string{
+				"taskId": id,
+				"url":    taskPath + "/" + id,
+			},
+			"version": "1.0",
+		})
+	})
+}
+
+// match returns the handler registered last for the method and path.
+func (s *Server) match(method string, path string) (http.Handler, map[string]string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := len(s.routes) - 1; i >= 0; i-- {
+		route := s.routes[i]
+		if route.method != "" && route.method != method {
+			continue
+		}
+		if params, ok := matchPath(route.pattern, path); ok {
+			return route.handler, params
+		}
+	}
+	return nil, nil
+}
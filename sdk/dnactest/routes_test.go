@@ -0,0 +1,107 @@
+package dnactest_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cisco-en-programmability/dnacenter-go-sdk/sdk/dnactest"
+	"github.com/go-resty/resty/v2"
+)
+
+func get(t *testing.T, client *resty.Client, path string) *resty.Response {
+	t.Helper()
+	response, err := client.R().Get(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return response
+}
+
+func TestRespondSequenceRepeatsLastResponse(t *testing.T) {
+	srv := dnactest.NewServer()
+	defer srv.Close()
+	srv.RespondSequence(http.MethodGet, "/items/{id}",
+		dnactest.Response{Status: http.StatusTooManyRequests, Header: http.Header{"Retry-After": {"7"}}},
+		dnactest.Response{Body: map[string]string{"id": "first"}},
+		dnactest.Response{Body: "last"},
+	)
+
+	client, err := srv.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	rc := client.RestyClient()
+	response := get(t, rc, "/items/1")
+	if response.StatusCode() != http.StatusTooManyRequests || response.Header().Get("Retry-After") != "7" {
+		t.Errorf("got status %d and Retry-After %q, want 429 and 7", response.StatusCode(), response.Header().Get("Retry-After"))
+	}
+	if got := get(t, rc, "/items/2").String(); got != `{"id":"first"}` {
+		t.Errorf("got %q, want the JSON of the second response", got)
+	}
+	for i := 0; i < 2; i++ {
+		if got := get(t, rc, "/items/3").String(); got != "last" {
+			t.Errorf("got %q, want the last response repeated", got)
+		}
+	}
+	srv.AssertCallCount(t, http.MethodGet, "/items/{id}", 4)
+}
+
+func TestRespondSequenceWithoutResponses(t *testing.T) {
+	srv := dnactest.NewServer()
+	defer srv.Close()
+	srv.RespondSequence(http.MethodDelete, "/items/{id}")
+
+	client, err := srv.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	response, err := client.RestyClient().R().Delete("/items/1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response.StatusCode() != http.StatusNoContent {
+		t.Errorf("got status %d, want 204", response.StatusCode())
+	}
+}
+
+func TestLastRegisteredHandlerWins(t *testing.T) {
+	srv := dnactest.NewServer()
+	defer srv.Close()
+	srv.RespondJSON(http.MethodGet, "/items/{id}", http.StatusOK, "any")
+	srv.HandleFunc(http.MethodGet, "/items/{id}", func(w http.ResponseWriter, r *http.Request) {
+		dnactest.WriteJSON(w, http.StatusOK, "item "+dnactest.PathParam(r, "id"))
+	})
+
+	client, err := srv.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := get(t, client.RestyClient(), "/items/42").String(); got != "item 42" {
+		t.Errorf("got %q, want the response of the last handler", got)
+	}
+	if r := srv.LastRequestTo(http.MethodGet, "/items/{id}"); r.Params["id"] != "42" {
+		t.Errorf("recorded params %v, want id 42", r.Params)
+	}
+}
+
+func TestUnmatchedRequestsAndReset(t *testing.T) {
+	srv := dnactest.NewServer()
+	defer srv.Close()
+
+	client, err := srv.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response := get(t, client.RestyClient(), "/missing"); response.StatusCode() != http.StatusNotFound {
+		t.Errorf("got status %d, want 404", response.StatusCode())
+	}
+	if unmatched := srv.Unmatched(); len(unmatched) != 1 || unmatched[0].Path != "/missing" {
+		t.Errorf("got unmatched %v, want /missing", unmatched)
+	}
+
+	srv.Reset()
+	if len(srv.Requests()) != 0 || len(srv.Unmatched()) != 0 {
+		t.Errorf("requests kept after Reset")
+	}
+	srv.AssertNoUnmatched(t)
+}
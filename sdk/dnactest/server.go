@@ -0,0 +1,223 @@
+// Package dnactest provides a fake Cisco DNA Center for hermetic tests of
+// code built on the dnac client.
+//
+// A Server serves the authentication token endpoint and the task endpoints
+// itself; every other endpoint answers with the canned or computed responses
+// registered on it. All requests are recorded and can be asserted on.
+//
+//	srv := dnactest.NewServer()
+//	defer srv.Close()
+//	srv.RespondJSON(http.MethodGet, "/dna/intent/api/v1/network-device/{id}", http.StatusOK,
+//		map[string]interface{}{"response": map[string]string{"id": "d1", "hostname": "edge-1"}})
+//	client, _ := srv.Client()
+//	device, _, err := client.Devices.GetDeviceByID("d1")
+//	srv.AssertCalled(t, http.MethodGet, "/dna/intent/api/v1/network-device/d1")
+package dnactest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	dnac "github.com/cisco-en-programmability/dnacenter-go-sdk/sdk"
+)
+
+const (
+	// DefaultUsername is the username the Server accepts unless changed.
+	DefaultUsername = "admin"
+	// DefaultPassword is the password the Server accepts unless changed.
+	DefaultPassword = "dnactest"
+
+	authTokenPath   = "/dna/system/api/v1/auth/token"
+	authTokenHeader = "X-Auth-Token"
+)
+
+// Server is a fake Cisco DNA Center served over TLS by an httptest.Server.
+// It is safe for concurrent use.
+type Server struct {
+	*httptest.Server
+
+	mu        sync.Mutex
+	username  string
+	password  string
+	token     string
+	tokens    int
+	noAuth    bool
+	routes    []*route
+	requests  []*Request
+	unmatched []*Request
+	tasks     map[string]*Task
+	taskOrder []string
+	taskSeq   int // Number of the last generated task ID
+}
+
+// NewServer starts a Server accepting DefaultUsername and DefaultPassword.
+// It must be closed with Close.
+func NewServer() *Server {
+	s := &Server{
+		username: DefaultUsername,
+		password: DefaultPassword,
+		tasks:    map[string]*Task{},
+	}
+	s.rotateToken()
+	s.Server = httptest.NewTLSServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Client returns a dnac client connected to the server and authenticated
+// with its credentials. opts are applied after the defaults and may
+// override them.
+func (s *Server) Client(opts ...dnac.Option) (*dnac.Client, error) {
+	s.mu.Lock()
+	username, password := s.username, s.password
+	s.mu.Unlock()
+	defaults := []dnac.Option{
+		dnac.WithBaseURL(s.URL),
+		dnac.WithHTTPClient(s.Server.Client()),
+		dnac.WithCredentials(username, password),
+	}
+	return dnac.New(append(defaults, opts...)...)
+}
+
+// SetCredentials changes the username and password the token endpoint accepts.
+func (s *Server) SetCredentials(username string, password string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.username = username
+	s.password = password
+}
+
+// DisableAuth makes the server accept requests without a valid X-Auth-Token.
+func (s *Server) DisableAuth() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.noAuth = true
+}
+
+// Token returns the access token currently issued by the server.
+func (s *Server) Token() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.token
+}
+
+// ExpireToken invalidates the current access token, so that the next
+// request using it is answered with 401 and the client has to obtain a new one.
+func (s *Server) ExpireToken() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rotateToken()
+}
+
+func (s *Server) rotateToken() {
+	s.tokens++
+	s.token = fmt.Sprintf("dnactest-token-%d", s.tokens)
+}
+
+// serveHTTP records the request and routes it to the token endpoint, the
+// registered handlers or the task endpoints, in that order.
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	request, err := newRequest(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "BAD_REQUEST", err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	s.requests = append(s.requests, request)
+	noAuth, token := s.noAuth, s.token
+	s.mu.Unlock()
+
+	if r.URL.Path == authTokenPath {
+		s.serveToken(w, r)
+		return
+	}
+	if !noAuth && r.Header.Get(authTokenHeader) != token {
+		writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "invalid or expired X-Auth-Token")
+		return
+	}
+
+	if handler, params := s.match(r.Method, r.URL.Path); handler != nil {
+		request.Params = params
+		handler.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), paramsKey{}, params)))
+		return
+	}
+	if s.serveTask(w, r) {
+		return
+	}
+
+	s.mu.Lock()
+	s.unmatched = append(s.unmatched, request)
+	s.mu.Unlock()
+	writeError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("dnactest: no response registered for %s %s", r.Method, r.URL.Path))
+}
+
+// serveToken implements POST /dna/system/api/v1/auth/token with basic auth.
+func (s *Server) serveToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "the token endpoint only accepts POST")
+		return
+	}
+	username, password, ok := r.BasicAuth()
+	s.mu.Lock()
+	valid := ok && username == s.username && password == s.password
+	token := s.token
+	s.mu.Unlock()
+	if !valid {
+		writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "invalid credentials")
+		return
+	}
+	WriteJSON(w, http.StatusOK, map[string]string{"Token": token})
+}
+
+// WriteJSON writes body encoded as JSON with the given status code. A body
+// that is a string or []byte is written as is.
+func WriteJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	switch b := body.(type) {
+	case nil:
+	case []byte:
+		w.Write(b)
+	case string:
+		w.Write([]byte(b))
+	default:
+		json.NewEncoder(w).Encode(body)
+	}
+}
+
+// writeError writes an error payload shaped like the ones of DNA Center.
+func writeError(w http.ResponseWriter, status int, errorCode string, message string) {
+	WriteJSON(w, status, map[string]interface{}{
+		"response": map[string]string{
+			"errorCode": errorCode,
+			"message":   message,
+		},
+		"version": "1.0",
+	})
+}
+
+// matchPath reports whether path matches pattern, where a {name} segment of
+// the pattern matches any single segment, and returns the matched segments.
+func matchPath(pattern string, path string) (map[string]string, bool) {
+	patternSegments := strings.Split(strings.Trim(pattern, "/"), "/")
+	pathSegments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(patternSegments) != len(pathSegments) {
+		return nil, false
+	}
+	params := map[string]string{}
+	for i, segment := range patternSegments {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			params[segment[1:len(segment)-1]] = pathSegments[i]
+			continue
+		}
+		if segment != pathSegments[i] {
+			return nil, false
+		}
+	}
+	return params, true
+}
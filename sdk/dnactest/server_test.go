@@ -0,0 +1,105 @@
+package dnactest_test
+
+import (
+	"net/http"
+	"testing"
+
+	dnac "github.com/cisco-en-programmability/dnacenter-go-sdk/sdk"
+	"github.com/cisco-en-programmability/dnacenter-go-sdk/sdk/dnactest"
+)
+
+const tokenPath = "/dna/system/api/v1/auth/token"
+
+func TestClientAuthenticates(t *testing.T) {
+	srv := dnactest.NewServer()
+	defer srv.Close()
+	srv.RespondJSON(http.MethodGet, "/dna/intent/api/v1/network-device/{id}", http.StatusOK, map[string]interface{}{"response": map[string]string{"id": "d1"}})
+
+	client, err := srv.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := client.Devices.GetDeviceByID("d1"); err != nil {
+		t.Fatal(err)
+	}
+	srv.AssertCallCount(t, http.MethodPost, tokenPath, 1)
+	r := srv.LastRequestTo(http.MethodGet, "/dna/intent/api/v1/network-device/{id}")
+	if got := r.Header.Get("X-Auth-Token"); got != srv.Token() {
+		t.Errorf("request carries token %q, want %q", got, srv.Token())
+	}
+}
+
+func TestClientRejectsWrongCredentials(t *testing.T) {
+	srv := dnactest.NewServer()
+	defer srv.Close()
+	srv.SetCredentials("operator", "other")
+
+	if _, err := srv.Client(dnac.WithCredentials(dnactest.DefaultUsername, dnactest.DefaultPassword)); !dnac.IsUnauthorized(err) {
+		t.Errorf("got %v, want a 401 error", err)
+	}
+	if _, err := srv.Client(); err != nil {
+		t.Errorf("client with the new credentials: %v", err)
+	}
+}
+
+func TestExpireTokenMakesClientRefresh(t *testing.T) {
+	srv := dnactest.NewServer()
+	defer srv.Close()
+	srv.RespondJSON(http.MethodGet, "/dna/intent/api/v1/network-device/{id}", http.StatusOK, map[string]interface{}{"response": map[string]string{"id": "d1"}})
+
+	client, err := srv.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	old := srv.Token()
+	srv.ExpireToken()
+	if srv.Token() == old {
+		t.Fatalf("token %q was not rotated", old)
+	}
+
+	if _, _, err := client.Devices.GetDeviceByID("d1"); err != nil {
+		t.Fatal(err)
+	}
+	requests := srv.RequestsTo(http.MethodGet, "/dna/intent/api/v1/network-device/{id}")
+	if len(requests) != 2 {
+		t.Fatalf("got %d requests, want the rejected one and its replay", len(requests))
+	}
+	if got := requests[0].Header.Get("X-Auth-Token"); got != old {
+		t.Errorf("first request carries %q, want the expired token %q", got, old)
+	}
+	if got := requests[1].Header.Get("X-Auth-Token"); got != srv.Token() {
+		t.Errorf("replay carries %q, want the new token %q", got, srv.Token())
+	}
+	srv.AssertCallCount(t, http.MethodPost, tokenPath, 2)
+}
+
+func TestDisableAuth(t *testing.T) {
+	srv := dnactest.NewServer()
+	defer srv.Close()
+	srv.RespondJSON(http.MethodGet, "/open", http.StatusOK, "ok")
+	srv.DisableAuth()
+
+	resp, err := srv.Server.Client().Get(srv.URL + "/open")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("got status %d without a token, want 200", resp.StatusCode)
+	}
+}
+
+func TestRequestsWithoutTokenAreRejected(t *testing.T) {
+	srv := dnactest.NewServer()
+	defer srv.Close()
+	srv.RespondJSON(http.MethodGet, "/open", http.StatusOK, "ok")
+
+	resp, err := srv.Server.Client().Get(srv.URL + "/open")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("got status %d without a token, want 401", resp.StatusCode)
+	}
+}
@@ -0,0 +1,155 @@
+package dnactest
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	dnac "github.com/cisco-en-programmability/dnacenter-go-sdk/sdk"
+)
+
+const taskPath = "/dna/intent/api/v1/task"
+
+// Task is the scripted behaviour of a task served by the task endpoints.
+type Task struct {
+	ID                  string // Generated by AddTask when empty. Adding a task with the ID of another replaces it
+	Progress            string
+	Data                string
+	AdditionalStatusURL string
+	IsError             bool
+	ErrorCode           string
+	FailureReason       string
+	// PendingPolls is the number of polls for which the task is reported as
+	// running, without EndTime and error, before it ends as described.
+	PendingPolls int
+	// Children are added as tasks of their own, reported by the task tree.
+	Children []Task
+
+	parentID string
+	polls    int
+	started  time.Time
+}
+
+// AddTask registers a task served by GET /dna/intent/api/v1/task/{taskId}
+// and the other task endpoints and returns its ID.
+func (s *Server) AddTask(task Task) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.addTask(task, "")
+}
+
+func (s *Server) addTask(task Task, parentID string) string {
+	if task.ID == "" {
+		task.ID = s.newTaskID()
+	}
+	task.parentID = parentID
+	task.polls = 0
+	task.started = time.Now()
+	children := task.Children
+	task.Children = nil
+	if _, ok := s.tasks[task.ID]; !ok {
+		s.taskOrder = append(s.taskOrder, task.ID)
+	}
+	s.tasks[task.ID] = &task
+	for _, child := range children {
+		child.ID = s.addTask(child, task.ID)
+		task.Children = append(task.Children, child)
+	}
+	return task.ID
+}
+
+// newTaskID returns a generated task ID that no task has, explicit IDs
+// included.
+func (s *Server) newTaskID() string {
+	for {
+		s.taskSeq++
+		id := fmt.Sprintf("dnactest-task-%d", s.taskSeq)
+		if _, taken := s.tasks[id]; !taken {
+			return id
+		}
+	}
+}
+
+// TaskPolls returns how many times the task was fetched by ID.
+func (s *Server) TaskPolls(id string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if task, ok := s.tasks[id]; ok {
+		return task.polls
+	}
+	return 0
+}
+
+// state renders the task as DNA Center reports it at its next poll.
+func (t *Task) state() dnac.GetTaskByIDResponseResponse {
+	state := dnac.GetTaskByIDResponseResponse{
+		ID:        t.ID,
+		ParentID:  t.parentID,
+		StartTime: int(t.started.UnixNano() / int64(time.Millisecond)),
+		Progress:  t.Progress,
+		Version:   1,
+	}
+	if t.polls < t.PendingPolls {
+		if state.Progress == "" {
+			state.Progress = "In progress"
+		}
+		return state
+	}
+	state.EndTime = state.StartTime + 1
+	state.Data = t.Data
+	state.AdditionalStatusURL = t.AdditionalStatusURL
+	state.IsError = t.IsError
+	state.ErrorCode = t.ErrorCode
+	state.FailureReason = t.FailureReason
+	return state
+}
+
+// serveTask serves the task endpoints and reports whether r was one of them.
+func (s *Server) serveTask(w http.ResponseWriter, r *http.Request) bool {
+	if r.Method != http.MethodGet || !strings.HasPrefix(r.URL.Path, taskPath) {
+		return false
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := matchPath(taskPath, r.URL.Path); ok {
+		var states []dnac.GetTaskByIDResponseResponse
+		for _, id := range s.taskOrder {
+			states = append(states, s.tasks[id].state())
+		}
+		WriteJSON(w, http.StatusOK, map[string]interface{}{"response": states, "version": "1.0"})
+		return true
+	}
+	if _, ok := matchPath(taskPath+"/count", r.URL.Path); ok {
+		WriteJSON(w, http.StatusOK, map[string]interface{}{"response": len(s.tasks), "version": "1.0"})
+		return true
+	}
+	if params, ok := matchPath(taskPath+"/{taskId}", r.URL.Path); ok {
+		task, found := s.tasks[params["taskId"]]
+		if !found {
+			writeError(w, http.StatusNotFound, "NOT_FOUND", "task not found: "+params["taskId"])
+			return true
+		}
+		state := task.state()
+		task.polls++
+		WriteJSON(w, http.StatusOK, map[string]interface{}{"response": state, "version": "1.0"})
+		return true
+	}
+	if params, ok := matchPath(taskPath+"/{taskId}/tree", r.URL.Path); ok {
+		task, found := s.tasks[params["taskId"]]
+		if !found {
+			writeError(w, http.StatusNotFound, "NOT_FOUND", "task not found: "+params["taskId"])
+			return true
+		}
+		states := []dnac.GetTaskByIDResponseResponse{task.state()}
+		for _, id := range s.taskOrder {
+			if child := s.tasks[id]; child.parentID == task.ID {
+				states = append(states, child.state())
+			}
+		}
+		WriteJSON(w, http.StatusOK, map[string]interface{}{"response": states, "version": "1.0"})
+		return true
+	}
+	return false
+}
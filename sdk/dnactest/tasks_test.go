@@ -0,0 +1,135 @@
+package dnactest_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	dnac "github.com/cisco-en-programmability/dnacenter-go-sdk/sdk"
+	"github.com/cisco-en-programmability/dnacenter-go-sdk/sdk/dnactest"
+)
+
+func TestAddTaskGeneratesUnusedIDs(t *testing.T) {
+	srv := dnactest.NewServer()
+	defer srv.Close()
+
+	explicit := srv.AddTask(dnactest.Task{ID: "dnactest-task-2", Data: "explicit"})
+	first := srv.AddTask(dnactest.Task{})
+	second := srv.AddTask(dnactest.Task{})
+	if first == explicit || second == explicit || first == second {
+		t.Fatalf("got IDs %q, %q and %q, want distinct ones", explicit, first, second)
+	}
+
+	client, err := srv.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	task, _, err := client.Task.GetTaskByID(explicit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if task.Response.Data != "explicit" {
+		t.Errorf("task %s has data %q, want it kept", explicit, task.Response.Data)
+	}
+	count, _, err := client.Task.GetTaskCount(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count.Response != 3 {
+		t.Errorf("got %d tasks, want 3", count.Response)
+	}
+}
+
+func TestTaskPendingPolls(t *testing.T) {
+	srv := dnactest.NewServer()
+	defer srv.Close()
+	id := srv.AddTask(dnactest.Task{PendingPolls: 2, Data: "done"})
+
+	client, err := srv.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for poll := 1; poll <= 3; poll++ {
+		task, _, err := client.Task.GetTaskByID(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ended := task.Response.EndTime != 0
+		if want := poll > 2; ended != want {
+			t.Errorf("poll %d: ended %t, want %t", poll, ended, want)
+		}
+		if ended && task.Response.Data != "done" {
+			t.Errorf("poll %d: got data %q, want done", poll, task.Response.Data)
+		}
+	}
+	if polls := srv.TaskPolls(id); polls != 3 {
+		t.Errorf("got %d polls, want 3", polls)
+	}
+}
+
+func TestTaskFailureAndTree(t *testing.T) {
+	srv := dnactest.NewServer()
+	defer srv.Close()
+	id := srv.AddTask(dnactest.Task{Children: []dnactest.Task{
+		{Progress: "step 1"},
+		{IsError: true, FailureReason: "step 2 failed"},
+	}})
+
+	client, err := srv.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tree, _, err := client.Task.GetTaskTree(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tree.Response) != 3 || tree.Response[0].ID != id {
+		t.Fatalf("got tree %+v, want the task and its 2 children", tree.Response)
+	}
+	for _, child := range tree.Response[1:] {
+		if child.ParentID != id {
+			t.Errorf("child %s has parent %q, want %q", child.ID, child.ParentID, id)
+		}
+	}
+	if failed := tree.Response[2]; !failed.IsError || failed.FailureReason != "step 2 failed" {
+		t.Errorf("got child %+v, want the failure", failed)
+	}
+
+	if _, _, err := client.Task.GetTaskByID("unknown"); !dnac.IsNotFound(err) {
+		t.Errorf("got %v for an unknown task, want a 404 error", err)
+	}
+}
+
+func TestRespondTaskWaitsWithClient(t *testing.T) {
+	srv := dnactest.NewServer()
+	defer srv.Close()
+	srv.RespondTask(http.MethodPost, "/dna/intent/api/v1/site", http.StatusAccepted, dnactest.Task{PendingPolls: 1, Progress: "created"})
+
+	client, err := srv.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []string
+	for i := 0; i < 2; i++ {
+		var result struct{ Response dnac.TaskResponse }
+		if _, err := client.RestyClient().R().SetResult(&result).Post("/dna/intent/api/v1/site"); err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, result.Response.TaskID)
+	}
+	if ids[0] == "" || ids[0] == ids[1] {
+		t.Fatalf("got task IDs %q, want a new task per request", ids)
+	}
+
+	task, err := client.Task.WaitForTask(context.Background(), ids[0], &dnac.WaitForTaskOptions{PollInterval: time.Millisecond, MaxPollInterval: time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if task.Task.Progress != "created" {
+		t.Errorf("got progress %q, want created", task.Task.Progress)
+	}
+	if polls := srv.TaskPolls(ids[0]); polls != 2 {
+		t.Errorf("got %d polls, want 2", polls)
+	}
+}
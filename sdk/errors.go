@@ -0,0 +1,105 @@
+package dnac
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// APIError is returned when Cisco DNA Center answers a request with an error
+// status. Use errors.As to inspect it.
+type APIError struct {
+	Operation  string // Operation name, e.g. getDeviceList
+	Method     string // HTTP method of the request
+	Path       string // URL path of the request
+	StatusCode int    // HTTP status code of the response
+	ErrorCode  string // errorCode reported by DNA Center, if any
+	Message    string // message reported by DNA Center, if any
+	Detail     string // detail reported by DNA Center, if any
+	Body       []byte // Raw response body
+}
+
+// Error implements the error interface.
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("Error with operation %s: %s %s returned %d", e.Operation, e.Method, e.Path, e.StatusCode)
+	if e.ErrorCode != "" {
+		msg += " " + e.ErrorCode
+	}
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+	if e.Detail != "" {
+		msg += " (" + e.Detail + ")"
+	}
+	return msg
+}
+
+// apiErrorBody covers the error payloads DNA Center returns, either at the
+// top level or wrapped in a response object.
+type apiErrorBody struct {
+	Error     string        `json:"error,omitempty"`
+	ErrorCode string        `json:"errorCode,omitempty"`
+	Message   string        `json:"message,omitempty"`
+	Detail    string        `json:"detail,omitempty"`
+	Response  *apiErrorBody `json:"response,omitempty"`
+}
+
+// newAPIError builds the APIError for the failed operation from its response.
+func newAPIError(operation string, response *resty.Response) *APIError {
+	return newAPIErrorWithBody(operation, response, response.Body())
+}
+
+// newAPIErrorWithBody is like newAPIError for responses whose body was not
+// read by resty.
+func newAPIErrorWithBody(operation string, response *resty.Response, body []byte) *APIError {
+	e := &APIError{
+		Operation:  operation,
+		StatusCode: response.StatusCode(),
+		Body:       body,
+	}
+	if request := response.Request; request != nil {
+		e.Method = request.Method
+		e.Path = request.URL
+		if request.RawRequest != nil {
+			e.Path = request.RawRequest.URL.Path
+		}
+	}
+
+	var errorBody apiErrorBody
+	if json.Unmarshal(body, &errorBody) == nil {
+		if errorBody.Response != nil {
+			errorBody = *errorBody.Response
+		}
+		e.ErrorCode = errorBody.ErrorCode
+		e.Message = errorBody.Message
+		if e.Message == "" {
+			e.Message = errorBody.Error
+		}
+		e.Detail = errorBody.Detail
+	}
+	return e
+}
+
+// hasStatus reports whether err is an APIError with the given status code.
+func hasStatus(err error, statusCode int) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == statusCode
+}
+
+// IsNotFound reports whether err is an APIError with status 404.
+func IsNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound)
+}
+
+// IsUnauthorized reports whether err is an APIError with status 401.
+func IsUnauthorized(err error) bool {
+	return hasStatus(err, http.StatusUnauthorized)
+}
+
+// IsRateLimited reports whether err is an APIError with status 429.
+func IsRateLimited(err error) bool {
+	return hasStatus(err, http.StatusTooManyRequests)
+}
@@ -1,6 +1,7 @@
 package dnac
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -14,7 +15,7 @@ type EventManagementService service
 // CreateEventSubscriptionsRequest is the createEventSubscriptionsRequest definition
 type CreateEventSubscriptionsRequest struct {
 	Description           string                                                 `json:"description,omitempty"`           //
-	Filter                CreateEventSubscriptionsRequestFilter                  `json:"filter,omitempty"`                //
+	Filter                *CreateEventSubscriptionsRequestFilter                 `json:"filter,omitempty"`                //
 	Name                  string                                                 `json:"name,omitempty"`                  //
 	SubscriptionEndpoints []CreateEventSubscriptionsRequestSubscriptionEndpoints `json:"subscriptionEndpoints,omitempty"` //
 	SubscriptionID        string                                                 `json:"subscriptionId,omitempty"`        //
@@ -31,8 +32,8 @@ type CreateEventSubscriptionsRequestFilterEventIDs []string
 
 // CreateEventSubscriptionsRequestSubscriptionEndpoints is the createEventSubscriptionsRequestSubscriptionEndpoints definition
 type CreateEventSubscriptionsRequestSubscriptionEndpoints struct {
-	InstanceID          string                                                                  `json:"instanceId,omitempty"`          //
-	SubscriptionDetails CreateEventSubscriptionsRequestSubscriptionEndpointsSubscriptionDetails `json:"subscriptionDetails,omitempty"` //
+	InstanceID          string                                                                   `json:"instanceId,omitempty"`          //
+	SubscriptionDetails *CreateEventSubscriptionsRequestSubscriptionEndpointsSubscriptionDetails `json:"subscriptionDetails,omitempty"` //
 }
 
 // CreateEventSubscriptionsRequestSubscriptionEndpointsSubscriptionDetails is the createEventSubscriptionsRequestSubscriptionEndpointsSubscriptionDetails definition
@@ -46,7 +47,7 @@ type CreateEventSubscriptionsRequestSubscriptionEndpointsSubscriptionDetails str
 // UpdateEventSubscriptionsRequest is the updateEventSubscriptionsRequest definition
 type UpdateEventSubscriptionsRequest struct {
 	Description           string                                                 `json:"description,omitempty"`           //
-	Filter                UpdateEventSubscriptionsRequestFilter                  `json:"filter,omitempty"`                //
+	Filter                *UpdateEventSubscriptionsRequestFilter                 `json:"filter,omitempty"`                //
 	Name                  string                                                 `json:"name,omitempty"`                  //
 	SubscriptionEndpoints []UpdateEventSubscriptionsRequestSubscriptionEndpoints `json:"subscriptionEndpoints,omitempty"` //
 	SubscriptionID        string                                                 `json:"subscriptionId,omitempty"`        //
@@ -63,8 +64,8 @@ type UpdateEventSubscriptionsRequestFilterEventIDs []string
 
 // UpdateEventSubscriptionsRequestSubscriptionEndpoints is the updateEventSubscriptionsRequestSubscriptionEndpoints definition
 type UpdateEventSubscriptionsRequestSubscriptionEndpoints struct {
-	InstanceID          string                                                                  `json:"instanceId,omitempty"`          //
-	SubscriptionDetails UpdateEventSubscriptionsRequestSubscriptionEndpointsSubscriptionDetails `json:"subscriptionDetails,omitempty"` //
+	InstanceID          string                                                                   `json:"instanceId,omitempty"`          //
+	SubscriptionDetails *UpdateEventSubscriptionsRequestSubscriptionEndpointsSubscriptionDetails `json:"subscriptionDetails,omitempty"` //
 }
 
 // UpdateEventSubscriptionsRequestSubscriptionEndpointsSubscriptionDetails is the updateEventSubscriptionsRequestSubscriptionEndpointsSubscriptionDetails definition
@@ -196,12 +197,18 @@ type CountOfEventSubscriptionsQueryParams struct {
 @param eventIDs List of subscriptions related to the respective eventIds
 */
 func (s *EventManagementService) CountOfEventSubscriptions(countOfEventSubscriptionsQueryParams *CountOfEventSubscriptionsQueryParams) (*CountOfEventSubscriptionsResponse, *resty.Response, error) {
+	return s.CountOfEventSubscriptionsWithContext(context.Background(), countOfEventSubscriptionsQueryParams)
+}
+
+// CountOfEventSubscriptionsWithContext is like CountOfEventSubscriptions but sends the request with ctx for cancellation and deadlines.
+func (s *EventManagementService) CountOfEventSubscriptionsWithContext(ctx context.Context, countOfEventSubscriptionsQueryParams *CountOfEventSubscriptionsQueryParams) (*CountOfEventSubscriptionsResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/event/subscription/count"
 
 	queryString, _ := query.Values(countOfEventSubscriptionsQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&CountOfEventSubscriptionsResponse{}).
 		SetError(&Error{}).
@@ -212,7 +219,7 @@ func (s *EventManagementService) CountOfEventSubscriptions(countOfEventSubscript
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation countOfEventSubscriptions")
+		return nil, response, newAPIError("countOfEventSubscriptions", response)
 	}
 
 	result := response.Result().(*CountOfEventSubscriptionsResponse)
@@ -231,12 +238,18 @@ type CountOfEventsQueryParams struct {
 @param tags The registered Tags should be provided
 */
 func (s *EventManagementService) CountOfEvents(countOfEventsQueryParams *CountOfEventsQueryParams) (*CountOfEventsResponse, *resty.Response, error) {
+	return s.CountOfEventsWithContext(context.Background(), countOfEventsQueryParams)
+}
+
+// CountOfEventsWithContext is like CountOfEvents but sends the request with ctx for cancellation and deadlines.
+func (s *EventManagementService) CountOfEventsWithContext(ctx context.Context, countOfEventsQueryParams *CountOfEventsQueryParams) (*CountOfEventsResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/events/count"
 
 	queryString, _ := query.Values(countOfEventsQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&CountOfEventsResponse{}).
 		SetError(&Error{}).
@@ -247,7 +260,7 @@ func (s *EventManagementService) CountOfEvents(countOfEventsQueryParams *CountOf
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation countOfEvents")
+		return nil, response, newAPIError("countOfEvents", response)
 	}
 
 	result := response.Result().(*CountOfEventsResponse)
@@ -280,12 +293,18 @@ type CountOfNotificationsQueryParams struct {
 @param source source
 */
 func (s *EventManagementService) CountOfNotifications(countOfNotificationsQueryParams *CountOfNotificationsQueryParams) (*CountOfNotificationsResponse, *resty.Response, error) {
+	return s.CountOfNotificationsWithContext(context.Background(), countOfNotificationsQueryParams)
+}
+
+// CountOfNotificationsWithContext is like CountOfNotifications but sends the request with ctx for cancellation and deadlines.
+func (s *EventManagementService) CountOfNotificationsWithContext(ctx context.Context, countOfNotificationsQueryParams *CountOfNotificationsQueryParams) (*CountOfNotificationsResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/event/event-series/count"
 
 	queryString, _ := query.Values(countOfNotificationsQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&CountOfNotificationsResponse{}).
 		SetError(&Error{}).
@@ -296,7 +315,7 @@ func (s *EventManagementService) CountOfNotifications(countOfNotificationsQueryP
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation countOfNotifications")
+		return nil, response, newAPIError("countOfNotifications", response)
 	}
 
 	result := response.Result().(*CountOfNotificationsResponse)
@@ -307,10 +326,16 @@ func (s *EventManagementService) CountOfNotifications(countOfNotificationsQueryP
 /* Subscribe SubscriptionEndpoint to list of registered events
  */
 func (s *EventManagementService) CreateEventSubscriptions(createEventSubscriptionsRequest *[]CreateEventSubscriptionsRequest) (*CreateEventSubscriptionsResponse, *resty.Response, error) {
+	return s.CreateEventSubscriptionsWithContext(context.Background(), createEventSubscriptionsRequest)
+}
+
+// CreateEventSubscriptionsWithContext is like CreateEventSubscriptions but sends the request with ctx for cancellation and deadlines.
+func (s *EventManagementService) CreateEventSubscriptionsWithContext(ctx context.Context, createEventSubscriptionsRequest *[]CreateEventSubscriptionsRequest) (*CreateEventSubscriptionsResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/event/subscription"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(createEventSubscriptionsRequest).
 		SetResult(&CreateEventSubscriptionsResponse{}).
 		SetError(&Error{}).
@@ -321,7 +346,7 @@ func (s *EventManagementService) CreateEventSubscriptions(createEventSubscriptio
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation createEventSubscriptions")
+		return nil, response, newAPIError("createEventSubscriptions", response)
 	}
 
 	result := response.Result().(*CreateEventSubscriptionsResponse)
@@ -339,12 +364,18 @@ type DeleteEventSubscriptionsQueryParams struct {
 @param subscriptions List of EventSubscriptionId's for removal
 */
 func (s *EventManagementService) DeleteEventSubscriptions(deleteEventSubscriptionsQueryParams *DeleteEventSubscriptionsQueryParams) (*DeleteEventSubscriptionsResponse, *resty.Response, error) {
+	return s.DeleteEventSubscriptionsWithContext(context.Background(), deleteEventSubscriptionsQueryParams)
+}
+
+// DeleteEventSubscriptionsWithContext is like DeleteEventSubscriptions but sends the request with ctx for cancellation and deadlines.
+func (s *EventManagementService) DeleteEventSubscriptionsWithContext(ctx context.Context, deleteEventSubscriptionsQueryParams *DeleteEventSubscriptionsQueryParams) (*DeleteEventSubscriptionsResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/event/subscription"
 
 	queryString, _ := query.Values(deleteEventSubscriptionsQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&DeleteEventSubscriptionsResponse{}).
 		SetError(&Error{}).
@@ -355,7 +386,7 @@ func (s *EventManagementService) DeleteEventSubscriptions(deleteEventSubscriptio
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation deleteEventSubscriptions")
+		return nil, response, newAPIError("deleteEventSubscriptions", response)
 	}
 
 	result := response.Result().(*DeleteEventSubscriptionsResponse)
@@ -380,12 +411,18 @@ type GetEventSubscriptionsQueryParams struct {
 @param order order(asc/desc)
 */
 func (s *EventManagementService) GetEventSubscriptions(getEventSubscriptionsQueryParams *GetEventSubscriptionsQueryParams) (*GetEventSubscriptionsResponse, *resty.Response, error) {
+	return s.GetEventSubscriptionsWithContext(context.Background(), getEventSubscriptionsQueryParams)
+}
+
+// GetEventSubscriptionsWithContext is like GetEventSubscriptions but sends the request with ctx for cancellation and deadlines.
+func (s *EventManagementService) GetEventSubscriptionsWithContext(ctx context.Context, getEventSubscriptionsQueryParams *GetEventSubscriptionsQueryParams) (*GetEventSubscriptionsResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/event/subscription"
 
 	queryString, _ := query.Values(getEventSubscriptionsQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetEventSubscriptionsResponse{}).
 		SetError(&Error{}).
@@ -396,7 +433,7 @@ func (s *EventManagementService) GetEventSubscriptions(getEventSubscriptionsQuer
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getEventSubscriptions")
+		return nil, response, newAPIError("getEventSubscriptions", response)
 	}
 
 	result := response.Result().(*GetEventSubscriptionsResponse)
@@ -414,7 +451,7 @@ type GetEventsQueryParams struct {
 }
 
 // GetEvents getEvents
-/* Gets the list of registered Events with provided eventIds or tags as mandatory
+/* Gets the list of registered Events with provided eventIds or tags as mandatory. The endpoint answers with a JSON array, decoded into a slice.
 @param eventID The registered EventId should be provided
 @param tags The registered Tags should be provided
 @param offset The number of Registries to offset in the resultset whose default value 0
@@ -422,15 +459,21 @@ type GetEventsQueryParams struct {
 @param sortBy SortBy field name
 @param order order(asc/desc)
 */
-func (s *EventManagementService) GetEvents(getEventsQueryParams *GetEventsQueryParams) (*GetEventsResponse, *resty.Response, error) {
+func (s *EventManagementService) GetEvents(getEventsQueryParams *GetEventsQueryParams) (*[]GetEventsResponse, *resty.Response, error) {
+	return s.GetEventsWithContext(context.Background(), getEventsQueryParams)
+}
+
+// GetEventsWithContext is like GetEvents but sends the request with ctx for cancellation and deadlines.
+func (s *EventManagementService) GetEventsWithContext(ctx context.Context, getEventsQueryParams *GetEventsQueryParams) (*[]GetEventsResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/events"
 
 	queryString, _ := query.Values(getEventsQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
-		SetResult(&GetEventsResponse{}).
+		SetResult(&[]GetEventsResponse{}).
 		SetError(&Error{}).
 		Get(path)
 
@@ -439,10 +482,10 @@ func (s *EventManagementService) GetEvents(getEventsQueryParams *GetEventsQueryP
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getEvents")
+		return nil, response, newAPIError("getEvents", response)
 	}
 
-	result := response.Result().(*GetEventsResponse)
+	result := response.Result().(*[]GetEventsResponse)
 	return result, response, err
 }
 
@@ -480,12 +523,18 @@ type GetNotificationsQueryParams struct {
 @param order order(asc/desc)
 */
 func (s *EventManagementService) GetNotifications(getNotificationsQueryParams *GetNotificationsQueryParams) (*GetNotificationsResponse, *resty.Response, error) {
+	return s.GetNotificationsWithContext(context.Background(), getNotificationsQueryParams)
+}
+
+// GetNotificationsWithContext is like GetNotifications but sends the request with ctx for cancellation and deadlines.
+func (s *EventManagementService) GetNotificationsWithContext(ctx context.Context, getNotificationsQueryParams *GetNotificationsQueryParams) (*GetNotificationsResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/event/event-series"
 
 	queryString, _ := query.Values(getNotificationsQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetNotificationsResponse{}).
 		SetError(&Error{}).
@@ -496,7 +545,7 @@ func (s *EventManagementService) GetNotifications(getNotificationsQueryParams *G
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getNotifications")
+		return nil, response, newAPIError("getNotifications", response)
 	}
 
 	result := response.Result().(*GetNotificationsResponse)
@@ -508,11 +557,17 @@ func (s *EventManagementService) GetNotifications(getNotificationsQueryParams *G
 @param executionID Execution ID
 */
 func (s *EventManagementService) GetStatusAPIForEvents(executionID string) (*GetStatusAPIForEventsResponse, *resty.Response, error) {
+	return s.GetStatusAPIForEventsWithContext(context.Background(), executionID)
+}
+
+// GetStatusAPIForEventsWithContext is like GetStatusAPIForEvents but sends the request with ctx for cancellation and deadlines.
+func (s *EventManagementService) GetStatusAPIForEventsWithContext(ctx context.Context, executionID string) (*GetStatusAPIForEventsResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/event/api-status/{executionId}"
 	path = strings.Replace(path, "{"+"executionId"+"}", fmt.Sprintf("%v", executionID), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&GetStatusAPIForEventsResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -522,7 +577,7 @@ func (s *EventManagementService) GetStatusAPIForEvents(executionID string) (*Get
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getStatusAPIForEvents")
+		return nil, response, newAPIError("getStatusAPIForEvents", response)
 	}
 
 	result := response.Result().(*GetStatusAPIForEventsResponse)
@@ -533,10 +588,16 @@ func (s *EventManagementService) GetStatusAPIForEvents(executionID string) (*Get
 /* Update SubscriptionEndpoint to list of registered events
  */
 func (s *EventManagementService) UpdateEventSubscriptions(updateEventSubscriptionsRequest *[]UpdateEventSubscriptionsRequest) (*UpdateEventSubscriptionsResponse, *resty.Response, error) {
+	return s.UpdateEventSubscriptionsWithContext(context.Background(), updateEventSubscriptionsRequest)
+}
+
+// UpdateEventSubscriptionsWithContext is like UpdateEventSubscriptions but sends the request with ctx for cancellation and deadlines.
+func (s *EventManagementService) UpdateEventSubscriptionsWithContext(ctx context.Context, updateEventSubscriptionsRequest *[]UpdateEventSubscriptionsRequest) (*UpdateEventSubscriptionsResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/event/subscription"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(updateEventSubscriptionsRequest).
 		SetResult(&UpdateEventSubscriptionsResponse{}).
 		SetError(&Error{}).
@@ -547,7 +608,7 @@ func (s *EventManagementService) UpdateEventSubscriptions(updateEventSubscriptio
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation updateEventSubscriptions")
+		return nil, response, newAPIError("updateEventSubscriptions", response)
 	}
 
 	result := response.Result().(*UpdateEventSubscriptionsResponse)
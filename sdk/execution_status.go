@@ -0,0 +1,71 @@
+package dnac
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// executionStatus is the state of an asynchronous business API call, as
+// reported by the executionStatusUrl it answered with.
+type executionStatus struct {
+	BapiExecutionID string `json:"bapiExecutionId,omitempty"`
+	BapiName        string `json:"bapiName,omitempty"`
+	BapiError       string `json:"bapiError,omitempty"`
+	Status          string `json:"status,omitempty"` // IN_PROGRESS, SUCCESS or FAILURE
+}
+
+// ExecutionError is returned when an asynchronous business API call failed.
+type ExecutionError struct {
+	ExecutionID string
+	Name        string
+	Reason      string
+}
+
+// Error implements the error interface.
+func (e *ExecutionError) Error() string {
+	return fmt.Sprintf("execution %s of %s failed: %s", e.ExecutionID, e.Name, e.Reason)
+}
+
+// waitForExecution polls the execution status URL of an asynchronous
+// business API call until the call ends, with the intervals of WaitForTask.
+// It returns an *ExecutionError if the call failed.
+func waitForExecution(ctx context.Context, client *resty.Client, executionStatusURL string, opts *WaitForTaskOptions) error {
+	pollInterval, maxPollInterval := pollIntervals(opts)
+	for {
+		timer := time.NewTimer(pollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		response, err := client.R().
+			SetContext(ctx).
+			SetResult(&executionStatus{}).
+			SetError(&Error{}).
+			Get(executionStatusURL)
+		if err != nil {
+			return err
+		}
+		if response.IsError() {
+			return newAPIError("getExecutionStatus", response)
+		}
+		execution := response.Result().(*executionStatus)
+		switch strings.ToUpper(execution.Status) {
+		case "SUCCESS":
+			return nil
+		case "FAILURE":
+			return &ExecutionError{ExecutionID: execution.BapiExecutionID, Name: execution.BapiName, Reason: execution.BapiError}
+		}
+
+		pollInterval *= 2
+		if pollInterval > maxPollInterval {
+			pollInterval = maxPollInterval
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package dnac
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -49,12 +50,18 @@ type GetListOfFilesResponseResponseSftpServerList []string
 @param fileID File Identification number
 */
 func (s *FileService) DownloadAFileByFileID(fileID string) (string, *resty.Response, error) {
+	return s.DownloadAFileByFileIDWithContext(context.Background(), fileID)
+}
+
+// DownloadAFileByFileIDWithContext is like DownloadAFileByFileID but sends the request with ctx for cancellation and deadlines.
+func (s *FileService) DownloadAFileByFileIDWithContext(ctx context.Context, fileID string) (string, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/file/{fileId}"
 	path = strings.Replace(path, "{"+"fileId"+"}", fmt.Sprintf("%v", fileID), -1)
 
 	var operationResult string
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&operationResult).
 		SetError(&Error{}).
 		Get(path)
@@ -69,10 +76,16 @@ func (s *FileService) DownloadAFileByFileID(fileID string) (string, *resty.Respo
 /* Returns list of available namespaces
  */
 func (s *FileService) GetListOfAvailableNamespaces() (*GetListOfAvailableNamespacesResponse, *resty.Response, error) {
+	return s.GetListOfAvailableNamespacesWithContext(context.Background())
+}
+
+// GetListOfAvailableNamespacesWithContext is like GetListOfAvailableNamespaces but sends the request with ctx for cancellation and deadlines.
+func (s *FileService) GetListOfAvailableNamespacesWithContext(ctx context.Context) (*GetListOfAvailableNamespacesResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/file/namespace"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&GetListOfAvailableNamespacesResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -82,7 +95,7 @@ func (s *FileService) GetListOfAvailableNamespaces() (*GetListOfAvailableNamespa
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getListOfAvailableNamespaces")
+		return nil, response, newAPIError("getListOfAvailableNamespaces", response)
 	}
 
 	result := response.Result().(*GetListOfAvailableNamespacesResponse)
@@ -94,11 +107,17 @@ func (s *FileService) GetListOfAvailableNamespaces() (*GetListOfAvailableNamespa
 @param nameSpace A listing of fileId's
 */
 func (s *FileService) GetListOfFiles(nameSpace string) (*GetListOfFilesResponse, *resty.Response, error) {
+	return s.GetListOfFilesWithContext(context.Background(), nameSpace)
+}
+
+// GetListOfFilesWithContext is like GetListOfFiles but sends the request with ctx for cancellation and deadlines.
+func (s *FileService) GetListOfFilesWithContext(ctx context.Context, nameSpace string) (*GetListOfFilesResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/file/namespace/{nameSpace}"
 	path = strings.Replace(path, "{"+"nameSpace"+"}", fmt.Sprintf("%v", nameSpace), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&GetListOfFilesResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -108,7 +127,7 @@ func (s *FileService) GetListOfFiles(nameSpace string) (*GetListOfFilesResponse,
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getListOfFiles")
+		return nil, response, newAPIError("getListOfFiles", response)
 	}
 
 	result := response.Result().(*GetListOfFilesResponse)
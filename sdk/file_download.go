@@ -0,0 +1,112 @@
+package dnac
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime"
+	"strings"
+)
+
+// DownloadOptions controls the verification of a downloaded file.
+type DownloadOptions struct {
+	Namespace    string // Look up the expected checksums of the file in this namespace with GetListOfFiles
+	Md5Checksum  string // Expected MD5 checksum, hex encoded
+	Sha1Checksum string // Expected SHA-1 checksum, hex encoded
+}
+
+// DownloadedFile describes a file written by DownloadToWriter.
+type DownloadedFile struct {
+	ContentType  string // Content-Type of the response
+	FileName     string // File name from the Content-Disposition header, if any
+	Size         int64  // Number of bytes written
+	Md5Checksum  string // MD5 checksum of the content, hex encoded
+	Sha1Checksum string // SHA-1 checksum of the content, hex encoded
+}
+
+// DownloadToWriter streams the content of the file with the given ID to w
+// without holding it in memory, so binary files such as configuration
+// archives are written unchanged. If opts carries expected checksums, or a
+// namespace to look them up, the content is verified against them and an
+// error is returned on mismatch. opts may be nil.
+func (s *FileService) DownloadToWriter(ctx context.Context, fileID string, w io.Writer, opts *DownloadOptions) (*DownloadedFile, error) {
+	var expected DownloadOptions
+	if opts != nil {
+		expected = *opts
+	}
+	if expected.Namespace != "" {
+		files, _, err := s.GetListOfFilesWithContext(ctx, expected.Namespace)
+		if err != nil {
+			return nil, err
+		}
+		found := false
+		for _, file := range files.Response {
+			if file.ID == fileID {
+				expected.Md5Checksum = file.Md5Checksum
+				expected.Sha1Checksum = file.Sha1Checksum
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("file %s not found in namespace %s", fileID, expected.Namespace)
+		}
+	}
+
+	path := "/dna/intent/api/v1/file/{fileId}"
+	path = strings.Replace(path, "{"+"fileId"+"}", fmt.Sprintf("%v", fileID), -1)
+
+	response, err := s.client.R().
+		SetContext(ctx).
+		SetDoNotParseResponse(true).
+		Get(path)
+
+	if err != nil {
+		return nil, err
+	}
+	body := response.RawBody()
+	defer body.Close()
+
+	if response.IsError() {
+		content, _ := io.ReadAll(body)
+		return nil, newAPIErrorWithBody("downloadAFileByFileId", response, content)
+	}
+
+	md5Hash := md5.New()
+	sha1Hash := sha1.New()
+	size, err := io.Copy(io.MultiWriter(w, md5Hash, sha1Hash), body)
+	if err != nil {
+		return nil, err
+	}
+
+	file := &DownloadedFile{
+		ContentType:  response.Header().Get("Content-Type"),
+		Size:         size,
+		Md5Checksum:  hex.EncodeToString(md5Hash.Sum(nil)),
+		Sha1Checksum: hex.EncodeToString(sha1Hash.Sum(nil)),
+	}
+	if _, params, err := mime.ParseMediaType(response.Header().Get("Content-Disposition")); err == nil {
+		file.FileName = params["filename"]
+	}
+
+	if expected.Md5Checksum != "" && !strings.EqualFold(expected.Md5Checksum, file.Md5Checksum) {
+		return file, fmt.Errorf("file %s: MD5 checksum %s does not match expected %s", fileID, file.Md5Checksum, expected.Md5Checksum)
+	}
+	if expected.Sha1Checksum != "" && !strings.EqualFold(expected.Sha1Checksum, file.Sha1Checksum) {
+		return file, fmt.Errorf("file %s: SHA-1 checksum %s does not match expected %s", fileID, file.Sha1Checksum, expected.Sha1Checksum)
+	}
+	return file, nil
+}
+
+// downloadFile returns the raw content of the file with the given ID.
+func (s *FileService) downloadFile(ctx context.Context, fileID string) ([]byte, error) {
+	var content bytes.Buffer
+	if _, err := s.DownloadToWriter(ctx, fileID, &content, nil); err != nil {
+		return nil, err
+	}
+	return content.Bytes(), nil
+}
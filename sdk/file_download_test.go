@@ -0,0 +1,124 @@
+package dnac_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	dnac "github.com/cisco-en-programmability/dnacenter-go-sdk/sdk"
+	"github.com/cisco-en-programmability/dnacenter-go-sdk/sdk/dnactest"
+)
+
+// bodyTracker is a transport recording whether the response bodies it
+// returned were closed.
+type bodyTracker struct {
+	next   http.RoundTripper
+	mu     sync.Mutex
+	bodies []*trackedBody
+}
+
+type trackedBody struct {
+	io.ReadCloser
+	closed bool
+}
+
+func (b *trackedBody) Close() error {
+	b.closed = true
+	return b.ReadCloser.Close()
+}
+
+func (t *bodyTracker) RoundTrip(r *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(r)
+	if err != nil {
+		return nil, err
+	}
+	body := &trackedBody{ReadCloser: resp.Body}
+	resp.Body = body
+	t.mu.Lock()
+	t.bodies = append(t.bodies, body)
+	t.mu.Unlock()
+	return resp, nil
+}
+
+func (t *bodyTracker) open() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	n := 0
+	for _, body := range t.bodies {
+		if !body.closed {
+			n++
+		}
+	}
+	return n
+}
+
+func trackedClient(t *testing.T, srv *dnactest.Server) (*dnac.Client, *bodyTracker) {
+	httpClient := srv.Server.Client()
+	tracker := &bodyTracker{next: httpClient.Transport}
+	httpClient.Transport = tracker
+	policy := dnac.DefaultRetryPolicy()
+	policy.MinBackoff = time.Millisecond
+	client, err := srv.Client(dnac.WithHTTPClient(httpClient), dnac.WithRetryPolicy(policy))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client, tracker
+}
+
+func TestDownloadToWriterClosesRetriedBodies(t *testing.T) {
+	srv := dnactest.NewServer()
+	defer srv.Close()
+	unavailable := dnactest.Response{Status: http.StatusServiceUnavailable, Body: map[string]string{"message": "busy"}}
+	srv.RespondSequence(http.MethodGet, "/dna/intent/api/v1/file/{fileId}", unavailable, unavailable,
+		dnactest.Response{Body: "content"})
+
+	client, tracker := trackedClient(t, srv)
+	var content bytes.Buffer
+	file, err := client.File.DownloadToWriter(context.Background(), "f1", &content, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if content.String() != "content" || file.Size != int64(content.Len()) {
+		t.Errorf("got %q of size %d, want the body of the last attempt", content.String(), file.Size)
+	}
+	srv.AssertCallCount(t, http.MethodGet, "/dna/intent/api/v1/file/f1", 3)
+	if n := tracker.open(); n != 0 {
+		t.Errorf("%d response bodies left open", n)
+	}
+}
+
+func TestDownloadToWriterClosesReplayedBody(t *testing.T) {
+	srv := dnactest.NewServer()
+	defer srv.Close()
+	srv.RespondJSON(http.MethodGet, "/dna/intent/api/v1/file/{fileId}", http.StatusOK, "content")
+
+	client, tracker := trackedClient(t, srv)
+	srv.ExpireToken()
+	if _, err := client.File.DownloadToWriter(context.Background(), "f1", io.Discard, nil); err != nil {
+		t.Fatal(err)
+	}
+	srv.AssertCallCount(t, http.MethodGet, "/dna/intent/api/v1/file/f1", 2)
+	if n := tracker.open(); n != 0 {
+		t.Errorf("%d response bodies left open", n)
+	}
+}
+
+func TestDownloadToWriterClosesBodyOfLastAttempt(t *testing.T) {
+	srv := dnactest.NewServer()
+	defer srv.Close()
+	srv.RespondJSON(http.MethodGet, "/dna/intent/api/v1/file/{fileId}", http.StatusServiceUnavailable, map[string]string{"message": "busy"})
+
+	client, tracker := trackedClient(t, srv)
+	_, err := client.File.DownloadToWriter(context.Background(), "f1", io.Discard, nil)
+	if err == nil {
+		t.Fatal("got no error, want the 503 of the last attempt")
+	}
+	srv.AssertCallCount(t, http.MethodGet, "/dna/intent/api/v1/file/f1", dnac.DefaultRetryPolicy().MaxAttempts)
+	if n := tracker.open(); n != 0 {
+		t.Errorf("%d response bodies left open", n)
+	}
+}
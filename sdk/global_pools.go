@@ -0,0 +1,308 @@
+package dnac
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net"
+	"sort"
+	"strings"
+)
+
+// Address spaces of global IP pools.
+const (
+	IPAddressSpaceIPv4 = "IPv4"
+	IPAddressSpaceIPv6 = "IPv6"
+)
+
+// GlobalPools is the set of global IP pools of DNA Center, used to check new
+// pools against the existing ones and to allocate free subnets.
+type GlobalPools struct {
+	Pools []GetGlobalPoolResponseResponse
+
+	settings *NetworkSettingsService
+	subnets  []*net.IPNet // Subnet of each pool, nil if its CIDR is invalid
+}
+
+// LoadGlobalPools loads all the global IP pools, page by page.
+func (s *NetworkSettingsService) LoadGlobalPools(ctx context.Context) (*GlobalPools, error) {
+	p := &GlobalPools{settings: s}
+	it := s.ListAllGlobalPools(ctx, nil)
+	for it.Next() {
+		p.add(it.Pool())
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (p *GlobalPools) add(pool GetGlobalPoolResponseResponse) {
+	_, subnet, err := net.ParseCIDR(pool.IPPoolCidr)
+	if err != nil {
+		subnet = nil
+	}
+	p.Pools = append(p.Pools, pool)
+	p.subnets = append(p.subnets, subnet)
+}
+
+// ByName returns the pool with the given name.
+func (p *GlobalPools) ByName(name string) (GetGlobalPoolResponseResponse, bool) {
+	for _, pool := range p.Pools {
+		if pool.IPPoolName == name {
+			return pool, true
+		}
+	}
+	return GetGlobalPoolResponseResponse{}, false
+}
+
+// Overlapping returns the pools whose subnet overlaps cidr.
+func (p *GlobalPools) Overlapping(cidr string) ([]GetGlobalPoolResponseResponse, error) {
+	_, subnet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, fmt.Errorf("global pools: %v", err)
+	}
+	var pools []GetGlobalPoolResponseResponse
+	for i, other := range p.subnets {
+		if other != nil && subnetsOverlap(subnet, other) {
+			pools = append(pools, p.Pools[i])
+		}
+	}
+	return pools, nil
+}
+
+// GlobalPoolError is returned when a global pool is invalid or conflicts with
+// the existing pools. It holds every problem found.
+type GlobalPoolError struct {
+	Name     string
+	CIDR     string
+	Problems []string
+}
+
+// Error implements the error interface.
+func (e *GlobalPoolError) Error() string {
+	return fmt.Sprintf("global pool %s (%s): %s", e.Name, e.CIDR, strings.Join(e.Problems, "; "))
+}
+
+// Check checks a pool about to be created: its CIDR must be a valid subnet
+// of its address space, its name must be unique, its gateway must be a host
+// address of the subnet and the subnet must not overlap an existing pool. It
+// returns a *GlobalPoolError listing every problem, or nil.
+func (p *GlobalPools) Check(pool CreateGlobalPoolRequestSettingsIPpool) error {
+	var problems []string
+	if _, exists := p.ByName(pool.IPPoolName); exists {
+		problems = append(problems, "a pool with this name already exists")
+	}
+	ip, subnet, err := net.ParseCIDR(pool.IPPoolCidr)
+	if err != nil {
+		problems = append(problems, fmt.Sprintf("invalid CIDR: %v", err))
+	} else {
+		if !ip.Equal(subnet.IP) {
+			problems = append(problems, fmt.Sprintf("%s is not a subnet address, use %s", pool.IPPoolCidr, subnet))
+		}
+		if space := addressSpaceOf(subnet); pool.IPAddressSpace != "" && !strings.EqualFold(pool.IPAddressSpace, space) {
+			problems = append(problems, fmt.Sprintf("%s is not in the %s address space", subnet, pool.IPAddressSpace))
+		}
+		if pool.Gateway != "" {
+			if problem := checkGateway(subnet, pool.Gateway); problem != "" {
+				problems = append(problems, problem)
+			}
+		}
+		for i, other := range p.subnets {
+			if other != nil && subnetsOverlap(subnet, other) {
+				problems = append(problems, fmt.Sprintf("overlaps pool %s (%s)", p.Pools[i].IPPoolName, other))
+			}
+		}
+	}
+	if len(problems) > 0 {
+		return &GlobalPoolError{Name: pool.IPPoolName, CIDR: pool.IPPoolCidr, Problems: problems}
+	}
+	return nil
+}
+
+// Audit checks the existing pools and returns a *GlobalPoolError for each
+// pool with an invalid or misaligned CIDR, a gateway outside its subnet, or a
+// subnet that overlaps another pool while neither is marked as overlapping.
+func (p *GlobalPools) Audit() []*GlobalPoolError {
+	var errs []*GlobalPoolError
+	for i, pool := range p.Pools {
+		var problems []string
+		subnet := p.subnets[i]
+		if subnet == nil {
+			problems = append(problems, "invalid CIDR")
+		} else {
+			if ip, _, _ := net.ParseCIDR(pool.IPPoolCidr); !ip.Equal(subnet.IP) {
+				problems = append(problems, fmt.Sprintf("%s is not a subnet address, use %s", pool.IPPoolCidr, subnet))
+			}
+			for _, gateway := range pool.Gateways {
+				if problem := checkGateway(subnet, gateway); problem != "" {
+					problems = append(problems, problem)
+				}
+			}
+			for j, other := range p.subnets {
+				if j != i && other != nil && subnetsOverlap(subnet, other) && !pool.Overlapping && !p.Pools[j].Overlapping {
+					problems = append(problems, fmt.Sprintf("overlaps pool %s (%s)", p.Pools[j].IPPoolName, other))
+				}
+			}
+		}
+		if len(problems) > 0 {
+			errs = append(errs, &GlobalPoolError{Name: pool.IPPoolName, CIDR: pool.IPPoolCidr, Problems: problems})
+		}
+	}
+	return errs
+}
+
+// NextFreeSubnet returns the lowest subnet of the given prefix length inside
+// supernet that overlaps none of the pools. The supernet may be IPv4 or IPv6.
+func (p *GlobalPools) NextFreeSubnet(supernet string, prefixLength int) (*net.IPNet, error) {
+	_, outer, err := net.ParseCIDR(supernet)
+	if err != nil {
+		return nil, fmt.Errorf("global pools: %v", err)
+	}
+	outerOnes, bits := outer.Mask.Size()
+	if prefixLength < outerOnes || prefixLength > bits {
+		return nil, fmt.Errorf("global pools: cannot allocate a /%d in %s", prefixLength, outer)
+	}
+
+	// The pools of the same family inside or around the supernet, by start.
+	var used []*net.IPNet
+	for _, subnet := range p.subnets {
+		if subnet != nil && subnetsOverlap(outer, subnet) {
+			used = append(used, subnet)
+		}
+	}
+	sort.Slice(used, func(i, j int) bool {
+		first, _ := subnetBounds(used[i])
+		other, _ := subnetBounds(used[j])
+		return first.Cmp(other) < 0
+	})
+
+	size := new(big.Int).Lsh(big.NewInt(1), uint(bits-prefixLength))
+	candidate, outerLast := subnetBounds(outer)
+	for {
+		last := new(big.Int).Sub(new(big.Int).Add(candidate, size), big.NewInt(1))
+		if last.Cmp(outerLast) > 0 {
+			return nil, fmt.Errorf("global pools: no free /%d left in %s", prefixLength, outer)
+		}
+		var blocking *big.Int
+		for _, subnet := range used {
+			first, usedLast := subnetBounds(subnet)
+			if first.Cmp(last) <= 0 && candidate.Cmp(usedLast) <= 0 {
+				blocking = usedLast
+				break
+			}
+		}
+		if blocking == nil {
+			return &net.IPNet{IP: intToIP(candidate, bits), Mask: net.CIDRMask(prefixLength, bits)}, nil
+		}
+		// Continue at the first boundary of the prefix length after the
+		// blocking pool.
+		next := new(big.Int).Add(blocking, big.NewInt(1))
+		remainder := new(big.Int).Mod(next, size)
+		if remainder.Sign() != 0 {
+			next.Add(next, new(big.Int).Sub(size, remainder))
+		}
+		candidate = next
+	}
+}
+
+// Create checks the pool, creates it, waits for its creation to complete and
+// returns it as reported by DNA Center. The pool is added to the set. opts may
+// be nil to use the defaults.
+func (p *GlobalPools) Create(ctx context.Context, pool CreateGlobalPoolRequestSettingsIPpool, opts *WaitForTaskOptions) (*GetGlobalPoolResponseResponse, error) {
+	if err := p.Check(pool); err != nil {
+		return nil, err
+	}
+	if pool.IPAddressSpace == "" {
+		_, subnet, _ := net.ParseCIDR(pool.IPPoolCidr)
+		pool.IPAddressSpace = addressSpaceOf(subnet)
+	}
+	request := &CreateGlobalPoolRequest{Settings: &CreateGlobalPoolRequestSettings{
+		IPpool: []CreateGlobalPoolRequestSettingsIPpool{pool},
+	}}
+	result, _, err := p.settings.CreateGlobalPoolWithContext(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	if result.ExecutionStatusURL != "" {
+		if err := waitForExecution(ctx, p.settings.client, result.ExecutionStatusURL, opts); err != nil {
+			return nil, err
+		}
+	}
+
+	it := p.settings.ListAllGlobalPools(ctx, nil)
+	for it.Next() {
+		if created := it.Pool(); created.IPPoolName == pool.IPPoolName {
+			p.add(created)
+			return &created, nil
+		}
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+	return nil, fmt.Errorf("global pools: %s not found after its creation", pool.IPPoolName)
+}
+
+// addressSpaceOf returns the address space of subnet.
+func addressSpaceOf(subnet *net.IPNet) string {
+	if subnet.IP.To4() != nil {
+		return IPAddressSpaceIPv4
+	}
+	return IPAddressSpaceIPv6
+}
+
+// checkGateway returns why gateway cannot be the gateway of subnet, or an
+// empty string.
+func checkGateway(subnet *net.IPNet, gateway string) string {
+	ip := net.ParseIP(gateway)
+	if ip == nil {
+		return fmt.Sprintf("gateway %q is not an IP address", gateway)
+	}
+	if !subnet.Contains(ip) {
+		return fmt.Sprintf("gateway %s is outside %s", gateway, subnet)
+	}
+	ones, bits := subnet.Mask.Size()
+	if bits-ones < 2 {
+		return ""
+	}
+	first, last := subnetBounds(subnet)
+	n := ipToInt(ip)
+	if n.Cmp(first) == 0 {
+		return fmt.Sprintf("gateway %s is the subnet address of %s", gateway, subnet)
+	}
+	if bits == 32 && n.Cmp(last) == 0 {
+		return fmt.Sprintf("gateway %s is the broadcast address of %s", gateway, subnet)
+	}
+	return ""
+}
+
+// subnetsOverlap reports whether a and b share an address. Subnets of
+// different families never overlap.
+func subnetsOverlap(a *net.IPNet, b *net.IPNet) bool {
+	if (a.IP.To4() == nil) != (b.IP.To4() == nil) {
+		return false
+	}
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
+
+// subnetBounds returns the first and last addresses of subnet as integers.
+func subnetBounds(subnet *net.IPNet) (*big.Int, *big.Int) {
+	ones, bits := subnet.Mask.Size()
+	first := ipToInt(subnet.IP.Mask(subnet.Mask))
+	size := new(big.Int).Lsh(big.NewInt(1), uint(bits-ones))
+	last := new(big.Int).Sub(new(big.Int).Add(first, size), big.NewInt(1))
+	return first, last
+}
+
+func ipToInt(ip net.IP) *big.Int {
+	if v4 := ip.To4(); v4 != nil {
+		return new(big.Int).SetBytes(v4)
+	}
+	return new(big.Int).SetBytes(ip.To16())
+}
+
+func intToIP(n *big.Int, bits int) net.IP {
+	ip := make(net.IP, bits/8)
+	n.FillBytes(ip)
+	return ip
+}
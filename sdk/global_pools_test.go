@@ -0,0 +1,229 @@
+package dnac_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	dnac "github.com/cisco-en-programmability/dnacenter-go-sdk/sdk"
+	"github.com/cisco-en-programmability/dnacenter-go-sdk/sdk/dnactest"
+)
+
+const globalPoolPath = "/dna/intent/api/v1/global-pool"
+
+// loadGlobalPools returns the global pools loaded from a server holding pools.
+func loadGlobalPools(t *testing.T, pools ...dnac.GetGlobalPoolResponseResponse) *dnac.GlobalPools {
+	t.Helper()
+	srv, client := taskServer(t)
+	page, err := json.Marshal(map[string]interface{}{"response": pools})
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv.RespondSequence(http.MethodGet, globalPoolPath,
+		dnactest.Response{Body: string(page)},
+		dnactest.Response{Body: `{"response": []}`},
+	)
+	p, err := client.NetworkSettings.LoadGlobalPools(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+// campusPools is a set of IPv4 and IPv6 pools. Printers is given with host
+// bits set and covers 10.0.2.128/26.
+func campusPools(t *testing.T) *dnac.GlobalPools {
+	return loadGlobalPools(t,
+		dnac.GetGlobalPoolResponseResponse{IPPoolName: "Campus", IPPoolCidr: "10.0.0.0/24"},
+		dnac.GetGlobalPoolResponseResponse{IPPoolName: "Branch", IPPoolCidr: "10.0.1.0/25"},
+		dnac.GetGlobalPoolResponseResponse{IPPoolName: "Printers", IPPoolCidr: "10.0.2.130/26"},
+		dnac.GetGlobalPoolResponseResponse{IPPoolName: "Lab", IPPoolCidr: "10.0.4.0/22"},
+		dnac.GetGlobalPoolResponseResponse{IPPoolName: "Campus-v6", IPPoolCidr: "2001:db8::/64", IPv6: true},
+		dnac.GetGlobalPoolResponseResponse{IPPoolName: "Voice-v6", IPPoolCidr: "2001:db8:0:2::/63", IPv6: true},
+	)
+}
+
+func TestGlobalPoolsNextFreeSubnet(t *testing.T) {
+	pools := campusPools(t)
+	tests := []struct {
+		name         string
+		supernet     string
+		prefixLength int
+		want         string // Subnet, or the text of the error
+	}{
+		{"IPv4 after partly used subnets", "10.0.0.0/16", 24, "10.0.3.0/24"},
+		{"IPv4 in a gap of a used subnet", "10.0.0.0/16", 25, "10.0.1.128/25"},
+		{"IPv4 aligned after misaligned pools", "10.0.0.0/16", 23, "10.0.8.0/23"},
+		{"IPv4 after a larger pool", "10.0.0.0/16", 22, "10.0.8.0/22"},
+		{"IPv4 supernet with host bits", "10.0.0.77/16", 24, "10.0.3.0/24"},
+		{"IPv4 whole supernet", "10.1.0.0/16", 16, "10.1.0.0/16"},
+		{"IPv4 exhausted supernet", "10.0.0.0/23", 24, "global pools: no free /24 left in 10.0.0.0/23"},
+		{"IPv4 supernet inside a pool", "10.0.5.0/24", 26, "global pools: no free /26 left in 10.0.5.0/24"},
+		{"IPv6 in a gap", "2001:db8::/48", 64, "2001:db8:0:1::/64"},
+		{"IPv6 aligned after pools", "2001:db8::/48", 63, "2001:db8:0:4::/63"},
+		{"IPv6 exhausted supernet", "2001:db8:0:2::/63", 64, "global pools: no free /64 left in 2001:db8:0:2::/63"},
+		{"IPv6 ignores IPv4 pools", "::/64", 96, "::/96"},
+		{"prefix shorter than the supernet", "10.0.0.0/16", 8, "global pools: cannot allocate a /8 in 10.0.0.0/16"},
+		{"prefix longer than the address", "2001:db8::/48", 129, "global pools: cannot allocate a /129 in 2001:db8::/48"},
+		{"invalid supernet", "10.0.0.0", 24, "global pools: invalid CIDR address: 10.0.0.0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subnet, err := pools.NextFreeSubnet(tt.supernet, tt.prefixLength)
+			var got string
+			if err != nil {
+				got = err.Error()
+			} else {
+				got = subnet.String()
+			}
+			if got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGlobalPoolsCheck(t *testing.T) {
+	pools := campusPools(t)
+	tests := []struct {
+		name     string
+		pool     dnac.CreateGlobalPoolRequestSettingsIPpool
+		problems []string
+	}{
+		{
+			"valid IPv4",
+			dnac.CreateGlobalPoolRequestSettingsIPpool{IPPoolName: "Guest", IPPoolCidr: "10.0.3.0/24", Gateway: "10.0.3.1", IPAddressSpace: "IPv4"},
+			nil,
+		},
+		{
+			"valid IPv6 with the last address as gateway",
+			dnac.CreateGlobalPoolRequestSettingsIPpool{IPPoolName: "Guest-v6", IPPoolCidr: "2001:db8:0:1::/64", Gateway: "2001:db8:0:1:ffff:ffff:ffff:ffff", IPAddressSpace: "ipv6"},
+			nil,
+		},
+		{
+			"duplicate name",
+			dnac.CreateGlobalPoolRequestSettingsIPpool{IPPoolName: "Campus", IPPoolCidr: "10.9.0.0/24"},
+			[]string{"a pool with this name already exists"},
+		},
+		{
+			"invalid CIDR",
+			dnac.CreateGlobalPoolRequestSettingsIPpool{IPPoolName: "Guest", IPPoolCidr: "10.0.3.0/33"},
+			[]string{"invalid CIDR: invalid CIDR address: 10.0.3.0/33"},
+		},
+		{
+			"misaligned IPv4",
+			dnac.CreateGlobalPoolRequestSettingsIPpool{IPPoolName: "Guest", IPPoolCidr: "10.0.3.5/24"},
+			[]string{"10.0.3.5/24 is not a subnet address, use 10.0.3.0/24"},
+		},
+		{
+			"misaligned IPv6",
+			dnac.CreateGlobalPoolRequestSettingsIPpool{IPPoolName: "Guest-v6", IPPoolCidr: "2001:db8:0:1::1/64"},
+			[]string{"2001:db8:0:1::1/64 is not a subnet address, use 2001:db8:0:1::/64"},
+		},
+		{
+			"wrong address space",
+			dnac.CreateGlobalPoolRequestSettingsIPpool{IPPoolName: "Guest", IPPoolCidr: "10.0.3.0/24", IPAddressSpace: "IPv6"},
+			[]string{"10.0.3.0/24 is not in the IPv6 address space"},
+		},
+		{
+			"gateway outside the subnet",
+			dnac.CreateGlobalPoolRequestSettingsIPpool{IPPoolName: "Guest", IPPoolCidr: "10.0.3.0/24", Gateway: "10.0.9.1"},
+			[]string{"gateway 10.0.9.1 is outside 10.0.3.0/24"},
+		},
+		{
+			"gateway of another family",
+			dnac.CreateGlobalPoolRequestSettingsIPpool{IPPoolName: "Guest-v6", IPPoolCidr: "2001:db8:0:1::/64", Gateway: "10.0.3.1"},
+			[]string{"gateway 10.0.3.1 is outside 2001:db8:0:1::/64"},
+		},
+		{
+			"gateway is the subnet address",
+			dnac.CreateGlobalPoolRequestSettingsIPpool{IPPoolName: "Guest", IPPoolCidr: "10.0.3.0/24", Gateway: "10.0.3.0"},
+			[]string{"gateway 10.0.3.0 is the subnet address of 10.0.3.0/24"},
+		},
+		{
+			"gateway is the broadcast address",
+			dnac.CreateGlobalPoolRequestSettingsIPpool{IPPoolName: "Guest", IPPoolCidr: "10.0.3.0/24", Gateway: "10.0.3.255"},
+			[]string{"gateway 10.0.3.255 is the broadcast address of 10.0.3.0/24"},
+		},
+		{
+			"invalid gateway",
+			dnac.CreateGlobalPoolRequestSettingsIPpool{IPPoolName: "Guest", IPPoolCidr: "10.0.3.0/24", Gateway: "router"},
+			[]string{`gateway "router" is not an IP address`},
+		},
+		{
+			"IPv4 overlaps",
+			dnac.CreateGlobalPoolRequestSettingsIPpool{IPPoolName: "Guest", IPPoolCidr: "10.0.0.0/22"},
+			[]string{"overlaps pool Campus (10.0.0.0/24)", "overlaps pool Branch (10.0.1.0/25)", "overlaps pool Printers (10.0.2.128/26)"},
+		},
+		{
+			"IPv6 overlaps",
+			dnac.CreateGlobalPoolRequestSettingsIPpool{IPPoolName: "Guest-v6", IPPoolCidr: "2001:db8::/48"},
+			[]string{"overlaps pool Campus-v6 (2001:db8::/64)", "overlaps pool Voice-v6 (2001:db8:0:2::/63)"},
+		},
+		{
+			"every problem at once",
+			dnac.CreateGlobalPoolRequestSettingsIPpool{IPPoolName: "Lab", IPPoolCidr: "10.0.1.200/25", Gateway: "10.0.9.1"},
+			[]string{
+				"a pool with this name already exists",
+				"10.0.1.200/25 is not a subnet address, use 10.0.1.128/25",
+				"gateway 10.0.9.1 is outside 10.0.1.128/25",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := pools.Check(tt.pool)
+			if tt.problems == nil {
+				if err != nil {
+					t.Errorf("got %v, want nil", err)
+				}
+				return
+			}
+			var poolErr *dnac.GlobalPoolError
+			if !errors.As(err, &poolErr) {
+				t.Fatalf("got %v, want a *GlobalPoolError", err)
+			}
+			if poolErr.Name != tt.pool.IPPoolName || poolErr.CIDR != tt.pool.IPPoolCidr {
+				t.Errorf("got pool %s (%s), want %s (%s)", poolErr.Name, poolErr.CIDR, tt.pool.IPPoolName, tt.pool.IPPoolCidr)
+			}
+			if !reflect.DeepEqual(poolErr.Problems, tt.problems) {
+				t.Errorf("got problems %q, want %q", poolErr.Problems, tt.problems)
+			}
+		})
+	}
+}
+
+func TestGlobalPoolsAudit(t *testing.T) {
+	pools := loadGlobalPools(t,
+		dnac.GetGlobalPoolResponseResponse{IPPoolName: "A", IPPoolCidr: "10.1.0.0/24", Gateways: []string{"10.1.0.1"}},
+		dnac.GetGlobalPoolResponseResponse{IPPoolName: "B", IPPoolCidr: "10.1.0.128/25"},
+		dnac.GetGlobalPoolResponseResponse{IPPoolName: "C", IPPoolCidr: "10.1.1.0/24", Gateways: []string{"10.1.2.1"}},
+		dnac.GetGlobalPoolResponseResponse{IPPoolName: "D", IPPoolCidr: "bogus"},
+		dnac.GetGlobalPoolResponseResponse{IPPoolName: "E", IPPoolCidr: "10.1.1.0/25", Overlapping: true},
+		dnac.GetGlobalPoolResponseResponse{IPPoolName: "F", IPPoolCidr: "2001:db8:1::/64", Gateways: []string{"2001:db8:1::"}, IPv6: true},
+		dnac.GetGlobalPoolResponseResponse{IPPoolName: "G", IPPoolCidr: "2001:db8:1::/48", IPv6: true},
+		dnac.GetGlobalPoolResponseResponse{IPPoolName: "H", IPPoolCidr: "10.1.3.9/24", Gateways: []string{"10.1.3.1"}},
+		dnac.GetGlobalPoolResponseResponse{IPPoolName: "I", IPPoolCidr: "10.2.0.0/24", Gateways: []string{"10.2.0.1"}},
+	)
+
+	var got []string
+	for _, err := range pools.Audit() {
+		got = append(got, err.Error())
+	}
+	want := []string{
+		"global pool A (10.1.0.0/24): overlaps pool B (10.1.0.128/25)",
+		"global pool B (10.1.0.128/25): overlaps pool A (10.1.0.0/24)",
+		"global pool C (10.1.1.0/24): gateway 10.1.2.1 is outside 10.1.1.0/24",
+		"global pool D (bogus): invalid CIDR",
+		"global pool F (2001:db8:1::/64): gateway 2001:db8:1:: is the subnet address of 2001:db8:1::/64; overlaps pool G (2001:db8:1::/48)",
+		"global pool G (2001:db8:1::/48): overlaps pool F (2001:db8:1::/64)",
+		"global pool H (10.1.3.9/24): 10.1.3.9/24 is not a subnet address, use 10.1.3.0/24",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("got:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
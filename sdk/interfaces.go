@@ -0,0 +1,787 @@
+// Code generated by geninterfaces. DO NOT EDIT.
+
+package dnac
+
+import (
+	"context"
+	"io"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// AuthenticationAPI is the set of methods of AuthenticationService, implemented by it and by dnacmock.AuthenticationAPI.
+type AuthenticationAPI interface {
+	AuthenticationAPI(username string, password string) (*AuthenticationAPIResponse, *resty.Response, error)
+	AuthenticationAPIWithContext(ctx context.Context, username string, password string) (*AuthenticationAPIResponse, *resty.Response, error)
+}
+
+// SitesAPI is the set of methods of SitesService, implemented by it and by dnacmock.SitesAPI.
+type SitesAPI interface {
+	AssignDeviceToSite(siteID string, assignDeviceToSiteRequest *AssignDeviceToSiteRequest) (*AssignDeviceToSiteResponse, *resty.Response, error)
+	AssignDeviceToSiteWithContext(ctx context.Context, siteID string, assignDeviceToSiteRequest *AssignDeviceToSiteRequest) (*AssignDeviceToSiteResponse, *resty.Response, error)
+	CreateSite(createSiteRequest *CreateSiteRequest) (*CreateSiteResponse, *resty.Response, error)
+	CreateSiteWithContext(ctx context.Context, createSiteRequest *CreateSiteRequest) (*CreateSiteResponse, *resty.Response, error)
+	DeleteSite(siteID string) (*DeleteSiteResponse, *resty.Response, error)
+	DeleteSiteWithContext(ctx context.Context, siteID string) (*DeleteSiteResponse, *resty.Response, error)
+	GetMembership(siteID string, getMembershipQueryParams *GetMembershipQueryParams) (*GetMembershipResponse, *resty.Response, error)
+	GetMembershipWithContext(ctx context.Context, siteID string, getMembershipQueryParams *GetMembershipQueryParams) (*GetMembershipResponse, *resty.Response, error)
+	GetSite(getSiteQueryParams *GetSiteQueryParams) (*GetSiteResponse, *resty.Response, error)
+	GetSiteCount(getSiteCountQueryParams *GetSiteCountQueryParams) (*GetSiteCountResponse, *resty.Response, error)
+	GetSiteCountWithContext(ctx context.Context, getSiteCountQueryParams *GetSiteCountQueryParams) (*GetSiteCountResponse, *resty.Response, error)
+	GetSiteHealth(getSiteHealthQueryParams *GetSiteHealthQueryParams) (*GetSiteHealthResponse, *resty.Response, error)
+	GetSiteHealthWithContext(ctx context.Context, getSiteHealthQueryParams *GetSiteHealthQueryParams) (*GetSiteHealthResponse, *resty.Response, error)
+	GetSiteWithContext(ctx context.Context, getSiteQueryParams *GetSiteQueryParams) (*GetSiteResponse, *resty.Response, error)
+	ListAll(ctx context.Context, params *GetSiteQueryParams, opts *PageOptions) *SiteIterator
+	LoadSiteTree(ctx context.Context) (*SiteTree, error)
+	UpdateSite(siteID string, updateSiteRequest *UpdateSiteRequest) (*UpdateSiteResponse, *resty.Response, error)
+	UpdateSiteWithContext(ctx context.Context, siteID string, updateSiteRequest *UpdateSiteRequest) (*UpdateSiteResponse, *resty.Response, error)
+}
+
+// TopologyAPI is the set of methods of TopologyService, implemented by it and by dnacmock.TopologyAPI.
+type TopologyAPI interface {
+	GetL3TopologyDetails(topologyType string) (*GetL3TopologyDetailsResponse, *resty.Response, error)
+	GetL3TopologyDetailsWithContext(ctx context.Context, topologyType string) (*GetL3TopologyDetailsResponse, *resty.Response, error)
+	GetOverallNetworkHealth(getOverallNetworkHealthQueryParams *GetOverallNetworkHealthQueryParams) (*GetOverallNetworkHealthResponse, *resty.Response, error)
+	GetOverallNetworkHealthWithContext(ctx context.Context, getOverallNetworkHealthQueryParams *GetOverallNetworkHealthQueryParams) (*GetOverallNetworkHealthResponse, *resty.Response, error)
+	GetPhysicalTopology(getPhysicalTopologyQueryParams *GetPhysicalTopologyQueryParams) (*GetPhysicalTopologyResponse, *resty.Response, error)
+	GetPhysicalTopologyWithContext(ctx context.Context, getPhysicalTopologyQueryParams *GetPhysicalTopologyQueryParams) (*GetPhysicalTopologyResponse, *resty.Response, error)
+	GetSiteTopology() (*GetSiteTopologyResponse, *resty.Response, error)
+	GetSiteTopologyWithContext(ctx context.Context) (*GetSiteTopologyResponse, *resty.Response, error)
+	GetTopologyDetails(vlanID string) (*GetTopologyDetailsResponse, *resty.Response, error)
+	GetTopologyDetailsWithContext(ctx context.Context, vlanID string) (*GetTopologyDetailsResponse, *resty.Response, error)
+	GetVLANDetails() (*GetVLANDetailsResponse, *resty.Response, error)
+	GetVLANDetailsWithContext(ctx context.Context) (*GetVLANDetailsResponse, *resty.Response, error)
+}
+
+// DevicesAPI is the set of methods of DevicesService, implemented by it and by dnacmock.DevicesAPI.
+type DevicesAPI interface {
+	AddDevice(addDeviceRequest *AddDeviceRequest) (*AddDeviceResponse, *resty.Response, error)
+	AddDeviceWithContext(ctx context.Context, addDeviceRequest *AddDeviceRequest) (*AddDeviceResponse, *resty.Response, error)
+	DeleteDeviceByID(id string, deleteDeviceByIDQueryParams *DeleteDeviceByIDQueryParams) (*DeleteDeviceByIDResponse, *resty.Response, error)
+	DeleteDeviceByIDWithContext(ctx context.Context, id string, deleteDeviceByIDQueryParams *DeleteDeviceByIDQueryParams) (*DeleteDeviceByIDResponse, *resty.Response, error)
+	Devices(devicesQueryParams *DevicesQueryParams) (*DevicesResponse, *resty.Response, error)
+	DevicesWithContext(ctx context.Context, devicesQueryParams *DevicesQueryParams) (*DevicesResponse, *resty.Response, error)
+	ExportDeviceList(exportDeviceListRequest *ExportDeviceListRequest) (*ExportDeviceListResponse, *resty.Response, error)
+	ExportDeviceListWithContext(ctx context.Context, exportDeviceListRequest *ExportDeviceListRequest) (*ExportDeviceListResponse, *resty.Response, error)
+	GetAllInterfaces(getAllInterfacesQueryParams *GetAllInterfacesQueryParams) (*GetAllInterfacesResponse, *resty.Response, error)
+	GetAllInterfacesWithContext(ctx context.Context, getAllInterfacesQueryParams *GetAllInterfacesQueryParams) (*GetAllInterfacesResponse, *resty.Response, error)
+	GetDeviceByID(id string) (*DevicesGetDeviceByIDResponse, *resty.Response, error)
+	GetDeviceByIDWithContext(ctx context.Context, id string) (*DevicesGetDeviceByIDResponse, *resty.Response, error)
+	GetDeviceBySerialNumber(serialNumber string) (*GetDeviceBySerialNumberResponse, *resty.Response, error)
+	GetDeviceBySerialNumberWithContext(ctx context.Context, serialNumber string) (*GetDeviceBySerialNumberResponse, *resty.Response, error)
+	GetDeviceConfigByID(networkDeviceID string) (*GetDeviceConfigByIDResponse, *resty.Response, error)
+	GetDeviceConfigByIDWithContext(ctx context.Context, networkDeviceID string) (*GetDeviceConfigByIDResponse, *resty.Response, error)
+	GetDeviceConfigCount() (*GetDeviceConfigCountResponse, *resty.Response, error)
+	GetDeviceConfigCountWithContext(ctx context.Context) (*GetDeviceConfigCountResponse, *resty.Response, error)
+	GetDeviceConfigForAllDevices() (*GetDeviceConfigForAllDevicesResponse, *resty.Response, error)
+	GetDeviceConfigForAllDevicesWithContext(ctx context.Context) (*GetDeviceConfigForAllDevicesResponse, *resty.Response, error)
+	GetDeviceCount() (*GetDeviceCountResponse, *resty.Response, error)
+	GetDeviceCountWithContext(ctx context.Context) (*GetDeviceCountResponse, *resty.Response, error)
+	GetDeviceDetail(getDeviceDetailQueryParams *GetDeviceDetailQueryParams) (*GetDeviceDetailResponse, *resty.Response, error)
+	GetDeviceDetailWithContext(ctx context.Context, getDeviceDetailQueryParams *GetDeviceDetailQueryParams) (*GetDeviceDetailResponse, *resty.Response, error)
+	GetDeviceEnrichmentDetails() (*GetDeviceEnrichmentDetailsResponse, *resty.Response, error)
+	GetDeviceEnrichmentDetailsWithContext(ctx context.Context) (*GetDeviceEnrichmentDetailsResponse, *resty.Response, error)
+	GetDeviceInterfaceCount() (*GetDeviceInterfaceCountResponse, *resty.Response, error)
+	GetDeviceInterfaceCountByDeviceID(deviceID string) (*GetDeviceInterfaceCountByDeviceIDResponse, *resty.Response, error)
+	GetDeviceInterfaceCountByDeviceIDWithContext(ctx context.Context, deviceID string) (*GetDeviceInterfaceCountByDeviceIDResponse, *resty.Response, error)
+	GetDeviceInterfaceCountWithContext(ctx context.Context) (*GetDeviceInterfaceCountResponse, *resty.Response, error)
+	GetDeviceInterfaceVLANs(id string, getDeviceInterfaceVLANsQueryParams *GetDeviceInterfaceVLANsQueryParams) (*GetDeviceInterfaceVLANsResponse, *resty.Response, error)
+	GetDeviceInterfaceVLANsWithContext(ctx context.Context, id string, getDeviceInterfaceVLANsQueryParams *GetDeviceInterfaceVLANsQueryParams) (*GetDeviceInterfaceVLANsResponse, *resty.Response, error)
+	GetDeviceInterfacesBySpecifiedRange(deviceID string, startIndex int, recordsToReturn int) (*GetDeviceInterfacesBySpecifiedRangeResponse, *resty.Response, error)
+	GetDeviceInterfacesBySpecifiedRangeWithContext(ctx context.Context, deviceID string, startIndex int, recordsToReturn int) (*GetDeviceInterfacesBySpecifiedRangeResponse, *resty.Response, error)
+	GetDeviceList(getDeviceListQueryParams *GetDeviceListQueryParams) (*GetDeviceListResponse, *resty.Response, error)
+	GetDeviceListWithContext(ctx context.Context, getDeviceListQueryParams *GetDeviceListQueryParams) (*GetDeviceListResponse, *resty.Response, error)
+	GetDeviceSummary(id string) (*GetDeviceSummaryResponse, *resty.Response, error)
+	GetDeviceSummaryWithContext(ctx context.Context, id string) (*GetDeviceSummaryResponse, *resty.Response, error)
+	GetFunctionalCapabilityByID(id string) (*GetFunctionalCapabilityByIDResponse, *resty.Response, error)
+	GetFunctionalCapabilityByIDWithContext(ctx context.Context, id string) (*GetFunctionalCapabilityByIDResponse, *resty.Response, error)
+	GetFunctionalCapabilityForDevices(getFunctionalCapabilityForDevicesQueryParams *GetFunctionalCapabilityForDevicesQueryParams) (*GetFunctionalCapabilityForDevicesResponse, *resty.Response, error)
+	GetFunctionalCapabilityForDevicesWithContext(ctx context.Context, getFunctionalCapabilityForDevicesQueryParams *GetFunctionalCapabilityForDevicesQueryParams) (*GetFunctionalCapabilityForDevicesResponse, *resty.Response, error)
+	GetISISInterfaces() (*GetISISInterfacesResponse, *resty.Response, error)
+	GetISISInterfacesWithContext(ctx context.Context) (*GetISISInterfacesResponse, *resty.Response, error)
+	GetInterfaceByID(id string) (*GetInterfaceByIDResponse, *resty.Response, error)
+	GetInterfaceByIDWithContext(ctx context.Context, id string) (*GetInterfaceByIDResponse, *resty.Response, error)
+	GetInterfaceByIP(ipAddress string) (*GetInterfaceByIPResponse, *resty.Response, error)
+	GetInterfaceByIPWithContext(ctx context.Context, ipAddress string) (*GetInterfaceByIPResponse, *resty.Response, error)
+	GetInterfaceDetailsByDeviceIDAndInterfaceName(deviceID string, getInterfaceDetailsByDeviceIDAndInterfaceNameQueryParams *GetInterfaceDetailsByDeviceIDAndInterfaceNameQueryParams) (*GetInterfaceDetailsByDeviceIDAndInterfaceNameResponse, *resty.Response, error)
+	GetInterfaceDetailsByDeviceIDAndInterfaceNameWithContext(ctx context.Context, deviceID string, getInterfaceDetailsByDeviceIDAndInterfaceNameQueryParams *GetInterfaceDetailsByDeviceIDAndInterfaceNameQueryParams) (*GetInterfaceDetailsByDeviceIDAndInterfaceNameResponse, *resty.Response, error)
+	GetInterfaceInfoByID(deviceID string) (*GetInterfaceInfoByIDResponse, *resty.Response, error)
+	GetInterfaceInfoByIDWithContext(ctx context.Context, deviceID string) (*GetInterfaceInfoByIDResponse, *resty.Response, error)
+	GetModuleCount(getModuleCountQueryParams *GetModuleCountQueryParams) (*GetModuleCountResponse, *resty.Response, error)
+	GetModuleCountWithContext(ctx context.Context, getModuleCountQueryParams *GetModuleCountQueryParams) (*GetModuleCountResponse, *resty.Response, error)
+	GetModuleInfoByID(id string) (*GetModuleInfoByIDResponse, *resty.Response, error)
+	GetModuleInfoByIDWithContext(ctx context.Context, id string) (*GetModuleInfoByIDResponse, *resty.Response, error)
+	GetModules(getModulesQueryParams *GetModulesQueryParams) (*GetModulesResponse, *resty.Response, error)
+	GetModulesWithContext(ctx context.Context, getModulesQueryParams *GetModulesQueryParams) (*GetModulesResponse, *resty.Response, error)
+	GetNetworkDeviceByIP(ipAddress string) (*GetNetworkDeviceByIPResponse, *resty.Response, error)
+	GetNetworkDeviceByIPWithContext(ctx context.Context, ipAddress string) (*GetNetworkDeviceByIPResponse, *resty.Response, error)
+	GetNetworkDeviceByPaginationRange(startIndex int, recordsToReturn int) (*GetNetworkDeviceByPaginationRangeResponse, *resty.Response, error)
+	GetNetworkDeviceByPaginationRangeWithContext(ctx context.Context, startIndex int, recordsToReturn int) (*GetNetworkDeviceByPaginationRangeResponse, *resty.Response, error)
+	GetOSPFInterfaces() (*GetOSPFInterfacesResponse, *resty.Response, error)
+	GetOSPFInterfacesWithContext(ctx context.Context) (*GetOSPFInterfacesResponse, *resty.Response, error)
+	GetOrganizationListForMeraki(id string) (*GetOrganizationListForMerakiResponse, *resty.Response, error)
+	GetOrganizationListForMerakiWithContext(ctx context.Context, id string) (*GetOrganizationListForMerakiResponse, *resty.Response, error)
+	GetPollingIntervalByID(id string) (*GetPollingIntervalByIDResponse, *resty.Response, error)
+	GetPollingIntervalByIDWithContext(ctx context.Context, id string) (*GetPollingIntervalByIDResponse, *resty.Response, error)
+	GetPollingIntervalForAllDevices() (*GetPollingIntervalForAllDevicesResponse, *resty.Response, error)
+	GetPollingIntervalForAllDevicesWithContext(ctx context.Context) (*GetPollingIntervalForAllDevicesResponse, *resty.Response, error)
+	GetWirelessLanControllerDetailsByID(id string) (*GetWirelessLanControllerDetailsByIDResponse, *resty.Response, error)
+	GetWirelessLanControllerDetailsByIDWithContext(ctx context.Context, id string) (*GetWirelessLanControllerDetailsByIDResponse, *resty.Response, error)
+	ListAll(ctx context.Context, opts *PageOptions) *NetworkDeviceIterator
+	ListAllMatching(ctx context.Context, params *RetrievesAllNetworkDevicesQueryParams, opts *PageOptions) *MatchingDeviceIterator
+	RegisterDeviceForWSA(registerDeviceForWSAQueryParams *RegisterDeviceForWSAQueryParams) (*RegisterDeviceForWSAResponse, *resty.Response, error)
+	RegisterDeviceForWSAWithContext(ctx context.Context, registerDeviceForWSAQueryParams *RegisterDeviceForWSAQueryParams) (*RegisterDeviceForWSAResponse, *resty.Response, error)
+	RetrievesAllNetworkDevices(retrievesAllNetworkDevicesQueryParams *RetrievesAllNetworkDevicesQueryParams) (string, *resty.Response, error)
+	RetrievesAllNetworkDevicesWithContext(ctx context.Context, retrievesAllNetworkDevicesQueryParams *RetrievesAllNetworkDevicesQueryParams) (string, *resty.Response, error)
+	SyncDevices(syncDevicesRequest *SyncDevicesRequest) (*SyncDevicesResponse, *resty.Response, error)
+	SyncDevicesWithContext(ctx context.Context, syncDevicesRequest *SyncDevicesRequest) (*SyncDevicesResponse, *resty.Response, error)
+	SyncNetworkDevices(syncNetworkDevicesQueryParams *SyncNetworkDevicesQueryParams, syncNetworkDevicesRequest *[]SyncNetworkDevicesRequest) (*SyncNetworkDevicesResponse, *resty.Response, error)
+	SyncNetworkDevicesWithContext(ctx context.Context, syncNetworkDevicesQueryParams *SyncNetworkDevicesQueryParams, syncNetworkDevicesRequest *[]SyncNetworkDevicesRequest) (*SyncNetworkDevicesResponse, *resty.Response, error)
+	UpdateDeviceRole(updateDeviceRoleRequest *UpdateDeviceRoleRequest) (*UpdateDeviceRoleResponse, *resty.Response, error)
+	UpdateDeviceRoleWithContext(ctx context.Context, updateDeviceRoleRequest *UpdateDeviceRoleRequest) (*UpdateDeviceRoleResponse, *resty.Response, error)
+}
+
+// ClientsAPI is the set of methods of ClientsService, implemented by it and by dnacmock.ClientsAPI.
+type ClientsAPI interface {
+	GetClientDetail(getClientDetailQueryParams *GetClientDetailQueryParams) (*GetClientDetailResponse, *resty.Response, error)
+	GetClientDetailWithContext(ctx context.Context, getClientDetailQueryParams *GetClientDetailQueryParams) (*GetClientDetailResponse, *resty.Response, error)
+	GetClientEnrichmentDetails(getClientEnrichmentDetailsHeaderParams *GetClientEnrichmentDetailsHeaderParams) (*GetClientEnrichmentDetailsResponse, *resty.Response, error)
+	GetClientEnrichmentDetailsWithContext(ctx context.Context, getClientEnrichmentDetailsHeaderParams *GetClientEnrichmentDetailsHeaderParams) (*GetClientEnrichmentDetailsResponse, *resty.Response, error)
+	GetOverallClientHealth(getOverallClientHealthQueryParams *GetOverallClientHealthQueryParams) (*GetOverallClientHealthResponse, *resty.Response, error)
+	GetOverallClientHealthWithContext(ctx context.Context, getOverallClientHealthQueryParams *GetOverallClientHealthQueryParams) (*GetOverallClientHealthResponse, *resty.Response, error)
+}
+
+// UsersAPI is the set of methods of UsersService, implemented by it and by dnacmock.UsersAPI.
+type UsersAPI interface {
+	GetUserEnrichmentDetails() (*GetUserEnrichmentDetailsResponse, *resty.Response, error)
+	GetUserEnrichmentDetailsWithContext(ctx context.Context) (*GetUserEnrichmentDetailsResponse, *resty.Response, error)
+}
+
+// IssuesAPI is the set of methods of IssuesService, implemented by it and by dnacmock.IssuesAPI.
+type IssuesAPI interface {
+	GetIssueEnrichmentDetails() (*GetIssueEnrichmentDetailsResponse, *resty.Response, error)
+	GetIssueEnrichmentDetailsWithContext(ctx context.Context) (*GetIssueEnrichmentDetailsResponse, *resty.Response, error)
+	Issues(issuesQueryParams *IssuesQueryParams) (*IssuesResponse, *resty.Response, error)
+	IssuesWithContext(ctx context.Context, issuesQueryParams *IssuesQueryParams) (*IssuesResponse, *resty.Response, error)
+}
+
+// SiteDesignAPI is the set of methods of SiteDesignService, implemented by it and by dnacmock.SiteDesignAPI.
+type SiteDesignAPI interface {
+	CreateNFVProfile(createNFVProfileRequest *CreateNFVProfileRequest) (*CreateNFVProfileResponse, *resty.Response, error)
+	CreateNFVProfileWithContext(ctx context.Context, createNFVProfileRequest *CreateNFVProfileRequest) (*CreateNFVProfileResponse, *resty.Response, error)
+	GetDeviceDetailsByIP(getDeviceDetailsByIPQueryParams *GetDeviceDetailsByIPQueryParams) (*GetDeviceDetailsByIPResponse, *resty.Response, error)
+	GetDeviceDetailsByIPWithContext(ctx context.Context, getDeviceDetailsByIPQueryParams *GetDeviceDetailsByIPQueryParams) (*GetDeviceDetailsByIPResponse, *resty.Response, error)
+	GetNFVProfile(id string, getNFVProfileQueryParams *GetNFVProfileQueryParams) (*GetNFVProfileResponse, *resty.Response, error)
+	GetNFVProfileWithContext(ctx context.Context, id string, getNFVProfileQueryParams *GetNFVProfileQueryParams) (*GetNFVProfileResponse, *resty.Response, error)
+	NFVProvisioningDetail(nFVProvisioningDetailRequest *NFVProvisioningDetailRequest) (*NFVProvisioningDetailResponse, *resty.Response, error)
+	NFVProvisioningDetailWithContext(ctx context.Context, nFVProvisioningDetailRequest *NFVProvisioningDetailRequest) (*NFVProvisioningDetailResponse, *resty.Response, error)
+	ProvisionNFV(provisionNFVRequest *ProvisionNFVRequest) (*ProvisionNFVResponse, *resty.Response, error)
+	ProvisionNFVWithContext(ctx context.Context, provisionNFVRequest *ProvisionNFVRequest) (*ProvisionNFVResponse, *resty.Response, error)
+	UpdateNFVProfile(id string, updateNFVProfileQueryParams *UpdateNFVProfileQueryParams, updateNFVProfileRequest *UpdateNFVProfileRequest) (*UpdateNFVProfileResponse, *resty.Response, error)
+	UpdateNFVProfileWithContext(ctx context.Context, id string, updateNFVProfileQueryParams *UpdateNFVProfileQueryParams, updateNFVProfileRequest *UpdateNFVProfileRequest) (*UpdateNFVProfileResponse, *resty.Response, error)
+}
+
+// NetworkSettingsAPI is the set of methods of NetworkSettingsService, implemented by it and by dnacmock.NetworkSettingsAPI.
+type NetworkSettingsAPI interface {
+	AssignCredentialToSite(siteID string, assignCredentialToSiteRequest *AssignCredentialToSiteRequest) (*AssignCredentialToSiteResponse, *resty.Response, error)
+	AssignCredentialToSiteWithContext(ctx context.Context, siteID string, assignCredentialToSiteRequest *AssignCredentialToSiteRequest) (*AssignCredentialToSiteResponse, *resty.Response, error)
+	CreateDeviceCredentials(createDeviceCredentialsRequest *CreateDeviceCredentialsRequest) (*CreateDeviceCredentialsResponse, *resty.Response, error)
+	CreateDeviceCredentialsWithContext(ctx context.Context, createDeviceCredentialsRequest *CreateDeviceCredentialsRequest) (*CreateDeviceCredentialsResponse, *resty.Response, error)
+	CreateGlobalPool(createGlobalPoolRequest *CreateGlobalPoolRequest) (*CreateGlobalPoolResponse, *resty.Response, error)
+	CreateGlobalPoolWithContext(ctx context.Context, createGlobalPoolRequest *CreateGlobalPoolRequest) (*CreateGlobalPoolResponse, *resty.Response, error)
+	CreateNetwork(siteID string, createNetworkRequest *CreateNetworkRequest) (*CreateNetworkResponse, *resty.Response, error)
+	CreateNetworkWithContext(ctx context.Context, siteID string, createNetworkRequest *CreateNetworkRequest) (*CreateNetworkResponse, *resty.Response, error)
+	CreateSPProfile(createSPProfileRequest *CreateSPProfileRequest) (*CreateSPProfileResponse, *resty.Response, error)
+	CreateSPProfileWithContext(ctx context.Context, createSPProfileRequest *CreateSPProfileRequest) (*CreateSPProfileResponse, *resty.Response, error)
+	DeleteDeviceCredential(id string) (*DeleteDeviceCredentialResponse, *resty.Response, error)
+	DeleteDeviceCredentialWithContext(ctx context.Context, id string) (*DeleteDeviceCredentialResponse, *resty.Response, error)
+	DeleteGlobalIPPool(id string) (*DeleteGlobalIPPoolResponse, *resty.Response, error)
+	DeleteGlobalIPPoolWithContext(ctx context.Context, id string) (*DeleteGlobalIPPoolResponse, *resty.Response, error)
+	DeleteSPProfile(spProfileName string) (*DeleteSPProfileResponse, *resty.Response, error)
+	DeleteSPProfileWithContext(ctx context.Context, spProfileName string) (*DeleteSPProfileResponse, *resty.Response, error)
+	GetDeviceCredentialDetails(getDeviceCredentialDetailsQueryParams *GetDeviceCredentialDetailsQueryParams) (*GetDeviceCredentialDetailsResponse, *resty.Response, error)
+	GetDeviceCredentialDetailsWithContext(ctx context.Context, getDeviceCredentialDetailsQueryParams *GetDeviceCredentialDetailsQueryParams) (*GetDeviceCredentialDetailsResponse, *resty.Response, error)
+	GetGlobalPool(getGlobalPoolQueryParams *GetGlobalPoolQueryParams) (*GetGlobalPoolResponse, *resty.Response, error)
+	GetGlobalPoolWithContext(ctx context.Context, getGlobalPoolQueryParams *GetGlobalPoolQueryParams) (*GetGlobalPoolResponse, *resty.Response, error)
+	GetNetwork(getNetworkQueryParams *GetNetworkQueryParams) (*GetNetworkResponse, *resty.Response, error)
+	GetNetworkWithContext(ctx context.Context, getNetworkQueryParams *GetNetworkQueryParams) (*GetNetworkResponse, *resty.Response, error)
+	GetServiceProviderDetails() (*GetServiceProviderDetailsResponse, *resty.Response, error)
+	GetServiceProviderDetailsWithContext(ctx context.Context) (*GetServiceProviderDetailsResponse, *resty.Response, error)
+	GetSiteNetworkSettings(ctx context.Context, siteID string) (*SiteNetworkSettings, error)
+	ListAllGlobalPools(ctx context.Context, opts *PageOptions) *GlobalPoolIterator
+	LoadGlobalPools(ctx context.Context) (*GlobalPools, error)
+	SyncSiteNetworkSettings(ctx context.Context, siteID string, desired *SiteNetworkSettings, opts *WaitForTaskOptions) ([]SiteNetworkChange, error)
+	UpdateDeviceCredentials(updateDeviceCredentialsRequest *UpdateDeviceCredentialsRequest) (*UpdateDeviceCredentialsResponse, *resty.Response, error)
+	UpdateDeviceCredentialsWithContext(ctx context.Context, updateDeviceCredentialsRequest *UpdateDeviceCredentialsRequest) (*UpdateDeviceCredentialsResponse, *resty.Response, error)
+	UpdateGlobalPool(updateGlobalPoolRequest *UpdateGlobalPoolRequest) (*UpdateGlobalPoolResponse, *resty.Response, error)
+	UpdateGlobalPoolWithContext(ctx context.Context, updateGlobalPoolRequest *UpdateGlobalPoolRequest) (*UpdateGlobalPoolResponse, *resty.Response, error)
+	UpdateNetwork(siteID string, updateNetworkRequest *UpdateNetworkRequest) (*UpdateNetworkResponse, *resty.Response, error)
+	UpdateNetworkWithContext(ctx context.Context, siteID string, updateNetworkRequest *UpdateNetworkRequest) (*UpdateNetworkResponse, *resty.Response, error)
+	UpdateSPProfile(updateSPProfileRequest *UpdateSPProfileRequest) (*UpdateSPProfileResponse, *resty.Response, error)
+	UpdateSPProfileWithContext(ctx context.Context, updateSPProfileRequest *UpdateSPProfileRequest) (*UpdateSPProfileResponse, *resty.Response, error)
+}
+
+// SoftwareImageManagementSWIMAPI is the set of methods of SoftwareImageManagementSWIMService, implemented by it and by dnacmock.SoftwareImageManagementSWIMAPI.
+type SoftwareImageManagementSWIMAPI interface {
+	GetSoftwareImageDetails(getSoftwareImageDetailsQueryParams *GetSoftwareImageDetailsQueryParams) (*GetSoftwareImageDetailsResponse, *resty.Response, error)
+	GetSoftwareImageDetailsWithContext(ctx context.Context, getSoftwareImageDetailsQueryParams *GetSoftwareImageDetailsQueryParams) (*GetSoftwareImageDetailsResponse, *resty.Response, error)
+	ImportLocalSoftwareImage(importLocalSoftwareImageQueryParams *ImportLocalSoftwareImageQueryParams) (*ImportLocalSoftwareImageResponse, *resty.Response, error)
+	ImportLocalSoftwareImageWithContext(ctx context.Context, importLocalSoftwareImageQueryParams *ImportLocalSoftwareImageQueryParams) (*ImportLocalSoftwareImageResponse, *resty.Response, error)
+	ImportSoftwareImageViaURL(importSoftwareImageViaURLQueryParams *ImportSoftwareImageViaURLQueryParams, importSoftwareImageViaURLRequest *[]ImportSoftwareImageViaURLRequest) (*ImportSoftwareImageViaURLResponse, *resty.Response, error)
+	ImportSoftwareImageViaURLWithContext(ctx context.Context, importSoftwareImageViaURLQueryParams *ImportSoftwareImageViaURLQueryParams, importSoftwareImageViaURLRequest *[]ImportSoftwareImageViaURLRequest) (*ImportSoftwareImageViaURLResponse, *resty.Response, error)
+	TriggerSoftwareImageActivation(triggerSoftwareImageActivationQueryParams *TriggerSoftwareImageActivationQueryParams, triggerSoftwareImageActivationRequest *[]TriggerSoftwareImageActivationRequest) (*TriggerSoftwareImageActivationResponse, *resty.Response, error)
+	TriggerSoftwareImageActivationWithContext(ctx context.Context, triggerSoftwareImageActivationQueryParams *TriggerSoftwareImageActivationQueryParams, triggerSoftwareImageActivationRequest *[]TriggerSoftwareImageActivationRequest) (*TriggerSoftwareImageActivationResponse, *resty.Response, error)
+	TriggerSoftwareImageDistribution(triggerSoftwareImageDistributionRequest *[]TriggerSoftwareImageDistributionRequest) (*TriggerSoftwareImageDistributionResponse, *resty.Response, error)
+	TriggerSoftwareImageDistributionWithContext(ctx context.Context, triggerSoftwareImageDistributionRequest *[]TriggerSoftwareImageDistributionRequest) (*TriggerSoftwareImageDistributionResponse, *resty.Response, error)
+	UploadLocalSoftwareImage(ctx context.Context, fileName string, image io.Reader, size int64, importLocalSoftwareImageQueryParams *ImportLocalSoftwareImageQueryParams, progress UploadProgressFunc) (*ImportLocalSoftwareImageResponse, *resty.Response, error)
+	UploadLocalSoftwareImageFile(ctx context.Context, filePath string, importLocalSoftwareImageQueryParams *ImportLocalSoftwareImageQueryParams, progress UploadProgressFunc) (*ImportLocalSoftwareImageResponse, *resty.Response, error)
+}
+
+// DeviceOnboardingPnPAPI is the set of methods of DeviceOnboardingPnPService, implemented by it and by dnacmock.DeviceOnboardingPnPAPI.
+type DeviceOnboardingPnPAPI interface {
+	AddAWorkflow(addAWorkflowRequest *AddAWorkflowRequest) (*AddAWorkflowResponse, *resty.Response, error)
+	AddAWorkflowWithContext(ctx context.Context, addAWorkflowRequest *AddAWorkflowRequest) (*AddAWorkflowResponse, *resty.Response, error)
+	AddDeviceToPnpDatabase(addDeviceToPnpDatabaseRequest *AddDeviceToPnpDatabaseRequest) (*AddDeviceToPnpDatabaseResponse, *resty.Response, error)
+	AddDeviceToPnpDatabaseWithContext(ctx context.Context, addDeviceToPnpDatabaseRequest *AddDeviceToPnpDatabaseRequest) (*AddDeviceToPnpDatabaseResponse, *resty.Response, error)
+	AddVirtualAccount(addVirtualAccountRequest *AddVirtualAccountRequest) (*AddVirtualAccountResponse, *resty.Response, error)
+	AddVirtualAccountWithContext(ctx context.Context, addVirtualAccountRequest *AddVirtualAccountRequest) (*AddVirtualAccountResponse, *resty.Response, error)
+	ClaimADeviceToASite(claimADeviceToASiteRequest *ClaimADeviceToASiteRequest) (*ClaimADeviceToASiteResponse, *resty.Response, error)
+	ClaimADeviceToASiteWithContext(ctx context.Context, claimADeviceToASiteRequest *ClaimADeviceToASiteRequest) (*ClaimADeviceToASiteResponse, *resty.Response, error)
+	ClaimDevice(claimDeviceRequest *ClaimDeviceRequest) (*ClaimDeviceResponse, *resty.Response, error)
+	ClaimDeviceWithContext(ctx context.Context, claimDeviceRequest *ClaimDeviceRequest) (*ClaimDeviceResponse, *resty.Response, error)
+	DeleteDeviceByIDFromPnP(id string) (*DeleteDeviceByIDFromPnPResponse, *resty.Response, error)
+	DeleteDeviceByIDFromPnPWithContext(ctx context.Context, id string) (*DeleteDeviceByIDFromPnPResponse, *resty.Response, error)
+	DeleteWorkflowByID(id string) (*DeleteWorkflowByIDResponse, *resty.Response, error)
+	DeleteWorkflowByIDWithContext(ctx context.Context, id string) (*DeleteWorkflowByIDResponse, *resty.Response, error)
+	DeregisterVirtualAccount(deregisterVirtualAccountQueryParams *DeregisterVirtualAccountQueryParams) (*DeregisterVirtualAccountResponse, *resty.Response, error)
+	DeregisterVirtualAccountWithContext(ctx context.Context, deregisterVirtualAccountQueryParams *DeregisterVirtualAccountQueryParams) (*DeregisterVirtualAccountResponse, *resty.Response, error)
+	GetDeviceByID(id string) (*GetDeviceByIDResponse, *resty.Response, error)
+	GetDeviceByIDWithContext(ctx context.Context, id string) (*GetDeviceByIDResponse, *resty.Response, error)
+	GetDeviceHistory(getDeviceHistoryQueryParams *GetDeviceHistoryQueryParams) (*GetDeviceHistoryResponse, *resty.Response, error)
+	GetDeviceHistoryWithContext(ctx context.Context, getDeviceHistoryQueryParams *GetDeviceHistoryQueryParams) (*GetDeviceHistoryResponse, *resty.Response, error)
+	GetPnPGlobalSettings() (*GetPnPGlobalSettingsResponse, *resty.Response, error)
+	GetPnPGlobalSettingsWithContext(ctx context.Context) (*GetPnPGlobalSettingsResponse, *resty.Response, error)
+	GetPnpDeviceCount(getPnpDeviceCountQueryParams *GetPnpDeviceCountQueryParams) (*GetPnpDeviceCountResponse, *resty.Response, error)
+	GetPnpDeviceCountWithContext(ctx context.Context, getPnpDeviceCountQueryParams *GetPnpDeviceCountQueryParams) (*GetPnpDeviceCountResponse, *resty.Response, error)
+	GetPnpDeviceList(getPnpDeviceListQueryParams *GetPnpDeviceListQueryParams) (*[]GetPnpDeviceListResponse, *resty.Response, error)
+	GetPnpDeviceListWithContext(ctx context.Context, getPnpDeviceListQueryParams *GetPnpDeviceListQueryParams) (*[]GetPnpDeviceListResponse, *resty.Response, error)
+	GetSmartAccountList() (*GetSmartAccountListResponse, *resty.Response, error)
+	GetSmartAccountListWithContext(ctx context.Context) (*GetSmartAccountListResponse, *resty.Response, error)
+	GetSyncResultForVirtualAccount(domain string, name string) (*GetSyncResultForVirtualAccountResponse, *resty.Response, error)
+	GetSyncResultForVirtualAccountWithContext(ctx context.Context, domain string, name string) (*GetSyncResultForVirtualAccountResponse, *resty.Response, error)
+	GetVirtualAccountList(domain string) (*GetVirtualAccountListResponse, *resty.Response, error)
+	GetVirtualAccountListWithContext(ctx context.Context, domain string) (*GetVirtualAccountListResponse, *resty.Response, error)
+	GetWorkflowByID(id string) (*GetWorkflowByIDResponse, *resty.Response, error)
+	GetWorkflowByIDWithContext(ctx context.Context, id string) (*GetWorkflowByIDResponse, *resty.Response, error)
+	GetWorkflowCount(getWorkflowCountQueryParams *GetWorkflowCountQueryParams) (*GetWorkflowCountResponse, *resty.Response, error)
+	GetWorkflowCountWithContext(ctx context.Context, getWorkflowCountQueryParams *GetWorkflowCountQueryParams) (*GetWorkflowCountResponse, *resty.Response, error)
+	GetWorkflows(getWorkflowsQueryParams *GetWorkflowsQueryParams) (*[]GetWorkflowsResponse, *resty.Response, error)
+	GetWorkflowsWithContext(ctx context.Context, getWorkflowsQueryParams *GetWorkflowsQueryParams) (*[]GetWorkflowsResponse, *resty.Response, error)
+	ImportDevicesInBulk(importDevicesInBulkRequest *ImportDevicesInBulkRequest) (*ImportDevicesInBulkResponse, *resty.Response, error)
+	ImportDevicesInBulkWithContext(ctx context.Context, importDevicesInBulkRequest *ImportDevicesInBulkRequest) (*ImportDevicesInBulkResponse, *resty.Response, error)
+	ListAllDevices(ctx context.Context, params *GetPnpDeviceListQueryParams, opts *PageOptions) *PnpDeviceIterator
+	PreviewConfig(previewConfigRequest *PreviewConfigRequest) (*PreviewConfigResponse, *resty.Response, error)
+	PreviewConfigWithContext(ctx context.Context, previewConfigRequest *PreviewConfigRequest) (*PreviewConfigResponse, *resty.Response, error)
+	ResetDevice(resetDeviceRequest *ResetDeviceRequest) (*ResetDeviceResponse, *resty.Response, error)
+	ResetDeviceWithContext(ctx context.Context, resetDeviceRequest *ResetDeviceRequest) (*ResetDeviceResponse, *resty.Response, error)
+	SyncVirtualAccountDevices(syncVirtualAccountDevicesRequest *SyncVirtualAccountDevicesRequest) (*SyncVirtualAccountDevicesResponse, *resty.Response, error)
+	SyncVirtualAccountDevicesWithContext(ctx context.Context, syncVirtualAccountDevicesRequest *SyncVirtualAccountDevicesRequest) (*SyncVirtualAccountDevicesResponse, *resty.Response, error)
+	UnClaimDevice(unClaimDeviceRequest *UnClaimDeviceRequest) (*UnClaimDeviceResponse, *resty.Response, error)
+	UnClaimDeviceWithContext(ctx context.Context, unClaimDeviceRequest *UnClaimDeviceRequest) (*UnClaimDeviceResponse, *resty.Response, error)
+	UpdateDevice(id string, updateDeviceRequest *UpdateDeviceRequest) (*UpdateDeviceResponse, *resty.Response, error)
+	UpdateDeviceWithContext(ctx context.Context, id string, updateDeviceRequest *UpdateDeviceRequest) (*UpdateDeviceResponse, *resty.Response, error)
+	UpdatePnPGlobalSettings(updatePnPGlobalSettingsRequest *UpdatePnPGlobalSettingsRequest) (*UpdatePnPGlobalSettingsResponse, *resty.Response, error)
+	UpdatePnPGlobalSettingsWithContext(ctx context.Context, updatePnPGlobalSettingsRequest *UpdatePnPGlobalSettingsRequest) (*UpdatePnPGlobalSettingsResponse, *resty.Response, error)
+	UpdatePnPServerProfile(updatePnPServerProfileRequest *UpdatePnPServerProfileRequest) (*UpdatePnPServerProfileResponse, *resty.Response, error)
+	UpdatePnPServerProfileWithContext(ctx context.Context, updatePnPServerProfileRequest *UpdatePnPServerProfileRequest) (*UpdatePnPServerProfileResponse, *resty.Response, error)
+	UpdateWorkflow(id string, updateWorkflowRequest *UpdateWorkflowRequest) (*UpdateWorkflowResponse, *resty.Response, error)
+	UpdateWorkflowWithContext(ctx context.Context, id string, updateWorkflowRequest *UpdateWorkflowRequest) (*UpdateWorkflowResponse, *resty.Response, error)
+}
+
+// ConfigurationTemplatesAPI is the set of methods of ConfigurationTemplatesService, implemented by it and by dnacmock.ConfigurationTemplatesAPI.
+type ConfigurationTemplatesAPI interface {
+	CreateProject(createProjectRequest *CreateProjectRequest) (*CreateProjectResponse, *resty.Response, error)
+	CreateProjectWithContext(ctx context.Context, createProjectRequest *CreateProjectRequest) (*CreateProjectResponse, *resty.Response, error)
+	CreateTemplate(projectID string, createTemplateRequest *CreateTemplateRequest) (*CreateTemplateResponse, *resty.Response, error)
+	CreateTemplateWithContext(ctx context.Context, projectID string, createTemplateRequest *CreateTemplateRequest) (*CreateTemplateResponse, *resty.Response, error)
+	DeleteProject(projectID string) (*DeleteProjectResponse, *resty.Response, error)
+	DeleteProjectWithContext(ctx context.Context, projectID string) (*DeleteProjectResponse, *resty.Response, error)
+	DeleteTemplate(templateID string) (*DeleteTemplateResponse, *resty.Response, error)
+	DeleteTemplateWithContext(ctx context.Context, templateID string) (*DeleteTemplateResponse, *resty.Response, error)
+	DeployTemplate(deployTemplateRequest *DeployTemplateRequest) (*DeployTemplateResponse, *resty.Response, error)
+	DeployTemplateChecked(ctx context.Context, request *DeployTemplateRequest) (*DeployTemplateResponse, *resty.Response, error)
+	DeployTemplateWithContext(ctx context.Context, deployTemplateRequest *DeployTemplateRequest) (*DeployTemplateResponse, *resty.Response, error)
+	GetProjects(getProjectsQueryParams *GetProjectsQueryParams) (*[]GetProjectsResponse, *resty.Response, error)
+	GetProjectsWithContext(ctx context.Context, getProjectsQueryParams *GetProjectsQueryParams) (*[]GetProjectsResponse, *resty.Response, error)
+	GetTemplateDeploymentStatus(deploymentID string) (*GetTemplateDeploymentStatusResponse, *resty.Response, error)
+	GetTemplateDeploymentStatusWithContext(ctx context.Context, deploymentID string) (*GetTemplateDeploymentStatusResponse, *resty.Response, error)
+	GetTemplateDetails(templateID string, getTemplateDetailsQueryParams *GetTemplateDetailsQueryParams) (*GetTemplateDetailsResponse, *resty.Response, error)
+	GetTemplateDetailsWithContext(ctx context.Context, templateID string, getTemplateDetailsQueryParams *GetTemplateDetailsQueryParams) (*GetTemplateDetailsResponse, *resty.Response, error)
+	GetTemplateVersions(templateID string) (*[]GetTemplateVersionsResponse, *resty.Response, error)
+	GetTemplateVersionsWithContext(ctx context.Context, templateID string) (*[]GetTemplateVersionsResponse, *resty.Response, error)
+	GetsTheTemplatesAvailable(getsTheTemplatesAvailableQueryParams *GetsTheTemplatesAvailableQueryParams) (*[]GetsTheTemplatesAvailableResponse, *resty.Response, error)
+	GetsTheTemplatesAvailableWithContext(ctx context.Context, getsTheTemplatesAvailableQueryParams *GetsTheTemplatesAvailableQueryParams) (*[]GetsTheTemplatesAvailableResponse, *resty.Response, error)
+	PreviewTemplate(previewTemplateRequest *PreviewTemplateRequest) (*PreviewTemplateResponse, *resty.Response, error)
+	PreviewTemplateChecked(ctx context.Context, request *PreviewTemplateRequest) (*PreviewTemplateResponse, *resty.Response, error)
+	PreviewTemplateWithContext(ctx context.Context, previewTemplateRequest *PreviewTemplateRequest) (*PreviewTemplateResponse, *resty.Response, error)
+	UpdateProject(updateProjectRequest *UpdateProjectRequest) (*UpdateProjectResponse, *resty.Response, error)
+	UpdateProjectWithContext(ctx context.Context, updateProjectRequest *UpdateProjectRequest) (*UpdateProjectResponse, *resty.Response, error)
+	UpdateTemplate(updateTemplateRequest *UpdateTemplateRequest) (*UpdateTemplateResponse, *resty.Response, error)
+	UpdateTemplateWithContext(ctx context.Context, updateTemplateRequest *UpdateTemplateRequest) (*UpdateTemplateResponse, *resty.Response, error)
+	ValidateDeployTemplate(ctx context.Context, request *DeployTemplateRequest) error
+	ValidatePreviewTemplate(ctx context.Context, request *PreviewTemplateRequest) error
+	VersionTemplate(versionTemplateRequest *VersionTemplateRequest) (*VersionTemplateResponse, *resty.Response, error)
+	VersionTemplateWithContext(ctx context.Context, versionTemplateRequest *VersionTemplateRequest) (*VersionTemplateResponse, *resty.Response, error)
+}
+
+// ConfigurationArchiveAPI is the set of methods of ConfigurationArchiveService, implemented by it and by dnacmock.ConfigurationArchiveAPI.
+type ConfigurationArchiveAPI interface {
+	ExportAndExtract(ctx context.Context, deviceIDs []string, password string, waitOpts *WaitForTaskOptions) (map[string]*DeviceConfiguration, error)
+	ExportDeviceConfigurations(exportDeviceConfigurationsRequest *ExportDeviceConfigurationsRequest) (*ExportDeviceConfigurationsResponse, *resty.Response, error)
+	ExportDeviceConfigurationsWithContext(ctx context.Context, exportDeviceConfigurationsRequest *ExportDeviceConfigurationsRequest) (*ExportDeviceConfigurationsResponse, *resty.Response, error)
+}
+
+// SDAAPI is the set of methods of SDAService, implemented by it and by dnacmock.SDAAPI.
+type SDAAPI interface {
+	AddControlPlaneDeviceInSDAFabric(addControlPlaneDeviceInSDAFabricRequest *[]AddControlPlaneDeviceInSDAFabricRequest) (*AddControlPlaneDeviceInSDAFabricResponse, *resty.Response, error)
+	AddControlPlaneDeviceInSDAFabricWithContext(ctx context.Context, addControlPlaneDeviceInSDAFabricRequest *[]AddControlPlaneDeviceInSDAFabricRequest) (*AddControlPlaneDeviceInSDAFabricResponse, *resty.Response, error)
+	AddDefaultAuthenticationProfileInSDAFabric(addDefaultAuthenticationProfileInSDAFabricRequest *[]AddDefaultAuthenticationProfileInSDAFabricRequest) (*AddDefaultAuthenticationProfileInSDAFabricResponse, *resty.Response, error)
+	AddDefaultAuthenticationProfileInSDAFabricWithContext(ctx context.Context, addDefaultAuthenticationProfileInSDAFabricRequest *[]AddDefaultAuthenticationProfileInSDAFabricRequest) (*AddDefaultAuthenticationProfileInSDAFabricResponse, *resty.Response, error)
+	AddEdgeDeviceInSDAFabric(addEdgeDeviceInSDAFabricRequest *[]AddEdgeDeviceInSDAFabricRequest) (*AddEdgeDeviceInSDAFabricResponse, *resty.Response, error)
+	AddEdgeDeviceInSDAFabricWithContext(ctx context.Context, addEdgeDeviceInSDAFabricRequest *[]AddEdgeDeviceInSDAFabricRequest) (*AddEdgeDeviceInSDAFabricResponse, *resty.Response, error)
+	AddFabric(addFabricRequest *[]AddFabricRequest) (*AddFabricResponse, *resty.Response, error)
+	AddFabricWithContext(ctx context.Context, addFabricRequest *[]AddFabricRequest) (*AddFabricResponse, *resty.Response, error)
+	AddIPPoolInSDAVirtualNetwork(addIPPoolInSDAVirtualNetworkRequest *[]AddIPPoolInSDAVirtualNetworkRequest) (*AddIPPoolInSDAVirtualNetworkResponse, *resty.Response, error)
+	AddIPPoolInSDAVirtualNetworkWithContext(ctx context.Context, addIPPoolInSDAVirtualNetworkRequest *[]AddIPPoolInSDAVirtualNetworkRequest) (*AddIPPoolInSDAVirtualNetworkResponse, *resty.Response, error)
+	AddPortAssignmentForAccessPointInSDAFabric(addPortAssignmentForAccessPointInSDAFabricRequest *[]AddPortAssignmentForAccessPointInSDAFabricRequest) (*AddPortAssignmentForAccessPointInSDAFabricResponse, *resty.Response, error)
+	AddPortAssignmentForAccessPointInSDAFabricWithContext(ctx context.Context, addPortAssignmentForAccessPointInSDAFabricRequest *[]AddPortAssignmentForAccessPointInSDAFabricRequest) (*AddPortAssignmentForAccessPointInSDAFabricResponse, *resty.Response, error)
+	AddPortAssignmentForUserDeviceInSDAFabric(addPortAssignmentForUserDeviceInSDAFabricRequest *[]AddPortAssignmentForUserDeviceInSDAFabricRequest) (*AddPortAssignmentForUserDeviceInSDAFabricResponse, *resty.Response, error)
+	AddPortAssignmentForUserDeviceInSDAFabricWithContext(ctx context.Context, addPortAssignmentForUserDeviceInSDAFabricRequest *[]AddPortAssignmentForUserDeviceInSDAFabricRequest) (*AddPortAssignmentForUserDeviceInSDAFabricResponse, *resty.Response, error)
+	AddSiteInSDAFabric(addSiteInSDAFabricRequest *[]AddSiteInSDAFabricRequest) (*AddSiteInSDAFabricResponse, *resty.Response, error)
+	AddSiteInSDAFabricWithContext(ctx context.Context, addSiteInSDAFabricRequest *[]AddSiteInSDAFabricRequest) (*AddSiteInSDAFabricResponse, *resty.Response, error)
+	AddVNInSDAFabric(addVNInSDAFabricRequest *[]AddVNInSDAFabricRequest) (*AddVNInSDAFabricResponse, *resty.Response, error)
+	AddVNInSDAFabricWithContext(ctx context.Context, addVNInSDAFabricRequest *[]AddVNInSDAFabricRequest) (*AddVNInSDAFabricResponse, *resty.Response, error)
+	AddsBorderDeviceInSDAFabric(addsBorderDeviceInSDAFabricRequest *[]AddsBorderDeviceInSDAFabricRequest) (*AddsBorderDeviceInSDAFabricResponse, *resty.Response, error)
+	AddsBorderDeviceInSDAFabricWithContext(ctx context.Context, addsBorderDeviceInSDAFabricRequest *[]AddsBorderDeviceInSDAFabricRequest) (*AddsBorderDeviceInSDAFabricResponse, *resty.Response, error)
+	ApplyFabricPlan(ctx context.Context, plan *FabricPlan, opts *WaitForTaskOptions) error
+	DeleteControlPlaneDeviceInSDAFabric(deleteControlPlaneDeviceInSDAFabricQueryParams *DeleteControlPlaneDeviceInSDAFabricQueryParams) (*DeleteControlPlaneDeviceInSDAFabricResponse, *resty.Response, error)
+	DeleteControlPlaneDeviceInSDAFabricWithContext(ctx context.Context, deleteControlPlaneDeviceInSDAFabricQueryParams *DeleteControlPlaneDeviceInSDAFabricQueryParams) (*DeleteControlPlaneDeviceInSDAFabricResponse, *resty.Response, error)
+	DeleteDefaultAuthenticationProfileFromSDAFabric(deleteDefaultAuthenticationProfileFromSDAFabricQueryParams *DeleteDefaultAuthenticationProfileFromSDAFabricQueryParams, deleteDefaultAuthenticationProfileFromSDAFabricRequest *[]DeleteDefaultAuthenticationProfileFromSDAFabricRequest) (*DeleteDefaultAuthenticationProfileFromSDAFabricResponse, *resty.Response, error)
+	DeleteDefaultAuthenticationProfileFromSDAFabricWithContext(ctx context.Context, deleteDefaultAuthenticationProfileFromSDAFabricQueryParams *DeleteDefaultAuthenticationProfileFromSDAFabricQueryParams, deleteDefaultAuthenticationProfileFromSDAFabricRequest *[]DeleteDefaultAuthenticationProfileFromSDAFabricRequest) (*DeleteDefaultAuthenticationProfileFromSDAFabricResponse, *resty.Response, error)
+	DeleteEdgeDeviceFromSDAFabric(deleteEdgeDeviceFromSDAFabricQueryParams *DeleteEdgeDeviceFromSDAFabricQueryParams) (*DeleteEdgeDeviceFromSDAFabricResponse, *resty.Response, error)
+	DeleteEdgeDeviceFromSDAFabricWithContext(ctx context.Context, deleteEdgeDeviceFromSDAFabricQueryParams *DeleteEdgeDeviceFromSDAFabricQueryParams) (*DeleteEdgeDeviceFromSDAFabricResponse, *resty.Response, error)
+	DeleteIPPoolFromSDAVirtualNetwork(deleteIPPoolFromSDAVirtualNetworkQueryParams *DeleteIPPoolFromSDAVirtualNetworkQueryParams, deleteIPPoolFromSDAVirtualNetworkRequest *[]DeleteIPPoolFromSDAVirtualNetworkRequest) (*DeleteIPPoolFromSDAVirtualNetworkResponse, *resty.Response, error)
+	DeleteIPPoolFromSDAVirtualNetworkWithContext(ctx context.Context, deleteIPPoolFromSDAVirtualNetworkQueryParams *DeleteIPPoolFromSDAVirtualNetworkQueryParams, deleteIPPoolFromSDAVirtualNetworkRequest *[]DeleteIPPoolFromSDAVirtualNetworkRequest) (*DeleteIPPoolFromSDAVirtualNetworkResponse, *resty.Response, error)
+	DeletePortAssignmentForAccessPointInSDAFabric(deletePortAssignmentForAccessPointInSDAFabricQueryParams *DeletePortAssignmentForAccessPointInSDAFabricQueryParams, deletePortAssignmentForAccessPointInSDAFabricRequest *[]DeletePortAssignmentForAccessPointInSDAFabricRequest) (*DeletePortAssignmentForAccessPointInSDAFabricResponse, *resty.Response, error)
+	DeletePortAssignmentForAccessPointInSDAFabricWithContext(ctx context.Context, deletePortAssignmentForAccessPointInSDAFabricQueryParams *DeletePortAssignmentForAccessPointInSDAFabricQueryParams, deletePortAssignmentForAccessPointInSDAFabricRequest *[]DeletePortAssignmentForAccessPointInSDAFabricRequest) (*DeletePortAssignmentForAccessPointInSDAFabricResponse, *resty.Response, error)
+	DeletePortAssignmentForUserDeviceInSDAFabric(deletePortAssignmentForUserDeviceInSDAFabricQueryParams *DeletePortAssignmentForUserDeviceInSDAFabricQueryParams, deletePortAssignmentForUserDeviceInSDAFabricRequest *[]DeletePortAssignmentForUserDeviceInSDAFabricRequest) (*DeletePortAssignmentForUserDeviceInSDAFabricResponse, *resty.Response, error)
+	DeletePortAssignmentForUserDeviceInSDAFabricWithContext(ctx context.Context, deletePortAssignmentForUserDeviceInSDAFabricQueryParams *DeletePortAssignmentForUserDeviceInSDAFabricQueryParams, deletePortAssignmentForUserDeviceInSDAFabricRequest *[]DeletePortAssignmentForUserDeviceInSDAFabricRequest) (*DeletePortAssignmentForUserDeviceInSDAFabricResponse, *resty.Response, error)
+	DeleteSDAFabric(deleteSDAFabricQueryParams *DeleteSDAFabricQueryParams, deleteSDAFabricRequest *[]DeleteSDAFabricRequest) (*DeleteSDAFabricResponse, *resty.Response, error)
+	DeleteSDAFabricWithContext(ctx context.Context, deleteSDAFabricQueryParams *DeleteSDAFabricQueryParams, deleteSDAFabricRequest *[]DeleteSDAFabricRequest) (*DeleteSDAFabricResponse, *resty.Response, error)
+	DeleteSiteFromSDAFabric(deleteSiteFromSDAFabricQueryParams *DeleteSiteFromSDAFabricQueryParams, deleteSiteFromSDAFabricRequest *[]DeleteSiteFromSDAFabricRequest) (*DeleteSiteFromSDAFabricResponse, *resty.Response, error)
+	DeleteSiteFromSDAFabricWithContext(ctx context.Context, deleteSiteFromSDAFabricQueryParams *DeleteSiteFromSDAFabricQueryParams, deleteSiteFromSDAFabricRequest *[]DeleteSiteFromSDAFabricRequest) (*DeleteSiteFromSDAFabricResponse, *resty.Response, error)
+	DeleteVNFromSDAFabric(deleteVNFromSDAFabricQueryParams *DeleteVNFromSDAFabricQueryParams) (*DeleteVNFromSDAFabricResponse, *resty.Response, error)
+	DeleteVNFromSDAFabricWithContext(ctx context.Context, deleteVNFromSDAFabricQueryParams *DeleteVNFromSDAFabricQueryParams) (*DeleteVNFromSDAFabricResponse, *resty.Response, error)
+	DeletesBorderDeviceFromSDAFabric(deletesBorderDeviceFromSDAFabricQueryParams *DeletesBorderDeviceFromSDAFabricQueryParams) (*DeletesBorderDeviceFromSDAFabricResponse, *resty.Response, error)
+	DeletesBorderDeviceFromSDAFabricWithContext(ctx context.Context, deletesBorderDeviceFromSDAFabricQueryParams *DeletesBorderDeviceFromSDAFabricQueryParams) (*DeletesBorderDeviceFromSDAFabricResponse, *resty.Response, error)
+	GetControlPlaneDeviceFromSDAFabric(getControlPlaneDeviceFromSDAFabricQueryParams *GetControlPlaneDeviceFromSDAFabricQueryParams) (*GetControlPlaneDeviceFromSDAFabricResponse, *resty.Response, error)
+	GetControlPlaneDeviceFromSDAFabricWithContext(ctx context.Context, getControlPlaneDeviceFromSDAFabricQueryParams *GetControlPlaneDeviceFromSDAFabricQueryParams) (*GetControlPlaneDeviceFromSDAFabricResponse, *resty.Response, error)
+	GetDefaultAuthenticationProfileFromSDAFabric(getDefaultAuthenticationProfileFromSDAFabricQueryParams *GetDefaultAuthenticationProfileFromSDAFabricQueryParams) (*GetDefaultAuthenticationProfileFromSDAFabricResponse, *resty.Response, error)
+	GetDefaultAuthenticationProfileFromSDAFabricWithContext(ctx context.Context, getDefaultAuthenticationProfileFromSDAFabricQueryParams *GetDefaultAuthenticationProfileFromSDAFabricQueryParams) (*GetDefaultAuthenticationProfileFromSDAFabricResponse, *resty.Response, error)
+	GetDeviceInfoFromSDAFabric(getDeviceInfoFromSDAFabricQueryParams *GetDeviceInfoFromSDAFabricQueryParams) (*GetDeviceInfoFromSDAFabricResponse, *resty.Response, error)
+	GetDeviceInfoFromSDAFabricWithContext(ctx context.Context, getDeviceInfoFromSDAFabricQueryParams *GetDeviceInfoFromSDAFabricQueryParams) (*GetDeviceInfoFromSDAFabricResponse, *resty.Response, error)
+	GetDeviceRoleInSDAFabric(getDeviceRoleInSDAFabricQueryParams *GetDeviceRoleInSDAFabricQueryParams) (*GetDeviceRoleInSDAFabricResponse, *resty.Response, error)
+	GetDeviceRoleInSDAFabricWithContext(ctx context.Context, getDeviceRoleInSDAFabricQueryParams *GetDeviceRoleInSDAFabricQueryParams) (*GetDeviceRoleInSDAFabricResponse, *resty.Response, error)
+	GetEdgeDeviceFromSDAFabric(getEdgeDeviceFromSDAFabricQueryParams *GetEdgeDeviceFromSDAFabricQueryParams) (*GetEdgeDeviceFromSDAFabricResponse, *resty.Response, error)
+	GetEdgeDeviceFromSDAFabricWithContext(ctx context.Context, getEdgeDeviceFromSDAFabricQueryParams *GetEdgeDeviceFromSDAFabricQueryParams) (*GetEdgeDeviceFromSDAFabricResponse, *resty.Response, error)
+	GetIPPoolFromSDAVirtualNetwork(getIPPoolFromSDAVirtualNetworkQueryParams *GetIPPoolFromSDAVirtualNetworkQueryParams) (*GetIPPoolFromSDAVirtualNetworkResponse, *resty.Response, error)
+	GetIPPoolFromSDAVirtualNetworkWithContext(ctx context.Context, getIPPoolFromSDAVirtualNetworkQueryParams *GetIPPoolFromSDAVirtualNetworkQueryParams) (*GetIPPoolFromSDAVirtualNetworkResponse, *resty.Response, error)
+	GetPortAssignmentForAccessPointInSDAFabric(getPortAssignmentForAccessPointInSDAFabricQueryParams *GetPortAssignmentForAccessPointInSDAFabricQueryParams) (*GetPortAssignmentForAccessPointInSDAFabricResponse, *resty.Response, error)
+	GetPortAssignmentForAccessPointInSDAFabricWithContext(ctx context.Context, getPortAssignmentForAccessPointInSDAFabricQueryParams *GetPortAssignmentForAccessPointInSDAFabricQueryParams) (*GetPortAssignmentForAccessPointInSDAFabricResponse, *resty.Response, error)
+	GetPortAssignmentForUserDeviceInSDAFabric(getPortAssignmentForUserDeviceInSDAFabricQueryParams *GetPortAssignmentForUserDeviceInSDAFabricQueryParams) (*GetPortAssignmentForUserDeviceInSDAFabricResponse, *resty.Response, error)
+	GetPortAssignmentForUserDeviceInSDAFabricWithContext(ctx context.Context, getPortAssignmentForUserDeviceInSDAFabricQueryParams *GetPortAssignmentForUserDeviceInSDAFabricQueryParams) (*GetPortAssignmentForUserDeviceInSDAFabricResponse, *resty.Response, error)
+	GetSDAFabricCount() (*GetSDAFabricCountResponse, *resty.Response, error)
+	GetSDAFabricCountWithContext(ctx context.Context) (*GetSDAFabricCountResponse, *resty.Response, error)
+	GetSDAFabricInfo(getSDAFabricInfoQueryParams *GetSDAFabricInfoQueryParams) (*GetSDAFabricInfoResponse, *resty.Response, error)
+	GetSDAFabricInfoWithContext(ctx context.Context, getSDAFabricInfoQueryParams *GetSDAFabricInfoQueryParams) (*GetSDAFabricInfoResponse, *resty.Response, error)
+	GetSiteFromSDAFabric(getSiteFromSDAFabricQueryParams *GetSiteFromSDAFabricQueryParams) (*GetSiteFromSDAFabricResponse, *resty.Response, error)
+	GetSiteFromSDAFabricWithContext(ctx context.Context, getSiteFromSDAFabricQueryParams *GetSiteFromSDAFabricQueryParams) (*GetSiteFromSDAFabricResponse, *resty.Response, error)
+	GetVNFromSDAFabric(getVNFromSDAFabricQueryParams *GetVNFromSDAFabricQueryParams) (*GetVNFromSDAFabricResponse, *resty.Response, error)
+	GetVNFromSDAFabricWithContext(ctx context.Context, getVNFromSDAFabricQueryParams *GetVNFromSDAFabricQueryParams) (*GetVNFromSDAFabricResponse, *resty.Response, error)
+	GetsBorderDeviceDetailFromSDAFabric(getsBorderDeviceDetailFromSDAFabricQueryParams *GetsBorderDeviceDetailFromSDAFabricQueryParams) (*GetsBorderDeviceDetailFromSDAFabricResponse, *resty.Response, error)
+	GetsBorderDeviceDetailFromSDAFabricWithContext(ctx context.Context, getsBorderDeviceDetailFromSDAFabricQueryParams *GetsBorderDeviceDetailFromSDAFabricQueryParams) (*GetsBorderDeviceDetailFromSDAFabricResponse, *resty.Response, error)
+	PlanFabric(ctx context.Context, spec *FabricSpec) (*FabricPlan, error)
+	Reconcile(ctx context.Context, spec *FabricSpec, opts *ReconcileOptions) (*FabricPlan, error)
+	UpdateDefaultAuthenticationProfileInSDAFabric(updateDefaultAuthenticationProfileInSDAFabricRequest *[]UpdateDefaultAuthenticationProfileInSDAFabricRequest) (*UpdateDefaultAuthenticationProfileInSDAFabricResponse, *resty.Response, error)
+	UpdateDefaultAuthenticationProfileInSDAFabricWithContext(ctx context.Context, updateDefaultAuthenticationProfileInSDAFabricRequest *[]UpdateDefaultAuthenticationProfileInSDAFabricRequest) (*UpdateDefaultAuthenticationProfileInSDAFabricResponse, *resty.Response, error)
+}
+
+// SensorsAPI is the set of methods of SensorsService, implemented by it and by dnacmock.SensorsAPI.
+type SensorsAPI interface {
+	CreateSensorTestTemplate(createSensorTestTemplateRequest *CreateSensorTestTemplateRequest) (*CreateSensorTestTemplateResponse, *resty.Response, error)
+	CreateSensorTestTemplateWithContext(ctx context.Context, createSensorTestTemplateRequest *CreateSensorTestTemplateRequest) (*CreateSensorTestTemplateResponse, *resty.Response, error)
+	DeleteSensorTest(deleteSensorTestQueryParams *DeleteSensorTestQueryParams) (*DeleteSensorTestResponse, *resty.Response, error)
+	DeleteSensorTestWithContext(ctx context.Context, deleteSensorTestQueryParams *DeleteSensorTestQueryParams) (*DeleteSensorTestResponse, *resty.Response, error)
+	DuplicateSensorTestTemplate(duplicateSensorTestTemplateRequest *DuplicateSensorTestTemplateRequest) (*DuplicateSensorTestTemplateResponse, *resty.Response, error)
+	DuplicateSensorTestTemplateWithContext(ctx context.Context, duplicateSensorTestTemplateRequest *DuplicateSensorTestTemplateRequest) (*DuplicateSensorTestTemplateResponse, *resty.Response, error)
+	EditSensorTestTemplate(editSensorTestTemplateRequest *EditSensorTestTemplateRequest) (*EditSensorTestTemplateResponse, *resty.Response, error)
+	EditSensorTestTemplateWithContext(ctx context.Context, editSensorTestTemplateRequest *EditSensorTestTemplateRequest) (*EditSensorTestTemplateResponse, *resty.Response, error)
+	RunNowSensorTest(runNowSensorTestRequest *RunNowSensorTestRequest) (*resty.Response, error)
+	RunNowSensorTestWithContext(ctx context.Context, runNowSensorTestRequest *RunNowSensorTestRequest) (*resty.Response, error)
+	Sensors(sensorsQueryParams *SensorsQueryParams) (*SensorsResponse, *resty.Response, error)
+	SensorsWithContext(ctx context.Context, sensorsQueryParams *SensorsQueryParams) (*SensorsResponse, *resty.Response, error)
+}
+
+// WirelessAPI is the set of methods of WirelessService, implemented by it and by dnacmock.WirelessAPI.
+type WirelessAPI interface {
+	APProvision(aPProvisionRequest *[]APProvisionRequest) (*APProvisionResponse, *resty.Response, error)
+	APProvisionWithContext(ctx context.Context, aPProvisionRequest *[]APProvisionRequest) (*APProvisionResponse, *resty.Response, error)
+	CreateAndProvisionSSID(createAndProvisionSSIDRequest *CreateAndProvisionSSIDRequest) (*CreateAndProvisionSSIDResponse, *resty.Response, error)
+	CreateAndProvisionSSIDWithContext(ctx context.Context, createAndProvisionSSIDRequest *CreateAndProvisionSSIDRequest) (*CreateAndProvisionSSIDResponse, *resty.Response, error)
+	CreateEnterpriseSSID(createEnterpriseSSIDRequest *CreateEnterpriseSSIDRequest) (*CreateEnterpriseSSIDResponse, *resty.Response, error)
+	CreateEnterpriseSSIDWithContext(ctx context.Context, createEnterpriseSSIDRequest *CreateEnterpriseSSIDRequest) (*CreateEnterpriseSSIDResponse, *resty.Response, error)
+	CreateOrUpdateRFProfile(createOrUpdateRFProfileRequest *CreateOrUpdateRFProfileRequest) (*CreateOrUpdateRFProfileResponse, *resty.Response, error)
+	CreateOrUpdateRFProfileWithContext(ctx context.Context, createOrUpdateRFProfileRequest *CreateOrUpdateRFProfileRequest) (*CreateOrUpdateRFProfileResponse, *resty.Response, error)
+	CreateWirelessProfile(createWirelessProfileRequest *CreateWirelessProfileRequest) (*CreateWirelessProfileResponse, *resty.Response, error)
+	CreateWirelessProfileWithContext(ctx context.Context, createWirelessProfileRequest *CreateWirelessProfileRequest) (*CreateWirelessProfileResponse, *resty.Response, error)
+	DeleteEnterpriseSSID(ssidName string) (*DeleteEnterpriseSSIDResponse, *resty.Response, error)
+	DeleteEnterpriseSSIDWithContext(ctx context.Context, ssidName string) (*DeleteEnterpriseSSIDResponse, *resty.Response, error)
+	DeleteRFProfiles(rfProfileName string) (*DeleteRFProfilesResponse, *resty.Response, error)
+	DeleteRFProfilesWithContext(ctx context.Context, rfProfileName string) (*DeleteRFProfilesResponse, *resty.Response, error)
+	DeleteSSIDAndProvisionItToDevices(ssidName string, managedAPLocations string) (*DeleteSSIDAndProvisionItToDevicesResponse, *resty.Response, error)
+	DeleteSSIDAndProvisionItToDevicesWithContext(ctx context.Context, ssidName string, managedAPLocations string) (*DeleteSSIDAndProvisionItToDevicesResponse, *resty.Response, error)
+	GetEnterpriseSSID(getEnterpriseSSIDQueryParams *GetEnterpriseSSIDQueryParams) (*GetEnterpriseSSIDResponse, *resty.Response, error)
+	GetEnterpriseSSIDWithContext(ctx context.Context, getEnterpriseSSIDQueryParams *GetEnterpriseSSIDQueryParams) (*GetEnterpriseSSIDResponse, *resty.Response, error)
+	GetWirelessProfile(getWirelessProfileQueryParams *GetWirelessProfileQueryParams) (*GetWirelessProfileResponse, *resty.Response, error)
+	GetWirelessProfileWithContext(ctx context.Context, getWirelessProfileQueryParams *GetWirelessProfileQueryParams) (*GetWirelessProfileResponse, *resty.Response, error)
+	Provision(provisionRequest *[]ProvisionRequest) (*ProvisionResponse, *resty.Response, error)
+	ProvisionUpdate(provisionUpdateRequest *[]ProvisionUpdateRequest) (*ProvisionUpdateResponse, *resty.Response, error)
+	ProvisionUpdateWithContext(ctx context.Context, provisionUpdateRequest *[]ProvisionUpdateRequest) (*ProvisionUpdateResponse, *resty.Response, error)
+	ProvisionWithContext(ctx context.Context, provisionRequest *[]ProvisionRequest) (*ProvisionResponse, *resty.Response, error)
+	RetrieveRFProfiles(retrieveRFProfilesQueryParams *RetrieveRFProfilesQueryParams) (*RetrieveRFProfilesResponse, *resty.Response, error)
+	RetrieveRFProfilesWithContext(ctx context.Context, retrieveRFProfilesQueryParams *RetrieveRFProfilesQueryParams) (*RetrieveRFProfilesResponse, *resty.Response, error)
+	SensorTestResults(sensorTestResultsQueryParams *SensorTestResultsQueryParams) (*SensorTestResultsResponse, *resty.Response, error)
+	SensorTestResultsWithContext(ctx context.Context, sensorTestResultsQueryParams *SensorTestResultsQueryParams) (*SensorTestResultsResponse, *resty.Response, error)
+	UpdateWirelessProfile(updateWirelessProfileRequest *UpdateWirelessProfileRequest) (*UpdateWirelessProfileResponse, *resty.Response, error)
+	UpdateWirelessProfileWithContext(ctx context.Context, updateWirelessProfileRequest *UpdateWirelessProfileRequest) (*UpdateWirelessProfileResponse, *resty.Response, error)
+}
+
+// CommandRunnerAPI is the set of methods of CommandRunnerService, implemented by it and by dnacmock.CommandRunnerAPI.
+type CommandRunnerAPI interface {
+	GetAllKeywordsOfCLIsAcceptedByCommandRunner() (*GetAllKeywordsOfCLIsAcceptedByCommandRunnerResponse, *resty.Response, error)
+	GetAllKeywordsOfCLIsAcceptedByCommandRunnerWithContext(ctx context.Context) (*GetAllKeywordsOfCLIsAcceptedByCommandRunnerResponse, *resty.Response, error)
+	Run(ctx context.Context, deviceUUIDs []string, commands []string, opts *CommandRunnerOptions) (CommandRunnerResult, error)
+	RunReadOnlyCommandsOnDevicesToGetTheirRealTimeConfiguration(runReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationRequest *RunReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationRequest) (*RunReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationResponse, *resty.Response, error)
+	RunReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationWithContext(ctx context.Context, runReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationRequest *RunReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationRequest) (*RunReadOnlyCommandsOnDevicesToGetTheirRealTimeConfigurationResponse, *resty.Response, error)
+}
+
+// DiscoveryAPI is the set of methods of DiscoveryService, implemented by it and by dnacmock.DiscoveryAPI.
+type DiscoveryAPI interface {
+	CreateCLICredentials(createCLICredentialsRequest *[]CreateCLICredentialsRequest) (*CreateCLICredentialsResponse, *resty.Response, error)
+	CreateCLICredentialsWithContext(ctx context.Context, createCLICredentialsRequest *[]CreateCLICredentialsRequest) (*CreateCLICredentialsResponse, *resty.Response, error)
+	CreateHTTPReadCredentials(createHTTPReadCredentialsRequest *[]CreateHTTPReadCredentialsRequest) (*CreateHTTPReadCredentialsResponse, *resty.Response, error)
+	CreateHTTPReadCredentialsWithContext(ctx context.Context, createHTTPReadCredentialsRequest *[]CreateHTTPReadCredentialsRequest) (*CreateHTTPReadCredentialsResponse, *resty.Response, error)
+	CreateHTTPWriteCredentials(createHTTPWriteCredentialsRequest *[]CreateHTTPWriteCredentialsRequest) (*CreateHTTPWriteCredentialsResponse, *resty.Response, error)
+	CreateHTTPWriteCredentialsWithContext(ctx context.Context, createHTTPWriteCredentialsRequest *[]CreateHTTPWriteCredentialsRequest) (*CreateHTTPWriteCredentialsResponse, *resty.Response, error)
+	CreateNetconfCredentials(createNetconfCredentialsRequest *[]CreateNetconfCredentialsRequest) (*CreateNetconfCredentialsResponse, *resty.Response, error)
+	CreateNetconfCredentialsWithContext(ctx context.Context, createNetconfCredentialsRequest *[]CreateNetconfCredentialsRequest) (*CreateNetconfCredentialsResponse, *resty.Response, error)
+	CreateSNMPReadCommunity(createSNMPReadCommunityRequest *[]CreateSNMPReadCommunityRequest) (*CreateSNMPReadCommunityResponse, *resty.Response, error)
+	CreateSNMPReadCommunityWithContext(ctx context.Context, createSNMPReadCommunityRequest *[]CreateSNMPReadCommunityRequest) (*CreateSNMPReadCommunityResponse, *resty.Response, error)
+	CreateSNMPWriteCommunity(createSNMPWriteCommunityRequest *[]CreateSNMPWriteCommunityRequest) (*CreateSNMPWriteCommunityResponse, *resty.Response, error)
+	CreateSNMPWriteCommunityWithContext(ctx context.Context, createSNMPWriteCommunityRequest *[]CreateSNMPWriteCommunityRequest) (*CreateSNMPWriteCommunityResponse, *resty.Response, error)
+	CreateSNMPv3Credentials(createSNMPv3CredentialsRequest *[]CreateSNMPv3CredentialsRequest) (*CreateSNMPv3CredentialsResponse, *resty.Response, error)
+	CreateSNMPv3CredentialsWithContext(ctx context.Context, createSNMPv3CredentialsRequest *[]CreateSNMPv3CredentialsRequest) (*CreateSNMPv3CredentialsResponse, *resty.Response, error)
+	CreateUpdateSNMPProperties(createUpdateSNMPPropertiesRequest *[]CreateUpdateSNMPPropertiesRequest) (*CreateUpdateSNMPPropertiesResponse, *resty.Response, error)
+	CreateUpdateSNMPPropertiesWithContext(ctx context.Context, createUpdateSNMPPropertiesRequest *[]CreateUpdateSNMPPropertiesRequest) (*CreateUpdateSNMPPropertiesResponse, *resty.Response, error)
+	DeleteAllDiscovery() (*DeleteAllDiscoveryResponse, *resty.Response, error)
+	DeleteAllDiscoveryWithContext(ctx context.Context) (*DeleteAllDiscoveryResponse, *resty.Response, error)
+	DeleteDiscoveryByID(id string) (*DeleteDiscoveryByIDResponse, *resty.Response, error)
+	DeleteDiscoveryByIDWithContext(ctx context.Context, id string) (*DeleteDiscoveryByIDResponse, *resty.Response, error)
+	DeleteDiscoveryBySpecifiedRange(startIndex int, recordsToDelete int) (*DeleteDiscoveryBySpecifiedRangeResponse, *resty.Response, error)
+	DeleteDiscoveryBySpecifiedRangeWithContext(ctx context.Context, startIndex int, recordsToDelete int) (*DeleteDiscoveryBySpecifiedRangeResponse, *resty.Response, error)
+	DeleteGlobalCredentialsByID(globalCredentialID string) (*DeleteGlobalCredentialsByIDResponse, *resty.Response, error)
+	DeleteGlobalCredentialsByIDWithContext(ctx context.Context, globalCredentialID string) (*DeleteGlobalCredentialsByIDResponse, *resty.Response, error)
+	GetCountOfAllDiscoveryJobs() (*GetCountOfAllDiscoveryJobsResponse, *resty.Response, error)
+	GetCountOfAllDiscoveryJobsWithContext(ctx context.Context) (*GetCountOfAllDiscoveryJobsResponse, *resty.Response, error)
+	GetCredentialSubTypeByCredentialID(id string) (*GetCredentialSubTypeByCredentialIDResponse, *resty.Response, error)
+	GetCredentialSubTypeByCredentialIDWithContext(ctx context.Context, id string) (*GetCredentialSubTypeByCredentialIDResponse, *resty.Response, error)
+	GetDevicesDiscoveredByID(id string, getDevicesDiscoveredByIDQueryParams *GetDevicesDiscoveredByIDQueryParams) (*GetDevicesDiscoveredByIDResponse, *resty.Response, error)
+	GetDevicesDiscoveredByIDWithContext(ctx context.Context, id string, getDevicesDiscoveredByIDQueryParams *GetDevicesDiscoveredByIDQueryParams) (*GetDevicesDiscoveredByIDResponse, *resty.Response, error)
+	GetDiscoveredDevicesByRange(id string, startIndex int, recordsToReturn int, getDiscoveredDevicesByRangeQueryParams *GetDiscoveredDevicesByRangeQueryParams) (*GetDiscoveredDevicesByRangeResponse, *resty.Response, error)
+	GetDiscoveredDevicesByRangeWithContext(ctx context.Context, id string, startIndex int, recordsToReturn int, getDiscoveredDevicesByRangeQueryParams *GetDiscoveredDevicesByRangeQueryParams) (*GetDiscoveredDevicesByRangeResponse, *resty.Response, error)
+	GetDiscoveredNetworkDevicesByDiscoveryID(id string, getDiscoveredNetworkDevicesByDiscoveryIDQueryParams *GetDiscoveredNetworkDevicesByDiscoveryIDQueryParams) (*GetDiscoveredNetworkDevicesByDiscoveryIDResponse, *resty.Response, error)
+	GetDiscoveredNetworkDevicesByDiscoveryIDWithContext(ctx context.Context, id string, getDiscoveredNetworkDevicesByDiscoveryIDQueryParams *GetDiscoveredNetworkDevicesByDiscoveryIDQueryParams) (*GetDiscoveredNetworkDevicesByDiscoveryIDResponse, *resty.Response, error)
+	GetDiscoveriesByRange(startIndex int, recordsToReturn int) (*GetDiscoveriesByRangeResponse, *resty.Response, error)
+	GetDiscoveriesByRangeWithContext(ctx context.Context, startIndex int, recordsToReturn int) (*GetDiscoveriesByRangeResponse, *resty.Response, error)
+	GetDiscoveryByID(id string) (*GetDiscoveryByIDResponse, *resty.Response, error)
+	GetDiscoveryByIDWithContext(ctx context.Context, id string) (*GetDiscoveryByIDResponse, *resty.Response, error)
+	GetDiscoveryJobsByIP(getDiscoveryJobsByIPQueryParams *GetDiscoveryJobsByIPQueryParams) (*GetDiscoveryJobsByIPResponse, *resty.Response, error)
+	GetDiscoveryJobsByIPWithContext(ctx context.Context, getDiscoveryJobsByIPQueryParams *GetDiscoveryJobsByIPQueryParams) (*GetDiscoveryJobsByIPResponse, *resty.Response, error)
+	GetGlobalCredentials(getGlobalCredentialsQueryParams *GetGlobalCredentialsQueryParams) (*GetGlobalCredentialsResponse, *resty.Response, error)
+	GetGlobalCredentialsWithContext(ctx context.Context, getGlobalCredentialsQueryParams *GetGlobalCredentialsQueryParams) (*GetGlobalCredentialsResponse, *resty.Response, error)
+	GetListOfDiscoveriesByDiscoveryID(id string, getListOfDiscoveriesByDiscoveryIDQueryParams *GetListOfDiscoveriesByDiscoveryIDQueryParams) (*GetListOfDiscoveriesByDiscoveryIDResponse, *resty.Response, error)
+	GetListOfDiscoveriesByDiscoveryIDWithContext(ctx context.Context, id string, getListOfDiscoveriesByDiscoveryIDQueryParams *GetListOfDiscoveriesByDiscoveryIDQueryParams) (*GetListOfDiscoveriesByDiscoveryIDResponse, *resty.Response, error)
+	GetNetworkDevicesFromDiscovery(id string, getNetworkDevicesFromDiscoveryQueryParams *GetNetworkDevicesFromDiscoveryQueryParams) (*GetNetworkDevicesFromDiscoveryResponse, *resty.Response, error)
+	GetNetworkDevicesFromDiscoveryWithContext(ctx context.Context, id string, getNetworkDevicesFromDiscoveryQueryParams *GetNetworkDevicesFromDiscoveryQueryParams) (*GetNetworkDevicesFromDiscoveryResponse, *resty.Response, error)
+	GetSNMPProperties() (*GetSNMPPropertiesResponse, *resty.Response, error)
+	GetSNMPPropertiesWithContext(ctx context.Context) (*GetSNMPPropertiesResponse, *resty.Response, error)
+	ListAll(ctx context.Context, opts *PageOptions) *DiscoveryIterator
+	StartDiscovery(startDiscoveryRequest *StartDiscoveryRequest) (*StartDiscoveryResponse, *resty.Response, error)
+	StartDiscoveryWithContext(ctx context.Context, startDiscoveryRequest *StartDiscoveryRequest) (*StartDiscoveryResponse, *resty.Response, error)
+	UpdateCLICredentials(updateCLICredentialsRequest *UpdateCLICredentialsRequest) (*UpdateCLICredentialsResponse, *resty.Response, error)
+	UpdateCLICredentialsWithContext(ctx context.Context, updateCLICredentialsRequest *UpdateCLICredentialsRequest) (*UpdateCLICredentialsResponse, *resty.Response, error)
+	UpdateGlobalCredentials(globalCredentialID string, updateGlobalCredentialsRequest *UpdateGlobalCredentialsRequest) (*UpdateGlobalCredentialsResponse, *resty.Response, error)
+	UpdateGlobalCredentialsWithContext(ctx context.Context, globalCredentialID string, updateGlobalCredentialsRequest *UpdateGlobalCredentialsRequest) (*UpdateGlobalCredentialsResponse, *resty.Response, error)
+	UpdateHTTPReadCredential(updateHTTPReadCredentialRequest *UpdateHTTPReadCredentialRequest) (*UpdateHTTPReadCredentialResponse, *resty.Response, error)
+	UpdateHTTPReadCredentialWithContext(ctx context.Context, updateHTTPReadCredentialRequest *UpdateHTTPReadCredentialRequest) (*UpdateHTTPReadCredentialResponse, *resty.Response, error)
+	UpdateHTTPWriteCredentials(updateHTTPWriteCredentialsRequest *UpdateHTTPWriteCredentialsRequest) (*UpdateHTTPWriteCredentialsResponse, *resty.Response, error)
+	UpdateHTTPWriteCredentialsWithContext(ctx context.Context, updateHTTPWriteCredentialsRequest *UpdateHTTPWriteCredentialsRequest) (*UpdateHTTPWriteCredentialsResponse, *resty.Response, error)
+	UpdateNetconfCredentials(updateNetconfCredentialsRequest *UpdateNetconfCredentialsRequest) (*UpdateNetconfCredentialsResponse, *resty.Response, error)
+	UpdateNetconfCredentialsWithContext(ctx context.Context, updateNetconfCredentialsRequest *UpdateNetconfCredentialsRequest) (*UpdateNetconfCredentialsResponse, *resty.Response, error)
+	UpdateSNMPReadCommunity(updateSNMPReadCommunityRequest *UpdateSNMPReadCommunityRequest) (*UpdateSNMPReadCommunityResponse, *resty.Response, error)
+	UpdateSNMPReadCommunityWithContext(ctx context.Context, updateSNMPReadCommunityRequest *UpdateSNMPReadCommunityRequest) (*UpdateSNMPReadCommunityResponse, *resty.Response, error)
+	UpdateSNMPWriteCommunity(updateSNMPWriteCommunityRequest *UpdateSNMPWriteCommunityRequest) (*UpdateSNMPWriteCommunityResponse, *resty.Response, error)
+	UpdateSNMPWriteCommunityWithContext(ctx context.Context, updateSNMPWriteCommunityRequest *UpdateSNMPWriteCommunityRequest) (*UpdateSNMPWriteCommunityResponse, *resty.Response, error)
+	UpdateSNMPv3Credentials(updateSNMPv3CredentialsRequest *UpdateSNMPv3CredentialsRequest) (*UpdateSNMPv3CredentialsResponse, *resty.Response, error)
+	UpdateSNMPv3CredentialsWithContext(ctx context.Context, updateSNMPv3CredentialsRequest *UpdateSNMPv3CredentialsRequest) (*UpdateSNMPv3CredentialsResponse, *resty.Response, error)
+	UpdatesAnExistingDiscoveryBySpecifiedID(updatesAnExistingDiscoveryBySpecifiedIDRequest *UpdatesAnExistingDiscoveryBySpecifiedIDRequest) (*UpdatesAnExistingDiscoveryBySpecifiedIDResponse, *resty.Response, error)
+	UpdatesAnExistingDiscoveryBySpecifiedIDWithContext(ctx context.Context, updatesAnExistingDiscoveryBySpecifiedIDRequest *UpdatesAnExistingDiscoveryBySpecifiedIDRequest) (*UpdatesAnExistingDiscoveryBySpecifiedIDResponse, *resty.Response, error)
+}
+
+// PathTraceAPI is the set of methods of PathTraceService, implemented by it and by dnacmock.PathTraceAPI.
+type PathTraceAPI interface {
+	DeletesPathtraceByID(flowAnalysisID string) (*DeletesPathtraceByIDResponse, *resty.Response, error)
+	DeletesPathtraceByIDWithContext(ctx context.Context, flowAnalysisID string) (*DeletesPathtraceByIDResponse, *resty.Response, error)
+	InitiateANewPathtrace(initiateANewPathtraceRequest *InitiateANewPathtraceRequest) (*InitiateANewPathtraceResponse, *resty.Response, error)
+	InitiateANewPathtraceWithContext(ctx context.Context, initiateANewPathtraceRequest *InitiateANewPathtraceRequest) (*InitiateANewPathtraceResponse, *resty.Response, error)
+	RetrievesPreviousPathtrace(flowAnalysisID string) (*RetrievesPreviousPathtraceResponse, *resty.Response, error)
+	RetrievesPreviousPathtraceWithContext(ctx context.Context, flowAnalysisID string) (*RetrievesPreviousPathtraceResponse, *resty.Response, error)
+	RetrivesAllPreviousPathtracesSummary(retrivesAllPreviousPathtracesSummaryQueryParams *RetrivesAllPreviousPathtracesSummaryQueryParams) (*RetrivesAllPreviousPathtracesSummaryResponse, *resty.Response, error)
+	RetrivesAllPreviousPathtracesSummaryWithContext(ctx context.Context, retrivesAllPreviousPathtracesSummaryQueryParams *RetrivesAllPreviousPathtracesSummaryQueryParams) (*RetrivesAllPreviousPathtracesSummaryResponse, *resty.Response, error)
+}
+
+// FileAPI is the set of methods of FileService, implemented by it and by dnacmock.FileAPI.
+type FileAPI interface {
+	DownloadAFileByFileID(fileID string) (string, *resty.Response, error)
+	DownloadAFileByFileIDWithContext(ctx context.Context, fileID string) (string, *resty.Response, error)
+	DownloadToWriter(ctx context.Context, fileID string, w io.Writer, opts *DownloadOptions) (*DownloadedFile, error)
+	GetListOfAvailableNamespaces() (*GetListOfAvailableNamespacesResponse, *resty.Response, error)
+	GetListOfAvailableNamespacesWithContext(ctx context.Context) (*GetListOfAvailableNamespacesResponse, *resty.Response, error)
+	GetListOfFiles(nameSpace string) (*GetListOfFilesResponse, *resty.Response, error)
+	GetListOfFilesWithContext(ctx context.Context, nameSpace string) (*GetListOfFilesResponse, *resty.Response, error)
+}
+
+// TaskAPI is the set of methods of TaskService, implemented by it and by dnacmock.TaskAPI.
+type TaskAPI interface {
+	GetTaskByID(taskID string) (*GetTaskByIDResponse, *resty.Response, error)
+	GetTaskByIDWithContext(ctx context.Context, taskID string) (*GetTaskByIDResponse, *resty.Response, error)
+	GetTaskByOperationID(operationID string, offset int, limit int) (*GetTaskByOperationIDResponse, *resty.Response, error)
+	GetTaskByOperationIDWithContext(ctx context.Context, operationID string, offset int, limit int) (*GetTaskByOperationIDResponse, *resty.Response, error)
+	GetTaskCount(getTaskCountQueryParams *GetTaskCountQueryParams) (*GetTaskCountResponse, *resty.Response, error)
+	GetTaskCountWithContext(ctx context.Context, getTaskCountQueryParams *GetTaskCountQueryParams) (*GetTaskCountResponse, *resty.Response, error)
+	GetTaskTree(taskID string) (*GetTaskTreeResponse, *resty.Response, error)
+	GetTaskTreeWithContext(ctx context.Context, taskID string) (*GetTaskTreeResponse, *resty.Response, error)
+	GetTasks(getTasksQueryParams *GetTasksQueryParams) (*GetTasksResponse, *resty.Response, error)
+	GetTasksWithContext(ctx context.Context, getTasksQueryParams *GetTasksQueryParams) (*GetTasksResponse, *resty.Response, error)
+	WaitForTask(ctx context.Context, taskID string, opts *WaitForTaskOptions) (*TaskResult, error)
+}
+
+// TagAPI is the set of methods of TagService, implemented by it and by dnacmock.TagAPI.
+type TagAPI interface {
+	CreateTag(createTagRequest *CreateTagRequest) (*CreateTagResponse, *resty.Response, error)
+	CreateTagWithContext(ctx context.Context, createTagRequest *CreateTagRequest) (*CreateTagResponse, *resty.Response, error)
+	DeleteTag(id string) (*DeleteTagResponse, *resty.Response, error)
+	DeleteTagWithContext(ctx context.Context, id string) (*DeleteTagResponse, *resty.Response, error)
+	GetTag(getTagQueryParams *GetTagQueryParams) (*GetTagResponse, *resty.Response, error)
+	GetTagByID(id string) (*GetTagByIDResponse, *resty.Response, error)
+	GetTagByIDWithContext(ctx context.Context, id string) (*GetTagByIDResponse, *resty.Response, error)
+	GetTagCount(getTagCountQueryParams *GetTagCountQueryParams) (*GetTagCountResponse, *resty.Response, error)
+	GetTagCountWithContext(ctx context.Context, getTagCountQueryParams *GetTagCountQueryParams) (*GetTagCountResponse, *resty.Response, error)
+	GetTagMemberCount(id string, getTagMemberCountQueryParams *GetTagMemberCountQueryParams) (*GetTagMemberCountResponse, *resty.Response, error)
+	GetTagMemberCountWithContext(ctx context.Context, id string, getTagMemberCountQueryParams *GetTagMemberCountQueryParams) (*GetTagMemberCountResponse, *resty.Response, error)
+	GetTagMembersByID(id string, getTagMembersByIDQueryParams *GetTagMembersByIDQueryParams) (*GetTagMembersByIDResponse, *resty.Response, error)
+	GetTagMembersByIDWithContext(ctx context.Context, id string, getTagMembersByIDQueryParams *GetTagMembersByIDQueryParams) (*GetTagMembersByIDResponse, *resty.Response, error)
+	GetTagResourceTypes() (*GetTagResourceTypesResponse, *resty.Response, error)
+	GetTagResourceTypesWithContext(ctx context.Context) (*GetTagResourceTypesResponse, *resty.Response, error)
+	GetTagWithContext(ctx context.Context, getTagQueryParams *GetTagQueryParams) (*GetTagResponse, *resty.Response, error)
+	ListAll(ctx context.Context, params *GetTagQueryParams, opts *PageOptions) *TagIterator
+	ListAllMembers(ctx context.Context, id string, params *GetTagMembersByIDQueryParams, opts *PageOptions) *TagMemberIterator
+	RemoveTagMember(id string, memberID string) (*RemoveTagMemberResponse, *resty.Response, error)
+	RemoveTagMemberWithContext(ctx context.Context, id string, memberID string) (*RemoveTagMemberResponse, *resty.Response, error)
+	SetTagMembers(ctx context.Context, tagID string, memberType string, memberIDs []string, opts *TagMembershipOptions) (*TagMembershipReport, error)
+	UpdateTag(updateTagRequest *UpdateTagRequest) (*UpdateTagResponse, *resty.Response, error)
+	UpdateTagWithContext(ctx context.Context, updateTagRequest *UpdateTagRequest) (*UpdateTagResponse, *resty.Response, error)
+	UpdatesTagMembership(updatesTagMembershipRequest *UpdatesTagMembershipRequest) (*UpdatesTagMembershipResponse, *resty.Response, error)
+	UpdatesTagMembershipWithContext(ctx context.Context, updatesTagMembershipRequest *UpdatesTagMembershipRequest) (*UpdatesTagMembershipResponse, *resty.Response, error)
+}
+
+// ApplicationPolicyAPI is the set of methods of ApplicationPolicyService, implemented by it and by dnacmock.ApplicationPolicyAPI.
+type ApplicationPolicyAPI interface {
+	CreateApplication(createApplicationRequest *[]CreateApplicationRequest) (*CreateApplicationResponse, *resty.Response, error)
+	CreateApplicationSet(createApplicationSetRequest *[]CreateApplicationSetRequest) (*CreateApplicationSetResponse, *resty.Response, error)
+	CreateApplicationSetWithContext(ctx context.Context, createApplicationSetRequest *[]CreateApplicationSetRequest) (*CreateApplicationSetResponse, *resty.Response, error)
+	CreateApplicationWithContext(ctx context.Context, createApplicationRequest *[]CreateApplicationRequest) (*CreateApplicationResponse, *resty.Response, error)
+	DeleteApplication(deleteApplicationQueryParams *DeleteApplicationQueryParams) (*DeleteApplicationResponse, *resty.Response, error)
+	DeleteApplicationSet(deleteApplicationSetQueryParams *DeleteApplicationSetQueryParams) (*DeleteApplicationSetResponse, *resty.Response, error)
+	DeleteApplicationSetWithContext(ctx context.Context, deleteApplicationSetQueryParams *DeleteApplicationSetQueryParams) (*DeleteApplicationSetResponse, *resty.Response, error)
+	DeleteApplicationWithContext(ctx context.Context, deleteApplicationQueryParams *DeleteApplicationQueryParams) (*DeleteApplicationResponse, *resty.Response, error)
+	EditApplication(editApplicationRequest *[]EditApplicationRequest) (*EditApplicationResponse, *resty.Response, error)
+	EditApplicationWithContext(ctx context.Context, editApplicationRequest *[]EditApplicationRequest) (*EditApplicationResponse, *resty.Response, error)
+	GetApplicationSets(getApplicationSetsQueryParams *GetApplicationSetsQueryParams) (*GetApplicationSetsResponse, *resty.Response, error)
+	GetApplicationSetsCount() (*GetApplicationSetsCountResponse, *resty.Response, error)
+	GetApplicationSetsCountWithContext(ctx context.Context) (*GetApplicationSetsCountResponse, *resty.Response, error)
+	GetApplicationSetsWithContext(ctx context.Context, getApplicationSetsQueryParams *GetApplicationSetsQueryParams) (*GetApplicationSetsResponse, *resty.Response, error)
+	GetApplications(getApplicationsQueryParams *GetApplicationsQueryParams) (*GetApplicationsResponse, *resty.Response, error)
+	GetApplicationsCount() (*GetApplicationsCountResponse, *resty.Response, error)
+	GetApplicationsCountWithContext(ctx context.Context) (*GetApplicationsCountResponse, *resty.Response, error)
+	GetApplicationsWithContext(ctx context.Context, getApplicationsQueryParams *GetApplicationsQueryParams) (*GetApplicationsResponse, *resty.Response, error)
+	ListAllApplications(ctx context.Context, params *GetApplicationsQueryParams, opts *PageOptions) *ApplicationIterator
+}
+
+// ApplicationsAPI is the set of methods of ApplicationsService, implemented by it and by dnacmock.ApplicationsAPI.
+type ApplicationsAPI interface {
+	Applications(applicationsQueryParams *ApplicationsQueryParams) (*ApplicationsResponse, *resty.Response, error)
+	ApplicationsWithContext(ctx context.Context, applicationsQueryParams *ApplicationsQueryParams) (*ApplicationsResponse, *resty.Response, error)
+}
+
+// ITSMAPI is the set of methods of ITSMService, implemented by it and by dnacmock.ITSMAPI.
+type ITSMAPI interface {
+	GetFailedITSMEvents(getFailedITSMEventsQueryParams *GetFailedITSMEventsQueryParams) (*GetFailedITSMEventsResponse, *resty.Response, error)
+	GetFailedITSMEventsWithContext(ctx context.Context, getFailedITSMEventsQueryParams *GetFailedITSMEventsQueryParams) (*GetFailedITSMEventsResponse, *resty.Response, error)
+	RetryIntegrationEvents(retryIntegrationEventsRequest *[]RetryIntegrationEventsRequest) (*RetryIntegrationEventsResponse, *resty.Response, error)
+	RetryIntegrationEventsWithContext(ctx context.Context, retryIntegrationEventsRequest *[]RetryIntegrationEventsRequest) (*RetryIntegrationEventsResponse, *resty.Response, error)
+}
+
+// EventManagementAPI is the set of methods of EventManagementService, implemented by it and by dnacmock.EventManagementAPI.
+type EventManagementAPI interface {
+	CountOfEventSubscriptions(countOfEventSubscriptionsQueryParams *CountOfEventSubscriptionsQueryParams) (*CountOfEventSubscriptionsResponse, *resty.Response, error)
+	CountOfEventSubscriptionsWithContext(ctx context.Context, countOfEventSubscriptionsQueryParams *CountOfEventSubscriptionsQueryParams) (*CountOfEventSubscriptionsResponse, *resty.Response, error)
+	CountOfEvents(countOfEventsQueryParams *CountOfEventsQueryParams) (*CountOfEventsResponse, *resty.Response, error)
+	CountOfEventsWithContext(ctx context.Context, countOfEventsQueryParams *CountOfEventsQueryParams) (*CountOfEventsResponse, *resty.Response, error)
+	CountOfNotifications(countOfNotificationsQueryParams *CountOfNotificationsQueryParams) (*CountOfNotificationsResponse, *resty.Response, error)
+	CountOfNotificationsWithContext(ctx context.Context, countOfNotificationsQueryParams *CountOfNotificationsQueryParams) (*CountOfNotificationsResponse, *resty.Response, error)
+	CreateEventSubscriptions(createEventSubscriptionsRequest *[]CreateEventSubscriptionsRequest) (*CreateEventSubscriptionsResponse, *resty.Response, error)
+	CreateEventSubscriptionsWithContext(ctx context.Context, createEventSubscriptionsRequest *[]CreateEventSubscriptionsRequest) (*CreateEventSubscriptionsResponse, *resty.Response, error)
+	DeleteEventSubscriptions(deleteEventSubscriptionsQueryParams *DeleteEventSubscriptionsQueryParams) (*DeleteEventSubscriptionsResponse, *resty.Response, error)
+	DeleteEventSubscriptionsWithContext(ctx context.Context, deleteEventSubscriptionsQueryParams *DeleteEventSubscriptionsQueryParams) (*DeleteEventSubscriptionsResponse, *resty.Response, error)
+	GetEventSubscriptions(getEventSubscriptionsQueryParams *GetEventSubscriptionsQueryParams) (*GetEventSubscriptionsResponse, *resty.Response, error)
+	GetEventSubscriptionsWithContext(ctx context.Context, getEventSubscriptionsQueryParams *GetEventSubscriptionsQueryParams) (*GetEventSubscriptionsResponse, *resty.Response, error)
+	GetEvents(getEventsQueryParams *GetEventsQueryParams) (*[]GetEventsResponse, *resty.Response, error)
+	GetEventsWithContext(ctx context.Context, getEventsQueryParams *GetEventsQueryParams) (*[]GetEventsResponse, *resty.Response, error)
+	GetNotifications(getNotificationsQueryParams *GetNotificationsQueryParams) (*GetNotificationsResponse, *resty.Response, error)
+	GetNotificationsWithContext(ctx context.Context, getNotificationsQueryParams *GetNotificationsQueryParams) (*GetNotificationsResponse, *resty.Response, error)
+	GetStatusAPIForEvents(executionID string) (*GetStatusAPIForEventsResponse, *resty.Response, error)
+	GetStatusAPIForEventsWithContext(ctx context.Context, executionID string) (*GetStatusAPIForEventsResponse, *resty.Response, error)
+	ListAllEvents(ctx context.Context, params *GetEventsQueryParams, opts *PageOptions) *EventIterator
+	UpdateEventSubscriptions(updateEventSubscriptionsRequest *[]UpdateEventSubscriptionsRequest) (*UpdateEventSubscriptionsResponse, *resty.Response, error)
+	UpdateEventSubscriptionsWithContext(ctx context.Context, updateEventSubscriptionsRequest *[]UpdateEventSubscriptionsRequest) (*UpdateEventSubscriptionsResponse, *resty.Response, error)
+}
+
+// DeviceReplacementAPI is the set of methods of DeviceReplacementService, implemented by it and by dnacmock.DeviceReplacementAPI.
+type DeviceReplacementAPI interface {
+	DeployDeviceReplacementWorkflow(deployDeviceReplacementWorkflowRequest *DeployDeviceReplacementWorkflowRequest) (*DeployDeviceReplacementWorkflowResponse, *resty.Response, error)
+	DeployDeviceReplacementWorkflowWithContext(ctx context.Context, deployDeviceReplacementWorkflowRequest *DeployDeviceReplacementWorkflowRequest) (*DeployDeviceReplacementWorkflowResponse, *resty.Response, error)
+	MarkDeviceForReplacement(markDeviceForReplacementRequest *[]MarkDeviceForReplacementRequest) (*MarkDeviceForReplacementResponse, *resty.Response, error)
+	MarkDeviceForReplacementWithContext(ctx context.Context, markDeviceForReplacementRequest *[]MarkDeviceForReplacementRequest) (*MarkDeviceForReplacementResponse, *resty.Response, error)
+	ReturnListOfReplacementDevicesWithReplacementDetails(returnListOfReplacementDevicesWithReplacementDetailsQueryParams *ReturnListOfReplacementDevicesWithReplacementDetailsQueryParams) (*ReturnListOfReplacementDevicesWithReplacementDetailsResponse, *resty.Response, error)
+	ReturnListOfReplacementDevicesWithReplacementDetailsWithContext(ctx context.Context, returnListOfReplacementDevicesWithReplacementDetailsQueryParams *ReturnListOfReplacementDevicesWithReplacementDetailsQueryParams) (*ReturnListOfReplacementDevicesWithReplacementDetailsResponse, *resty.Response, error)
+	ReturnReplacementDevicesCount(returnReplacementDevicesCountQueryParams *ReturnReplacementDevicesCountQueryParams) (*ReturnReplacementDevicesCountResponse, *resty.Response, error)
+	ReturnReplacementDevicesCountWithContext(ctx context.Context, returnReplacementDevicesCountQueryParams *ReturnReplacementDevicesCountQueryParams) (*ReturnReplacementDevicesCountResponse, *resty.Response, error)
+	UnMarkDeviceForReplacement(unMarkDeviceForReplacementRequest *[]UnMarkDeviceForReplacementRequest) (*UnMarkDeviceForReplacementResponse, *resty.Response, error)
+	UnMarkDeviceForReplacementWithContext(ctx context.Context, unMarkDeviceForReplacementRequest *[]UnMarkDeviceForReplacementRequest) (*UnMarkDeviceForReplacementResponse, *resty.Response, error)
+}
+
+var (
+	_ AuthenticationAPI              = (*AuthenticationService)(nil)
+	_ SitesAPI                       = (*SitesService)(nil)
+	_ TopologyAPI                    = (*TopologyService)(nil)
+	_ DevicesAPI                     = (*DevicesService)(nil)
+	_ ClientsAPI                     = (*ClientsService)(nil)
+	_ UsersAPI                       = (*UsersService)(nil)
+	_ IssuesAPI                      = (*IssuesService)(nil)
+	_ SiteDesignAPI                  = (*SiteDesignService)(nil)
+	_ NetworkSettingsAPI             = (*NetworkSettingsService)(nil)
+	_ SoftwareImageManagementSWIMAPI = (*SoftwareImageManagementSWIMService)(nil)
+	_ DeviceOnboardingPnPAPI         = (*DeviceOnboardingPnPService)(nil)
+	_ ConfigurationTemplatesAPI      = (*ConfigurationTemplatesService)(nil)
+	_ ConfigurationArchiveAPI        = (*ConfigurationArchiveService)(nil)
+	_ SDAAPI                         = (*SDAService)(nil)
+	_ SensorsAPI                     = (*SensorsService)(nil)
+	_ WirelessAPI                    = (*WirelessService)(nil)
+	_ CommandRunnerAPI               = (*CommandRunnerService)(nil)
+	_ DiscoveryAPI                   = (*DiscoveryService)(nil)
+	_ PathTraceAPI                   = (*PathTraceService)(nil)
+	_ FileAPI                        = (*FileService)(nil)
+	_ TaskAPI                        = (*TaskService)(nil)
+	_ TagAPI                         = (*TagService)(nil)
+	_ ApplicationPolicyAPI           = (*ApplicationPolicyService)(nil)
+	_ ApplicationsAPI                = (*ApplicationsService)(nil)
+	_ ITSMAPI                        = (*ITSMService)(nil)
+	_ EventManagementAPI             = (*EventManagementService)(nil)
+	_ DeviceReplacementAPI           = (*DeviceReplacementService)(nil)
+)
+
+// API holds the services of a Client as interfaces, so that code using
+// them can be given fakes, such as the mocks of package dnacmock, in tests.
+type API struct {
+	Authentication              AuthenticationAPI
+	Sites                       SitesAPI
+	Topology                    TopologyAPI
+	Devices                     DevicesAPI
+	Clients                     ClientsAPI
+	Users                       UsersAPI
+	Issues                      IssuesAPI
+	SiteDesign                  SiteDesignAPI
+	NetworkSettings             NetworkSettingsAPI
+	SoftwareImageManagementSWIM SoftwareImageManagementSWIMAPI
+	DeviceOnboardingPnP         DeviceOnboardingPnPAPI
+	ConfigurationTemplates      ConfigurationTemplatesAPI
+	ConfigurationArchive        ConfigurationArchiveAPI
+	SDA                         SDAAPI
+	Sensors                     SensorsAPI
+	Wireless                    WirelessAPI
+	CommandRunner               CommandRunnerAPI
+	Discovery                   DiscoveryAPI
+	PathTrace                   PathTraceAPI
+	File                        FileAPI
+	Task                        TaskAPI
+	Tag                         TagAPI
+	ApplicationPolicy           ApplicationPolicyAPI
+	Applications                ApplicationsAPI
+	ITSM                        ITSMAPI
+	EventManagement             EventManagementAPI
+	DeviceReplacement           DeviceReplacementAPI
+}
+
+// API returns the services of the client as interfaces.
+func (s *Client) API() *API {
+	return &API{
+		Authentication:              s.Authentication,
+		Sites:                       s.Sites,
+		Topology:                    s.Topology,
+		Devices:                     s.Devices,
+		Clients:                     s.Clients,
+		Users:                       s.Users,
+		Issues:                      s.Issues,
+		SiteDesign:                  s.SiteDesign,
+		NetworkSettings:             s.NetworkSettings,
+		SoftwareImageManagementSWIM: s.SoftwareImageManagementSWIM,
+		DeviceOnboardingPnP:         s.DeviceOnboardingPnP,
+		ConfigurationTemplates:      s.ConfigurationTemplates,
+		ConfigurationArchive:        s.ConfigurationArchive,
+		SDA:                         s.SDA,
+		Sensors:                     s.Sensors,
+		Wireless:                    s.Wireless,
+		CommandRunner:               s.CommandRunner,
+		Discovery:                   s.Discovery,
+		PathTrace:                   s.PathTrace,
+		File:                        s.File,
+		Task:                        s.Task,
+		Tag:                         s.Tag,
+		ApplicationPolicy:           s.ApplicationPolicy,
+		Applications:                s.Applications,
+		ITSM:                        s.ITSM,
+		EventManagement:             s.EventManagement,
+		DeviceReplacement:           s.DeviceReplacement,
+	}
+}
@@ -0,0 +1,336 @@
+// Command geninterfaces generates the service interfaces of package dnac
+// (interfaces.go) and their mock implementations (dnacmock/mocks.go) from
+// the method sets of the services held by dnac.Client.
+//
+// It is run by go generate from the sdk directory.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const (
+	interfacesFile = "interfaces.go"
+	mocksFile      = "dnacmock/mocks.go"
+	importPath     = "github.com/cisco-en-programmability/dnacenter-go-sdk/sdk"
+	header         = "// Code generated by geninterfaces. DO NOT EDIT.\n\n"
+)
+
+// serviceInfo is a service held by a field of dnac.Client.
+type serviceInfo struct {
+	Field     string // Name of the Client field, e.g. Devices
+	Type      string // Name of the service type, e.g. DevicesService
+	Interface string // Name of the generated interface, e.g. DevicesAPI
+	Methods   []*types.Func
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("geninterfaces: ")
+
+	pkg, err := loadPackage(".")
+	if err != nil {
+		log.Fatal(err)
+	}
+	services, err := clientServices(pkg)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := writeSource(interfacesFile, generateInterfaces(pkg, services)); err != nil {
+		log.Fatal(err)
+	}
+	if err := writeSource(mocksFile, generateMocks(pkg, services)); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// loadPackage type-checks the package in dir, leaving out the generated
+// interfaces so that they never depend on a stale version of themselves.
+func loadPackage(dir string) (*types.Package, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(info os.FileInfo) bool {
+		return info.Name() != interfacesFile && !strings.HasSuffix(info.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(pkgs) != 1 {
+		return nil, fmt.Errorf("expected one package in %s, found %d", dir, len(pkgs))
+	}
+	var files []*ast.File
+	var name string
+	for pkgName, p := range pkgs {
+		name = pkgName
+		for _, file := range p.Files {
+			files = append(files, file)
+		}
+	}
+	config := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	pkg, err := config.Check(importPath, fset, files, nil)
+	if err != nil {
+		return nil, err
+	}
+	if pkg.Name() != name {
+		return nil, fmt.Errorf("expected package %s, found %s", name, pkg.Name())
+	}
+	return pkg, nil
+}
+
+// clientServices returns the services held by the fields of Client, in the
+// order of the fields.
+func clientServices(pkg *types.Package) ([]*serviceInfo, error) {
+	obj := pkg.Scope().Lookup("Client")
+	if obj == nil {
+		return nil, fmt.Errorf("type Client not found")
+	}
+	client := obj.Type().Underlying().(*types.Struct)
+
+	var services []*serviceInfo
+	for i := 0; i < client.NumFields(); i++ {
+		field := client.Field(i)
+		ptr, ok := field.Type().(*types.Pointer)
+		if !field.Exported() || !ok {
+			continue
+		}
+		named, ok := ptr.Elem().(*types.Named)
+		if !ok || !strings.HasSuffix(named.Obj().Name(), "Service") {
+			continue
+		}
+		info := &serviceInfo{
+			Field:     field.Name(),
+			Type:      named.Obj().Name(),
+			Interface: strings.TrimSuffix(named.Obj().Name(), "Service") + "API",
+		}
+		methods := types.NewMethodSet(ptr)
+		for j := 0; j < methods.Len(); j++ {
+			if fn := methods.At(j).Obj().(*types.Func); fn.Exported() {
+				info.Methods = append(info.Methods, fn)
+			}
+		}
+		sort.Slice(info.Methods, func(a, b int) bool { return info.Methods[a].Name() < info.Methods[b].Name() })
+		services = append(services, info)
+	}
+	return services, nil
+}
+
+// importSet collects the imports needed by generated code and qualifies
+// the types it prints.
+type importSet struct {
+	self    *types.Package
+	aliases map[string]string // Import path to package name
+}
+
+func newImportSet(self *types.Package) *importSet {
+	return &importSet{self: self, aliases: map[string]string{}}
+}
+
+func (s *importSet) qualifier(pkg *types.Package) string {
+	if pkg == s.self {
+		return ""
+	}
+	s.aliases[pkg.Path()] = pkg.Name()
+	return pkg.Name()
+}
+
+func (s *importSet) add(path string, name string) {
+	s.aliases[path] = name
+}
+
+// write writes the import block, standard library first.
+func (s *importSet) write(b *bytes.Buffer, localName func(path string, name string) string) {
+	var std, other []string
+	for path := range s.aliases {
+		if strings.Contains(strings.SplitN(path, "/", 2)[0], ".") {
+			other = append(other, path)
+		} else {
+			std = append(std, path)
+		}
+	}
+	sort.Strings(std)
+	sort.Strings(other)
+	b.WriteString("import (\n")
+	for _, path := range std {
+		fmt.Fprintf(b, "\t%q\n", path)
+	}
+	if len(std) > 0 && len(other) > 0 {
+		b.WriteString("\n")
+	}
+	for _, path := range other {
+		fmt.Fprintf(b, "\t%s%q\n", localName(path, s.aliases[path]), path)
+	}
+	b.WriteString(")\n\n")
+}
+
+// signature prints the parameters and results of fn, naming the parameters
+// so that they can be forwarded.
+func signature(fn *types.Func, qualifier types.Qualifier) (params string, args string, results string) {
+	sig := fn.Type().(*types.Signature)
+	var paramList, argList []string
+	for i := 0; i < sig.Params().Len(); i++ {
+		param := sig.Params().At(i)
+		name := param.Name()
+		if name == "" || name == "_" {
+			name = fmt.Sprintf("arg%d", i)
+		}
+		typ := types.TypeString(param.Type(), qualifier)
+		arg := name
+		if sig.Variadic() && i == sig.Params().Len()-1 {
+			typ = "..." + types.TypeString(param.Type().(*types.Slice).Elem(), qualifier)
+			arg += "..."
+		}
+		paramList = append(paramList, name+" "+typ)
+		argList = append(argList, arg)
+	}
+	var resultList []string
+	for i := 0; i < sig.Results().Len(); i++ {
+		resultList = append(resultList, types.TypeString(sig.Results().At(i).Type(), qualifier))
+	}
+	results = strings.Join(resultList, ", ")
+	if len(resultList) > 1 {
+		results = "(" + results + ")"
+	}
+	return strings.Join(paramList, ", "), strings.Join(argList, ", "), results
+}
+
+func generateInterfaces(pkg *types.Package, services []*serviceInfo) []byte {
+	imports := newImportSet(pkg)
+	var body bytes.Buffer
+	for _, service := range services {
+		fmt.Fprintf(&body, "// %s is the set of methods of %s, implemented by it and by dnacmock.%s.\n", service.Interface, service.Type, service.Interface)
+		fmt.Fprintf(&body, "type %s interface {\n", service.Interface)
+		for _, method := range service.Methods {
+			params, _, results := signature(method, imports.qualifier)
+			fmt.Fprintf(&body, "\t%s(%s) %s\n", method.Name(), params, results)
+		}
+		body.WriteString("}\n\n")
+	}
+
+	body.WriteString("var (\n")
+	for _, service := range services {
+		fmt.Fprintf(&body, "\t_ %s = (*%s)(nil)\n", service.Interface, service.Type)
+	}
+	body.WriteString(")\n\n")
+
+	body.WriteString("// API holds the services of a Client as interfaces, so that code using\n")
+	body.WriteString("// them can be given fakes, such as the mocks of package dnacmock, in tests.\n")
+	body.WriteString("type API struct {\n")
+	for _, service := range services {
+		fmt.Fprintf(&body, "\t%s %s\n", service.Field, service.Interface)
+	}
+	body.WriteString("}\n\n")
+
+	body.WriteString("// API returns the services of the client as interfaces.\n")
+	body.WriteString("func (s *Client) API() *API {\n\treturn &API{\n")
+	for _, service := range services {
+		fmt.Fprintf(&body, "\t\t%s: s.%s,\n", service.Field, service.Field)
+	}
+	body.WriteString("\t}\n}\n")
+
+	var b bytes.Buffer
+	b.WriteString(header)
+	fmt.Fprintf(&b, "package %s\n\n", pkg.Name())
+	imports.write(&b, func(path string, name string) string { return "" })
+	b.Write(body.Bytes())
+	return b.Bytes()
+}
+
+func generateMocks(pkg *types.Package, services []*serviceInfo) []byte {
+	// The mocks live in another package, so the types of pkg are qualified too.
+	imports := newImportSet(nil)
+	imports.add(pkg.Path(), pkg.Name())
+	var body bytes.Buffer
+	for _, service := range services {
+		fmt.Fprintf(&body, "// %s is a mock of %s.%s. Each method calls the function of the\n", service.Interface, pkg.Name(), service.Interface)
+		fmt.Fprintf(&body, "// same name suffixed with Func, which must be set if the method is called.\n")
+		fmt.Fprintf(&body, "type %s struct {\n\tRecorder\n\n", service.Interface)
+		for _, method := range service.Methods {
+			params, _, results := signature(method, imports.qualifier)
+			fmt.Fprintf(&body, "\t%sFunc func(%s) %s\n", method.Name(), params, results)
+		}
+		body.WriteString("}\n\n")
+
+		for _, method := range service.Methods {
+			params, args, results := signature(method, imports.qualifier)
+			recorded := strings.Replace(args, "...", "", -1)
+			fmt.Fprintf(&body, "// %s calls %sFunc.\n", method.Name(), method.Name())
+			fmt.Fprintf(&body, "func (m *%s) %s(%s) %s {\n", service.Interface, method.Name(), params, results)
+			fmt.Fprintf(&body, "\tm.record(%q", method.Name())
+			if recorded != "" {
+				fmt.Fprintf(&body, ", %s", recorded)
+			}
+			body.WriteString(")\n")
+			fmt.Fprintf(&body, "\tif m.%sFunc == nil {\n", method.Name())
+			fmt.Fprintf(&body, "\t\tpanic(%q)\n\t}\n", fmt.Sprintf("dnacmock: %s.%s called but %sFunc is not set", service.Interface, method.Name(), method.Name()))
+			ret := "return "
+			if results == "" {
+				ret = ""
+			}
+			fmt.Fprintf(&body, "\t%sm.%sFunc(%s)\n}\n\n", ret, method.Name(), args)
+		}
+	}
+
+	body.WriteString("var (\n")
+	for _, service := range services {
+		fmt.Fprintf(&body, "\t_ %s.%s = (*%s)(nil)\n", pkg.Name(), service.Interface, service.Interface)
+	}
+	body.WriteString(")\n\n")
+
+	body.WriteString("// API holds a mock of every service.\n")
+	body.WriteString("type API struct {\n")
+	for _, service := range services {
+		fmt.Fprintf(&body, "\t%s *%s\n", service.Field, service.Interface)
+	}
+	body.WriteString("}\n\n")
+
+	body.WriteString("// NewAPI returns mocks of every service with no function set.\n")
+	body.WriteString("func NewAPI() *API {\n\treturn &API{\n")
+	for _, service := range services {
+		fmt.Fprintf(&body, "\t\t%s: &%s{},\n", service.Field, service.Interface)
+	}
+	body.WriteString("\t}\n}\n\n")
+
+	fmt.Fprintf(&body, "// API returns the mocks as a %s.API.\n", pkg.Name())
+	fmt.Fprintf(&body, "func (m *API) API() *%s.API {\n\treturn &%s.API{\n", pkg.Name(), pkg.Name())
+	for _, service := range services {
+		fmt.Fprintf(&body, "\t\t%s: m.%s,\n", service.Field, service.Field)
+	}
+	body.WriteString("\t}\n}\n")
+
+	var b bytes.Buffer
+	b.WriteString(header)
+	b.WriteString("package dnacmock\n\n")
+	imports.write(&b, func(path string, name string) string {
+		if filepath.Base(path) != name {
+			return name + " "
+		}
+		return ""
+	})
+	b.Write(body.Bytes())
+	return b.Bytes()
+}
+
+// writeSource formats src and writes it to path.
+func writeSource(path string, src []byte) error {
+	formatted, err := format.Source(src)
+	if err != nil {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, formatted, 0644)
+}
@@ -1,7 +1,7 @@
 package dnac
 
 import (
-	"fmt"
+	"context"
 
 	"github.com/go-resty/resty/v2"
 	"github.com/google/go-querystring/query"
@@ -78,10 +78,16 @@ type IssuesResponseResponse struct {
 @param entity_value Contains the actual value for the entity type that has been defined
 */
 func (s *IssuesService) GetIssueEnrichmentDetails() (*GetIssueEnrichmentDetailsResponse, *resty.Response, error) {
+	return s.GetIssueEnrichmentDetailsWithContext(context.Background())
+}
+
+// GetIssueEnrichmentDetailsWithContext is like GetIssueEnrichmentDetails but sends the request with ctx for cancellation and deadlines.
+func (s *IssuesService) GetIssueEnrichmentDetailsWithContext(ctx context.Context) (*GetIssueEnrichmentDetailsResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/issue-enrichment-details"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&GetIssueEnrichmentDetailsResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -91,7 +97,7 @@ func (s *IssuesService) GetIssueEnrichmentDetails() (*GetIssueEnrichmentDetailsR
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getIssueEnrichmentDetails")
+		return nil, response, newAPIError("getIssueEnrichmentDetails", response)
 	}
 
 	result := response.Result().(*GetIssueEnrichmentDetailsResponse)
@@ -122,12 +128,18 @@ type IssuesQueryParams struct {
 @param issueStatus The issue's status value (One of ACTIVE, IGNORED, RESOLVED) (Use only when macAddress and deviceId are not provided)
 */
 func (s *IssuesService) Issues(issuesQueryParams *IssuesQueryParams) (*IssuesResponse, *resty.Response, error) {
+	return s.IssuesWithContext(context.Background(), issuesQueryParams)
+}
+
+// IssuesWithContext is like Issues but sends the request with ctx for cancellation and deadlines.
+func (s *IssuesService) IssuesWithContext(ctx context.Context, issuesQueryParams *IssuesQueryParams) (*IssuesResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/issues"
 
 	queryString, _ := query.Values(issuesQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&IssuesResponse{}).
 		SetError(&Error{}).
@@ -138,7 +150,7 @@ func (s *IssuesService) Issues(issuesQueryParams *IssuesQueryParams) (*IssuesRes
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation issues")
+		return nil, response, newAPIError("issues", response)
 	}
 
 	result := response.Result().(*IssuesResponse)
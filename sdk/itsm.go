@@ -1,7 +1,7 @@
 package dnac
 
 import (
-	"fmt"
+	"context"
 
 	"github.com/go-resty/resty/v2"
 	"github.com/google/go-querystring/query"
@@ -54,12 +54,18 @@ type GetFailedITSMEventsQueryParams struct {
 @param instanceID Instance Id of the failed event as in the Runtime Dashboard
 */
 func (s *ITSMService) GetFailedITSMEvents(getFailedITSMEventsQueryParams *GetFailedITSMEventsQueryParams) (*GetFailedITSMEventsResponse, *resty.Response, error) {
+	return s.GetFailedITSMEventsWithContext(context.Background(), getFailedITSMEventsQueryParams)
+}
+
+// GetFailedITSMEventsWithContext is like GetFailedITSMEvents but sends the request with ctx for cancellation and deadlines.
+func (s *ITSMService) GetFailedITSMEventsWithContext(ctx context.Context, getFailedITSMEventsQueryParams *GetFailedITSMEventsQueryParams) (*GetFailedITSMEventsResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/integration/events"
 
 	queryString, _ := query.Values(getFailedITSMEventsQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetFailedITSMEventsResponse{}).
 		SetError(&Error{}).
@@ -70,7 +76,7 @@ func (s *ITSMService) GetFailedITSMEvents(getFailedITSMEventsQueryParams *GetFai
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getFailedITSMEvents")
+		return nil, response, newAPIError("getFailedITSMEvents", response)
 	}
 
 	result := response.Result().(*GetFailedITSMEventsResponse)
@@ -81,10 +87,16 @@ func (s *ITSMService) GetFailedITSMEvents(getFailedITSMEventsQueryParams *GetFai
 /* Allows retry of multiple failed ITSM event instances. The retry request payload can be given as a list of strings: ["instance1","instance2","instance3",..] A minimum of one instance Id is mandatory. The list of failed event instance Ids can be retrieved using the 'Get Failed ITSM Events' API in the 'instanceId' attribute.
  */
 func (s *ITSMService) RetryIntegrationEvents(retryIntegrationEventsRequest *[]RetryIntegrationEventsRequest) (*RetryIntegrationEventsResponse, *resty.Response, error) {
+	return s.RetryIntegrationEventsWithContext(context.Background(), retryIntegrationEventsRequest)
+}
+
+// RetryIntegrationEventsWithContext is like RetryIntegrationEvents but sends the request with ctx for cancellation and deadlines.
+func (s *ITSMService) RetryIntegrationEventsWithContext(ctx context.Context, retryIntegrationEventsRequest *[]RetryIntegrationEventsRequest) (*RetryIntegrationEventsResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/integration/events"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(retryIntegrationEventsRequest).
 		SetResult(&RetryIntegrationEventsResponse{}).
 		SetError(&Error{}).
@@ -95,7 +107,7 @@ func (s *ITSMService) RetryIntegrationEvents(retryIntegrationEventsRequest *[]Re
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation retryIntegrationEvents")
+		return nil, response, newAPIError("retryIntegrationEvents", response)
 	}
 
 	result := response.Result().(*RetryIntegrationEventsResponse)
@@ -1,6 +1,7 @@
 package dnac
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -23,7 +24,7 @@ type AssignCredentialToSiteRequest struct {
 
 // CreateDeviceCredentialsRequest is the createDeviceCredentialsRequest definition
 type CreateDeviceCredentialsRequest struct {
-	Settings CreateDeviceCredentialsRequestSettings `json:"settings,omitempty"` //
+	Settings *CreateDeviceCredentialsRequestSettings `json:"settings,omitempty"` //
 }
 
 // CreateDeviceCredentialsRequestSettings is the createDeviceCredentialsRequestSettings definition
@@ -46,18 +47,18 @@ type CreateDeviceCredentialsRequestSettingsCliCredential struct {
 
 // CreateDeviceCredentialsRequestSettingsHTTPSRead is the createDeviceCredentialsRequestSettingsHTTPSRead definition
 type CreateDeviceCredentialsRequestSettingsHTTPSRead struct {
-	Name     string  `json:"name,omitempty"`     //
-	Password string  `json:"password,omitempty"` //
-	Port     float64 `json:"port,omitempty"`     //
-	Username string  `json:"username,omitempty"` //
+	Name     string   `json:"name,omitempty"`     //
+	Password string   `json:"password,omitempty"` //
+	Port     *float64 `json:"port,omitempty"`     //
+	Username string   `json:"username,omitempty"` //
 }
 
 // CreateDeviceCredentialsRequestSettingsHTTPSWrite is the createDeviceCredentialsRequestSettingsHTTPSWrite definition
 type CreateDeviceCredentialsRequestSettingsHTTPSWrite struct {
-	Name     string  `json:"name,omitempty"`     //
-	Password string  `json:"password,omitempty"` //
-	Port     float64 `json:"port,omitempty"`     //
-	Username string  `json:"username,omitempty"` //
+	Name     string   `json:"name,omitempty"`     //
+	Password string   `json:"password,omitempty"` //
+	Port     *float64 `json:"port,omitempty"`     //
+	Username string   `json:"username,omitempty"` //
 }
 
 // CreateDeviceCredentialsRequestSettingsSNMPV2cRead is the createDeviceCredentialsRequestSettingsSNMPV2cRead definition
@@ -85,7 +86,7 @@ type CreateDeviceCredentialsRequestSettingsSNMPV3 struct {
 
 // CreateGlobalPoolRequest is the createGlobalPoolRequest definition
 type CreateGlobalPoolRequest struct {
-	Settings CreateGlobalPoolRequestSettings `json:"settings,omitempty"` //
+	Settings *CreateGlobalPoolRequestSettings `json:"settings,omitempty"` //
 }
 
 // CreateGlobalPoolRequestSettings is the createGlobalPoolRequestSettings definition
@@ -112,21 +113,21 @@ type CreateGlobalPoolRequestSettingsIPpoolDhcpServerIPs []string
 
 // CreateNetworkRequest is the createNetworkRequest definition
 type CreateNetworkRequest struct {
-	Settings CreateNetworkRequestSettings `json:"settings,omitempty"` //
+	Settings *CreateNetworkRequestSettings `json:"settings,omitempty"` //
 }
 
 // CreateNetworkRequestSettings is the createNetworkRequestSettings definition
 type CreateNetworkRequestSettings struct {
-	ClientAndEndpointAAA CreateNetworkRequestSettingsClientAndEndpointAAA `json:"clientAndEndpoint_aaa,omitempty"` //
-	DhcpServer           []string                                         `json:"dhcpServer,omitempty"`            //
-	DNSServer            CreateNetworkRequestSettingsDNSServer            `json:"dnsServer,omitempty"`             //
-	MessageOfTheday      CreateNetworkRequestSettingsMessageOfTheday      `json:"messageOfTheday,omitempty"`       //
-	Netflowcollector     CreateNetworkRequestSettingsNetflowcollector     `json:"netflowcollector,omitempty"`      //
-	NetworkAAA           CreateNetworkRequestSettingsNetworkAAA           `json:"network_aaa,omitempty"`           //
-	NtpServer            []string                                         `json:"ntpServer,omitempty"`             //
-	SNMPServer           CreateNetworkRequestSettingsSNMPServer           `json:"snmpServer,omitempty"`            //
-	SyslogServer         CreateNetworkRequestSettingsSyslogServer         `json:"syslogServer,omitempty"`          //
-	Timezone             string                                           `json:"timezone,omitempty"`              //
+	ClientAndEndpointAAA *CreateNetworkRequestSettingsClientAndEndpointAAA `json:"clientAndEndpoint_aaa,omitempty"` //
+	DhcpServer           []string                                          `json:"dhcpServer,omitempty"`            //
+	DNSServer            *CreateNetworkRequestSettingsDNSServer            `json:"dnsServer,omitempty"`             //
+	MessageOfTheday      *CreateNetworkRequestSettingsMessageOfTheday      `json:"messageOfTheday,omitempty"`       //
+	Netflowcollector     *CreateNetworkRequestSettingsNetflowcollector     `json:"netflowcollector,omitempty"`      //
+	NetworkAAA           *CreateNetworkRequestSettingsNetworkAAA           `json:"network_aaa,omitempty"`           //
+	NtpServer            []string                                          `json:"ntpServer,omitempty"`             //
+	SNMPServer           *CreateNetworkRequestSettingsSNMPServer           `json:"snmpServer,omitempty"`            //
+	SyslogServer         *CreateNetworkRequestSettingsSyslogServer         `json:"syslogServer,omitempty"`          //
+	Timezone             string                                            `json:"timezone,omitempty"`              //
 }
 
 // CreateNetworkRequestSettingsClientAndEndpointAAA is the createNetworkRequestSettingsClientAndEndpointAAA definition
@@ -151,13 +152,13 @@ type CreateNetworkRequestSettingsDhcpServer []string
 // CreateNetworkRequestSettingsMessageOfTheday is the createNetworkRequestSettingsMessageOfTheday definition
 type CreateNetworkRequestSettingsMessageOfTheday struct {
 	BannerMessage        string `json:"bannerMessage,omitempty"`        //
-	RetainExistingBanner bool   `json:"retainExistingBanner,omitempty"` //
+	RetainExistingBanner *bool  `json:"retainExistingBanner,omitempty"` //
 }
 
 // CreateNetworkRequestSettingsNetflowcollector is the createNetworkRequestSettingsNetflowcollector definition
 type CreateNetworkRequestSettingsNetflowcollector struct {
-	IPAddress string  `json:"ipAddress,omitempty"` //
-	Port      float64 `json:"port,omitempty"`      //
+	IPAddress string   `json:"ipAddress,omitempty"` //
+	Port      *float64 `json:"port,omitempty"`      //
 }
 
 // CreateNetworkRequestSettingsNetworkAAA is the createNetworkRequestSettingsNetworkAAA definition
@@ -174,7 +175,7 @@ type CreateNetworkRequestSettingsNtpServer []string
 
 // CreateNetworkRequestSettingsSNMPServer is the createNetworkRequestSettingsSNMPServer definition
 type CreateNetworkRequestSettingsSNMPServer struct {
-	ConfigureDnacIP bool     `json:"configureDnacIP,omitempty"` //
+	ConfigureDnacIP *bool    `json:"configureDnacIP,omitempty"` //
 	IPAddresses     []string `json:"ipAddresses,omitempty"`     //
 }
 
@@ -183,7 +184,7 @@ type CreateNetworkRequestSettingsSNMPServerIPAddresses []string
 
 // CreateNetworkRequestSettingsSyslogServer is the createNetworkRequestSettingsSyslogServer definition
 type CreateNetworkRequestSettingsSyslogServer struct {
-	ConfigureDnacIP bool     `json:"configureDnacIP,omitempty"` //
+	ConfigureDnacIP *bool    `json:"configureDnacIP,omitempty"` //
 	IPAddresses     []string `json:"ipAddresses,omitempty"`     //
 }
 
@@ -192,7 +193,7 @@ type CreateNetworkRequestSettingsSyslogServerIPAddresses []string
 
 // CreateSPProfileRequest is the createSPProfileRequest definition
 type CreateSPProfileRequest struct {
-	Settings CreateSPProfileRequestSettings `json:"settings,omitempty"` //
+	Settings *CreateSPProfileRequestSettings `json:"settings,omitempty"` //
 }
 
 // CreateSPProfileRequestSettings is the createSPProfileRequestSettings definition
@@ -209,17 +210,17 @@ type CreateSPProfileRequestSettingsQos struct {
 
 // UpdateDeviceCredentialsRequest is the updateDeviceCredentialsRequest definition
 type UpdateDeviceCredentialsRequest struct {
-	Settings UpdateDeviceCredentialsRequestSettings `json:"settings,omitempty"` //
+	Settings *UpdateDeviceCredentialsRequestSettings `json:"settings,omitempty"` //
 }
 
 // UpdateDeviceCredentialsRequestSettings is the updateDeviceCredentialsRequestSettings definition
 type UpdateDeviceCredentialsRequestSettings struct {
-	CliCredential UpdateDeviceCredentialsRequestSettingsCliCredential `json:"cliCredential,omitempty"` //
-	HTTPSRead     UpdateDeviceCredentialsRequestSettingsHTTPSRead     `json:"httpsRead,omitempty"`     //
-	HTTPSWrite    UpdateDeviceCredentialsRequestSettingsHTTPSWrite    `json:"httpsWrite,omitempty"`    //
-	SNMPV2cRead   UpdateDeviceCredentialsRequestSettingsSNMPV2cRead   `json:"snmpV2cRead,omitempty"`   //
-	SNMPV2cWrite  UpdateDeviceCredentialsRequestSettingsSNMPV2cWrite  `json:"snmpV2cWrite,omitempty"`  //
-	SNMPV3        UpdateDeviceCredentialsRequestSettingsSNMPV3        `json:"snmpV3,omitempty"`        //
+	CliCredential *UpdateDeviceCredentialsRequestSettingsCliCredential `json:"cliCredential,omitempty"` //
+	HTTPSRead     *UpdateDeviceCredentialsRequestSettingsHTTPSRead     `json:"httpsRead,omitempty"`     //
+	HTTPSWrite    *UpdateDeviceCredentialsRequestSettingsHTTPSWrite    `json:"httpsWrite,omitempty"`    //
+	SNMPV2cRead   *UpdateDeviceCredentialsRequestSettingsSNMPV2cRead   `json:"snmpV2cRead,omitempty"`   //
+	SNMPV2cWrite  *UpdateDeviceCredentialsRequestSettingsSNMPV2cWrite  `json:"snmpV2cWrite,omitempty"`  //
+	SNMPV3        *UpdateDeviceCredentialsRequestSettingsSNMPV3        `json:"snmpV3,omitempty"`        //
 }
 
 // UpdateDeviceCredentialsRequestSettingsCliCredential is the updateDeviceCredentialsRequestSettingsCliCredential definition
@@ -277,7 +278,7 @@ type UpdateDeviceCredentialsRequestSettingsSNMPV3 struct {
 
 // UpdateGlobalPoolRequest is the updateGlobalPoolRequest definition
 type UpdateGlobalPoolRequest struct {
-	Settings UpdateGlobalPoolRequestSettings `json:"settings,omitempty"` //
+	Settings *UpdateGlobalPoolRequestSettings `json:"settings,omitempty"` //
 }
 
 // UpdateGlobalPoolRequestSettings is the updateGlobalPoolRequestSettings definition
@@ -302,7 +303,7 @@ type UpdateGlobalPoolRequestSettingsIPpoolDhcpServerIPs []string
 
 // UpdateNetworkRequest is the updateNetworkRequest definition
 type UpdateNetworkRequest struct {
-	Settings UpdateNetworkRequestSettings `json:"settings,omitempty"` //
+	Settings *UpdateNetworkRequestSettings `json:"settings,omitempty"` //
 }
 
 // UpdateNetworkRequestSettings is the updateNetworkRequestSettings definition
@@ -341,13 +342,13 @@ type UpdateNetworkRequestSettingsDhcpServer []string
 // UpdateNetworkRequestSettingsMessageOfTheday is the updateNetworkRequestSettingsMessageOfTheday definition
 type UpdateNetworkRequestSettingsMessageOfTheday struct {
 	BannerMessage        string `json:"bannerMessage,omitempty"`        //
-	RetainExistingBanner bool   `json:"retainExistingBanner,omitempty"` //
+	RetainExistingBanner *bool  `json:"retainExistingBanner,omitempty"` //
 }
 
 // UpdateNetworkRequestSettingsNetflowcollector is the updateNetworkRequestSettingsNetflowcollector definition
 type UpdateNetworkRequestSettingsNetflowcollector struct {
-	IPAddress string  `json:"ipAddress,omitempty"` //
-	Port      float64 `json:"port,omitempty"`      //
+	IPAddress string   `json:"ipAddress,omitempty"` //
+	Port      *float64 `json:"port,omitempty"`      //
 }
 
 // UpdateNetworkRequestSettingsNetworkAAA is the updateNetworkRequestSettingsNetworkAAA definition
@@ -364,7 +365,7 @@ type UpdateNetworkRequestSettingsNtpServer []string
 
 // UpdateNetworkRequestSettingsSNMPServer is the updateNetworkRequestSettingsSNMPServer definition
 type UpdateNetworkRequestSettingsSNMPServer struct {
-	ConfigureDnacIP bool     `json:"configureDnacIP,omitempty"` //
+	ConfigureDnacIP *bool    `json:"configureDnacIP,omitempty"` //
 	IPAddresses     []string `json:"ipAddresses,omitempty"`     //
 }
 
@@ -373,7 +374,7 @@ type UpdateNetworkRequestSettingsSNMPServerIPAddresses []string
 
 // UpdateNetworkRequestSettingsSyslogServer is the updateNetworkRequestSettingsSyslogServer definition
 type UpdateNetworkRequestSettingsSyslogServer struct {
-	ConfigureDnacIP bool     `json:"configureDnacIP,omitempty"` //
+	ConfigureDnacIP *bool    `json:"configureDnacIP,omitempty"` //
 	IPAddresses     []string `json:"ipAddresses,omitempty"`     //
 }
 
@@ -382,7 +383,7 @@ type UpdateNetworkRequestSettingsSyslogServerIPAddresses []string
 
 // UpdateSPProfileRequest is the updateSPProfileRequest definition
 type UpdateSPProfileRequest struct {
-	Settings UpdateSPProfileRequestSettings `json:"settings,omitempty"` //
+	Settings *UpdateSPProfileRequestSettings `json:"settings,omitempty"` //
 }
 
 // UpdateSPProfileRequestSettings is the updateSPProfileRequestSettings definition
@@ -678,11 +679,17 @@ type UpdateSPProfileResponse struct {
 @param siteID site id to assign credential.
 */
 func (s *NetworkSettingsService) AssignCredentialToSite(siteID string, assignCredentialToSiteRequest *AssignCredentialToSiteRequest) (*AssignCredentialToSiteResponse, *resty.Response, error) {
+	return s.AssignCredentialToSiteWithContext(context.Background(), siteID, assignCredentialToSiteRequest)
+}
+
+// AssignCredentialToSiteWithContext is like AssignCredentialToSite but sends the request with ctx for cancellation and deadlines.
+func (s *NetworkSettingsService) AssignCredentialToSiteWithContext(ctx context.Context, siteID string, assignCredentialToSiteRequest *AssignCredentialToSiteRequest) (*AssignCredentialToSiteResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/credential-to-site/{siteId}"
 	path = strings.Replace(path, "{"+"siteId"+"}", fmt.Sprintf("%v", siteID), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(assignCredentialToSiteRequest).
 		SetResult(&AssignCredentialToSiteResponse{}).
 		SetError(&Error{}).
@@ -693,7 +700,7 @@ func (s *NetworkSettingsService) AssignCredentialToSite(siteID string, assignCre
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation assignCredentialToSite")
+		return nil, response, newAPIError("assignCredentialToSite", response)
 	}
 
 	result := response.Result().(*AssignCredentialToSiteResponse)
@@ -704,10 +711,16 @@ func (s *NetworkSettingsService) AssignCredentialToSite(siteID string, assignCre
 /* API to create device credentials.
  */
 func (s *NetworkSettingsService) CreateDeviceCredentials(createDeviceCredentialsRequest *CreateDeviceCredentialsRequest) (*CreateDeviceCredentialsResponse, *resty.Response, error) {
+	return s.CreateDeviceCredentialsWithContext(context.Background(), createDeviceCredentialsRequest)
+}
+
+// CreateDeviceCredentialsWithContext is like CreateDeviceCredentials but sends the request with ctx for cancellation and deadlines.
+func (s *NetworkSettingsService) CreateDeviceCredentialsWithContext(ctx context.Context, createDeviceCredentialsRequest *CreateDeviceCredentialsRequest) (*CreateDeviceCredentialsResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/device-credential"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(createDeviceCredentialsRequest).
 		SetResult(&CreateDeviceCredentialsResponse{}).
 		SetError(&Error{}).
@@ -718,7 +731,7 @@ func (s *NetworkSettingsService) CreateDeviceCredentials(createDeviceCredentials
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation createDeviceCredentials")
+		return nil, response, newAPIError("createDeviceCredentials", response)
 	}
 
 	result := response.Result().(*CreateDeviceCredentialsResponse)
@@ -730,10 +743,16 @@ func (s *NetworkSettingsService) CreateDeviceCredentials(createDeviceCredentials
 @param __persistbapioutput Persist bapi sync response
 */
 func (s *NetworkSettingsService) CreateGlobalPool(createGlobalPoolRequest *CreateGlobalPoolRequest) (*CreateGlobalPoolResponse, *resty.Response, error) {
+	return s.CreateGlobalPoolWithContext(context.Background(), createGlobalPoolRequest)
+}
+
+// CreateGlobalPoolWithContext is like CreateGlobalPool but sends the request with ctx for cancellation and deadlines.
+func (s *NetworkSettingsService) CreateGlobalPoolWithContext(ctx context.Context, createGlobalPoolRequest *CreateGlobalPoolRequest) (*CreateGlobalPoolResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/global-pool"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(createGlobalPoolRequest).
 		SetResult(&CreateGlobalPoolResponse{}).
 		SetError(&Error{}).
@@ -744,7 +763,7 @@ func (s *NetworkSettingsService) CreateGlobalPool(createGlobalPoolRequest *Creat
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation createGlobalPool")
+		return nil, response, newAPIError("createGlobalPool", response)
 	}
 
 	result := response.Result().(*CreateGlobalPoolResponse)
@@ -757,11 +776,17 @@ func (s *NetworkSettingsService) CreateGlobalPool(createGlobalPoolRequest *Creat
 @param siteID Site id to which site details to associate with the network settings.
 */
 func (s *NetworkSettingsService) CreateNetwork(siteID string, createNetworkRequest *CreateNetworkRequest) (*CreateNetworkResponse, *resty.Response, error) {
+	return s.CreateNetworkWithContext(context.Background(), siteID, createNetworkRequest)
+}
+
+// CreateNetworkWithContext is like CreateNetwork but sends the request with ctx for cancellation and deadlines.
+func (s *NetworkSettingsService) CreateNetworkWithContext(ctx context.Context, siteID string, createNetworkRequest *CreateNetworkRequest) (*CreateNetworkResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/network/{siteId}"
 	path = strings.Replace(path, "{"+"siteId"+"}", fmt.Sprintf("%v", siteID), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(createNetworkRequest).
 		SetResult(&CreateNetworkResponse{}).
 		SetError(&Error{}).
@@ -772,7 +797,7 @@ func (s *NetworkSettingsService) CreateNetwork(siteID string, createNetworkReque
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation createNetwork")
+		return nil, response, newAPIError("createNetwork", response)
 	}
 
 	result := response.Result().(*CreateNetworkResponse)
@@ -783,10 +808,16 @@ func (s *NetworkSettingsService) CreateNetwork(siteID string, createNetworkReque
 /* API to create service provider profile(QOS).
  */
 func (s *NetworkSettingsService) CreateSPProfile(createSPProfileRequest *CreateSPProfileRequest) (*CreateSPProfileResponse, *resty.Response, error) {
+	return s.CreateSPProfileWithContext(context.Background(), createSPProfileRequest)
+}
+
+// CreateSPProfileWithContext is like CreateSPProfile but sends the request with ctx for cancellation and deadlines.
+func (s *NetworkSettingsService) CreateSPProfileWithContext(ctx context.Context, createSPProfileRequest *CreateSPProfileRequest) (*CreateSPProfileResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/service-provider"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(createSPProfileRequest).
 		SetResult(&CreateSPProfileResponse{}).
 		SetError(&Error{}).
@@ -797,7 +828,7 @@ func (s *NetworkSettingsService) CreateSPProfile(createSPProfileRequest *CreateS
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation createSPProfile")
+		return nil, response, newAPIError("createSPProfile", response)
 	}
 
 	result := response.Result().(*CreateSPProfileResponse)
@@ -809,11 +840,17 @@ func (s *NetworkSettingsService) CreateSPProfile(createSPProfileRequest *CreateS
 @param id global credential id
 */
 func (s *NetworkSettingsService) DeleteDeviceCredential(id string) (*DeleteDeviceCredentialResponse, *resty.Response, error) {
+	return s.DeleteDeviceCredentialWithContext(context.Background(), id)
+}
+
+// DeleteDeviceCredentialWithContext is like DeleteDeviceCredential but sends the request with ctx for cancellation and deadlines.
+func (s *NetworkSettingsService) DeleteDeviceCredentialWithContext(ctx context.Context, id string) (*DeleteDeviceCredentialResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/device-credential/{id}"
 	path = strings.Replace(path, "{"+"id"+"}", fmt.Sprintf("%v", id), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&DeleteDeviceCredentialResponse{}).
 		SetError(&Error{}).
 		Delete(path)
@@ -823,7 +860,7 @@ func (s *NetworkSettingsService) DeleteDeviceCredential(id string) (*DeleteDevic
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation deleteDeviceCredential")
+		return nil, response, newAPIError("deleteDeviceCredential", response)
 	}
 
 	result := response.Result().(*DeleteDeviceCredentialResponse)
@@ -835,11 +872,17 @@ func (s *NetworkSettingsService) DeleteDeviceCredential(id string) (*DeleteDevic
 @param id global pool id
 */
 func (s *NetworkSettingsService) DeleteGlobalIPPool(id string) (*DeleteGlobalIPPoolResponse, *resty.Response, error) {
+	return s.DeleteGlobalIPPoolWithContext(context.Background(), id)
+}
+
+// DeleteGlobalIPPoolWithContext is like DeleteGlobalIPPool but sends the request with ctx for cancellation and deadlines.
+func (s *NetworkSettingsService) DeleteGlobalIPPoolWithContext(ctx context.Context, id string) (*DeleteGlobalIPPoolResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/global-pool/{id}"
 	path = strings.Replace(path, "{"+"id"+"}", fmt.Sprintf("%v", id), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&DeleteGlobalIPPoolResponse{}).
 		SetError(&Error{}).
 		Delete(path)
@@ -849,7 +892,7 @@ func (s *NetworkSettingsService) DeleteGlobalIPPool(id string) (*DeleteGlobalIPP
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation deleteGlobalIPPool")
+		return nil, response, newAPIError("deleteGlobalIPPool", response)
 	}
 
 	result := response.Result().(*DeleteGlobalIPPoolResponse)
@@ -861,11 +904,17 @@ func (s *NetworkSettingsService) DeleteGlobalIPPool(id string) (*DeleteGlobalIPP
 @param spProfileName sp profile name
 */
 func (s *NetworkSettingsService) DeleteSPProfile(spProfileName string) (*DeleteSPProfileResponse, *resty.Response, error) {
+	return s.DeleteSPProfileWithContext(context.Background(), spProfileName)
+}
+
+// DeleteSPProfileWithContext is like DeleteSPProfile but sends the request with ctx for cancellation and deadlines.
+func (s *NetworkSettingsService) DeleteSPProfileWithContext(ctx context.Context, spProfileName string) (*DeleteSPProfileResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/sp-profile/{spProfileName}"
 	path = strings.Replace(path, "{"+"spProfileName"+"}", fmt.Sprintf("%v", spProfileName), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&DeleteSPProfileResponse{}).
 		SetError(&Error{}).
 		Delete(path)
@@ -875,7 +924,7 @@ func (s *NetworkSettingsService) DeleteSPProfile(spProfileName string) (*DeleteS
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation deleteSPProfile")
+		return nil, response, newAPIError("deleteSPProfile", response)
 	}
 
 	result := response.Result().(*DeleteSPProfileResponse)
@@ -892,12 +941,18 @@ type GetDeviceCredentialDetailsQueryParams struct {
 @param siteID Site id to retrieve the credential details associated with the site.
 */
 func (s *NetworkSettingsService) GetDeviceCredentialDetails(getDeviceCredentialDetailsQueryParams *GetDeviceCredentialDetailsQueryParams) (*GetDeviceCredentialDetailsResponse, *resty.Response, error) {
+	return s.GetDeviceCredentialDetailsWithContext(context.Background(), getDeviceCredentialDetailsQueryParams)
+}
+
+// GetDeviceCredentialDetailsWithContext is like GetDeviceCredentialDetails but sends the request with ctx for cancellation and deadlines.
+func (s *NetworkSettingsService) GetDeviceCredentialDetailsWithContext(ctx context.Context, getDeviceCredentialDetailsQueryParams *GetDeviceCredentialDetailsQueryParams) (*GetDeviceCredentialDetailsResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/device-credential"
 
 	queryString, _ := query.Values(getDeviceCredentialDetailsQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetDeviceCredentialDetailsResponse{}).
 		SetError(&Error{}).
@@ -908,7 +963,7 @@ func (s *NetworkSettingsService) GetDeviceCredentialDetails(getDeviceCredentialD
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getDeviceCredentialDetails")
+		return nil, response, newAPIError("getDeviceCredentialDetails", response)
 	}
 
 	result := response.Result().(*GetDeviceCredentialDetailsResponse)
@@ -927,12 +982,18 @@ type GetGlobalPoolQueryParams struct {
 @param limit No of Global Pools to be retrieved
 */
 func (s *NetworkSettingsService) GetGlobalPool(getGlobalPoolQueryParams *GetGlobalPoolQueryParams) (*GetGlobalPoolResponse, *resty.Response, error) {
+	return s.GetGlobalPoolWithContext(context.Background(), getGlobalPoolQueryParams)
+}
+
+// GetGlobalPoolWithContext is like GetGlobalPool but sends the request with ctx for cancellation and deadlines.
+func (s *NetworkSettingsService) GetGlobalPoolWithContext(ctx context.Context, getGlobalPoolQueryParams *GetGlobalPoolQueryParams) (*GetGlobalPoolResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/global-pool"
 
 	queryString, _ := query.Values(getGlobalPoolQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetGlobalPoolResponse{}).
 		SetError(&Error{}).
@@ -943,7 +1004,7 @@ func (s *NetworkSettingsService) GetGlobalPool(getGlobalPoolQueryParams *GetGlob
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getGlobalPool")
+		return nil, response, newAPIError("getGlobalPool", response)
 	}
 
 	result := response.Result().(*GetGlobalPoolResponse)
@@ -960,12 +1021,18 @@ type GetNetworkQueryParams struct {
 @param siteID Site id to get the network settings associated with the site.
 */
 func (s *NetworkSettingsService) GetNetwork(getNetworkQueryParams *GetNetworkQueryParams) (*GetNetworkResponse, *resty.Response, error) {
+	return s.GetNetworkWithContext(context.Background(), getNetworkQueryParams)
+}
+
+// GetNetworkWithContext is like GetNetwork but sends the request with ctx for cancellation and deadlines.
+func (s *NetworkSettingsService) GetNetworkWithContext(ctx context.Context, getNetworkQueryParams *GetNetworkQueryParams) (*GetNetworkResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/network"
 
 	queryString, _ := query.Values(getNetworkQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetNetworkResponse{}).
 		SetError(&Error{}).
@@ -976,7 +1043,7 @@ func (s *NetworkSettingsService) GetNetwork(getNetworkQueryParams *GetNetworkQue
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getNetwork")
+		return nil, response, newAPIError("getNetwork", response)
 	}
 
 	result := response.Result().(*GetNetworkResponse)
@@ -987,10 +1054,16 @@ func (s *NetworkSettingsService) GetNetwork(getNetworkQueryParams *GetNetworkQue
 /* API to get service provider details (QoS).
  */
 func (s *NetworkSettingsService) GetServiceProviderDetails() (*GetServiceProviderDetailsResponse, *resty.Response, error) {
+	return s.GetServiceProviderDetailsWithContext(context.Background())
+}
+
+// GetServiceProviderDetailsWithContext is like GetServiceProviderDetails but sends the request with ctx for cancellation and deadlines.
+func (s *NetworkSettingsService) GetServiceProviderDetailsWithContext(ctx context.Context) (*GetServiceProviderDetailsResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/service-provider"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&GetServiceProviderDetailsResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -1000,7 +1073,7 @@ func (s *NetworkSettingsService) GetServiceProviderDetails() (*GetServiceProvide
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getServiceProviderDetails")
+		return nil, response, newAPIError("getServiceProviderDetails", response)
 	}
 
 	result := response.Result().(*GetServiceProviderDetailsResponse)
@@ -1011,10 +1084,16 @@ func (s *NetworkSettingsService) GetServiceProviderDetails() (*GetServiceProvide
 /* API to update device credentials.
  */
 func (s *NetworkSettingsService) UpdateDeviceCredentials(updateDeviceCredentialsRequest *UpdateDeviceCredentialsRequest) (*UpdateDeviceCredentialsResponse, *resty.Response, error) {
+	return s.UpdateDeviceCredentialsWithContext(context.Background(), updateDeviceCredentialsRequest)
+}
+
+// UpdateDeviceCredentialsWithContext is like UpdateDeviceCredentials but sends the request with ctx for cancellation and deadlines.
+func (s *NetworkSettingsService) UpdateDeviceCredentialsWithContext(ctx context.Context, updateDeviceCredentialsRequest *UpdateDeviceCredentialsRequest) (*UpdateDeviceCredentialsResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/device-credential"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(updateDeviceCredentialsRequest).
 		SetResult(&UpdateDeviceCredentialsResponse{}).
 		SetError(&Error{}).
@@ -1025,7 +1104,7 @@ func (s *NetworkSettingsService) UpdateDeviceCredentials(updateDeviceCredentials
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation updateDeviceCredentials")
+		return nil, response, newAPIError("updateDeviceCredentials", response)
 	}
 
 	result := response.Result().(*UpdateDeviceCredentialsResponse)
@@ -1036,10 +1115,16 @@ func (s *NetworkSettingsService) UpdateDeviceCredentials(updateDeviceCredentials
 /* API to update global pool
  */
 func (s *NetworkSettingsService) UpdateGlobalPool(updateGlobalPoolRequest *UpdateGlobalPoolRequest) (*UpdateGlobalPoolResponse, *resty.Response, error) {
+	return s.UpdateGlobalPoolWithContext(context.Background(), updateGlobalPoolRequest)
+}
+
+// UpdateGlobalPoolWithContext is like UpdateGlobalPool but sends the request with ctx for cancellation and deadlines.
+func (s *NetworkSettingsService) UpdateGlobalPoolWithContext(ctx context.Context, updateGlobalPoolRequest *UpdateGlobalPoolRequest) (*UpdateGlobalPoolResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/global-pool"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(updateGlobalPoolRequest).
 		SetResult(&UpdateGlobalPoolResponse{}).
 		SetError(&Error{}).
@@ -1050,7 +1135,7 @@ func (s *NetworkSettingsService) UpdateGlobalPool(updateGlobalPoolRequest *Updat
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation updateGlobalPool")
+		return nil, response, newAPIError("updateGlobalPool", response)
 	}
 
 	result := response.Result().(*UpdateGlobalPoolResponse)
@@ -1063,11 +1148,17 @@ func (s *NetworkSettingsService) UpdateGlobalPool(updateGlobalPoolRequest *Updat
 @param siteID Site id to update the network settings which is associated with the site
 */
 func (s *NetworkSettingsService) UpdateNetwork(siteID string, updateNetworkRequest *UpdateNetworkRequest) (*UpdateNetworkResponse, *resty.Response, error) {
+	return s.UpdateNetworkWithContext(context.Background(), siteID, updateNetworkRequest)
+}
+
+// UpdateNetworkWithContext is like UpdateNetwork but sends the request with ctx for cancellation and deadlines.
+func (s *NetworkSettingsService) UpdateNetworkWithContext(ctx context.Context, siteID string, updateNetworkRequest *UpdateNetworkRequest) (*UpdateNetworkResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/network/{siteId}"
 	path = strings.Replace(path, "{"+"siteId"+"}", fmt.Sprintf("%v", siteID), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(updateNetworkRequest).
 		SetResult(&UpdateNetworkResponse{}).
 		SetError(&Error{}).
@@ -1078,7 +1169,7 @@ func (s *NetworkSettingsService) UpdateNetwork(siteID string, updateNetworkReque
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation updateNetwork")
+		return nil, response, newAPIError("updateNetwork", response)
 	}
 
 	result := response.Result().(*UpdateNetworkResponse)
@@ -1089,10 +1180,16 @@ func (s *NetworkSettingsService) UpdateNetwork(siteID string, updateNetworkReque
 /* API to update SP profile
  */
 func (s *NetworkSettingsService) UpdateSPProfile(updateSPProfileRequest *UpdateSPProfileRequest) (*UpdateSPProfileResponse, *resty.Response, error) {
+	return s.UpdateSPProfileWithContext(context.Background(), updateSPProfileRequest)
+}
+
+// UpdateSPProfileWithContext is like UpdateSPProfile but sends the request with ctx for cancellation and deadlines.
+func (s *NetworkSettingsService) UpdateSPProfileWithContext(ctx context.Context, updateSPProfileRequest *UpdateSPProfileRequest) (*UpdateSPProfileResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/service-provider"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(updateSPProfileRequest).
 		SetResult(&UpdateSPProfileResponse{}).
 		SetError(&Error{}).
@@ -1103,7 +1200,7 @@ func (s *NetworkSettingsService) UpdateSPProfile(updateSPProfileRequest *UpdateS
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation updateSPProfile")
+		return nil, response, newAPIError("updateSPProfile", response)
 	}
 
 	result := response.Result().(*UpdateSPProfileResponse)
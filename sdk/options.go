@@ -0,0 +1,123 @@
+package dnac
+
+import (
+	"crypto/tls"
+	"net/http"
+	"time"
+)
+
+// clientConfig holds the settings applied by New. It lives on the Client
+// instance so that several clients can coexist in the same process.
+type clientConfig struct {
+	baseURL    string
+	username   string
+	password   string
+	tlsConfig  *tls.Config
+	httpClient *http.Client
+	debug      bool
+	userAgent  string
+
+	tokenProvider TokenProvider
+	tokenLifetime time.Duration
+	retryPolicy   RetryPolicy
+
+	rateLimit          *RateLimit
+	endpointRateLimits map[string]RateLimit
+}
+
+// Option configures a Client created with New.
+type Option func(*clientConfig)
+
+// WithBaseURL sets the base URL, FQDN or IP, of the DNA Center instance.
+func WithBaseURL(baseURL string) Option {
+	return func(c *clientConfig) {
+		c.baseURL = baseURL
+	}
+}
+
+// WithCredentials sets the username and password used to obtain the access
+// token through a PasswordTokenProvider. It is ignored if a token provider is set.
+func WithCredentials(username string, password string) Option {
+	return func(c *clientConfig) {
+		c.username = username
+		c.password = password
+	}
+}
+
+// WithTLSConfig sets the TLS configuration used by the underlying transport,
+// e.g. &tls.Config{InsecureSkipVerify: true} to disable certificate verification.
+func WithTLSConfig(tlsConfig *tls.Config) Option {
+	return func(c *clientConfig) {
+		c.tlsConfig = tlsConfig
+	}
+}
+
+// WithHTTPClient sets the http.Client used to send requests.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *clientConfig) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithDebug enables or disables request and response logging.
+func WithDebug(debug bool) Option {
+	return func(c *clientConfig) {
+		c.debug = debug
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) Option {
+	return func(c *clientConfig) {
+		c.userAgent = userAgent
+	}
+}
+
+// WithTokenLifetime sets how long a token obtained with WithCredentials is
+// considered valid. The client authenticates again shortly before a token
+// reaches this age. It defaults to one hour, the DNA Center token lifetime.
+func WithTokenLifetime(lifetime time.Duration) Option {
+	return func(c *clientConfig) {
+		c.tokenLifetime = lifetime
+	}
+}
+
+// WithTokenProvider sets the provider of the access tokens, replacing the
+// default PasswordTokenProvider built from WithCredentials.
+func WithTokenProvider(provider TokenProvider) Option {
+	return func(c *clientConfig) {
+		c.tokenProvider = provider
+	}
+}
+
+// WithToken uses a fixed access token instead of authenticating with credentials.
+func WithToken(token string) Option {
+	return WithTokenProvider(StaticTokenProvider(token))
+}
+
+// WithRetryPolicy sets the policy used to retry requests that failed with a
+// transient error. Requests are not retried by default; see DefaultRetryPolicy.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *clientConfig) {
+		c.retryPolicy = policy
+	}
+}
+
+// WithRateLimit limits the rate of all the requests sent by the client.
+// Requests block until capacity is available or their context is done.
+func WithRateLimit(limit RateLimit) Option {
+	return func(c *clientConfig) {
+		c.rateLimit = &limit
+	}
+}
+
+// WithEndpointRateLimit additionally limits the rate of the requests whose
+// path starts with pathPrefix, e.g. "/dna/intent/api/v1/network-device-poller/cli".
+func WithEndpointRateLimit(pathPrefix string, limit RateLimit) Option {
+	return func(c *clientConfig) {
+		if c.endpointRateLimits == nil {
+			c.endpointRateLimits = map[string]RateLimit{}
+		}
+		c.endpointRateLimits[pathPrefix] = limit
+	}
+}
@@ -0,0 +1,560 @@
+package dnac
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/google/go-querystring/query"
+)
+
+// defaultPageSize is the number of items requested per page unless PageOptions say otherwise.
+const defaultPageSize = 100
+
+// PageOptions controls how an iterator walks the pages of a list endpoint.
+type PageOptions struct {
+	PageSize int // Items requested per page. Defaults to 100
+	MaxItems int // Stop after this many items, 0 means no limit
+}
+
+// pager walks the pages of an offset/limit list endpoint. fetch loads the
+// page starting at offset into the iterator and returns its length.
+type pager struct {
+	ctx       context.Context
+	fetch     func(ctx context.Context, offset int, limit int) (int, error)
+	offset    int
+	pageSize  int
+	remaining int // Items left before MaxItems is reached, -1 if unlimited
+	index     int
+	length    int
+	started   bool
+	done      bool
+	err       error
+}
+
+func newPager(ctx context.Context, firstOffset int, opts *PageOptions, fetch func(ctx context.Context, offset int, limit int) (int, error)) pager {
+	p := pager{
+		ctx:       ctx,
+		fetch:     fetch,
+		offset:    firstOffset,
+		pageSize:  defaultPageSize,
+		remaining: -1,
+	}
+	if opts != nil {
+		if opts.PageSize > 0 {
+			p.pageSize = opts.PageSize
+		}
+		if opts.MaxItems > 0 {
+			p.remaining = opts.MaxItems
+		}
+	}
+	return p
+}
+
+// next advances to the next item and returns its index in the current page.
+func (p *pager) next() (int, bool) {
+	if p.err != nil || p.remaining == 0 {
+		return 0, false
+	}
+	if p.started && p.index+1 < p.length {
+		p.index++
+		p.remaining--
+		return p.index, true
+	}
+	if p.done {
+		return 0, false
+	}
+
+	limit := p.pageSize
+	if p.remaining > 0 && p.remaining < limit {
+		limit = p.remaining
+	}
+	n, err := p.fetch(p.ctx, p.offset, limit)
+	if err != nil {
+		p.err = err
+		return 0, false
+	}
+	p.started = true
+	p.offset += n
+	p.length = n
+	p.index = 0
+	// DNA Center caps the page size of some endpoints below the limit asked
+	// for, so a short page does not mean it is the last: only an empty one does.
+	if n == 0 {
+		p.done = true
+		return 0, false
+	}
+	p.remaining--
+	return 0, true
+}
+
+// NetworkDeviceIterator iterates over all the network devices.
+type NetworkDeviceIterator struct {
+	pager
+	page []GetNetworkDeviceByPaginationRangeResponseResponse
+	cur  GetNetworkDeviceByPaginationRangeResponseResponse
+}
+
+// ListAll returns an iterator over all the network devices, fetched page by
+// page with GetNetworkDeviceByPaginationRange. opts may be nil.
+func (s *DevicesService) ListAll(ctx context.Context, opts *PageOptions) *NetworkDeviceIterator {
+	it := &NetworkDeviceIterator{}
+	it.pager = newPager(ctx, 1, opts, func(ctx context.Context, offset int, limit int) (int, error) {
+		result, _, err := s.GetNetworkDeviceByPaginationRangeWithContext(ctx, offset, limit)
+		if err != nil {
+			return 0, err
+		}
+		it.page = result.Response
+		return len(it.page), nil
+	})
+	return it
+}
+
+// Next advances to the next device and reports whether there is one.
+func (it *NetworkDeviceIterator) Next() bool {
+	i, ok := it.next()
+	if ok {
+		it.cur = it.page[i]
+	}
+	return ok
+}
+
+// Device returns the current device.
+func (it *NetworkDeviceIterator) Device() GetNetworkDeviceByPaginationRangeResponseResponse {
+	return it.cur
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *NetworkDeviceIterator) Err() error {
+	return it.err
+}
+
+// MatchingDeviceIterator iterates over the network devices matching a
+// RetrievesAllNetworkDevices query.
+type MatchingDeviceIterator struct {
+	pager
+	page []GetDeviceListResponseResponse
+	cur  GetDeviceListResponseResponse
+}
+
+// ListAllMatching returns an iterator over the network devices matching
+// params, fetched page by page from the endpoint of RetrievesAllNetworkDevices.
+// That method returns the response undecoded, so the pages are requested
+// here and decoded as in GetDeviceList. The Offset and Limit of params are
+// ignored. params and opts may be nil.
+func (s *DevicesService) ListAllMatching(ctx context.Context, params *RetrievesAllNetworkDevicesQueryParams, opts *PageOptions) *MatchingDeviceIterator {
+	it := &MatchingDeviceIterator{}
+	it.pager = newPager(ctx, 1, opts, func(ctx context.Context, offset int, limit int) (int, error) {
+		page := RetrievesAllNetworkDevicesQueryParams{}
+		if params != nil {
+			page = *params
+		}
+		page.Offset = strconv.Itoa(offset)
+		page.Limit = strconv.Itoa(limit)
+		queryString, _ := query.Values(&page)
+		response, err := s.client.R().
+			SetContext(ctx).
+			SetQueryString(queryString.Encode()).
+			SetResult(&GetDeviceListResponse{}).
+			SetError(&Error{}).
+			Get("/dna/intent/api/v1/network-device/autocomplete")
+		if err != nil {
+			return 0, err
+		}
+		if response.IsError() {
+			return 0, newAPIError("retrievesAllNetworkDevices", response)
+		}
+		it.page = response.Result().(*GetDeviceListResponse).Response
+		return len(it.page), nil
+	})
+	return it
+}
+
+// Next advances to the next device and reports whether there is one.
+func (it *MatchingDeviceIterator) Next() bool {
+	i, ok := it.next()
+	if ok {
+		it.cur = it.page[i]
+	}
+	return ok
+}
+
+// Device returns the current device.
+func (it *MatchingDeviceIterator) Device() GetDeviceListResponseResponse {
+	return it.cur
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *MatchingDeviceIterator) Err() error {
+	return it.err
+}
+
+// DiscoveryIterator iterates over all the discoveries.
+type DiscoveryIterator struct {
+	pager
+	page []GetDiscoveriesByRangeResponseResponse
+	cur  GetDiscoveriesByRangeResponseResponse
+}
+
+// ListAll returns an iterator over all the discoveries, fetched page by page
+// with GetDiscoveriesByRange. opts may be nil.
+func (s *DiscoveryService) ListAll(ctx context.Context, opts *PageOptions) *DiscoveryIterator {
+	it := &DiscoveryIterator{}
+	it.pager = newPager(ctx, 1, opts, func(ctx context.Context, offset int, limit int) (int, error) {
+		result, _, err := s.GetDiscoveriesByRangeWithContext(ctx, offset, limit)
+		if err != nil {
+			return 0, err
+		}
+		it.page = result.Response
+		return len(it.page), nil
+	})
+	return it
+}
+
+// Next advances to the next discovery and reports whether there is one.
+func (it *DiscoveryIterator) Next() bool {
+	i, ok := it.next()
+	if ok {
+		it.cur = it.page[i]
+	}
+	return ok
+}
+
+// Discovery returns the current discovery.
+func (it *DiscoveryIterator) Discovery() GetDiscoveriesByRangeResponseResponse {
+	return it.cur
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *DiscoveryIterator) Err() error {
+	return it.err
+}
+
+// TagIterator iterates over the tags matching a GetTag query.
+type TagIterator struct {
+	pager
+	page []GetTagResponseResponse
+	cur  GetTagResponseResponse
+}
+
+// ListAll returns an iterator over the tags matching params, fetched page by
+// page with GetTag. The Offset and Limit of params are ignored. params and
+// opts may be nil.
+func (s *TagService) ListAll(ctx context.Context, params *GetTagQueryParams, opts *PageOptions) *TagIterator {
+	it := &TagIterator{}
+	it.pager = newPager(ctx, 1, opts, func(ctx context.Context, offset int, limit int) (int, error) {
+		page := GetTagQueryParams{}
+		if params != nil {
+			page = *params
+		}
+		page.Offset = strconv.Itoa(offset)
+		page.Limit = strconv.Itoa(limit)
+		result, _, err := s.GetTagWithContext(ctx, &page)
+		if err != nil {
+			return 0, err
+		}
+		it.page = result.Response
+		return len(it.page), nil
+	})
+	return it
+}
+
+// Next advances to the next tag and reports whether there is one.
+func (it *TagIterator) Next() bool {
+	i, ok := it.next()
+	if ok {
+		it.cur = it.page[i]
+	}
+	return ok
+}
+
+// Tag returns the current tag.
+func (it *TagIterator) Tag() GetTagResponseResponse {
+	return it.cur
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *TagIterator) Err() error {
+	return it.err
+}
+
+// EventIterator iterates over the events matching a GetEvents query.
+type EventIterator struct {
+	pager
+	page []GetEventsResponse
+	cur  GetEventsResponse
+}
+
+// ListAllEvents returns an iterator over the events matching params, fetched
+// page by page with GetEvents. The Offset and Limit of params are ignored.
+// opts may be nil.
+func (s *EventManagementService) ListAllEvents(ctx context.Context, params *GetEventsQueryParams, opts *PageOptions) *EventIterator {
+	it := &EventIterator{}
+	it.pager = newPager(ctx, 0, opts, func(ctx context.Context, offset int, limit int) (int, error) {
+		page := GetEventsQueryParams{}
+		if params != nil {
+			page = *params
+		}
+		page.Offset = float64(offset)
+		page.Limit = float64(limit)
+		result, _, err := s.GetEventsWithContext(ctx, &page)
+		if err != nil {
+			return 0, err
+		}
+		it.page = *result
+		return len(it.page), nil
+	})
+	return it
+}
+
+// Next advances to the next event and reports whether there is one.
+func (it *EventIterator) Next() bool {
+	i, ok := it.next()
+	if ok {
+		it.cur = it.page[i]
+	}
+	return ok
+}
+
+// Event returns the current event.
+func (it *EventIterator) Event() GetEventsResponse {
+	return it.cur
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *EventIterator) Err() error {
+	return it.err
+}
+
+// PnpDeviceIterator iterates over the PnP devices matching a GetPnpDeviceList query.
+type PnpDeviceIterator struct {
+	pager
+	page []GetPnpDeviceListResponse
+	cur  GetPnpDeviceListResponse
+}
+
+// ListAllDevices returns an iterator over the PnP devices matching params,
+// fetched page by page with GetPnpDeviceList. The Offset and Limit of params
+// are ignored. params and opts may be nil.
+func (s *DeviceOnboardingPnPService) ListAllDevices(ctx context.Context, params *GetPnpDeviceListQueryParams, opts *PageOptions) *PnpDeviceIterator {
+	it := &PnpDeviceIterator{}
+	it.pager = newPager(ctx, 0, opts, func(ctx context.Context, offset int, limit int) (int, error) {
+		page := GetPnpDeviceListQueryParams{}
+		if params != nil {
+			page = *params
+		}
+		page.Offset = offset
+		page.Limit = limit
+		result, _, err := s.GetPnpDeviceListWithContext(ctx, &page)
+		if err != nil {
+			return 0, err
+		}
+		it.page = *result
+		return len(it.page), nil
+	})
+	return it
+}
+
+// Next advances to the next PnP device and reports whether there is one.
+func (it *PnpDeviceIterator) Next() bool {
+	i, ok := it.next()
+	if ok {
+		it.cur = it.page[i]
+	}
+	return ok
+}
+
+// Device returns the current PnP device.
+func (it *PnpDeviceIterator) Device() GetPnpDeviceListResponse {
+	return it.cur
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *PnpDeviceIterator) Err() error {
+	return it.err
+}
+
+// ApplicationIterator iterates over the applications matching a GetApplications query.
+type ApplicationIterator struct {
+	pager
+	page []GetApplicationsResponseResponse
+	cur  GetApplicationsResponseResponse
+}
+
+// ListAllApplications returns an iterator over the applications matching
+// params, fetched page by page with GetApplications. The Offset and Limit of
+// params are ignored. params and opts may be nil.
+func (s *ApplicationPolicyService) ListAllApplications(ctx context.Context, params *GetApplicationsQueryParams, opts *PageOptions) *ApplicationIterator {
+	it := &ApplicationIterator{}
+	it.pager = newPager(ctx, 1, opts, func(ctx context.Context, offset int, limit int) (int, error) {
+		page := GetApplicationsQueryParams{}
+		if params != nil {
+			page = *params
+		}
+		page.Offset = float64(offset)
+		page.Limit = float64(limit)
+		result, _, err := s.GetApplicationsWithContext(ctx, &page)
+		if err != nil {
+			return 0, err
+		}
+		it.page = result.Response
+		return len(it.page), nil
+	})
+	return it
+}
+
+// Next advances to the next application and reports whether there is one.
+func (it *ApplicationIterator) Next() bool {
+	i, ok := it.next()
+	if ok {
+		it.cur = it.page[i]
+	}
+	return ok
+}
+
+// Application returns the current application.
+func (it *ApplicationIterator) Application() GetApplicationsResponseResponse {
+	return it.cur
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *ApplicationIterator) Err() error {
+	return it.err
+}
+
+// SiteIterator iterates over the sites matching a GetSite query.
+type SiteIterator struct {
+	pager
+	page []GetSiteResponseResponse
+	cur  GetSiteResponseResponse
+}
+
+// ListAll returns an iterator over the sites matching params, fetched page by
+// page with GetSite. The Offset and Limit of params are ignored. params and
+// opts may be nil.
+func (s *SitesService) ListAll(ctx context.Context, params *GetSiteQueryParams, opts *PageOptions) *SiteIterator {
+	it := &SiteIterator{}
+	it.pager = newPager(ctx, 1, opts, func(ctx context.Context, offset int, limit int) (int, error) {
+		page := GetSiteQueryParams{}
+		if params != nil {
+			page = *params
+		}
+		page.Offset = strconv.Itoa(offset)
+		page.Limit = strconv.Itoa(limit)
+		result, _, err := s.GetSiteWithContext(ctx, &page)
+		if err != nil {
+			return 0, err
+		}
+		it.page = result.Response
+		return len(it.page), nil
+	})
+	return it
+}
+
+// Next advances to the next site and reports whether there is one.
+func (it *SiteIterator) Next() bool {
+	i, ok := it.next()
+	if ok {
+		it.cur = it.page[i]
+	}
+	return ok
+}
+
+// Site returns the current site.
+func (it *SiteIterator) Site() GetSiteResponseResponse {
+	return it.cur
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *SiteIterator) Err() error {
+	return it.err
+}
+
+// GlobalPoolIterator iterates over all the global IP pools.
+type GlobalPoolIterator struct {
+	pager
+	page []GetGlobalPoolResponseResponse
+	cur  GetGlobalPoolResponseResponse
+}
+
+// ListAllGlobalPools returns an iterator over all the global IP pools,
+// fetched page by page with GetGlobalPool. opts may be nil.
+func (s *NetworkSettingsService) ListAllGlobalPools(ctx context.Context, opts *PageOptions) *GlobalPoolIterator {
+	it := &GlobalPoolIterator{}
+	it.pager = newPager(ctx, 1, opts, func(ctx context.Context, offset int, limit int) (int, error) {
+		page := GetGlobalPoolQueryParams{Offset: strconv.Itoa(offset), Limit: strconv.Itoa(limit)}
+		result, _, err := s.GetGlobalPoolWithContext(ctx, &page)
+		if err != nil {
+			return 0, err
+		}
+		it.page = result.Response
+		return len(it.page), nil
+	})
+	return it
+}
+
+// Next advances to the next global pool and reports whether there is one.
+func (it *GlobalPoolIterator) Next() bool {
+	i, ok := it.next()
+	if ok {
+		it.cur = it.page[i]
+	}
+	return ok
+}
+
+// Pool returns the current global pool.
+func (it *GlobalPoolIterator) Pool() GetGlobalPoolResponseResponse {
+	return it.cur
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *GlobalPoolIterator) Err() error {
+	return it.err
+}
+
+// TagMemberIterator iterates over the members of a tag.
+type TagMemberIterator struct {
+	pager
+	page []GetTagMembersByIDResponseResponse
+	cur  GetTagMembersByIDResponseResponse
+}
+
+// ListAllMembers returns an iterator over the members of the tag matching
+// params, fetched page by page with GetTagMembersByID. params should set the
+// MemberType; its Offset and Limit are ignored. params and opts may be nil.
+func (s *TagService) ListAllMembers(ctx context.Context, id string, params *GetTagMembersByIDQueryParams, opts *PageOptions) *TagMemberIterator {
+	it := &TagMemberIterator{}
+	it.pager = newPager(ctx, 1, opts, func(ctx context.Context, offset int, limit int) (int, error) {
+		page := GetTagMembersByIDQueryParams{}
+		if params != nil {
+			page = *params
+		}
+		page.Offset = strconv.Itoa(offset)
+		page.Limit = strconv.Itoa(limit)
+		result, _, err := s.GetTagMembersByIDWithContext(ctx, id, &page)
+		if err != nil {
+			return 0, err
+		}
+		it.page = result.Response
+		return len(it.page), nil
+	})
+	return it
+}
+
+// Next advances to the next member and reports whether there is one.
+func (it *TagMemberIterator) Next() bool {
+	i, ok := it.next()
+	if ok {
+		it.cur = it.page[i]
+	}
+	return ok
+}
+
+// Member returns the current member.
+func (it *TagMemberIterator) Member() GetTagMembersByIDResponseResponse {
+	return it.cur
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *TagMemberIterator) Err() error {
+	return it.err
+}
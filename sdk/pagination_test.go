@@ -0,0 +1,138 @@
+package dnac_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+
+	dnac "github.com/cisco-en-programmability/dnacenter-go-sdk/sdk"
+	"github.com/cisco-en-programmability/dnacenter-go-sdk/sdk/dnactest"
+)
+
+func TestListAllMatchingWalksPages(t *testing.T) {
+	srv := dnactest.NewServer()
+	defer srv.Close()
+	srv.HandleFunc(http.MethodGet, "/dna/intent/api/v1/network-device/autocomplete", func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		var devices []map[string]string
+		for i := offset; i < offset+limit && i <= 5; i++ {
+			devices = append(devices, map[string]string{"hostname": "edge-" + strconv.Itoa(i)})
+		}
+		dnactest.WriteJSON(w, http.StatusOK, map[string]interface{}{"response": devices})
+	})
+
+	client, err := srv.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	it := client.Devices.ListAllMatching(context.Background(), &dnac.RetrievesAllNetworkDevicesQueryParams{Family: "Switches and Hubs"}, &dnac.PageOptions{PageSize: 2})
+	var hostnames []string
+	for it.Next() {
+		hostnames = append(hostnames, it.Device().Hostname)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(hostnames) != 5 || hostnames[0] != "edge-1" || hostnames[4] != "edge-5" {
+		t.Errorf("got %v, want edge-1 to edge-5", hostnames)
+	}
+
+	requests := srv.RequestsTo(http.MethodGet, "/dna/intent/api/v1/network-device/autocomplete")
+	if len(requests) != 4 {
+		t.Fatalf("got %d requests, want 3 and the empty page ending the iteration", len(requests))
+	}
+	for i, offset := range []string{"1", "3", "5", "6"} {
+		query := requests[i].Query
+		if query.Get("offset") != offset || query.Get("limit") != "2" || query.Get("family") != "Switches and Hubs" {
+			t.Errorf("request %d has query %v, want offset %s, limit 2 and the family", i, query, offset)
+		}
+	}
+}
+
+func TestListAllMatchingReportsErrors(t *testing.T) {
+	srv := dnactest.NewServer()
+	defer srv.Close()
+	srv.RespondJSON(http.MethodGet, "/dna/intent/api/v1/network-device/autocomplete", http.StatusBadRequest, map[string]string{"message": "bad filter"})
+
+	client, err := srv.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	it := client.Devices.ListAllMatching(context.Background(), nil, nil)
+	if it.Next() {
+		t.Fatal("got a device, want none")
+	}
+	var apiErr *dnac.APIError
+	if !errors.As(it.Err(), &apiErr) || apiErr.StatusCode != http.StatusBadRequest {
+		t.Errorf("got %v, want a 400 API error", it.Err())
+	}
+}
+
+func TestListAllMembersWithoutParams(t *testing.T) {
+	srv := dnactest.NewServer()
+	defer srv.Close()
+	srv.RespondSequence(http.MethodGet, "/dna/intent/api/v1/tag/{id}/member",
+		dnactest.Response{Body: map[string]interface{}{"response": []map[string]string{{"instanceUuid": "m1"}}}},
+		dnactest.Response{Body: map[string]interface{}{"response": []map[string]string{}}},
+	)
+
+	client, err := srv.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	it := client.Tag.ListAllMembers(context.Background(), "t1", nil, nil)
+	var members []string
+	for it.Next() {
+		members = append(members, it.Member().InstanceUUID)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(members) != 1 || members[0] != "m1" {
+		t.Errorf("got %v, want [m1]", members)
+	}
+}
+
+// DNA Center caps the page size of some endpoints, e.g. to 500, below the
+// limit asked for. A short page must not end the iteration.
+func TestListAllContinuesAfterCappedPages(t *testing.T) {
+	const total, maxPage = 7, 3
+	srv := dnactest.NewServer()
+	defer srv.Close()
+	srv.HandleFunc(http.MethodGet, "/dna/intent/api/v1/network-device/{offset}/{limit}", func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(dnactest.PathParam(r, "offset"))
+		limit, _ := strconv.Atoi(dnactest.PathParam(r, "limit"))
+		if limit > maxPage {
+			limit = maxPage
+		}
+		devices := []map[string]string{}
+		for i := offset; i < offset+limit && i <= total; i++ {
+			devices = append(devices, map[string]string{"id": "d" + strconv.Itoa(i)})
+		}
+		dnactest.WriteJSON(w, http.StatusOK, map[string]interface{}{"response": devices})
+	})
+
+	client, err := srv.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	it := client.Devices.ListAll(context.Background(), &dnac.PageOptions{PageSize: 5})
+	var ids []string
+	for it.Next() {
+		ids = append(ids, it.Device().ID)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != total || ids[0] != "d1" || ids[total-1] != "d7" {
+		t.Errorf("got %v, want d1 to d7", ids)
+	}
+	for _, r := range srv.RequestsTo(http.MethodGet, "/dna/intent/api/v1/network-device/{offset}/{limit}") {
+		if r.Params["limit"] != "5" {
+			t.Errorf("request %s asks for limit %s, want 5", r.Path, r.Params["limit"])
+		}
+	}
+}
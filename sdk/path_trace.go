@@ -1,6 +1,7 @@
 package dnac
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -13,11 +14,11 @@ type PathTraceService service
 
 // InitiateANewPathtraceRequest is the initiateANewPathtraceRequest definition
 type InitiateANewPathtraceRequest struct {
-	ControlPath     bool     `json:"controlPath,omitempty"`     //
+	ControlPath     *bool    `json:"controlPath,omitempty"`     //
 	DestIP          string   `json:"destIP,omitempty"`          //
 	DestPort        string   `json:"destPort,omitempty"`        //
 	Inclusions      []string `json:"inclusions,omitempty"`      //
-	PeriodicRefresh bool     `json:"periodicRefresh,omitempty"` //
+	PeriodicRefresh *bool    `json:"periodicRefresh,omitempty"` //
 	Protocol        string   `json:"protocol,omitempty"`        //
 	SourceIP        string   `json:"sourceIP,omitempty"`        //
 	SourcePort      string   `json:"sourcePort,omitempty"`      //
@@ -1237,11 +1238,17 @@ type RetrivesAllPreviousPathtracesSummaryResponseResponseInclusions []string
 @param flowAnalysisID Flow analysis request id
 */
 func (s *PathTraceService) DeletesPathtraceByID(flowAnalysisID string) (*DeletesPathtraceByIDResponse, *resty.Response, error) {
+	return s.DeletesPathtraceByIDWithContext(context.Background(), flowAnalysisID)
+}
+
+// DeletesPathtraceByIDWithContext is like DeletesPathtraceByID but sends the request with ctx for cancellation and deadlines.
+func (s *PathTraceService) DeletesPathtraceByIDWithContext(ctx context.Context, flowAnalysisID string) (*DeletesPathtraceByIDResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/flow-analysis/{flowAnalysisId}"
 	path = strings.Replace(path, "{"+"flowAnalysisId"+"}", fmt.Sprintf("%v", flowAnalysisID), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&DeletesPathtraceByIDResponse{}).
 		SetError(&Error{}).
 		Delete(path)
@@ -1251,7 +1258,7 @@ func (s *PathTraceService) DeletesPathtraceByID(flowAnalysisID string) (*Deletes
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation deletesPathtraceById")
+		return nil, response, newAPIError("deletesPathtraceById", response)
 	}
 
 	result := response.Result().(*DeletesPathtraceByIDResponse)
@@ -1262,10 +1269,16 @@ func (s *PathTraceService) DeletesPathtraceByID(flowAnalysisID string) (*Deletes
 /* Initiates a new flow analysis with periodic refresh and stat collection options. Returns a request id and a task id to get results and follow progress.
  */
 func (s *PathTraceService) InitiateANewPathtrace(initiateANewPathtraceRequest *InitiateANewPathtraceRequest) (*InitiateANewPathtraceResponse, *resty.Response, error) {
+	return s.InitiateANewPathtraceWithContext(context.Background(), initiateANewPathtraceRequest)
+}
+
+// InitiateANewPathtraceWithContext is like InitiateANewPathtrace but sends the request with ctx for cancellation and deadlines.
+func (s *PathTraceService) InitiateANewPathtraceWithContext(ctx context.Context, initiateANewPathtraceRequest *InitiateANewPathtraceRequest) (*InitiateANewPathtraceResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/flow-analysis"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(initiateANewPathtraceRequest).
 		SetResult(&InitiateANewPathtraceResponse{}).
 		SetError(&Error{}).
@@ -1276,7 +1289,7 @@ func (s *PathTraceService) InitiateANewPathtrace(initiateANewPathtraceRequest *I
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation initiateANewPathtrace")
+		return nil, response, newAPIError("initiateANewPathtrace", response)
 	}
 
 	result := response.Result().(*InitiateANewPathtraceResponse)
@@ -1288,11 +1301,17 @@ func (s *PathTraceService) InitiateANewPathtrace(initiateANewPathtraceRequest *I
 @param flowAnalysisID Flow analysis request id
 */
 func (s *PathTraceService) RetrievesPreviousPathtrace(flowAnalysisID string) (*RetrievesPreviousPathtraceResponse, *resty.Response, error) {
+	return s.RetrievesPreviousPathtraceWithContext(context.Background(), flowAnalysisID)
+}
+
+// RetrievesPreviousPathtraceWithContext is like RetrievesPreviousPathtrace but sends the request with ctx for cancellation and deadlines.
+func (s *PathTraceService) RetrievesPreviousPathtraceWithContext(ctx context.Context, flowAnalysisID string) (*RetrievesPreviousPathtraceResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/flow-analysis/{flowAnalysisId}"
 	path = strings.Replace(path, "{"+"flowAnalysisId"+"}", fmt.Sprintf("%v", flowAnalysisID), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&RetrievesPreviousPathtraceResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -1302,7 +1321,7 @@ func (s *PathTraceService) RetrievesPreviousPathtrace(flowAnalysisID string) (*R
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation retrievesPreviousPathtrace")
+		return nil, response, newAPIError("retrievesPreviousPathtrace", response)
 	}
 
 	result := response.Result().(*RetrievesPreviousPathtraceResponse)
@@ -1347,12 +1366,18 @@ type RetrivesAllPreviousPathtracesSummaryQueryParams struct {
 @param sortBy Sort by this field
 */
 func (s *PathTraceService) RetrivesAllPreviousPathtracesSummary(retrivesAllPreviousPathtracesSummaryQueryParams *RetrivesAllPreviousPathtracesSummaryQueryParams) (*RetrivesAllPreviousPathtracesSummaryResponse, *resty.Response, error) {
+	return s.RetrivesAllPreviousPathtracesSummaryWithContext(context.Background(), retrivesAllPreviousPathtracesSummaryQueryParams)
+}
+
+// RetrivesAllPreviousPathtracesSummaryWithContext is like RetrivesAllPreviousPathtracesSummary but sends the request with ctx for cancellation and deadlines.
+func (s *PathTraceService) RetrivesAllPreviousPathtracesSummaryWithContext(ctx context.Context, retrivesAllPreviousPathtracesSummaryQueryParams *RetrivesAllPreviousPathtracesSummaryQueryParams) (*RetrivesAllPreviousPathtracesSummaryResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/flow-analysis"
 
 	queryString, _ := query.Values(retrivesAllPreviousPathtracesSummaryQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&RetrivesAllPreviousPathtracesSummaryResponse{}).
 		SetError(&Error{}).
@@ -1363,7 +1388,7 @@ func (s *PathTraceService) RetrivesAllPreviousPathtracesSummary(retrivesAllPrevi
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation retrivesAllPreviousPathtracesSummary")
+		return nil, response, newAPIError("retrivesAllPreviousPathtracesSummary", response)
 	}
 
 	result := response.Result().(*RetrivesAllPreviousPathtracesSummaryResponse)
@@ -0,0 +1,21 @@
+package dnac
+
+// Request models use pointers for optional booleans, numbers and nested
+// objects, so that an unset field is omitted from the request body while
+// false, 0 and empty objects are still sent when set explicitly. These
+// helpers return pointers to literal values.
+
+// Bool returns a pointer to v.
+func Bool(v bool) *bool {
+	return &v
+}
+
+// Int returns a pointer to v.
+func Int(v int) *int {
+	return &v
+}
+
+// Float64 returns a pointer to v.
+func Float64(v float64) *float64 {
+	return &v
+}
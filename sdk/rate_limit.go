@@ -0,0 +1,135 @@
+package dnac
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// RateLimit allows Requests requests per Per interval on average, with bursts
+// of up to Burst requests. A RateLimit without Requests does not limit.
+type RateLimit struct {
+	Requests int
+	Per      time.Duration
+	Burst    int
+}
+
+// tokenBucket implements a RateLimit.
+type tokenBucket struct {
+	mu       sync.Mutex
+	interval time.Duration // Time to gain one token
+	burst    float64
+	tokens   float64
+	last     time.Time
+}
+
+func newTokenBucket(limit RateLimit) *tokenBucket {
+	burst := limit.Burst
+	if burst < 1 {
+		burst = 1
+	}
+	b := &tokenBucket{
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+	if limit.Requests > 0 {
+		b.interval = limit.Per / time.Duration(limit.Requests)
+	}
+	return b
+}
+
+// wait blocks until a token is available or ctx is done.
+func (b *tokenBucket) wait(ctx context.Context) error {
+	for {
+		b.mu.Lock()
+		now := time.Now()
+		if b.interval > 0 {
+			b.tokens += float64(now.Sub(b.last)) / float64(b.interval)
+		} else {
+			b.tokens = b.burst
+		}
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+		b.last = now
+		if b.tokens >= 1 {
+			b.tokens--
+			b.mu.Unlock()
+			return nil
+		}
+		delay := time.Duration((1 - b.tokens) * float64(b.interval))
+		b.mu.Unlock()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// endpointLimiter applies a token bucket to the requests whose path starts with pathPrefix.
+type endpointLimiter struct {
+	pathPrefix string
+	bucket     *tokenBucket
+}
+
+// rateLimiter throttles the requests of a Client with an optional global
+// limit and limits for groups of endpoints.
+type rateLimiter struct {
+	global    *tokenBucket
+	endpoints []endpointLimiter
+}
+
+func newRateLimiter(cfg clientConfig) *rateLimiter {
+	if cfg.rateLimit == nil && len(cfg.endpointRateLimits) == 0 {
+		return nil
+	}
+	l := &rateLimiter{}
+	if cfg.rateLimit != nil {
+		l.global = newTokenBucket(*cfg.rateLimit)
+	}
+	for pathPrefix, limit := range cfg.endpointRateLimits {
+		l.endpoints = append(l.endpoints, endpointLimiter{
+			pathPrefix: pathPrefix,
+			bucket:     newTokenBucket(limit),
+		})
+	}
+	return l
+}
+
+// wait blocks until the request to path is allowed by the global limit and by
+// the limits of all the endpoint groups it belongs to.
+func (l *rateLimiter) wait(ctx context.Context, path string) error {
+	if l.global != nil {
+		if err := l.global.wait(ctx); err != nil {
+			return err
+		}
+	}
+	for _, endpoint := range l.endpoints {
+		if !strings.HasPrefix(path, endpoint.pathPrefix) {
+			continue
+		}
+		if err := endpoint.bucket.wait(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// waitRateLimit is a request middleware that blocks every request until the
+// rate limiter allows it.
+func (s *Client) waitRateLimit(_ *resty.Client, r *resty.Request) error {
+	path := r.URL
+	if u, err := url.Parse(r.URL); err == nil {
+		path = u.Path
+	}
+	return s.limiter.wait(r.Context(), path)
+}
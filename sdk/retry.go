@@ -0,0 +1,191 @@
+package dnac
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// RetryPolicy controls how requests that failed with a transient error are
+// retried. The zero value disables retries.
+type RetryPolicy struct {
+	MaxAttempts        int             // Total attempts per request including the first one, 1 or less disables retries
+	MinBackoff         time.Duration   // Wait before the first retry, doubled for every further retry
+	MaxBackoff         time.Duration   // Upper bound of the exponential backoff, 0 for none
+	Jitter             float64         // Fraction, between 0 and 1, of the backoff that is randomized
+	StatusCodes        []int           // HTTP status codes that are retried
+	RetryNonIdempotent bool            // Also retry POST and PATCH requests
+	OnRetry            func(RetryInfo) // Called before every retry, may be nil
+}
+
+// RetryInfo describes a request that is about to be retried.
+type RetryInfo struct {
+	Attempt  int             // Number of the attempt that failed, starting at 1
+	Response *resty.Response // Response of the failed attempt
+	Err      error           // Transport error of the failed attempt, if any
+}
+
+// DefaultRetryPolicy returns a policy that makes up to 4 attempts of
+// idempotent requests failing with 429, 502, 503, 504 or a transport error,
+// backing off exponentially from 1 to 30 seconds unless DNA Center sends a
+// Retry-After header.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 4,
+		MinBackoff:  time.Second,
+		MaxBackoff:  30 * time.Second,
+		Jitter:      0.2,
+		StatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+	}
+}
+
+// shouldRetry reports whether a request that failed on the given attempt
+// should be sent again.
+func (p *RetryPolicy) shouldRetry(r *resty.Response, err error, attempt int) bool {
+	if attempt >= p.MaxAttempts || r == nil || r.Request == nil {
+		return false
+	}
+	if !p.RetryNonIdempotent && !isIdempotent(r.Request.Method) {
+		return false
+	}
+	if err != nil {
+		return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+	for _, statusCode := range p.StatusCodes {
+		if r.StatusCode() == statusCode {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff returns how long to wait after the given failed attempt.
+func (p *RetryPolicy) backoff(r *resty.Response, attempt int) time.Duration {
+	if wait, ok := retryAfter(r); ok {
+		return wait
+	}
+	wait := p.MinBackoff
+	for i := 1; i < attempt; i++ {
+		if p.MaxBackoff > 0 && wait >= p.MaxBackoff || wait > math.MaxInt64/2 {
+			break
+		}
+		wait *= 2
+	}
+	if p.MaxBackoff > 0 && wait > p.MaxBackoff {
+		wait = p.MaxBackoff
+	}
+	if p.Jitter > 0 {
+		wait -= time.Duration(p.Jitter * rand.Float64() * float64(wait))
+	}
+	return wait
+}
+
+// retryAfter parses the Retry-After header, given either in seconds or as an HTTP date.
+func retryAfter(r *resty.Response) (time.Duration, bool) {
+	if r == nil {
+		return 0, false
+	}
+	value := r.Header().Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// policyAttempt returns the number of the attempt of r as counted by the
+// retry policy, which does not include the replay after a token refresh.
+func policyAttempt(r *resty.Response) int {
+	attempt := r.Request.Attempt
+	if r.Request.Context().Value(replayedKey{}) != nil {
+		attempt--
+	}
+	return attempt
+}
+
+// retryCondition decides whether resty sends a request again, either to
+// replay it with a new token or because the retry policy asks for it.
+func (s *Client) retryCondition(r *resty.Response, err error) bool {
+	// Streamed bodies are consumed by the first attempt and cannot be sent again.
+	if r != nil && r.Request != nil {
+		if _, ok := r.Request.Body.(io.Reader); ok {
+			return false
+		}
+	}
+	retry := s.retryUnauthorized(r, err) ||
+		r != nil && r.Request != nil && s.cfg.retryPolicy.shouldRetry(r, err, policyAttempt(r))
+	// resty drops the response of an attempt followed by another one.
+	if retry && r.Request.Attempt <= s.common.client.RetryCount {
+		discardBody(r)
+	}
+	return retry
+}
+
+// maxDiscardedBody is the most read from a discarded body so that its
+// connection can be reused; larger bodies are closed unread.
+const maxDiscardedBody = 64 << 10
+
+// discardBody drains and closes the body of a response that resty drops.
+// Requests that do not parse the response, as in DownloadToWriter, leave the
+// body open; the bodies of the others are already closed.
+func discardBody(r *resty.Response) {
+	if r.RawResponse == nil || r.RawResponse.Body == nil {
+		return
+	}
+	io.Copy(io.Discard, io.LimitReader(r.RawResponse.Body, maxDiscardedBody))
+	r.RawResponse.Body.Close()
+}
+
+// retryWait returns how long resty waits before sending a request again.
+func (s *Client) retryWait(_ *resty.Client, r *resty.Response) (time.Duration, error) {
+	wait := time.Millisecond
+	if r.StatusCode() != http.StatusUnauthorized {
+		wait = s.cfg.retryPolicy.backoff(r, policyAttempt(r))
+	}
+	// resty applies its own backoff to a zero wait.
+	if wait <= 0 {
+		wait = time.Nanosecond
+	}
+	return wait, nil
+}
+
+// onRetry reports every retry to the hook of the retry policy.
+func (s *Client) onRetry(r *resty.Response, err error) {
+	if s.cfg.retryPolicy.OnRetry == nil || r == nil || r.Request == nil {
+		return
+	}
+	s.cfg.retryPolicy.OnRetry(RetryInfo{
+		Attempt:  r.Request.Attempt,
+		Response: r,
+		Err:      err,
+	})
+}
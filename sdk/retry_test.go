@@ -0,0 +1,125 @@
+package dnac_test
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+	"time"
+
+	dnac "github.com/cisco-en-programmability/dnacenter-go-sdk/sdk"
+	"github.com/cisco-en-programmability/dnacenter-go-sdk/sdk/dnactest"
+)
+
+const devicePath = "/dna/intent/api/v1/network-device/{id}"
+
+func deviceResponse(id string) dnactest.Response {
+	return dnactest.Response{Body: map[string]interface{}{"response": map[string]string{"id": id}}}
+}
+
+func TestRetryBacksOffExponentiallyWithoutMaxBackoff(t *testing.T) {
+	srv := dnactest.NewServer()
+	defer srv.Close()
+	unavailable := dnactest.Response{Status: http.StatusServiceUnavailable}
+	srv.RespondSequence(http.MethodGet, devicePath, unavailable, unavailable, unavailable, deviceResponse("d1"))
+
+	client, err := srv.Client(dnac.WithRetryPolicy(dnac.RetryPolicy{
+		MaxAttempts: 4,
+		MinBackoff:  20 * time.Millisecond,
+		StatusCodes: []int{http.StatusServiceUnavailable},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Now()
+	if _, _, err := client.Devices.GetDeviceByID("d1"); err != nil {
+		t.Fatal(err)
+	}
+	// 20ms, 40ms and 80ms; without doubling the waits would add up to 60ms.
+	if elapsed := time.Since(start); elapsed < 140*time.Millisecond {
+		t.Errorf("retries took %v, want at least 140ms of exponential backoff", elapsed)
+	}
+	srv.AssertCallCount(t, http.MethodGet, "/dna/intent/api/v1/network-device/d1", 4)
+}
+
+func TestRetryDoesNotCountTokenReplay(t *testing.T) {
+	srv := dnactest.NewServer()
+	defer srv.Close()
+	srv.RespondSequence(http.MethodGet, devicePath,
+		dnactest.Response{Status: http.StatusServiceUnavailable},
+		deviceResponse("d1"),
+	)
+
+	client, err := srv.Client(dnac.WithRetryPolicy(dnac.RetryPolicy{
+		MaxAttempts: 2,
+		MinBackoff:  time.Millisecond,
+		StatusCodes: []int{http.StatusServiceUnavailable},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv.ExpireToken()
+
+	// 401 replayed with a new token, then 503 retried once by the policy.
+	result, _, err := client.Devices.GetDeviceByID("d1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Response.ID != "d1" {
+		t.Errorf("got device %q, want d1", result.Response.ID)
+	}
+	srv.AssertCallCount(t, http.MethodGet, "/dna/intent/api/v1/network-device/d1", 3)
+	srv.AssertCallCount(t, http.MethodPost, "/dna/system/api/v1/auth/token", 2)
+}
+
+func TestTokenReplayHappensOnce(t *testing.T) {
+	srv := dnactest.NewServer()
+	defer srv.Close()
+	srv.RespondJSON(http.MethodGet, devicePath, http.StatusUnauthorized, map[string]string{"message": "rejected"})
+
+	client, err := srv.Client(dnac.WithRetryPolicy(dnac.DefaultRetryPolicy()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _, err = client.Devices.GetDeviceByID("d1")
+	if !dnac.IsUnauthorized(err) {
+		t.Fatalf("got %v, want a 401 error", err)
+	}
+	srv.AssertCallCount(t, http.MethodGet, "/dna/intent/api/v1/network-device/d1", 2)
+}
+
+func TestStreamedBodiesAreNotSentAgain(t *testing.T) {
+	srv := dnactest.NewServer()
+	defer srv.Close()
+	srv.RespondSequence(http.MethodPost, "/upload",
+		dnactest.Response{Status: http.StatusServiceUnavailable},
+		dnactest.Response{Status: http.StatusOK},
+	)
+
+	policy := dnac.DefaultRetryPolicy()
+	policy.MinBackoff = time.Millisecond
+	policy.RetryNonIdempotent = true
+	client, err := srv.Client(dnac.WithRetryPolicy(policy))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	response, err := client.RestyClient().R().SetBody(bytes.NewReader([]byte("image"))).Post(srv.URL + "/upload")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response.StatusCode() != http.StatusServiceUnavailable {
+		t.Errorf("got status %d, want the 503 of the only attempt", response.StatusCode())
+	}
+	srv.AssertCallCount(t, http.MethodPost, "/upload", 1)
+
+	// Nor are they replayed after a token refresh.
+	srv.ExpireToken()
+	response, err = client.RestyClient().R().SetBody(bytes.NewReader([]byte("image"))).Post(srv.URL + "/upload")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response.StatusCode() != http.StatusUnauthorized {
+		t.Errorf("got status %d, want 401", response.StatusCode())
+	}
+	srv.AssertCallCount(t, http.MethodPost, "/upload", 2)
+}
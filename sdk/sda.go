@@ -1,7 +1,7 @@
 package dnac
 
 import (
-	"fmt"
+	"context"
 
 	"github.com/go-resty/resty/v2"
 	"github.com/google/go-querystring/query"
@@ -37,8 +37,8 @@ type AddFabricRequest struct {
 type AddIPPoolInSDAVirtualNetworkRequest struct {
 	AuthenticationPolicyName string `json:"authenticationPolicyName,omitempty"` //
 	IPPoolName               string `json:"ipPoolName,omitempty"`               //
-	IsL2FloodingEnabled      bool   `json:"isL2FloodingEnabled,omitempty"`      //
-	IsThisCriticalPool       bool   `json:"isThisCriticalPool,omitempty"`       //
+	IsL2FloodingEnabled      *bool  `json:"isL2FloodingEnabled,omitempty"`      //
+	IsThisCriticalPool       *bool  `json:"isThisCriticalPool,omitempty"`       //
 	PoolType                 string `json:"poolType,omitempty"`                 //
 	ScalableGroupName        string `json:"scalableGroupName,omitempty"`        //
 	TrafficType              string `json:"trafficType,omitempty"`              //
@@ -80,7 +80,7 @@ type AddVNInSDAFabricRequest struct {
 // AddsBorderDeviceInSDAFabricRequest is the addsBorderDeviceInSDAFabricRequest definition
 type AddsBorderDeviceInSDAFabricRequest struct {
 	BorderSessionType                 string                                                           `json:"borderSessionType,omitempty"`                 //
-	ConnectedToInternet               bool                                                             `json:"connectedToInternet,omitempty"`               //
+	ConnectedToInternet               *bool                                                            `json:"connectedToInternet,omitempty"`               //
 	DeviceManagementIPAddress         string                                                           `json:"deviceManagementIpAddress,omitempty"`         //
 	ExternalConnectivityIPPoolName    string                                                           `json:"externalConnectivityIpPoolName,omitempty"`    //
 	ExternalConnectivitySettings      []AddsBorderDeviceInSDAFabricRequestExternalConnectivitySettings `json:"externalConnectivitySettings,omitempty"`      //
@@ -98,7 +98,7 @@ type AddsBorderDeviceInSDAFabricRequestExternalConnectivitySettings struct {
 
 // AddsBorderDeviceInSDAFabricRequestExternalConnectivitySettingsL3Handoff is the addsBorderDeviceInSDAFabricRequestExternalConnectivitySettingsL3Handoff definition
 type AddsBorderDeviceInSDAFabricRequestExternalConnectivitySettingsL3Handoff struct {
-	VirtualNetwork AddsBorderDeviceInSDAFabricRequestExternalConnectivitySettingsL3HandoffVirtualNetwork `json:"virtualNetwork,omitempty"` //
+	VirtualNetwork *AddsBorderDeviceInSDAFabricRequestExternalConnectivitySettingsL3HandoffVirtualNetwork `json:"virtualNetwork,omitempty"` //
 }
 
 // AddsBorderDeviceInSDAFabricRequestExternalConnectivitySettingsL3HandoffVirtualNetwork is the addsBorderDeviceInSDAFabricRequestExternalConnectivitySettingsL3HandoffVirtualNetwork definition
@@ -116,7 +116,7 @@ type DeleteDefaultAuthenticationProfileFromSDAFabricRequest struct {
 type DeleteIPPoolFromSDAVirtualNetworkRequest struct {
 	AuthenticationPolicyName string `json:"authenticationPolicyName,omitempty"` //
 	IPPoolName               string `json:"ipPoolName,omitempty"`               //
-	IsL2FloodingEnabled      bool   `json:"isL2FloodingEnabled,omitempty"`      //
+	IsL2FloodingEnabled      *bool  `json:"isL2FloodingEnabled,omitempty"`      //
 	ScalableGroupName        string `json:"scalableGroupName,omitempty"`        //
 	SiteNameHierarchy        string `json:"siteNameHierarchy,omitempty"`        //
 	TrafficType              string `json:"trafficType,omitempty"`              //
@@ -691,10 +691,16 @@ type UpdateDefaultAuthenticationProfileInSDAFabricResponse struct {
 /* Add control plane device in SDA Fabric
  */
 func (s *SDAService) AddControlPlaneDeviceInSDAFabric(addControlPlaneDeviceInSDAFabricRequest *[]AddControlPlaneDeviceInSDAFabricRequest) (*AddControlPlaneDeviceInSDAFabricResponse, *resty.Response, error) {
+	return s.AddControlPlaneDeviceInSDAFabricWithContext(context.Background(), addControlPlaneDeviceInSDAFabricRequest)
+}
+
+// AddControlPlaneDeviceInSDAFabricWithContext is like AddControlPlaneDeviceInSDAFabric but sends the request with ctx for cancellation and deadlines.
+func (s *SDAService) AddControlPlaneDeviceInSDAFabricWithContext(ctx context.Context, addControlPlaneDeviceInSDAFabricRequest *[]AddControlPlaneDeviceInSDAFabricRequest) (*AddControlPlaneDeviceInSDAFabricResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/business/sda/control-plane-device"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(addControlPlaneDeviceInSDAFabricRequest).
 		SetResult(&AddControlPlaneDeviceInSDAFabricResponse{}).
 		SetError(&Error{}).
@@ -705,7 +711,7 @@ func (s *SDAService) AddControlPlaneDeviceInSDAFabric(addControlPlaneDeviceInSDA
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation addControlPlaneDeviceInSDAFabric")
+		return nil, response, newAPIError("addControlPlaneDeviceInSDAFabric", response)
 	}
 
 	result := response.Result().(*AddControlPlaneDeviceInSDAFabricResponse)
@@ -716,10 +722,16 @@ func (s *SDAService) AddControlPlaneDeviceInSDAFabric(addControlPlaneDeviceInSDA
 /* Add default authentication profile in SDA Fabric
  */
 func (s *SDAService) AddDefaultAuthenticationProfileInSDAFabric(addDefaultAuthenticationProfileInSDAFabricRequest *[]AddDefaultAuthenticationProfileInSDAFabricRequest) (*AddDefaultAuthenticationProfileInSDAFabricResponse, *resty.Response, error) {
+	return s.AddDefaultAuthenticationProfileInSDAFabricWithContext(context.Background(), addDefaultAuthenticationProfileInSDAFabricRequest)
+}
+
+// AddDefaultAuthenticationProfileInSDAFabricWithContext is like AddDefaultAuthenticationProfileInSDAFabric but sends the request with ctx for cancellation and deadlines.
+func (s *SDAService) AddDefaultAuthenticationProfileInSDAFabricWithContext(ctx context.Context, addDefaultAuthenticationProfileInSDAFabricRequest *[]AddDefaultAuthenticationProfileInSDAFabricRequest) (*AddDefaultAuthenticationProfileInSDAFabricResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/business/sda/authentication-profile"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(addDefaultAuthenticationProfileInSDAFabricRequest).
 		SetResult(&AddDefaultAuthenticationProfileInSDAFabricResponse{}).
 		SetError(&Error{}).
@@ -730,7 +742,7 @@ func (s *SDAService) AddDefaultAuthenticationProfileInSDAFabric(addDefaultAuthen
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation addDefaultAuthenticationProfileInSDAFabric")
+		return nil, response, newAPIError("addDefaultAuthenticationProfileInSDAFabric", response)
 	}
 
 	result := response.Result().(*AddDefaultAuthenticationProfileInSDAFabricResponse)
@@ -741,10 +753,16 @@ func (s *SDAService) AddDefaultAuthenticationProfileInSDAFabric(addDefaultAuthen
 /* Add edge device in SDA Fabric
  */
 func (s *SDAService) AddEdgeDeviceInSDAFabric(addEdgeDeviceInSDAFabricRequest *[]AddEdgeDeviceInSDAFabricRequest) (*AddEdgeDeviceInSDAFabricResponse, *resty.Response, error) {
+	return s.AddEdgeDeviceInSDAFabricWithContext(context.Background(), addEdgeDeviceInSDAFabricRequest)
+}
+
+// AddEdgeDeviceInSDAFabricWithContext is like AddEdgeDeviceInSDAFabric but sends the request with ctx for cancellation and deadlines.
+func (s *SDAService) AddEdgeDeviceInSDAFabricWithContext(ctx context.Context, addEdgeDeviceInSDAFabricRequest *[]AddEdgeDeviceInSDAFabricRequest) (*AddEdgeDeviceInSDAFabricResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/business/sda/edge-device"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(addEdgeDeviceInSDAFabricRequest).
 		SetResult(&AddEdgeDeviceInSDAFabricResponse{}).
 		SetError(&Error{}).
@@ -755,7 +773,7 @@ func (s *SDAService) AddEdgeDeviceInSDAFabric(addEdgeDeviceInSDAFabricRequest *[
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation addEdgeDeviceInSDAFabric")
+		return nil, response, newAPIError("addEdgeDeviceInSDAFabric", response)
 	}
 
 	result := response.Result().(*AddEdgeDeviceInSDAFabricResponse)
@@ -766,10 +784,16 @@ func (s *SDAService) AddEdgeDeviceInSDAFabric(addEdgeDeviceInSDAFabricRequest *[
 /* Add SDA Fabric
  */
 func (s *SDAService) AddFabric(addFabricRequest *[]AddFabricRequest) (*AddFabricResponse, *resty.Response, error) {
+	return s.AddFabricWithContext(context.Background(), addFabricRequest)
+}
+
+// AddFabricWithContext is like AddFabric but sends the request with ctx for cancellation and deadlines.
+func (s *SDAService) AddFabricWithContext(ctx context.Context, addFabricRequest *[]AddFabricRequest) (*AddFabricResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/business/sda/fabric"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(addFabricRequest).
 		SetResult(&AddFabricResponse{}).
 		SetError(&Error{}).
@@ -780,7 +804,7 @@ func (s *SDAService) AddFabric(addFabricRequest *[]AddFabricRequest) (*AddFabric
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation addFabric")
+		return nil, response, newAPIError("addFabric", response)
 	}
 
 	result := response.Result().(*AddFabricResponse)
@@ -791,10 +815,16 @@ func (s *SDAService) AddFabric(addFabricRequest *[]AddFabricRequest) (*AddFabric
 /* Add IP Pool in SDA Virtual Network
  */
 func (s *SDAService) AddIPPoolInSDAVirtualNetwork(addIPPoolInSDAVirtualNetworkRequest *[]AddIPPoolInSDAVirtualNetworkRequest) (*AddIPPoolInSDAVirtualNetworkResponse, *resty.Response, error) {
+	return s.AddIPPoolInSDAVirtualNetworkWithContext(context.Background(), addIPPoolInSDAVirtualNetworkRequest)
+}
+
+// AddIPPoolInSDAVirtualNetworkWithContext is like AddIPPoolInSDAVirtualNetwork but sends the request with ctx for cancellation and deadlines.
+func (s *SDAService) AddIPPoolInSDAVirtualNetworkWithContext(ctx context.Context, addIPPoolInSDAVirtualNetworkRequest *[]AddIPPoolInSDAVirtualNetworkRequest) (*AddIPPoolInSDAVirtualNetworkResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/business/sda/virtualnetwork/ippool"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(addIPPoolInSDAVirtualNetworkRequest).
 		SetResult(&AddIPPoolInSDAVirtualNetworkResponse{}).
 		SetError(&Error{}).
@@ -805,7 +835,7 @@ func (s *SDAService) AddIPPoolInSDAVirtualNetwork(addIPPoolInSDAVirtualNetworkRe
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation addIPPoolInSDAVirtualNetwork")
+		return nil, response, newAPIError("addIPPoolInSDAVirtualNetwork", response)
 	}
 
 	result := response.Result().(*AddIPPoolInSDAVirtualNetworkResponse)
@@ -816,10 +846,16 @@ func (s *SDAService) AddIPPoolInSDAVirtualNetwork(addIPPoolInSDAVirtualNetworkRe
 /* Add Port assignment for access point in SDA Fabric
  */
 func (s *SDAService) AddPortAssignmentForAccessPointInSDAFabric(addPortAssignmentForAccessPointInSDAFabricRequest *[]AddPortAssignmentForAccessPointInSDAFabricRequest) (*AddPortAssignmentForAccessPointInSDAFabricResponse, *resty.Response, error) {
+	return s.AddPortAssignmentForAccessPointInSDAFabricWithContext(context.Background(), addPortAssignmentForAccessPointInSDAFabricRequest)
+}
+
+// AddPortAssignmentForAccessPointInSDAFabricWithContext is like AddPortAssignmentForAccessPointInSDAFabric but sends the request with ctx for cancellation and deadlines.
+func (s *SDAService) AddPortAssignmentForAccessPointInSDAFabricWithContext(ctx context.Context, addPortAssignmentForAccessPointInSDAFabricRequest *[]AddPortAssignmentForAccessPointInSDAFabricRequest) (*AddPortAssignmentForAccessPointInSDAFabricResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/business/sda/hostonboarding/access-point"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(addPortAssignmentForAccessPointInSDAFabricRequest).
 		SetResult(&AddPortAssignmentForAccessPointInSDAFabricResponse{}).
 		SetError(&Error{}).
@@ -830,7 +866,7 @@ func (s *SDAService) AddPortAssignmentForAccessPointInSDAFabric(addPortAssignmen
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation addPortAssignmentForAccessPointInSDAFabric")
+		return nil, response, newAPIError("addPortAssignmentForAccessPointInSDAFabric", response)
 	}
 
 	result := response.Result().(*AddPortAssignmentForAccessPointInSDAFabricResponse)
@@ -841,10 +877,16 @@ func (s *SDAService) AddPortAssignmentForAccessPointInSDAFabric(addPortAssignmen
 /* Add Port assignment for user device in SDA Fabric.
  */
 func (s *SDAService) AddPortAssignmentForUserDeviceInSDAFabric(addPortAssignmentForUserDeviceInSDAFabricRequest *[]AddPortAssignmentForUserDeviceInSDAFabricRequest) (*AddPortAssignmentForUserDeviceInSDAFabricResponse, *resty.Response, error) {
+	return s.AddPortAssignmentForUserDeviceInSDAFabricWithContext(context.Background(), addPortAssignmentForUserDeviceInSDAFabricRequest)
+}
+
+// AddPortAssignmentForUserDeviceInSDAFabricWithContext is like AddPortAssignmentForUserDeviceInSDAFabric but sends the request with ctx for cancellation and deadlines.
+func (s *SDAService) AddPortAssignmentForUserDeviceInSDAFabricWithContext(ctx context.Context, addPortAssignmentForUserDeviceInSDAFabricRequest *[]AddPortAssignmentForUserDeviceInSDAFabricRequest) (*AddPortAssignmentForUserDeviceInSDAFabricResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/business/sda/hostonboarding/user-device"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(addPortAssignmentForUserDeviceInSDAFabricRequest).
 		SetResult(&AddPortAssignmentForUserDeviceInSDAFabricResponse{}).
 		SetError(&Error{}).
@@ -855,7 +897,7 @@ func (s *SDAService) AddPortAssignmentForUserDeviceInSDAFabric(addPortAssignment
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation addPortAssignmentForUserDeviceInSDAFabric")
+		return nil, response, newAPIError("addPortAssignmentForUserDeviceInSDAFabric", response)
 	}
 
 	result := response.Result().(*AddPortAssignmentForUserDeviceInSDAFabricResponse)
@@ -866,10 +908,16 @@ func (s *SDAService) AddPortAssignmentForUserDeviceInSDAFabric(addPortAssignment
 /* Add Site in SDA Fabric
  */
 func (s *SDAService) AddSiteInSDAFabric(addSiteInSDAFabricRequest *[]AddSiteInSDAFabricRequest) (*AddSiteInSDAFabricResponse, *resty.Response, error) {
+	return s.AddSiteInSDAFabricWithContext(context.Background(), addSiteInSDAFabricRequest)
+}
+
+// AddSiteInSDAFabricWithContext is like AddSiteInSDAFabric but sends the request with ctx for cancellation and deadlines.
+func (s *SDAService) AddSiteInSDAFabricWithContext(ctx context.Context, addSiteInSDAFabricRequest *[]AddSiteInSDAFabricRequest) (*AddSiteInSDAFabricResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/business/sda/fabric-site"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(addSiteInSDAFabricRequest).
 		SetResult(&AddSiteInSDAFabricResponse{}).
 		SetError(&Error{}).
@@ -880,7 +928,7 @@ func (s *SDAService) AddSiteInSDAFabric(addSiteInSDAFabricRequest *[]AddSiteInSD
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation addSiteInSDAFabric")
+		return nil, response, newAPIError("addSiteInSDAFabric", response)
 	}
 
 	result := response.Result().(*AddSiteInSDAFabricResponse)
@@ -891,10 +939,16 @@ func (s *SDAService) AddSiteInSDAFabric(addSiteInSDAFabricRequest *[]AddSiteInSD
 /* Add virtual network (VN) in SDA Fabric
  */
 func (s *SDAService) AddVNInSDAFabric(addVNInSDAFabricRequest *[]AddVNInSDAFabricRequest) (*AddVNInSDAFabricResponse, *resty.Response, error) {
+	return s.AddVNInSDAFabricWithContext(context.Background(), addVNInSDAFabricRequest)
+}
+
+// AddVNInSDAFabricWithContext is like AddVNInSDAFabric but sends the request with ctx for cancellation and deadlines.
+func (s *SDAService) AddVNInSDAFabricWithContext(ctx context.Context, addVNInSDAFabricRequest *[]AddVNInSDAFabricRequest) (*AddVNInSDAFabricResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/business/sda/virtual-network"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(addVNInSDAFabricRequest).
 		SetResult(&AddVNInSDAFabricResponse{}).
 		SetError(&Error{}).
@@ -905,7 +959,7 @@ func (s *SDAService) AddVNInSDAFabric(addVNInSDAFabricRequest *[]AddVNInSDAFabri
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation addVNInSDAFabric")
+		return nil, response, newAPIError("addVNInSDAFabric", response)
 	}
 
 	result := response.Result().(*AddVNInSDAFabricResponse)
@@ -916,10 +970,16 @@ func (s *SDAService) AddVNInSDAFabric(addVNInSDAFabricRequest *[]AddVNInSDAFabri
 /* Adds border device in SDA Fabric
  */
 func (s *SDAService) AddsBorderDeviceInSDAFabric(addsBorderDeviceInSDAFabricRequest *[]AddsBorderDeviceInSDAFabricRequest) (*AddsBorderDeviceInSDAFabricResponse, *resty.Response, error) {
+	return s.AddsBorderDeviceInSDAFabricWithContext(context.Background(), addsBorderDeviceInSDAFabricRequest)
+}
+
+// AddsBorderDeviceInSDAFabricWithContext is like AddsBorderDeviceInSDAFabric but sends the request with ctx for cancellation and deadlines.
+func (s *SDAService) AddsBorderDeviceInSDAFabricWithContext(ctx context.Context, addsBorderDeviceInSDAFabricRequest *[]AddsBorderDeviceInSDAFabricRequest) (*AddsBorderDeviceInSDAFabricResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/business/sda/border-device"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(addsBorderDeviceInSDAFabricRequest).
 		SetResult(&AddsBorderDeviceInSDAFabricResponse{}).
 		SetError(&Error{}).
@@ -930,7 +990,7 @@ func (s *SDAService) AddsBorderDeviceInSDAFabric(addsBorderDeviceInSDAFabricRequ
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation addsBorderDeviceInSDAFabric")
+		return nil, response, newAPIError("addsBorderDeviceInSDAFabric", response)
 	}
 
 	result := response.Result().(*AddsBorderDeviceInSDAFabricResponse)
@@ -947,12 +1007,18 @@ type DeleteControlPlaneDeviceInSDAFabricQueryParams struct {
 @param deviceIPAddress Device IP Address
 */
 func (s *SDAService) DeleteControlPlaneDeviceInSDAFabric(deleteControlPlaneDeviceInSDAFabricQueryParams *DeleteControlPlaneDeviceInSDAFabricQueryParams) (*DeleteControlPlaneDeviceInSDAFabricResponse, *resty.Response, error) {
+	return s.DeleteControlPlaneDeviceInSDAFabricWithContext(context.Background(), deleteControlPlaneDeviceInSDAFabricQueryParams)
+}
+
+// DeleteControlPlaneDeviceInSDAFabricWithContext is like DeleteControlPlaneDeviceInSDAFabric but sends the request with ctx for cancellation and deadlines.
+func (s *SDAService) DeleteControlPlaneDeviceInSDAFabricWithContext(ctx context.Context, deleteControlPlaneDeviceInSDAFabricQueryParams *DeleteControlPlaneDeviceInSDAFabricQueryParams) (*DeleteControlPlaneDeviceInSDAFabricResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/business/sda/control-plane-device"
 
 	queryString, _ := query.Values(deleteControlPlaneDeviceInSDAFabricQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&DeleteControlPlaneDeviceInSDAFabricResponse{}).
 		SetError(&Error{}).
@@ -963,7 +1029,7 @@ func (s *SDAService) DeleteControlPlaneDeviceInSDAFabric(deleteControlPlaneDevic
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation deleteControlPlaneDeviceInSDAFabric")
+		return nil, response, newAPIError("deleteControlPlaneDeviceInSDAFabric", response)
 	}
 
 	result := response.Result().(*DeleteControlPlaneDeviceInSDAFabricResponse)
@@ -980,12 +1046,18 @@ type DeleteDefaultAuthenticationProfileFromSDAFabricQueryParams struct {
 @param siteNameHierarchy siteNameHierarchy
 */
 func (s *SDAService) DeleteDefaultAuthenticationProfileFromSDAFabric(deleteDefaultAuthenticationProfileFromSDAFabricQueryParams *DeleteDefaultAuthenticationProfileFromSDAFabricQueryParams, deleteDefaultAuthenticationProfileFromSDAFabricRequest *[]DeleteDefaultAuthenticationProfileFromSDAFabricRequest) (*DeleteDefaultAuthenticationProfileFromSDAFabricResponse, *resty.Response, error) {
+	return s.DeleteDefaultAuthenticationProfileFromSDAFabricWithContext(context.Background(), deleteDefaultAuthenticationProfileFromSDAFabricQueryParams, deleteDefaultAuthenticationProfileFromSDAFabricRequest)
+}
+
+// DeleteDefaultAuthenticationProfileFromSDAFabricWithContext is like DeleteDefaultAuthenticationProfileFromSDAFabric but sends the request with ctx for cancellation and deadlines.
+func (s *SDAService) DeleteDefaultAuthenticationProfileFromSDAFabricWithContext(ctx context.Context, deleteDefaultAuthenticationProfileFromSDAFabricQueryParams *DeleteDefaultAuthenticationProfileFromSDAFabricQueryParams, deleteDefaultAuthenticationProfileFromSDAFabricRequest *[]DeleteDefaultAuthenticationProfileFromSDAFabricRequest) (*DeleteDefaultAuthenticationProfileFromSDAFabricResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/business/sda/authentication-profile"
 
 	queryString, _ := query.Values(deleteDefaultAuthenticationProfileFromSDAFabricQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetBody(deleteDefaultAuthenticationProfileFromSDAFabricRequest).
 		SetResult(&DeleteDefaultAuthenticationProfileFromSDAFabricResponse{}).
@@ -997,7 +1069,7 @@ func (s *SDAService) DeleteDefaultAuthenticationProfileFromSDAFabric(deleteDefau
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation deleteDefaultAuthenticationProfileFromSDAFabric")
+		return nil, response, newAPIError("deleteDefaultAuthenticationProfileFromSDAFabric", response)
 	}
 
 	result := response.Result().(*DeleteDefaultAuthenticationProfileFromSDAFabricResponse)
@@ -1014,12 +1086,18 @@ type DeleteEdgeDeviceFromSDAFabricQueryParams struct {
 @param deviceIPAddress Device IP Address
 */
 func (s *SDAService) DeleteEdgeDeviceFromSDAFabric(deleteEdgeDeviceFromSDAFabricQueryParams *DeleteEdgeDeviceFromSDAFabricQueryParams) (*DeleteEdgeDeviceFromSDAFabricResponse, *resty.Response, error) {
+	return s.DeleteEdgeDeviceFromSDAFabricWithContext(context.Background(), deleteEdgeDeviceFromSDAFabricQueryParams)
+}
+
+// DeleteEdgeDeviceFromSDAFabricWithContext is like DeleteEdgeDeviceFromSDAFabric but sends the request with ctx for cancellation and deadlines.
+func (s *SDAService) DeleteEdgeDeviceFromSDAFabricWithContext(ctx context.Context, deleteEdgeDeviceFromSDAFabricQueryParams *DeleteEdgeDeviceFromSDAFabricQueryParams) (*DeleteEdgeDeviceFromSDAFabricResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/business/sda/edge-device"
 
 	queryString, _ := query.Values(deleteEdgeDeviceFromSDAFabricQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&DeleteEdgeDeviceFromSDAFabricResponse{}).
 		SetError(&Error{}).
@@ -1030,7 +1108,7 @@ func (s *SDAService) DeleteEdgeDeviceFromSDAFabric(deleteEdgeDeviceFromSDAFabric
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation deleteEdgeDeviceFromSDAFabric")
+		return nil, response, newAPIError("deleteEdgeDeviceFromSDAFabric", response)
 	}
 
 	result := response.Result().(*DeleteEdgeDeviceFromSDAFabricResponse)
@@ -1049,12 +1127,18 @@ type DeleteIPPoolFromSDAVirtualNetworkQueryParams struct {
 @param virtualNetworkName virtualNetworkName
 */
 func (s *SDAService) DeleteIPPoolFromSDAVirtualNetwork(deleteIPPoolFromSDAVirtualNetworkQueryParams *DeleteIPPoolFromSDAVirtualNetworkQueryParams, deleteIPPoolFromSDAVirtualNetworkRequest *[]DeleteIPPoolFromSDAVirtualNetworkRequest) (*DeleteIPPoolFromSDAVirtualNetworkResponse, *resty.Response, error) {
+	return s.DeleteIPPoolFromSDAVirtualNetworkWithContext(context.Background(), deleteIPPoolFromSDAVirtualNetworkQueryParams, deleteIPPoolFromSDAVirtualNetworkRequest)
+}
+
+// DeleteIPPoolFromSDAVirtualNetworkWithContext is like DeleteIPPoolFromSDAVirtualNetwork but sends the request with ctx for cancellation and deadlines.
+func (s *SDAService) DeleteIPPoolFromSDAVirtualNetworkWithContext(ctx context.Context, deleteIPPoolFromSDAVirtualNetworkQueryParams *DeleteIPPoolFromSDAVirtualNetworkQueryParams, deleteIPPoolFromSDAVirtualNetworkRequest *[]DeleteIPPoolFromSDAVirtualNetworkRequest) (*DeleteIPPoolFromSDAVirtualNetworkResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/business/sda/virtualnetwork/ippool"
 
 	queryString, _ := query.Values(deleteIPPoolFromSDAVirtualNetworkQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetBody(deleteIPPoolFromSDAVirtualNetworkRequest).
 		SetResult(&DeleteIPPoolFromSDAVirtualNetworkResponse{}).
@@ -1066,7 +1150,7 @@ func (s *SDAService) DeleteIPPoolFromSDAVirtualNetwork(deleteIPPoolFromSDAVirtua
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation deleteIPPoolFromSDAVirtualNetwork")
+		return nil, response, newAPIError("deleteIPPoolFromSDAVirtualNetwork", response)
 	}
 
 	result := response.Result().(*DeleteIPPoolFromSDAVirtualNetworkResponse)
@@ -1085,12 +1169,18 @@ type DeletePortAssignmentForAccessPointInSDAFabricQueryParams struct {
 @param interfaceName interfaceName
 */
 func (s *SDAService) DeletePortAssignmentForAccessPointInSDAFabric(deletePortAssignmentForAccessPointInSDAFabricQueryParams *DeletePortAssignmentForAccessPointInSDAFabricQueryParams, deletePortAssignmentForAccessPointInSDAFabricRequest *[]DeletePortAssignmentForAccessPointInSDAFabricRequest) (*DeletePortAssignmentForAccessPointInSDAFabricResponse, *resty.Response, error) {
+	return s.DeletePortAssignmentForAccessPointInSDAFabricWithContext(context.Background(), deletePortAssignmentForAccessPointInSDAFabricQueryParams, deletePortAssignmentForAccessPointInSDAFabricRequest)
+}
+
+// DeletePortAssignmentForAccessPointInSDAFabricWithContext is like DeletePortAssignmentForAccessPointInSDAFabric but sends the request with ctx for cancellation and deadlines.
+func (s *SDAService) DeletePortAssignmentForAccessPointInSDAFabricWithContext(ctx context.Context, deletePortAssignmentForAccessPointInSDAFabricQueryParams *DeletePortAssignmentForAccessPointInSDAFabricQueryParams, deletePortAssignmentForAccessPointInSDAFabricRequest *[]DeletePortAssignmentForAccessPointInSDAFabricRequest) (*DeletePortAssignmentForAccessPointInSDAFabricResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/business/sda/hostonboarding/access-point"
 
 	queryString, _ := query.Values(deletePortAssignmentForAccessPointInSDAFabricQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetBody(deletePortAssignmentForAccessPointInSDAFabricRequest).
 		SetResult(&DeletePortAssignmentForAccessPointInSDAFabricResponse{}).
@@ -1102,7 +1192,7 @@ func (s *SDAService) DeletePortAssignmentForAccessPointInSDAFabric(deletePortAss
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation deletePortAssignmentForAccessPointInSDAFabric")
+		return nil, response, newAPIError("deletePortAssignmentForAccessPointInSDAFabric", response)
 	}
 
 	result := response.Result().(*DeletePortAssignmentForAccessPointInSDAFabricResponse)
@@ -1121,12 +1211,18 @@ type DeletePortAssignmentForUserDeviceInSDAFabricQueryParams struct {
 @param interfaceName interfaceName
 */
 func (s *SDAService) DeletePortAssignmentForUserDeviceInSDAFabric(deletePortAssignmentForUserDeviceInSDAFabricQueryParams *DeletePortAssignmentForUserDeviceInSDAFabricQueryParams, deletePortAssignmentForUserDeviceInSDAFabricRequest *[]DeletePortAssignmentForUserDeviceInSDAFabricRequest) (*DeletePortAssignmentForUserDeviceInSDAFabricResponse, *resty.Response, error) {
+	return s.DeletePortAssignmentForUserDeviceInSDAFabricWithContext(context.Background(), deletePortAssignmentForUserDeviceInSDAFabricQueryParams, deletePortAssignmentForUserDeviceInSDAFabricRequest)
+}
+
+// DeletePortAssignmentForUserDeviceInSDAFabricWithContext is like DeletePortAssignmentForUserDeviceInSDAFabric but sends the request with ctx for cancellation and deadlines.
+func (s *SDAService) DeletePortAssignmentForUserDeviceInSDAFabricWithContext(ctx context.Context, deletePortAssignmentForUserDeviceInSDAFabricQueryParams *DeletePortAssignmentForUserDeviceInSDAFabricQueryParams, deletePortAssignmentForUserDeviceInSDAFabricRequest *[]DeletePortAssignmentForUserDeviceInSDAFabricRequest) (*DeletePortAssignmentForUserDeviceInSDAFabricResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/business/sda/hostonboarding/user-device"
 
 	queryString, _ := query.Values(deletePortAssignmentForUserDeviceInSDAFabricQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetBody(deletePortAssignmentForUserDeviceInSDAFabricRequest).
 		SetResult(&DeletePortAssignmentForUserDeviceInSDAFabricResponse{}).
@@ -1138,7 +1234,7 @@ func (s *SDAService) DeletePortAssignmentForUserDeviceInSDAFabric(deletePortAssi
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation deletePortAssignmentForUserDeviceInSDAFabric")
+		return nil, response, newAPIError("deletePortAssignmentForUserDeviceInSDAFabric", response)
 	}
 
 	result := response.Result().(*DeletePortAssignmentForUserDeviceInSDAFabricResponse)
@@ -1155,12 +1251,18 @@ type DeleteSDAFabricQueryParams struct {
 @param fabricName Fabric Name
 */
 func (s *SDAService) DeleteSDAFabric(deleteSDAFabricQueryParams *DeleteSDAFabricQueryParams, deleteSDAFabricRequest *[]DeleteSDAFabricRequest) (*DeleteSDAFabricResponse, *resty.Response, error) {
+	return s.DeleteSDAFabricWithContext(context.Background(), deleteSDAFabricQueryParams, deleteSDAFabricRequest)
+}
+
+// DeleteSDAFabricWithContext is like DeleteSDAFabric but sends the request with ctx for cancellation and deadlines.
+func (s *SDAService) DeleteSDAFabricWithContext(ctx context.Context, deleteSDAFabricQueryParams *DeleteSDAFabricQueryParams, deleteSDAFabricRequest *[]DeleteSDAFabricRequest) (*DeleteSDAFabricResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/business/sda/fabric"
 
 	queryString, _ := query.Values(deleteSDAFabricQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetBody(deleteSDAFabricRequest).
 		SetResult(&DeleteSDAFabricResponse{}).
@@ -1172,7 +1274,7 @@ func (s *SDAService) DeleteSDAFabric(deleteSDAFabricQueryParams *DeleteSDAFabric
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation deleteSDAFabric")
+		return nil, response, newAPIError("deleteSDAFabric", response)
 	}
 
 	result := response.Result().(*DeleteSDAFabricResponse)
@@ -1189,12 +1291,18 @@ type DeleteSiteFromSDAFabricQueryParams struct {
 @param siteNameHierarchy Site Name Hierarchy
 */
 func (s *SDAService) DeleteSiteFromSDAFabric(deleteSiteFromSDAFabricQueryParams *DeleteSiteFromSDAFabricQueryParams, deleteSiteFromSDAFabricRequest *[]DeleteSiteFromSDAFabricRequest) (*DeleteSiteFromSDAFabricResponse, *resty.Response, error) {
+	return s.DeleteSiteFromSDAFabricWithContext(context.Background(), deleteSiteFromSDAFabricQueryParams, deleteSiteFromSDAFabricRequest)
+}
+
+// DeleteSiteFromSDAFabricWithContext is like DeleteSiteFromSDAFabric but sends the request with ctx for cancellation and deadlines.
+func (s *SDAService) DeleteSiteFromSDAFabricWithContext(ctx context.Context, deleteSiteFromSDAFabricQueryParams *DeleteSiteFromSDAFabricQueryParams, deleteSiteFromSDAFabricRequest *[]DeleteSiteFromSDAFabricRequest) (*DeleteSiteFromSDAFabricResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/business/sda/fabric-site"
 
 	queryString, _ := query.Values(deleteSiteFromSDAFabricQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetBody(deleteSiteFromSDAFabricRequest).
 		SetResult(&DeleteSiteFromSDAFabricResponse{}).
@@ -1206,7 +1314,7 @@ func (s *SDAService) DeleteSiteFromSDAFabric(deleteSiteFromSDAFabricQueryParams
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation deleteSiteFromSDAFabric")
+		return nil, response, newAPIError("deleteSiteFromSDAFabric", response)
 	}
 
 	result := response.Result().(*DeleteSiteFromSDAFabricResponse)
@@ -1225,12 +1333,18 @@ type DeleteVNFromSDAFabricQueryParams struct {
 @param siteNameHierarchy siteNameHierarchy
 */
 func (s *SDAService) DeleteVNFromSDAFabric(deleteVNFromSDAFabricQueryParams *DeleteVNFromSDAFabricQueryParams) (*DeleteVNFromSDAFabricResponse, *resty.Response, error) {
+	return s.DeleteVNFromSDAFabricWithContext(context.Background(), deleteVNFromSDAFabricQueryParams)
+}
+
+// DeleteVNFromSDAFabricWithContext is like DeleteVNFromSDAFabric but sends the request with ctx for cancellation and deadlines.
+func (s *SDAService) DeleteVNFromSDAFabricWithContext(ctx context.Context, deleteVNFromSDAFabricQueryParams *DeleteVNFromSDAFabricQueryParams) (*DeleteVNFromSDAFabricResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/business/sda/virtual-network"
 
 	queryString, _ := query.Values(deleteVNFromSDAFabricQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&DeleteVNFromSDAFabricResponse{}).
 		SetError(&Error{}).
@@ -1241,7 +1355,7 @@ func (s *SDAService) DeleteVNFromSDAFabric(deleteVNFromSDAFabricQueryParams *Del
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation deleteVNFromSDAFabric")
+		return nil, response, newAPIError("deleteVNFromSDAFabric", response)
 	}
 
 	result := response.Result().(*DeleteVNFromSDAFabricResponse)
@@ -1257,30 +1371,35 @@ type DeletesBorderDeviceFromSDAFabricQueryParams struct {
 /* Deletes border device from SDA Fabric
 @param deviceIPAddress Device IP Address
 */
-// func (s *SDAService) DeletesBorderDeviceFromSDAFabric(deletesBorderDeviceFromSDAFabricQueryParams *DeletesBorderDeviceFromSDAFabricQueryParams, deletesBorderDeviceFromSDAFabricRequest *DeletesBorderDeviceFromSDAFabricRequest) (*DeletesBorderDeviceFromSDAFabricResponse, *resty.Response, error) {
+func (s *SDAService) DeletesBorderDeviceFromSDAFabric(deletesBorderDeviceFromSDAFabricQueryParams *DeletesBorderDeviceFromSDAFabricQueryParams) (*DeletesBorderDeviceFromSDAFabricResponse, *resty.Response, error) {
+	return s.DeletesBorderDeviceFromSDAFabricWithContext(context.Background(), deletesBorderDeviceFromSDAFabricQueryParams)
+}
 
-// 	path := "/dna/intent/api/v1/business/sda/border-device"
+// DeletesBorderDeviceFromSDAFabricWithContext is like DeletesBorderDeviceFromSDAFabric but sends the request with ctx for cancellation and deadlines.
+func (s *SDAService) DeletesBorderDeviceFromSDAFabricWithContext(ctx context.Context, deletesBorderDeviceFromSDAFabricQueryParams *DeletesBorderDeviceFromSDAFabricQueryParams) (*DeletesBorderDeviceFromSDAFabricResponse, *resty.Response, error) {
 
-// 	queryString, _ := query.Values(deletesBorderDeviceFromSDAFabricQueryParams)
+	path := "/dna/intent/api/v1/business/sda/border-device"
 
-// 	response, err := s.client.R().
-// 		SetQueryString(queryString.Encode()).
-// 		SetBody(deletesBorderDeviceFromSDAFabricRequest).
-// 		SetResult(&DeletesBorderDeviceFromSDAFabricResponse{}).
-// 		SetError(&Error{}).
-// 		Delete(path)
+	queryString, _ := query.Values(deletesBorderDeviceFromSDAFabricQueryParams)
 
-// 	if err != nil {
-// 		return nil, nil, err
-// 	}
+	response, err := s.client.R().
+		SetContext(ctx).
+		SetQueryString(queryString.Encode()).
+		SetResult(&DeletesBorderDeviceFromSDAFabricResponse{}).
+		SetError(&Error{}).
+		Delete(path)
 
-// 	if response.IsError() {
-// 		return nil, response, fmt.Errorf("Error with operation deletesBorderDeviceFromSDAFabric")
-// 	}
+	if err != nil {
+		return nil, nil, err
+	}
 
-// 	result := response.Result().(*DeletesBorderDeviceFromSDAFabricResponse)
-// 	return result, response, err
-// }
+	if response.IsError() {
+		return nil, response, newAPIError("deletesBorderDeviceFromSDAFabric", response)
+	}
+
+	result := response.Result().(*DeletesBorderDeviceFromSDAFabricResponse)
+	return result, response, err
+}
 
 // GetControlPlaneDeviceFromSDAFabricQueryParams defines the query parameters for this request
 type GetControlPlaneDeviceFromSDAFabricQueryParams struct {
@@ -1292,12 +1411,18 @@ type GetControlPlaneDeviceFromSDAFabricQueryParams struct {
 @param deviceIPAddress Device IP Address
 */
 func (s *SDAService) GetControlPlaneDeviceFromSDAFabric(getControlPlaneDeviceFromSDAFabricQueryParams *GetControlPlaneDeviceFromSDAFabricQueryParams) (*GetControlPlaneDeviceFromSDAFabricResponse, *resty.Response, error) {
+	return s.GetControlPlaneDeviceFromSDAFabricWithContext(context.Background(), getControlPlaneDeviceFromSDAFabricQueryParams)
+}
+
+// GetControlPlaneDeviceFromSDAFabricWithContext is like GetControlPlaneDeviceFromSDAFabric but sends the request with ctx for cancellation and deadlines.
+func (s *SDAService) GetControlPlaneDeviceFromSDAFabricWithContext(ctx context.Context, getControlPlaneDeviceFromSDAFabricQueryParams *GetControlPlaneDeviceFromSDAFabricQueryParams) (*GetControlPlaneDeviceFromSDAFabricResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/business/sda/control-plane-device"
 
 	queryString, _ := query.Values(getControlPlaneDeviceFromSDAFabricQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetControlPlaneDeviceFromSDAFabricResponse{}).
 		SetError(&Error{}).
@@ -1308,7 +1433,7 @@ func (s *SDAService) GetControlPlaneDeviceFromSDAFabric(getControlPlaneDeviceFro
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getControlPlaneDeviceFromSDAFabric")
+		return nil, response, newAPIError("getControlPlaneDeviceFromSDAFabric", response)
 	}
 
 	result := response.Result().(*GetControlPlaneDeviceFromSDAFabricResponse)
@@ -1325,12 +1450,18 @@ type GetDefaultAuthenticationProfileFromSDAFabricQueryParams struct {
 @param siteNameHierarchy siteNameHierarchy
 */
 func (s *SDAService) GetDefaultAuthenticationProfileFromSDAFabric(getDefaultAuthenticationProfileFromSDAFabricQueryParams *GetDefaultAuthenticationProfileFromSDAFabricQueryParams) (*GetDefaultAuthenticationProfileFromSDAFabricResponse, *resty.Response, error) {
+	return s.GetDefaultAuthenticationProfileFromSDAFabricWithContext(context.Background(), getDefaultAuthenticationProfileFromSDAFabricQueryParams)
+}
+
+// GetDefaultAuthenticationProfileFromSDAFabricWithContext is like GetDefaultAuthenticationProfileFromSDAFabric but sends the request with ctx for cancellation and deadlines.
+func (s *SDAService) GetDefaultAuthenticationProfileFromSDAFabricWithContext(ctx context.Context, getDefaultAuthenticationProfileFromSDAFabricQueryParams *GetDefaultAuthenticationProfileFromSDAFabricQueryParams) (*GetDefaultAuthenticationProfileFromSDAFabricResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/business/sda/authentication-profile"
 
 	queryString, _ := query.Values(getDefaultAuthenticationProfileFromSDAFabricQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetDefaultAuthenticationProfileFromSDAFabricResponse{}).
 		SetError(&Error{}).
@@ -1341,7 +1472,7 @@ func (s *SDAService) GetDefaultAuthenticationProfileFromSDAFabric(getDefaultAuth
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getDefaultAuthenticationProfileFromSDAFabric")
+		return nil, response, newAPIError("getDefaultAuthenticationProfileFromSDAFabric", response)
 	}
 
 	result := response.Result().(*GetDefaultAuthenticationProfileFromSDAFabricResponse)
@@ -1358,12 +1489,18 @@ type GetDeviceInfoFromSDAFabricQueryParams struct {
 @param deviceIPAddress Device IP Address
 */
 func (s *SDAService) GetDeviceInfoFromSDAFabric(getDeviceInfoFromSDAFabricQueryParams *GetDeviceInfoFromSDAFabricQueryParams) (*GetDeviceInfoFromSDAFabricResponse, *resty.Response, error) {
+	return s.GetDeviceInfoFromSDAFabricWithContext(context.Background(), getDeviceInfoFromSDAFabricQueryParams)
+}
+
+// GetDeviceInfoFromSDAFabricWithContext is like GetDeviceInfoFromSDAFabric but sends the request with ctx for cancellation and deadlines.
+func (s *SDAService) GetDeviceInfoFromSDAFabricWithContext(ctx context.Context, getDeviceInfoFromSDAFabricQueryParams *GetDeviceInfoFromSDAFabricQueryParams) (*GetDeviceInfoFromSDAFabricResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/business/sda/device"
 
 	queryString, _ := query.Values(getDeviceInfoFromSDAFabricQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetDeviceInfoFromSDAFabricResponse{}).
 		SetError(&Error{}).
@@ -1374,7 +1511,7 @@ func (s *SDAService) GetDeviceInfoFromSDAFabric(getDeviceInfoFromSDAFabricQueryP
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getDeviceInfoFromSDAFabric")
+		return nil, response, newAPIError("getDeviceInfoFromSDAFabric", response)
 	}
 
 	result := response.Result().(*GetDeviceInfoFromSDAFabricResponse)
@@ -1391,12 +1528,18 @@ type GetDeviceRoleInSDAFabricQueryParams struct {
 @param deviceManagementIPAddress Device Management IP Address
 */
 func (s *SDAService) GetDeviceRoleInSDAFabric(getDeviceRoleInSDAFabricQueryParams *GetDeviceRoleInSDAFabricQueryParams) (*GetDeviceRoleInSDAFabricResponse, *resty.Response, error) {
+	return s.GetDeviceRoleInSDAFabricWithContext(context.Background(), getDeviceRoleInSDAFabricQueryParams)
+}
+
+// GetDeviceRoleInSDAFabricWithContext is like GetDeviceRoleInSDAFabric but sends the request with ctx for cancellation and deadlines.
+func (s *SDAService) GetDeviceRoleInSDAFabricWithContext(ctx context.Context, getDeviceRoleInSDAFabricQueryParams *GetDeviceRoleInSDAFabricQueryParams) (*GetDeviceRoleInSDAFabricResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/business/sda/device/role"
 
 	queryString, _ := query.Values(getDeviceRoleInSDAFabricQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetDeviceRoleInSDAFabricResponse{}).
 		SetError(&Error{}).
@@ -1407,7 +1550,7 @@ func (s *SDAService) GetDeviceRoleInSDAFabric(getDeviceRoleInSDAFabricQueryParam
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getDeviceRoleInSDAFabric")
+		return nil, response, newAPIError("getDeviceRoleInSDAFabric", response)
 	}
 
 	result := response.Result().(*GetDeviceRoleInSDAFabricResponse)
@@ -1424,12 +1567,18 @@ type GetEdgeDeviceFromSDAFabricQueryParams struct {
 @param deviceIPAddress Device IP Address
 */
 func (s *SDAService) GetEdgeDeviceFromSDAFabric(getEdgeDeviceFromSDAFabricQueryParams *GetEdgeDeviceFromSDAFabricQueryParams) (*GetEdgeDeviceFromSDAFabricResponse, *resty.Response, error) {
+	return s.GetEdgeDeviceFromSDAFabricWithContext(context.Background(), getEdgeDeviceFromSDAFabricQueryParams)
+}
+
+// GetEdgeDeviceFromSDAFabricWithContext is like GetEdgeDeviceFromSDAFabric but sends the request with ctx for cancellation and deadlines.
+func (s *SDAService) GetEdgeDeviceFromSDAFabricWithContext(ctx context.Context, getEdgeDeviceFromSDAFabricQueryParams *GetEdgeDeviceFromSDAFabricQueryParams) (*GetEdgeDeviceFromSDAFabricResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/business/sda/edge-device"
 
 	queryString, _ := query.Values(getEdgeDeviceFromSDAFabricQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetEdgeDeviceFromSDAFabricResponse{}).
 		SetError(&Error{}).
@@ -1440,7 +1589,7 @@ func (s *SDAService) GetEdgeDeviceFromSDAFabric(getEdgeDeviceFromSDAFabricQueryP
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getEdgeDeviceFromSDAFabric")
+		return nil, response, newAPIError("getEdgeDeviceFromSDAFabric", response)
 	}
 
 	result := response.Result().(*GetEdgeDeviceFromSDAFabricResponse)
@@ -1459,12 +1608,18 @@ type GetIPPoolFromSDAVirtualNetworkQueryParams struct {
 @param virtualNetworkName virtualNetworkName
 */
 func (s *SDAService) GetIPPoolFromSDAVirtualNetwork(getIPPoolFromSDAVirtualNetworkQueryParams *GetIPPoolFromSDAVirtualNetworkQueryParams) (*GetIPPoolFromSDAVirtualNetworkResponse, *resty.Response, error) {
+	return s.GetIPPoolFromSDAVirtualNetworkWithContext(context.Background(), getIPPoolFromSDAVirtualNetworkQueryParams)
+}
+
+// GetIPPoolFromSDAVirtualNetworkWithContext is like GetIPPoolFromSDAVirtualNetwork but sends the request with ctx for cancellation and deadlines.
+func (s *SDAService) GetIPPoolFromSDAVirtualNetworkWithContext(ctx context.Context, getIPPoolFromSDAVirtualNetworkQueryParams *GetIPPoolFromSDAVirtualNetworkQueryParams) (*GetIPPoolFromSDAVirtualNetworkResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/business/sda/virtualnetwork/ippool"
 
 	queryString, _ := query.Values(getIPPoolFromSDAVirtualNetworkQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetIPPoolFromSDAVirtualNetworkResponse{}).
 		SetError(&Error{}).
@@ -1475,7 +1630,7 @@ func (s *SDAService) GetIPPoolFromSDAVirtualNetwork(getIPPoolFromSDAVirtualNetwo
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getIPPoolFromSDAVirtualNetwork")
+		return nil, response, newAPIError("getIPPoolFromSDAVirtualNetwork", response)
 	}
 
 	result := response.Result().(*GetIPPoolFromSDAVirtualNetworkResponse)
@@ -1494,12 +1649,18 @@ type GetPortAssignmentForAccessPointInSDAFabricQueryParams struct {
 @param interfaceName interfaceName
 */
 func (s *SDAService) GetPortAssignmentForAccessPointInSDAFabric(getPortAssignmentForAccessPointInSDAFabricQueryParams *GetPortAssignmentForAccessPointInSDAFabricQueryParams) (*GetPortAssignmentForAccessPointInSDAFabricResponse, *resty.Response, error) {
+	return s.GetPortAssignmentForAccessPointInSDAFabricWithContext(context.Background(), getPortAssignmentForAccessPointInSDAFabricQueryParams)
+}
+
+// GetPortAssignmentForAccessPointInSDAFabricWithContext is like GetPortAssignmentForAccessPointInSDAFabric but sends the request with ctx for cancellation and deadlines.
+func (s *SDAService) GetPortAssignmentForAccessPointInSDAFabricWithContext(ctx context.Context, getPortAssignmentForAccessPointInSDAFabricQueryParams *GetPortAssignmentForAccessPointInSDAFabricQueryParams) (*GetPortAssignmentForAccessPointInSDAFabricResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/business/sda/hostonboarding/access-point"
 
 	queryString, _ := query.Values(getPortAssignmentForAccessPointInSDAFabricQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetPortAssignmentForAccessPointInSDAFabricResponse{}).
 		SetError(&Error{}).
@@ -1510,7 +1671,7 @@ func (s *SDAService) GetPortAssignmentForAccessPointInSDAFabric(getPortAssignmen
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getPortAssignmentForAccessPointInSDAFabric")
+		return nil, response, newAPIError("getPortAssignmentForAccessPointInSDAFabric", response)
 	}
 
 	result := response.Result().(*GetPortAssignmentForAccessPointInSDAFabricResponse)
@@ -1529,12 +1690,18 @@ type GetPortAssignmentForUserDeviceInSDAFabricQueryParams struct {
 @param interfaceName interfaceName
 */
 func (s *SDAService) GetPortAssignmentForUserDeviceInSDAFabric(getPortAssignmentForUserDeviceInSDAFabricQueryParams *GetPortAssignmentForUserDeviceInSDAFabricQueryParams) (*GetPortAssignmentForUserDeviceInSDAFabricResponse, *resty.Response, error) {
+	return s.GetPortAssignmentForUserDeviceInSDAFabricWithContext(context.Background(), getPortAssignmentForUserDeviceInSDAFabricQueryParams)
+}
+
+// GetPortAssignmentForUserDeviceInSDAFabricWithContext is like GetPortAssignmentForUserDeviceInSDAFabric but sends the request with ctx for cancellation and deadlines.
+func (s *SDAService) GetPortAssignmentForUserDeviceInSDAFabricWithContext(ctx context.Context, getPortAssignmentForUserDeviceInSDAFabricQueryParams *GetPortAssignmentForUserDeviceInSDAFabricQueryParams) (*GetPortAssignmentForUserDeviceInSDAFabricResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/business/sda/hostonboarding/user-device"
 
 	queryString, _ := query.Values(getPortAssignmentForUserDeviceInSDAFabricQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetPortAssignmentForUserDeviceInSDAFabricResponse{}).
 		SetError(&Error{}).
@@ -1545,7 +1712,7 @@ func (s *SDAService) GetPortAssignmentForUserDeviceInSDAFabric(getPortAssignment
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getPortAssignmentForUserDeviceInSDAFabric")
+		return nil, response, newAPIError("getPortAssignmentForUserDeviceInSDAFabric", response)
 	}
 
 	result := response.Result().(*GetPortAssignmentForUserDeviceInSDAFabricResponse)
@@ -1556,10 +1723,16 @@ func (s *SDAService) GetPortAssignmentForUserDeviceInSDAFabric(getPortAssignment
 /* Get SDA Fabric Count
  */
 func (s *SDAService) GetSDAFabricCount() (*GetSDAFabricCountResponse, *resty.Response, error) {
+	return s.GetSDAFabricCountWithContext(context.Background())
+}
+
+// GetSDAFabricCountWithContext is like GetSDAFabricCount but sends the request with ctx for cancellation and deadlines.
+func (s *SDAService) GetSDAFabricCountWithContext(ctx context.Context) (*GetSDAFabricCountResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/business/sda/fabric/count"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&GetSDAFabricCountResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -1569,7 +1742,7 @@ func (s *SDAService) GetSDAFabricCount() (*GetSDAFabricCountResponse, *resty.Res
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getSDAFabricCount")
+		return nil, response, newAPIError("getSDAFabricCount", response)
 	}
 
 	result := response.Result().(*GetSDAFabricCountResponse)
@@ -1586,12 +1759,18 @@ type GetSDAFabricInfoQueryParams struct {
 @param fabricName Fabric Name
 */
 func (s *SDAService) GetSDAFabricInfo(getSDAFabricInfoQueryParams *GetSDAFabricInfoQueryParams) (*GetSDAFabricInfoResponse, *resty.Response, error) {
+	return s.GetSDAFabricInfoWithContext(context.Background(), getSDAFabricInfoQueryParams)
+}
+
+// GetSDAFabricInfoWithContext is like GetSDAFabricInfo but sends the request with ctx for cancellation and deadlines.
+func (s *SDAService) GetSDAFabricInfoWithContext(ctx context.Context, getSDAFabricInfoQueryParams *GetSDAFabricInfoQueryParams) (*GetSDAFabricInfoResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/business/sda/fabric"
 
 	queryString, _ := query.Values(getSDAFabricInfoQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetSDAFabricInfoResponse{}).
 		SetError(&Error{}).
@@ -1602,7 +1781,7 @@ func (s *SDAService) GetSDAFabricInfo(getSDAFabricInfoQueryParams *GetSDAFabricI
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getSDAFabricInfo")
+		return nil, response, newAPIError("getSDAFabricInfo", response)
 	}
 
 	result := response.Result().(*GetSDAFabricInfoResponse)
@@ -1619,12 +1798,18 @@ type GetSiteFromSDAFabricQueryParams struct {
 @param siteNameHierarchy Site Name Hierarchy
 */
 func (s *SDAService) GetSiteFromSDAFabric(getSiteFromSDAFabricQueryParams *GetSiteFromSDAFabricQueryParams) (*GetSiteFromSDAFabricResponse, *resty.Response, error) {
+	return s.GetSiteFromSDAFabricWithContext(context.Background(), getSiteFromSDAFabricQueryParams)
+}
+
+// GetSiteFromSDAFabricWithContext is like GetSiteFromSDAFabric but sends the request with ctx for cancellation and deadlines.
+func (s *SDAService) GetSiteFromSDAFabricWithContext(ctx context.Context, getSiteFromSDAFabricQueryParams *GetSiteFromSDAFabricQueryParams) (*GetSiteFromSDAFabricResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/business/sda/fabric-site"
 
 	queryString, _ := query.Values(getSiteFromSDAFabricQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetSiteFromSDAFabricResponse{}).
 		SetError(&Error{}).
@@ -1635,7 +1820,7 @@ func (s *SDAService) GetSiteFromSDAFabric(getSiteFromSDAFabricQueryParams *GetSi
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getSiteFromSDAFabric")
+		return nil, response, newAPIError("getSiteFromSDAFabric", response)
 	}
 
 	result := response.Result().(*GetSiteFromSDAFabricResponse)
@@ -1654,12 +1839,18 @@ type GetVNFromSDAFabricQueryParams struct {
 @param siteNameHierarchy siteNameHierarchy
 */
 func (s *SDAService) GetVNFromSDAFabric(getVNFromSDAFabricQueryParams *GetVNFromSDAFabricQueryParams) (*GetVNFromSDAFabricResponse, *resty.Response, error) {
+	return s.GetVNFromSDAFabricWithContext(context.Background(), getVNFromSDAFabricQueryParams)
+}
+
+// GetVNFromSDAFabricWithContext is like GetVNFromSDAFabric but sends the request with ctx for cancellation and deadlines.
+func (s *SDAService) GetVNFromSDAFabricWithContext(ctx context.Context, getVNFromSDAFabricQueryParams *GetVNFromSDAFabricQueryParams) (*GetVNFromSDAFabricResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/business/sda/virtual-network"
 
 	queryString, _ := query.Values(getVNFromSDAFabricQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetVNFromSDAFabricResponse{}).
 		SetError(&Error{}).
@@ -1670,7 +1861,7 @@ func (s *SDAService) GetVNFromSDAFabric(getVNFromSDAFabricQueryParams *GetVNFrom
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getVNFromSDAFabric")
+		return nil, response, newAPIError("getVNFromSDAFabric", response)
 	}
 
 	result := response.Result().(*GetVNFromSDAFabricResponse)
@@ -1687,12 +1878,18 @@ type GetsBorderDeviceDetailFromSDAFabricQueryParams struct {
 @param deviceIPAddress Device IP Address
 */
 func (s *SDAService) GetsBorderDeviceDetailFromSDAFabric(getsBorderDeviceDetailFromSDAFabricQueryParams *GetsBorderDeviceDetailFromSDAFabricQueryParams) (*GetsBorderDeviceDetailFromSDAFabricResponse, *resty.Response, error) {
+	return s.GetsBorderDeviceDetailFromSDAFabricWithContext(context.Background(), getsBorderDeviceDetailFromSDAFabricQueryParams)
+}
+
+// GetsBorderDeviceDetailFromSDAFabricWithContext is like GetsBorderDeviceDetailFromSDAFabric but sends the request with ctx for cancellation and deadlines.
+func (s *SDAService) GetsBorderDeviceDetailFromSDAFabricWithContext(ctx context.Context, getsBorderDeviceDetailFromSDAFabricQueryParams *GetsBorderDeviceDetailFromSDAFabricQueryParams) (*GetsBorderDeviceDetailFromSDAFabricResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/business/sda/border-device"
 
 	queryString, _ := query.Values(getsBorderDeviceDetailFromSDAFabricQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetsBorderDeviceDetailFromSDAFabricResponse{}).
 		SetError(&Error{}).
@@ -1703,7 +1900,7 @@ func (s *SDAService) GetsBorderDeviceDetailFromSDAFabric(getsBorderDeviceDetailF
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getsBorderDeviceDetailFromSDAFabric")
+		return nil, response, newAPIError("getsBorderDeviceDetailFromSDAFabric", response)
 	}
 
 	result := response.Result().(*GetsBorderDeviceDetailFromSDAFabricResponse)
@@ -1714,10 +1911,16 @@ func (s *SDAService) GetsBorderDeviceDetailFromSDAFabric(getsBorderDeviceDetailF
 /* Update default authentication profile in SDA Fabric
  */
 func (s *SDAService) UpdateDefaultAuthenticationProfileInSDAFabric(updateDefaultAuthenticationProfileInSDAFabricRequest *[]UpdateDefaultAuthenticationProfileInSDAFabricRequest) (*UpdateDefaultAuthenticationProfileInSDAFabricResponse, *resty.Response, error) {
+	return s.UpdateDefaultAuthenticationProfileInSDAFabricWithContext(context.Background(), updateDefaultAuthenticationProfileInSDAFabricRequest)
+}
+
+// UpdateDefaultAuthenticationProfileInSDAFabricWithContext is like UpdateDefaultAuthenticationProfileInSDAFabric but sends the request with ctx for cancellation and deadlines.
+func (s *SDAService) UpdateDefaultAuthenticationProfileInSDAFabricWithContext(ctx context.Context, updateDefaultAuthenticationProfileInSDAFabricRequest *[]UpdateDefaultAuthenticationProfileInSDAFabricRequest) (*UpdateDefaultAuthenticationProfileInSDAFabricResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/business/sda/authentication-profile"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(updateDefaultAuthenticationProfileInSDAFabricRequest).
 		SetResult(&UpdateDefaultAuthenticationProfileInSDAFabricResponse{}).
 		SetError(&Error{}).
@@ -1728,7 +1931,7 @@ func (s *SDAService) UpdateDefaultAuthenticationProfileInSDAFabric(updateDefault
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation updateDefaultAuthenticationProfileInSDAFabric")
+		return nil, response, newAPIError("updateDefaultAuthenticationProfileInSDAFabric", response)
 	}
 
 	result := response.Result().(*UpdateDefaultAuthenticationProfileInSDAFabricResponse)
@@ -0,0 +1,913 @@
+package dnac
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// FabricSpec is the desired state of an SDA fabric. Items marked Absent are
+// removed from the fabric; items that are not listed are left untouched.
+type FabricSpec struct {
+	FabricName string
+	Absent     bool
+	Sites      []FabricSiteSpec
+}
+
+// FabricSiteSpec is the desired state of a site in the fabric and of what it holds.
+type FabricSiteSpec struct {
+	SiteNameHierarchy string
+	Absent            bool
+	VirtualNetworks   []FabricVirtualNetworkSpec
+	Devices           []FabricDeviceSpec
+	Ports             []FabricPortSpec
+}
+
+// FabricVirtualNetworkSpec is the desired state of a virtual network in a fabric site.
+type FabricVirtualNetworkSpec struct {
+	Name    string
+	Absent  bool
+	IPPools []FabricIPPoolSpec
+}
+
+// FabricIPPoolSpec is the desired state of an IP pool in a virtual network.
+// A pool whose settings differ from the current ones is deleted and added again.
+type FabricIPPoolSpec struct {
+	Name                     string
+	Absent                   bool
+	AuthenticationPolicyName string
+	PoolType                 string
+	ScalableGroupName        string
+	TrafficType              string
+	L2FloodingEnabled        *bool // Left to DNA Center and not compared when nil
+	CriticalPool             *bool // Left to DNA Center and not compared when nil
+}
+
+// FabricDeviceRole is a role a device plays in a fabric site.
+type FabricDeviceRole string
+
+const (
+	// FabricRoleBorder is the border node role.
+	FabricRoleBorder FabricDeviceRole = "border"
+	// FabricRoleControlPlane is the control plane node role.
+	FabricRoleControlPlane FabricDeviceRole = "control-plane"
+	// FabricRoleEdge is the edge node role.
+	FabricRoleEdge FabricDeviceRole = "edge"
+)
+
+// fabricDeviceRoles lists the roles in the order they are added.
+var fabricDeviceRoles = []FabricDeviceRole{FabricRoleControlPlane, FabricRoleBorder, FabricRoleEdge}
+
+// FabricDeviceSpec is the desired set of roles of a device in a fabric site.
+// Roles the device currently has but that are not listed are removed; with
+// Absent, all of its roles are removed.
+type FabricDeviceSpec struct {
+	ManagementIPAddress string
+	Absent              bool
+	Roles               []FabricDeviceRole
+	// Border settings used when the border role is added. Its
+	// DeviceManagementIPAddress and SiteNameHierarchy are filled in.
+	Border *AddsBorderDeviceInSDAFabricRequest
+}
+
+// FabricPortKind is the kind of host onboarding port assignment.
+type FabricPortKind string
+
+const (
+	// FabricPortUserDevice assigns a port to user devices.
+	FabricPortUserDevice FabricPortKind = "user-device"
+	// FabricPortAccessPoint assigns a port to an access point.
+	FabricPortAccessPoint FabricPortKind = "access-point"
+)
+
+// FabricPortSpec is the desired host onboarding assignment of an interface.
+// An assignment whose settings differ from the current ones is deleted and
+// added again.
+type FabricPortSpec struct {
+	Kind                      FabricPortKind // Defaults to FabricPortUserDevice
+	DeviceManagementIPAddress string
+	InterfaceName             string
+	Absent                    bool
+	DataIPAddressPoolName     string
+	VoiceIPAddressPoolName    string
+	AuthenticateTemplateName  string
+	ScalableGroupName         string
+}
+
+// FabricAction is what a FabricChange does.
+type FabricAction string
+
+const (
+	// FabricAdd adds the object to the fabric.
+	FabricAdd FabricAction = "add"
+	// FabricDelete removes the object from the fabric.
+	FabricDelete FabricAction = "delete"
+)
+
+// FabricObject is the kind of object a FabricChange applies to.
+type FabricObject string
+
+// Kinds of fabric objects, in the order they are added.
+const (
+	FabricObjectFabric         FabricObject = "fabric"
+	FabricObjectSite           FabricObject = "site"
+	FabricObjectVirtualNetwork FabricObject = "virtual-network"
+	FabricObjectIPPool         FabricObject = "ip-pool"
+	FabricObjectDevice         FabricObject = "device"
+	FabricObjectPort           FabricObject = "port"
+)
+
+// fabricObjectOrder ranks the kinds of objects in the order they are added;
+// deletes run in the reverse order.
+var fabricObjectOrder = map[FabricObject]int{
+	FabricObjectFabric:         0,
+	FabricObjectSite:           1,
+	FabricObjectVirtualNetwork: 2,
+	FabricObjectIPPool:         3,
+	FabricObjectDevice:         4,
+	FabricObjectPort:           5,
+}
+
+// FabricChange is a single Add or Delete call planned by PlanFabric.
+type FabricChange struct {
+	Action FabricAction
+	Object FabricObject
+	Key    string // Identifies the object, e.g. its site name hierarchy or device IP address
+	Reason string // Why the change is needed
+
+	apply func(ctx context.Context) (*sdaStatus, error)
+}
+
+// String describes the change as one line of a plan.
+func (c FabricChange) String() string {
+	sign := "+"
+	if c.Action == FabricDelete {
+		sign = "-"
+	}
+	line := fmt.Sprintf("%s %s %s", sign, c.Object, c.Key)
+	if c.Reason != "" {
+		line += " (" + c.Reason + ")"
+	}
+	return line
+}
+
+// FabricPlan is the ordered list of changes that brings a fabric to its FabricSpec.
+type FabricPlan struct {
+	Changes []FabricChange
+}
+
+// Empty reports whether the fabric already matches its spec.
+func (p *FabricPlan) Empty() bool {
+	return len(p.Changes) == 0
+}
+
+// String renders the plan one change per line, in the order it is applied.
+func (p *FabricPlan) String() string {
+	if p.Empty() {
+		return "no changes\n"
+	}
+	var b strings.Builder
+	for _, c := range p.Changes {
+		b.WriteString(c.String())
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// ReconcileOptions controls how Reconcile applies a plan.
+type ReconcileOptions struct {
+	DryRun bool                // Only compute the plan
+	Wait   *WaitForTaskOptions // How the execution status of each change is polled
+}
+
+// FabricChangeError is returned when applying a change of a plan failed.
+// The changes before it were applied, the ones after it were not.
+type FabricChangeError struct {
+	Change FabricChange
+	Err    error
+}
+
+// Error implements the error interface.
+func (e *FabricChangeError) Error() string {
+	return fmt.Sprintf("sda: %s: %v", e.Change, e.Err)
+}
+
+// Unwrap returns the error of the failed call.
+func (e *FabricChangeError) Unwrap() error {
+	return e.Err
+}
+
+// Reconcile brings the fabric to the state described by spec: it reads the
+// current state through the Get calls, plans the Add and Delete calls needed
+// and, unless opts.DryRun is set, applies them in order. The plan is
+// returned in both cases. opts may be nil to use the defaults.
+func (s *SDAService) Reconcile(ctx context.Context, spec *FabricSpec, opts *ReconcileOptions) (*FabricPlan, error) {
+	plan, err := s.PlanFabric(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+	if opts != nil && opts.DryRun {
+		return plan, nil
+	}
+	var waitOpts *WaitForTaskOptions
+	if opts != nil {
+		waitOpts = opts.Wait
+	}
+	return plan, s.ApplyFabricPlan(ctx, plan, waitOpts)
+}
+
+// ApplyFabricPlan applies the changes of plan one at a time, waiting for each
+// to complete before starting the next. It stops at the first failure and
+// returns it as a *FabricChangeError, which wraps an *ExecutionError when
+// DNA Center reports that the call failed. Only changes built by PlanFabric
+// can be applied: if plan holds any other, nothing is applied and a
+// *FabricChangeError is returned for the first of them. opts may be nil to
+// use the defaults.
+func (s *SDAService) ApplyFabricPlan(ctx context.Context, plan *FabricPlan, opts *WaitForTaskOptions) error {
+	for _, change := range plan.Changes {
+		if change.apply == nil {
+			return &FabricChangeError{Change: change, Err: errors.New("change not planned by PlanFabric")}
+		}
+	}
+	for _, change := range plan.Changes {
+		status, err := change.apply(ctx)
+		if err == nil {
+			err = s.waitForSDAStatus(ctx, status, opts)
+		}
+		if err != nil {
+			return &FabricChangeError{Change: change, Err: err}
+		}
+	}
+	return nil
+}
+
+// PlanFabric compares the current state of the fabric with spec and returns
+// the changes needed: deletes first, from ports up to the fabric, then adds,
+// from the fabric down to ports.
+func (s *SDAService) PlanFabric(ctx context.Context, spec *FabricSpec) (*FabricPlan, error) {
+	p := &fabricPlanner{s: s}
+	if err := p.plan(ctx, spec); err != nil {
+		return nil, err
+	}
+	return &FabricPlan{Changes: p.ordered()}, nil
+}
+
+// fabricPlanner accumulates the changes of a plan.
+type fabricPlanner struct {
+	s       *SDAService
+	deletes []FabricChange
+	adds    []FabricChange
+	siteIDs map[string]string // IDs of the sites by name hierarchy, looked up once
+}
+
+// ordered returns the deletes, most dependent first, followed by the adds,
+// least dependent first. Changes of the same kind keep the spec order.
+func (p *fabricPlanner) ordered() []FabricChange {
+	var changes []FabricChange
+	for rank := len(fabricObjectOrder) - 1; rank >= 0; rank-- {
+		for _, c := range p.deletes {
+			if fabricObjectOrder[c.Object] == rank {
+				changes = append(changes, c)
+			}
+		}
+	}
+	for rank := 0; rank < len(fabricObjectOrder); rank++ {
+		for _, c := range p.adds {
+			if fabricObjectOrder[c.Object] == rank {
+				changes = append(changes, c)
+			}
+		}
+	}
+	return changes
+}
+
+func (p *fabricPlanner) add(c FabricChange) {
+	c.Action = FabricAdd
+	p.adds = append(p.adds, c)
+}
+
+func (p *fabricPlanner) delete(c FabricChange) {
+	c.Action = FabricDelete
+	p.deletes = append(p.deletes, c)
+}
+
+func (p *fabricPlanner) plan(ctx context.Context, spec *FabricSpec) error {
+	s := p.s
+	info, _, err := s.GetSDAFabricInfoWithContext(ctx, &GetSDAFabricInfoQueryParams{FabricName: spec.FabricName})
+	exists, err := sdaExists(info, err)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case spec.Absent && exists:
+		// Deleting the fabric requires removing what it holds first.
+		for i := range spec.Sites {
+			site := spec.Sites[i]
+			site.Absent = true
+			if err := p.planSite(ctx, spec.FabricName, &site, true); err != nil {
+				return err
+			}
+		}
+		p.delete(FabricChange{
+			Object: FabricObjectFabric,
+			Key:    spec.FabricName,
+			apply: func(ctx context.Context) (*sdaStatus, error) {
+				result, _, err := s.DeleteSDAFabricWithContext(ctx, &DeleteSDAFabricQueryParams{FabricName: spec.FabricName}, nil)
+				return statusOf(result, err)
+			},
+		})
+		return nil
+	case spec.Absent:
+		return nil
+	case !exists:
+		p.add(FabricChange{
+			Object: FabricObjectFabric,
+			Key:    spec.FabricName,
+			Reason: "missing",
+			apply: func(ctx context.Context) (*sdaStatus, error) {
+				result, _, err := s.AddFabricWithContext(ctx, &[]AddFabricRequest{{FabricName: spec.FabricName}})
+				return statusOf(result, err)
+			},
+		})
+	}
+
+	for i := range spec.Sites {
+		if err := p.planSite(ctx, spec.FabricName, &spec.Sites[i], exists); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// planSite plans the changes of a fabric site. When the fabric does not
+// exist yet nothing below it can exist either, so no Get call is made.
+func (p *fabricPlanner) planSite(ctx context.Context, fabricName string, spec *FabricSiteSpec, lookup bool) error {
+	s := p.s
+	site := spec.SiteNameHierarchy
+	exists := false
+	if lookup {
+		result, _, err := s.GetSiteFromSDAFabricWithContext(ctx, &GetSiteFromSDAFabricQueryParams{SiteNameHierarchy: site})
+		var err2 error
+		if exists, err2 = sdaExists(result, err); err2 != nil {
+			return err2
+		}
+	}
+
+	if spec.Absent {
+		if !exists {
+			return nil
+		}
+		for i := range spec.VirtualNetworks {
+			vn := spec.VirtualNetworks[i]
+			vn.Absent = true
+			if err := p.planVirtualNetwork(ctx, site, &vn, true); err != nil {
+				return err
+			}
+		}
+		for i := range spec.Devices {
+			device := spec.Devices[i]
+			device.Absent = true
+			if err := p.planDevice(ctx, site, &device, true); err != nil {
+				return err
+			}
+		}
+		for i := range spec.Ports {
+			port := spec.Ports[i]
+			port.Absent = true
+			if err := p.planPort(ctx, site, &port, true); err != nil {
+				return err
+			}
+		}
+		p.delete(FabricChange{
+			Object: FabricObjectSite,
+			Key:    site,
+			apply: func(ctx context.Context) (*sdaStatus, error) {
+				result, _, err := s.DeleteSiteFromSDAFabricWithContext(ctx, &DeleteSiteFromSDAFabricQueryParams{SiteNameHierarchy: site}, nil)
+				return statusOf(result, err)
+			},
+		})
+		return nil
+	}
+
+	if !exists {
+		p.add(FabricChange{
+			Object: FabricObjectSite,
+			Key:    site,
+			Reason: "missing",
+			apply: func(ctx context.Context) (*sdaStatus, error) {
+				request := &[]AddSiteInSDAFabricRequest{{FabricName: fabricName, SiteNameHierarchy: site}}
+				result, _, err := s.AddSiteInSDAFabricWithContext(ctx, request)
+				return statusOf(result, err)
+			},
+		})
+	}
+	for i := range spec.VirtualNetworks {
+		if err := p.planVirtualNetwork(ctx, site, &spec.VirtualNetworks[i], exists); err != nil {
+			return err
+		}
+	}
+	for i := range spec.Devices {
+		if err := p.planDevice(ctx, site, &spec.Devices[i], exists); err != nil {
+			return err
+		}
+	}
+	for i := range spec.Ports {
+		if err := p.planPort(ctx, site, &spec.Ports[i], exists); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *fabricPlanner) planVirtualNetwork(ctx context.Context, site string, spec *FabricVirtualNetworkSpec, lookup bool) error {
+	s := p.s
+	name := spec.Name
+	key := site + " " + name
+	exists := false
+	if lookup {
+		result, _, err := s.GetVNFromSDAFabricWithContext(ctx, &GetVNFromSDAFabricQueryParams{VirtualNetworkName: name, SiteNameHierarchy: site})
+		var err2 error
+		if exists, err2 = sdaExists(result, err); err2 != nil {
+			return err2
+		}
+	}
+
+	if spec.Absent {
+		if !exists {
+			return nil
+		}
+		for i := range spec.IPPools {
+			pool := spec.IPPools[i]
+			pool.Absent = true
+			if err := p.planIPPool(ctx, site, name, &pool, true); err != nil {
+				return err
+			}
+		}
+		p.delete(FabricChange{
+			Object: FabricObjectVirtualNetwork,
+			Key:    key,
+			apply: func(ctx context.Context) (*sdaStatus, error) {
+				result, _, err := s.DeleteVNFromSDAFabricWithContext(ctx, &DeleteVNFromSDAFabricQueryParams{VirtualNetworkName: name, SiteNameHierarchy: site})
+				return statusOf(result, err)
+			},
+		})
+		return nil
+	}
+
+	if !exists {
+		p.add(FabricChange{
+			Object: FabricObjectVirtualNetwork,
+			Key:    key,
+			Reason: "missing",
+			apply: func(ctx context.Context) (*sdaStatus, error) {
+				request := &[]AddVNInSDAFabricRequest{{VirtualNetworkName: name, SiteNameHierarchy: site}}
+				result, _, err := s.AddVNInSDAFabricWithContext(ctx, request)
+				return statusOf(result, err)
+			},
+		})
+	}
+	for i := range spec.IPPools {
+		if err := p.planIPPool(ctx, site, name, &spec.IPPools[i], exists); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *fabricPlanner) planIPPool(ctx context.Context, site string, vn string, spec *FabricIPPoolSpec, lookup bool) error {
+	s := p.s
+	key := site + " " + vn + " " + spec.Name
+	var current *GetIPPoolFromSDAVirtualNetworkResponse
+	exists := false
+	if lookup {
+		var err error
+		current, _, err = s.GetIPPoolFromSDAVirtualNetworkWithContext(ctx, &GetIPPoolFromSDAVirtualNetworkQueryParams{IPPoolName: spec.Name, VirtualNetworkName: vn})
+		if exists, err = sdaExists(current, err); err != nil {
+			return err
+		}
+	}
+
+	reason := "missing"
+	if exists {
+		if spec.Absent {
+			reason = ""
+		} else if reason = ipPoolDiff(current, spec); reason == "" {
+			return nil
+		}
+		p.delete(FabricChange{
+			Object: FabricObjectIPPool,
+			Key:    key,
+			Reason: reason,
+			apply: func(ctx context.Context) (*sdaStatus, error) {
+				query := &DeleteIPPoolFromSDAVirtualNetworkQueryParams{IPPoolName: spec.Name, VirtualNetworkName: vn}
+				request := &[]DeleteIPPoolFromSDAVirtualNetworkRequest{{
+					AuthenticationPolicyName: current.AuthenticationPolicyName,
+					IPPoolName:               current.IPPoolName,
+					IsL2FloodingEnabled:      Bool(current.IsL2FloodingEnabled),
+					ScalableGroupName:        current.ScalableGroupName,
+					SiteNameHierarchy:        site,
+					TrafficType:              current.TrafficType,
+					VirtualNetworkName:       vn,
+				}}
+				result, _, err := s.DeleteIPPoolFromSDAVirtualNetworkWithContext(ctx, query, request)
+				return statusOf(result, err)
+			},
+		})
+	}
+	if spec.Absent {
+		return nil
+	}
+	p.add(FabricChange{
+		Object: FabricObjectIPPool,
+		Key:    key,
+		Reason: reason,
+		apply: func(ctx context.Context) (*sdaStatus, error) {
+			request := &[]AddIPPoolInSDAVirtualNetworkRequest{{
+				AuthenticationPolicyName: spec.AuthenticationPolicyName,
+				IPPoolName:               spec.Name,
+				IsL2FloodingEnabled:      spec.L2FloodingEnabled,
+				IsThisCriticalPool:       spec.CriticalPool,
+				PoolType:                 spec.PoolType,
+				ScalableGroupName:        spec.ScalableGroupName,
+				TrafficType:              spec.TrafficType,
+				VirtualNetworkName:       vn,
+			}}
+			result, _, err := s.AddIPPoolInSDAVirtualNetworkWithContext(ctx, request)
+			return statusOf(result, err)
+		},
+	})
+	return nil
+}
+
+// ipPoolDiff describes how the current pool differs from spec, or returns ""
+// if it matches. Settings left empty or nil in spec are not compared.
+func ipPoolDiff(current *GetIPPoolFromSDAVirtualNetworkResponse, spec *FabricIPPoolSpec) string {
+	var diffs []string
+	diffs = appendStringDiff(diffs, "authenticationPolicyName", current.AuthenticationPolicyName, spec.AuthenticationPolicyName)
+	diffs = appendStringDiff(diffs, "scalableGroupName", current.ScalableGroupName, spec.ScalableGroupName)
+	diffs = appendStringDiff(diffs, "trafficType", current.TrafficType, spec.TrafficType)
+	if spec.L2FloodingEnabled != nil && current.IsL2FloodingEnabled != *spec.L2FloodingEnabled {
+		diffs = append(diffs, fmt.Sprintf("isL2FloodingEnabled %t -> %t", current.IsL2FloodingEnabled, *spec.L2FloodingEnabled))
+	}
+	if spec.CriticalPool != nil && current.IsThisCriticalPool != *spec.CriticalPool {
+		diffs = append(diffs, fmt.Sprintf("isThisCriticalPool %t -> %t", current.IsThisCriticalPool, *spec.CriticalPool))
+	}
+	return strings.Join(diffs, ", ")
+}
+
+// appendStringDiff appends a description of the change of field to diffs
+// when desired is set and differs from current.
+func appendStringDiff(diffs []string, field string, current string, desired string) []string {
+	if desired == "" || strings.EqualFold(current, desired) {
+		return diffs
+	}
+	return append(diffs, fmt.Sprintf("%s %q -> %q", field, current, desired))
+}
+
+func (p *fabricPlanner) planDevice(ctx context.Context, site string, spec *FabricDeviceSpec, lookup bool) error {
+	desired := map[FabricDeviceRole]bool{}
+	if !spec.Absent {
+		for _, role := range spec.Roles {
+			if !isFabricDeviceRole(role) {
+				return fmt.Errorf("sda: device %s: unknown role %q", spec.ManagementIPAddress, role)
+			}
+			desired[role] = true
+		}
+	}
+	for _, role := range fabricDeviceRoles {
+		exists := false
+		if lookup {
+			var err error
+			if exists, err = p.deviceHasRole(ctx, site, spec.ManagementIPAddress, role); err != nil {
+				return err
+			}
+		}
+		change := FabricChange{
+			Object: FabricObjectDevice,
+			Key:    fmt.Sprintf("%s %s %s", site, spec.ManagementIPAddress, role),
+		}
+		switch {
+		case desired[role] && !exists:
+			change.Reason = "missing"
+			change.apply = p.addDeviceRole(site, spec, role)
+			p.add(change)
+		case !desired[role] && exists:
+			if !spec.Absent {
+				change.Reason = "role not listed"
+			}
+			change.apply = p.deleteDeviceRole(spec.ManagementIPAddress, role)
+			p.delete(change)
+		}
+	}
+	return nil
+}
+
+func isFabricDeviceRole(role FabricDeviceRole) bool {
+	for _, r := range fabricDeviceRoles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// deviceHasRole reports whether the device currently plays role in the
+// fabric site. A role the device plays in another site does not count; when
+// DNA Center does not report the site of the role, any site does.
+func (p *fabricPlanner) deviceHasRole(ctx context.Context, site string, ip string, role FabricDeviceRole) (bool, error) {
+	s := p.s
+	switch role {
+	case FabricRoleBorder:
+		result, _, err := s.GetsBorderDeviceDetailFromSDAFabricWithContext(ctx, &GetsBorderDeviceDetailFromSDAFabricQueryParams{DeviceIPAddress: ip})
+		exists, err := sdaExists(result, err)
+		if !exists || err != nil || result.Payload.SiteID == "" {
+			return exists, err
+		}
+		siteID, err := p.siteID(ctx, site)
+		if err != nil {
+			return false, err
+		}
+		return strings.EqualFold(result.Payload.SiteID, siteID), nil
+	case FabricRoleControlPlane:
+		result, _, err := s.GetControlPlaneDeviceFromSDAFabricWithContext(ctx, &GetControlPlaneDeviceFromSDAFabricQueryParams{DeviceIPAddress: ip})
+		exists, err := sdaExists(result, err)
+		if !exists || err != nil || result.SiteHierarchy == "" {
+			return exists, err
+		}
+		return strings.EqualFold(result.SiteHierarchy, site), nil
+	default:
+		result, _, err := s.GetEdgeDeviceFromSDAFabricWithContext(ctx, &GetEdgeDeviceFromSDAFabricQueryParams{DeviceIPAddress: ip})
+		exists, err := sdaExists(result, err)
+		if !exists || err != nil || result.SiteHierarchy == "" {
+			return exists, err
+		}
+		return strings.EqualFold(result.SiteHierarchy, site), nil
+	}
+}
+
+// siteID returns the ID of the site with the given name hierarchy.
+func (p *fabricPlanner) siteID(ctx context.Context, site string) (string, error) {
+	if id, ok := p.siteIDs[site]; ok {
+		return id, nil
+	}
+	result, _, err := (*SitesService)(p.s).GetSiteWithContext(ctx, &GetSiteQueryParams{Name: site})
+	if err != nil {
+		return "", err
+	}
+	id := ""
+	for _, r := range result.Response {
+		if strings.EqualFold(r.SiteNameHierarchy, site) || len(result.Response) == 1 {
+			id = r.ID
+			break
+		}
+	}
+	if id == "" {
+		return "", fmt.Errorf("sda: site %s not found", site)
+	}
+	if p.siteIDs == nil {
+		p.siteIDs = map[string]string{}
+	}
+	p.siteIDs[site] = id
+	return id, nil
+}
+
+func (p *fabricPlanner) addDeviceRole(site string, spec *FabricDeviceSpec, role FabricDeviceRole) func(ctx context.Context) (*sdaStatus, error) {
+	s := p.s
+	ip := spec.ManagementIPAddress
+	return func(ctx context.Context) (*sdaStatus, error) {
+		switch role {
+		case FabricRoleBorder:
+			request := AddsBorderDeviceInSDAFabricRequest{}
+			if spec.Border != nil {
+				request = *spec.Border
+			}
+			request.DeviceManagementIPAddress = ip
+			request.SiteNameHierarchy = site
+			result, _, err := s.AddsBorderDeviceInSDAFabricWithContext(ctx, &[]AddsBorderDeviceInSDAFabricRequest{request})
+			return statusOf(result, err)
+		case FabricRoleControlPlane:
+			request := &[]AddControlPlaneDeviceInSDAFabricRequest{{DeviceManagementIPAddress: ip, SiteNameHierarchy: site}}
+			result, _, err := s.AddControlPlaneDeviceInSDAFabricWithContext(ctx, request)
+			return statusOf(result, err)
+		default:
+			request := &[]AddEdgeDeviceInSDAFabricRequest{{DeviceManagementIPAddress: ip, SiteNameHierarchy: site}}
+			result, _, err := s.AddEdgeDeviceInSDAFabricWithContext(ctx, request)
+			return statusOf(result, err)
+		}
+	}
+}
+
+func (p *fabricPlanner) deleteDeviceRole(ip string, role FabricDeviceRole) func(ctx context.Context) (*sdaStatus, error) {
+	s := p.s
+	return func(ctx context.Context) (*sdaStatus, error) {
+		switch role {
+		case FabricRoleBorder:
+			result, _, err := s.DeletesBorderDeviceFromSDAFabricWithContext(ctx, &DeletesBorderDeviceFromSDAFabricQueryParams{DeviceIPAddress: ip})
+			return statusOf(result, err)
+		case FabricRoleControlPlane:
+			result, _, err := s.DeleteControlPlaneDeviceInSDAFabricWithContext(ctx, &DeleteControlPlaneDeviceInSDAFabricQueryParams{DeviceIPAddress: ip})
+			return statusOf(result, err)
+		default:
+			result, _, err := s.DeleteEdgeDeviceFromSDAFabricWithContext(ctx, &DeleteEdgeDeviceFromSDAFabricQueryParams{DeviceIPAddress: ip})
+			return statusOf(result, err)
+		}
+	}
+}
+
+// fabricPort is the current host onboarding assignment of an interface,
+// common to user device and access point ports.
+type fabricPort struct {
+	AuthenticateTemplateName string
+	DataIPAddressPoolName    string
+	ScalableGroupName        string
+	VoiceIPAddressPoolName   string
+}
+
+func (p *fabricPlanner) planPort(ctx context.Context, site string, spec *FabricPortSpec, lookup bool) error {
+	s := p.s
+	kind := spec.Kind
+	if kind == "" {
+		kind = FabricPortUserDevice
+	}
+	if kind != FabricPortUserDevice && kind != FabricPortAccessPoint {
+		return fmt.Errorf("sda: port %s %s: unknown kind %q", spec.DeviceManagementIPAddress, spec.InterfaceName, kind)
+	}
+	key := fmt.Sprintf("%s %s %s %s", site, spec.DeviceManagementIPAddress, spec.InterfaceName, kind)
+
+	var current fabricPort
+	exists := false
+	if lookup {
+		var err error
+		if kind == FabricPortAccessPoint {
+			query := &GetPortAssignmentForAccessPointInSDAFabricQueryParams{Devicp: spec.DeviceManagementIPAddress, InterfaceName: spec.InterfaceName}
+			var result *GetPortAssignmentForAccessPointInSDAFabricResponse
+			result, _, err = s.GetPortAssignmentForAccessPointInSDAFabricWithContext(ctx, query)
+			if exists, err = sdaExists(result, err); exists {
+				current = fabricPort{result.AuthenticateTemplateName, result.DataIPAddressPoolName, result.ScalableGroupName, result.VoiceIPAddressPoolName}
+			}
+		} else {
+			query := &GetPortAssignmentForUserDeviceInSDAFabricQueryParams{Devicp: spec.DeviceManagementIPAddress, InterfaceName: spec.InterfaceName}
+			var result *GetPortAssignmentForUserDeviceInSDAFabricResponse
+			result, _, err = s.GetPortAssignmentForUserDeviceInSDAFabricWithContext(ctx, query)
+			if exists, err = sdaExists(result, err); exists {
+				current = fabricPort{result.AuthenticateTemplateName, result.DataIPAddressPoolName, result.ScalableGroupName, result.VoiceIPAddressPoolName}
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	reason := "missing"
+	if exists {
+		if spec.Absent {
+			reason = ""
+		} else if reason = portDiff(current, spec); reason == "" {
+			return nil
+		}
+		p.delete(FabricChange{
+			Object: FabricObjectPort,
+			Key:    key,
+			Reason: reason,
+			apply:  p.deletePort(site, kind, spec.DeviceManagementIPAddress, spec.InterfaceName, current),
+		})
+	}
+	if spec.Absent {
+		return nil
+	}
+	p.add(FabricChange{
+		Object: FabricObjectPort,
+		Key:    key,
+		Reason: reason,
+		apply:  p.addPort(site, kind, spec),
+	})
+	return nil
+}
+
+// portDiff describes how the current assignment differs from spec, or
+// returns "" if it matches. Settings left empty in spec are not compared.
+func portDiff(current fabricPort, spec *FabricPortSpec) string {
+	var diffs []string
+	diffs = appendStringDiff(diffs, "dataIpAddressPoolName", current.DataIPAddressPoolName, spec.DataIPAddressPoolName)
+	diffs = appendStringDiff(diffs, "voiceIpAddressPoolName", current.VoiceIPAddressPoolName, spec.VoiceIPAddressPoolName)
+	diffs = appendStringDiff(diffs, "authenticateTemplateName", current.AuthenticateTemplateName, spec.AuthenticateTemplateName)
+	diffs = appendStringDiff(diffs, "scalableGroupName", current.ScalableGroupName, spec.ScalableGroupName)
+	return strings.Join(diffs, ", ")
+}
+
+func (p *fabricPlanner) addPort(site string, kind FabricPortKind, spec *FabricPortSpec) func(ctx context.Context) (*sdaStatus, error) {
+	s := p.s
+	return func(ctx context.Context) (*sdaStatus, error) {
+		if kind == FabricPortAccessPoint {
+			request := &[]AddPortAssignmentForAccessPointInSDAFabricRequest{{
+				AuthenticateTemplateName:  spec.AuthenticateTemplateName,
+				DataIPAddressPoolName:     spec.DataIPAddressPoolName,
+				DeviceManagementIPAddress: spec.DeviceManagementIPAddress,
+				InterfaceName:             spec.InterfaceName,
+				SiteNameHierarchy:         site,
+				VoiceIPAddressPoolName:    spec.VoiceIPAddressPoolName,
+			}}
+			result, _, err := s.AddPortAssignmentForAccessPointInSDAFabricWithContext(ctx, request)
+			return statusOf(result, err)
+		}
+		request := &[]AddPortAssignmentForUserDeviceInSDAFabricRequest{{
+			AuthenticateTemplateName:  spec.AuthenticateTemplateName,
+			DataIPAddressPoolName:     spec.DataIPAddressPoolName,
+			DeviceManagementIPAddress: spec.DeviceManagementIPAddress,
+			InterfaceName:             spec.InterfaceName,
+			SiteNameHierarchy:         site,
+			VoiceIPAddressPoolName:    spec.VoiceIPAddressPoolName,
+		}}
+		result, _, err := s.AddPortAssignmentForUserDeviceInSDAFabricWithContext(ctx, request)
+		return statusOf(result, err)
+	}
+}
+
+func (p *fabricPlanner) deletePort(site string, kind FabricPortKind, ip string, interfaceName string, current fabricPort) func(ctx context.Context) (*sdaStatus, error) {
+	s := p.s
+	return func(ctx context.Context) (*sdaStatus, error) {
+		if kind == FabricPortAccessPoint {
+			query := &DeletePortAssignmentForAccessPointInSDAFabricQueryParams{Devicp: ip, InterfaceName: interfaceName}
+			request := &[]DeletePortAssignmentForAccessPointInSDAFabricRequest{{
+				AuthenticateTemplateName:  current.AuthenticateTemplateName,
+				DataIPAddressPoolName:     current.DataIPAddressPoolName,
+				DeviceManagementIPAddress: ip,
+				InterfaceName:             interfaceName,
+				ScalableGroupName:         current.ScalableGroupName,
+				SiteNameHierarchy:         site,
+				VoiceIPAddressPoolName:    current.VoiceIPAddressPoolName,
+			}}
+			result, _, err := s.DeletePortAssignmentForAccessPointInSDAFabricWithContext(ctx, query, request)
+			return statusOf(result, err)
+		}
+		query := &DeletePortAssignmentForUserDeviceInSDAFabricQueryParams{Devicp: ip, InterfaceName: interfaceName}
+		request := &[]DeletePortAssignmentForUserDeviceInSDAFabricRequest{{
+			AuthenticateTemplateName:  current.AuthenticateTemplateName,
+			DataIPAddressPoolName:     current.DataIPAddressPoolName,
+			DeviceManagementIPAddress: ip,
+			InterfaceName:             interfaceName,
+			ScalableGroupName:         current.ScalableGroupName,
+			SiteNameHierarchy:         site,
+			VoiceIPAddressPoolName:    current.VoiceIPAddressPoolName,
+		}}
+		result, _, err := s.DeletePortAssignmentForUserDeviceInSDAFabricWithContext(ctx, query, request)
+		return statusOf(result, err)
+	}
+}
+
+// sdaStatus is the {status, description, executionStatusUrl} envelope the
+// SDA API answers with, both for lookups and for asynchronous changes.
+type sdaStatus struct {
+	Status             string `json:"status,omitempty"`
+	Description        string `json:"description,omitempty"`
+	ExecutionStatusURL string `json:"executionStatusUrl,omitempty"`
+}
+
+// statusOf extracts the status envelope from any SDA response.
+func statusOf(result interface{}, err error) (*sdaStatus, error) {
+	if err != nil {
+		return nil, err
+	}
+	b, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+	status := &sdaStatus{}
+	if err := json.Unmarshal(b, status); err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+// sdaExists reports whether a Get call of the SDA API found its object. The
+// SDA API reports missing objects with a "failed" status, sometimes together
+// with an error status code.
+func sdaExists(result interface{}, err error) (bool, error) {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		var status sdaStatus
+		if apiErr.StatusCode == 404 || json.Unmarshal(apiErr.Body, &status) == nil && strings.EqualFold(status.Status, "failed") {
+			return false, nil
+		}
+		return false, err
+	}
+	status, err := statusOf(result, err)
+	if err != nil {
+		return false, err
+	}
+	return !strings.EqualFold(status.Status, "failed"), nil
+}
+
+// waitForSDAStatus waits for the asynchronous SDA call that answered with status.
+func (s *SDAService) waitForSDAStatus(ctx context.Context, status *sdaStatus, opts *WaitForTaskOptions) error {
+	if strings.EqualFold(status.Status, "failed") {
+		return fmt.Errorf("sda: request failed: %s", status.Description)
+	}
+	if status.ExecutionStatusURL == "" {
+		return nil
+	}
+	return waitForExecution(ctx, s.client, status.ExecutionStatusURL, opts)
+}
@@ -0,0 +1,234 @@
+package dnac_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	dnac "github.com/cisco-en-programmability/dnacenter-go-sdk/sdk"
+	"github.com/cisco-en-programmability/dnacenter-go-sdk/sdk/dnactest"
+)
+
+const sdaPath = "/dna/intent/api/v1/business/sda/"
+
+var sdaSuccess = map[string]string{"status": "success"}
+
+// fabricServer returns a server where the fabric and its site Global/US/SJC exist.
+func fabricServer(t *testing.T) (*dnactest.Server, *dnac.Client) {
+	t.Helper()
+	srv := dnactest.NewServer()
+	t.Cleanup(srv.Close)
+	srv.RespondJSON(http.MethodGet, sdaPath+"fabric", http.StatusOK, sdaSuccess)
+	srv.RespondJSON(http.MethodGet, sdaPath+"fabric-site", http.StatusOK, sdaSuccess)
+	srv.RespondJSON(http.MethodGet, "/dna/intent/api/v1/site", http.StatusOK, map[string]interface{}{
+		"response": []map[string]string{{"id": "sjc-id", "siteNameHierarchy": "Global/US/SJC"}},
+	})
+	client, err := srv.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return srv, client
+}
+
+func planKeys(plan *dnac.FabricPlan) []string {
+	var keys []string
+	for _, c := range plan.Changes {
+		keys = append(keys, string(c.Action)+" "+c.Key)
+	}
+	return keys
+}
+
+func assertPlan(t *testing.T, plan *dnac.FabricPlan, want ...string) {
+	t.Helper()
+	got := planKeys(plan)
+	if len(got) != len(want) {
+		t.Fatalf("got plan %q, want %q", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("got plan %q, want %q", got, want)
+			return
+		}
+	}
+}
+
+func TestPlanFabricScopesDeviceRolesToSite(t *testing.T) {
+	spec := &dnac.FabricSpec{
+		FabricName: "Default LAN Fabric",
+		Sites: []dnac.FabricSiteSpec{{
+			SiteNameHierarchy: "Global/US/SJC",
+			Devices:           []dnac.FabricDeviceSpec{{ManagementIPAddress: "10.10.20.51", Roles: []dnac.FabricDeviceRole{dnac.FabricRoleControlPlane, dnac.FabricRoleEdge}}},
+		}},
+	}
+
+	tests := []struct {
+		name         string
+		borderSiteID string
+		want         []string
+	}{
+		{"border in another site", "nyc-id", []string{"add Global/US/SJC 10.10.20.51 edge"}},
+		{"border in the site", "sjc-id", []string{"delete Global/US/SJC 10.10.20.51 border", "add Global/US/SJC 10.10.20.51 edge"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, client := fabricServer(t)
+			srv.RespondJSON(http.MethodGet, sdaPath+"border-device", http.StatusOK, map[string]interface{}{
+				"status": "success", "payload": map[string]string{"siteId": tt.borderSiteID},
+			})
+			srv.RespondJSON(http.MethodGet, sdaPath+"control-plane-device", http.StatusOK, map[string]string{
+				"status": "success", "siteHierarchy": "Global/US/SJC",
+			})
+			// The edge role is played in another site, so it is missing here.
+			srv.RespondJSON(http.MethodGet, sdaPath+"edge-device", http.StatusOK, map[string]string{
+				"status": "success", "siteHierarchy": "Global/US/NYC",
+			})
+
+			plan, err := client.SDA.PlanFabric(context.Background(), spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertPlan(t, plan, tt.want...)
+			srv.AssertNoUnmatched(t)
+		})
+	}
+}
+
+func TestPlanFabricComparesOnlySetPoolFlags(t *testing.T) {
+	pool := func(l2Flooding *bool, critical *bool) *dnac.FabricSpec {
+		return &dnac.FabricSpec{
+			FabricName: "Default LAN Fabric",
+			Sites: []dnac.FabricSiteSpec{{
+				SiteNameHierarchy: "Global/US/SJC",
+				VirtualNetworks: []dnac.FabricVirtualNetworkSpec{{
+					Name:    "CAMPUS",
+					IPPools: []dnac.FabricIPPoolSpec{{Name: "SJC-Data", TrafficType: "DATA", L2FloodingEnabled: l2Flooding, CriticalPool: critical}},
+				}},
+			}},
+		}
+	}
+	tests := []struct {
+		name   string
+		spec   *dnac.FabricSpec
+		reason string
+	}{
+		{"unset", pool(nil, nil), ""},
+		{"matching", pool(dnac.Bool(true), dnac.Bool(false)), ""},
+		{"critical", pool(nil, dnac.Bool(true)), "isThisCriticalPool false -> true"},
+		{"flooding", pool(dnac.Bool(false), nil), "isL2FloodingEnabled true -> false"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, client := fabricServer(t)
+			srv.RespondJSON(http.MethodGet, sdaPath+"virtual-network", http.StatusOK, sdaSuccess)
+			srv.RespondJSON(http.MethodGet, sdaPath+"virtualnetwork/ippool", http.StatusOK, map[string]interface{}{
+				"status": "success", "ipPoolName": "SJC-Data", "trafficType": "DATA",
+				"isL2FloodingEnabled": true, "isThisCriticalPool": false,
+			})
+
+			plan, err := client.SDA.PlanFabric(context.Background(), tt.spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.reason == "" {
+				assertPlan(t, plan)
+				return
+			}
+			assertPlan(t, plan, "delete Global/US/SJC CAMPUS SJC-Data", "add Global/US/SJC CAMPUS SJC-Data")
+			if plan.Changes[0].Reason != tt.reason {
+				t.Errorf("got reason %q, want %q", plan.Changes[0].Reason, tt.reason)
+			}
+		})
+	}
+}
+
+func TestApplyFabricPlanLeavesUnsetPoolFlagsOut(t *testing.T) {
+	srv, client := fabricServer(t)
+	srv.RespondJSON(http.MethodGet, sdaPath+"virtual-network", http.StatusOK, sdaSuccess)
+	srv.RespondJSON(http.MethodGet, sdaPath+"virtualnetwork/ippool", http.StatusNotFound, map[string]string{"status": "failed"})
+	srv.RespondJSON(http.MethodPost, sdaPath+"virtualnetwork/ippool", http.StatusOK, sdaSuccess)
+
+	spec := &dnac.FabricSpec{
+		FabricName: "Default LAN Fabric",
+		Sites: []dnac.FabricSiteSpec{{
+			SiteNameHierarchy: "Global/US/SJC",
+			VirtualNetworks: []dnac.FabricVirtualNetworkSpec{{
+				Name:    "CAMPUS",
+				IPPools: []dnac.FabricIPPoolSpec{{Name: "SJC-Data", TrafficType: "DATA", CriticalPool: dnac.Bool(false)}},
+			}},
+		}},
+	}
+	if _, err := client.SDA.Reconcile(context.Background(), spec, nil); err != nil {
+		t.Fatal(err)
+	}
+	srv.AssertJSONBody(t, http.MethodPost, sdaPath+"virtualnetwork/ippool", []map[string]interface{}{{
+		"ipPoolName":         "SJC-Data",
+		"isThisCriticalPool": false,
+		"trafficType":        "DATA",
+		"virtualNetworkName": "CAMPUS",
+	}})
+}
+
+func TestApplyFabricPlanReportsExecutionError(t *testing.T) {
+	srv, client := fabricServer(t)
+	for _, role := range []string{"border-device", "control-plane-device", "edge-device"} {
+		srv.RespondJSON(http.MethodGet, sdaPath+role, http.StatusOK, map[string]string{"status": "failed"})
+	}
+	srv.RespondJSON(http.MethodPost, sdaPath+"edge-device", http.StatusAccepted, map[string]string{
+		"status": "pending", "executionStatusUrl": "/dna/intent/api/v1/dnacaap/management/execution-status/e1",
+	})
+	srv.RespondSequence(http.MethodGet, "/dna/intent/api/v1/dnacaap/management/execution-status/{id}",
+		dnactest.Response{Body: map[string]string{"status": "IN_PROGRESS"}},
+		dnactest.Response{Body: map[string]string{"status": "FAILURE", "bapiExecutionId": "e1", "bapiName": "Add Edge Device", "bapiError": "device not provisioned"}},
+	)
+
+	spec := &dnac.FabricSpec{
+		FabricName: "Default LAN Fabric",
+		Sites: []dnac.FabricSiteSpec{{
+			SiteNameHierarchy: "Global/US/SJC",
+			Devices:           []dnac.FabricDeviceSpec{{ManagementIPAddress: "10.10.20.51", Roles: []dnac.FabricDeviceRole{dnac.FabricRoleEdge}}},
+		}},
+	}
+	_, err := client.SDA.Reconcile(context.Background(), spec, &dnac.ReconcileOptions{
+		Wait: &dnac.WaitForTaskOptions{PollInterval: time.Millisecond, MaxPollInterval: time.Millisecond},
+	})
+	var changeErr *dnac.FabricChangeError
+	if !errors.As(err, &changeErr) || changeErr.Change.Key != "Global/US/SJC 10.10.20.51 edge" {
+		t.Fatalf("got %v, want the failure of adding the edge role", err)
+	}
+	var execErr *dnac.ExecutionError
+	if !errors.As(err, &execErr) {
+		t.Fatalf("got %v, want an *ExecutionError", err)
+	}
+	if execErr.ExecutionID != "e1" || execErr.Name != "Add Edge Device" || execErr.Reason != "device not provisioned" {
+		t.Errorf("got %+v, want execution e1 of Add Edge Device failed as not provisioned", execErr)
+	}
+	srv.AssertCallCount(t, http.MethodGet, "/dna/intent/api/v1/dnacaap/management/execution-status/e1", 2)
+}
+
+func TestApplyFabricPlanRejectsUnplannedChanges(t *testing.T) {
+	srv, client := fabricServer(t)
+	srv.RespondJSON(http.MethodGet, sdaPath+"virtual-network", http.StatusOK, map[string]string{"status": "failed"})
+	srv.RespondJSON(http.MethodPost, sdaPath+"virtual-network", http.StatusOK, sdaSuccess)
+	spec := &dnac.FabricSpec{
+		FabricName: "Default LAN Fabric",
+		Sites: []dnac.FabricSiteSpec{{
+			SiteNameHierarchy: "Global/US/SJC",
+			VirtualNetworks:   []dnac.FabricVirtualNetworkSpec{{Name: "CAMPUS"}},
+		}},
+	}
+	plan, err := client.SDA.PlanFabric(context.Background(), spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	unplanned := dnac.FabricChange{Action: dnac.FabricDelete, Object: dnac.FabricObjectVirtualNetwork, Key: "Global/US/SJC GUEST"}
+	plan.Changes = append(plan.Changes, unplanned)
+
+	err = client.SDA.ApplyFabricPlan(context.Background(), plan, nil)
+	var changeErr *dnac.FabricChangeError
+	if !errors.As(err, &changeErr) || changeErr.Change.Key != unplanned.Key {
+		t.Fatalf("got %v, want the unplanned change rejected", err)
+	}
+	srv.AssertNotCalled(t, http.MethodPost, sdaPath+"virtual-network")
+}
@@ -0,0 +1,205 @@
+package dnac_test
+
+import (
+	"net/http"
+	"testing"
+
+	dnac "github.com/cisco-en-programmability/dnacenter-go-sdk/sdk"
+	"github.com/cisco-en-programmability/dnacenter-go-sdk/sdk/dnactest"
+)
+
+const (
+	sdaDeviceIP       = "10.10.20.1"
+	sdaSite           = "Global/San Jose/Building 1"
+	sdaExecutionURL   = "/dna/intent/api/v1/dnacaap/management/execution-status/e1"
+	sdaExecutionReply = `{"status":"pending","description":"accepted","executionStatusUrl":"` + sdaExecutionURL + `"}`
+)
+
+// sdaRole exercises the Get, Add and Delete operations of an SDA node role,
+// returning the decoded fields the tests check.
+type sdaRole struct {
+	name    string
+	path    string
+	getBody interface{}
+	getName string // Name of the device in getBody
+	get     func(c *dnac.Client, ip string) (name string, err error)
+	add     func(c *dnac.Client, ip string, site string) (executionStatusURL string, err error)
+	delete  func(c *dnac.Client, ip string) (executionStatusURL string, err error)
+}
+
+var sdaRoles = []sdaRole{
+	{
+		name:    "border",
+		path:    "/dna/intent/api/v1/business/sda/border-device",
+		getBody: map[string]interface{}{"status": "success", "payload": map[string]interface{}{"name": "border-1", "roles": []string{"BORDER"}}},
+		getName: "border-1",
+		get: func(c *dnac.Client, ip string) (string, error) {
+			result, _, err := c.SDA.GetsBorderDeviceDetailFromSDAFabric(&dnac.GetsBorderDeviceDetailFromSDAFabricQueryParams{DeviceIPAddress: ip})
+			if err != nil {
+				return "", err
+			}
+			return result.Payload.Name, nil
+		},
+		add: func(c *dnac.Client, ip string, site string) (string, error) {
+			result, _, err := c.SDA.AddsBorderDeviceInSDAFabric(&[]dnac.AddsBorderDeviceInSDAFabricRequest{{DeviceManagementIPAddress: ip, SiteNameHierarchy: site}})
+			if err != nil {
+				return "", err
+			}
+			return result.ExecutionStatusURL, nil
+		},
+		delete: func(c *dnac.Client, ip string) (string, error) {
+			result, _, err := c.SDA.DeletesBorderDeviceFromSDAFabric(&dnac.DeletesBorderDeviceFromSDAFabricQueryParams{DeviceIPAddress: ip})
+			if err != nil {
+				return "", err
+			}
+			return result.ExecutionStatusURL, nil
+		},
+	},
+	{
+		name:    "edge",
+		path:    "/dna/intent/api/v1/business/sda/edge-device",
+		getBody: map[string]interface{}{"status": "success", "name": "edge-1", "roles": []string{"EDGENODE"}},
+		getName: "edge-1",
+		get: func(c *dnac.Client, ip string) (string, error) {
+			result, _, err := c.SDA.GetEdgeDeviceFromSDAFabric(&dnac.GetEdgeDeviceFromSDAFabricQueryParams{DeviceIPAddress: ip})
+			if err != nil {
+				return "", err
+			}
+			return result.Name, nil
+		},
+		add: func(c *dnac.Client, ip string, site string) (string, error) {
+			result, _, err := c.SDA.AddEdgeDeviceInSDAFabric(&[]dnac.AddEdgeDeviceInSDAFabricRequest{{DeviceManagementIPAddress: ip, SiteNameHierarchy: site}})
+			if err != nil {
+				return "", err
+			}
+			return result.ExecutionStatusURL, nil
+		},
+		delete: func(c *dnac.Client, ip string) (string, error) {
+			result, _, err := c.SDA.DeleteEdgeDeviceFromSDAFabric(&dnac.DeleteEdgeDeviceFromSDAFabricQueryParams{DeviceIPAddress: ip})
+			if err != nil {
+				return "", err
+			}
+			return result.ExecutionStatusURL, nil
+		},
+	},
+	{
+		name:    "control plane",
+		path:    "/dna/intent/api/v1/business/sda/control-plane-device",
+		getBody: map[string]interface{}{"status": "success", "name": "cp-1", "roles": []string{"MAPSERVER"}},
+		getName: "cp-1",
+		get: func(c *dnac.Client, ip string) (string, error) {
+			result, _, err := c.SDA.GetControlPlaneDeviceFromSDAFabric(&dnac.GetControlPlaneDeviceFromSDAFabricQueryParams{DeviceIPAddress: ip})
+			if err != nil {
+				return "", err
+			}
+			return result.Name, nil
+		},
+		add: func(c *dnac.Client, ip string, site string) (string, error) {
+			result, _, err := c.SDA.AddControlPlaneDeviceInSDAFabric(&[]dnac.AddControlPlaneDeviceInSDAFabricRequest{{DeviceManagementIPAddress: ip, SiteNameHierarchy: site}})
+			if err != nil {
+				return "", err
+			}
+			return result.ExecutionStatusURL, nil
+		},
+		delete: func(c *dnac.Client, ip string) (string, error) {
+			result, _, err := c.SDA.DeleteControlPlaneDeviceInSDAFabric(&dnac.DeleteControlPlaneDeviceInSDAFabricQueryParams{DeviceIPAddress: ip})
+			if err != nil {
+				return "", err
+			}
+			return result.ExecutionStatusURL, nil
+		},
+	},
+}
+
+// sdaServer returns a server with the responses of all the operations of role.
+func sdaServer(t *testing.T, role sdaRole) (*dnactest.Server, *dnac.Client) {
+	t.Helper()
+	srv := dnactest.NewServer()
+	t.Cleanup(srv.Close)
+	srv.RespondJSON(http.MethodGet, role.path, http.StatusOK, role.getBody)
+	srv.RespondSequence(http.MethodPost, role.path, dnactest.Response{Status: http.StatusAccepted, Body: sdaExecutionReply})
+	srv.RespondSequence(http.MethodDelete, role.path, dnactest.Response{Status: http.StatusOK, Body: sdaExecutionReply})
+	client, err := srv.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return srv, client
+}
+
+func assertDeviceIPQuery(t *testing.T, srv *dnactest.Server, method string, path string) {
+	t.Helper()
+	r := srv.LastRequestTo(method, path)
+	if r == nil {
+		t.Fatalf("no request to %s %s", method, path)
+	}
+	if got := r.Query.Get("deviceIPAddress"); got != sdaDeviceIP || len(r.Query) != 1 {
+		t.Errorf("%s %s has query %v, want only deviceIPAddress=%s", method, path, r.Query, sdaDeviceIP)
+	}
+}
+
+func TestSDADeviceRoles(t *testing.T) {
+	for _, role := range sdaRoles {
+		role := role
+		t.Run(role.name+"/get", func(t *testing.T) {
+			srv, client := sdaServer(t, role)
+			name, err := role.get(client, sdaDeviceIP)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if name != role.getName {
+				t.Errorf("got device %q, want %q", name, role.getName)
+			}
+			srv.AssertCallCount(t, http.MethodGet, role.path, 1)
+			assertDeviceIPQuery(t, srv, http.MethodGet, role.path)
+			srv.AssertNoUnmatched(t)
+		})
+		t.Run(role.name+"/add", func(t *testing.T) {
+			srv, client := sdaServer(t, role)
+			url, err := role.add(client, sdaDeviceIP, sdaSite)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if url != sdaExecutionURL {
+				t.Errorf("got execution status URL %q, want %q", url, sdaExecutionURL)
+			}
+			srv.AssertCallCount(t, http.MethodPost, role.path, 1)
+			srv.AssertJSONBody(t, http.MethodPost, role.path, []map[string]string{
+				{"deviceManagementIpAddress": sdaDeviceIP, "siteNameHierarchy": sdaSite},
+			})
+			if r := srv.LastRequestTo(http.MethodPost, role.path); len(r.Query) != 0 {
+				t.Errorf("POST %s has query %v, want none", role.path, r.Query)
+			}
+			srv.AssertNoUnmatched(t)
+		})
+		t.Run(role.name+"/delete", func(t *testing.T) {
+			srv, client := sdaServer(t, role)
+			url, err := role.delete(client, sdaDeviceIP)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if url != sdaExecutionURL {
+				t.Errorf("got execution status URL %q, want %q", url, sdaExecutionURL)
+			}
+			srv.AssertCallCount(t, http.MethodDelete, role.path, 1)
+			assertDeviceIPQuery(t, srv, http.MethodDelete, role.path)
+			if r := srv.LastRequestTo(http.MethodDelete, role.path); len(r.Body) != 0 {
+				t.Errorf("DELETE %s has body %s, want none", role.path, r.Body)
+			}
+			srv.AssertNotCalled(t, http.MethodGet, role.path)
+			srv.AssertNotCalled(t, http.MethodPost, role.path)
+			srv.AssertNoUnmatched(t)
+		})
+		t.Run(role.name+"/delete error", func(t *testing.T) {
+			srv := dnactest.NewServer()
+			defer srv.Close()
+			srv.RespondJSON(http.MethodDelete, role.path, http.StatusNotFound, map[string]string{"status": "failed", "message": "device not in fabric"})
+			client, err := srv.Client()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := role.delete(client, sdaDeviceIP); !dnac.IsNotFound(err) {
+				t.Errorf("got %v, want a 404 error", err)
+			}
+		})
+	}
+}
@@ -1,7 +1,7 @@
 package dnac
 
 import (
-	"fmt"
+	"context"
 
 	"github.com/go-resty/resty/v2"
 	"github.com/google/go-querystring/query"
@@ -14,7 +14,7 @@ type SensorsService service
 type CreateSensorTestTemplateRequest struct {
 	ApCoverage   []CreateSensorTestTemplateRequestApCoverage `json:"apCoverage,omitempty"`   //
 	Connection   string                                      `json:"connection,omitempty"`   //
-	ModelVersion int                                         `json:"modelVersion,omitempty"` //
+	ModelVersion *int                                        `json:"modelVersion,omitempty"` //
 	Name         string                                      `json:"name,omitempty"`         //
 	SSIDs        []CreateSensorTestTemplateRequestSSIDs      `json:"ssids,omitempty"`        //
 }
@@ -22,20 +22,20 @@ type CreateSensorTestTemplateRequest struct {
 // CreateSensorTestTemplateRequestApCoverage is the createSensorTestTemplateRequestApCoverage definition
 type CreateSensorTestTemplateRequestApCoverage struct {
 	Bands             string `json:"bands,omitempty"`             //
-	NumberOfApsToTest int    `json:"numberOfApsToTest,omitempty"` //
+	NumberOfApsToTest *int   `json:"numberOfApsToTest,omitempty"` //
 	RssiThreshold     string `json:"rssiThreshold,omitempty"`     //
 }
 
 // CreateSensorTestTemplateRequestSSIDs is the createSensorTestTemplateRequestSSIDs definition
 type CreateSensorTestTemplateRequestSSIDs struct {
-	AuthType    string                                         `json:"authType,omitempty"`    //
-	Categories  []string                                       `json:"categories,omitempty"`  //
-	ProfileName string                                         `json:"profileName,omitempty"` //
-	Psk         string                                         `json:"psk,omitempty"`         //
-	QosPolicy   string                                         `json:"qosPolicy,omitempty"`   //
-	SSID        string                                         `json:"ssid,omitempty"`        //
-	Tests       []CreateSensorTestTemplateRequestSSIDsTests    `json:"tests,omitempty"`       //
-	ThirdParty  CreateSensorTestTemplateRequestSSIDsThirdParty `json:"thirdParty,omitempty"`  //
+	AuthType    string                                          `json:"authType,omitempty"`    //
+	Categories  []string                                        `json:"categories,omitempty"`  //
+	ProfileName string                                          `json:"profileName,omitempty"` //
+	Psk         string                                          `json:"psk,omitempty"`         //
+	QosPolicy   string                                          `json:"qosPolicy,omitempty"`   //
+	SSID        string                                          `json:"ssid,omitempty"`        //
+	Tests       []CreateSensorTestTemplateRequestSSIDsTests     `json:"tests,omitempty"`       //
+	ThirdParty  *CreateSensorTestTemplateRequestSSIDsThirdParty `json:"thirdParty,omitempty"`  //
 }
 
 // CreateSensorTestTemplateRequestSSIDsCategories is the createSensorTestTemplateRequestSSIDsCategories definition
@@ -52,7 +52,7 @@ type CreateSensorTestTemplateRequestSSIDsTestsConfig []string
 
 // CreateSensorTestTemplateRequestSSIDsThirdParty is the createSensorTestTemplateRequestSSIDsThirdParty definition
 type CreateSensorTestTemplateRequestSSIDsThirdParty struct {
-	Selected bool `json:"selected,omitempty"` //
+	Selected *bool `json:"selected,omitempty"` //
 }
 
 // DuplicateSensorTestTemplateRequest is the duplicateSensorTestTemplateRequest definition
@@ -64,13 +64,13 @@ type DuplicateSensorTestTemplateRequest struct {
 // EditSensorTestTemplateRequest is the editSensorTestTemplateRequest definition
 type EditSensorTestTemplateRequest struct {
 	LocationInfoList []EditSensorTestTemplateRequestLocationInfoList `json:"locationInfoList,omitempty"` //
-	Schedule         EditSensorTestTemplateRequestSchedule           `json:"schedule,omitempty"`         //
+	Schedule         *EditSensorTestTemplateRequestSchedule          `json:"schedule,omitempty"`         //
 	TemplateName     string                                          `json:"templateName,omitempty"`     //
 }
 
 // EditSensorTestTemplateRequestLocationInfoList is the editSensorTestTemplateRequestLocationInfoList definition
 type EditSensorTestTemplateRequestLocationInfoList struct {
-	AllSensors    bool   `json:"allSensors,omitempty"`    //
+	AllSensors    *bool  `json:"allSensors,omitempty"`    //
 	LocationID    string `json:"locationId,omitempty"`    //
 	LocationType  string `json:"locationType,omitempty"`  //
 	SiteHierarchy string `json:"siteHierarchy,omitempty"` //
@@ -78,7 +78,7 @@ type EditSensorTestTemplateRequestLocationInfoList struct {
 
 // EditSensorTestTemplateRequestSchedule is the editSensorTestTemplateRequestSchedule definition
 type EditSensorTestTemplateRequestSchedule struct {
-	Frequency        EditSensorTestTemplateRequestScheduleFrequency       `json:"frequency,omitempty"`        //
+	Frequency        *EditSensorTestTemplateRequestScheduleFrequency      `json:"frequency,omitempty"`        //
 	ScheduleRange    []EditSensorTestTemplateRequestScheduleScheduleRange `json:"scheduleRange,omitempty"`    //
 	TestScheduleMode string                                               `json:"testScheduleMode,omitempty"` //
 }
@@ -86,7 +86,7 @@ type EditSensorTestTemplateRequestSchedule struct {
 // EditSensorTestTemplateRequestScheduleFrequency is the editSensorTestTemplateRequestScheduleFrequency definition
 type EditSensorTestTemplateRequestScheduleFrequency struct {
 	Unit  string `json:"unit,omitempty"`  //
-	Value int    `json:"value,omitempty"` //
+	Value *int   `json:"value,omitempty"` //
 }
 
 // EditSensorTestTemplateRequestScheduleScheduleRange is the editSensorTestTemplateRequestScheduleScheduleRange definition
@@ -97,15 +97,15 @@ type EditSensorTestTemplateRequestScheduleScheduleRange struct {
 
 // EditSensorTestTemplateRequestScheduleScheduleRangeTimeRange is the editSensorTestTemplateRequestScheduleScheduleRangeTimeRange definition
 type EditSensorTestTemplateRequestScheduleScheduleRangeTimeRange struct {
-	Frequency EditSensorTestTemplateRequestScheduleScheduleRangeTimeRangeFrequency `json:"frequency,omitempty"` //
-	From      string                                                               `json:"from,omitempty"`      //
-	To        string                                                               `json:"to,omitempty"`        //
+	Frequency *EditSensorTestTemplateRequestScheduleScheduleRangeTimeRangeFrequency `json:"frequency,omitempty"` //
+	From      string                                                                `json:"from,omitempty"`      //
+	To        string                                                                `json:"to,omitempty"`        //
 }
 
 // EditSensorTestTemplateRequestScheduleScheduleRangeTimeRangeFrequency is the editSensorTestTemplateRequestScheduleScheduleRangeTimeRangeFrequency definition
 type EditSensorTestTemplateRequestScheduleScheduleRangeTimeRangeFrequency struct {
 	Unit  string `json:"unit,omitempty"`  //
-	Value int    `json:"value,omitempty"` //
+	Value *int   `json:"value,omitempty"` //
 }
 
 // RunNowSensorTestRequest is the runNowSensorTestRequest definition
@@ -589,10 +589,16 @@ type SensorsResponseResponseSSHConfig struct {
 /* Intent API to create a SENSOR test template with a new SSID, existing SSID, or both new and existing SSID
  */
 func (s *SensorsService) CreateSensorTestTemplate(createSensorTestTemplateRequest *CreateSensorTestTemplateRequest) (*CreateSensorTestTemplateResponse, *resty.Response, error) {
+	return s.CreateSensorTestTemplateWithContext(context.Background(), createSensorTestTemplateRequest)
+}
+
+// CreateSensorTestTemplateWithContext is like CreateSensorTestTemplate but sends the request with ctx for cancellation and deadlines.
+func (s *SensorsService) CreateSensorTestTemplateWithContext(ctx context.Context, createSensorTestTemplateRequest *CreateSensorTestTemplateRequest) (*CreateSensorTestTemplateResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/sensor"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(createSensorTestTemplateRequest).
 		SetResult(&CreateSensorTestTemplateResponse{}).
 		SetError(&Error{}).
@@ -603,7 +609,7 @@ func (s *SensorsService) CreateSensorTestTemplate(createSensorTestTemplateReques
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation createSensorTestTemplate")
+		return nil, response, newAPIError("createSensorTestTemplate", response)
 	}
 
 	result := response.Result().(*CreateSensorTestTemplateResponse)
@@ -620,12 +626,18 @@ type DeleteSensorTestQueryParams struct {
 @param templateName
 */
 func (s *SensorsService) DeleteSensorTest(deleteSensorTestQueryParams *DeleteSensorTestQueryParams) (*DeleteSensorTestResponse, *resty.Response, error) {
+	return s.DeleteSensorTestWithContext(context.Background(), deleteSensorTestQueryParams)
+}
+
+// DeleteSensorTestWithContext is like DeleteSensorTest but sends the request with ctx for cancellation and deadlines.
+func (s *SensorsService) DeleteSensorTestWithContext(ctx context.Context, deleteSensorTestQueryParams *DeleteSensorTestQueryParams) (*DeleteSensorTestResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/sensor"
 
 	queryString, _ := query.Values(deleteSensorTestQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&DeleteSensorTestResponse{}).
 		SetError(&Error{}).
@@ -636,7 +648,7 @@ func (s *SensorsService) DeleteSensorTest(deleteSensorTestQueryParams *DeleteSen
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation deleteSensorTest")
+		return nil, response, newAPIError("deleteSensorTest", response)
 	}
 
 	result := response.Result().(*DeleteSensorTestResponse)
@@ -647,10 +659,16 @@ func (s *SensorsService) DeleteSensorTest(deleteSensorTestQueryParams *DeleteSen
 /* Intent API to duplicate an existing SENSOR test template
  */
 func (s *SensorsService) DuplicateSensorTestTemplate(duplicateSensorTestTemplateRequest *DuplicateSensorTestTemplateRequest) (*DuplicateSensorTestTemplateResponse, *resty.Response, error) {
+	return s.DuplicateSensorTestTemplateWithContext(context.Background(), duplicateSensorTestTemplateRequest)
+}
+
+// DuplicateSensorTestTemplateWithContext is like DuplicateSensorTestTemplate but sends the request with ctx for cancellation and deadlines.
+func (s *SensorsService) DuplicateSensorTestTemplateWithContext(ctx context.Context, duplicateSensorTestTemplateRequest *DuplicateSensorTestTemplateRequest) (*DuplicateSensorTestTemplateResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/sensorTestTemplate"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(duplicateSensorTestTemplateRequest).
 		SetResult(&DuplicateSensorTestTemplateResponse{}).
 		SetError(&Error{}).
@@ -661,7 +679,7 @@ func (s *SensorsService) DuplicateSensorTestTemplate(duplicateSensorTestTemplate
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation duplicateSensorTestTemplate")
+		return nil, response, newAPIError("duplicateSensorTestTemplate", response)
 	}
 
 	result := response.Result().(*DuplicateSensorTestTemplateResponse)
@@ -672,10 +690,16 @@ func (s *SensorsService) DuplicateSensorTestTemplate(duplicateSensorTestTemplate
 /* Intent API to deploy, schedule, or edit and existing SENSOR test template
  */
 func (s *SensorsService) EditSensorTestTemplate(editSensorTestTemplateRequest *EditSensorTestTemplateRequest) (*EditSensorTestTemplateResponse, *resty.Response, error) {
+	return s.EditSensorTestTemplateWithContext(context.Background(), editSensorTestTemplateRequest)
+}
+
+// EditSensorTestTemplateWithContext is like EditSensorTestTemplate but sends the request with ctx for cancellation and deadlines.
+func (s *SensorsService) EditSensorTestTemplateWithContext(ctx context.Context, editSensorTestTemplateRequest *EditSensorTestTemplateRequest) (*EditSensorTestTemplateResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/AssuranceScheduleSensorTest"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(editSensorTestTemplateRequest).
 		SetResult(&EditSensorTestTemplateResponse{}).
 		SetError(&Error{}).
@@ -686,7 +710,7 @@ func (s *SensorsService) EditSensorTestTemplate(editSensorTestTemplateRequest *E
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation editSensorTestTemplate")
+		return nil, response, newAPIError("editSensorTestTemplate", response)
 	}
 
 	result := response.Result().(*EditSensorTestTemplateResponse)
@@ -697,10 +721,16 @@ func (s *SensorsService) EditSensorTestTemplate(editSensorTestTemplateRequest *E
 /* Intent API to run a deployed SENSOR test
  */
 func (s *SensorsService) RunNowSensorTest(runNowSensorTestRequest *RunNowSensorTestRequest) (*resty.Response, error) {
+	return s.RunNowSensorTestWithContext(context.Background(), runNowSensorTestRequest)
+}
+
+// RunNowSensorTestWithContext is like RunNowSensorTest but sends the request with ctx for cancellation and deadlines.
+func (s *SensorsService) RunNowSensorTestWithContext(ctx context.Context, runNowSensorTestRequest *RunNowSensorTestRequest) (*resty.Response, error) {
 
 	path := "/dna/intent/api/v1/sensor-run-now"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(runNowSensorTestRequest).
 		SetError(&Error{}).
 		Put(path)
@@ -721,12 +751,18 @@ type SensorsQueryParams struct {
 @param siteID
 */
 func (s *SensorsService) Sensors(sensorsQueryParams *SensorsQueryParams) (*SensorsResponse, *resty.Response, error) {
+	return s.SensorsWithContext(context.Background(), sensorsQueryParams)
+}
+
+// SensorsWithContext is like Sensors but sends the request with ctx for cancellation and deadlines.
+func (s *SensorsService) SensorsWithContext(ctx context.Context, sensorsQueryParams *SensorsQueryParams) (*SensorsResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/sensor"
 
 	queryString, _ := query.Values(sensorsQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&SensorsResponse{}).
 		SetError(&Error{}).
@@ -737,7 +773,7 @@ func (s *SensorsService) Sensors(sensorsQueryParams *SensorsQueryParams) (*Senso
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation sensors")
+		return nil, response, newAPIError("sensors", response)
 	}
 
 	result := response.Result().(*SensorsResponse)
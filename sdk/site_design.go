@@ -1,6 +1,7 @@
 package dnac
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -23,7 +24,7 @@ type CreateNFVProfileRequestDevice struct {
 	CustomTemplate                  []CreateNFVProfileRequestDeviceCustomTemplate         `json:"customTemplate,omitempty"`                  //
 	DeviceTag                       string                                                `json:"deviceTag,omitempty"`                       //
 	DeviceType                      string                                                `json:"deviceType,omitempty"`                      //
-	DirectInternetAccessForFirewall bool                                                  `json:"directInternetAccessForFirewall,omitempty"` //
+	DirectInternetAccessForFirewall *bool                                                 `json:"directInternetAccessForFirewall,omitempty"` //
 	ServiceProviderProfile          []CreateNFVProfileRequestDeviceServiceProviderProfile `json:"serviceProviderProfile,omitempty"`          //
 	Services                        []CreateNFVProfileRequestDeviceServices               `json:"services,omitempty"`                        //
 	VLANForL2                       []CreateNFVProfileRequestDeviceVLANForL2              `json:"vlanForL2,omitempty"`                       //
@@ -34,7 +35,7 @@ type CreateNFVProfileRequestDeviceCustomNetworks struct {
 	ConnectionType    string                                                         `json:"connectionType,omitempty"`    //
 	NetworkName       string                                                         `json:"networkName,omitempty"`       //
 	ServicesToConnect []CreateNFVProfileRequestDeviceCustomNetworksServicesToConnect `json:"servicesToConnect,omitempty"` //
-	VLANID            float64                                                        `json:"vlanId,omitempty"`            //
+	VLANID            *float64                                                       `json:"vlanId,omitempty"`            //
 	VLANMode          string                                                         `json:"vlanMode,omitempty"`          //
 }
 
@@ -52,8 +53,8 @@ type CreateNFVProfileRequestDeviceCustomTemplate struct {
 
 // CreateNFVProfileRequestDeviceServiceProviderProfile is the createNFVProfileRequestDeviceServiceProviderProfile definition
 type CreateNFVProfileRequestDeviceServiceProviderProfile struct {
-	Connect                    bool   `json:"connect,omitempty"`                    //
-	ConnectDefaultGatewayOnWan bool   `json:"connectDefaultGatewayOnWan,omitempty"` //
+	Connect                    *bool  `json:"connect,omitempty"`                    //
+	ConnectDefaultGatewayOnWan *bool  `json:"connectDefaultGatewayOnWan,omitempty"` //
 	LinkType                   string `json:"linkType,omitempty"`                   //
 	ServiceProvider            string `json:"serviceProvider,omitempty"`            //
 }
@@ -76,9 +77,9 @@ type CreateNFVProfileRequestDeviceServicesVNicMapping struct {
 
 // CreateNFVProfileRequestDeviceVLANForL2 is the createNFVProfileRequestDeviceVLANForL2 definition
 type CreateNFVProfileRequestDeviceVLANForL2 struct {
-	VLANDescription string  `json:"vlanDescription,omitempty"` //
-	VLANID          float64 `json:"vlanId,omitempty"`          //
-	VLANType        string  `json:"vlanType,omitempty"`        //
+	VLANDescription string   `json:"vlanDescription,omitempty"` //
+	VLANID          *float64 `json:"vlanId,omitempty"`          //
+	VLANType        string   `json:"vlanType,omitempty"`        //
 }
 
 // NFVProvisioningDetailRequest is the nFVProvisioningDetailRequest definition
@@ -95,7 +96,7 @@ type ProvisionNFVRequest struct {
 // ProvisionNFVRequestProvisioning is the provisionNFVRequestProvisioning definition
 type ProvisionNFVRequestProvisioning struct {
 	Device []ProvisionNFVRequestProvisioningDevice `json:"device,omitempty"` //
-	Site   ProvisionNFVRequestProvisioningSite     `json:"site,omitempty"`   //
+	Site   *ProvisionNFVRequestProvisioningSite    `json:"site,omitempty"`   //
 }
 
 // ProvisionNFVRequestProvisioningDevice is the provisionNFVRequestProvisioningDevice definition
@@ -107,7 +108,7 @@ type ProvisionNFVRequestProvisioningDevice struct {
 	Services           []ProvisionNFVRequestProvisioningDeviceServices         `json:"services,omitempty"`           //
 	SubPools           []ProvisionNFVRequestProvisioningDeviceSubPools         `json:"subPools,omitempty"`           //
 	TagName            string                                                  `json:"tagName,omitempty"`            //
-	TemplateParam      ProvisionNFVRequestProvisioningDeviceTemplateParam      `json:"templateParam,omitempty"`      //
+	TemplateParam      *ProvisionNFVRequestProvisioningDeviceTemplateParam     `json:"templateParam,omitempty"`      //
 	VLAN               []ProvisionNFVRequestProvisioningDeviceVLAN             `json:"vlan,omitempty"`               //
 }
 
@@ -120,8 +121,8 @@ type ProvisionNFVRequestProvisioningDeviceCustomNetworks struct {
 
 // ProvisionNFVRequestProvisioningDeviceServiceProviders is the provisionNFVRequestProvisioningDeviceServiceProviders definition
 type ProvisionNFVRequestProvisioningDeviceServiceProviders struct {
-	ServiceProvider string                                                            `json:"serviceProvider,omitempty"` //
-	WanInterface    ProvisionNFVRequestProvisioningDeviceServiceProvidersWanInterface `json:"wanInterface,omitempty"`    //
+	ServiceProvider string                                                             `json:"serviceProvider,omitempty"` //
+	WanInterface    *ProvisionNFVRequestProvisioningDeviceServiceProvidersWanInterface `json:"wanInterface,omitempty"`    //
 }
 
 // ProvisionNFVRequestProvisioningDeviceServiceProvidersWanInterface is the provisionNFVRequestProvisioningDeviceServiceProvidersWanInterface definition
@@ -156,8 +157,8 @@ type ProvisionNFVRequestProvisioningDeviceSubPools struct {
 
 // ProvisionNFVRequestProvisioningDeviceTemplateParam is the provisionNFVRequestProvisioningDeviceTemplateParam definition
 type ProvisionNFVRequestProvisioningDeviceTemplateParam struct {
-	Asav  ProvisionNFVRequestProvisioningDeviceTemplateParamAsav  `json:"asav,omitempty"`  //
-	Nfvis ProvisionNFVRequestProvisioningDeviceTemplateParamNfvis `json:"nfvis,omitempty"` //
+	Asav  *ProvisionNFVRequestProvisioningDeviceTemplateParamAsav  `json:"asav,omitempty"`  //
+	Nfvis *ProvisionNFVRequestProvisioningDeviceTemplateParamNfvis `json:"nfvis,omitempty"` //
 }
 
 // ProvisionNFVRequestProvisioningDeviceTemplateParamAsav is the provisionNFVRequestProvisioningDeviceTemplateParamAsav definition
@@ -180,10 +181,10 @@ type ProvisionNFVRequestProvisioningDeviceVLAN struct {
 
 // ProvisionNFVRequestProvisioningSite is the provisionNFVRequestProvisioningSite definition
 type ProvisionNFVRequestProvisioningSite struct {
-	Area            ProvisionNFVRequestProvisioningSiteArea     `json:"area,omitempty"`            //
-	Building        ProvisionNFVRequestProvisioningSiteBuilding `json:"building,omitempty"`        //
-	Floor           ProvisionNFVRequestProvisioningSiteFloor    `json:"floor,omitempty"`           //
-	SiteProfileName string                                      `json:"siteProfileName,omitempty"` //
+	Area            *ProvisionNFVRequestProvisioningSiteArea     `json:"area,omitempty"`            //
+	Building        *ProvisionNFVRequestProvisioningSiteBuilding `json:"building,omitempty"`        //
+	Floor           *ProvisionNFVRequestProvisioningSiteFloor    `json:"floor,omitempty"`           //
+	SiteProfileName string                                       `json:"siteProfileName,omitempty"` //
 }
 
 // ProvisionNFVRequestProvisioningSiteArea is the provisionNFVRequestProvisioningSiteArea definition
@@ -194,21 +195,21 @@ type ProvisionNFVRequestProvisioningSiteArea struct {
 
 // ProvisionNFVRequestProvisioningSiteBuilding is the provisionNFVRequestProvisioningSiteBuilding definition
 type ProvisionNFVRequestProvisioningSiteBuilding struct {
-	Address    string  `json:"address,omitempty"`    //
-	Latitude   float64 `json:"latitude,omitempty"`   //
-	Longitude  float64 `json:"longitude,omitempty"`  //
-	Name       string  `json:"name,omitempty"`       //
-	ParentName string  `json:"parentName,omitempty"` //
+	Address    string   `json:"address,omitempty"`    //
+	Latitude   *float64 `json:"latitude,omitempty"`   //
+	Longitude  *float64 `json:"longitude,omitempty"`  //
+	Name       string   `json:"name,omitempty"`       //
+	ParentName string   `json:"parentName,omitempty"` //
 }
 
 // ProvisionNFVRequestProvisioningSiteFloor is the provisionNFVRequestProvisioningSiteFloor definition
 type ProvisionNFVRequestProvisioningSiteFloor struct {
-	Height     float64 `json:"height,omitempty"`     //
-	Length     float64 `json:"length,omitempty"`     //
-	Name       string  `json:"name,omitempty"`       //
-	ParentName string  `json:"parentName,omitempty"` //
-	RfModel    string  `json:"rfModel,omitempty"`    //
-	Width      float64 `json:"width,omitempty"`      //
+	Height     *float64 `json:"height,omitempty"`     //
+	Length     *float64 `json:"length,omitempty"`     //
+	Name       string   `json:"name,omitempty"`       //
+	ParentName string   `json:"parentName,omitempty"` //
+	RfModel    string   `json:"rfModel,omitempty"`    //
+	Width      *float64 `json:"width,omitempty"`      //
 }
 
 // ProvisionNFVRequestSiteProfile is the provisionNFVRequestSiteProfile definition
@@ -223,7 +224,7 @@ type ProvisionNFVRequestSiteProfileDevice struct {
 	CustomServices   []ProvisionNFVRequestSiteProfileDeviceCustomServices   `json:"customServices,omitempty"`   //
 	CustomTemplate   []ProvisionNFVRequestSiteProfileDeviceCustomTemplate   `json:"customTemplate,omitempty"`   //
 	DeviceType       string                                                 `json:"deviceType,omitempty"`       //
-	Dia              bool                                                   `json:"dia,omitempty"`              //
+	Dia              *bool                                                  `json:"dia,omitempty"`              //
 	ServiceProviders []ProvisionNFVRequestSiteProfileDeviceServiceProviders `json:"serviceProviders,omitempty"` //
 	Services         []ProvisionNFVRequestSiteProfileDeviceServices         `json:"services,omitempty"`         //
 	TagName          string                                                 `json:"tagName,omitempty"`          //
@@ -246,11 +247,11 @@ type ProvisionNFVRequestSiteProfileDeviceCustomNetworksServicesToConnect struct
 
 // ProvisionNFVRequestSiteProfileDeviceCustomServices is the provisionNFVRequestSiteProfileDeviceCustomServices definition
 type ProvisionNFVRequestSiteProfileDeviceCustomServices struct {
-	ApplicationType string                                                     `json:"applicationType,omitempty"` //
-	ImageName       string                                                     `json:"imageName,omitempty"`       //
-	Name            string                                                     `json:"name,omitempty"`            //
-	Profile         string                                                     `json:"profile,omitempty"`         //
-	Topology        ProvisionNFVRequestSiteProfileDeviceCustomServicesTopology `json:"topology,omitempty"`        //
+	ApplicationType string                                                      `json:"applicationType,omitempty"` //
+	ImageName       string                                                      `json:"imageName,omitempty"`       //
+	Name            string                                                      `json:"name,omitempty"`            //
+	Profile         string                                                      `json:"profile,omitempty"`         //
+	Topology        *ProvisionNFVRequestSiteProfileDeviceCustomServicesTopology `json:"topology,omitempty"`        //
 }
 
 // ProvisionNFVRequestSiteProfileDeviceCustomServicesTopology is the provisionNFVRequestSiteProfileDeviceCustomServicesTopology definition
@@ -268,20 +269,20 @@ type ProvisionNFVRequestSiteProfileDeviceCustomTemplate struct {
 
 // ProvisionNFVRequestSiteProfileDeviceServiceProviders is the provisionNFVRequestSiteProfileDeviceServiceProviders definition
 type ProvisionNFVRequestSiteProfileDeviceServiceProviders struct {
-	Connect         bool   `json:"connect,omitempty"`         //
-	DefaultGateway  bool   `json:"defaultGateway,omitempty"`  //
+	Connect         *bool  `json:"connect,omitempty"`         //
+	DefaultGateway  *bool  `json:"defaultGateway,omitempty"`  //
 	LinkType        string `json:"linkType,omitempty"`        //
 	ServiceProvider string `json:"serviceProvider,omitempty"` //
 }
 
 // ProvisionNFVRequestSiteProfileDeviceServices is the provisionNFVRequestSiteProfileDeviceServices definition
 type ProvisionNFVRequestSiteProfileDeviceServices struct {
-	ImageName string                                               `json:"imageName,omitempty"` //
-	Mode      string                                               `json:"mode,omitempty"`      //
-	Name      string                                               `json:"name,omitempty"`      //
-	Profile   string                                               `json:"profile,omitempty"`   //
-	Topology  ProvisionNFVRequestSiteProfileDeviceServicesTopology `json:"topology,omitempty"`  //
-	Type      string                                               `json:"type,omitempty"`      //
+	ImageName string                                                `json:"imageName,omitempty"` //
+	Mode      string                                                `json:"mode,omitempty"`      //
+	Name      string                                                `json:"name,omitempty"`      //
+	Profile   string                                                `json:"profile,omitempty"`   //
+	Topology  *ProvisionNFVRequestSiteProfileDeviceServicesTopology `json:"topology,omitempty"`  //
+	Type      string                                                `json:"type,omitempty"`      //
 }
 
 // ProvisionNFVRequestSiteProfileDeviceServicesTopology is the provisionNFVRequestSiteProfileDeviceServicesTopology definition
@@ -308,7 +309,7 @@ type UpdateNFVProfileRequestDevice struct {
 	CustomNetworks                  []UpdateNFVProfileRequestDeviceCustomNetworks `json:"customNetworks,omitempty"`                  //
 	CustomTemplate                  []UpdateNFVProfileRequestDeviceCustomTemplate `json:"customTemplate,omitempty"`                  //
 	DeviceTag                       string                                        `json:"deviceTag,omitempty"`                       //
-	DirectInternetAccessForFirewall bool                                          `json:"directInternetAccessForFirewall,omitempty"` //
+	DirectInternetAccessForFirewall *bool                                         `json:"directInternetAccessForFirewall,omitempty"` //
 	Services                        []UpdateNFVProfileRequestDeviceServices       `json:"services,omitempty"`                        //
 	VLANForL2                       []UpdateNFVProfileRequestDeviceVLANForL2      `json:"vlanForL2,omitempty"`                       //
 }
@@ -318,7 +319,7 @@ type UpdateNFVProfileRequestDeviceCustomNetworks struct {
 	ConnectionType    string                                                         `json:"connectionType,omitempty"`    //
 	NetworkName       string                                                         `json:"networkName,omitempty"`       //
 	ServicesToConnect []UpdateNFVProfileRequestDeviceCustomNetworksServicesToConnect `json:"servicesToConnect,omitempty"` //
-	VLANID            float64                                                        `json:"vlanId,omitempty"`            //
+	VLANID            *float64                                                       `json:"vlanId,omitempty"`            //
 	VLANMode          string                                                         `json:"vlanMode,omitempty"`          //
 }
 
@@ -352,9 +353,9 @@ type UpdateNFVProfileRequestDeviceServicesVNicMapping struct {
 
 // UpdateNFVProfileRequestDeviceVLANForL2 is the updateNFVProfileRequestDeviceVLANForL2 definition
 type UpdateNFVProfileRequestDeviceVLANForL2 struct {
-	VLANDescription string  `json:"vlanDescription,omitempty"` //
-	VLANID          float64 `json:"vlanId,omitempty"`          //
-	VLANType        string  `json:"vlanType,omitempty"`        //
+	VLANDescription string   `json:"vlanDescription,omitempty"` //
+	VLANID          *float64 `json:"vlanId,omitempty"`          //
+	VLANType        string   `json:"vlanType,omitempty"`        //
 }
 
 // CreateNFVProfileResponse is the createNFVProfileResponse definition
@@ -500,10 +501,16 @@ type UpdateNFVProfileResponse struct {
 /* API to create network profile for different NFV topologies
  */
 func (s *SiteDesignService) CreateNFVProfile(createNFVProfileRequest *CreateNFVProfileRequest) (*CreateNFVProfileResponse, *resty.Response, error) {
+	return s.CreateNFVProfileWithContext(context.Background(), createNFVProfileRequest)
+}
+
+// CreateNFVProfileWithContext is like CreateNFVProfile but sends the request with ctx for cancellation and deadlines.
+func (s *SiteDesignService) CreateNFVProfileWithContext(ctx context.Context, createNFVProfileRequest *CreateNFVProfileRequest) (*CreateNFVProfileResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/nfv/network-profile"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(createNFVProfileRequest).
 		SetResult(&CreateNFVProfileResponse{}).
 		SetError(&Error{}).
@@ -514,7 +521,7 @@ func (s *SiteDesignService) CreateNFVProfile(createNFVProfileRequest *CreateNFVP
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation createNFVProfile")
+		return nil, response, newAPIError("createNFVProfile", response)
 	}
 
 	result := response.Result().(*CreateNFVProfileResponse)
@@ -531,12 +538,18 @@ type GetDeviceDetailsByIPQueryParams struct {
 @param deviceIP Device to which the provisioning detail has to be retrieved
 */
 func (s *SiteDesignService) GetDeviceDetailsByIP(getDeviceDetailsByIPQueryParams *GetDeviceDetailsByIPQueryParams) (*GetDeviceDetailsByIPResponse, *resty.Response, error) {
+	return s.GetDeviceDetailsByIPWithContext(context.Background(), getDeviceDetailsByIPQueryParams)
+}
+
+// GetDeviceDetailsByIPWithContext is like GetDeviceDetailsByIP but sends the request with ctx for cancellation and deadlines.
+func (s *SiteDesignService) GetDeviceDetailsByIPWithContext(ctx context.Context, getDeviceDetailsByIPQueryParams *GetDeviceDetailsByIPQueryParams) (*GetDeviceDetailsByIPResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/business/nfv/provisioningDetail"
 
 	queryString, _ := query.Values(getDeviceDetailsByIPQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetDeviceDetailsByIPResponse{}).
 		SetError(&Error{}).
@@ -547,7 +560,7 @@ func (s *SiteDesignService) GetDeviceDetailsByIP(getDeviceDetailsByIPQueryParams
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getDeviceDetailsByIP")
+		return nil, response, newAPIError("getDeviceDetailsByIP", response)
 	}
 
 	result := response.Result().(*GetDeviceDetailsByIPResponse)
@@ -569,6 +582,11 @@ type GetNFVProfileQueryParams struct {
 @param name Name of network profile to be retrieved
 */
 func (s *SiteDesignService) GetNFVProfile(id string, getNFVProfileQueryParams *GetNFVProfileQueryParams) (*GetNFVProfileResponse, *resty.Response, error) {
+	return s.GetNFVProfileWithContext(context.Background(), id, getNFVProfileQueryParams)
+}
+
+// GetNFVProfileWithContext is like GetNFVProfile but sends the request with ctx for cancellation and deadlines.
+func (s *SiteDesignService) GetNFVProfileWithContext(ctx context.Context, id string, getNFVProfileQueryParams *GetNFVProfileQueryParams) (*GetNFVProfileResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/nfv/network-profile/{id}"
 	path = strings.Replace(path, "{"+"id"+"}", fmt.Sprintf("%v", id), -1)
@@ -576,6 +594,7 @@ func (s *SiteDesignService) GetNFVProfile(id string, getNFVProfileQueryParams *G
 	queryString, _ := query.Values(getNFVProfileQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetNFVProfileResponse{}).
 		SetError(&Error{}).
@@ -586,7 +605,7 @@ func (s *SiteDesignService) GetNFVProfile(id string, getNFVProfileQueryParams *G
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getNFVProfile")
+		return nil, response, newAPIError("getNFVProfile", response)
 	}
 
 	result := response.Result().(*GetNFVProfileResponse)
@@ -600,10 +619,16 @@ func (s *SiteDesignService) GetNFVProfile(id string, getNFVProfileQueryParams *G
 @param __persistbapioutput Persist bapi sync response
 */
 func (s *SiteDesignService) NFVProvisioningDetail(nFVProvisioningDetailRequest *NFVProvisioningDetailRequest) (*NFVProvisioningDetailResponse, *resty.Response, error) {
+	return s.NFVProvisioningDetailWithContext(context.Background(), nFVProvisioningDetailRequest)
+}
+
+// NFVProvisioningDetailWithContext is like NFVProvisioningDetail but sends the request with ctx for cancellation and deadlines.
+func (s *SiteDesignService) NFVProvisioningDetailWithContext(ctx context.Context, nFVProvisioningDetailRequest *NFVProvisioningDetailRequest) (*NFVProvisioningDetailResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/nfv-provision-detail"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(nFVProvisioningDetailRequest).
 		SetResult(&NFVProvisioningDetailResponse{}).
 		SetError(&Error{}).
@@ -614,7 +639,7 @@ func (s *SiteDesignService) NFVProvisioningDetail(nFVProvisioningDetailRequest *
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation nFVProvisioningDetail")
+		return nil, response, newAPIError("nFVProvisioningDetail", response)
 	}
 
 	result := response.Result().(*NFVProvisioningDetailResponse)
@@ -628,10 +653,16 @@ func (s *SiteDesignService) NFVProvisioningDetail(nFVProvisioningDetailRequest *
 @param __persistbapioutput Persist bapi sync response
 */
 func (s *SiteDesignService) ProvisionNFV(provisionNFVRequest *ProvisionNFVRequest) (*ProvisionNFVResponse, *resty.Response, error) {
+	return s.ProvisionNFVWithContext(context.Background(), provisionNFVRequest)
+}
+
+// ProvisionNFVWithContext is like ProvisionNFV but sends the request with ctx for cancellation and deadlines.
+func (s *SiteDesignService) ProvisionNFVWithContext(ctx context.Context, provisionNFVRequest *ProvisionNFVRequest) (*ProvisionNFVResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/business/nfv"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(provisionNFVRequest).
 		SetResult(&ProvisionNFVResponse{}).
 		SetError(&Error{}).
@@ -642,7 +673,7 @@ func (s *SiteDesignService) ProvisionNFV(provisionNFVRequest *ProvisionNFVReques
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation provisionNFV")
+		return nil, response, newAPIError("provisionNFV", response)
 	}
 
 	result := response.Result().(*ProvisionNFVResponse)
@@ -660,6 +691,11 @@ type UpdateNFVProfileQueryParams struct {
 @param name Name of the profile to be updated
 */
 func (s *SiteDesignService) UpdateNFVProfile(id string, updateNFVProfileQueryParams *UpdateNFVProfileQueryParams, updateNFVProfileRequest *UpdateNFVProfileRequest) (*UpdateNFVProfileResponse, *resty.Response, error) {
+	return s.UpdateNFVProfileWithContext(context.Background(), id, updateNFVProfileQueryParams, updateNFVProfileRequest)
+}
+
+// UpdateNFVProfileWithContext is like UpdateNFVProfile but sends the request with ctx for cancellation and deadlines.
+func (s *SiteDesignService) UpdateNFVProfileWithContext(ctx context.Context, id string, updateNFVProfileQueryParams *UpdateNFVProfileQueryParams, updateNFVProfileRequest *UpdateNFVProfileRequest) (*UpdateNFVProfileResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/nfv/network-profile/{id}"
 	path = strings.Replace(path, "{"+"id"+"}", fmt.Sprintf("%v", id), -1)
@@ -667,6 +703,7 @@ func (s *SiteDesignService) UpdateNFVProfile(id string, updateNFVProfileQueryPar
 	queryString, _ := query.Values(updateNFVProfileQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetBody(updateNFVProfileRequest).
 		SetResult(&UpdateNFVProfileResponse{}).
@@ -678,7 +715,7 @@ func (s *SiteDesignService) UpdateNFVProfile(id string, updateNFVProfileQueryPar
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation updateNFVProfile")
+		return nil, response, newAPIError("updateNFVProfile", response)
 	}
 
 	result := response.Result().(*UpdateNFVProfileResponse)
@@ -0,0 +1,474 @@
+package dnac
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// SiteNetworkField is a field of SiteNetworkSettings, named after the JSON
+// name of the setting in CreateNetworkRequest and UpdateNetworkRequest.
+type SiteNetworkField string
+
+const (
+	SiteNetworkDHCP                 SiteNetworkField = "dhcpServer"
+	SiteNetworkDNS                  SiteNetworkField = "dnsServer"
+	SiteNetworkNTP                  SiteNetworkField = "ntpServer"
+	SiteNetworkSyslog               SiteNetworkField = "syslogServer"
+	SiteNetworkSNMP                 SiteNetworkField = "snmpServer"
+	SiteNetworkNetflow              SiteNetworkField = "netflowcollector"
+	SiteNetworkNetworkAAA           SiteNetworkField = "network_aaa"
+	SiteNetworkClientAndEndpointAAA SiteNetworkField = "clientAndEndpoint_aaa"
+	SiteNetworkTimezone             SiteNetworkField = "timezone"
+	SiteNetworkBanner               SiteNetworkField = "messageOfTheday"
+)
+
+// siteNetworkFields is the order in which fields are compared and reported.
+var siteNetworkFields = []SiteNetworkField{
+	SiteNetworkDHCP,
+	SiteNetworkDNS,
+	SiteNetworkNTP,
+	SiteNetworkSyslog,
+	SiteNetworkSNMP,
+	SiteNetworkNetflow,
+	SiteNetworkNetworkAAA,
+	SiteNetworkClientAndEndpointAAA,
+	SiteNetworkTimezone,
+	SiteNetworkBanner,
+}
+
+// SiteNetworkSettings are the network settings of a site. In a desired
+// state, nil and empty fields are left as they are.
+type SiteNetworkSettings struct {
+	DHCPServers          []string
+	DNS                  *SiteDNSSettings
+	NTPServers           []string
+	Syslog               *SiteServerSettings
+	SNMP                 *SiteServerSettings
+	Netflow              *SiteNetflowSettings
+	NetworkAAA           *SiteAAASettings
+	ClientAndEndpointAAA *SiteAAASettings
+	Timezone             string
+	Banner               *SiteBannerSettings
+
+	// inherited maps the fields inherited from an ancestor site to its name.
+	inherited map[SiteNetworkField]string
+}
+
+// SiteDNSSettings are the DNS settings of a site.
+type SiteDNSSettings struct {
+	DomainName         string `json:"domainName,omitempty"`
+	PrimaryIPAddress   string `json:"primaryIpAddress,omitempty"`
+	SecondaryIPAddress string `json:"secondaryIpAddress,omitempty"`
+}
+
+// SiteServerSettings are the syslog or SNMP trap receivers of a site.
+type SiteServerSettings struct {
+	IPAddresses     []string `json:"ipAddresses,omitempty"`
+	ConfigureDNACIP bool     `json:"configureDnacIP,omitempty"` // Use DNA Center as a receiver too
+}
+
+// SiteNetflowSettings is the netflow collector of a site.
+type SiteNetflowSettings struct {
+	IPAddress string `json:"ipAddress,omitempty"`
+	Port      int    `json:"port,omitempty"`
+}
+
+// SiteAAASettings are the AAA servers of a site. DNA Center never reports
+// the shared secret, so it is sent but not compared.
+type SiteAAASettings struct {
+	Servers      string // AAA or ISE
+	Protocol     string // RADIUS or TACACS
+	Network      string // Primary server
+	IPAddress    string // Secondary server, or the ISE PAN
+	SharedSecret string
+}
+
+// SiteBannerSettings is the login banner of a site.
+type SiteBannerSettings struct {
+	Message        string `json:"bannerMessage,omitempty"`
+	RetainExisting bool   `json:"retainExistingBanner,omitempty"`
+}
+
+// InheritedFrom returns the name of the ancestor site, e.g. Global, a field of
+// settings loaded with GetSiteNetworkSettings is inherited from, or an empty
+// string if the field is set on the site itself.
+func (s *SiteNetworkSettings) InheritedFrom(field SiteNetworkField) string {
+	return s.inherited[field]
+}
+
+// GetSiteNetworkSettings returns the network settings of a site, including
+// those it inherits.
+func (s *NetworkSettingsService) GetSiteNetworkSettings(ctx context.Context, siteID string) (*SiteNetworkSettings, error) {
+	result, _, err := s.GetNetworkWithContext(ctx, &GetNetworkQueryParams{SiteID: siteID})
+	if err != nil {
+		return nil, err
+	}
+	settings, _, err := parseSiteNetworkSettings(result.Response)
+	return settings, err
+}
+
+// parseSiteNetworkSettings decodes the entries of GetNetwork, and reports
+// whether any of them is set on the site itself.
+func parseSiteNetworkSettings(entries []GetNetworkResponseResponse) (*SiteNetworkSettings, bool, error) {
+	settings := &SiteNetworkSettings{inherited: map[SiteNetworkField]string{}}
+	own := false
+	for _, entry := range entries {
+		field, err := settings.decode(entry)
+		if err != nil {
+			return nil, false, fmt.Errorf("site network settings: %s: %v", entry.Key, err)
+		}
+		if field == "" {
+			continue
+		}
+		if entry.InheritedGroupUUID != "" && entry.InheritedGroupUUID != entry.GroupUUID {
+			settings.inherited[field] = entry.InheritedGroupName
+		} else {
+			own = true
+		}
+	}
+	return settings, own, nil
+}
+
+// decode stores the value of a GetNetwork entry and returns its field, or an
+// empty field for the keys that are not modeled.
+func (s *SiteNetworkSettings) decode(entry GetNetworkResponseResponse) (SiteNetworkField, error) {
+	key := entry.Key
+	switch {
+	case key == "dhcp.server":
+		return SiteNetworkDHCP, decodeNetworkValues(entry.Value, &s.DHCPServers)
+	case key == "ntp.server":
+		return SiteNetworkNTP, decodeNetworkValues(entry.Value, &s.NTPServers)
+	case key == "dns.server":
+		s.DNS = &SiteDNSSettings{}
+		return SiteNetworkDNS, decodeNetworkValue(entry.Value, s.DNS)
+	case key == "syslog.server":
+		s.Syslog = &SiteServerSettings{}
+		return SiteNetworkSyslog, decodeNetworkValue(entry.Value, s.Syslog)
+	case key == "snmp.trap.receiver":
+		s.SNMP = &SiteServerSettings{}
+		return SiteNetworkSNMP, decodeNetworkValue(entry.Value, s.SNMP)
+	case key == "netflow.collector":
+		s.Netflow = &SiteNetflowSettings{}
+		return SiteNetworkNetflow, decodeNetworkValue(entry.Value, s.Netflow)
+	case key == "device.banner":
+		s.Banner = &SiteBannerSettings{}
+		return SiteNetworkBanner, decodeNetworkValue(entry.Value, s.Banner)
+	case key == "timezone.site":
+		var zones []string
+		err := decodeNetworkValues(entry.Value, &zones)
+		if len(zones) > 0 {
+			s.Timezone = zones[0]
+		}
+		return SiteNetworkTimezone, err
+	case strings.HasPrefix(key, "aaa.network.") || key == "aaa.server.pan.network":
+		if s.NetworkAAA == nil {
+			s.NetworkAAA = &SiteAAASettings{Servers: "AAA"}
+		}
+		return SiteNetworkNetworkAAA, decodeAAAValue(key, entry.Value, s.NetworkAAA)
+	case strings.HasPrefix(key, "aaa.endpoint.") || key == "aaa.server.pan.endpoint":
+		if s.ClientAndEndpointAAA == nil {
+			s.ClientAndEndpointAAA = &SiteAAASettings{Servers: "AAA"}
+		}
+		return SiteNetworkClientAndEndpointAAA, decodeAAAValue(key, entry.Value, s.ClientAndEndpointAAA)
+	}
+	return "", nil
+}
+
+// decodeNetworkValues re-marshals the values of an entry into target.
+func decodeNetworkValues(values []interface{}, target interface{}) error {
+	b, err := json.Marshal(values)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, target)
+}
+
+// decodeNetworkValue re-marshals the first value of an entry into target.
+func decodeNetworkValue(values []interface{}, target interface{}) error {
+	if len(values) == 0 {
+		return nil
+	}
+	b, err := json.Marshal(values[0])
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, target)
+}
+
+// decodeAAAValue stores an AAA server entry, reported either as an address
+// or as an object with the address and protocol of the server.
+func decodeAAAValue(key string, values []interface{}, aaa *SiteAAASettings) error {
+	if len(values) == 0 {
+		return nil
+	}
+	var server struct {
+		IPAddress string `json:"ipAddress"`
+		Protocol  string `json:"protocol"`
+	}
+	if address, ok := values[0].(string); ok {
+		server.IPAddress = address
+	} else if err := decodeNetworkValue(values, &server); err != nil {
+		return err
+	}
+	if server.Protocol != "" {
+		aaa.Protocol = server.Protocol
+	}
+	switch {
+	case strings.HasPrefix(key, "aaa.server.pan."):
+		aaa.Servers = "ISE"
+		aaa.IPAddress = server.IPAddress
+	case strings.HasSuffix(key, ".protocol"):
+		aaa.Protocol = server.IPAddress
+	case strings.HasSuffix(key, ".2"):
+		if aaa.Servers != "ISE" {
+			aaa.IPAddress = server.IPAddress
+		}
+	default:
+		aaa.Network = server.IPAddress
+	}
+	return nil
+}
+
+// SiteNetworkChange is a field whose desired value differs from the current one.
+type SiteNetworkChange struct {
+	Field         SiteNetworkField
+	Current       interface{}
+	Desired       interface{}
+	InheritedFrom string // Ancestor site the current value is inherited from, if any
+}
+
+// String returns the change as text.
+func (c SiteNetworkChange) String() string {
+	source := ""
+	if c.InheritedFrom != "" {
+		source = " (inherited from " + c.InheritedFrom + ")"
+	}
+	return fmt.Sprintf("%s: %+v%s -> %+v", c.Field, c.Current, source, c.Desired)
+}
+
+// DiffSiteNetworkSettings returns the fields set in desired whose value
+// differs from current, in a fixed order. Lists of addresses are compared
+// regardless of their order, and the shared secrets of AAA servers are not
+// compared.
+func DiffSiteNetworkSettings(current *SiteNetworkSettings, desired *SiteNetworkSettings) []SiteNetworkChange {
+	var changes []SiteNetworkChange
+	for _, field := range siteNetworkFields {
+		have, want := current.field(field), desired.field(field)
+		if want == nil || siteNetworkValuesEqual(have, want) {
+			continue
+		}
+		changes = append(changes, SiteNetworkChange{
+			Field:         field,
+			Current:       have,
+			Desired:       want,
+			InheritedFrom: current.InheritedFrom(field),
+		})
+	}
+	return changes
+}
+
+// field returns the value of a field, nil if it is not set.
+func (s *SiteNetworkSettings) field(field SiteNetworkField) interface{} {
+	switch field {
+	case SiteNetworkDHCP:
+		if len(s.DHCPServers) > 0 {
+			return s.DHCPServers
+		}
+	case SiteNetworkNTP:
+		if len(s.NTPServers) > 0 {
+			return s.NTPServers
+		}
+	case SiteNetworkTimezone:
+		if s.Timezone != "" {
+			return s.Timezone
+		}
+	case SiteNetworkDNS:
+		if s.DNS != nil {
+			return *s.DNS
+		}
+	case SiteNetworkSyslog:
+		if s.Syslog != nil {
+			return *s.Syslog
+		}
+	case SiteNetworkSNMP:
+		if s.SNMP != nil {
+			return *s.SNMP
+		}
+	case SiteNetworkNetflow:
+		if s.Netflow != nil {
+			return *s.Netflow
+		}
+	case SiteNetworkNetworkAAA:
+		if s.NetworkAAA != nil {
+			return *s.NetworkAAA
+		}
+	case SiteNetworkClientAndEndpointAAA:
+		if s.ClientAndEndpointAAA != nil {
+			return *s.ClientAndEndpointAAA
+		}
+	case SiteNetworkBanner:
+		if s.Banner != nil {
+			return *s.Banner
+		}
+	}
+	return nil
+}
+
+func siteNetworkValuesEqual(current interface{}, desired interface{}) bool {
+	switch want := desired.(type) {
+	case []string:
+		have, _ := current.([]string)
+		return sortedStringsEqual(have, want)
+	case SiteServerSettings:
+		have, ok := current.(SiteServerSettings)
+		return ok && have.ConfigureDNACIP == want.ConfigureDNACIP && sortedStringsEqual(have.IPAddresses, want.IPAddresses)
+	case SiteAAASettings:
+		have, ok := current.(SiteAAASettings)
+		want.SharedSecret = ""
+		have.SharedSecret = ""
+		return ok && have == want
+	}
+	return reflect.DeepEqual(current, desired)
+}
+
+func sortedStringsEqual(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	a = append([]string(nil), a...)
+	b = append([]string(nil), b...)
+	sort.Strings(a)
+	sort.Strings(b)
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// SyncSiteNetworkSettings brings the network settings of a site to desired.
+// Only the fields that differ are sent, with CreateNetwork if the site has no
+// settings of its own yet and with UpdateNetwork otherwise, and the call is
+// awaited. It returns the changes made, none if the site is already in sync.
+// opts may be nil to use the defaults.
+func (s *NetworkSettingsService) SyncSiteNetworkSettings(ctx context.Context, siteID string, desired *SiteNetworkSettings, opts *WaitForTaskOptions) ([]SiteNetworkChange, error) {
+	result, _, err := s.GetNetworkWithContext(ctx, &GetNetworkQueryParams{SiteID: siteID})
+	if err != nil {
+		return nil, err
+	}
+	current, own, err := parseSiteNetworkSettings(result.Response)
+	if err != nil {
+		return nil, err
+	}
+	changes := DiffSiteNetworkSettings(current, desired)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+
+	request := &UpdateNetworkRequest{Settings: siteNetworkRequestSettings(desired, changes)}
+	executionStatusURL := ""
+	if own {
+		response, _, err := s.UpdateNetworkWithContext(ctx, siteID, request)
+		if err != nil {
+			return nil, err
+		}
+		executionStatusURL = response.ExecutionStatusURL
+	} else {
+		create := &CreateNetworkRequest{Settings: createNetworkRequestSettings(request.Settings)}
+		response, _, err := s.CreateNetworkWithContext(ctx, siteID, create)
+		if err != nil {
+			return nil, err
+		}
+		executionStatusURL = response.ExecutionStatusURL
+	}
+	if executionStatusURL != "" {
+		if err := waitForExecution(ctx, s.client, executionStatusURL, opts); err != nil {
+			return nil, err
+		}
+	}
+	return changes, nil
+}
+
+// siteNetworkRequestSettings returns the settings of the request that makes
+// the changes, holding only the changed fields.
+func siteNetworkRequestSettings(desired *SiteNetworkSettings, changes []SiteNetworkChange) *UpdateNetworkRequestSettings {
+	settings := &UpdateNetworkRequestSettings{}
+	for _, change := range changes {
+		switch change.Field {
+		case SiteNetworkDHCP:
+			settings.DhcpServer = desired.DHCPServers
+		case SiteNetworkNTP:
+			settings.NtpServer = desired.NTPServers
+		case SiteNetworkTimezone:
+			settings.Timezone = desired.Timezone
+		case SiteNetworkDNS:
+			settings.DNSServer = &UpdateNetworkRequestSettingsDNSServer{
+				DomainName:         desired.DNS.DomainName,
+				PrimaryIPAddress:   desired.DNS.PrimaryIPAddress,
+				SecondaryIPAddress: desired.DNS.SecondaryIPAddress,
+			}
+		case SiteNetworkSyslog:
+			settings.SyslogServer = &UpdateNetworkRequestSettingsSyslogServer{
+				ConfigureDnacIP: Bool(desired.Syslog.ConfigureDNACIP),
+				IPAddresses:     desired.Syslog.IPAddresses,
+			}
+		case SiteNetworkSNMP:
+			settings.SNMPServer = &UpdateNetworkRequestSettingsSNMPServer{
+				ConfigureDnacIP: Bool(desired.SNMP.ConfigureDNACIP),
+				IPAddresses:     desired.SNMP.IPAddresses,
+			}
+		case SiteNetworkNetflow:
+			settings.Netflowcollector = &UpdateNetworkRequestSettingsNetflowcollector{
+				IPAddress: desired.Netflow.IPAddress,
+				Port:      Float64(float64(desired.Netflow.Port)),
+			}
+		case SiteNetworkNetworkAAA:
+			aaa := desired.NetworkAAA
+			settings.NetworkAAA = &UpdateNetworkRequestSettingsNetworkAAA{
+				Servers:      aaa.Servers,
+				Protocol:     aaa.Protocol,
+				Network:      aaa.Network,
+				IPAddress:    aaa.IPAddress,
+				SharedSecret: aaa.SharedSecret,
+			}
+		case SiteNetworkClientAndEndpointAAA:
+			aaa := desired.ClientAndEndpointAAA
+			settings.ClientAndEndpointAAA = &UpdateNetworkRequestSettingsClientAndEndpointAAA{
+				Servers:      aaa.Servers,
+				Protocol:     aaa.Protocol,
+				Network:      aaa.Network,
+				IPAddress:    aaa.IPAddress,
+				SharedSecret: aaa.SharedSecret,
+			}
+		case SiteNetworkBanner:
+			settings.MessageOfTheday = &UpdateNetworkRequestSettingsMessageOfTheday{
+				BannerMessage:        desired.Banner.Message,
+				RetainExistingBanner: Bool(desired.Banner.RetainExisting),
+			}
+		}
+	}
+	return settings
+}
+
+// createNetworkRequestSettings returns the CreateNetwork settings equal to the
+// UpdateNetwork settings. The settings of both requests have the same fields,
+// so their pointers convert to each other.
+func createNetworkRequestSettings(settings *UpdateNetworkRequestSettings) *CreateNetworkRequestSettings {
+	return &CreateNetworkRequestSettings{
+		ClientAndEndpointAAA: (*CreateNetworkRequestSettingsClientAndEndpointAAA)(settings.ClientAndEndpointAAA),
+		DhcpServer:           settings.DhcpServer,
+		DNSServer:            (*CreateNetworkRequestSettingsDNSServer)(settings.DNSServer),
+		MessageOfTheday:      (*CreateNetworkRequestSettingsMessageOfTheday)(settings.MessageOfTheday),
+		Netflowcollector:     (*CreateNetworkRequestSettingsNetflowcollector)(settings.Netflowcollector),
+		NetworkAAA:           (*CreateNetworkRequestSettingsNetworkAAA)(settings.NetworkAAA),
+		NtpServer:            settings.NtpServer,
+		SNMPServer:           (*CreateNetworkRequestSettingsSNMPServer)(settings.SNMPServer),
+		SyslogServer:         (*CreateNetworkRequestSettingsSyslogServer)(settings.SyslogServer),
+		Timezone:             settings.Timezone,
+	}
+}
@@ -0,0 +1,331 @@
+package dnac_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	dnac "github.com/cisco-en-programmability/dnacenter-go-sdk/sdk"
+	"github.com/cisco-en-programmability/dnacenter-go-sdk/sdk/dnactest"
+)
+
+const (
+	networkPath     = "/dna/intent/api/v1/network"
+	siteNetworkPath = "/dna/intent/api/v1/network/{siteId}"
+)
+
+// The payloads in testdata/network are GetNetwork responses. SJC sets most
+// settings itself and inherits DHCP, DNS and NTP from Global. NYC only
+// inherits its settings, from Global and US.
+
+// networkServer returns a server answering GetNetwork with body, and
+// CreateNetwork and UpdateNetwork with an execution that ends with status.
+func networkServer(t *testing.T, body string, status string) (*dnactest.Server, *dnac.Client) {
+	t.Helper()
+	srv, client := taskServer(t)
+	srv.RespondSequence(http.MethodGet, networkPath, dnactest.Response{Body: body})
+	accepted := map[string]string{
+		"executionId":        "e1",
+		"executionStatusUrl": "/dna/intent/api/v1/dnacaap/management/execution-status/e1",
+		"message":            "The request has been accepted for execution",
+	}
+	srv.RespondJSON(http.MethodPost, siteNetworkPath, http.StatusAccepted, accepted)
+	srv.RespondJSON(http.MethodPut, siteNetworkPath, http.StatusAccepted, accepted)
+	srv.RespondJSON(http.MethodGet, executionStatusPath, http.StatusOK, map[string]string{
+		"bapiExecutionId": "e1",
+		"bapiName":        "Update Network",
+		"bapiError":       "invalid AAA server",
+		"status":          status,
+	})
+	return srv, client
+}
+
+func recordedNetwork(t *testing.T, site string) string {
+	t.Helper()
+	b, err := ioutil.ReadFile(filepath.Join("testdata", "network", site+".json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
+
+func TestGetSiteNetworkSettings(t *testing.T) {
+	srv, client := networkServer(t, recordedNetwork(t, "sjc"), "SUCCESS")
+
+	settings, err := client.NetworkSettings.GetSiteNetworkSettings(context.Background(), "sjc-uuid")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r := srv.LastRequestTo(http.MethodGet, networkPath); r == nil || r.Query.Get("siteId") != "sjc-uuid" {
+		t.Errorf("got request %+v, want the settings of sjc-uuid", r)
+	}
+
+	want := dnac.SiteNetworkSettings{
+		DHCPServers:          []string{"10.10.1.10", "10.10.1.11"},
+		DNS:                  &dnac.SiteDNSSettings{DomainName: "corp.example.com", PrimaryIPAddress: "10.10.1.20", SecondaryIPAddress: "10.10.1.21"},
+		NTPServers:           []string{"10.10.1.30"},
+		Syslog:               &dnac.SiteServerSettings{IPAddresses: []string{"10.10.1.40"}, ConfigureDNACIP: true},
+		SNMP:                 &dnac.SiteServerSettings{IPAddresses: []string{"10.10.1.41", "10.10.1.42"}},
+		Netflow:              &dnac.SiteNetflowSettings{IPAddress: "10.10.1.50", Port: 2055},
+		NetworkAAA:           &dnac.SiteAAASettings{Servers: "ISE", Protocol: "RADIUS", Network: "10.10.1.61", IPAddress: "10.10.1.60"},
+		ClientAndEndpointAAA: &dnac.SiteAAASettings{Servers: "AAA", Protocol: "TACACS", Network: "10.10.1.70", IPAddress: "10.10.1.71"},
+		Timezone:             "America/Los_Angeles",
+		Banner:               &dnac.SiteBannerSettings{Message: "Authorized access only"},
+	}
+	// The inherited fields are compared through InheritedFrom below.
+	got := *settings
+	if diff := dnac.DiffSiteNetworkSettings(&got, &want); len(diff) > 0 {
+		t.Errorf("got settings that differ from the payload: %v", diff)
+	}
+	if !reflect.DeepEqual(got.NetworkAAA, want.NetworkAAA) || !reflect.DeepEqual(got.ClientAndEndpointAAA, want.ClientAndEndpointAAA) {
+		t.Errorf("got AAA %+v and %+v, want %+v and %+v", got.NetworkAAA, got.ClientAndEndpointAAA, want.NetworkAAA, want.ClientAndEndpointAAA)
+	}
+
+	for field, site := range map[dnac.SiteNetworkField]string{
+		dnac.SiteNetworkDHCP:                 "Global",
+		dnac.SiteNetworkDNS:                  "Global",
+		dnac.SiteNetworkNTP:                  "Global",
+		dnac.SiteNetworkTimezone:             "",
+		dnac.SiteNetworkSyslog:               "",
+		dnac.SiteNetworkNetworkAAA:           "",
+		dnac.SiteNetworkClientAndEndpointAAA: "",
+	} {
+		if got := settings.InheritedFrom(field); got != site {
+			t.Errorf("%s inherited from %q, want %q", field, got, site)
+		}
+	}
+}
+
+// aaaEntry is a GetNetwork entry of an AAA setting.
+type aaaEntry struct {
+	key   string
+	value string // JSON of the first value
+}
+
+func aaaPayload(entries []aaaEntry) string {
+	var response []string
+	for _, entry := range entries {
+		response = append(response, fmt.Sprintf(`{"key": %q, "value": [%s], "groupUuid": "sjc-uuid"}`, entry.key, entry.value))
+	}
+	return `{"response": [` + strings.Join(response, ",") + `]}`
+}
+
+func TestGetSiteNetworkSettingsAAAKeys(t *testing.T) {
+	tests := []struct {
+		name     string
+		entries  []aaaEntry
+		network  *dnac.SiteAAASettings
+		endpoint *dnac.SiteAAASettings
+	}{
+		{
+			"primary and secondary servers",
+			[]aaaEntry{
+				{"aaa.network.server.1", `{"ipAddress": "10.0.0.1", "protocol": "RADIUS"}`},
+				{"aaa.network.server.2", `{"ipAddress": "10.0.0.2"}`},
+			},
+			&dnac.SiteAAASettings{Servers: "AAA", Protocol: "RADIUS", Network: "10.0.0.1", IPAddress: "10.0.0.2"},
+			nil,
+		},
+		{
+			"addresses and a protocol key",
+			[]aaaEntry{
+				{"aaa.network.protocol", `"TACACS"`},
+				{"aaa.network.server.1", `"10.0.0.1"`},
+			},
+			&dnac.SiteAAASettings{Servers: "AAA", Protocol: "TACACS", Network: "10.0.0.1"},
+			nil,
+		},
+		{
+			"ISE PAN first",
+			[]aaaEntry{
+				{"aaa.server.pan.network", `"10.0.0.5"`},
+				{"aaa.network.server.1", `{"ipAddress": "10.0.0.1", "protocol": "RADIUS"}`},
+				{"aaa.network.server.2", `{"ipAddress": "10.0.0.2"}`},
+			},
+			&dnac.SiteAAASettings{Servers: "ISE", Protocol: "RADIUS", Network: "10.0.0.1", IPAddress: "10.0.0.5"},
+			nil,
+		},
+		{
+			"ISE PAN last",
+			[]aaaEntry{
+				{"aaa.network.server.2", `{"ipAddress": "10.0.0.2"}`},
+				{"aaa.network.server.1", `{"ipAddress": "10.0.0.1", "protocol": "RADIUS"}`},
+				{"aaa.server.pan.network", `"10.0.0.5"`},
+			},
+			&dnac.SiteAAASettings{Servers: "ISE", Protocol: "RADIUS", Network: "10.0.0.1", IPAddress: "10.0.0.5"},
+			nil,
+		},
+		{
+			"client and endpoint servers",
+			[]aaaEntry{
+				{"aaa.endpoint.server.1", `{"ipAddress": "10.0.1.1", "protocol": "RADIUS"}`},
+				{"aaa.server.pan.endpoint", `"10.0.1.5"`},
+			},
+			nil,
+			&dnac.SiteAAASettings{Servers: "ISE", Protocol: "RADIUS", Network: "10.0.1.1", IPAddress: "10.0.1.5"},
+		},
+		{
+			"both kinds",
+			[]aaaEntry{
+				{"aaa.network.server.1", `"10.0.0.1"`},
+				{"aaa.endpoint.server.1", `"10.0.1.1"`},
+				{"aaa.endpoint.server.2", `"10.0.1.2"`},
+			},
+			&dnac.SiteAAASettings{Servers: "AAA", Network: "10.0.0.1"},
+			&dnac.SiteAAASettings{Servers: "AAA", Network: "10.0.1.1", IPAddress: "10.0.1.2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, client := networkServer(t, aaaPayload(tt.entries), "SUCCESS")
+			settings, err := client.NetworkSettings.GetSiteNetworkSettings(context.Background(), "sjc-uuid")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(settings.NetworkAAA, tt.network) {
+				t.Errorf("got network AAA %+v, want %+v", settings.NetworkAAA, tt.network)
+			}
+			if !reflect.DeepEqual(settings.ClientAndEndpointAAA, tt.endpoint) {
+				t.Errorf("got client and endpoint AAA %+v, want %+v", settings.ClientAndEndpointAAA, tt.endpoint)
+			}
+		})
+	}
+}
+
+func TestGetSiteNetworkSettingsInvalidValue(t *testing.T) {
+	_, client := networkServer(t, aaaPayload([]aaaEntry{{"aaa.network.server.1", `42`}}), "SUCCESS")
+	_, err := client.NetworkSettings.GetSiteNetworkSettings(context.Background(), "sjc-uuid")
+	if err == nil || !strings.HasPrefix(err.Error(), "site network settings: aaa.network.server.1: ") {
+		t.Errorf("got %v, want an error for the AAA server", err)
+	}
+}
+
+func TestDiffSiteNetworkSettings(t *testing.T) {
+	_, client := networkServer(t, recordedNetwork(t, "sjc"), "SUCCESS")
+	current, err := client.NetworkSettings.GetSiteNetworkSettings(context.Background(), "sjc-uuid")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	desired := &dnac.SiteNetworkSettings{
+		DHCPServers: []string{"10.10.1.11", "10.10.1.10"}, // Same servers in another order
+		NTPServers:  []string{"10.10.1.30", "10.10.1.31"},
+		SNMP:        &dnac.SiteServerSettings{IPAddresses: []string{"10.10.1.42", "10.10.1.41"}},
+		Syslog:      &dnac.SiteServerSettings{IPAddresses: []string{"10.10.1.40"}}, // configureDnacIP turned off
+		NetworkAAA:  &dnac.SiteAAASettings{Servers: "ISE", Protocol: "RADIUS", Network: "10.10.1.61", IPAddress: "10.10.1.60", SharedSecret: "s3cret"},
+		Timezone:    "America/Los_Angeles",
+		Banner:      &dnac.SiteBannerSettings{Message: "Authorized access only. Disconnect now.", RetainExisting: true},
+	}
+	var got []string
+	for _, change := range dnac.DiffSiteNetworkSettings(current, desired) {
+		got = append(got, change.String())
+	}
+	want := []string{
+		"ntpServer: [10.10.1.30] (inherited from Global) -> [10.10.1.30 10.10.1.31]",
+		"syslogServer: {IPAddresses:[10.10.1.40] ConfigureDNACIP:true} -> {IPAddresses:[10.10.1.40] ConfigureDNACIP:false}",
+		"messageOfTheday: {Message:Authorized access only RetainExisting:false} -> {Message:Authorized access only. Disconnect now. RetainExisting:true}",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("got changes:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+
+	if changes := dnac.DiffSiteNetworkSettings(current, &dnac.SiteNetworkSettings{}); len(changes) != 0 {
+		t.Errorf("got %v for empty desired settings, want no changes", changes)
+	}
+}
+
+func TestSyncSiteNetworkSettingsUpdatesOwnSettings(t *testing.T) {
+	srv, client := networkServer(t, recordedNetwork(t, "sjc"), "SUCCESS")
+	desired := &dnac.SiteNetworkSettings{
+		NTPServers: []string{"10.10.1.30", "10.10.1.31"},
+		SNMP:       &dnac.SiteServerSettings{IPAddresses: []string{"10.10.1.42", "10.10.1.41"}},
+		NetworkAAA: &dnac.SiteAAASettings{Servers: "ISE", Protocol: "RADIUS", Network: "10.10.1.61", IPAddress: "10.10.1.60", SharedSecret: "s3cret"},
+		Banner:     &dnac.SiteBannerSettings{Message: "Authorized access only. Disconnect now."},
+	}
+
+	changes, err := client.NetworkSettings.SyncSiteNetworkSettings(context.Background(), "sjc-uuid", desired, fastPolls)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 2 || changes[0].Field != dnac.SiteNetworkNTP || changes[1].Field != dnac.SiteNetworkBanner {
+		t.Errorf("got changes %v, want NTP and the banner", changes)
+	}
+	srv.AssertNotCalled(t, http.MethodPost, siteNetworkPath)
+	srv.AssertJSONBody(t, http.MethodPut, siteNetworkPath, map[string]interface{}{
+		"settings": map[string]interface{}{
+			"ntpServer":       []string{"10.10.1.30", "10.10.1.31"},
+			"messageOfTheday": map[string]interface{}{"bannerMessage": "Authorized access only. Disconnect now.", "retainExistingBanner": false},
+		},
+	})
+	if r := srv.LastRequestTo(http.MethodPut, siteNetworkPath); r.Params["siteId"] != "sjc-uuid" {
+		t.Errorf("updated site %s, want sjc-uuid", r.Params["siteId"])
+	}
+	srv.AssertCalled(t, http.MethodGet, executionStatusPath)
+}
+
+func TestSyncSiteNetworkSettingsCreatesInheritedSettings(t *testing.T) {
+	srv, client := networkServer(t, recordedNetwork(t, "nyc"), "SUCCESS")
+	desired := &dnac.SiteNetworkSettings{
+		DHCPServers: []string{"10.10.1.10", "10.10.1.11"},
+		DNS:         &dnac.SiteDNSSettings{DomainName: "nyc.example.com", PrimaryIPAddress: "10.20.1.20"},
+		Syslog:      &dnac.SiteServerSettings{IPAddresses: []string{"10.20.1.40"}},
+		Netflow:     &dnac.SiteNetflowSettings{IPAddress: "10.20.1.50", Port: 2055},
+		NetworkAAA:  &dnac.SiteAAASettings{Servers: "AAA", Protocol: "TACACS", Network: "10.20.1.61", IPAddress: "10.20.1.62", SharedSecret: "s3cret"},
+		Timezone:    "America/New_York",
+	}
+
+	changes, err := client.NetworkSettings.SyncSiteNetworkSettings(context.Background(), "nyc-uuid", desired, fastPolls)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 4 {
+		t.Errorf("got changes %v, want DNS, syslog, netflow and AAA", changes)
+	}
+	srv.AssertNotCalled(t, http.MethodPut, siteNetworkPath)
+	srv.AssertJSONBody(t, http.MethodPost, siteNetworkPath, map[string]interface{}{
+		"settings": map[string]interface{}{
+			"dnsServer":        map[string]interface{}{"domainName": "nyc.example.com", "primaryIpAddress": "10.20.1.20"},
+			"syslogServer":     map[string]interface{}{"configureDnacIP": false, "ipAddresses": []string{"10.20.1.40"}},
+			"netflowcollector": map[string]interface{}{"ipAddress": "10.20.1.50", "port": 2055},
+			"network_aaa": map[string]interface{}{
+				"servers":      "AAA",
+				"protocol":     "TACACS",
+				"network":      "10.20.1.61",
+				"ipAddress":    "10.20.1.62",
+				"sharedSecret": "s3cret",
+			},
+		},
+	})
+}
+
+func TestSyncSiteNetworkSettingsInSync(t *testing.T) {
+	srv, client := networkServer(t, recordedNetwork(t, "sjc"), "SUCCESS")
+	desired := &dnac.SiteNetworkSettings{
+		NTPServers: []string{"10.10.1.30"},
+		NetworkAAA: &dnac.SiteAAASettings{Servers: "ISE", Protocol: "RADIUS", Network: "10.10.1.61", IPAddress: "10.10.1.60", SharedSecret: "s3cret"},
+	}
+
+	changes, err := client.NetworkSettings.SyncSiteNetworkSettings(context.Background(), "sjc-uuid", desired, fastPolls)
+	if err != nil || changes != nil {
+		t.Errorf("got %v and %v, want no changes", changes, err)
+	}
+	srv.AssertNotCalled(t, http.MethodPut, siteNetworkPath)
+	srv.AssertNotCalled(t, http.MethodPost, siteNetworkPath)
+}
+
+func TestSyncSiteNetworkSettingsReportsFailedExecution(t *testing.T) {
+	_, client := networkServer(t, recordedNetwork(t, "sjc"), "FAILURE")
+	desired := &dnac.SiteNetworkSettings{ClientAndEndpointAAA: &dnac.SiteAAASettings{Servers: "ISE", Protocol: "RADIUS", Network: "10.10.1.99"}}
+
+	_, err := client.NetworkSettings.SyncSiteNetworkSettings(context.Background(), "sjc-uuid", desired, fastPolls)
+	var execErr *dnac.ExecutionError
+	if !errors.As(err, &execErr) || execErr.Reason != "invalid AAA server" {
+		t.Errorf("got %v, want the failed execution", err)
+	}
+}
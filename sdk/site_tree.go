@@ -0,0 +1,275 @@
+package dnac
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// SiteType is the type of a site in the site hierarchy.
+type SiteType string
+
+const (
+	// SiteArea is an area, which holds areas and buildings. Global is an area.
+	SiteArea SiteType = "area"
+	// SiteBuilding is a building, which holds floors.
+	SiteBuilding SiteType = "building"
+	// SiteFloor is a floor of a building.
+	SiteFloor SiteType = "floor"
+)
+
+// siteTreeRoot is the name of the root of the site hierarchy.
+const siteTreeRoot = "Global"
+
+// SiteNode is a site in a SiteTree.
+type SiteNode struct {
+	Site     GetSiteResponseResponse
+	Type     SiteType
+	Parent   *SiteNode // nil for the root
+	Children []*SiteNode
+}
+
+// ID returns the ID of the site.
+func (n *SiteNode) ID() string {
+	return n.Site.ID
+}
+
+// Name returns the name of the site.
+func (n *SiteNode) Name() string {
+	return n.Site.Name
+}
+
+// Path returns the site name hierarchy of the site, e.g. Global/US/SJC.
+func (n *SiteNode) Path() string {
+	return n.Site.SiteNameHierarchy
+}
+
+// Child returns the child of the site with the given name, or nil.
+func (n *SiteNode) Child(name string) *SiteNode {
+	for _, child := range n.Children {
+		if child.Name() == name {
+			return child
+		}
+	}
+	return nil
+}
+
+// Ancestors returns the ancestors of the site, from its parent up to the root.
+func (n *SiteNode) Ancestors() []*SiteNode {
+	var ancestors []*SiteNode
+	for parent := n.Parent; parent != nil; parent = parent.Parent {
+		ancestors = append(ancestors, parent)
+	}
+	return ancestors
+}
+
+// Walk calls fn for the site and then for each of its descendants, depth
+// first, and stops at the first error fn returns.
+func (n *SiteNode) Walk(fn func(*SiteNode) error) error {
+	if err := fn(n); err != nil {
+		return err
+	}
+	for _, child := range n.Children {
+		if err := child.Walk(fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SiteTree is the site hierarchy of DNA Center, indexed by path and ID.
+type SiteTree struct {
+	Root *SiteNode // Global
+
+	sites  *SitesService
+	byID   map[string]*SiteNode
+	byPath map[string]*SiteNode
+}
+
+// LoadSiteTree loads all the sites, page by page, and links them into a tree.
+func (s *SitesService) LoadSiteTree(ctx context.Context) (*SiteTree, error) {
+	t := &SiteTree{
+		sites:  s,
+		byID:   map[string]*SiteNode{},
+		byPath: map[string]*SiteNode{},
+	}
+	var nodes []*SiteNode
+	it := s.ListAll(ctx, nil, nil)
+	for it.Next() {
+		node := newSiteNode(it.Site())
+		nodes = append(nodes, node)
+		t.index(node)
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, node := range nodes {
+		if parent, ok := t.byID[node.Site.ParentID]; ok && parent != node {
+			node.Parent = parent
+			parent.Children = append(parent.Children, node)
+		}
+	}
+	t.Root = t.byPath[siteTreeRoot]
+	if t.Root == nil {
+		return nil, fmt.Errorf("site tree: %s not found", siteTreeRoot)
+	}
+	return t, nil
+}
+
+func newSiteNode(site GetSiteResponseResponse) *SiteNode {
+	node := &SiteNode{Site: site, Type: SiteArea}
+	for _, info := range site.AdditionalInfo {
+		if info.Namespace == "Location" && info.Attributes.Type != "" {
+			node.Type = SiteType(info.Attributes.Type)
+		}
+	}
+	return node
+}
+
+func (t *SiteTree) index(node *SiteNode) {
+	t.byID[node.ID()] = node
+	t.byPath[node.Path()] = node
+}
+
+// ByID returns the site with the given ID.
+func (t *SiteTree) ByID(id string) (*SiteNode, bool) {
+	node, ok := t.byID[id]
+	return node, ok
+}
+
+// ByPath returns the site with the given site name hierarchy, e.g.
+// Global/US/SJC/Bldg-14/Floor-2.
+func (t *SiteTree) ByPath(path string) (*SiteNode, bool) {
+	node, ok := t.byPath[strings.Trim(path, "/")]
+	return node, ok
+}
+
+// Walk calls fn for every site of the tree, depth first from the root.
+func (t *SiteTree) Walk(fn func(*SiteNode) error) error {
+	return t.Root.Walk(fn)
+}
+
+// SitePathElement is a site of the path given to EnsurePath. The Building
+// and Floor settings are only used when the site is created; their Name and
+// ParentName are filled in.
+type SitePathElement struct {
+	Name     string
+	Type     SiteType
+	Building *CreateSiteRequestSiteBuilding
+	Floor    *CreateSiteRequestSiteFloor
+}
+
+// EnsurePath returns the site at the end of path, creating the missing sites
+// of the path from the top down and waiting for each creation to complete.
+// The path starts below Global; a leading Global element is accepted too.
+// The types of the sites are checked, before anything is created, against
+// the existing sites and against the hierarchy rules: areas hold areas and
+// buildings, and buildings hold floors. opts may be nil to use the defaults.
+func (t *SiteTree) EnsurePath(ctx context.Context, path []SitePathElement, opts *WaitForTaskOptions) (*SiteNode, error) {
+	if len(path) > 0 && path[0].Name == t.Root.Name() {
+		path = path[1:]
+	}
+
+	parent := t.Root
+	missing := len(path)
+	for i, element := range path {
+		child := parent.Child(element.Name)
+		if child == nil {
+			missing = i
+			break
+		}
+		if element.Type != "" && element.Type != child.Type {
+			return nil, fmt.Errorf("site tree: %s is a %s, not a %s", child.Path(), child.Type, element.Type)
+		}
+		parent = child
+	}
+
+	parentType, parentPath := parent.Type, parent.Path()
+	for _, element := range path[missing:] {
+		if element.Name == "" || strings.Contains(element.Name, "/") {
+			return nil, fmt.Errorf("site tree: invalid site name %q", element.Name)
+		}
+		if !siteTypeAllowed(parentType, element.Type) {
+			return nil, fmt.Errorf("site tree: cannot create %s %q under %s %s", element.Type, element.Name, parentType, parentPath)
+		}
+		parentType, parentPath = element.Type, parentPath+"/"+element.Name
+	}
+
+	for _, element := range path[missing:] {
+		node, err := t.create(ctx, parent, element, opts)
+		if err != nil {
+			return nil, err
+		}
+		parent = node
+	}
+	return parent, nil
+}
+
+// siteTypeAllowed reports whether a site of type child can be created in a
+// site of type parent.
+func siteTypeAllowed(parent SiteType, child SiteType) bool {
+	switch child {
+	case SiteArea, SiteBuilding:
+		return parent == SiteArea
+	case SiteFloor:
+		return parent == SiteBuilding
+	}
+	return false
+}
+
+// create creates the site described by element in parent, waits for the
+// creation to complete and adds the new site to the tree.
+func (t *SiteTree) create(ctx context.Context, parent *SiteNode, element SitePathElement, opts *WaitForTaskOptions) (*SiteNode, error) {
+	site := &CreateSiteRequestSite{}
+	switch element.Type {
+	case SiteArea:
+		site.Area = &CreateSiteRequestSiteArea{Name: element.Name, ParentName: parent.Path()}
+	case SiteBuilding:
+		building := CreateSiteRequestSiteBuilding{}
+		if element.Building != nil {
+			building = *element.Building
+		}
+		building.Name = element.Name
+		building.ParentName = parent.Path()
+		site.Building = &building
+	case SiteFloor:
+		floor := CreateSiteRequestSiteFloor{}
+		if element.Floor != nil {
+			floor = *element.Floor
+		}
+		floor.Name = element.Name
+		floor.ParentName = parent.Path()
+		site.Floor = &floor
+	}
+	request := &CreateSiteRequest{Site: site, Type: string(element.Type)}
+
+	result, _, err := t.sites.CreateSiteWithContext(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	if result.ExecutionStatusURL != "" {
+		if err := waitForExecution(ctx, t.sites.client, result.ExecutionStatusURL, opts); err != nil {
+			return nil, err
+		}
+	}
+
+	path := parent.Path() + "/" + element.Name
+	sites, _, err := t.sites.GetSiteWithContext(ctx, &GetSiteQueryParams{Name: path})
+	if err != nil {
+		return nil, err
+	}
+	for _, site := range sites.Response {
+		if site.SiteNameHierarchy == path {
+			node := newSiteNode(site)
+			if node.Type != element.Type && len(site.AdditionalInfo) == 0 {
+				node.Type = element.Type
+			}
+			node.Parent = parent
+			parent.Children = append(parent.Children, node)
+			t.index(node)
+			return node, nil
+		}
+	}
+	return nil, fmt.Errorf("site tree: %s not found after its creation", path)
+}
@@ -0,0 +1,316 @@
+package dnac_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	dnac "github.com/cisco-en-programmability/dnacenter-go-sdk/sdk"
+	"github.com/cisco-en-programmability/dnacenter-go-sdk/sdk/dnactest"
+)
+
+const (
+	sitePath            = "/dna/intent/api/v1/site"
+	executionStatusPath = "/dna/intent/api/v1/dnacaap/management/execution-status/{executionId}"
+)
+
+// siteStore is a fake site hierarchy behind the site endpoints. Sites are
+// created through POST /site and listed, page by page or by name, through
+// GET /site. Creations of the sites named in fail end with a FAILURE
+// execution status and create nothing.
+type siteStore struct {
+	mu    sync.Mutex
+	sites []dnac.GetSiteResponseResponse
+	fail  map[string]bool
+}
+
+func (s *siteStore) add(path string, siteType dnac.SiteType) {
+	name, parentID := path, ""
+	if i := strings.LastIndex(path, "/"); i >= 0 {
+		name = path[i+1:]
+		for _, site := range s.sites {
+			if site.SiteNameHierarchy == path[:i] {
+				parentID = site.ID
+			}
+		}
+	}
+	id := "site-" + strconv.Itoa(len(s.sites)+1)
+	if parentID == "" {
+		parentID = id // Global is its own parent
+	}
+	s.sites = append(s.sites, dnac.GetSiteResponseResponse{
+		ID:                id,
+		Name:              name,
+		ParentID:          parentID,
+		SiteNameHierarchy: path,
+		AdditionalInfo: []dnac.GetSiteResponseResponseAdditionalInfo{
+			{Namespace: "Location", Attributes: dnac.GetSiteResponseResponseAdditionalInfoAttributes{Type: string(siteType)}},
+		},
+	})
+}
+
+func siteServer(t *testing.T) (*dnactest.Server, *dnac.Client, *siteStore) {
+	t.Helper()
+	srv, client := taskServer(t)
+	store := &siteStore{fail: map[string]bool{}}
+	store.add("Global", dnac.SiteArea)
+	store.add("Global/US", dnac.SiteArea)
+	store.add("Global/US/SJC", dnac.SiteBuilding)
+	store.add("Global/US/SJC/Floor-1", dnac.SiteFloor)
+
+	srv.HandleFunc(http.MethodGet, sitePath, func(w http.ResponseWriter, r *http.Request) {
+		store.mu.Lock()
+		defer store.mu.Unlock()
+		query := r.URL.Query()
+		page := []dnac.GetSiteResponseResponse{}
+		if name := query.Get("name"); name != "" {
+			for _, site := range store.sites {
+				if site.SiteNameHierarchy == name {
+					page = append(page, site)
+				}
+			}
+		} else {
+			offset, _ := strconv.Atoi(query.Get("offset"))
+			limit, _ := strconv.Atoi(query.Get("limit"))
+			for i := offset - 1; i >= 0 && i < len(store.sites) && len(page) < limit; i++ {
+				page = append(page, store.sites[i])
+			}
+		}
+		dnactest.WriteJSON(w, http.StatusOK, map[string]interface{}{"response": page})
+	})
+	srv.HandleFunc(http.MethodPost, sitePath, func(w http.ResponseWriter, r *http.Request) {
+		store.mu.Lock()
+		defer store.mu.Unlock()
+		var request dnac.CreateSiteRequest
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			dnactest.WriteJSON(w, http.StatusBadRequest, map[string]string{"message": err.Error()})
+			return
+		}
+		var name, parentName string
+		switch {
+		case request.Site.Area != nil:
+			name, parentName = request.Site.Area.Name, request.Site.Area.ParentName
+		case request.Site.Building != nil:
+			name, parentName = request.Site.Building.Name, request.Site.Building.ParentName
+		case request.Site.Floor != nil:
+			name, parentName = request.Site.Floor.Name, request.Site.Floor.ParentName
+		}
+		status := "SUCCESS"
+		if store.fail[name] {
+			status = "FAILURE"
+		} else {
+			store.add(parentName+"/"+name, dnac.SiteType(request.Type))
+		}
+		id := status + "-" + name
+		dnactest.WriteJSON(w, http.StatusAccepted, map[string]string{
+			"executionId":        id,
+			"executionStatusUrl": "/dna/intent/api/v1/dnacaap/management/execution-status/" + id,
+			"message":            "The request has been accepted for execution",
+		})
+	})
+	srv.HandleFunc(http.MethodGet, executionStatusPath, func(w http.ResponseWriter, r *http.Request) {
+		id := dnactest.PathParam(r, "executionId")
+		execution := map[string]string{"bapiExecutionId": id, "bapiName": "Create Site", "status": strings.SplitN(id, "-", 2)[0]}
+		if execution["status"] == "FAILURE" {
+			execution["bapiError"] = "site already exists"
+		}
+		dnactest.WriteJSON(w, http.StatusOK, execution)
+	})
+	return srv, client, store
+}
+
+func loadSiteTree(t *testing.T, client *dnac.Client) *dnac.SiteTree {
+	t.Helper()
+	tree, err := client.Sites.LoadSiteTree(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tree
+}
+
+func TestLoadSiteTree(t *testing.T) {
+	_, client, _ := siteServer(t)
+	tree := loadSiteTree(t, client)
+
+	if tree.Root.Path() != "Global" || tree.Root.Parent != nil {
+		t.Fatalf("got root %+v, want Global without a parent", tree.Root)
+	}
+	floor, ok := tree.ByPath("/Global/US/SJC/Floor-1/")
+	if !ok {
+		t.Fatal("Global/US/SJC/Floor-1 not found")
+	}
+	if floor.Type != dnac.SiteFloor || floor.Name() != "Floor-1" {
+		t.Errorf("got %s %s, want floor Floor-1", floor.Type, floor.Name())
+	}
+	var ancestors []string
+	for _, ancestor := range floor.Ancestors() {
+		ancestors = append(ancestors, fmt.Sprintf("%s %s", ancestor.Type, ancestor.Path()))
+	}
+	if got, want := strings.Join(ancestors, ", "), "building Global/US/SJC, area Global/US, area Global"; got != want {
+		t.Errorf("got ancestors %s, want %s", got, want)
+	}
+	if node, ok := tree.ByID(floor.ID()); !ok || node != floor {
+		t.Errorf("got %+v by ID, want the floor", node)
+	}
+	if _, ok := tree.ByPath("Global/US/NYC"); ok {
+		t.Error("found Global/US/NYC, want nothing")
+	}
+	if _, ok := tree.ByID("unknown"); ok {
+		t.Error("found site unknown, want nothing")
+	}
+
+	var paths []string
+	if err := tree.Walk(func(node *dnac.SiteNode) error {
+		paths = append(paths, node.Path())
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := strings.Join(paths, " "), "Global Global/US Global/US/SJC Global/US/SJC/Floor-1"; got != want {
+		t.Errorf("walked %s, want %s", got, want)
+	}
+}
+
+func TestLoadSiteTreeWithoutGlobal(t *testing.T) {
+	srv, client := taskServer(t)
+	srv.RespondSequence(http.MethodGet, sitePath,
+		dnactest.Response{Body: `{"response": [{"id": "s1", "name": "US", "siteNameHierarchy": "US"}]}`},
+		dnactest.Response{Body: `{"response": []}`},
+	)
+	if _, err := client.Sites.LoadSiteTree(context.Background()); err == nil || !strings.Contains(err.Error(), "Global not found") {
+		t.Errorf("got %v, want an error for the missing root", err)
+	}
+}
+
+func TestEnsurePathCreatesMissingSites(t *testing.T) {
+	srv, client, _ := siteServer(t)
+	tree := loadSiteTree(t, client)
+	latitude := 40.75
+
+	node, err := tree.EnsurePath(context.Background(), []dnac.SitePathElement{
+		{Name: "Global", Type: dnac.SiteArea},
+		{Name: "US", Type: dnac.SiteArea},
+		{Name: "NYC", Type: dnac.SiteBuilding, Building: &dnac.CreateSiteRequestSiteBuilding{Name: "ignored", Address: "5th Avenue", Latitude: &latitude}},
+		{Name: "Floor-3", Type: dnac.SiteFloor},
+	}, fastPolls)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if node.Path() != "Global/US/NYC/Floor-3" || node.Type != dnac.SiteFloor {
+		t.Errorf("got %s %s, want floor Global/US/NYC/Floor-3", node.Type, node.Path())
+	}
+	if found, ok := tree.ByPath("Global/US/NYC/Floor-3"); !ok || found != node {
+		t.Error("the new floor was not added to the tree")
+	}
+	if building, ok := tree.ByPath("Global/US/NYC"); !ok || node.Parent != building || building.Parent != tree.Root.Child("US") {
+		t.Error("the new sites are not linked to their parents")
+	}
+
+	requests := srv.RequestsTo(http.MethodPost, sitePath)
+	if len(requests) != 2 {
+		t.Fatalf("got %d creations, want the building and the floor", len(requests))
+	}
+	want := []string{
+		`{"site":{"building":{"address":"5th Avenue","latitude":40.75,"name":"NYC","parentName":"Global/US"}},"type":"building"}`,
+		`{"site":{"floor":{"name":"Floor-3","parentName":"Global/US/NYC"}},"type":"floor"}`,
+	}
+	for i, r := range requests {
+		if string(r.Body) != want[i] {
+			t.Errorf("creation %d sent %s, want %s", i, r.Body, want[i])
+		}
+	}
+	srv.AssertCallCount(t, http.MethodGet, executionStatusPath, 2)
+}
+
+func TestEnsurePathExistingSites(t *testing.T) {
+	srv, client, _ := siteServer(t)
+	tree := loadSiteTree(t, client)
+
+	node, err := tree.EnsurePath(context.Background(), []dnac.SitePathElement{
+		{Name: "US", Type: dnac.SiteArea},
+		{Name: "SJC"}, // an empty type matches any site
+		{Name: "Floor-1", Type: dnac.SiteFloor},
+	}, fastPolls)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if floor, _ := tree.ByPath("Global/US/SJC/Floor-1"); node != floor {
+		t.Errorf("got %s, want the existing floor", node.Path())
+	}
+	srv.AssertNotCalled(t, http.MethodPost, sitePath)
+}
+
+func TestEnsurePathChecksTypesFirst(t *testing.T) {
+	tests := []struct {
+		name string
+		path []dnac.SitePathElement
+		err  string
+	}{
+		{
+			"existing site of another type",
+			[]dnac.SitePathElement{{Name: "US", Type: dnac.SiteArea}, {Name: "SJC", Type: dnac.SiteArea}, {Name: "Floor-2", Type: dnac.SiteFloor}},
+			"Global/US/SJC is a building, not a area",
+		},
+		{
+			"floor under an area",
+			[]dnac.SitePathElement{{Name: "US", Type: dnac.SiteArea}, {Name: "Austin", Type: dnac.SiteArea}, {Name: "Floor-1", Type: dnac.SiteFloor}},
+			"cannot create floor \"Floor-1\" under area Global/US/Austin",
+		},
+		{
+			"building under a building",
+			[]dnac.SitePathElement{{Name: "US", Type: dnac.SiteArea}, {Name: "SJC", Type: dnac.SiteBuilding}, {Name: "Annex", Type: dnac.SiteBuilding}},
+			"cannot create building \"Annex\" under building Global/US/SJC",
+		},
+		{
+			"missing type",
+			[]dnac.SitePathElement{{Name: "US", Type: dnac.SiteArea}, {Name: "Austin"}},
+			"cannot create  \"Austin\" under area Global/US",
+		},
+		{
+			"invalid name",
+			[]dnac.SitePathElement{{Name: "US", Type: dnac.SiteArea}, {Name: "Austin/TX", Type: dnac.SiteArea}},
+			"invalid site name \"Austin/TX\"",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, client, _ := siteServer(t)
+			tree := loadSiteTree(t, client)
+
+			_, err := tree.EnsurePath(context.Background(), tt.path, fastPolls)
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("got %v, want an error containing %q", err, tt.err)
+			}
+			srv.AssertNotCalled(t, http.MethodPost, sitePath)
+		})
+	}
+}
+
+func TestEnsurePathReportsFailedCreation(t *testing.T) {
+	srv, client, store := siteServer(t)
+	store.fail["NYC"] = true
+	tree := loadSiteTree(t, client)
+
+	_, err := tree.EnsurePath(context.Background(), []dnac.SitePathElement{
+		{Name: "US", Type: dnac.SiteArea},
+		{Name: "NYC", Type: dnac.SiteBuilding},
+		{Name: "Floor-1", Type: dnac.SiteFloor},
+	}, fastPolls)
+	var execErr *dnac.ExecutionError
+	if !errors.As(err, &execErr) {
+		t.Fatalf("got %v, want an *ExecutionError", err)
+	}
+	if execErr.ExecutionID != "FAILURE-NYC" || execErr.Reason != "site already exists" {
+		t.Errorf("got %+v, want the failed execution of NYC", execErr)
+	}
+	srv.AssertCallCount(t, http.MethodPost, sitePath, 1)
+	if _, ok := tree.ByPath("Global/US/NYC"); ok {
+		t.Error("the failed building was added to the tree")
+	}
+}
@@ -1,6 +1,7 @@
 package dnac
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -23,15 +24,15 @@ type AssignDeviceToSiteRequestDevice struct {
 
 // CreateSiteRequest is the createSiteRequest definition
 type CreateSiteRequest struct {
-	Site CreateSiteRequestSite `json:"site,omitempty"` //
-	Type string                `json:"type,omitempty"` //
+	Site *CreateSiteRequestSite `json:"site,omitempty"` //
+	Type string                 `json:"type,omitempty"` //
 }
 
 // CreateSiteRequestSite is the createSiteRequestSite definition
 type CreateSiteRequestSite struct {
-	Area     CreateSiteRequestSiteArea     `json:"area,omitempty"`     //
-	Building CreateSiteRequestSiteBuilding `json:"building,omitempty"` //
-	Floor    CreateSiteRequestSiteFloor    `json:"floor,omitempty"`    //
+	Area     *CreateSiteRequestSiteArea     `json:"area,omitempty"`     //
+	Building *CreateSiteRequestSiteBuilding `json:"building,omitempty"` //
+	Floor    *CreateSiteRequestSiteFloor    `json:"floor,omitempty"`    //
 }
 
 // CreateSiteRequestSiteArea is the createSiteRequestSiteArea definition
@@ -42,34 +43,34 @@ type CreateSiteRequestSiteArea struct {
 
 // CreateSiteRequestSiteBuilding is the createSiteRequestSiteBuilding definition
 type CreateSiteRequestSiteBuilding struct {
-	Address    string  `json:"address,omitempty"`    //
-	Latitude   float64 `json:"latitude,omitempty"`   //
-	Longitude  float64 `json:"longitude,omitempty"`  //
-	Name       string  `json:"name,omitempty"`       //
-	ParentName string  `json:"parentName,omitempty"` //
+	Address    string   `json:"address,omitempty"`    //
+	Latitude   *float64 `json:"latitude,omitempty"`   //
+	Longitude  *float64 `json:"longitude,omitempty"`  //
+	Name       string   `json:"name,omitempty"`       //
+	ParentName string   `json:"parentName,omitempty"` //
 }
 
 // CreateSiteRequestSiteFloor is the createSiteRequestSiteFloor definition
 type CreateSiteRequestSiteFloor struct {
-	Height     float64 `json:"height,omitempty"`     //
-	Length     float64 `json:"length,omitempty"`     //
-	Name       string  `json:"name,omitempty"`       //
-	ParentName string  `json:"parentName,omitempty"` //
-	RfModel    string  `json:"rfModel,omitempty"`    //
-	Width      float64 `json:"width,omitempty"`      //
+	Height     *float64 `json:"height,omitempty"`     //
+	Length     *float64 `json:"length,omitempty"`     //
+	Name       string   `json:"name,omitempty"`       //
+	ParentName string   `json:"parentName,omitempty"` //
+	RfModel    string   `json:"rfModel,omitempty"`    //
+	Width      *float64 `json:"width,omitempty"`      //
 }
 
 // UpdateSiteRequest is the updateSiteRequest definition
 type UpdateSiteRequest struct {
-	Site UpdateSiteRequestSite `json:"site,omitempty"` //
-	Type string                `json:"type,omitempty"` //
+	Site *UpdateSiteRequestSite `json:"site,omitempty"` //
+	Type string                 `json:"type,omitempty"` //
 }
 
 // UpdateSiteRequestSite is the updateSiteRequestSite definition
 type UpdateSiteRequestSite struct {
-	Area     UpdateSiteRequestSiteArea     `json:"area,omitempty"`     //
-	Building UpdateSiteRequestSiteBuilding `json:"building,omitempty"` //
-	Floor    UpdateSiteRequestSiteFloor    `json:"floor,omitempty"`    //
+	Area     *UpdateSiteRequestSiteArea     `json:"area,omitempty"`     //
+	Building *UpdateSiteRequestSiteBuilding `json:"building,omitempty"` //
+	Floor    *UpdateSiteRequestSiteFloor    `json:"floor,omitempty"`    //
 }
 
 // UpdateSiteRequestSiteArea is the updateSiteRequestSiteArea definition
@@ -80,20 +81,20 @@ type UpdateSiteRequestSiteArea struct {
 
 // UpdateSiteRequestSiteBuilding is the updateSiteRequestSiteBuilding definition
 type UpdateSiteRequestSiteBuilding struct {
-	Address    string  `json:"address,omitempty"`    //
-	Latitude   float64 `json:"latitude,omitempty"`   //
-	Longitude  float64 `json:"longitude,omitempty"`  //
-	Name       string  `json:"name,omitempty"`       //
-	ParentName string  `json:"parentName,omitempty"` //
+	Address    string   `json:"address,omitempty"`    //
+	Latitude   *float64 `json:"latitude,omitempty"`   //
+	Longitude  *float64 `json:"longitude,omitempty"`  //
+	Name       string   `json:"name,omitempty"`       //
+	ParentName string   `json:"parentName,omitempty"` //
 }
 
 // UpdateSiteRequestSiteFloor is the updateSiteRequestSiteFloor definition
 type UpdateSiteRequestSiteFloor struct {
-	Height  float64 `json:"height,omitempty"`  //
-	Length  float64 `json:"length,omitempty"`  //
-	Name    string  `json:"name,omitempty"`    //
-	RfModel string  `json:"rfModel,omitempty"` //
-	Width   float64 `json:"width,omitempty"`   //
+	Height  *float64 `json:"height,omitempty"`  //
+	Length  *float64 `json:"length,omitempty"`  //
+	Name    string   `json:"name,omitempty"`    //
+	RfModel string   `json:"rfModel,omitempty"` //
+	Width   *float64 `json:"width,omitempty"`   //
 }
 
 // AssignDeviceToSiteResponse is the assignDeviceToSiteResponse definition
@@ -310,11 +311,17 @@ type UpdateSiteResponseResponseOperationIDList []string
 @param siteID Site id to which site the device to assign
 */
 func (s *SitesService) AssignDeviceToSite(siteID string, assignDeviceToSiteRequest *AssignDeviceToSiteRequest) (*AssignDeviceToSiteResponse, *resty.Response, error) {
+	return s.AssignDeviceToSiteWithContext(context.Background(), siteID, assignDeviceToSiteRequest)
+}
+
+// AssignDeviceToSiteWithContext is like AssignDeviceToSite but sends the request with ctx for cancellation and deadlines.
+func (s *SitesService) AssignDeviceToSiteWithContext(ctx context.Context, siteID string, assignDeviceToSiteRequest *AssignDeviceToSiteRequest) (*AssignDeviceToSiteResponse, *resty.Response, error) {
 
 	path := "/dna/system/api/v1/site/{siteId}/device"
 	path = strings.Replace(path, "{"+"siteId"+"}", fmt.Sprintf("%v", siteID), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(assignDeviceToSiteRequest).
 		SetResult(&AssignDeviceToSiteResponse{}).
 		SetError(&Error{}).
@@ -325,7 +332,7 @@ func (s *SitesService) AssignDeviceToSite(siteID string, assignDeviceToSiteReque
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation assignDeviceToSite")
+		return nil, response, newAPIError("assignDeviceToSite", response)
 	}
 
 	result := response.Result().(*AssignDeviceToSiteResponse)
@@ -339,10 +346,16 @@ func (s *SitesService) AssignDeviceToSite(siteID string, assignDeviceToSiteReque
 @param __persistbapioutput Persist bapi sync response
 */
 func (s *SitesService) CreateSite(createSiteRequest *CreateSiteRequest) (*CreateSiteResponse, *resty.Response, error) {
+	return s.CreateSiteWithContext(context.Background(), createSiteRequest)
+}
+
+// CreateSiteWithContext is like CreateSite but sends the request with ctx for cancellation and deadlines.
+func (s *SitesService) CreateSiteWithContext(ctx context.Context, createSiteRequest *CreateSiteRequest) (*CreateSiteResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/site"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(createSiteRequest).
 		SetResult(&CreateSiteResponse{}).
 		SetError(&Error{}).
@@ -353,7 +366,7 @@ func (s *SitesService) CreateSite(createSiteRequest *CreateSiteRequest) (*Create
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation createSite")
+		return nil, response, newAPIError("createSite", response)
 	}
 
 	result := response.Result().(*CreateSiteResponse)
@@ -365,11 +378,17 @@ func (s *SitesService) CreateSite(createSiteRequest *CreateSiteRequest) (*Create
 @param siteID Site id to which site details to be deleted.
 */
 func (s *SitesService) DeleteSite(siteID string) (*DeleteSiteResponse, *resty.Response, error) {
+	return s.DeleteSiteWithContext(context.Background(), siteID)
+}
+
+// DeleteSiteWithContext is like DeleteSite but sends the request with ctx for cancellation and deadlines.
+func (s *SitesService) DeleteSiteWithContext(ctx context.Context, siteID string) (*DeleteSiteResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/site/{siteId}"
 	path = strings.Replace(path, "{"+"siteId"+"}", fmt.Sprintf("%v", siteID), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&DeleteSiteResponse{}).
 		SetError(&Error{}).
 		Delete(path)
@@ -379,7 +398,7 @@ func (s *SitesService) DeleteSite(siteID string) (*DeleteSiteResponse, *resty.Re
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation deleteSite")
+		return nil, response, newAPIError("deleteSite", response)
 	}
 
 	result := response.Result().(*DeleteSiteResponse)
@@ -403,6 +422,11 @@ type GetMembershipQueryParams struct {
 @param serialNumber Device serial number
 */
 func (s *SitesService) GetMembership(siteID string, getMembershipQueryParams *GetMembershipQueryParams) (*GetMembershipResponse, *resty.Response, error) {
+	return s.GetMembershipWithContext(context.Background(), siteID, getMembershipQueryParams)
+}
+
+// GetMembershipWithContext is like GetMembership but sends the request with ctx for cancellation and deadlines.
+func (s *SitesService) GetMembershipWithContext(ctx context.Context, siteID string, getMembershipQueryParams *GetMembershipQueryParams) (*GetMembershipResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/membership/{siteId}"
 	path = strings.Replace(path, "{"+"siteId"+"}", fmt.Sprintf("%v", siteID), -1)
@@ -410,6 +434,7 @@ func (s *SitesService) GetMembership(siteID string, getMembershipQueryParams *Ge
 	queryString, _ := query.Values(getMembershipQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetMembershipResponse{}).
 		SetError(&Error{}).
@@ -420,7 +445,7 @@ func (s *SitesService) GetMembership(siteID string, getMembershipQueryParams *Ge
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getMembership")
+		return nil, response, newAPIError("getMembership", response)
 	}
 
 	result := response.Result().(*GetMembershipResponse)
@@ -445,12 +470,18 @@ type GetSiteQueryParams struct {
 @param limit Number of sites to be retrieved
 */
 func (s *SitesService) GetSite(getSiteQueryParams *GetSiteQueryParams) (*GetSiteResponse, *resty.Response, error) {
+	return s.GetSiteWithContext(context.Background(), getSiteQueryParams)
+}
+
+// GetSiteWithContext is like GetSite but sends the request with ctx for cancellation and deadlines.
+func (s *SitesService) GetSiteWithContext(ctx context.Context, getSiteQueryParams *GetSiteQueryParams) (*GetSiteResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/site"
 
 	queryString, _ := query.Values(getSiteQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetSiteResponse{}).
 		SetError(&Error{}).
@@ -461,7 +492,7 @@ func (s *SitesService) GetSite(getSiteQueryParams *GetSiteQueryParams) (*GetSite
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getSite")
+		return nil, response, newAPIError("getSite", response)
 	}
 
 	result := response.Result().(*GetSiteResponse)
@@ -478,12 +509,18 @@ type GetSiteCountQueryParams struct {
 @param siteID Site id to retrieve site count.
 */
 func (s *SitesService) GetSiteCount(getSiteCountQueryParams *GetSiteCountQueryParams) (*GetSiteCountResponse, *resty.Response, error) {
+	return s.GetSiteCountWithContext(context.Background(), getSiteCountQueryParams)
+}
+
+// GetSiteCountWithContext is like GetSiteCount but sends the request with ctx for cancellation and deadlines.
+func (s *SitesService) GetSiteCountWithContext(ctx context.Context, getSiteCountQueryParams *GetSiteCountQueryParams) (*GetSiteCountResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/site/count"
 
 	queryString, _ := query.Values(getSiteCountQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetSiteCountResponse{}).
 		SetError(&Error{}).
@@ -494,7 +531,7 @@ func (s *SitesService) GetSiteCount(getSiteCountQueryParams *GetSiteCountQueryPa
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getSiteCount")
+		return nil, response, newAPIError("getSiteCount", response)
 	}
 
 	result := response.Result().(*GetSiteCountResponse)
@@ -511,12 +548,18 @@ type GetSiteHealthQueryParams struct {
 @param timestamp Epoch time(in milliseconds) when the Site Hierarchy data is required
 */
 func (s *SitesService) GetSiteHealth(getSiteHealthQueryParams *GetSiteHealthQueryParams) (*GetSiteHealthResponse, *resty.Response, error) {
+	return s.GetSiteHealthWithContext(context.Background(), getSiteHealthQueryParams)
+}
+
+// GetSiteHealthWithContext is like GetSiteHealth but sends the request with ctx for cancellation and deadlines.
+func (s *SitesService) GetSiteHealthWithContext(ctx context.Context, getSiteHealthQueryParams *GetSiteHealthQueryParams) (*GetSiteHealthResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/site-health"
 
 	queryString, _ := query.Values(getSiteHealthQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetSiteHealthResponse{}).
 		SetError(&Error{}).
@@ -527,7 +570,7 @@ func (s *SitesService) GetSiteHealth(getSiteHealthQueryParams *GetSiteHealthQuer
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getSiteHealth")
+		return nil, response, newAPIError("getSiteHealth", response)
 	}
 
 	result := response.Result().(*GetSiteHealthResponse)
@@ -542,11 +585,17 @@ func (s *SitesService) GetSiteHealth(getSiteHealthQueryParams *GetSiteHealthQuer
 @param siteID Site id to which site details to be updated.
 */
 func (s *SitesService) UpdateSite(siteID string, updateSiteRequest *UpdateSiteRequest) (*UpdateSiteResponse, *resty.Response, error) {
+	return s.UpdateSiteWithContext(context.Background(), siteID, updateSiteRequest)
+}
+
+// UpdateSiteWithContext is like UpdateSite but sends the request with ctx for cancellation and deadlines.
+func (s *SitesService) UpdateSiteWithContext(ctx context.Context, siteID string, updateSiteRequest *UpdateSiteRequest) (*UpdateSiteResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/site/{siteId}"
 	path = strings.Replace(path, "{"+"siteId"+"}", fmt.Sprintf("%v", siteID), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(updateSiteRequest).
 		SetResult(&UpdateSiteResponse{}).
 		SetError(&Error{}).
@@ -557,7 +606,7 @@ func (s *SitesService) UpdateSite(siteID string, updateSiteRequest *UpdateSiteRe
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation updateSite")
+		return nil, response, newAPIError("updateSite", response)
 	}
 
 	result := response.Result().(*UpdateSiteResponse)
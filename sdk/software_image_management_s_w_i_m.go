@@ -1,7 +1,7 @@
 package dnac
 
 import (
-	"fmt"
+	"context"
 
 	"github.com/go-resty/resty/v2"
 	"github.com/google/go-querystring/query"
@@ -15,16 +15,16 @@ type ImportSoftwareImageViaURLRequest struct {
 	ApplicationType string `json:"applicationType,omitempty"` //
 	ImageFamily     string `json:"imageFamily,omitempty"`     //
 	SourceURL       string `json:"sourceURL,omitempty"`       //
-	ThirdParty      bool   `json:"thirdParty,omitempty"`      //
+	ThirdParty      *bool  `json:"thirdParty,omitempty"`      //
 	Vendor          string `json:"vendor,omitempty"`          //
 }
 
 // TriggerSoftwareImageActivationRequest is the triggerSoftwareImageActivationRequest definition
 type TriggerSoftwareImageActivationRequest struct {
-	ActivateLowerImageVersion bool     `json:"activateLowerImageVersion,omitempty"` //
+	ActivateLowerImageVersion *bool    `json:"activateLowerImageVersion,omitempty"` //
 	DeviceUpgradeMode         string   `json:"deviceUpgradeMode,omitempty"`         //
 	DeviceUUID                string   `json:"deviceUuid,omitempty"`                //
-	DistributeIfNeeded        bool     `json:"distributeIfNeeded,omitempty"`        //
+	DistributeIfNeeded        *bool    `json:"distributeIfNeeded,omitempty"`        //
 	ImageUUIDList             []string `json:"imageUuidList,omitempty"`             //
 	SmuImageUUIDList          []string `json:"smuImageUuidList,omitempty"`          //
 }
@@ -189,12 +189,18 @@ type GetSoftwareImageDetailsQueryParams struct {
 @param offset offset
 */
 func (s *SoftwareImageManagementSWIMService) GetSoftwareImageDetails(getSoftwareImageDetailsQueryParams *GetSoftwareImageDetailsQueryParams) (*GetSoftwareImageDetailsResponse, *resty.Response, error) {
+	return s.GetSoftwareImageDetailsWithContext(context.Background(), getSoftwareImageDetailsQueryParams)
+}
+
+// GetSoftwareImageDetailsWithContext is like GetSoftwareImageDetails but sends the request with ctx for cancellation and deadlines.
+func (s *SoftwareImageManagementSWIMService) GetSoftwareImageDetailsWithContext(ctx context.Context, getSoftwareImageDetailsQueryParams *GetSoftwareImageDetailsQueryParams) (*GetSoftwareImageDetailsResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/image/importation"
 
 	queryString, _ := query.Values(getSoftwareImageDetailsQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetSoftwareImageDetailsResponse{}).
 		SetError(&Error{}).
@@ -205,7 +211,7 @@ func (s *SoftwareImageManagementSWIMService) GetSoftwareImageDetails(getSoftware
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getSoftwareImageDetails")
+		return nil, response, newAPIError("getSoftwareImageDetails", response)
 	}
 
 	result := response.Result().(*GetSoftwareImageDetailsResponse)
@@ -227,14 +233,26 @@ type ImportLocalSoftwareImageQueryParams struct {
 @param thirdPartyVendor Third Party Vendor
 @param thirdPartyImageFamily Third Party image family
 @param thirdPartyApplicationType Third Party Application Type
+
+Deprecated: ImportLocalSoftwareImage sends no image, so DNA Center rejects it. Use UploadLocalSoftwareImage or UploadLocalSoftwareImageFile instead.
 */
 func (s *SoftwareImageManagementSWIMService) ImportLocalSoftwareImage(importLocalSoftwareImageQueryParams *ImportLocalSoftwareImageQueryParams) (*ImportLocalSoftwareImageResponse, *resty.Response, error) {
+	return s.ImportLocalSoftwareImageWithContext(context.Background(), importLocalSoftwareImageQueryParams)
+}
+
+// ImportLocalSoftwareImageWithContext is like ImportLocalSoftwareImage but sends the request with ctx for cancellation and deadlines.
+//
+// Deprecated: ImportLocalSoftwareImageWithContext sends no image, so DNA
+// Center rejects it. Use UploadLocalSoftwareImage or
+// UploadLocalSoftwareImageFile instead.
+func (s *SoftwareImageManagementSWIMService) ImportLocalSoftwareImageWithContext(ctx context.Context, importLocalSoftwareImageQueryParams *ImportLocalSoftwareImageQueryParams) (*ImportLocalSoftwareImageResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/image/importation/source/file"
 
 	queryString, _ := query.Values(importLocalSoftwareImageQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&ImportLocalSoftwareImageResponse{}).
 		SetError(&Error{}).
@@ -245,7 +263,7 @@ func (s *SoftwareImageManagementSWIMService) ImportLocalSoftwareImage(importLoca
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation importLocalSoftwareImage")
+		return nil, response, newAPIError("importLocalSoftwareImage", response)
 	}
 
 	result := response.Result().(*ImportLocalSoftwareImageResponse)
@@ -266,12 +284,18 @@ type ImportSoftwareImageViaURLQueryParams struct {
 @param scheduleOrigin Originator of this call (Optional)
 */
 func (s *SoftwareImageManagementSWIMService) ImportSoftwareImageViaURL(importSoftwareImageViaURLQueryParams *ImportSoftwareImageViaURLQueryParams, importSoftwareImageViaURLRequest *[]ImportSoftwareImageViaURLRequest) (*ImportSoftwareImageViaURLResponse, *resty.Response, error) {
+	return s.ImportSoftwareImageViaURLWithContext(context.Background(), importSoftwareImageViaURLQueryParams, importSoftwareImageViaURLRequest)
+}
+
+// ImportSoftwareImageViaURLWithContext is like ImportSoftwareImageViaURL but sends the request with ctx for cancellation and deadlines.
+func (s *SoftwareImageManagementSWIMService) ImportSoftwareImageViaURLWithContext(ctx context.Context, importSoftwareImageViaURLQueryParams *ImportSoftwareImageViaURLQueryParams, importSoftwareImageViaURLRequest *[]ImportSoftwareImageViaURLRequest) (*ImportSoftwareImageViaURLResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/image/importation/source/url"
 
 	queryString, _ := query.Values(importSoftwareImageViaURLQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetBody(importSoftwareImageViaURLRequest).
 		SetResult(&ImportSoftwareImageViaURLResponse{}).
@@ -283,7 +307,7 @@ func (s *SoftwareImageManagementSWIMService) ImportSoftwareImageViaURL(importSof
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation importSoftwareImageViaURL")
+		return nil, response, newAPIError("importSoftwareImageViaURL", response)
 	}
 
 	result := response.Result().(*ImportSoftwareImageViaURLResponse)
@@ -302,12 +326,18 @@ type TriggerSoftwareImageActivationQueryParams struct {
 @param scheduleValidate scheduleValidate, validates data before schedule (Optional)
 */
 func (s *SoftwareImageManagementSWIMService) TriggerSoftwareImageActivation(triggerSoftwareImageActivationQueryParams *TriggerSoftwareImageActivationQueryParams, triggerSoftwareImageActivationRequest *[]TriggerSoftwareImageActivationRequest) (*TriggerSoftwareImageActivationResponse, *resty.Response, error) {
+	return s.TriggerSoftwareImageActivationWithContext(context.Background(), triggerSoftwareImageActivationQueryParams, triggerSoftwareImageActivationRequest)
+}
+
+// TriggerSoftwareImageActivationWithContext is like TriggerSoftwareImageActivation but sends the request with ctx for cancellation and deadlines.
+func (s *SoftwareImageManagementSWIMService) TriggerSoftwareImageActivationWithContext(ctx context.Context, triggerSoftwareImageActivationQueryParams *TriggerSoftwareImageActivationQueryParams, triggerSoftwareImageActivationRequest *[]TriggerSoftwareImageActivationRequest) (*TriggerSoftwareImageActivationResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/image/activation/device"
 
 	queryString, _ := query.Values(triggerSoftwareImageActivationQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetBody(triggerSoftwareImageActivationRequest).
 		SetResult(&TriggerSoftwareImageActivationResponse{}).
@@ -319,7 +349,7 @@ func (s *SoftwareImageManagementSWIMService) TriggerSoftwareImageActivation(trig
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation triggerSoftwareImageActivation")
+		return nil, response, newAPIError("triggerSoftwareImageActivation", response)
 	}
 
 	result := response.Result().(*TriggerSoftwareImageActivationResponse)
@@ -330,10 +360,16 @@ func (s *SoftwareImageManagementSWIMService) TriggerSoftwareImageActivation(trig
 /* Distributes a software image on a given device. Software image must be imported successfully into DNA Center before it can be distributed
  */
 func (s *SoftwareImageManagementSWIMService) TriggerSoftwareImageDistribution(triggerSoftwareImageDistributionRequest *[]TriggerSoftwareImageDistributionRequest) (*TriggerSoftwareImageDistributionResponse, *resty.Response, error) {
+	return s.TriggerSoftwareImageDistributionWithContext(context.Background(), triggerSoftwareImageDistributionRequest)
+}
+
+// TriggerSoftwareImageDistributionWithContext is like TriggerSoftwareImageDistribution but sends the request with ctx for cancellation and deadlines.
+func (s *SoftwareImageManagementSWIMService) TriggerSoftwareImageDistributionWithContext(ctx context.Context, triggerSoftwareImageDistributionRequest *[]TriggerSoftwareImageDistributionRequest) (*TriggerSoftwareImageDistributionResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/image/distribution"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(triggerSoftwareImageDistributionRequest).
 		SetResult(&TriggerSoftwareImageDistributionResponse{}).
 		SetError(&Error{}).
@@ -344,7 +380,7 @@ func (s *SoftwareImageManagementSWIMService) TriggerSoftwareImageDistribution(tr
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation triggerSoftwareImageDistribution")
+		return nil, response, newAPIError("triggerSoftwareImageDistribution", response)
 	}
 
 	result := response.Result().(*TriggerSoftwareImageDistributionResponse)
@@ -0,0 +1,102 @@
+package dnac
+
+import (
+	"context"
+	"io"
+	"mime/multipart"
+	"os"
+	"path/filepath"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/google/go-querystring/query"
+)
+
+// UploadProgressFunc is called while an image is uploaded with the number of
+// bytes sent so far and the total size, or -1 if the size is unknown. It runs
+// on the goroutine that writes the multipart body, not on the goroutine of
+// the caller of the upload, so it must be safe to call concurrently with the
+// code of the caller and should return quickly.
+type UploadProgressFunc func(sent int64, total int64)
+
+// progressReader reports the bytes read through it to a UploadProgressFunc.
+type progressReader struct {
+	r        io.Reader
+	sent     int64
+	total    int64
+	progress UploadProgressFunc
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.r.Read(b)
+	if n > 0 {
+		p.sent += int64(n)
+		p.progress(p.sent, p.total)
+	}
+	return n, err
+}
+
+// UploadLocalSoftwareImage streams the software image read from image to DNA
+// Center as multipart/form-data under the given file name, without buffering
+// it in memory. size is only used for progress reports and may be -1 if
+// unknown; progress may be nil and is called on the goroutine that writes the
+// request body into a pipe. The returned response holds the task ID of the
+// import. As the image cannot be read twice, the request is never retried.
+func (s *SoftwareImageManagementSWIMService) UploadLocalSoftwareImage(ctx context.Context, fileName string, image io.Reader, size int64, importLocalSoftwareImageQueryParams *ImportLocalSoftwareImageQueryParams, progress UploadProgressFunc) (*ImportLocalSoftwareImageResponse, *resty.Response, error) {
+
+	path := "/dna/intent/api/v1/image/importation/source/file"
+
+	queryString, _ := query.Values(importLocalSoftwareImageQueryParams)
+
+	if progress != nil {
+		image = &progressReader{r: image, total: size, progress: progress}
+	}
+	body, writer := io.Pipe()
+	defer body.Close()
+	form := multipart.NewWriter(writer)
+	go func() {
+		part, err := form.CreateFormFile("file", filepath.Base(fileName))
+		if err == nil {
+			_, err = io.Copy(part, image)
+		}
+		if err == nil {
+			err = form.Close()
+		}
+		writer.CloseWithError(err)
+	}()
+
+	response, err := s.client.R().
+		SetContext(ctx).
+		SetQueryString(queryString.Encode()).
+		SetHeader("Content-Type", form.FormDataContentType()).
+		SetBody(body).
+		SetResult(&ImportLocalSoftwareImageResponse{}).
+		SetError(&Error{}).
+		Post(path)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if response.IsError() {
+		return nil, response, newAPIError("importLocalSoftwareImage", response)
+	}
+
+	result := response.Result().(*ImportLocalSoftwareImageResponse)
+	return result, response, err
+}
+
+// UploadLocalSoftwareImageFile streams the software image stored at filePath
+// to DNA Center, see UploadLocalSoftwareImage. progress may be nil.
+func (s *SoftwareImageManagementSWIMService) UploadLocalSoftwareImageFile(ctx context.Context, filePath string, importLocalSoftwareImageQueryParams *ImportLocalSoftwareImageQueryParams, progress UploadProgressFunc) (*ImportLocalSoftwareImageResponse, *resty.Response, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, nil, err
+	}
+	return s.UploadLocalSoftwareImage(ctx, filepath.Base(filePath), file, info.Size(), importLocalSoftwareImageQueryParams, progress)
+}
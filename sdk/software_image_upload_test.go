@@ -0,0 +1,172 @@
+package dnac_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	dnac "github.com/cisco-en-programmability/dnacenter-go-sdk/sdk"
+	"github.com/cisco-en-programmability/dnacenter-go-sdk/sdk/dnactest"
+)
+
+const imageUploadPath = "/dna/intent/api/v1/image/importation/source/file"
+
+// progressRecorder records the progress reports of an upload, which arrive
+// on the goroutine writing the request body.
+type progressRecorder struct {
+	mu      sync.Mutex
+	reports [][2]int64
+}
+
+func (p *progressRecorder) report(sent int64, total int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.reports = append(p.reports, [2]int64{sent, total})
+}
+
+// uploadedFile returns the name and content of the file part of the last
+// upload request.
+func uploadedFile(t *testing.T, srv *dnactest.Server) (string, []byte) {
+	t.Helper()
+	r := srv.LastRequestTo(http.MethodPost, imageUploadPath)
+	if r == nil {
+		t.Fatal("no upload request")
+	}
+	mediaType, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/form-data" {
+		t.Fatalf("got content type %q, want multipart/form-data", r.Header.Get("Content-Type"))
+	}
+	part, err := multipart.NewReader(bytes.NewReader(r.Body), params["boundary"]).NextPart()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if part.FormName() != "file" {
+		t.Errorf("got form field %q, want file", part.FormName())
+	}
+	content, err := ioutil.ReadAll(part)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return part.FileName(), content
+}
+
+func uploadServer(t *testing.T, opts ...dnac.Option) (*dnactest.Server, *dnac.Client) {
+	t.Helper()
+	srv := dnactest.NewServer()
+	t.Cleanup(srv.Close)
+	srv.RespondJSON(http.MethodPost, imageUploadPath, http.StatusAccepted, map[string]interface{}{"response": map[string]string{"taskId": "t1"}})
+	client, err := srv.Client(opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return srv, client
+}
+
+func TestUploadLocalSoftwareImageStreamsMultipart(t *testing.T) {
+	srv, client := uploadServer(t)
+	image := bytes.Repeat([]byte("0123456789abcdef"), 64<<10) // 1 MiB, written in several chunks
+	var progress progressRecorder
+
+	result, _, err := client.SoftwareImageManagementSWIM.UploadLocalSoftwareImage(context.Background(), "images/cat9k.bin",
+		bytes.NewReader(image), int64(len(image)), &dnac.ImportLocalSoftwareImageQueryParams{IsThirdParty: true, ThirdPartyVendor: "acme"}, progress.report)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Response.TaskID != "t1" {
+		t.Errorf("got task %q, want t1", result.Response.TaskID)
+	}
+
+	name, content := uploadedFile(t, srv)
+	if name != "cat9k.bin" {
+		t.Errorf("got file name %q, want the base name cat9k.bin", name)
+	}
+	if !bytes.Equal(content, image) {
+		t.Errorf("got %d bytes, want the %d bytes of the image", len(content), len(image))
+	}
+	query := srv.LastRequestTo(http.MethodPost, imageUploadPath).Query
+	if query.Get("isThirdParty") != "true" || query.Get("thirdPartyVendor") != "acme" {
+		t.Errorf("got query %v, want the third party params", query)
+	}
+
+	progress.mu.Lock()
+	defer progress.mu.Unlock()
+	if len(progress.reports) < 2 {
+		t.Fatalf("got %d progress reports, want one per chunk", len(progress.reports))
+	}
+	var last int64
+	for _, report := range progress.reports {
+		if report[0] <= last || report[1] != int64(len(image)) {
+			t.Fatalf("got progress %v, want increasing counts out of %d", progress.reports, len(image))
+		}
+		last = report[0]
+	}
+	if last != int64(len(image)) {
+		t.Errorf("last progress report is %d bytes, want %d", last, len(image))
+	}
+}
+
+func TestUploadLocalSoftwareImageFile(t *testing.T) {
+	srv, client := uploadServer(t)
+	path := filepath.Join(t.TempDir(), "c9800.bin")
+	if err := ioutil.WriteFile(path, []byte("image"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	var progress progressRecorder
+
+	if _, _, err := client.SoftwareImageManagementSWIM.UploadLocalSoftwareImageFile(context.Background(), path, nil, progress.report); err != nil {
+		t.Fatal(err)
+	}
+	if name, content := uploadedFile(t, srv); name != "c9800.bin" || string(content) != "image" {
+		t.Errorf("got file %q with %q, want c9800.bin with the image", name, content)
+	}
+	if n := len(progress.reports); n == 0 || progress.reports[n-1] != [2]int64{5, 5} {
+		t.Errorf("got progress %v, want 5 of 5 bytes last", progress.reports)
+	}
+
+	if _, _, err := client.SoftwareImageManagementSWIM.UploadLocalSoftwareImageFile(context.Background(), filepath.Join(t.TempDir(), "missing.bin"), nil, nil); !os.IsNotExist(err) {
+		t.Errorf("got %v for a missing file, want a not exist error", err)
+	}
+}
+
+type brokenReader struct{}
+
+func (brokenReader) Read([]byte) (int, error) {
+	return 0, errors.New("disk read failed")
+}
+
+func TestUploadLocalSoftwareImageReportsReadErrors(t *testing.T) {
+	_, client := uploadServer(t)
+	image := io.MultiReader(strings.NewReader("partial"), brokenReader{})
+
+	_, _, err := client.SoftwareImageManagementSWIM.UploadLocalSoftwareImage(context.Background(), "cat9k.bin", image, -1, nil, nil)
+	if err == nil || !strings.Contains(err.Error(), "disk read failed") {
+		t.Errorf("got %v, want the read error of the image", err)
+	}
+}
+
+func TestUploadLocalSoftwareImageIsNotRetried(t *testing.T) {
+	srv, client := uploadServer(t, dnac.WithRetryPolicy(dnac.RetryPolicy{
+		MaxAttempts: 3,
+		MinBackoff:  time.Millisecond,
+		StatusCodes: []int{http.StatusServiceUnavailable},
+	}))
+	srv.RespondJSON(http.MethodPost, imageUploadPath, http.StatusServiceUnavailable, map[string]string{"message": "busy"})
+
+	_, _, err := client.SoftwareImageManagementSWIM.UploadLocalSoftwareImage(context.Background(), "cat9k.bin", strings.NewReader("image"), 5, nil, nil)
+	var apiErr *dnac.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("got %v, want a 503 error", err)
+	}
+	srv.AssertCallCount(t, http.MethodPost, imageUploadPath, 1)
+}
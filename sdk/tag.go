@@ -1,6 +1,7 @@
 package dnac
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -18,26 +19,26 @@ type CreateTagRequest struct {
 	ID               string                         `json:"id,omitempty"`               //
 	InstanceTenantID string                         `json:"instanceTenantId,omitempty"` //
 	Name             string                         `json:"name,omitempty"`             //
-	SystemTag        bool                           `json:"systemTag,omitempty"`        //
+	SystemTag        *bool                          `json:"systemTag,omitempty"`        //
 }
 
 // CreateTagRequestDynamicRules is the createTagRequestDynamicRules definition
 type CreateTagRequestDynamicRules struct {
-	MemberType string                            `json:"memberType,omitempty"` //
-	Rules      CreateTagRequestDynamicRulesRules `json:"rules,omitempty"`      //
+	MemberType string                             `json:"memberType,omitempty"` //
+	Rules      *CreateTagRequestDynamicRulesRules `json:"rules,omitempty"`      //
 }
 
 // CreateTagRequestDynamicRulesRules is the createTagRequestDynamicRulesRules definition
 type CreateTagRequestDynamicRulesRules struct {
-	Items     []string `json:"items,omitempty"`     //
-	Name      string   `json:"name,omitempty"`      //
-	Operation string   `json:"operation,omitempty"` //
-	Value     string   `json:"value,omitempty"`     //
-	Values    []string `json:"values,omitempty"`    //
+	Items     []CreateTagRequestDynamicRulesRules `json:"items,omitempty"`     //
+	Name      string                              `json:"name,omitempty"`      //
+	Operation string                              `json:"operation,omitempty"` //
+	Value     string                              `json:"value,omitempty"`     //
+	Values    []string                            `json:"values,omitempty"`    //
 }
 
 // CreateTagRequestDynamicRulesRulesItems is the createTagRequestDynamicRulesRulesItems definition
-type CreateTagRequestDynamicRulesRulesItems []string
+type CreateTagRequestDynamicRulesRulesItems []CreateTagRequestDynamicRulesRules
 
 // CreateTagRequestDynamicRulesRulesValues is the createTagRequestDynamicRulesRulesValues definition
 type CreateTagRequestDynamicRulesRulesValues []string
@@ -49,42 +50,47 @@ type UpdateTagRequest struct {
 	ID               string                         `json:"id,omitempty"`               //
 	InstanceTenantID string                         `json:"instanceTenantId,omitempty"` //
 	Name             string                         `json:"name,omitempty"`             //
-	SystemTag        bool                           `json:"systemTag,omitempty"`        //
+	SystemTag        *bool                          `json:"systemTag,omitempty"`        //
 }
 
 // UpdateTagRequestDynamicRules is the updateTagRequestDynamicRules definition
 type UpdateTagRequestDynamicRules struct {
-	MemberType string                            `json:"memberType,omitempty"` //
-	Rules      UpdateTagRequestDynamicRulesRules `json:"rules,omitempty"`      //
+	MemberType string                             `json:"memberType,omitempty"` //
+	Rules      *UpdateTagRequestDynamicRulesRules `json:"rules,omitempty"`      //
 }
 
 // UpdateTagRequestDynamicRulesRules is the updateTagRequestDynamicRulesRules definition
 type UpdateTagRequestDynamicRulesRules struct {
-	Items     []string `json:"items,omitempty"`     //
-	Name      string   `json:"name,omitempty"`      //
-	Operation string   `json:"operation,omitempty"` //
-	Value     string   `json:"value,omitempty"`     //
-	Values    []string `json:"values,omitempty"`    //
+	Items     []UpdateTagRequestDynamicRulesRules `json:"items,omitempty"`     //
+	Name      string                              `json:"name,omitempty"`      //
+	Operation string                              `json:"operation,omitempty"` //
+	Value     string                              `json:"value,omitempty"`     //
+	Values    []string                            `json:"values,omitempty"`    //
 }
 
 // UpdateTagRequestDynamicRulesRulesItems is the updateTagRequestDynamicRulesRulesItems definition
-type UpdateTagRequestDynamicRulesRulesItems []string
+type UpdateTagRequestDynamicRulesRulesItems []UpdateTagRequestDynamicRulesRules
 
 // UpdateTagRequestDynamicRulesRulesValues is the updateTagRequestDynamicRulesRulesValues definition
 type UpdateTagRequestDynamicRulesRulesValues []string
 
 // UpdatesTagMembershipRequest is the updatesTagMembershipRequest definition
 type UpdatesTagMembershipRequest struct {
-	MemberToTags []UpdatesTagMembershipRequestMemberToTags `json:"memberToTags,omitempty"` //
-	MemberType   string                                    `json:"memberType,omitempty"`   //
+	MemberToTags map[string][]string `json:"memberToTags,omitempty"` // Tag IDs by member ID
+	MemberType   string              `json:"memberType,omitempty"`   //
 }
 
 // UpdatesTagMembershipRequestMemberToTags is the updatesTagMembershipRequestMemberToTags definition
+//
+// Deprecated: MemberToTags of UpdatesTagMembershipRequest is a map of tag IDs
+// by member ID, which this type could not express.
 type UpdatesTagMembershipRequestMemberToTags struct {
 	Key []string `json:"key,omitempty"` //
 }
 
 // UpdatesTagMembershipRequestMemberToTagsKey is the updatesTagMembershipRequestMemberToTagsKey definition
+//
+// Deprecated: use []string in MemberToTags of UpdatesTagMembershipRequest.
 type UpdatesTagMembershipRequestMemberToTagsKey []string
 
 // AddMembersToTheTagResponse is the addMembersToTheTagResponse definition
@@ -141,15 +147,15 @@ type GetTagByIDResponseResponseDynamicRules struct {
 
 // GetTagByIDResponseResponseDynamicRulesRules is the getTagByIDResponseResponseDynamicRulesRules definition
 type GetTagByIDResponseResponseDynamicRulesRules struct {
-	Items     []string `json:"items,omitempty"`     //
-	Name      string   `json:"name,omitempty"`      //
-	Operation string   `json:"operation,omitempty"` //
-	Value     string   `json:"value,omitempty"`     //
-	Values    []string `json:"values,omitempty"`    //
+	Items     []GetTagByIDResponseResponseDynamicRulesRules `json:"items,omitempty"`     //
+	Name      string                                        `json:"name,omitempty"`      //
+	Operation string                                        `json:"operation,omitempty"` //
+	Value     string                                        `json:"value,omitempty"`     //
+	Values    []string                                      `json:"values,omitempty"`    //
 }
 
 // GetTagByIDResponseResponseDynamicRulesRulesItems is the getTagByIDResponseResponseDynamicRulesRulesItems definition
-type GetTagByIDResponseResponseDynamicRulesRulesItems []string
+type GetTagByIDResponseResponseDynamicRulesRulesItems []GetTagByIDResponseResponseDynamicRulesRules
 
 // GetTagByIDResponseResponseDynamicRulesRulesValues is the getTagByIDResponseResponseDynamicRulesRulesValues definition
 type GetTagByIDResponseResponseDynamicRulesRulesValues []string
@@ -216,15 +222,15 @@ type GetTagResponseResponseDynamicRules struct {
 
 // GetTagResponseResponseDynamicRulesRules is the getTagResponseResponseDynamicRulesRules definition
 type GetTagResponseResponseDynamicRulesRules struct {
-	Items     []string `json:"items,omitempty"`     //
-	Name      string   `json:"name,omitempty"`      //
-	Operation string   `json:"operation,omitempty"` //
-	Value     string   `json:"value,omitempty"`     //
-	Values    []string `json:"values,omitempty"`    //
+	Items     []GetTagResponseResponseDynamicRulesRules `json:"items,omitempty"`     //
+	Name      string                                    `json:"name,omitempty"`      //
+	Operation string                                    `json:"operation,omitempty"` //
+	Value     string                                    `json:"value,omitempty"`     //
+	Values    []string                                  `json:"values,omitempty"`    //
 }
 
 // GetTagResponseResponseDynamicRulesRulesItems is the getTagResponseResponseDynamicRulesRulesItems definition
-type GetTagResponseResponseDynamicRulesRulesItems []string
+type GetTagResponseResponseDynamicRulesRulesItems []GetTagResponseResponseDynamicRulesRules
 
 // GetTagResponseResponseDynamicRulesRulesValues is the getTagResponseResponseDynamicRulesRulesValues definition
 type GetTagResponseResponseDynamicRulesRulesValues []string
@@ -296,10 +302,16 @@ type UpdatesTagMembershipResponseResponse struct {
 /* Creates tag with specified tag attributes
  */
 func (s *TagService) CreateTag(createTagRequest *CreateTagRequest) (*CreateTagResponse, *resty.Response, error) {
+	return s.CreateTagWithContext(context.Background(), createTagRequest)
+}
+
+// CreateTagWithContext is like CreateTag but sends the request with ctx for cancellation and deadlines.
+func (s *TagService) CreateTagWithContext(ctx context.Context, createTagRequest *CreateTagRequest) (*CreateTagResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/tag"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(createTagRequest).
 		SetResult(&CreateTagResponse{}).
 		SetError(&Error{}).
@@ -310,7 +322,7 @@ func (s *TagService) CreateTag(createTagRequest *CreateTagRequest) (*CreateTagRe
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation createTag")
+		return nil, response, newAPIError("createTag", response)
 	}
 
 	result := response.Result().(*CreateTagResponse)
@@ -322,11 +334,17 @@ func (s *TagService) CreateTag(createTagRequest *CreateTagRequest) (*CreateTagRe
 @param id Tag ID
 */
 func (s *TagService) DeleteTag(id string) (*DeleteTagResponse, *resty.Response, error) {
+	return s.DeleteTagWithContext(context.Background(), id)
+}
+
+// DeleteTagWithContext is like DeleteTag but sends the request with ctx for cancellation and deadlines.
+func (s *TagService) DeleteTagWithContext(ctx context.Context, id string) (*DeleteTagResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/tag/{id}"
 	path = strings.Replace(path, "{"+"id"+"}", fmt.Sprintf("%v", id), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&DeleteTagResponse{}).
 		SetError(&Error{}).
 		Delete(path)
@@ -336,7 +354,7 @@ func (s *TagService) DeleteTag(id string) (*DeleteTagResponse, *resty.Response,
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation deleteTag")
+		return nil, response, newAPIError("deleteTag", response)
 	}
 
 	result := response.Result().(*DeleteTagResponse)
@@ -373,12 +391,18 @@ type GetTagQueryParams struct {
 @param systemTag systemTag
 */
 func (s *TagService) GetTag(getTagQueryParams *GetTagQueryParams) (*GetTagResponse, *resty.Response, error) {
+	return s.GetTagWithContext(context.Background(), getTagQueryParams)
+}
+
+// GetTagWithContext is like GetTag but sends the request with ctx for cancellation and deadlines.
+func (s *TagService) GetTagWithContext(ctx context.Context, getTagQueryParams *GetTagQueryParams) (*GetTagResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/tag"
 
 	queryString, _ := query.Values(getTagQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetTagResponse{}).
 		SetError(&Error{}).
@@ -389,7 +413,7 @@ func (s *TagService) GetTag(getTagQueryParams *GetTagQueryParams) (*GetTagRespon
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getTag")
+		return nil, response, newAPIError("getTag", response)
 	}
 
 	result := response.Result().(*GetTagResponse)
@@ -401,11 +425,17 @@ func (s *TagService) GetTag(getTagQueryParams *GetTagQueryParams) (*GetTagRespon
 @param id Tag ID
 */
 func (s *TagService) GetTagByID(id string) (*GetTagByIDResponse, *resty.Response, error) {
+	return s.GetTagByIDWithContext(context.Background(), id)
+}
+
+// GetTagByIDWithContext is like GetTagByID but sends the request with ctx for cancellation and deadlines.
+func (s *TagService) GetTagByIDWithContext(ctx context.Context, id string) (*GetTagByIDResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/tag/{id}"
 	path = strings.Replace(path, "{"+"id"+"}", fmt.Sprintf("%v", id), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&GetTagByIDResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -415,7 +445,7 @@ func (s *TagService) GetTagByID(id string) (*GetTagByIDResponse, *resty.Response
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getTagById")
+		return nil, response, newAPIError("getTagById", response)
 	}
 
 	result := response.Result().(*GetTagByIDResponse)
@@ -442,12 +472,18 @@ type GetTagCountQueryParams struct {
 @param systemTag systemTag
 */
 func (s *TagService) GetTagCount(getTagCountQueryParams *GetTagCountQueryParams) (*GetTagCountResponse, *resty.Response, error) {
+	return s.GetTagCountWithContext(context.Background(), getTagCountQueryParams)
+}
+
+// GetTagCountWithContext is like GetTagCount but sends the request with ctx for cancellation and deadlines.
+func (s *TagService) GetTagCountWithContext(ctx context.Context, getTagCountQueryParams *GetTagCountQueryParams) (*GetTagCountResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/tag/count"
 
 	queryString, _ := query.Values(getTagCountQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetTagCountResponse{}).
 		SetError(&Error{}).
@@ -458,7 +494,7 @@ func (s *TagService) GetTagCount(getTagCountQueryParams *GetTagCountQueryParams)
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getTagCount")
+		return nil, response, newAPIError("getTagCount", response)
 	}
 
 	result := response.Result().(*GetTagCountResponse)
@@ -480,6 +516,11 @@ type GetTagMemberCountQueryParams struct {
 @param level level
 */
 func (s *TagService) GetTagMemberCount(id string, getTagMemberCountQueryParams *GetTagMemberCountQueryParams) (*GetTagMemberCountResponse, *resty.Response, error) {
+	return s.GetTagMemberCountWithContext(context.Background(), id, getTagMemberCountQueryParams)
+}
+
+// GetTagMemberCountWithContext is like GetTagMemberCount but sends the request with ctx for cancellation and deadlines.
+func (s *TagService) GetTagMemberCountWithContext(ctx context.Context, id string, getTagMemberCountQueryParams *GetTagMemberCountQueryParams) (*GetTagMemberCountResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/tag/{id}/member/count"
 	path = strings.Replace(path, "{"+"id"+"}", fmt.Sprintf("%v", id), -1)
@@ -487,6 +528,7 @@ func (s *TagService) GetTagMemberCount(id string, getTagMemberCountQueryParams *
 	queryString, _ := query.Values(getTagMemberCountQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetTagMemberCountResponse{}).
 		SetError(&Error{}).
@@ -497,7 +539,7 @@ func (s *TagService) GetTagMemberCount(id string, getTagMemberCountQueryParams *
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getTagMemberCount")
+		return nil, response, newAPIError("getTagMemberCount", response)
 	}
 
 	result := response.Result().(*GetTagMemberCountResponse)
@@ -523,6 +565,11 @@ type GetTagMembersByIDQueryParams struct {
 @param level level
 */
 func (s *TagService) GetTagMembersByID(id string, getTagMembersByIDQueryParams *GetTagMembersByIDQueryParams) (*GetTagMembersByIDResponse, *resty.Response, error) {
+	return s.GetTagMembersByIDWithContext(context.Background(), id, getTagMembersByIDQueryParams)
+}
+
+// GetTagMembersByIDWithContext is like GetTagMembersByID but sends the request with ctx for cancellation and deadlines.
+func (s *TagService) GetTagMembersByIDWithContext(ctx context.Context, id string, getTagMembersByIDQueryParams *GetTagMembersByIDQueryParams) (*GetTagMembersByIDResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/tag/{id}/member"
 	path = strings.Replace(path, "{"+"id"+"}", fmt.Sprintf("%v", id), -1)
@@ -530,6 +577,7 @@ func (s *TagService) GetTagMembersByID(id string, getTagMembersByIDQueryParams *
 	queryString, _ := query.Values(getTagMembersByIDQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetTagMembersByIDResponse{}).
 		SetError(&Error{}).
@@ -540,7 +588,7 @@ func (s *TagService) GetTagMembersByID(id string, getTagMembersByIDQueryParams *
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getTagMembersById")
+		return nil, response, newAPIError("getTagMembersById", response)
 	}
 
 	result := response.Result().(*GetTagMembersByIDResponse)
@@ -551,10 +599,16 @@ func (s *TagService) GetTagMembersByID(id string, getTagMembersByIDQueryParams *
 /* Returns list of supported resource types
  */
 func (s *TagService) GetTagResourceTypes() (*GetTagResourceTypesResponse, *resty.Response, error) {
+	return s.GetTagResourceTypesWithContext(context.Background())
+}
+
+// GetTagResourceTypesWithContext is like GetTagResourceTypes but sends the request with ctx for cancellation and deadlines.
+func (s *TagService) GetTagResourceTypesWithContext(ctx context.Context) (*GetTagResourceTypesResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/tag/member/type"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&GetTagResourceTypesResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -564,7 +618,7 @@ func (s *TagService) GetTagResourceTypes() (*GetTagResourceTypesResponse, *resty
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getTagResourceTypes")
+		return nil, response, newAPIError("getTagResourceTypes", response)
 	}
 
 	result := response.Result().(*GetTagResourceTypesResponse)
@@ -577,12 +631,18 @@ func (s *TagService) GetTagResourceTypes() (*GetTagResourceTypesResponse, *resty
 @param memberID TagMember id to be removed from tag
 */
 func (s *TagService) RemoveTagMember(id string, memberID string) (*RemoveTagMemberResponse, *resty.Response, error) {
+	return s.RemoveTagMemberWithContext(context.Background(), id, memberID)
+}
+
+// RemoveTagMemberWithContext is like RemoveTagMember but sends the request with ctx for cancellation and deadlines.
+func (s *TagService) RemoveTagMemberWithContext(ctx context.Context, id string, memberID string) (*RemoveTagMemberResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/tag/{id}/member/{memberId}"
 	path = strings.Replace(path, "{"+"id"+"}", fmt.Sprintf("%v", id), -1)
 	path = strings.Replace(path, "{"+"memberId"+"}", fmt.Sprintf("%v", memberID), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&RemoveTagMemberResponse{}).
 		SetError(&Error{}).
 		Delete(path)
@@ -592,7 +652,7 @@ func (s *TagService) RemoveTagMember(id string, memberID string) (*RemoveTagMemb
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation removeTagMember")
+		return nil, response, newAPIError("removeTagMember", response)
 	}
 
 	result := response.Result().(*RemoveTagMemberResponse)
@@ -603,10 +663,16 @@ func (s *TagService) RemoveTagMember(id string, memberID string) (*RemoveTagMemb
 /* Updates a tag specified by id
  */
 func (s *TagService) UpdateTag(updateTagRequest *UpdateTagRequest) (*UpdateTagResponse, *resty.Response, error) {
+	return s.UpdateTagWithContext(context.Background(), updateTagRequest)
+}
+
+// UpdateTagWithContext is like UpdateTag but sends the request with ctx for cancellation and deadlines.
+func (s *TagService) UpdateTagWithContext(ctx context.Context, updateTagRequest *UpdateTagRequest) (*UpdateTagResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/tag"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(updateTagRequest).
 		SetResult(&UpdateTagResponse{}).
 		SetError(&Error{}).
@@ -617,7 +683,7 @@ func (s *TagService) UpdateTag(updateTagRequest *UpdateTagRequest) (*UpdateTagRe
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation updateTag")
+		return nil, response, newAPIError("updateTag", response)
 	}
 
 	result := response.Result().(*UpdateTagResponse)
@@ -628,10 +694,16 @@ func (s *TagService) UpdateTag(updateTagRequest *UpdateTagRequest) (*UpdateTagRe
 /* Updates tag membership. As part of the request payload through this API, only the specified members are added / retained to the given input tags. Possible values of memberType attribute in the request payload can be queried by using the /tag/member/type API
  */
 func (s *TagService) UpdatesTagMembership(updatesTagMembershipRequest *UpdatesTagMembershipRequest) (*UpdatesTagMembershipResponse, *resty.Response, error) {
+	return s.UpdatesTagMembershipWithContext(context.Background(), updatesTagMembershipRequest)
+}
+
+// UpdatesTagMembershipWithContext is like UpdatesTagMembership but sends the request with ctx for cancellation and deadlines.
+func (s *TagService) UpdatesTagMembershipWithContext(ctx context.Context, updatesTagMembershipRequest *UpdatesTagMembershipRequest) (*UpdatesTagMembershipResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/tag/member"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(updatesTagMembershipRequest).
 		SetResult(&UpdatesTagMembershipResponse{}).
 		SetError(&Error{}).
@@ -642,7 +714,7 @@ func (s *TagService) UpdatesTagMembership(updatesTagMembershipRequest *UpdatesTa
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation updatesTagMembership")
+		return nil, response, newAPIError("updatesTagMembership", response)
 	}
 
 	result := response.Result().(*UpdatesTagMembershipResponse)
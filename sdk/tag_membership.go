@@ -0,0 +1,180 @@
+package dnac
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// defaultTagMembershipBatchSize is the number of members changed per batch
+// unless TagMembershipOptions say otherwise.
+const defaultTagMembershipBatchSize = 50
+
+// TagMembershipOptions controls how SetTagMembers applies the changes.
+type TagMembershipOptions struct {
+	BatchSize int                 // Members added per UpdatesTagMembership call, and removed before waiting. Defaults to 50
+	Wait      *WaitForTaskOptions // Options of the waits for the tasks of the changes, may be nil
+}
+
+// TagMemberAction is what SetTagMembers did to a member.
+type TagMemberAction string
+
+const (
+	TagMemberAdded   TagMemberAction = "added"
+	TagMemberRemoved TagMemberAction = "removed"
+)
+
+// TagMemberOutcome is the outcome of the change of a member.
+type TagMemberOutcome struct {
+	MemberID string
+	Action   TagMemberAction
+	TaskID   string
+	Err      error // Why the change failed, nil if it succeeded
+}
+
+// TagMembershipReport is the outcome of SetTagMembers.
+type TagMembershipReport struct {
+	TagID      string
+	MemberType string
+	Unchanged  []string           // Members that were already in the tag
+	Outcomes   []TagMemberOutcome // Members added, then members removed
+}
+
+// Failed returns the outcomes of the changes that failed.
+func (r *TagMembershipReport) Failed() []TagMemberOutcome {
+	var failed []TagMemberOutcome
+	for _, outcome := range r.Outcomes {
+		if outcome.Err != nil {
+			failed = append(failed, outcome)
+		}
+	}
+	return failed
+}
+
+// Err returns an error summarizing the failed changes, or nil.
+func (r *TagMembershipReport) Err() error {
+	failed := r.Failed()
+	if len(failed) == 0 {
+		return nil
+	}
+	return fmt.Errorf("tag membership: %d of %d changes to tag %s failed, first: %s not %s: %v",
+		len(failed), len(r.Outcomes), r.TagID, failed[0].MemberID, failed[0].Action, failed[0].Err)
+}
+
+// SetTagMembers makes memberIDs the members of the given type of a tag. It
+// reads the current members page by page, adds the missing members in
+// batches with UpdatesTagMembership, removes the others with
+// RemoveTagMember, and waits for every task. Members that are associated
+// with the tag by its dynamic rules cannot be removed, and are reported as
+// failed removals. Changes for which no task is returned cannot be confirmed
+// and are reported as failed too. The report holds the outcome of every
+// member changed; an error is returned only if the current members cannot be
+// read. opts may be nil to use the defaults.
+func (s *TagService) SetTagMembers(ctx context.Context, tagID string, memberType string, memberIDs []string, opts *TagMembershipOptions) (*TagMembershipReport, error) {
+	batchSize := defaultTagMembershipBatchSize
+	var waitOpts *WaitForTaskOptions
+	if opts != nil {
+		if opts.BatchSize > 0 {
+			batchSize = opts.BatchSize
+		}
+		waitOpts = opts.Wait
+	}
+
+	current := map[string]bool{}
+	it := s.ListAllMembers(ctx, tagID, &GetTagMembersByIDQueryParams{MemberType: memberType}, nil)
+	for it.Next() {
+		current[it.Member().InstanceUUID] = true
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	report := &TagMembershipReport{TagID: tagID, MemberType: memberType}
+	desired := map[string]bool{}
+	var adds, removes []string
+	for _, id := range memberIDs {
+		if desired[id] {
+			continue
+		}
+		desired[id] = true
+		if current[id] {
+			report.Unchanged = append(report.Unchanged, id)
+		} else {
+			adds = append(adds, id)
+		}
+	}
+	for id := range current {
+		if !desired[id] {
+			removes = append(removes, id)
+		}
+	}
+	sort.Strings(removes)
+
+	for start := 0; start < len(adds); start += batchSize {
+		end := start + batchSize
+		if end > len(adds) {
+			end = len(adds)
+		}
+		report.Outcomes = append(report.Outcomes, s.addTagMembers(ctx, tagID, memberType, adds[start:end], waitOpts)...)
+	}
+	for start := 0; start < len(removes); start += batchSize {
+		end := start + batchSize
+		if end > len(removes) {
+			end = len(removes)
+		}
+		report.Outcomes = append(report.Outcomes, s.removeTagMembers(ctx, tagID, removes[start:end], waitOpts)...)
+	}
+	return report, nil
+}
+
+// addTagMembers adds a batch of members with a single call and reports the
+// outcome of the call for each of them.
+func (s *TagService) addTagMembers(ctx context.Context, tagID string, memberType string, memberIDs []string, opts *WaitForTaskOptions) []TagMemberOutcome {
+	request := &UpdatesTagMembershipRequest{MemberToTags: map[string][]string{}, MemberType: memberType}
+	for _, id := range memberIDs {
+		request.MemberToTags[id] = []string{tagID}
+	}
+	var taskID string
+	result, _, err := s.UpdatesTagMembershipWithContext(ctx, request)
+	if err == nil {
+		taskID = result.Response.TaskID
+		err = s.waitForTagTask(ctx, taskID, opts)
+	}
+	outcomes := make([]TagMemberOutcome, len(memberIDs))
+	for i, id := range memberIDs {
+		outcomes[i] = TagMemberOutcome{MemberID: id, Action: TagMemberAdded, TaskID: taskID, Err: err}
+	}
+	return outcomes
+}
+
+// removeTagMembers removes a batch of members, one call each, and then waits
+// for their tasks.
+func (s *TagService) removeTagMembers(ctx context.Context, tagID string, memberIDs []string, opts *WaitForTaskOptions) []TagMemberOutcome {
+	outcomes := make([]TagMemberOutcome, len(memberIDs))
+	for i, id := range memberIDs {
+		outcomes[i] = TagMemberOutcome{MemberID: id, Action: TagMemberRemoved}
+		result, _, err := s.RemoveTagMemberWithContext(ctx, tagID, id)
+		if err != nil {
+			outcomes[i].Err = err
+			continue
+		}
+		outcomes[i].TaskID = result.Response.TaskID
+	}
+	for i := range outcomes {
+		if outcomes[i].Err == nil {
+			outcomes[i].Err = s.waitForTagTask(ctx, outcomes[i].TaskID, opts)
+		}
+	}
+	return outcomes
+}
+
+// waitForTagTask waits for the task of a membership change. A change without
+// a task cannot be confirmed, so it is reported as failed.
+func (s *TagService) waitForTagTask(ctx context.Context, taskID string, opts *WaitForTaskOptions) error {
+	if taskID == "" {
+		return errors.New("tag membership: no task returned for the change")
+	}
+	_, err := (*TaskService)(s).WaitForTask(ctx, taskID, opts)
+	return err
+}
@@ -0,0 +1,231 @@
+package dnac_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	dnac "github.com/cisco-en-programmability/dnacenter-go-sdk/sdk"
+	"github.com/cisco-en-programmability/dnacenter-go-sdk/sdk/dnactest"
+)
+
+const (
+	tagMembersPath    = "/dna/intent/api/v1/tag/{id}/member"
+	tagMemberPath     = "/dna/intent/api/v1/tag/{id}/member/{memberId}"
+	tagMembershipPath = "/dna/intent/api/v1/tag/member"
+)
+
+// tagMemberServer returns a server where the tag holds current. Additions
+// fail for batches holding the member bad, and removals fail for the member
+// dynamic, which the tag holds through a dynamic rule. The removal of gone
+// fails with a 404, and the removal of notask returns no task.
+func tagMemberServer(t *testing.T, current ...string) (*dnactest.Server, *dnac.Client) {
+	t.Helper()
+	srv, client := taskServer(t)
+	members := []map[string]string{}
+	for _, id := range current {
+		members = append(members, map[string]string{"instanceUuid": id})
+	}
+	srv.RespondSequence(http.MethodGet, tagMembersPath,
+		dnactest.Response{Body: map[string]interface{}{"response": members}},
+		dnactest.Response{Body: map[string]interface{}{"response": []map[string]string{}}},
+	)
+	srv.HandleFunc(http.MethodPut, tagMembershipPath, func(w http.ResponseWriter, r *http.Request) {
+		var request dnac.UpdatesTagMembershipRequest
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			dnactest.WriteJSON(w, http.StatusBadRequest, map[string]string{"message": err.Error()})
+			return
+		}
+		task := dnactest.Task{Progress: "membership updated"}
+		if _, ok := request.MemberToTags["bad"]; ok {
+			task = dnactest.Task{IsError: true, ErrorCode: "NCTG00012", FailureReason: "invalid member bad"}
+		}
+		dnactest.WriteJSON(w, http.StatusAccepted, map[string]interface{}{"response": map[string]string{"taskId": srv.AddTask(task)}})
+	})
+	srv.HandleFunc(http.MethodDelete, tagMemberPath, func(w http.ResponseWriter, r *http.Request) {
+		task := dnactest.Task{Progress: "member removed"}
+		switch dnactest.PathParam(r, "memberId") {
+		case "gone":
+			dnactest.WriteJSON(w, http.StatusNotFound, map[string]string{"message": "member not found"})
+			return
+		case "notask":
+			dnactest.WriteJSON(w, http.StatusAccepted, map[string]interface{}{"response": map[string]string{}})
+			return
+		case "dynamic":
+			task = dnactest.Task{IsError: true, ErrorCode: "NCTG00007", FailureReason: "member is associated by a dynamic rule"}
+		}
+		dnactest.WriteJSON(w, http.StatusAccepted, map[string]interface{}{"response": map[string]string{"taskId": srv.AddTask(task)}})
+	})
+	return srv, client
+}
+
+// addedBatches returns the members of each UpdatesTagMembership request, sorted.
+func addedBatches(t *testing.T, srv *dnactest.Server) [][]string {
+	t.Helper()
+	var batches [][]string
+	for _, r := range srv.RequestsTo(http.MethodPut, tagMembershipPath) {
+		var request dnac.UpdatesTagMembershipRequest
+		if err := json.Unmarshal(r.Body, &request); err != nil {
+			t.Fatal(err)
+		}
+		var batch []string
+		for id, tags := range request.MemberToTags {
+			if !reflect.DeepEqual(tags, []string{"t1"}) || request.MemberType != "networkdevice" {
+				t.Errorf("got %s added to %v as %s, want t1 and networkdevice", id, tags, request.MemberType)
+			}
+			batch = append(batch, id)
+		}
+		sort.Strings(batch)
+		batches = append(batches, batch)
+	}
+	return batches
+}
+
+// removedMembers returns the members of the RemoveTagMember requests in order.
+func removedMembers(srv *dnactest.Server) []string {
+	var removed []string
+	for _, r := range srv.RequestsTo(http.MethodDelete, tagMemberPath) {
+		removed = append(removed, r.Params["memberId"])
+	}
+	return removed
+}
+
+func TestSetTagMembersAppliesTheDifference(t *testing.T) {
+	srv, client := tagMemberServer(t, "m1", "m2", "m3", "m6")
+
+	report, err := client.Tag.SetTagMembers(context.Background(), "t1", "networkdevice",
+		[]string{"m2", "m4", "m1", "m4", "m5"}, &dnac.TagMembershipOptions{Wait: fastPolls})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := report.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if r := srv.LastRequestTo(http.MethodGet, tagMembersPath); r == nil || r.Params["id"] != "t1" || r.Query.Get("memberType") != "networkdevice" {
+		t.Errorf("got members request %+v, want the network devices of t1", r)
+	}
+
+	if want := []string{"m2", "m1"}; !reflect.DeepEqual(report.Unchanged, want) {
+		t.Errorf("got unchanged %v, want %v", report.Unchanged, want)
+	}
+	var outcomes []string
+	for _, outcome := range report.Outcomes {
+		if outcome.TaskID == "" {
+			t.Errorf("%s %s without a task", outcome.MemberID, outcome.Action)
+		}
+		outcomes = append(outcomes, outcome.MemberID+" "+string(outcome.Action))
+	}
+	if got, want := strings.Join(outcomes, ", "), "m4 added, m5 added, m3 removed, m6 removed"; got != want {
+		t.Errorf("got outcomes %s, want %s", got, want)
+	}
+	if got := addedBatches(t, srv); !reflect.DeepEqual(got, [][]string{{"m4", "m5"}}) {
+		t.Errorf("got added batches %v, want m4 and m5 at once", got)
+	}
+	if got := removedMembers(srv); !reflect.DeepEqual(got, []string{"m3", "m6"}) {
+		t.Errorf("got removed %v, want m3 and m6", got)
+	}
+}
+
+func TestSetTagMembersBatches(t *testing.T) {
+	srv, client := tagMemberServer(t, "r1", "r2", "r3", "r4")
+
+	report, err := client.Tag.SetTagMembers(context.Background(), "t1", "networkdevice",
+		[]string{"a1", "a2", "a3", "a4", "a5", "a6", "a7"}, &dnac.TagMembershipOptions{BatchSize: 3, Wait: fastPolls})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := report.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	want := [][]string{{"a1", "a2", "a3"}, {"a4", "a5", "a6"}, {"a7"}}
+	if got := addedBatches(t, srv); !reflect.DeepEqual(got, want) {
+		t.Errorf("got added batches %v, want %v", got, want)
+	}
+	// The members of a batch share the task of its call.
+	tasks := map[string][]string{}
+	for _, outcome := range report.Outcomes[:7] {
+		tasks[outcome.TaskID] = append(tasks[outcome.TaskID], outcome.MemberID)
+	}
+	if len(tasks) != 3 {
+		t.Errorf("got tasks %v, want one per batch", tasks)
+	}
+	if got := removedMembers(srv); !reflect.DeepEqual(got, []string{"r1", "r2", "r3", "r4"}) {
+		t.Errorf("got removed %v, want r1 to r4", got)
+	}
+}
+
+func TestSetTagMembersReportsFailedChanges(t *testing.T) {
+	srv, client := tagMemberServer(t, "old", "dynamic", "gone", "notask")
+
+	report, err := client.Tag.SetTagMembers(context.Background(), "t1", "networkdevice",
+		[]string{"bad", "new"}, &dnac.TagMembershipOptions{BatchSize: 1, Wait: fastPolls})
+	if err != nil {
+		t.Fatalf("got %v, want failures in the report only", err)
+	}
+
+	outcomes := map[string]dnac.TagMemberOutcome{}
+	for _, outcome := range report.Outcomes {
+		outcomes[outcome.MemberID] = outcome
+	}
+	if len(outcomes) != 6 {
+		t.Fatalf("got outcomes %+v, want 2 additions and 4 removals", report.Outcomes)
+	}
+	var taskErr *dnac.TaskError
+	if err := outcomes["bad"].Err; !errors.As(err, &taskErr) || taskErr.FailureReason != "invalid member bad" {
+		t.Errorf("got %v for bad, want its failed task", err)
+	}
+	if err := outcomes["dynamic"].Err; !errors.As(err, &taskErr) || taskErr.ErrorCode != "NCTG00007" {
+		t.Errorf("got %v for the dynamic member, want its failed task", err)
+	}
+	if err := outcomes["gone"].Err; !dnac.IsNotFound(err) {
+		t.Errorf("got %v for gone, want a 404 error", err)
+	}
+	if err := outcomes["notask"].Err; err == nil || !strings.Contains(err.Error(), "no task") {
+		t.Errorf("got %v for notask, want an error for the missing task", err)
+	}
+	for _, id := range []string{"new", "old"} {
+		if err := outcomes[id].Err; err != nil {
+			t.Errorf("got %v for %s, want nil", err, id)
+		}
+	}
+	if got := addedBatches(t, srv); !reflect.DeepEqual(got, [][]string{{"bad"}, {"new"}}) {
+		t.Errorf("got added batches %v, want bad and new apart", got)
+	}
+
+	if failed := report.Failed(); len(failed) != 4 {
+		t.Errorf("got %d failed changes, want 4", len(failed))
+	}
+	if err := report.Err(); err == nil || !strings.HasPrefix(err.Error(), "tag membership: 4 of 6 changes to tag t1 failed, first: bad not added: ") {
+		t.Errorf("got %v, want a summary of the 4 failures", err)
+	}
+}
+
+func TestSetTagMembersUnchanged(t *testing.T) {
+	srv, client := tagMemberServer(t, "m1", "m2")
+
+	report, err := client.Tag.SetTagMembers(context.Background(), "t1", "networkdevice", []string{"m2", "m1"}, &dnac.TagMembershipOptions{Wait: fastPolls})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(report.Unchanged, []string{"m2", "m1"}) || len(report.Outcomes) != 0 || report.Err() != nil {
+		t.Errorf("got report %+v, want m1 and m2 unchanged", report)
+	}
+	srv.AssertNotCalled(t, http.MethodPut, tagMembershipPath)
+	srv.AssertNotCalled(t, http.MethodDelete, tagMemberPath)
+}
+
+func TestSetTagMembersReportsListErrors(t *testing.T) {
+	srv, client := taskServer(t)
+	srv.RespondJSON(http.MethodGet, tagMembersPath, http.StatusInternalServerError, map[string]string{"message": "database unavailable"})
+
+	if _, err := client.Tag.SetTagMembers(context.Background(), "t1", "networkdevice", []string{"m1"}, nil); err == nil {
+		t.Error("got nil, want the error of the member list")
+	}
+	srv.AssertNotCalled(t, http.MethodPut, tagMembershipPath)
+}
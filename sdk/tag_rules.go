@@ -0,0 +1,225 @@
+package dnac
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Operations of dynamic tag rules.
+const (
+	TagRuleAndOperation    = "AND"
+	TagRuleOrOperation     = "OR"
+	TagRuleEqualsOperation = "EQ"
+	TagRuleILikeOperation  = "ILIKE"
+)
+
+// Attributes of network devices that dynamic tag rules can match.
+const (
+	TagRuleHostname            = "hostname"
+	TagRuleFamily              = "family"
+	TagRuleSeries              = "series"
+	TagRuleType                = "type"
+	TagRulePlatformID          = "platformId"
+	TagRuleSerialNumber        = "serialNumber"
+	TagRuleSoftwareType        = "softwareType"
+	TagRuleSoftwareVersion     = "softwareVersion"
+	TagRuleManagementIPAddress = "managementIpAddress"
+	TagRuleMacAddress          = "macAddress"
+	TagRuleRole                = "role"
+	TagRuleLocationName        = "locationName"
+	TagRuleSNMPLocation        = "snmpLocation"
+)
+
+// tagRuleDeviceAttributes reads the attributes of a network device.
+var tagRuleDeviceAttributes = map[string]func(device *GetDeviceListResponseResponse) string{
+	TagRuleHostname:            func(d *GetDeviceListResponseResponse) string { return d.Hostname },
+	TagRuleFamily:              func(d *GetDeviceListResponseResponse) string { return d.Family },
+	TagRuleSeries:              func(d *GetDeviceListResponseResponse) string { return d.Series },
+	TagRuleType:                func(d *GetDeviceListResponseResponse) string { return d.Type },
+	TagRulePlatformID:          func(d *GetDeviceListResponseResponse) string { return d.PlatformID },
+	TagRuleSerialNumber:        func(d *GetDeviceListResponseResponse) string { return d.SerialNumber },
+	TagRuleSoftwareType:        func(d *GetDeviceListResponseResponse) string { return d.SoftwareType },
+	TagRuleSoftwareVersion:     func(d *GetDeviceListResponseResponse) string { return d.SoftwareVersion },
+	TagRuleManagementIPAddress: func(d *GetDeviceListResponseResponse) string { return d.ManagementIPAddress },
+	TagRuleMacAddress:          func(d *GetDeviceListResponseResponse) string { return d.MacAddress },
+	TagRuleRole:                func(d *GetDeviceListResponseResponse) string { return d.Role },
+	TagRuleLocationName:        func(d *GetDeviceListResponseResponse) string { return d.LocationName },
+	TagRuleSNMPLocation:        func(d *GetDeviceListResponseResponse) string { return d.SNMPLocation },
+}
+
+// TagRule is a dynamic tag rule: either a condition on an attribute, or the
+// AND or OR of other rules. It has the JSON shape of the rules of the tag API.
+type TagRule struct {
+	Operation string    `json:"operation,omitempty"`
+	Name      string    `json:"name,omitempty"` // Attribute matched by a condition
+	Value     string    `json:"value,omitempty"`
+	Values    []string  `json:"values,omitempty"`
+	Items     []TagRule `json:"items,omitempty"` // Rules combined by AND and OR
+}
+
+// TagRuleEquals returns a rule matching the devices whose attribute is value.
+func TagRuleEquals(attribute string, value string) TagRule {
+	return TagRule{Operation: TagRuleEqualsOperation, Name: attribute, Value: value}
+}
+
+// TagRuleILike returns a rule matching the devices whose attribute matches
+// pattern, case insensitively. In the pattern, % matches any sequence of
+// characters and _ any single character, e.g. %edge% or SJC-__-%.
+func TagRuleILike(attribute string, pattern string) TagRule {
+	return TagRule{Operation: TagRuleILikeOperation, Name: attribute, Value: pattern}
+}
+
+// TagRuleAnd returns a rule matching the devices matched by all the rules.
+func TagRuleAnd(rules ...TagRule) TagRule {
+	return TagRule{Operation: TagRuleAndOperation, Items: rules}
+}
+
+// TagRuleOr returns a rule matching the devices matched by any of the rules.
+func TagRuleOr(rules ...TagRule) TagRule {
+	return TagRule{Operation: TagRuleOrOperation, Items: rules}
+}
+
+// String returns the rule as an expression, e.g.
+// (family = "Switches and Hubs" AND hostname ILIKE "%edge%").
+func (r TagRule) String() string {
+	switch strings.ToUpper(r.Operation) {
+	case TagRuleAndOperation, TagRuleOrOperation:
+		var items []string
+		for _, item := range r.Items {
+			items = append(items, item.String())
+		}
+		return "(" + strings.Join(items, " "+strings.ToUpper(r.Operation)+" ") + ")"
+	case TagRuleEqualsOperation:
+		return fmt.Sprintf("%s = %q", r.Name, r.Value)
+	}
+	return fmt.Sprintf("%s %s %q", r.Name, r.Operation, r.Value)
+}
+
+// Validate checks that the rule and its items are well formed: conditions
+// have a known attribute and operation, and AND and OR have items.
+func (r TagRule) Validate() error {
+	switch strings.ToUpper(r.Operation) {
+	case TagRuleAndOperation, TagRuleOrOperation:
+		if len(r.Items) == 0 {
+			return fmt.Errorf("tag rule: %s without items", r.Operation)
+		}
+		for _, item := range r.Items {
+			if err := item.Validate(); err != nil {
+				return err
+			}
+		}
+		return nil
+	case TagRuleEqualsOperation, TagRuleILikeOperation:
+		if _, ok := tagRuleDeviceAttributes[r.Name]; !ok {
+			return fmt.Errorf("tag rule: unknown attribute %q", r.Name)
+		}
+		return nil
+	}
+	return fmt.Errorf("tag rule: unknown operation %q", r.Operation)
+}
+
+// DynamicRule returns the rule as the dynamic rule of a CreateTagRequest for
+// members of the given type, e.g. networkdevice.
+func (r TagRule) DynamicRule(memberType string) CreateTagRequestDynamicRules {
+	rules := r.createRules()
+	return CreateTagRequestDynamicRules{MemberType: memberType, Rules: &rules}
+}
+
+func (r TagRule) createRules() CreateTagRequestDynamicRulesRules {
+	rules := CreateTagRequestDynamicRulesRules{Operation: r.Operation, Name: r.Name, Value: r.Value, Values: r.Values}
+	for _, item := range r.Items {
+		rules.Items = append(rules.Items, item.createRules())
+	}
+	return rules
+}
+
+// UpdateDynamicRule returns the rule as the dynamic rule of an
+// UpdateTagRequest for members of the given type.
+func (r TagRule) UpdateDynamicRule(memberType string) UpdateTagRequestDynamicRules {
+	rules := r.updateRules()
+	return UpdateTagRequestDynamicRules{MemberType: memberType, Rules: &rules}
+}
+
+func (r TagRule) updateRules() UpdateTagRequestDynamicRulesRules {
+	rules := UpdateTagRequestDynamicRulesRules{Operation: r.Operation, Name: r.Name, Value: r.Value, Values: r.Values}
+	for _, item := range r.Items {
+		rules.Items = append(rules.Items, item.updateRules())
+	}
+	return rules
+}
+
+// TagRuleOfTag returns the rule of a dynamic rule of an existing tag.
+func TagRuleOfTag(rules *GetTagResponseResponseDynamicRulesRules) TagRule {
+	rule := TagRule{Operation: rules.Operation, Name: rules.Name, Value: rules.Value, Values: rules.Values}
+	for i := range rules.Items {
+		rule.Items = append(rule.Items, TagRuleOfTag(&rules.Items[i]))
+	}
+	return rule
+}
+
+// Match reports whether the rule matches the device, as DNA Center would
+// evaluate it. It returns an error if the rule is not valid.
+func (r TagRule) Match(device GetDeviceListResponseResponse) (bool, error) {
+	if err := r.Validate(); err != nil {
+		return false, err
+	}
+	return r.match(&device), nil
+}
+
+func (r TagRule) match(device *GetDeviceListResponseResponse) bool {
+	switch strings.ToUpper(r.Operation) {
+	case TagRuleAndOperation:
+		for _, item := range r.Items {
+			if !item.match(device) {
+				return false
+			}
+		}
+		return true
+	case TagRuleOrOperation:
+		for _, item := range r.Items {
+			if item.match(device) {
+				return true
+			}
+		}
+		return false
+	case TagRuleEqualsOperation:
+		return tagRuleDeviceAttributes[r.Name](device) == r.Value
+	case TagRuleILikeOperation:
+		return iLikePattern(r.Value).MatchString(tagRuleDeviceAttributes[r.Name](device))
+	}
+	return false
+}
+
+// Filter returns the devices matched by the rule, in order, to preview the
+// members of a dynamic tag without creating it.
+func (r TagRule) Filter(devices []GetDeviceListResponseResponse) ([]GetDeviceListResponseResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	var matched []GetDeviceListResponseResponse
+	for i := range devices {
+		if r.match(&devices[i]) {
+			matched = append(matched, devices[i])
+		}
+	}
+	return matched, nil
+}
+
+// iLikePattern compiles an ILIKE pattern into a case insensitive regexp.
+func iLikePattern(pattern string) *regexp.Regexp {
+	var b strings.Builder
+	b.WriteString("(?is)^")
+	for _, c := range pattern {
+		switch c {
+		case '%':
+			b.WriteString(".*")
+		case '_':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	b.WriteString("$")
+	return regexp.MustCompile(b.String())
+}
@@ -0,0 +1,60 @@
+package dnac_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	dnac "github.com/cisco-en-programmability/dnacenter-go-sdk/sdk"
+)
+
+var edgeSwitches = dnac.TagRuleAnd(
+	dnac.TagRuleEquals(dnac.TagRuleFamily, "Switches and Hubs"),
+	dnac.TagRuleOr(
+		dnac.TagRuleILike(dnac.TagRuleHostname, "%edge%"),
+		dnac.TagRule{Operation: dnac.TagRuleEqualsOperation, Name: dnac.TagRuleRole, Values: []string{"ACCESS"}},
+	),
+)
+
+// decodeJSON returns v marshalled and decoded again, to compare JSON values
+// regardless of the order of the fields.
+func decodeJSON(t *testing.T, v interface{}) interface{} {
+	t.Helper()
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded interface{}
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	return decoded
+}
+
+func TestTagRuleDynamicRuleJSON(t *testing.T) {
+	want := decodeJSON(t, edgeSwitches)
+	create := edgeSwitches.DynamicRule("networkdevice")
+	update := edgeSwitches.UpdateDynamicRule("networkdevice")
+	if create.MemberType != "networkdevice" || update.MemberType != "networkdevice" {
+		t.Errorf("got member types %q and %q, want networkdevice", create.MemberType, update.MemberType)
+	}
+	for name, rules := range map[string]interface{}{"DynamicRule": create.Rules, "UpdateDynamicRule": update.Rules} {
+		if got := decodeJSON(t, rules); !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: got %v, want %v", name, got, want)
+		}
+	}
+}
+
+func TestTagRuleOfTag(t *testing.T) {
+	b, err := json.Marshal(edgeSwitches)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var rules dnac.GetTagResponseResponseDynamicRulesRules
+	if err := json.Unmarshal(b, &rules); err != nil {
+		t.Fatal(err)
+	}
+	if got := dnac.TagRuleOfTag(&rules); !reflect.DeepEqual(got, edgeSwitches) {
+		t.Errorf("got %v, want %v", got, edgeSwitches)
+	}
+}
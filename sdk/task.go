@@ -1,6 +1,7 @@
 package dnac
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -134,11 +135,17 @@ type GetTasksResponseResponse struct {
 @param taskID UUID of the Task
 */
 func (s *TaskService) GetTaskByID(taskID string) (*GetTaskByIDResponse, *resty.Response, error) {
+	return s.GetTaskByIDWithContext(context.Background(), taskID)
+}
+
+// GetTaskByIDWithContext is like GetTaskByID but sends the request with ctx for cancellation and deadlines.
+func (s *TaskService) GetTaskByIDWithContext(ctx context.Context, taskID string) (*GetTaskByIDResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/task/{taskId}"
 	path = strings.Replace(path, "{"+"taskId"+"}", fmt.Sprintf("%v", taskID), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&GetTaskByIDResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -148,7 +155,7 @@ func (s *TaskService) GetTaskByID(taskID string) (*GetTaskByIDResponse, *resty.R
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getTaskById")
+		return nil, response, newAPIError("getTaskById", response)
 	}
 
 	result := response.Result().(*GetTaskByIDResponse)
@@ -162,6 +169,11 @@ func (s *TaskService) GetTaskByID(taskID string) (*GetTaskByIDResponse, *resty.R
 @param limit The maximum value of {limit} supported is 500. <br/> Base 1 indexing for {limit}, minimum value is 1
 */
 func (s *TaskService) GetTaskByOperationID(operationID string, offset int, limit int) (*GetTaskByOperationIDResponse, *resty.Response, error) {
+	return s.GetTaskByOperationIDWithContext(context.Background(), operationID, offset, limit)
+}
+
+// GetTaskByOperationIDWithContext is like GetTaskByOperationID but sends the request with ctx for cancellation and deadlines.
+func (s *TaskService) GetTaskByOperationIDWithContext(ctx context.Context, operationID string, offset int, limit int) (*GetTaskByOperationIDResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/task/operation/{operationId}/{offset}/{limit}"
 	path = strings.Replace(path, "{"+"operationId"+"}", fmt.Sprintf("%v", operationID), -1)
@@ -169,6 +181,7 @@ func (s *TaskService) GetTaskByOperationID(operationID string, offset int, limit
 	path = strings.Replace(path, "{"+"limit"+"}", fmt.Sprintf("%v", limit), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&GetTaskByOperationIDResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -178,7 +191,7 @@ func (s *TaskService) GetTaskByOperationID(operationID string, offset int, limit
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getTaskByOperationId")
+		return nil, response, newAPIError("getTaskByOperationId", response)
 	}
 
 	result := response.Result().(*GetTaskByOperationIDResponse)
@@ -213,12 +226,18 @@ type GetTaskCountQueryParams struct {
 @param parentID Fetch tasks that have this parent Id
 */
 func (s *TaskService) GetTaskCount(getTaskCountQueryParams *GetTaskCountQueryParams) (*GetTaskCountResponse, *resty.Response, error) {
+	return s.GetTaskCountWithContext(context.Background(), getTaskCountQueryParams)
+}
+
+// GetTaskCountWithContext is like GetTaskCount but sends the request with ctx for cancellation and deadlines.
+func (s *TaskService) GetTaskCountWithContext(ctx context.Context, getTaskCountQueryParams *GetTaskCountQueryParams) (*GetTaskCountResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/task/count"
 
 	queryString, _ := query.Values(getTaskCountQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetTaskCountResponse{}).
 		SetError(&Error{}).
@@ -229,7 +248,7 @@ func (s *TaskService) GetTaskCount(getTaskCountQueryParams *GetTaskCountQueryPar
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getTaskCount")
+		return nil, response, newAPIError("getTaskCount", response)
 	}
 
 	result := response.Result().(*GetTaskCountResponse)
@@ -241,11 +260,17 @@ func (s *TaskService) GetTaskCount(getTaskCountQueryParams *GetTaskCountQueryPar
 @param taskID UUID of the Task
 */
 func (s *TaskService) GetTaskTree(taskID string) (*GetTaskTreeResponse, *resty.Response, error) {
+	return s.GetTaskTreeWithContext(context.Background(), taskID)
+}
+
+// GetTaskTreeWithContext is like GetTaskTree but sends the request with ctx for cancellation and deadlines.
+func (s *TaskService) GetTaskTreeWithContext(ctx context.Context, taskID string) (*GetTaskTreeResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/task/{taskId}/tree"
 	path = strings.Replace(path, "{"+"taskId"+"}", fmt.Sprintf("%v", taskID), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&GetTaskTreeResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -255,7 +280,7 @@ func (s *TaskService) GetTaskTree(taskID string) (*GetTaskTreeResponse, *resty.R
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getTaskTree")
+		return nil, response, newAPIError("getTaskTree", response)
 	}
 
 	result := response.Result().(*GetTaskTreeResponse)
@@ -298,12 +323,18 @@ type GetTasksQueryParams struct {
 @param order Sort order - asc or dsc
 */
 func (s *TaskService) GetTasks(getTasksQueryParams *GetTasksQueryParams) (*GetTasksResponse, *resty.Response, error) {
+	return s.GetTasksWithContext(context.Background(), getTasksQueryParams)
+}
+
+// GetTasksWithContext is like GetTasks but sends the request with ctx for cancellation and deadlines.
+func (s *TaskService) GetTasksWithContext(ctx context.Context, getTasksQueryParams *GetTasksQueryParams) (*GetTasksResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/task"
 
 	queryString, _ := query.Values(getTasksQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetTasksResponse{}).
 		SetError(&Error{}).
@@ -314,7 +345,7 @@ func (s *TaskService) GetTasks(getTasksQueryParams *GetTasksQueryParams) (*GetTa
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getTasks")
+		return nil, response, newAPIError("getTasks", response)
 	}
 
 	result := response.Result().(*GetTasksResponse)
@@ -0,0 +1,131 @@
+package dnac
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// WaitForTaskOptions controls how WaitForTask polls a task.
+type WaitForTaskOptions struct {
+	PollInterval    time.Duration // Wait before the first poll, doubled after every poll. Defaults to 1 second
+	MaxPollInterval time.Duration // Upper bound of the wait between polls. Defaults to 10 seconds
+	CheckChildren   bool          // Walk the task tree and report failed child tasks
+}
+
+// TaskResult is the terminal state of a task.
+type TaskResult struct {
+	Task           GetTaskByIDResponseResponse   // The task once it ended
+	FailedChildren []GetTaskTreeResponseResponse // Child tasks that ended with an error, if CheckChildren was set
+}
+
+// TaskError is returned by WaitForTask when a task, or one of its children,
+// ended with an error.
+type TaskError struct {
+	TaskID         string
+	ErrorCode      string
+	FailureReason  string
+	Progress       string
+	FailedChildren []GetTaskTreeResponseResponse
+}
+
+// Error implements the error interface.
+func (e *TaskError) Error() string {
+	if e.ErrorCode == "" && e.FailureReason == "" && len(e.FailedChildren) > 0 {
+		child := e.FailedChildren[0]
+		return fmt.Sprintf("task %s failed: child task %s failed: %s %s", e.TaskID, child.ID, child.ErrorCode, child.FailureReason)
+	}
+	return fmt.Sprintf("task %s failed: %s %s", e.TaskID, e.ErrorCode, e.FailureReason)
+}
+
+// WaitForTask polls the task until it ends, either because its EndTime is set
+// or because it reports an error, and returns its terminal state. If the task
+// or, with CheckChildren, one of its child tasks failed, the result is
+// returned together with a *TaskError. opts may be nil to use the defaults.
+func (s *TaskService) WaitForTask(ctx context.Context, taskID string, opts *WaitForTaskOptions) (*TaskResult, error) {
+	pollInterval, maxPollInterval := pollIntervals(opts)
+	checkChildren := opts != nil && opts.CheckChildren
+
+	for {
+		timer := time.NewTimer(pollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+
+		task, _, err := s.GetTaskByIDWithContext(ctx, taskID)
+		if err != nil {
+			return nil, err
+		}
+		if task.Response.EndTime != 0 || task.Response.IsError {
+			return s.taskResult(ctx, taskID, task.Response, checkChildren)
+		}
+
+		pollInterval *= 2
+		if pollInterval > maxPollInterval {
+			pollInterval = maxPollInterval
+		}
+	}
+}
+
+// pollIntervals returns the first and the maximum interval between polls
+// set by opts, or their defaults.
+func pollIntervals(opts *WaitForTaskOptions) (time.Duration, time.Duration) {
+	pollInterval := time.Second
+	maxPollInterval := 10 * time.Second
+	if opts != nil {
+		if opts.PollInterval > 0 {
+			pollInterval = opts.PollInterval
+		}
+		if opts.MaxPollInterval > 0 {
+			maxPollInterval = opts.MaxPollInterval
+		}
+	}
+	return pollInterval, maxPollInterval
+}
+
+// taskResult builds the result of an ended task, looking up its failed
+// children if asked to.
+func (s *TaskService) taskResult(ctx context.Context, taskID string, task GetTaskByIDResponseResponse, checkChildren bool) (*TaskResult, error) {
+	result := &TaskResult{Task: task}
+	if checkChildren {
+		tree, _, err := s.GetTaskTreeWithContext(ctx, taskID)
+		if err != nil {
+			return result, err
+		}
+		for _, child := range tree.Response {
+			if child.ID != taskID && child.IsError {
+				result.FailedChildren = append(result.FailedChildren, child)
+			}
+		}
+	}
+	if task.IsError || len(result.FailedChildren) > 0 {
+		return result, &TaskError{
+			TaskID:         taskID,
+			ErrorCode:      task.ErrorCode,
+			FailureReason:  task.FailureReason,
+			Progress:       task.Progress,
+			FailedChildren: result.FailedChildren,
+		}
+	}
+	return result, nil
+}
+
+// taskFileID returns the ID of the file produced by a task, reported either
+// as {"fileId": ...} in its progress or as a /file/{fileId} additional status URL.
+func taskFileID(task GetTaskByIDResponseResponse) (string, error) {
+	var progress struct {
+		FileID string `json:"fileId"`
+	}
+	if json.Unmarshal([]byte(task.Progress), &progress) == nil && progress.FileID != "" {
+		return progress.FileID, nil
+	}
+	if i := strings.LastIndex(task.AdditionalStatusURL, "/file/"); i >= 0 {
+		return task.AdditionalStatusURL[i+len("/file/"):], nil
+	}
+	return "", fmt.Errorf("task %s did not report a file: %s", task.ID, task.Progress)
+}
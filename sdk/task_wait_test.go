@@ -0,0 +1,156 @@
+package dnac_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	dnac "github.com/cisco-en-programmability/dnacenter-go-sdk/sdk"
+	"github.com/cisco-en-programmability/dnacenter-go-sdk/sdk/dnactest"
+)
+
+const (
+	filePath        = "/dna/intent/api/v1/file/{fileId}"
+	readRequestPath = "/dna/intent/api/v1/network-device-poller/cli/read-request"
+)
+
+var fastPolls = &dnac.WaitForTaskOptions{PollInterval: time.Millisecond, MaxPollInterval: time.Millisecond}
+
+func taskServer(t *testing.T) (*dnactest.Server, *dnac.Client) {
+	t.Helper()
+	srv := dnactest.NewServer()
+	t.Cleanup(srv.Close)
+	client, err := srv.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return srv, client
+}
+
+func TestWaitForTaskPollsUntilEnd(t *testing.T) {
+	srv, client := taskServer(t)
+	id := srv.AddTask(dnactest.Task{PendingPolls: 2, Progress: "done", Data: "result"})
+
+	result, err := client.Task.WaitForTask(context.Background(), id, fastPolls)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Task.ID != id || result.Task.Data != "result" || result.Task.EndTime == 0 {
+		t.Errorf("got task %+v, want %s ended with its data", result.Task, id)
+	}
+	if polls := srv.TaskPolls(id); polls != 3 {
+		t.Errorf("got %d polls, want 3", polls)
+	}
+	srv.AssertNotCalled(t, http.MethodGet, "/dna/intent/api/v1/task/{taskId}/tree")
+}
+
+func TestWaitForTaskReportsFailure(t *testing.T) {
+	srv, client := taskServer(t)
+	id := srv.AddTask(dnactest.Task{PendingPolls: 1, IsError: true, ErrorCode: "NCND00001", FailureReason: "device unreachable"})
+
+	result, err := client.Task.WaitForTask(context.Background(), id, fastPolls)
+	var taskErr *dnac.TaskError
+	if !errors.As(err, &taskErr) {
+		t.Fatalf("got %v, want a *TaskError", err)
+	}
+	if taskErr.TaskID != id || taskErr.ErrorCode != "NCND00001" || taskErr.FailureReason != "device unreachable" {
+		t.Errorf("got %+v, want the failure of %s", taskErr, id)
+	}
+	if result == nil || !result.Task.IsError {
+		t.Errorf("got result %+v, want the failed task", result)
+	}
+}
+
+func TestWaitForTaskReportsFailedChildren(t *testing.T) {
+	srv, client := taskServer(t)
+	id := srv.AddTask(dnactest.Task{Children: []dnactest.Task{
+		{Progress: "step 1"},
+		{IsError: true, ErrorCode: "E2", FailureReason: "step 2 failed"},
+	}})
+
+	result, err := client.Task.WaitForTask(context.Background(), id, &dnac.WaitForTaskOptions{PollInterval: time.Millisecond, CheckChildren: true})
+	var taskErr *dnac.TaskError
+	if !errors.As(err, &taskErr) {
+		t.Fatalf("got %v, want a *TaskError", err)
+	}
+	if len(taskErr.FailedChildren) != 1 || len(result.FailedChildren) != 1 {
+		t.Fatalf("got failed children %+v, want step 2", taskErr.FailedChildren)
+	}
+	child := taskErr.FailedChildren[0]
+	if want := "task " + id + " failed: child task " + child.ID + " failed: E2 step 2 failed"; err.Error() != want {
+		t.Errorf("got %q, want %q", err.Error(), want)
+	}
+
+	// Without CheckChildren the failed child goes unnoticed.
+	if _, err := client.Task.WaitForTask(context.Background(), id, fastPolls); err != nil {
+		t.Errorf("got %v without CheckChildren, want nil", err)
+	}
+}
+
+func TestWaitForTaskStopsWithContext(t *testing.T) {
+	srv, client := taskServer(t)
+	id := srv.AddTask(dnactest.Task{PendingPolls: 1 << 20})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := client.Task.WaitForTask(ctx, id, fastPolls); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got %v, want the deadline of the context", err)
+	}
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	polls := srv.TaskPolls(id)
+	if _, err := client.Task.WaitForTask(cancelled, id, nil); !errors.Is(err, context.Canceled) {
+		t.Errorf("got %v, want the cancellation of the context", err)
+	}
+	if got := srv.TaskPolls(id); got != polls {
+		t.Errorf("polled %d times with a cancelled context, want none", got-polls)
+	}
+}
+
+func TestWaitForTaskUnknownTask(t *testing.T) {
+	_, client := taskServer(t)
+	if _, err := client.Task.WaitForTask(context.Background(), "unknown", fastPolls); !dnac.IsNotFound(err) {
+		t.Errorf("got %v, want a 404 error", err)
+	}
+}
+
+// The ID of the file produced by a task is read by the helpers that wait for
+// a task and download its file, such as CommandRunner.Run.
+func TestTaskFileID(t *testing.T) {
+	tests := []struct {
+		name   string
+		task   dnactest.Task
+		fileID string
+	}{
+		{"progress", dnactest.Task{Progress: `{"fileId":"f-progress"}`}, "f-progress"},
+		{"status URL", dnactest.Task{Progress: "done", AdditionalStatusURL: "/api/v1/file/f-url"}, "f-url"},
+		{"progress first", dnactest.Task{Progress: `{"fileId":"f-progress"}`, AdditionalStatusURL: "/api/v1/file/f-url"}, "f-progress"},
+		{"none", dnactest.Task{Progress: "done"}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, client := taskServer(t)
+			srv.RespondTask(http.MethodPost, readRequestPath, http.StatusAccepted, tt.task)
+			srv.RespondSequence(http.MethodGet, filePath, dnactest.Response{Body: "[]"})
+
+			_, err := client.CommandRunner.Run(context.Background(), []string{"d1"}, []string{"show version"}, &dnac.CommandRunnerOptions{Wait: fastPolls})
+			if tt.fileID == "" {
+				if err == nil || !strings.Contains(err.Error(), "did not report a file") {
+					t.Errorf("got %v, want an error for the missing file", err)
+				}
+				srv.AssertNotCalled(t, http.MethodGet, filePath)
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if r := srv.LastRequestTo(http.MethodGet, filePath); r == nil || r.Params["fileId"] != tt.fileID {
+				t.Errorf("got request %+v, want file %s", r, tt.fileID)
+			}
+		})
+	}
+}
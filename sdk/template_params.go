@@ -0,0 +1,395 @@
+package dnac
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// Data types of template parameters.
+const (
+	TemplateParamString         = "STRING"
+	TemplateParamInteger        = "INTEGER"
+	TemplateParamIPAddress      = "IPADDRESS"
+	TemplateParamMACAddress     = "MACADDRESS"
+	TemplateParamSectionDivider = "SECTIONDIVIDER"
+)
+
+// Selection types of template parameters.
+const (
+	TemplateParamSingleSelect = "SINGLE_SELECT"
+	TemplateParamMultiSelect  = "MULTI_SELECT"
+)
+
+// TemplateParam is a parameter declared by a template, as described by the
+// templateParams of CreateTemplateRequest and GetTemplateDetailsResponse.
+type TemplateParam struct {
+	ParameterName string                  `json:"parameterName,omitempty"`
+	DataType      string                  `json:"dataType,omitempty"`
+	DefaultValue  string                  `json:"defaultValue,omitempty"`
+	Binding       string                  `json:"binding,omitempty"` // Set when DNA Center resolves the value itself
+	NotParam      bool                    `json:"notParam,omitempty"`
+	ParamArray    bool                    `json:"paramArray,omitempty"`
+	Required      bool                    `json:"required,omitempty"`
+	Order         int                     `json:"order,omitempty"`
+	Range         []TemplateParamRange    `json:"range,omitempty"`
+	Selection     *TemplateParamSelection `json:"selection,omitempty"`
+}
+
+// TemplateParamRange is a range of values of an INTEGER parameter, or of
+// lengths of a STRING parameter.
+type TemplateParamRange struct {
+	MinValue int `json:"minValue,omitempty"`
+	MaxValue int `json:"maxValue,omitempty"`
+}
+
+// TemplateParamSelection restricts the values of a parameter to a list.
+// SelectionValues maps the labels of the values to the values.
+type TemplateParamSelection struct {
+	SelectionType   string                 `json:"selectionType,omitempty"`
+	SelectionValues map[string]interface{} `json:"selectionValues,omitempty"`
+}
+
+// TemplateParamsOfDetails returns the parameters declared by a template.
+func TemplateParamsOfDetails(details *GetTemplateDetailsResponse) ([]TemplateParam, error) {
+	return convertTemplateParams(details.TemplateParams)
+}
+
+// TemplateParamsOfRequest returns the parameters declared by a template
+// about to be created or updated.
+func TemplateParamsOfRequest(request *CreateTemplateRequest) ([]TemplateParam, error) {
+	return convertTemplateParams(request.TemplateParams)
+}
+
+// convertTemplateParams re-marshals the templateParams of a generated model,
+// which all have the JSON shape of TemplateParam.
+func convertTemplateParams(templateParams interface{}) ([]TemplateParam, error) {
+	var params []TemplateParam
+	b, err := json.Marshal(templateParams)
+	if err == nil {
+		err = json.Unmarshal(b, &params)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("template params: %w", err)
+	}
+	return params, nil
+}
+
+// TemplateParamViolation is a parameter value rejected by the validation.
+type TemplateParamViolation struct {
+	TemplateID string // Template deployed or previewed, empty for ValidateTemplateParams
+	Target     string // ID or host name of the deployment target, empty for a preview
+	Param      string
+	Reason     string
+}
+
+// String returns the violation as text.
+func (v TemplateParamViolation) String() string {
+	var prefix string
+	if v.TemplateID != "" {
+		prefix = "template " + v.TemplateID + ": "
+	}
+	if v.Target != "" {
+		prefix += v.Target + ": "
+	}
+	return fmt.Sprintf("%s%s: %s", prefix, v.Param, v.Reason)
+}
+
+// TemplateParamsError is returned when parameter values do not match the
+// parameters declared by a template. It holds every violation found,
+// including those of the member deployments of a DeployTemplateRequest.
+type TemplateParamsError struct {
+	TemplateID string // Template of the request validated
+	Violations []TemplateParamViolation
+}
+
+// Error implements the error interface.
+func (e *TemplateParamsError) Error() string {
+	var reasons []string
+	for _, v := range e.Violations {
+		reasons = append(reasons, v.String())
+	}
+	return fmt.Sprintf("template params: %d invalid parameter values: %s", len(e.Violations), strings.Join(reasons, "; "))
+}
+
+// ValidateTemplateParams checks values against the parameters declared by a
+// template: required parameters must have a value, and values must be of
+// the declared data type, within the declared ranges and among the selection
+// values. Values of undeclared parameters are rejected too. It returns a
+// *TemplateParamsError listing every violation, or nil.
+func ValidateTemplateParams(params []TemplateParam, values map[string]interface{}) error {
+	violations := checkTemplateParams(params, values, "", "")
+	if len(violations) > 0 {
+		return &TemplateParamsError{Violations: violations}
+	}
+	return nil
+}
+
+// ValidateDeployTemplate fetches the template to deploy, and the templates of
+// a composite template and of the member deployments, and checks the
+// parameter values of every target against their declarations. It returns a
+// single *TemplateParamsError listing the violations of the request and of
+// all its member deployments, each tagged with its template ID, or nil.
+func (s *ConfigurationTemplatesService) ValidateDeployTemplate(ctx context.Context, request *DeployTemplateRequest) error {
+	violations, err := s.deployTemplateViolations(ctx, request)
+	if err != nil {
+		return err
+	}
+	if len(violations) > 0 {
+		return &TemplateParamsError{TemplateID: request.TemplateID, Violations: violations}
+	}
+	return nil
+}
+
+// deployTemplateViolations returns the violations of the targets of request,
+// followed by those of its member deployments in order.
+func (s *ConfigurationTemplatesService) deployTemplateViolations(ctx context.Context, request *DeployTemplateRequest) ([]TemplateParamViolation, error) {
+	params, err := s.declaredTemplateParams(ctx, request.TemplateID)
+	if err != nil {
+		return nil, err
+	}
+	var violations []TemplateParamViolation
+	for _, target := range request.TargetInfo {
+		name := target.ID
+		if name == "" {
+			name = target.HostName
+		}
+		violations = append(violations, checkTemplateParams(params, target.Params, request.TemplateID, name)...)
+	}
+	if request.MemberTemplateDeploymentInfo != nil {
+		for i := range *request.MemberTemplateDeploymentInfo {
+			memberViolations, err := s.deployTemplateViolations(ctx, &(*request.MemberTemplateDeploymentInfo)[i])
+			if err != nil {
+				return nil, err
+			}
+			violations = append(violations, memberViolations...)
+		}
+	}
+	return violations, nil
+}
+
+// ValidatePreviewTemplate fetches the template to preview and checks the
+// parameter values against its declarations. It returns a
+// *TemplateParamsError listing every violation, or nil.
+func (s *ConfigurationTemplatesService) ValidatePreviewTemplate(ctx context.Context, request *PreviewTemplateRequest) error {
+	params, err := s.declaredTemplateParams(ctx, request.TemplateID)
+	if err != nil {
+		return err
+	}
+	if violations := checkTemplateParams(params, request.Params, request.TemplateID, ""); len(violations) > 0 {
+		return &TemplateParamsError{TemplateID: request.TemplateID, Violations: violations}
+	}
+	return nil
+}
+
+// DeployTemplateChecked is DeployTemplateWithContext preceded by
+// ValidateDeployTemplate. The request is only sent if its parameter values
+// are valid; otherwise the *TemplateParamsError is returned.
+func (s *ConfigurationTemplatesService) DeployTemplateChecked(ctx context.Context, request *DeployTemplateRequest) (*DeployTemplateResponse, *resty.Response, error) {
+	if err := s.ValidateDeployTemplate(ctx, request); err != nil {
+		return nil, nil, err
+	}
+	return s.DeployTemplateWithContext(ctx, request)
+}
+
+// PreviewTemplateChecked is PreviewTemplateWithContext preceded by
+// ValidatePreviewTemplate. The request is only sent if its parameter values
+// are valid; otherwise the *TemplateParamsError is returned.
+func (s *ConfigurationTemplatesService) PreviewTemplateChecked(ctx context.Context, request *PreviewTemplateRequest) (*PreviewTemplateResponse, *resty.Response, error) {
+	if err := s.ValidatePreviewTemplate(ctx, request); err != nil {
+		return nil, nil, err
+	}
+	return s.PreviewTemplateWithContext(ctx, request)
+}
+
+// declaredTemplateParams returns the parameters of a template, including
+// those of its member templates if it is composite.
+func (s *ConfigurationTemplatesService) declaredTemplateParams(ctx context.Context, templateID string) ([]TemplateParam, error) {
+	details, _, err := s.GetTemplateDetailsWithContext(ctx, templateID, nil)
+	if err != nil {
+		return nil, err
+	}
+	params, err := TemplateParamsOfDetails(details)
+	if err != nil {
+		return nil, err
+	}
+	if !details.Composite {
+		return params, nil
+	}
+	seen := map[string]bool{}
+	for _, param := range params {
+		seen[param.ParameterName] = true
+	}
+	for _, member := range details.ContainingTemplates {
+		memberParams, err := s.declaredTemplateParams(ctx, member.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, param := range memberParams {
+			if !seen[param.ParameterName] {
+				seen[param.ParameterName] = true
+				params = append(params, param)
+			}
+		}
+	}
+	return params, nil
+}
+
+// checkTemplateParams returns the violations of values, in the order of the
+// declarations followed by the undeclared parameters by name.
+func checkTemplateParams(params []TemplateParam, values map[string]interface{}, templateID string, target string) []TemplateParamViolation {
+	declared := append([]TemplateParam(nil), params...)
+	sort.SliceStable(declared, func(i, j int) bool { return declared[i].Order < declared[j].Order })
+
+	var violations []TemplateParamViolation
+	add := func(param string, format string, args ...interface{}) {
+		violations = append(violations, TemplateParamViolation{TemplateID: templateID, Target: target, Param: param, Reason: fmt.Sprintf(format, args...)})
+	}
+
+	known := map[string]bool{}
+	for _, param := range declared {
+		known[param.ParameterName] = true
+		if param.NotParam || strings.EqualFold(param.DataType, TemplateParamSectionDivider) {
+			continue
+		}
+		value, ok := values[param.ParameterName]
+		if !ok || value == nil {
+			if param.Required && param.DefaultValue == "" && param.Binding == "" {
+				add(param.ParameterName, "required")
+			}
+			continue
+		}
+
+		elements := []interface{}{value}
+		if param.ParamArray || isMultiSelect(param) {
+			list, ok := value.([]interface{})
+			if !ok {
+				if texts, isTexts := value.([]string); isTexts {
+					for _, s := range texts {
+						list = append(list, s)
+					}
+					ok = true
+				}
+			}
+			if !ok {
+				add(param.ParameterName, "expected a list, got %T", value)
+				continue
+			}
+			elements = list
+		}
+		for _, element := range elements {
+			if reason := checkTemplateParamValue(param, element); reason != "" {
+				add(param.ParameterName, "%s", reason)
+			}
+		}
+	}
+
+	var unknown []string
+	for name := range values {
+		if !known[name] {
+			unknown = append(unknown, name)
+		}
+	}
+	sort.Strings(unknown)
+	for _, name := range unknown {
+		add(name, "not a parameter of the template")
+	}
+	return violations
+}
+
+func isMultiSelect(param TemplateParam) bool {
+	return param.Selection != nil && strings.EqualFold(param.Selection.SelectionType, TemplateParamMultiSelect)
+}
+
+// checkTemplateParamValue returns why a single value does not match the
+// parameter, or an empty string.
+func checkTemplateParamValue(param TemplateParam, value interface{}) string {
+	switch strings.ToUpper(param.DataType) {
+	case TemplateParamInteger:
+		n, ok := templateParamInteger(value)
+		if !ok {
+			return fmt.Sprintf("%v is not an integer", value)
+		}
+		if len(param.Range) > 0 && !inTemplateParamRanges(param.Range, n) {
+			return fmt.Sprintf("%d is not in %s", n, formatTemplateParamRanges(param.Range))
+		}
+	case TemplateParamIPAddress:
+		s, ok := value.(string)
+		if !ok || net.ParseIP(s) == nil {
+			return fmt.Sprintf("%v is not an IP address", value)
+		}
+	case TemplateParamMACAddress:
+		s, ok := value.(string)
+		if _, err := net.ParseMAC(s); !ok || err != nil {
+			return fmt.Sprintf("%v is not a MAC address", value)
+		}
+	case TemplateParamString, "":
+		s, ok := value.(string)
+		if !ok {
+			return fmt.Sprintf("expected a string, got %T", value)
+		}
+		if len(param.Range) > 0 && !inTemplateParamRanges(param.Range, len(s)) {
+			return fmt.Sprintf("length %d of %q is not in %s", len(s), s, formatTemplateParamRanges(param.Range))
+		}
+	}
+
+	if param.Selection != nil && len(param.Selection.SelectionValues) > 0 {
+		text := fmt.Sprint(value)
+		for label, allowed := range param.Selection.SelectionValues {
+			if text == label || text == fmt.Sprint(allowed) {
+				return ""
+			}
+		}
+		return fmt.Sprintf("%v is not one of the selection values", value)
+	}
+	return ""
+}
+
+// templateParamInteger returns value as an integer. JSON numbers and numeric
+// strings are accepted, as DNA Center accepts both.
+func templateParamInteger(value interface{}) (int, bool) {
+	switch v := value.(type) {
+	case int:
+		return v, true
+	case int32:
+		return int(v), true
+	case int64:
+		return int(v), true
+	case float64:
+		if v != math.Trunc(v) {
+			return 0, false
+		}
+		return int(v), true
+	case json.Number:
+		n, err := strconv.Atoi(v.String())
+		return n, err == nil
+	case string:
+		n, err := strconv.Atoi(strings.TrimSpace(v))
+		return n, err == nil
+	}
+	return 0, false
+}
+
+func inTemplateParamRanges(ranges []TemplateParamRange, n int) bool {
+	for _, r := range ranges {
+		if n >= r.MinValue && n <= r.MaxValue {
+			return true
+		}
+	}
+	return false
+}
+
+func formatTemplateParamRanges(ranges []TemplateParamRange) string {
+	var parts []string
+	for _, r := range ranges {
+		parts = append(parts, fmt.Sprintf("[%d, %d]", r.MinValue, r.MaxValue))
+	}
+	return strings.Join(parts, " or ")
+}
@@ -0,0 +1,146 @@
+package dnac_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	dnac "github.com/cisco-en-programmability/dnacenter-go-sdk/sdk"
+	"github.com/cisco-en-programmability/dnacenter-go-sdk/sdk/dnactest"
+)
+
+const (
+	templatePath        = "/dna/intent/api/v1/template-programmer/template/{templateId}"
+	templateDeployPath  = "/dna/intent/api/v1/template-programmer/template/deploy"
+	templatePreviewPath = "/dna/intent/api/v1/template-programmer/template/preview"
+)
+
+// templateServer returns a server where every template declares a required
+// INTEGER parameter vlan.
+func templateServer(t *testing.T) (*dnactest.Server, *dnac.Client) {
+	t.Helper()
+	srv := dnactest.NewServer()
+	t.Cleanup(srv.Close)
+	srv.HandleFunc(http.MethodGet, templatePath, func(w http.ResponseWriter, r *http.Request) {
+		dnactest.WriteJSON(w, http.StatusOK, map[string]interface{}{
+			"id":             dnactest.PathParam(r, "templateId"),
+			"templateParams": []map[string]interface{}{{"parameterName": "vlan", "dataType": "INTEGER", "required": true}},
+		})
+	})
+	client, err := srv.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return srv, client
+}
+
+func TestValidateDeployTemplateCollectsMemberViolations(t *testing.T) {
+	srv, client := templateServer(t)
+	request := &dnac.DeployTemplateRequest{
+		TemplateID: "main",
+		TargetInfo: []dnac.DeployTemplateRequestTargetInfo{{ID: "10.10.20.51", Params: map[string]interface{}{"vlan": "ten"}}},
+		MemberTemplateDeploymentInfo: &[]dnac.DeployTemplateRequest{
+			{TemplateID: "member-1", TargetInfo: []dnac.DeployTemplateRequestTargetInfo{{ID: "10.10.20.52", Params: map[string]interface{}{"vlan": 20}}}},
+			{TemplateID: "member-2", TargetInfo: []dnac.DeployTemplateRequestTargetInfo{{HostName: "edge-1", Params: map[string]interface{}{}}}},
+		},
+	}
+
+	err := client.ConfigurationTemplates.ValidateDeployTemplate(context.Background(), request)
+	var paramsErr *dnac.TemplateParamsError
+	if !errors.As(err, &paramsErr) {
+		t.Fatalf("got %v, want a *TemplateParamsError", err)
+	}
+	want := []dnac.TemplateParamViolation{
+		{TemplateID: "main", Target: "10.10.20.51", Param: "vlan", Reason: "ten is not an integer"},
+		{TemplateID: "member-2", Target: "edge-1", Param: "vlan", Reason: "required"},
+	}
+	if len(paramsErr.Violations) != len(want) {
+		t.Fatalf("got violations %v, want %v", paramsErr.Violations, want)
+	}
+	for i := range want {
+		if paramsErr.Violations[i] != want[i] {
+			t.Errorf("violation %d: got %v, want %v", i, paramsErr.Violations[i], want[i])
+		}
+	}
+	if paramsErr.TemplateID != "main" {
+		t.Errorf("got template %q, want main", paramsErr.TemplateID)
+	}
+	if got, want := err.Error(), "template params: 2 invalid parameter values: template main: 10.10.20.51: vlan: ten is not an integer; template member-2: edge-1: vlan: required"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	srv.AssertCallCount(t, http.MethodGet, templatePath, 3)
+}
+
+func TestValidateDeployTemplateAcceptsValidMembers(t *testing.T) {
+	_, client := templateServer(t)
+	request := &dnac.DeployTemplateRequest{
+		TemplateID:                   "main",
+		TargetInfo:                   []dnac.DeployTemplateRequestTargetInfo{{ID: "10.10.20.51", Params: map[string]interface{}{"vlan": 10}}},
+		MemberTemplateDeploymentInfo: &[]dnac.DeployTemplateRequest{{TemplateID: "member-1"}},
+	}
+	if err := client.ConfigurationTemplates.ValidateDeployTemplate(context.Background(), request); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDeployTemplateCheckedSendsValidRequests(t *testing.T) {
+	srv, client := templateServer(t)
+	srv.RespondJSON(http.MethodPost, templateDeployPath, http.StatusAccepted, map[string]string{"deploymentId": "dep-1"})
+
+	invalid := &dnac.DeployTemplateRequest{TemplateID: "main", TargetInfo: []dnac.DeployTemplateRequestTargetInfo{{ID: "10.10.20.51"}}}
+	_, _, err := client.ConfigurationTemplates.DeployTemplateChecked(context.Background(), invalid)
+	var paramsErr *dnac.TemplateParamsError
+	if !errors.As(err, &paramsErr) || len(paramsErr.Violations) != 1 {
+		t.Fatalf("got %v, want the missing vlan", err)
+	}
+	srv.AssertNotCalled(t, http.MethodPost, templateDeployPath)
+
+	valid := &dnac.DeployTemplateRequest{TemplateID: "main", TargetInfo: []dnac.DeployTemplateRequestTargetInfo{{ID: "10.10.20.51", Params: map[string]interface{}{"vlan": 10}}}}
+	result, _, err := client.ConfigurationTemplates.DeployTemplateChecked(context.Background(), valid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.DeploymentID != "dep-1" {
+		t.Errorf("got deployment %q, want dep-1", result.DeploymentID)
+	}
+	srv.AssertCallCount(t, http.MethodPost, templateDeployPath, 1)
+}
+
+func TestPreviewTemplateCheckedSendsValidRequests(t *testing.T) {
+	srv, client := templateServer(t)
+	srv.RespondJSON(http.MethodPut, templatePreviewPath, http.StatusOK, map[string]string{"cliPreview": "vlan 10"})
+
+	_, _, err := client.ConfigurationTemplates.PreviewTemplateChecked(context.Background(), &dnac.PreviewTemplateRequest{TemplateID: "main", Params: map[string]interface{}{"vlan": 5000.5}})
+	var paramsErr *dnac.TemplateParamsError
+	if !errors.As(err, &paramsErr) {
+		t.Fatalf("got %v, want a *TemplateParamsError", err)
+	}
+	srv.AssertNotCalled(t, http.MethodPut, templatePreviewPath)
+
+	result, _, err := client.ConfigurationTemplates.PreviewTemplateChecked(context.Background(), &dnac.PreviewTemplateRequest{TemplateID: "main", Params: map[string]interface{}{"vlan": 10}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.CliPreview != "vlan 10" {
+		t.Errorf("got preview %q, want vlan 10", result.CliPreview)
+	}
+	srv.AssertJSONBody(t, http.MethodPut, templatePreviewPath, map[string]interface{}{"templateId": "main", "params": map[string]interface{}{"vlan": 10}})
+}
+
+func TestValidateTemplateParamsOffline(t *testing.T) {
+	params, err := dnac.TemplateParamsOfRequest(&dnac.CreateTemplateRequest{
+		TemplateParams: []dnac.CreateTemplateRequestTemplateParams{{ParameterName: "hostname", DataType: "STRING", Required: dnac.Bool(true)}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = dnac.ValidateTemplateParams(params, map[string]interface{}{"vlan": 10})
+	var paramsErr *dnac.TemplateParamsError
+	if !errors.As(err, &paramsErr) {
+		t.Fatalf("got %v, want a *TemplateParamsError", err)
+	}
+	if got, want := err.Error(), "template params: 2 invalid parameter values: hostname: required; vlan: not a parameter of the template"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
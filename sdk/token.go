@@ -0,0 +1,221 @@
+package dnac
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+const (
+	authTokenHeader = "X-Auth-Token"
+	authTokenPath   = "/dna/system/api/v1/auth/token"
+
+	// defaultTokenLifetime is how long DNA Center considers an access token valid.
+	defaultTokenLifetime = time.Hour
+	// tokenRefreshMargin is how long before expiry a token is proactively refreshed.
+	tokenRefreshMargin = 5 * time.Minute
+)
+
+// Token is an access token sent in the X-Auth-Token header.
+type Token struct {
+	Value     string    // The access token
+	ExpiresAt time.Time // When the token stops being valid, zero if unknown
+}
+
+// TokenProvider supplies the access tokens used by a Client. Token is called
+// when the client has no token yet, when the cached one is about to expire and
+// after DNA Center rejected the cached one with 401.
+type TokenProvider interface {
+	Token(ctx context.Context) (Token, error)
+}
+
+// StaticTokenProvider always returns the same token, which never expires.
+type StaticTokenProvider string
+
+// Token returns the static token.
+func (p StaticTokenProvider) Token(ctx context.Context) (Token, error) {
+	return Token{Value: string(p)}, nil
+}
+
+// PasswordTokenProvider obtains tokens from DNA Center with basic-auth
+// credentials through AuthenticationService.AuthenticationAPI.
+type PasswordTokenProvider struct {
+	Authentication *AuthenticationService
+	Username       string
+	Password       string
+	Lifetime       time.Duration // How long an issued token is valid
+}
+
+// NewPasswordTokenProvider creates a PasswordTokenProvider for the given
+// credentials, assuming the default DNA Center token lifetime of one hour.
+func NewPasswordTokenProvider(authentication *AuthenticationService, username string, password string) *PasswordTokenProvider {
+	return &PasswordTokenProvider{
+		Authentication: authentication,
+		Username:       username,
+		Password:       password,
+		Lifetime:       defaultTokenLifetime,
+	}
+}
+
+// Token authenticates against DNA Center and returns the issued token.
+func (p *PasswordTokenProvider) Token(ctx context.Context) (Token, error) {
+	issuedAt := time.Now()
+	result, response, err := p.Authentication.AuthenticationAPIWithContext(ctx, p.Username, p.Password)
+	if err != nil {
+		return Token{}, err
+	}
+	if response.IsError() {
+		return Token{}, newAPIError("authenticationAPI", response)
+	}
+	return Token{Value: result.Token, ExpiresAt: issuedAt.Add(p.Lifetime)}, nil
+}
+
+// tokenManager caches the token of a TokenProvider, refreshes it before it
+// expires and makes sure concurrent callers share a single refresh.
+type tokenManager struct {
+	mu       sync.Mutex
+	token    Token
+	provider TokenProvider
+	refresh  *tokenRefresh // In progress, nil if none
+}
+
+// tokenRefresh is a call to the provider shared by the callers of get. done
+// is closed once token and err are set.
+type tokenRefresh struct {
+	done  chan struct{}
+	token Token
+	err   error
+}
+
+// get returns a valid token, asking the provider for a new one if the cached
+// one is missing or about to expire. The mutex is not held while the
+// provider is called: callers arriving during a refresh wait for it, or for
+// their own context to end.
+func (t *tokenManager) get(ctx context.Context) (string, error) {
+	for {
+		t.mu.Lock()
+		if t.token.Value != "" && !t.expiring() {
+			token := t.token.Value
+			t.mu.Unlock()
+			return token, nil
+		}
+		r := t.refresh
+		if r == nil {
+			r = &tokenRefresh{done: make(chan struct{})}
+			t.refresh = r
+			provider := t.provider
+			t.mu.Unlock()
+			t.run(ctx, r, provider)
+			if r.err != nil {
+				return "", r.err
+			}
+			return r.token.Value, nil
+		}
+		t.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-r.done:
+		}
+		if r.err == nil {
+			return r.token.Value, nil
+		}
+		// The refresh was cancelled with the context of the caller that
+		// started it, not with ours: start another one.
+		if ctx.Err() == nil && (errors.Is(r.err, context.Canceled) || errors.Is(r.err, context.DeadlineExceeded)) {
+			continue
+		}
+		return "", r.err
+	}
+}
+
+// run calls the provider for r and caches the token, unless the provider was
+// replaced in the meantime.
+func (t *tokenManager) run(ctx context.Context, r *tokenRefresh, provider TokenProvider) {
+	defer close(r.done)
+	defer func() {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		if t.refresh == r {
+			t.refresh = nil
+			if r.err == nil {
+				t.token = r.token
+			}
+		}
+	}()
+	// Left for the waiters if the provider panics.
+	r.err = errors.New("dnac: token provider panicked")
+	r.token, r.err = provider.Token(ctx)
+}
+
+// expiring reports whether the cached token expires within the refresh margin.
+func (t *tokenManager) expiring() bool {
+	if t.token.ExpiresAt.IsZero() {
+		return false
+	}
+	return time.Until(t.token.ExpiresAt) < tokenRefreshMargin
+}
+
+// invalidate discards token if it is still the cached one, so the next call
+// to get asks the provider again. Tokens already replaced by a concurrent
+// refresh are left alone.
+func (t *tokenManager) invalidate(token string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.token.Value == token {
+		t.token = Token{}
+	}
+}
+
+// setProvider replaces the provider and drops the cached token.
+func (t *tokenManager) setProvider(provider TokenProvider) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.provider = provider
+	t.token = Token{}
+	t.refresh = nil
+}
+
+// isAuthRequest reports whether r is the token request itself, which must
+// not carry or trigger a token refresh.
+func isAuthRequest(r *resty.Request) bool {
+	return strings.HasSuffix(r.URL, authTokenPath)
+}
+
+// setAuthTokenHeader is a request middleware that attaches a valid token to
+// every request.
+func (s *Client) setAuthTokenHeader(_ *resty.Client, r *resty.Request) error {
+	if isAuthRequest(r) {
+		return nil
+	}
+	token, err := s.tokens.get(r.Context())
+	if err != nil {
+		return err
+	}
+	r.SetHeader(authTokenHeader, token)
+	return nil
+}
+
+type replayedKey struct{}
+
+// retryUnauthorized is a retry condition that fetches a new token and replays
+// a request once when DNA Center rejects its token.
+func (s *Client) retryUnauthorized(r *resty.Response, err error) bool {
+	if err != nil || r == nil || r.StatusCode() != 401 || isAuthRequest(r.Request) {
+		return false
+	}
+	ctx := r.Request.Context()
+	if ctx.Value(replayedKey{}) != nil {
+		return false
+	}
+	s.tokens.invalidate(r.Request.Header.Get(authTokenHeader))
+	r.Request.SetContext(context.WithValue(ctx, replayedKey{}, true))
+	return true
+}
@@ -0,0 +1,68 @@
+package dnac_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	dnac "github.com/cisco-en-programmability/dnacenter-go-sdk/sdk"
+	"github.com/cisco-en-programmability/dnacenter-go-sdk/sdk/dnactest"
+)
+
+// slowTokenProvider issues tokens that are always about to expire, so that
+// every request refreshes them. Refreshes after the first block until
+// release is closed.
+type slowTokenProvider struct {
+	srv     *dnactest.Server
+	calls   int32
+	started chan struct{}
+	release chan struct{}
+}
+
+func (p *slowTokenProvider) Token(ctx context.Context) (dnac.Token, error) {
+	if atomic.AddInt32(&p.calls, 1) > 1 {
+		p.started <- struct{}{}
+		<-p.release
+	}
+	return dnac.Token{Value: p.srv.Token(), ExpiresAt: time.Now()}, nil
+}
+
+func TestTokenWaitEndsWithContext(t *testing.T) {
+	srv := dnactest.NewServer()
+	defer srv.Close()
+	srv.RespondJSON(http.MethodGet, devicePath, http.StatusOK, map[string]interface{}{"response": map[string]string{"id": "d1"}})
+	provider := &slowTokenProvider{srv: srv, started: make(chan struct{}, 1), release: make(chan struct{})}
+	client, err := srv.Client(dnac.WithTokenProvider(provider))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	refreshed := make(chan error, 1)
+	go func() {
+		_, _, err := client.Devices.GetDeviceByIDWithContext(context.Background(), "d1")
+		refreshed <- err
+	}()
+	<-provider.started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, _, err = client.Devices.GetDeviceByIDWithContext(ctx, "d1")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got %v, want the deadline of the context", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("waited %v for the refresh, want to stop at the deadline", elapsed)
+	}
+
+	close(provider.release)
+	if err := <-refreshed; err != nil {
+		t.Fatal(err)
+	}
+	if calls := atomic.LoadInt32(&provider.calls); calls != 2 {
+		t.Errorf("provider called %d times, want the waiter to share the refresh", calls)
+	}
+}
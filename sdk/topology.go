@@ -1,6 +1,7 @@
 package dnac
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -342,11 +343,17 @@ type GetVLANDetailsResponseResponse []string
 @param topologyType Type of topology(OSPF,ISIS,etc)
 */
 func (s *TopologyService) GetL3TopologyDetails(topologyType string) (*GetL3TopologyDetailsResponse, *resty.Response, error) {
+	return s.GetL3TopologyDetailsWithContext(context.Background(), topologyType)
+}
+
+// GetL3TopologyDetailsWithContext is like GetL3TopologyDetails but sends the request with ctx for cancellation and deadlines.
+func (s *TopologyService) GetL3TopologyDetailsWithContext(ctx context.Context, topologyType string) (*GetL3TopologyDetailsResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/topology/l3/{topologyType}"
 	path = strings.Replace(path, "{"+"topologyType"+"}", fmt.Sprintf("%v", topologyType), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&GetL3TopologyDetailsResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -356,7 +363,7 @@ func (s *TopologyService) GetL3TopologyDetails(topologyType string) (*GetL3Topol
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getL3TopologyDetails")
+		return nil, response, newAPIError("getL3TopologyDetails", response)
 	}
 
 	result := response.Result().(*GetL3TopologyDetailsResponse)
@@ -373,12 +380,18 @@ type GetOverallNetworkHealthQueryParams struct {
 @param timestamp Epoch time(in milliseconds) when the Network health data is required
 */
 func (s *TopologyService) GetOverallNetworkHealth(getOverallNetworkHealthQueryParams *GetOverallNetworkHealthQueryParams) (*GetOverallNetworkHealthResponse, *resty.Response, error) {
+	return s.GetOverallNetworkHealthWithContext(context.Background(), getOverallNetworkHealthQueryParams)
+}
+
+// GetOverallNetworkHealthWithContext is like GetOverallNetworkHealth but sends the request with ctx for cancellation and deadlines.
+func (s *TopologyService) GetOverallNetworkHealthWithContext(ctx context.Context, getOverallNetworkHealthQueryParams *GetOverallNetworkHealthQueryParams) (*GetOverallNetworkHealthResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/network-health"
 
 	queryString, _ := query.Values(getOverallNetworkHealthQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetOverallNetworkHealthResponse{}).
 		SetError(&Error{}).
@@ -389,7 +402,7 @@ func (s *TopologyService) GetOverallNetworkHealth(getOverallNetworkHealthQueryPa
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getOverallNetworkHealth")
+		return nil, response, newAPIError("getOverallNetworkHealth", response)
 	}
 
 	result := response.Result().(*GetOverallNetworkHealthResponse)
@@ -406,12 +419,18 @@ type GetPhysicalTopologyQueryParams struct {
 @param nodeType nodeType
 */
 func (s *TopologyService) GetPhysicalTopology(getPhysicalTopologyQueryParams *GetPhysicalTopologyQueryParams) (*GetPhysicalTopologyResponse, *resty.Response, error) {
+	return s.GetPhysicalTopologyWithContext(context.Background(), getPhysicalTopologyQueryParams)
+}
+
+// GetPhysicalTopologyWithContext is like GetPhysicalTopology but sends the request with ctx for cancellation and deadlines.
+func (s *TopologyService) GetPhysicalTopologyWithContext(ctx context.Context, getPhysicalTopologyQueryParams *GetPhysicalTopologyQueryParams) (*GetPhysicalTopologyResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/topology/physical-topology"
 
 	queryString, _ := query.Values(getPhysicalTopologyQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetPhysicalTopologyResponse{}).
 		SetError(&Error{}).
@@ -422,7 +441,7 @@ func (s *TopologyService) GetPhysicalTopology(getPhysicalTopologyQueryParams *Ge
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getPhysicalTopology")
+		return nil, response, newAPIError("getPhysicalTopology", response)
 	}
 
 	result := response.Result().(*GetPhysicalTopologyResponse)
@@ -433,10 +452,16 @@ func (s *TopologyService) GetPhysicalTopology(getPhysicalTopologyQueryParams *Ge
 /* Returns site topology
  */
 func (s *TopologyService) GetSiteTopology() (*GetSiteTopologyResponse, *resty.Response, error) {
+	return s.GetSiteTopologyWithContext(context.Background())
+}
+
+// GetSiteTopologyWithContext is like GetSiteTopology but sends the request with ctx for cancellation and deadlines.
+func (s *TopologyService) GetSiteTopologyWithContext(ctx context.Context) (*GetSiteTopologyResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/topology/site-topology"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&GetSiteTopologyResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -446,7 +471,7 @@ func (s *TopologyService) GetSiteTopology() (*GetSiteTopologyResponse, *resty.Re
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getSiteTopology")
+		return nil, response, newAPIError("getSiteTopology", response)
 	}
 
 	result := response.Result().(*GetSiteTopologyResponse)
@@ -458,11 +483,17 @@ func (s *TopologyService) GetSiteTopology() (*GetSiteTopologyResponse, *resty.Re
 @param vlanID Vlan Name for e.g Vlan1, Vlan23 etc
 */
 func (s *TopologyService) GetTopologyDetails(vlanID string) (*GetTopologyDetailsResponse, *resty.Response, error) {
+	return s.GetTopologyDetailsWithContext(context.Background(), vlanID)
+}
+
+// GetTopologyDetailsWithContext is like GetTopologyDetails but sends the request with ctx for cancellation and deadlines.
+func (s *TopologyService) GetTopologyDetailsWithContext(ctx context.Context, vlanID string) (*GetTopologyDetailsResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/topology/l2/{vlanID}"
 	path = strings.Replace(path, "{"+"vlanID"+"}", fmt.Sprintf("%v", vlanID), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&GetTopologyDetailsResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -472,7 +503,7 @@ func (s *TopologyService) GetTopologyDetails(vlanID string) (*GetTopologyDetails
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getTopologyDetails")
+		return nil, response, newAPIError("getTopologyDetails", response)
 	}
 
 	result := response.Result().(*GetTopologyDetailsResponse)
@@ -483,10 +514,16 @@ func (s *TopologyService) GetTopologyDetails(vlanID string) (*GetTopologyDetails
 /* Returns the list of VLAN names
  */
 func (s *TopologyService) GetVLANDetails() (*GetVLANDetailsResponse, *resty.Response, error) {
+	return s.GetVLANDetailsWithContext(context.Background())
+}
+
+// GetVLANDetailsWithContext is like GetVLANDetails but sends the request with ctx for cancellation and deadlines.
+func (s *TopologyService) GetVLANDetailsWithContext(ctx context.Context) (*GetVLANDetailsResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/topology/vlan/vlan-names"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&GetVLANDetailsResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -496,7 +533,7 @@ func (s *TopologyService) GetVLANDetails() (*GetVLANDetailsResponse, *resty.Resp
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getVLANDetails")
+		return nil, response, newAPIError("getVLANDetails", response)
 	}
 
 	result := response.Result().(*GetVLANDetailsResponse)
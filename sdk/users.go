@@ -1,7 +1,7 @@
 package dnac
 
 import (
-	"fmt"
+	"context"
 
 	"github.com/go-resty/resty/v2"
 )
@@ -141,10 +141,16 @@ type GetUserEnrichmentDetailsResponseUserDetailsOnboarding struct {
 @param entity_value Contains the actual value for the entity type that has been defined
 */
 func (s *UsersService) GetUserEnrichmentDetails() (*GetUserEnrichmentDetailsResponse, *resty.Response, error) {
+	return s.GetUserEnrichmentDetailsWithContext(context.Background())
+}
+
+// GetUserEnrichmentDetailsWithContext is like GetUserEnrichmentDetails but sends the request with ctx for cancellation and deadlines.
+func (s *UsersService) GetUserEnrichmentDetailsWithContext(ctx context.Context) (*GetUserEnrichmentDetailsResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/user-enrichment-details"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&GetUserEnrichmentDetailsResponse{}).
 		SetError(&Error{}).
 		Get(path)
@@ -154,7 +160,7 @@ func (s *UsersService) GetUserEnrichmentDetails() (*GetUserEnrichmentDetailsResp
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getUserEnrichmentDetails")
+		return nil, response, newAPIError("getUserEnrichmentDetails", response)
 	}
 
 	result := response.Result().(*GetUserEnrichmentDetailsResponse)
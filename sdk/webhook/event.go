@@ -0,0 +1,204 @@
+// Package webhook receives the event notifications that Cisco DNA Center
+// pushes to the REST endpoints registered with
+// EventManagementService.CreateEventSubscriptions.
+//
+// A Handler is an http.Handler that checks the credentials of the requests,
+// parses their payloads into Events and dispatches them to the functions
+// registered for their event ID or category:
+//
+//	h := webhook.NewHandler(webhook.WithBasicAuth("dnac", "secret"))
+//	h.HandleEvent("NETWORK-DEVICES-3-506", func(ctx context.Context, event *webhook.Event) error {
+//		log.Printf("%s is unreachable", event.Detail("Device"))
+//		return nil
+//	})
+//	h.HandleCategory(webhook.CategoryAlert, alert)
+//	http.Handle("/dnac/events", h)
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Categories of events.
+const (
+	CategoryError        = "ERROR"
+	CategoryWarn         = "WARN"
+	CategoryInfo         = "INFO"
+	CategoryAlert        = "ALERT"
+	CategoryTaskProgress = "TASK_PROGRESS"
+	CategoryTaskComplete = "TASK_COMPLETE"
+)
+
+// Event is an event notification of DNA Center.
+type Event struct {
+	Version     string                 `json:"version,omitempty"`
+	InstanceID  string                 `json:"instanceId,omitempty"`
+	EventID     string                 `json:"eventId,omitempty"` // e.g. NETWORK-DEVICES-3-506
+	Namespace   string                 `json:"namespace,omitempty"`
+	Name        string                 `json:"name,omitempty"`
+	Description string                 `json:"description,omitempty"`
+	Type        string                 `json:"type,omitempty"` // e.g. NETWORK, APP, SYSTEM
+	Category    string                 `json:"category,omitempty"`
+	Domain      string                 `json:"domain,omitempty"`
+	SubDomain   string                 `json:"subDomain,omitempty"`
+	Severity    int                    `json:"severity,omitempty"` // From 1, the highest, to 5
+	Source      string                 `json:"source,omitempty"`
+	Timestamp   int64                  `json:"timestamp,omitempty"` // Milliseconds since the epoch
+	Tags        []string               `json:"tags,omitempty"`
+	Details     map[string]interface{} `json:"details,omitempty"`
+	Link        string                 `json:"ciscoDnaEventLink,omitempty"`
+	Note        string                 `json:"note,omitempty"`
+	TenantID    string                 `json:"tntId,omitempty"`
+	Network     *Network               `json:"network,omitempty"`
+
+	// Raw is the payload of the event as received.
+	Raw json.RawMessage `json:"-"`
+}
+
+// Network identifies the site and network device an event is about.
+type Network struct {
+	SiteID   string `json:"siteId,omitempty"`
+	DeviceID string `json:"deviceId,omitempty"`
+}
+
+// Time returns the time of the event.
+func (e *Event) Time() time.Time {
+	return time.Unix(0, e.Timestamp*int64(time.Millisecond))
+}
+
+// Detail returns a detail of the event as text, e.g. Detail("Device") for the
+// address of the device of an Assurance issue, or an empty string.
+func (e *Event) Detail(key string) string {
+	value, ok := e.Details[key]
+	if !ok || value == nil {
+		return ""
+	}
+	if s, ok := value.(string); ok {
+		return s
+	}
+	return fmt.Sprint(value)
+}
+
+// DeviceID returns the ID of the network device of the event, if any.
+func (e *Event) DeviceID() string {
+	if e.Network == nil {
+		return ""
+	}
+	return e.Network.DeviceID
+}
+
+// UnmarshalJSON decodes an event, accepting the severity and timestamp as
+// numbers or as strings, and the details as an object or as a string holding
+// one, since DNA Center versions differ. Details given as plain text are kept
+// under the "message" key.
+func (e *Event) UnmarshalJSON(data []byte) error {
+	type plain Event
+	var event struct {
+		*plain
+		Severity  json.RawMessage `json:"severity,omitempty"`
+		Timestamp json.RawMessage `json:"timestamp,omitempty"`
+		Details   json.RawMessage `json:"details,omitempty"`
+	}
+	event.plain = (*plain)(e)
+	if err := json.Unmarshal(data, &event); err != nil {
+		return err
+	}
+	severity, err := flexibleInt(event.Severity)
+	if err != nil {
+		return fmt.Errorf("webhook: severity: %v", err)
+	}
+	timestamp, err := flexibleInt(event.Timestamp)
+	if err != nil {
+		return fmt.Errorf("webhook: timestamp: %v", err)
+	}
+	details, err := flexibleDetails(event.Details)
+	if err != nil {
+		return fmt.Errorf("webhook: details: %v", err)
+	}
+	e.Severity = int(severity)
+	e.Timestamp = timestamp
+	e.Details = details
+	e.Raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// flexibleInt decodes a JSON number or a string holding one.
+func flexibleInt(data json.RawMessage) (int64, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return 0, nil
+	}
+	var s string
+	if data[0] == '"' {
+		if err := json.Unmarshal(data, &s); err != nil {
+			return 0, err
+		}
+		if s == "" {
+			return 0, nil
+		}
+	} else {
+		s = string(data)
+	}
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return n, nil
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%s is not a number", data)
+	}
+	return int64(f), nil
+}
+
+// flexibleDetails decodes a JSON object, or a string holding one or text.
+func flexibleDetails(data json.RawMessage) (map[string]interface{}, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return nil, nil
+	}
+	if data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return nil, err
+		}
+		if s == "" {
+			return nil, nil
+		}
+		var details map[string]interface{}
+		if err := json.Unmarshal([]byte(s), &details); err != nil {
+			return map[string]interface{}{"message": s}, nil
+		}
+		return details, nil
+	}
+	var details map[string]interface{}
+	err := json.Unmarshal(data, &details)
+	return details, err
+}
+
+// ParseEvents parses a notification payload, which holds either one event or
+// a list of events. It rejects a null event, alone or in the list.
+func ParseEvents(payload []byte) ([]*Event, error) {
+	trimmed := bytes.TrimSpace(payload)
+	if bytes.Equal(trimmed, []byte("null")) {
+		return nil, errors.New("webhook: null event")
+	}
+	if len(trimmed) > 0 && trimmed[0] == '[' {
+		var events []*Event
+		if err := json.Unmarshal(payload, &events); err != nil {
+			return nil, err
+		}
+		for i, event := range events {
+			if event == nil {
+				return nil, fmt.Errorf("webhook: null event at index %d", i)
+			}
+		}
+		return events, nil
+	}
+	event := &Event{}
+	if err := json.Unmarshal(payload, event); err != nil {
+		return nil, err
+	}
+	return []*Event{event}, nil
+}
@@ -0,0 +1,209 @@
+package webhook
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// DefaultMaxBodySize is the largest payload a Handler accepts unless changed
+// with WithMaxBodySize.
+const DefaultMaxBodySize = 1 << 20
+
+// HandlerFunc handles an event. The context is the one of the request.
+// Returning an error makes the Handler answer 500, so that DNA Center reports
+// the delivery as failed. The error is logged, not sent to DNA Center.
+type HandlerFunc func(ctx context.Context, event *Event) error
+
+// Option configures a Handler created with NewHandler.
+type Option func(*Handler)
+
+// WithBasicAuth requires the requests to carry the basic authentication
+// credentials configured on the REST endpoint in DNA Center.
+func WithBasicAuth(username string, password string) Option {
+	return func(h *Handler) {
+		h.username = username
+		h.password = password
+		h.basicAuth = true
+	}
+}
+
+// WithSharedSecret requires the requests to carry the header with the
+// secret value, configured as a header of the REST endpoint in DNA Center.
+func WithSharedSecret(header string, secret string) Option {
+	return func(h *Handler) {
+		h.secretHeader = header
+		h.secret = secret
+	}
+}
+
+// WithMaxBodySize sets the largest payload accepted, in bytes.
+func WithMaxBodySize(size int64) Option {
+	return func(h *Handler) {
+		h.maxBodySize = size
+	}
+}
+
+// WithErrorLog sets the logger of the errors returned by the handlers. By
+// default they are logged with the standard logger of the log package.
+func WithErrorLog(logger *log.Logger) Option {
+	return func(h *Handler) {
+		h.errorLog = logger
+	}
+}
+
+// Handler is an http.Handler receiving the event notifications of DNA
+// Center. It is safe for concurrent use, and handlers may be registered while
+// it serves requests.
+type Handler struct {
+	username     string
+	password     string
+	basicAuth    bool
+	secretHeader string
+	secret       string
+	maxBodySize  int64
+	errorLog     *log.Logger
+
+	mu         sync.RWMutex
+	byEventID  map[string][]HandlerFunc
+	byCategory map[string][]HandlerFunc
+	fallback   []HandlerFunc
+}
+
+// NewHandler returns a Handler with no handlers registered.
+func NewHandler(opts ...Option) *Handler {
+	h := &Handler{
+		maxBodySize: DefaultMaxBodySize,
+		byEventID:   map[string][]HandlerFunc{},
+		byCategory:  map[string][]HandlerFunc{},
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+// HandleEvent registers fn for the events with the given event ID, e.g.
+// NETWORK-DEVICES-3-506.
+func (h *Handler) HandleEvent(eventID string, fn HandlerFunc) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.byEventID[eventID] = append(h.byEventID[eventID], fn)
+}
+
+// HandleCategory registers fn for the events of the given category, e.g.
+// CategoryAlert. Categories are matched case insensitively.
+func (h *Handler) HandleCategory(category string, fn HandlerFunc) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	category = strings.ToUpper(category)
+	h.byCategory[category] = append(h.byCategory[category], fn)
+}
+
+// HandleDefault registers fn for the events no other handler is registered for.
+func (h *Handler) HandleDefault(fn HandlerFunc) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.fallback = append(h.fallback, fn)
+}
+
+// handlers returns the handlers of an event: those of its event ID, then
+// those of its category, or the default ones if there are none.
+func (h *Handler) handlers(event *Event) []HandlerFunc {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	var fns []HandlerFunc
+	fns = append(fns, h.byEventID[event.EventID]...)
+	fns = append(fns, h.byCategory[strings.ToUpper(event.Category)]...)
+	if len(fns) == 0 {
+		fns = append(fns, h.fallback...)
+	}
+	return fns
+}
+
+// Dispatch calls the handlers of each event, in order, and returns the errors
+// they returned, joined.
+func (h *Handler) Dispatch(ctx context.Context, events ...*Event) error {
+	var failures []string
+	for _, event := range events {
+		for _, fn := range h.handlers(event) {
+			if err := fn(ctx, event); err != nil {
+				failures = append(failures, fmt.Sprintf("%s: %v", event.EventID, err))
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return errors.New("webhook: " + strings.Join(failures, "; "))
+	}
+	return nil
+}
+
+// ServeHTTP checks the credentials of a notification, parses it and
+// dispatches its events. It answers 405 to other methods than POST, 401 to
+// missing or wrong credentials, 400 to invalid payloads, 500 if a handler
+// failed and 200 otherwise. The errors of the handlers are logged, and the
+// 500 response does not include them.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if !h.authorized(r) {
+		if h.basicAuth {
+			w.Header().Set("WWW-Authenticate", `Basic realm="webhook"`)
+		}
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	payload, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, h.maxBodySize))
+	if err != nil {
+		http.Error(w, "cannot read payload: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	events, err := ParseEvents(payload)
+	if err != nil {
+		http.Error(w, "invalid payload: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := h.Dispatch(r.Context(), events...); err != nil {
+		h.logf("%v", err)
+		http.Error(w, "handler failed", http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *Handler) logf(format string, args ...interface{}) {
+	if h.errorLog != nil {
+		h.errorLog.Printf(format, args...)
+		return
+	}
+	log.Printf(format, args...)
+}
+
+// authorized reports whether the request carries the required credentials.
+func (h *Handler) authorized(r *http.Request) bool {
+	if h.basicAuth {
+		username, password, ok := r.BasicAuth()
+		if !ok || !secureEqual(username, h.username) || !secureEqual(password, h.password) {
+			return false
+		}
+	}
+	if h.secretHeader != "" && !secureEqual(r.Header.Get(h.secretHeader), h.secret) {
+		return false
+	}
+	return true
+}
+
+// secureEqual compares a and b in constant time.
+func secureEqual(a string, b string) bool {
+	return subtle.ConstantTimeCompare([]byte(a), []byte(b)) == 1
+}
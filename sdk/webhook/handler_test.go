@@ -0,0 +1,71 @@
+package webhook_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cisco-en-programmability/dnacenter-go-sdk/sdk/webhook"
+)
+
+func post(h http.Handler, payload string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/events", strings.NewReader(payload)))
+	return w
+}
+
+func TestParseEventsRejectsNullEvents(t *testing.T) {
+	for _, payload := range []string{`null`, ` null `, `[null]`, `[{"eventId":"E1"},null]`} {
+		if events, err := webhook.ParseEvents([]byte(payload)); err == nil {
+			t.Errorf("%s: got events %v, want an error", payload, events)
+		}
+	}
+	events, err := webhook.ParseEvents([]byte(`[{"eventId":"E1"},{"eventId":"E2"}]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 2 || events[0].EventID != "E1" || events[1].EventID != "E2" {
+		t.Errorf("got events %v, want E1 and E2", events)
+	}
+}
+
+func TestHandlerAnswersNullEventsWith400(t *testing.T) {
+	called := false
+	h := webhook.NewHandler()
+	h.HandleDefault(func(ctx context.Context, event *webhook.Event) error {
+		called = true
+		return nil
+	})
+	for _, payload := range []string{`[null]`, `[{"eventId":"E1"},null]`} {
+		if w := post(h, payload); w.Code != http.StatusBadRequest {
+			t.Errorf("%s: got status %d, want 400", payload, w.Code)
+		}
+	}
+	if called {
+		t.Errorf("handler called for a payload with a null event")
+	}
+}
+
+func TestHandlerLogsHandlerErrors(t *testing.T) {
+	var logged bytes.Buffer
+	h := webhook.NewHandler(webhook.WithErrorLog(log.New(&logged, "", 0)))
+	h.HandleEvent("E1", func(ctx context.Context, event *webhook.Event) error {
+		return errors.New("database password rejected")
+	})
+
+	w := post(h, `{"eventId":"E1"}`)
+	if w.Code != http.StatusInternalServerError {
+		t.Fatalf("got status %d, want 500", w.Code)
+	}
+	if strings.Contains(w.Body.String(), "password") {
+		t.Errorf("response %q leaks the error of the handler", w.Body.String())
+	}
+	if want := "webhook: E1: database password rejected\n"; logged.String() != want {
+		t.Errorf("logged %q, want %q", logged.String(), want)
+	}
+}
@@ -1,6 +1,7 @@
 package dnac
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -26,17 +27,17 @@ type APProvisionRequestCustomFlexGroupName []string
 
 // CreateAndProvisionSSIDRequest is the createAndProvisionSSIDRequest definition
 type CreateAndProvisionSSIDRequest struct {
-	EnableFabric       bool                                     `json:"enableFabric,omitempty"`       //
-	FlexConnect        CreateAndProvisionSSIDRequestFlexConnect `json:"flexConnect,omitempty"`        //
-	ManagedAPLocations []string                                 `json:"managedAPLocations,omitempty"` //
-	SSIDDetails        CreateAndProvisionSSIDRequestSSIDDetails `json:"ssidDetails,omitempty"`        //
-	SSIDType           string                                   `json:"ssidType,omitempty"`           //
+	EnableFabric       *bool                                     `json:"enableFabric,omitempty"`       //
+	FlexConnect        *CreateAndProvisionSSIDRequestFlexConnect `json:"flexConnect,omitempty"`        //
+	ManagedAPLocations []string                                  `json:"managedAPLocations,omitempty"` //
+	SSIDDetails        *CreateAndProvisionSSIDRequestSSIDDetails `json:"ssidDetails,omitempty"`        //
+	SSIDType           string                                    `json:"ssidType,omitempty"`           //
 }
 
 // CreateAndProvisionSSIDRequestFlexConnect is the createAndProvisionSSIDRequestFlexConnect definition
 type CreateAndProvisionSSIDRequestFlexConnect struct {
-	EnableFlexConnect bool `json:"enableFlexConnect,omitempty"` //
-	LocalToVLAN       int  `json:"localToVlan,omitempty"`       //
+	EnableFlexConnect *bool `json:"enableFlexConnect,omitempty"` //
+	LocalToVLAN       *int  `json:"localToVlan,omitempty"`       //
 }
 
 // CreateAndProvisionSSIDRequestManagedAPLocations is the createAndProvisionSSIDRequestManagedAPLocations definition
@@ -44,9 +45,9 @@ type CreateAndProvisionSSIDRequestManagedAPLocations []string
 
 // CreateAndProvisionSSIDRequestSSIDDetails is the createAndProvisionSSIDRequestSSIDDetails definition
 type CreateAndProvisionSSIDRequestSSIDDetails struct {
-	EnableBroadcastSSID bool   `json:"enableBroadcastSSID,omitempty"` //
-	EnableFastLane      bool   `json:"enableFastLane,omitempty"`      //
-	EnableMACFiltering  bool   `json:"enableMACFiltering,omitempty"`  //
+	EnableBroadcastSSID *bool  `json:"enableBroadcastSSID,omitempty"` //
+	EnableFastLane      *bool  `json:"enableFastLane,omitempty"`      //
+	EnableMACFiltering  *bool  `json:"enableMACFiltering,omitempty"`  //
 	FastTransition      string `json:"fastTransition,omitempty"`      //
 	Name                string `json:"name,omitempty"`                //
 	Passphrase          string `json:"passphrase,omitempty"`          //
@@ -58,9 +59,9 @@ type CreateAndProvisionSSIDRequestSSIDDetails struct {
 
 // CreateEnterpriseSSIDRequest is the createEnterpriseSSIDRequest definition
 type CreateEnterpriseSSIDRequest struct {
-	EnableBroadcastSSID bool   `json:"enableBroadcastSSID,omitempty"` //
-	EnableFastLane      bool   `json:"enableFastLane,omitempty"`      //
-	EnableMACFiltering  bool   `json:"enableMACFiltering,omitempty"`  //
+	EnableBroadcastSSID *bool  `json:"enableBroadcastSSID,omitempty"` //
+	EnableFastLane      *bool  `json:"enableFastLane,omitempty"`      //
+	EnableMACFiltering  *bool  `json:"enableMACFiltering,omitempty"`  //
 	FastTransition      string `json:"fastTransition,omitempty"`      //
 	Name                string `json:"name,omitempty"`                //
 	Passphrase          string `json:"passphrase,omitempty"`          //
@@ -71,44 +72,44 @@ type CreateEnterpriseSSIDRequest struct {
 
 // CreateOrUpdateRFProfileRequest is the createOrUpdateRFProfileRequest definition
 type CreateOrUpdateRFProfileRequest struct {
-	ChannelWidth         string                                             `json:"channelWidth,omitempty"`         //
-	DefaultRfProfile     bool                                               `json:"defaultRfProfile,omitempty"`     //
-	EnableBrownField     bool                                               `json:"enableBrownField,omitempty"`     //
-	EnableCustom         bool                                               `json:"enableCustom,omitempty"`         //
-	EnableRadioTypeA     bool                                               `json:"enableRadioTypeA,omitempty"`     //
-	EnableRadioTypeB     bool                                               `json:"enableRadioTypeB,omitempty"`     //
-	Name                 string                                             `json:"name,omitempty"`                 //
-	RadioTypeAProperties CreateOrUpdateRFProfileRequestRadioTypeAProperties `json:"radioTypeAProperties,omitempty"` //
-	RadioTypeBProperties CreateOrUpdateRFProfileRequestRadioTypeBProperties `json:"radioTypeBProperties,omitempty"` //
+	ChannelWidth         string                                              `json:"channelWidth,omitempty"`         //
+	DefaultRfProfile     *bool                                               `json:"defaultRfProfile,omitempty"`     //
+	EnableBrownField     *bool                                               `json:"enableBrownField,omitempty"`     //
+	EnableCustom         *bool                                               `json:"enableCustom,omitempty"`         //
+	EnableRadioTypeA     *bool                                               `json:"enableRadioTypeA,omitempty"`     //
+	EnableRadioTypeB     *bool                                               `json:"enableRadioTypeB,omitempty"`     //
+	Name                 string                                              `json:"name,omitempty"`                 //
+	RadioTypeAProperties *CreateOrUpdateRFProfileRequestRadioTypeAProperties `json:"radioTypeAProperties,omitempty"` //
+	RadioTypeBProperties *CreateOrUpdateRFProfileRequestRadioTypeBProperties `json:"radioTypeBProperties,omitempty"` //
 }
 
 // CreateOrUpdateRFProfileRequestRadioTypeAProperties is the createOrUpdateRFProfileRequestRadioTypeAProperties definition
 type CreateOrUpdateRFProfileRequestRadioTypeAProperties struct {
-	DataRates          string  `json:"dataRates,omitempty"`          //
-	MandatoryDataRates string  `json:"mandatoryDataRates,omitempty"` //
-	MaxPowerLevel      float64 `json:"maxPowerLevel,omitempty"`      //
-	MinPowerLevel      float64 `json:"minPowerLevel,omitempty"`      //
-	ParentProfile      string  `json:"parentProfile,omitempty"`      //
-	PowerThresholdV1   float64 `json:"powerThresholdV1,omitempty"`   //
-	RadioChannels      string  `json:"radioChannels,omitempty"`      //
-	RxSopThreshold     string  `json:"rxSopThreshold,omitempty"`     //
+	DataRates          string   `json:"dataRates,omitempty"`          //
+	MandatoryDataRates string   `json:"mandatoryDataRates,omitempty"` //
+	MaxPowerLevel      *float64 `json:"maxPowerLevel,omitempty"`      //
+	MinPowerLevel      *float64 `json:"minPowerLevel,omitempty"`      //
+	ParentProfile      string   `json:"parentProfile,omitempty"`      //
+	PowerThresholdV1   *float64 `json:"powerThresholdV1,omitempty"`   //
+	RadioChannels      string   `json:"radioChannels,omitempty"`      //
+	RxSopThreshold     string   `json:"rxSopThreshold,omitempty"`     //
 }
 
 // CreateOrUpdateRFProfileRequestRadioTypeBProperties is the createOrUpdateRFProfileRequestRadioTypeBProperties definition
 type CreateOrUpdateRFProfileRequestRadioTypeBProperties struct {
-	DataRates          string  `json:"dataRates,omitempty"`          //
-	MandatoryDataRates string  `json:"mandatoryDataRates,omitempty"` //
-	MaxPowerLevel      float64 `json:"maxPowerLevel,omitempty"`      //
-	MinPowerLevel      float64 `json:"minPowerLevel,omitempty"`      //
-	ParentProfile      string  `json:"parentProfile,omitempty"`      //
-	PowerThresholdV1   float64 `json:"powerThresholdV1,omitempty"`   //
-	RadioChannels      string  `json:"radioChannels,omitempty"`      //
-	RxSopThreshold     string  `json:"rxSopThreshold,omitempty"`     //
+	DataRates          string   `json:"dataRates,omitempty"`          //
+	MandatoryDataRates string   `json:"mandatoryDataRates,omitempty"` //
+	MaxPowerLevel      *float64 `json:"maxPowerLevel,omitempty"`      //
+	MinPowerLevel      *float64 `json:"minPowerLevel,omitempty"`      //
+	ParentProfile      string   `json:"parentProfile,omitempty"`      //
+	PowerThresholdV1   *float64 `json:"powerThresholdV1,omitempty"`   //
+	RadioChannels      string   `json:"radioChannels,omitempty"`      //
+	RxSopThreshold     string   `json:"rxSopThreshold,omitempty"`     //
 }
 
 // CreateWirelessProfileRequest is the createWirelessProfileRequest definition
 type CreateWirelessProfileRequest struct {
-	ProfileDetails CreateWirelessProfileRequestProfileDetails `json:"profileDetails,omitempty"` //
+	ProfileDetails *CreateWirelessProfileRequestProfileDetails `json:"profileDetails,omitempty"` //
 }
 
 // CreateWirelessProfileRequestProfileDetails is the createWirelessProfileRequestProfileDetails definition
@@ -120,17 +121,17 @@ type CreateWirelessProfileRequestProfileDetails struct {
 
 // CreateWirelessProfileRequestProfileDetailsSSIDDetails is the createWirelessProfileRequestProfileDetailsSSIDDetails definition
 type CreateWirelessProfileRequestProfileDetailsSSIDDetails struct {
-	EnableFabric  bool                                                             `json:"enableFabric,omitempty"`  //
-	FlexConnect   CreateWirelessProfileRequestProfileDetailsSSIDDetailsFlexConnect `json:"flexConnect,omitempty"`   //
-	InterfaceName string                                                           `json:"interfaceName,omitempty"` //
-	Name          string                                                           `json:"name,omitempty"`          //
-	Type          string                                                           `json:"type,omitempty"`          //
+	EnableFabric  *bool                                                             `json:"enableFabric,omitempty"`  //
+	FlexConnect   *CreateWirelessProfileRequestProfileDetailsSSIDDetailsFlexConnect `json:"flexConnect,omitempty"`   //
+	InterfaceName string                                                            `json:"interfaceName,omitempty"` //
+	Name          string                                                            `json:"name,omitempty"`          //
+	Type          string                                                            `json:"type,omitempty"`          //
 }
 
 // CreateWirelessProfileRequestProfileDetailsSSIDDetailsFlexConnect is the createWirelessProfileRequestProfileDetailsSSIDDetailsFlexConnect definition
 type CreateWirelessProfileRequestProfileDetailsSSIDDetailsFlexConnect struct {
-	EnableFlexConnect bool `json:"enableFlexConnect,omitempty"` //
-	LocalToVLAN       int  `json:"localToVlan,omitempty"`       //
+	EnableFlexConnect *bool `json:"enableFlexConnect,omitempty"` //
+	LocalToVLAN       *int  `json:"localToVlan,omitempty"`       //
 }
 
 // CreateWirelessProfileRequestProfileDetailsSites is the createWirelessProfileRequestProfileDetailsSites definition
@@ -149,9 +150,9 @@ type ProvisionRequestDynamicInterfaces struct {
 	InterfaceGateway       string `json:"interfaceGateway,omitempty"`       //
 	InterfaceIPAddress     string `json:"interfaceIPAddress,omitempty"`     //
 	InterfaceName          string `json:"interfaceName,omitempty"`          //
-	InterfaceNetmaskInCIDR int    `json:"interfaceNetmaskInCIDR,omitempty"` //
-	LagOrPortNumber        int    `json:"lagOrPortNumber,omitempty"`        //
-	VLANID                 int    `json:"vlanId,omitempty"`                 //
+	InterfaceNetmaskInCIDR *int   `json:"interfaceNetmaskInCIDR,omitempty"` //
+	LagOrPortNumber        *int   `json:"lagOrPortNumber,omitempty"`        //
+	VLANID                 *int   `json:"vlanId,omitempty"`                 //
 }
 
 // ProvisionRequestManagedAPLocations is the provisionRequestManagedAPLocations definition
@@ -169,9 +170,9 @@ type ProvisionUpdateRequestDynamicInterfaces struct {
 	InterfaceGateway       string `json:"interfaceGateway,omitempty"`       //
 	InterfaceIPAddress     string `json:"interfaceIPAddress,omitempty"`     //
 	InterfaceName          string `json:"interfaceName,omitempty"`          //
-	InterfaceNetmaskInCIDR int    `json:"interfaceNetmaskInCIDR,omitempty"` //
-	LagOrPortNumber        int    `json:"lagOrPortNumber,omitempty"`        //
-	VLANID                 int    `json:"vlanId,omitempty"`                 //
+	InterfaceNetmaskInCIDR *int   `json:"interfaceNetmaskInCIDR,omitempty"` //
+	LagOrPortNumber        *int   `json:"lagOrPortNumber,omitempty"`        //
+	VLANID                 *int   `json:"vlanId,omitempty"`                 //
 }
 
 // ProvisionUpdateRequestManagedAPLocations is the provisionUpdateRequestManagedAPLocations definition
@@ -179,7 +180,7 @@ type ProvisionUpdateRequestManagedAPLocations []string
 
 // UpdateWirelessProfileRequest is the updateWirelessProfileRequest definition
 type UpdateWirelessProfileRequest struct {
-	ProfileDetails UpdateWirelessProfileRequestProfileDetails `json:"profileDetails,omitempty"` //
+	ProfileDetails *UpdateWirelessProfileRequestProfileDetails `json:"profileDetails,omitempty"` //
 }
 
 // UpdateWirelessProfileRequestProfileDetails is the updateWirelessProfileRequestProfileDetails definition
@@ -191,17 +192,17 @@ type UpdateWirelessProfileRequestProfileDetails struct {
 
 // UpdateWirelessProfileRequestProfileDetailsSSIDDetails is the updateWirelessProfileRequestProfileDetailsSSIDDetails definition
 type UpdateWirelessProfileRequestProfileDetailsSSIDDetails struct {
-	EnableFabric  bool                                                             `json:"enableFabric,omitempty"`  //
-	FlexConnect   UpdateWirelessProfileRequestProfileDetailsSSIDDetailsFlexConnect `json:"flexConnect,omitempty"`   //
-	InterfaceName string                                                           `json:"interfaceName,omitempty"` //
-	Name          string                                                           `json:"name,omitempty"`          //
-	Type          string                                                           `json:"type,omitempty"`          //
+	EnableFabric  *bool                                                             `json:"enableFabric,omitempty"`  //
+	FlexConnect   *UpdateWirelessProfileRequestProfileDetailsSSIDDetailsFlexConnect `json:"flexConnect,omitempty"`   //
+	InterfaceName string                                                            `json:"interfaceName,omitempty"` //
+	Name          string                                                            `json:"name,omitempty"`          //
+	Type          string                                                            `json:"type,omitempty"`          //
 }
 
 // UpdateWirelessProfileRequestProfileDetailsSSIDDetailsFlexConnect is the updateWirelessProfileRequestProfileDetailsSSIDDetailsFlexConnect definition
 type UpdateWirelessProfileRequestProfileDetailsSSIDDetailsFlexConnect struct {
-	EnableFlexConnect bool `json:"enableFlexConnect,omitempty"` //
-	LocalToVLAN       int  `json:"localToVlan,omitempty"`       //
+	EnableFlexConnect *bool `json:"enableFlexConnect,omitempty"` //
+	LocalToVLAN       *int  `json:"localToVlan,omitempty"`       //
 }
 
 // UpdateWirelessProfileRequestProfileDetailsSites is the updateWirelessProfileRequestProfileDetailsSites definition
@@ -554,10 +555,16 @@ type UpdateWirelessProfileResponse struct {
 @param __persistbapioutput
 */
 func (s *WirelessService) APProvision(aPProvisionRequest *[]APProvisionRequest) (*APProvisionResponse, *resty.Response, error) {
+	return s.APProvisionWithContext(context.Background(), aPProvisionRequest)
+}
+
+// APProvisionWithContext is like APProvision but sends the request with ctx for cancellation and deadlines.
+func (s *WirelessService) APProvisionWithContext(ctx context.Context, aPProvisionRequest *[]APProvisionRequest) (*APProvisionResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/wireless/ap-provision"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(aPProvisionRequest).
 		SetResult(&APProvisionResponse{}).
 		SetError(&Error{}).
@@ -568,7 +575,7 @@ func (s *WirelessService) APProvision(aPProvisionRequest *[]APProvisionRequest)
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation aPProvision")
+		return nil, response, newAPIError("aPProvision", response)
 	}
 
 	result := response.Result().(*APProvisionResponse)
@@ -580,10 +587,16 @@ func (s *WirelessService) APProvision(aPProvisionRequest *[]APProvisionRequest)
 @param __persistbapioutput Enable this parameter to execute the API and return a response asynchronously.
 */
 func (s *WirelessService) CreateAndProvisionSSID(createAndProvisionSSIDRequest *CreateAndProvisionSSIDRequest) (*CreateAndProvisionSSIDResponse, *resty.Response, error) {
+	return s.CreateAndProvisionSSIDWithContext(context.Background(), createAndProvisionSSIDRequest)
+}
+
+// CreateAndProvisionSSIDWithContext is like CreateAndProvisionSSID but sends the request with ctx for cancellation and deadlines.
+func (s *WirelessService) CreateAndProvisionSSIDWithContext(ctx context.Context, createAndProvisionSSIDRequest *CreateAndProvisionSSIDRequest) (*CreateAndProvisionSSIDResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/business/ssid"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(createAndProvisionSSIDRequest).
 		SetResult(&CreateAndProvisionSSIDResponse{}).
 		SetError(&Error{}).
@@ -594,7 +607,7 @@ func (s *WirelessService) CreateAndProvisionSSID(createAndProvisionSSIDRequest *
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation createAndProvisionSSID")
+		return nil, response, newAPIError("createAndProvisionSSID", response)
 	}
 
 	result := response.Result().(*CreateAndProvisionSSIDResponse)
@@ -605,10 +618,16 @@ func (s *WirelessService) CreateAndProvisionSSID(createAndProvisionSSIDRequest *
 /* Creates enterprise SSID
  */
 func (s *WirelessService) CreateEnterpriseSSID(createEnterpriseSSIDRequest *CreateEnterpriseSSIDRequest) (*CreateEnterpriseSSIDResponse, *resty.Response, error) {
+	return s.CreateEnterpriseSSIDWithContext(context.Background(), createEnterpriseSSIDRequest)
+}
+
+// CreateEnterpriseSSIDWithContext is like CreateEnterpriseSSID but sends the request with ctx for cancellation and deadlines.
+func (s *WirelessService) CreateEnterpriseSSIDWithContext(ctx context.Context, createEnterpriseSSIDRequest *CreateEnterpriseSSIDRequest) (*CreateEnterpriseSSIDResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/enterprise-ssid"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(createEnterpriseSSIDRequest).
 		SetResult(&CreateEnterpriseSSIDResponse{}).
 		SetError(&Error{}).
@@ -619,7 +638,7 @@ func (s *WirelessService) CreateEnterpriseSSID(createEnterpriseSSIDRequest *Crea
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation createEnterpriseSSID")
+		return nil, response, newAPIError("createEnterpriseSSID", response)
 	}
 
 	result := response.Result().(*CreateEnterpriseSSIDResponse)
@@ -630,10 +649,16 @@ func (s *WirelessService) CreateEnterpriseSSID(createEnterpriseSSIDRequest *Crea
 /* Create or Update RF profile
  */
 func (s *WirelessService) CreateOrUpdateRFProfile(createOrUpdateRFProfileRequest *CreateOrUpdateRFProfileRequest) (*CreateOrUpdateRFProfileResponse, *resty.Response, error) {
+	return s.CreateOrUpdateRFProfileWithContext(context.Background(), createOrUpdateRFProfileRequest)
+}
+
+// CreateOrUpdateRFProfileWithContext is like CreateOrUpdateRFProfile but sends the request with ctx for cancellation and deadlines.
+func (s *WirelessService) CreateOrUpdateRFProfileWithContext(ctx context.Context, createOrUpdateRFProfileRequest *CreateOrUpdateRFProfileRequest) (*CreateOrUpdateRFProfileResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/wireless/rf-profile"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(createOrUpdateRFProfileRequest).
 		SetResult(&CreateOrUpdateRFProfileResponse{}).
 		SetError(&Error{}).
@@ -644,7 +669,7 @@ func (s *WirelessService) CreateOrUpdateRFProfile(createOrUpdateRFProfileRequest
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation createOrUpdateRFProfile")
+		return nil, response, newAPIError("createOrUpdateRFProfile", response)
 	}
 
 	result := response.Result().(*CreateOrUpdateRFProfileResponse)
@@ -655,10 +680,16 @@ func (s *WirelessService) CreateOrUpdateRFProfile(createOrUpdateRFProfileRequest
 /* Creates Wireless Network Profile on DNAC and associates sites and SSIDs to it.
  */
 func (s *WirelessService) CreateWirelessProfile(createWirelessProfileRequest *CreateWirelessProfileRequest) (*CreateWirelessProfileResponse, *resty.Response, error) {
+	return s.CreateWirelessProfileWithContext(context.Background(), createWirelessProfileRequest)
+}
+
+// CreateWirelessProfileWithContext is like CreateWirelessProfile but sends the request with ctx for cancellation and deadlines.
+func (s *WirelessService) CreateWirelessProfileWithContext(ctx context.Context, createWirelessProfileRequest *CreateWirelessProfileRequest) (*CreateWirelessProfileResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/wireless/profile"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(createWirelessProfileRequest).
 		SetResult(&CreateWirelessProfileResponse{}).
 		SetError(&Error{}).
@@ -669,7 +700,7 @@ func (s *WirelessService) CreateWirelessProfile(createWirelessProfileRequest *Cr
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation createWirelessProfile")
+		return nil, response, newAPIError("createWirelessProfile", response)
 	}
 
 	result := response.Result().(*CreateWirelessProfileResponse)
@@ -681,11 +712,17 @@ func (s *WirelessService) CreateWirelessProfile(createWirelessProfileRequest *Cr
 @param ssidName Enter the SSID name to be deleted
 */
 func (s *WirelessService) DeleteEnterpriseSSID(ssidName string) (*DeleteEnterpriseSSIDResponse, *resty.Response, error) {
+	return s.DeleteEnterpriseSSIDWithContext(context.Background(), ssidName)
+}
+
+// DeleteEnterpriseSSIDWithContext is like DeleteEnterpriseSSID but sends the request with ctx for cancellation and deadlines.
+func (s *WirelessService) DeleteEnterpriseSSIDWithContext(ctx context.Context, ssidName string) (*DeleteEnterpriseSSIDResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/enterprise-ssid/{ssidName}"
 	path = strings.Replace(path, "{"+"ssidName"+"}", fmt.Sprintf("%v", ssidName), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&DeleteEnterpriseSSIDResponse{}).
 		SetError(&Error{}).
 		Delete(path)
@@ -695,7 +732,7 @@ func (s *WirelessService) DeleteEnterpriseSSID(ssidName string) (*DeleteEnterpri
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation deleteEnterpriseSSID")
+		return nil, response, newAPIError("deleteEnterpriseSSID", response)
 	}
 
 	result := response.Result().(*DeleteEnterpriseSSIDResponse)
@@ -707,11 +744,17 @@ func (s *WirelessService) DeleteEnterpriseSSID(ssidName string) (*DeleteEnterpri
 @param rfProfileName
 */
 func (s *WirelessService) DeleteRFProfiles(rfProfileName string) (*DeleteRFProfilesResponse, *resty.Response, error) {
+	return s.DeleteRFProfilesWithContext(context.Background(), rfProfileName)
+}
+
+// DeleteRFProfilesWithContext is like DeleteRFProfiles but sends the request with ctx for cancellation and deadlines.
+func (s *WirelessService) DeleteRFProfilesWithContext(ctx context.Context, rfProfileName string) (*DeleteRFProfilesResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/wireless/rf-profile/{rfProfileName}"
 	path = strings.Replace(path, "{"+"rfProfileName"+"}", fmt.Sprintf("%v", rfProfileName), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&DeleteRFProfilesResponse{}).
 		SetError(&Error{}).
 		Delete(path)
@@ -721,7 +764,7 @@ func (s *WirelessService) DeleteRFProfiles(rfProfileName string) (*DeleteRFProfi
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation deleteRFProfiles")
+		return nil, response, newAPIError("deleteRFProfiles", response)
 	}
 
 	result := response.Result().(*DeleteRFProfilesResponse)
@@ -735,12 +778,18 @@ func (s *WirelessService) DeleteRFProfiles(rfProfileName string) (*DeleteRFProfi
 @param managedAPLocations
 */
 func (s *WirelessService) DeleteSSIDAndProvisionItToDevices(ssidName string, managedAPLocations string) (*DeleteSSIDAndProvisionItToDevicesResponse, *resty.Response, error) {
+	return s.DeleteSSIDAndProvisionItToDevicesWithContext(context.Background(), ssidName, managedAPLocations)
+}
+
+// DeleteSSIDAndProvisionItToDevicesWithContext is like DeleteSSIDAndProvisionItToDevices but sends the request with ctx for cancellation and deadlines.
+func (s *WirelessService) DeleteSSIDAndProvisionItToDevicesWithContext(ctx context.Context, ssidName string, managedAPLocations string) (*DeleteSSIDAndProvisionItToDevicesResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/business/ssid/{ssidName}/{managedAPLocations}"
 	path = strings.Replace(path, "{"+"ssidName"+"}", fmt.Sprintf("%v", ssidName), -1)
 	path = strings.Replace(path, "{"+"managedAPLocations"+"}", fmt.Sprintf("%v", managedAPLocations), -1)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetResult(&DeleteSSIDAndProvisionItToDevicesResponse{}).
 		SetError(&Error{}).
 		Delete(path)
@@ -750,7 +799,7 @@ func (s *WirelessService) DeleteSSIDAndProvisionItToDevices(ssidName string, man
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation deleteSSIDAndProvisionItToDevices")
+		return nil, response, newAPIError("deleteSSIDAndProvisionItToDevices", response)
 	}
 
 	result := response.Result().(*DeleteSSIDAndProvisionItToDevicesResponse)
@@ -794,12 +843,18 @@ type GetEnterpriseSSIDQueryParams struct {
 @param ssidName Enter the enterprise SSID name that needs to be retrieved. If not entered, all the enterprise SSIDs will be retrieved.
 */
 func (s *WirelessService) GetEnterpriseSSID(getEnterpriseSSIDQueryParams *GetEnterpriseSSIDQueryParams) (*GetEnterpriseSSIDResponse, *resty.Response, error) {
+	return s.GetEnterpriseSSIDWithContext(context.Background(), getEnterpriseSSIDQueryParams)
+}
+
+// GetEnterpriseSSIDWithContext is like GetEnterpriseSSID but sends the request with ctx for cancellation and deadlines.
+func (s *WirelessService) GetEnterpriseSSIDWithContext(ctx context.Context, getEnterpriseSSIDQueryParams *GetEnterpriseSSIDQueryParams) (*GetEnterpriseSSIDResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/enterprise-ssid"
 
 	queryString, _ := query.Values(getEnterpriseSSIDQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetEnterpriseSSIDResponse{}).
 		SetError(&Error{}).
@@ -810,7 +865,7 @@ func (s *WirelessService) GetEnterpriseSSID(getEnterpriseSSIDQueryParams *GetEnt
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getEnterpriseSSID")
+		return nil, response, newAPIError("getEnterpriseSSID", response)
 	}
 
 	result := response.Result().(*GetEnterpriseSSIDResponse)
@@ -827,12 +882,18 @@ type GetWirelessProfileQueryParams struct {
 @param profileName
 */
 func (s *WirelessService) GetWirelessProfile(getWirelessProfileQueryParams *GetWirelessProfileQueryParams) (*GetWirelessProfileResponse, *resty.Response, error) {
+	return s.GetWirelessProfileWithContext(context.Background(), getWirelessProfileQueryParams)
+}
+
+// GetWirelessProfileWithContext is like GetWirelessProfile but sends the request with ctx for cancellation and deadlines.
+func (s *WirelessService) GetWirelessProfileWithContext(ctx context.Context, getWirelessProfileQueryParams *GetWirelessProfileQueryParams) (*GetWirelessProfileResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/wireless/profile"
 
 	queryString, _ := query.Values(getWirelessProfileQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&GetWirelessProfileResponse{}).
 		SetError(&Error{}).
@@ -843,7 +904,7 @@ func (s *WirelessService) GetWirelessProfile(getWirelessProfileQueryParams *GetW
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation getWirelessProfile")
+		return nil, response, newAPIError("getWirelessProfile", response)
 	}
 
 	result := response.Result().(*GetWirelessProfileResponse)
@@ -855,10 +916,16 @@ func (s *WirelessService) GetWirelessProfile(getWirelessProfileQueryParams *GetW
 @param __persistbapioutput Enable this parameter to execute the API and return a response asynchronously.
 */
 func (s *WirelessService) Provision(provisionRequest *[]ProvisionRequest) (*ProvisionResponse, *resty.Response, error) {
+	return s.ProvisionWithContext(context.Background(), provisionRequest)
+}
+
+// ProvisionWithContext is like Provision but sends the request with ctx for cancellation and deadlines.
+func (s *WirelessService) ProvisionWithContext(ctx context.Context, provisionRequest *[]ProvisionRequest) (*ProvisionResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/wireless/provision"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(provisionRequest).
 		SetResult(&ProvisionResponse{}).
 		SetError(&Error{}).
@@ -869,7 +936,7 @@ func (s *WirelessService) Provision(provisionRequest *[]ProvisionRequest) (*Prov
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation provision")
+		return nil, response, newAPIError("provision", response)
 	}
 
 	result := response.Result().(*ProvisionResponse)
@@ -881,10 +948,16 @@ func (s *WirelessService) Provision(provisionRequest *[]ProvisionRequest) (*Prov
 @param __persistbapioutput Enable this parameter to execute the API and return a response asynchronously.
 */
 func (s *WirelessService) ProvisionUpdate(provisionUpdateRequest *[]ProvisionUpdateRequest) (*ProvisionUpdateResponse, *resty.Response, error) {
+	return s.ProvisionUpdateWithContext(context.Background(), provisionUpdateRequest)
+}
+
+// ProvisionUpdateWithContext is like ProvisionUpdate but sends the request with ctx for cancellation and deadlines.
+func (s *WirelessService) ProvisionUpdateWithContext(ctx context.Context, provisionUpdateRequest *[]ProvisionUpdateRequest) (*ProvisionUpdateResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/wireless/provision"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(provisionUpdateRequest).
 		SetResult(&ProvisionUpdateResponse{}).
 		SetError(&Error{}).
@@ -895,7 +968,7 @@ func (s *WirelessService) ProvisionUpdate(provisionUpdateRequest *[]ProvisionUpd
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation provisionUpdate")
+		return nil, response, newAPIError("provisionUpdate", response)
 	}
 
 	result := response.Result().(*ProvisionUpdateResponse)
@@ -912,12 +985,18 @@ type RetrieveRFProfilesQueryParams struct {
 @param rfProfileName
 */
 func (s *WirelessService) RetrieveRFProfiles(retrieveRFProfilesQueryParams *RetrieveRFProfilesQueryParams) (*RetrieveRFProfilesResponse, *resty.Response, error) {
+	return s.RetrieveRFProfilesWithContext(context.Background(), retrieveRFProfilesQueryParams)
+}
+
+// RetrieveRFProfilesWithContext is like RetrieveRFProfiles but sends the request with ctx for cancellation and deadlines.
+func (s *WirelessService) RetrieveRFProfilesWithContext(ctx context.Context, retrieveRFProfilesQueryParams *RetrieveRFProfilesQueryParams) (*RetrieveRFProfilesResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/wireless/rf-profile"
 
 	queryString, _ := query.Values(retrieveRFProfilesQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&RetrieveRFProfilesResponse{}).
 		SetError(&Error{}).
@@ -928,7 +1007,7 @@ func (s *WirelessService) RetrieveRFProfiles(retrieveRFProfilesQueryParams *Retr
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation retrieveRFProfiles")
+		return nil, response, newAPIError("retrieveRFProfiles", response)
 	}
 
 	result := response.Result().(*RetrieveRFProfilesResponse)
@@ -951,12 +1030,18 @@ type SensorTestResultsQueryParams struct {
 @param testFailureBy Obtain failure statistics group by "area", "building", or "floor"
 */
 func (s *WirelessService) SensorTestResults(sensorTestResultsQueryParams *SensorTestResultsQueryParams) (*SensorTestResultsResponse, *resty.Response, error) {
+	return s.SensorTestResultsWithContext(context.Background(), sensorTestResultsQueryParams)
+}
+
+// SensorTestResultsWithContext is like SensorTestResults but sends the request with ctx for cancellation and deadlines.
+func (s *WirelessService) SensorTestResultsWithContext(ctx context.Context, sensorTestResultsQueryParams *SensorTestResultsQueryParams) (*SensorTestResultsResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/AssuranceGetSensorTestResults"
 
 	queryString, _ := query.Values(sensorTestResultsQueryParams)
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetQueryString(queryString.Encode()).
 		SetResult(&SensorTestResultsResponse{}).
 		SetError(&Error{}).
@@ -967,7 +1052,7 @@ func (s *WirelessService) SensorTestResults(sensorTestResultsQueryParams *Sensor
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation sensorTestResults")
+		return nil, response, newAPIError("sensorTestResults", response)
 	}
 
 	result := response.Result().(*SensorTestResultsResponse)
@@ -978,10 +1063,16 @@ func (s *WirelessService) SensorTestResults(sensorTestResultsQueryParams *Sensor
 /* Updates the wireless Network Profile with updated details provided. All sites to be present in the network profile should be provided.
  */
 func (s *WirelessService) UpdateWirelessProfile(updateWirelessProfileRequest *UpdateWirelessProfileRequest) (*UpdateWirelessProfileResponse, *resty.Response, error) {
+	return s.UpdateWirelessProfileWithContext(context.Background(), updateWirelessProfileRequest)
+}
+
+// UpdateWirelessProfileWithContext is like UpdateWirelessProfile but sends the request with ctx for cancellation and deadlines.
+func (s *WirelessService) UpdateWirelessProfileWithContext(ctx context.Context, updateWirelessProfileRequest *UpdateWirelessProfileRequest) (*UpdateWirelessProfileResponse, *resty.Response, error) {
 
 	path := "/dna/intent/api/v1/wireless/profile"
 
 	response, err := s.client.R().
+		SetContext(ctx).
 		SetBody(updateWirelessProfileRequest).
 		SetResult(&UpdateWirelessProfileResponse{}).
 		SetError(&Error{}).
@@ -992,7 +1083,7 @@ func (s *WirelessService) UpdateWirelessProfile(updateWirelessProfileRequest *Up
 	}
 
 	if response.IsError() {
-		return nil, response, fmt.Errorf("Error with operation updateWirelessProfile")
+		return nil, response, newAPIError("updateWirelessProfile", response)
 	}
 
 	result := response.Result().(*UpdateWirelessProfileResponse)
@@ -0,0 +1,214 @@
+package dnac
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/flate"
+	"crypto/aes"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+)
+
+// Zip entries are decrypted here because archive/zip only reads unencrypted
+// archives. Both WinZip AES and the traditional PKWARE encryption are supported.
+
+const (
+	zipFlagEncrypted      = 0x1
+	zipFlagDataDescriptor = 0x8
+	zipMethodAES          = 99
+	zipExtraAES           = 0x9901
+	zipAESVersion2        = 2
+)
+
+// errZipPassword is returned when an entry cannot be decrypted with the given password.
+var errZipPassword = errors.New("zip: invalid password")
+
+// readZipEntry returns the uncompressed content of f, decrypting it with
+// password if it is encrypted.
+func readZipEntry(f *zip.File, password string) ([]byte, error) {
+	if f.Flags&zipFlagEncrypted == 0 {
+		rc, err := f.Open()
+		if err != nil {
+			return nil, err
+		}
+		defer rc.Close()
+		return io.ReadAll(rc)
+	}
+
+	raw, err := f.OpenRaw()
+	if err != nil {
+		return nil, err
+	}
+	data, err := io.ReadAll(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	method := f.Method
+	checkCRC := true
+	if method == zipMethodAES {
+		var version uint16
+		var strength byte
+		version, strength, method, err = zipAESExtra(f.Extra)
+		if err != nil {
+			return nil, err
+		}
+		// AE-2 entries store no CRC, their HMAC authenticates the content.
+		checkCRC = version != zipAESVersion2
+		data, err = decryptZipAES(data, password, strength)
+	} else {
+		data, err = decryptZipCrypto(data, password, f)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	switch method {
+	case zip.Store:
+	case zip.Deflate:
+		data, err = io.ReadAll(flate.NewReader(bytes.NewReader(data)))
+	default:
+		return nil, fmt.Errorf("zip: unsupported compression method %d in %s", method, f.Name)
+	}
+	if err == nil && checkCRC && crc32.ChecksumIEEE(data) != f.CRC32 {
+		err = zip.ErrChecksum
+	}
+	if err != nil {
+		// The password check of the traditional encryption is a single
+		// byte that one wrong password in 256 passes; those fail here.
+		if f.Method != zipMethodAES {
+			return nil, errZipPassword
+		}
+		return nil, err
+	}
+	return data, nil
+}
+
+// zipAESExtra returns the vendor version, the key strength and the actual
+// compression method stored in the WinZip AES extra field.
+func zipAESExtra(extra []byte) (uint16, byte, uint16, error) {
+	for len(extra) >= 4 {
+		id := binary.LittleEndian.Uint16(extra[0:2])
+		size := int(binary.LittleEndian.Uint16(extra[2:4]))
+		extra = extra[4:]
+		if size > len(extra) {
+			break
+		}
+		if id == zipExtraAES && size >= 7 {
+			return binary.LittleEndian.Uint16(extra[0:2]), extra[4], binary.LittleEndian.Uint16(extra[5:7]), nil
+		}
+		extra = extra[size:]
+	}
+	return 0, 0, 0, errors.New("zip: missing AES extra field")
+}
+
+// decryptZipAES decrypts a WinZip AES entry: salt, password verifier,
+// AES-CTR encrypted data and a truncated HMAC-SHA1 of the encrypted data.
+func decryptZipAES(data []byte, password string, strength byte) ([]byte, error) {
+	if strength < 1 || strength > 3 {
+		return nil, fmt.Errorf("zip: unsupported AES strength %d", strength)
+	}
+	keyLen := 8 + 8*int(strength)
+	saltLen := keyLen / 2
+	if len(data) < saltLen+2+10 {
+		return nil, errors.New("zip: truncated AES entry")
+	}
+	salt := data[:saltLen]
+	verifier := data[saltLen : saltLen+2]
+	encrypted := data[saltLen+2 : len(data)-10]
+	authCode := data[len(data)-10:]
+
+	keys := pbkdf2SHA1([]byte(password), salt, 1000, 2*keyLen+2)
+	if !bytes.Equal(keys[2*keyLen:], verifier) {
+		return nil, errZipPassword
+	}
+	mac := hmac.New(sha1.New, keys[keyLen:2*keyLen])
+	mac.Write(encrypted)
+	if !hmac.Equal(mac.Sum(nil)[:10], authCode) {
+		return nil, errors.New("zip: AES authentication failed")
+	}
+
+	block, err := aes.NewCipher(keys[:keyLen])
+	if err != nil {
+		return nil, err
+	}
+	// WinZip AES uses CTR mode with a little-endian counter starting at 1.
+	plain := make([]byte, len(encrypted))
+	var counter, stream [aes.BlockSize]byte
+	for i := 0; i < len(encrypted); i += aes.BlockSize {
+		binary.LittleEndian.PutUint64(counter[:8], uint64(i/aes.BlockSize+1))
+		block.Encrypt(stream[:], counter[:])
+		for j := i; j < i+aes.BlockSize && j < len(encrypted); j++ {
+			plain[j] = encrypted[j] ^ stream[j-i]
+		}
+	}
+	return plain, nil
+}
+
+// decryptZipCrypto decrypts a traditional PKWARE encrypted entry.
+func decryptZipCrypto(data []byte, password string, f *zip.File) ([]byte, error) {
+	if len(data) < 12 {
+		return nil, errors.New("zip: truncated encrypted entry")
+	}
+	keys := [3]uint32{305419896, 591751049, 878082192}
+	update := func(b byte) {
+		keys[0] = crc32.IEEETable[(keys[0]^uint32(b))&0xff] ^ (keys[0] >> 8)
+		keys[1] = (keys[1]+keys[0]&0xff)*134775813 + 1
+		keys[2] = crc32.IEEETable[(keys[2]^(keys[1]>>24))&0xff] ^ (keys[2] >> 8)
+	}
+	for _, b := range []byte(password) {
+		update(b)
+	}
+
+	plain := make([]byte, len(data))
+	for i, b := range data {
+		k := keys[2] | 2
+		plain[i] = b ^ byte((k*(k^1))>>8)
+		update(plain[i])
+	}
+
+	check := byte(f.CRC32 >> 24)
+	if f.Flags&zipFlagDataDescriptor != 0 {
+		check = byte(f.ModifiedTime >> 8)
+	}
+	if plain[11] != check {
+		return nil, errZipPassword
+	}
+	return plain[12:], nil
+}
+
+// pbkdf2SHA1 derives a key of keyLen bytes from password and salt with
+// PBKDF2 using HMAC-SHA1.
+func pbkdf2SHA1(password []byte, salt []byte, iterations int, keyLen int) []byte {
+	prf := hmac.New(sha1.New, password)
+	hashLen := prf.Size()
+	blocks := (keyLen + hashLen - 1) / hashLen
+
+	var index [4]byte
+	key := make([]byte, 0, blocks*hashLen)
+	u := make([]byte, hashLen)
+	for block := 1; block <= blocks; block++ {
+		prf.Reset()
+		prf.Write(salt)
+		binary.BigEndian.PutUint32(index[:], uint32(block))
+		prf.Write(index[:])
+		key = prf.Sum(key)
+		t := key[len(key)-hashLen:]
+		copy(u, t)
+
+		for n := 2; n <= iterations; n++ {
+			prf.Reset()
+			prf.Write(u)
+			u = prf.Sum(u[:0])
+			for i := range u {
+				t[i] ^= u[i]
+			}
+		}
+	}
+	return key[:keyLen]
+}
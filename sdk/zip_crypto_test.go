@@ -0,0 +1,112 @@
+package dnac
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// The archives in testdata/zip hold running.cfg encrypted with the password
+// s3cret: the ZipCrypto ones were written by Info-ZIP zip, the AES ones by
+// libarchive.
+var zipFixtures = []struct {
+	file   string
+	method uint16
+}{
+	{"zipcrypto.zip", zip.Deflate},
+	{"zipcrypto-store.zip", zip.Store},
+	{"zipcrypto-stream.zip", zip.Deflate},
+	{"aes128.zip", zipMethodAES},
+	{"aes256.zip", zipMethodAES},
+}
+
+func openZipFixture(t *testing.T, name string) *zip.File {
+	t.Helper()
+	r, err := zip.OpenReader(filepath.Join("testdata", "zip", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { r.Close() })
+	if len(r.File) != 1 {
+		t.Fatalf("%s has %d entries, want 1", name, len(r.File))
+	}
+	return r.File[0]
+}
+
+func TestReadZipEntryKnownAnswers(t *testing.T) {
+	want, err := os.ReadFile(filepath.Join("testdata", "zip", "running.cfg"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, fixture := range zipFixtures {
+		t.Run(fixture.file, func(t *testing.T) {
+			f := openZipFixture(t, fixture.file)
+			if f.Method != fixture.method || f.Flags&zipFlagEncrypted == 0 {
+				t.Fatalf("entry has method %d and flags %#x, want method %d, encrypted", f.Method, f.Flags, fixture.method)
+			}
+			got, err := readZipEntry(f, "s3cret")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("got %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestReadZipEntryWrongPassword(t *testing.T) {
+	for _, fixture := range zipFixtures {
+		t.Run(fixture.file, func(t *testing.T) {
+			f := openZipFixture(t, fixture.file)
+			if _, err := readZipEntry(f, "secret"); err != errZipPassword {
+				t.Errorf("got %v, want %v", err, errZipPassword)
+			}
+		})
+	}
+}
+
+func TestReadZipEntryChecksumMismatch(t *testing.T) {
+	for _, fixture := range zipFixtures {
+		t.Run(fixture.file, func(t *testing.T) {
+			f := openZipFixture(t, fixture.file)
+			f.CRC32 ^= 0xff
+			// The check byte of ZipCrypto comes from the modification time
+			// when the entry has a data descriptor, so it still passes.
+			_, err := readZipEntry(f, "s3cret")
+			want := zip.ErrChecksum
+			if fixture.method != zipMethodAES {
+				want = errZipPassword
+			}
+			if !errors.Is(err, want) {
+				t.Errorf("got %v, want %v", err, want)
+			}
+		})
+	}
+}
+
+// TestPBKDF2SHA1 checks the test vectors of RFC 6070.
+func TestPBKDF2SHA1(t *testing.T) {
+	tests := []struct {
+		password   string
+		salt       string
+		iterations int
+		key        string
+	}{
+		{"password", "salt", 1, "0c60c80f961f0e71f3a9b524af6012062fe037a6"},
+		{"password", "salt", 2, "ea6c014dc72d6f8ccd1ed92ace1d41f0d8de8957"},
+		{"password", "salt", 4096, "4b007901b765489abead49d926f721d065a429c1"},
+		{"passwordPASSWORDpassword", "saltSALTsaltSALTsaltSALTsaltSALTsalt", 4096, "3d2eec4fe41c849b80c8d83662c0e44a8b291a964cf2f07038"},
+		{"pass\x00word", "sa\x00lt", 4096, "56fa6aa75548099dcc37d7f03425e0c3"},
+	}
+	for _, tt := range tests {
+		key := pbkdf2SHA1([]byte(tt.password), []byte(tt.salt), tt.iterations, len(tt.key)/2)
+		if got := hex.EncodeToString(key); got != tt.key {
+			t.Errorf("pbkdf2SHA1(%q, %q, %d) = %s, want %s", tt.password, tt.salt, tt.iterations, got, tt.key)
+		}
+	}
+}